@@ -0,0 +1,68 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/adapter/grpcapi"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/address"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/document"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/eventbus"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/security"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/file"
+)
+
+func main() {
+	addr := flag.String("addr", ":9090", "адрес gRPC сервера")
+	dataDir := flag.String("data", file.DefaultPassportsDir(), "каталог с паспортами")
+	usersFile := flag.String("users", file.DefaultUsersFile(), "файл пользователей")
+	flag.Parse()
+
+	logger := log.New(os.Stderr, "techpassport-grpc: ", log.LstdFlags)
+
+	// События паспортов записываются в журнал асинхронно
+	bus := eventbus.NewBus(func(event entity.DomainEvent, err error) {
+		logger.Printf("event %s: %v", event.Type, err)
+	})
+	bus.SubscribeAsync("log", 0, eventbus.LogHandler(logger))
+	defer bus.Close()
+
+	// Outbox включен в каталоге данных или зарегистрированы webhook организаций:
+	// события записываются вместе с паспортом
+	// и доставляются techpassport-server или techpassport-cli relay-outbox
+	passports := file.NewJSONPassportRepository(*dataDir)
+	if file.OutboxEnabled(*dataDir) || file.WebhooksEnabled(*dataDir) {
+		file.NewJSONOutbox(passports)
+	}
+
+	server := grpcapi.NewGRPCServer(grpcapi.Config{
+		Passports:  eventbus.NewPublishingRepository(passports, bus),
+		Users:      file.NewJSONUserRepository(*usersFile),
+		Hasher:     security.NewBcryptHasher(),
+		Generator:  document.NewGenerator(),
+		Normalizer: address.NewNormalizer(),
+		Logger:     logger,
+	})
+
+	listener, err := net.Listen("tcp", *addr)
+	if err != nil {
+		logger.Fatal(err)
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-signals
+		server.GracefulStop()
+	}()
+
+	logger.Printf("listening on %s (data: %s)", *addr, *dataDir)
+	if err := server.Serve(listener); err != nil {
+		logger.Fatal(err)
+	}
+}
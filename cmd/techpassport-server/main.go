@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/adapter/rest"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/address"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/document"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/eventbus"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/interchange"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/outbox"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/security"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/file"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "адрес HTTP сервера")
+	dataDir := flag.String("data", file.DefaultPassportsDir(), "каталог с паспортами")
+	usersFile := flag.String("users", file.DefaultUsersFile(), "файл пользователей")
+	openapi := flag.Bool("openapi", false, "вывести спецификацию OpenAPI и завершить работу")
+	var sinks outbox.SinkConfig
+	flag.StringVar(&sinks.Webhook, "webhook", "", "адрес webhook для событий паспортов")
+	flag.StringVar(&sinks.NATS, "nats", "", "адрес сервера NATS для событий паспортов (host:port)")
+	flag.StringVar(&sinks.NATSSubject, "nats-subject", outbox.DefaultNATSSubject, "префикс темы NATS")
+	flag.StringVar(&sinks.File, "events-file", "", "файл JSON Lines для событий паспортов")
+	relayInterval := flag.Duration("relay-interval", 5*time.Second, "период доставки событий из outbox")
+	flag.Parse()
+
+	logger := log.New(os.Stderr, "techpassport-server: ", log.LstdFlags)
+
+	// События паспортов записываются в журнал асинхронно
+	bus := eventbus.NewBus(func(event entity.DomainEvent, err error) {
+		logger.Printf("event %s: %v", event.Type, err)
+	})
+	bus.SubscribeAsync("log", 0, eventbus.LogHandler(logger))
+	defer bus.Close()
+
+	// События для внешних систем записываются в outbox вместе с паспортом
+	passports := file.NewJSONPassportRepository(*dataDir)
+	receivers := sinks.Sinks()
+
+	// Webhook организаций регистрируются командой techpassport-cli webhook-add
+	// и получают события через тот же outbox
+	if file.WebhooksEnabled(*dataDir) {
+		receivers = append(receivers, outbox.NewWebhookDispatcher(file.NewJSONWebhookRepository(*dataDir), nil))
+	}
+	var events *file.JSONOutbox
+	if len(receivers) > 0 || file.OutboxEnabled(*dataDir) {
+		events = file.NewJSONOutbox(passports)
+	}
+
+	server, err := rest.NewServer(rest.Config{
+		Passports:  eventbus.NewPublishingRepository(passports, bus),
+		Users:      file.NewJSONUserRepository(*usersFile),
+		Hasher:     security.NewBcryptHasher(),
+		Generator:  document.NewGenerator(),
+		Codec:      interchange.NewCodec(),
+		Normalizer: address.NewNormalizer(),
+		Logger:     logger,
+	})
+	if err != nil {
+		logger.Fatal(err)
+	}
+
+	if *openapi {
+		fmt.Println(string(server.OpenAPI()))
+		return
+	}
+
+	httpServer := &http.Server{
+		Addr:              *addr,
+		Handler:           server,
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      2 * time.Minute,
+		IdleTimeout:       2 * time.Minute,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if len(receivers) > 0 {
+		relay := outbox.NewRelay(events, receivers, outbox.Options{
+			OnError: func(err error) { logger.Printf("outbox: %v", err) },
+		})
+		go relay.Run(ctx, *relayInterval)
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			logger.Printf("shutdown: %v", err)
+		}
+	}()
+
+	logger.Printf("listening on %s (data: %s)", *addr, *dataDir)
+	if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logger.Fatal(err)
+	}
+}
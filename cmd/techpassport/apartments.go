@@ -0,0 +1,393 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// objectTypeOptions типы объектов в порядке списка выбора на вкладке "Общие сведения"
+var objectTypeOptions = []struct {
+	objectType entity.ObjectType
+	title      string
+}{
+	{entity.ObjectTypeResidentialHouse, "Индивидуальный жилой дом"},
+	{entity.ObjectTypeApartmentBuilding, "Многоквартирный жилой дом"},
+	{entity.ObjectTypeApartment, "Квартира"},
+	{entity.ObjectTypeRoom, "Комната"},
+	{entity.ObjectTypeNonResidential, "Нежилое помещение"},
+}
+
+// newObjectTypeSelect список выбора типа объекта
+func newObjectTypeSelect() *widget.Select {
+	var titles []string
+	for _, o := range objectTypeOptions {
+		titles = append(titles, o.title)
+	}
+	s := widget.NewSelect(titles, nil)
+	s.SetSelected(objectTypeOptions[0].title)
+	return s
+}
+
+// selectedObjectType тип объекта, выбранный на вкладке "Общие сведения"
+func (a *App) selectedObjectType() entity.ObjectType {
+	for _, o := range objectTypeOptions {
+		if o.title == a.generalFields.objectType.Selected {
+			return o.objectType
+		}
+	}
+	return entity.ObjectTypeResidentialHouse
+}
+
+// createFlatsTab создает вкладку "Квартиры" многоквартирного дома
+func (a *App) createFlatsTab() fyne.CanvasObject {
+	a.flatsList = widget.NewList(
+		func() int { return len(a.flats) },
+		func() fyne.CanvasObject { return widget.NewLabel("") },
+		func(id widget.ListItemID, obj fyne.CanvasObject) {
+			f := a.flats[id]
+			obj.(*widget.Label).SetText(fmt.Sprintf("Кв. %s - подъезд %s, эт. %s, лит. %s (%.2f кв.м, жилая %.2f кв.м)",
+				f.Number, f.Entrance, f.Floor, f.Litera, f.TotalArea(), f.LivingArea()))
+		},
+	)
+	a.flatsList.OnSelected = func(id widget.ListItemID) {
+		a.selectedFlat = id
+	}
+
+	a.apartmentSummary = widget.NewLabel("")
+	a.refreshFlats()
+
+	entrancesBtn := widget.NewButton("Подъезды...", a.showEntrancesDialog)
+	addBtn := widget.NewButton("Квартира...", a.showFlatDialog)
+	removeBtn := widget.NewButton("Удалить", func() {
+		if a.selectedFlat < 0 || a.selectedFlat >= len(a.flats) {
+			dialog.ShowInformation("Квартиры", "Выберите квартиру в списке", a.window)
+			return
+		}
+		a.flats = append(a.flats[:a.selectedFlat], a.flats[a.selectedFlat+1:]...)
+		a.selectedFlat = -1
+		a.flatsList.UnselectAll()
+		a.refreshFlats()
+	})
+	commonBtn := widget.NewButton("Общее имущество...", a.showCommonAreasDialog)
+	extractBtn := widget.NewButton("Выписка...", a.exportFlatExtract)
+
+	info := widget.NewLabel("Подъезды, квартиры и помещения общего имущества многоквартирного дома")
+	buttonBox := container.NewHBox(entrancesBtn, addBtn, removeBtn, commonBtn, extractBtn)
+
+	return container.NewBorder(info, container.NewVBox(a.apartmentSummary, buttonBox), nil, nil, a.flatsList)
+}
+
+// apartmentDraft паспорт многоквартирного дома из несохраненных списков вкладки;
+// изменения проверяются на нем теми же правилами, что и при сохранении
+func (a *App) apartmentDraft() (*entity.TechnicalPassport, error) {
+	if a.selectedObjectType() != entity.ObjectTypeApartmentBuilding {
+		return nil, fmt.Errorf("выберите тип объекта «Многоквартирный жилой дом» на вкладке «Общие сведения»")
+	}
+	draft := entity.NewTechnicalPassport(entity.ObjectTypeApartmentBuilding, entity.Address{})
+	draft.Entrances = append([]entity.Entrance(nil), a.entrances...)
+	draft.Flats = append([]entity.Flat(nil), a.flats...)
+	draft.CommonAreas = append([]entity.Room(nil), a.commonAreas...)
+	return draft, nil
+}
+
+// refreshFlats обновляет список квартир и итоги по дому
+func (a *App) refreshFlats() {
+	draft := entity.TechnicalPassport{Entrances: a.entrances, Flats: a.flats, CommonAreas: a.commonAreas}
+	t := draft.ApartmentTotals()
+	a.apartmentSummary.SetText(fmt.Sprintf(
+		"Подъездов: %d, квартир: %d, жилых комнат: %d. Площадь квартир %.2f кв.м (жилая %.2f), общее имущество %.2f кв.м, всего %.2f кв.м",
+		len(a.entrances), t.Flats, t.LivingRooms, t.FlatsArea, t.LivingArea, t.CommonArea, t.TotalArea))
+	a.flatsList.Refresh()
+}
+
+// showEntrancesDialog редактирование списка подъездов: строка на подъезд
+func (a *App) showEntrancesDialog() {
+	draft, err := a.apartmentDraft()
+	if err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+
+	var lines []string
+	for _, e := range a.entrances {
+		lines = append(lines, fmt.Sprintf("%s; %s; %d; %s", e.Number, e.Litera, e.Floors, e.Section))
+	}
+	text := widget.NewMultiLineEntry()
+	text.SetText(strings.Join(lines, "\n"))
+	text.SetPlaceHolder("1; А; 5; секция 1")
+
+	content := container.NewBorder(widget.NewLabel("Номер; литера; этажей; секция - по строке на подъезд"), nil, nil, nil, text)
+	d := dialog.NewCustomConfirm("Подъезды", "Сохранить", "Отмена", content, func(ok bool) {
+		if !ok {
+			return
+		}
+		var entrances []entity.Entrance
+		for i, cells := range splitLines(text.Text) {
+			e := entity.Entrance{Number: cell(cells, 0), Litera: cell(cells, 1), Section: cell(cells, 3)}
+			if floors := cell(cells, 2); floors != "" {
+				if e.Floors, err = strconv.Atoi(floors); err != nil {
+					dialog.ShowError(fmt.Errorf("строка %d: некорректное число этажей %s", i+1, floors), a.window)
+					return
+				}
+			}
+			entrances = append(entrances, e)
+		}
+		if err := draft.SetEntrances(entrances); err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		a.entrances = draft.Entrances
+		a.refreshFlats()
+	}, a.window)
+	d.Resize(fyne.NewSize(500, 350))
+	d.Show()
+}
+
+// showFlatDialog добавление квартиры или замена квартиры с тем же номером;
+// экспликация вводится по строке на помещение
+func (a *App) showFlatDialog() {
+	draft, err := a.apartmentDraft()
+	if err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+
+	number := widget.NewEntry()
+	litera := widget.NewEntry()
+	litera.SetPlaceHolder("А")
+	entrance := widget.NewEntry()
+	floor := widget.NewEntry()
+	cadastral := widget.NewEntry()
+	rooms := widget.NewMultiLineEntry()
+	rooms.SetPlaceHolder("1; Жилая комната; 17,4; 17,4\n2; Кухня; 8,5")
+	rooms.SetMinRowsVisible(6)
+
+	if a.selectedFlat >= 0 && a.selectedFlat < len(a.flats) {
+		f := a.flats[a.selectedFlat]
+		number.SetText(f.Number)
+		litera.SetText(f.Litera)
+		entrance.SetText(f.Entrance)
+		floor.SetText(f.Floor)
+		cadastral.SetText(f.CadastralNumber)
+		var lines []string
+		for _, r := range f.Explication {
+			lines = append(lines, fmt.Sprintf("%s; %s; %s; %s", r.RoomNumber, r.Purpose, formatNumber(r.Area), formatNumber(r.LivingArea)))
+		}
+		rooms.SetText(strings.Join(lines, "\n"))
+	}
+
+	items := []*widget.FormItem{
+		widget.NewFormItem("Номер квартиры *", number),
+		widget.NewFormItem("Литера *", litera),
+		widget.NewFormItem("Подъезд", entrance),
+		widget.NewFormItem("Этаж *", floor),
+		widget.NewFormItem("Кадастровый номер", cadastral),
+		widget.NewFormItem("Экспликация (№; назначение; площадь; жилая)", rooms),
+	}
+	dialog.ShowForm("Квартира", "Сохранить", "Отмена", items, func(ok bool) {
+		if !ok {
+			return
+		}
+		flat := entity.Flat{
+			Number:          strings.TrimSpace(number.Text),
+			Litera:          strings.TrimSpace(litera.Text),
+			Entrance:        strings.TrimSpace(entrance.Text),
+			Floor:           strings.TrimSpace(floor.Text),
+			CadastralNumber: strings.TrimSpace(cadastral.Text),
+		}
+		for i, cells := range splitLines(rooms.Text) {
+			room := entity.Room{RoomNumber: cell(cells, 0), Purpose: cell(cells, 1)}
+			if room.Area, err = parseNumber(cell(cells, 2)); err != nil {
+				dialog.ShowError(fmt.Errorf("помещение %d: некорректная площадь", i+1), a.window)
+				return
+			}
+			if room.LivingArea, err = parseNumber(cell(cells, 3)); err != nil {
+				dialog.ShowError(fmt.Errorf("помещение %d: некорректная жилая площадь", i+1), a.window)
+				return
+			}
+			if room.LivingArea == 0 {
+				room.AuxiliaryArea = room.Area
+			}
+			flat.Explication = append(flat.Explication, room)
+		}
+
+		if _, err := draft.SetFlat(flat); err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		a.flats = draft.Flats
+		a.refreshFlats()
+	}, a.window)
+}
+
+// showCommonAreasDialog редактирование помещений общего имущества
+func (a *App) showCommonAreasDialog() {
+	draft, err := a.apartmentDraft()
+	if err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+
+	var lines []string
+	for _, r := range a.commonAreas {
+		lines = append(lines, fmt.Sprintf("%s; %s; %s; %s; %s", r.Litera, r.Floor, r.RoomNumber, r.Purpose, formatNumber(r.Area)))
+	}
+	text := widget.NewMultiLineEntry()
+	text.SetText(strings.Join(lines, "\n"))
+	text.SetPlaceHolder("А; 1; I; Лестничная клетка; 24,6")
+
+	content := container.NewBorder(widget.NewLabel("Литера; этаж; №; назначение; площадь - по строке на помещение"), nil, nil, nil, text)
+	d := dialog.NewCustomConfirm("Помещения общего имущества", "Сохранить", "Отмена", content, func(ok bool) {
+		if !ok {
+			return
+		}
+		var rooms []entity.Room
+		for i, cells := range splitLines(text.Text) {
+			room := entity.Room{Litera: cell(cells, 0), Floor: cell(cells, 1), RoomNumber: cell(cells, 2), Purpose: cell(cells, 3)}
+			if room.Area, err = parseNumber(cell(cells, 4)); err != nil {
+				dialog.ShowError(fmt.Errorf("строка %d: некорректная площадь", i+1), a.window)
+				return
+			}
+			room.AuxiliaryArea = room.Area
+			rooms = append(rooms, room)
+		}
+		if err := draft.SetCommonAreas(rooms); err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		a.commonAreas = draft.CommonAreas
+		a.refreshFlats()
+	}, a.window)
+	d.Resize(fyne.NewSize(600, 350))
+	d.Show()
+}
+
+// exportFlatExtract сохраняет выписку из паспорта на выбранную квартиру в PDF
+func (a *App) exportFlatExtract() {
+	if a.selectedFlat < 0 || a.selectedFlat >= len(a.flats) {
+		dialog.ShowInformation("Выписка", "Выберите квартиру в списке", a.window)
+		return
+	}
+	if err := access.Authorize(a.ctx, entity.PermissionViewPassport); err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+	if err := a.collectDataFromFields(); err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+
+	current := *a.currentPassport
+	current.Buildings = a.buildings
+	current.FloorPlans = a.floorPlans
+	current.Entrances = a.entrances
+	current.Flats = a.flats
+	current.CommonAreas = a.commonAreas
+	number := a.flats[a.selectedFlat].Number
+
+	data, err := a.generator.Generate(a.ctx, &current, service.GenerateOptions{
+		Format:        service.FormatPDF,
+		IncludeImages: true,
+		Flat:          number,
+	})
+	if err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+
+	save := dialog.NewFileSave(func(w fyne.URIWriteCloser, err error) {
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		if w == nil {
+			return
+		}
+		defer w.Close()
+
+		if _, err := w.Write(data); err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		dialog.ShowInformation("Успех", "Выписка на квартиру № "+number+" сохранена в файл\n"+w.URI().Name(), a.window)
+	}, a.window)
+	save.SetFileName("Выписка кв " + number + ".pdf")
+	save.SetFilter(storage.NewExtensionFileFilter([]string{".pdf"}))
+	save.Show()
+}
+
+// saveApartments записывает подъезды, квартиры и общее имущество в сохраненный паспорт
+func (a *App) saveApartments() error {
+	if a.currentPassport.ObjectType != entity.ObjectTypeApartmentBuilding {
+		return nil
+	}
+
+	id := a.currentPassport.ID
+	if _, err := a.setEntrancesUC.Execute(a.ctx, passport.SetEntrancesInput{PassportID: id, Entrances: a.entrances}); err != nil {
+		return err
+	}
+	for _, f := range a.flats {
+		if _, err := a.setFlatUC.Execute(a.ctx, passport.SetFlatInput{PassportID: id, Flat: f}); err != nil {
+			return err
+		}
+	}
+	if len(a.commonAreas) > 0 {
+		if _, err := a.setCommonAreasUC.Execute(a.ctx, passport.SetCommonAreasInput{PassportID: id, Rooms: a.commonAreas}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitLines разбивает многострочный ввод на ячейки, разделенные точкой с запятой;
+// пустые строки пропускаются
+func splitLines(text string) [][]string {
+	var rows [][]string
+	for _, line := range strings.Split(text, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		cells := strings.Split(line, ";")
+		for i := range cells {
+			cells[i] = strings.TrimSpace(cells[i])
+		}
+		rows = append(rows, cells)
+	}
+	return rows
+}
+
+// cell ячейка строки или пустая строка
+func cell(cells []string, i int) string {
+	if i < len(cells) {
+		return cells[i]
+	}
+	return ""
+}
+
+// parseNumber разбирает число с десятичной запятой или точкой; пустая строка - 0
+func parseNumber(text string) (float64, error) {
+	if text == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(strings.ReplaceAll(text, ",", "."), 64)
+}
+
+// formatNumber форматирует число для редактирования
+func formatNumber(v float64) string {
+	if v == 0 {
+		return ""
+	}
+	return strings.Replace(strconv.FormatFloat(v, 'f', -1, 64), ".", ",", 1)
+}
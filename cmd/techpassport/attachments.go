@@ -0,0 +1,214 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// pendingAttachment файл, приложенный к несохраненному паспорту
+type pendingAttachment struct {
+	fileName    string
+	data        []byte
+	description string
+	target      entity.AttachmentTarget
+	mimeType    string
+	thumbnail   []byte
+}
+
+// createAttachmentsTab создает вкладку "Вложения": сканы документов
+// и фотографии со связью с правообладателем, зданием или планом
+func (a *App) createAttachmentsTab() fyne.CanvasObject {
+	a.attachmentPreview = canvas.NewImageFromResource(nil)
+	a.attachmentPreview.FillMode = canvas.ImageFillContain
+	a.attachmentPreview.SetMinSize(fyne.NewSize(256, 256))
+
+	a.attachmentsList = widget.NewList(
+		func() int { return len(a.attachments) },
+		func() fyne.CanvasObject { return widget.NewLabel("") },
+		func(id widget.ListItemID, obj fyne.CanvasObject) {
+			att := a.attachments[id]
+			text := fmt.Sprintf("%s (%s, %d КБ) - %s", att.fileName, att.mimeType, (len(att.data)+1023)/1024, att.target)
+			if att.description != "" {
+				text += ": " + att.description
+			}
+			obj.(*widget.Label).SetText(text)
+		},
+	)
+	a.attachmentsList.OnSelected = func(id widget.ListItemID) {
+		a.selectedAttachment = id
+		a.showAttachmentPreview(a.attachments[id])
+	}
+
+	addBtn := widget.NewButton("Добавить файл...", a.showAddAttachmentDialog)
+	removeBtn := widget.NewButton("Удалить", func() {
+		if a.selectedAttachment < 0 || a.selectedAttachment >= len(a.attachments) {
+			return
+		}
+		a.attachments = append(a.attachments[:a.selectedAttachment], a.attachments[a.selectedAttachment+1:]...)
+		a.clearAttachmentSelection()
+	})
+
+	info := widget.NewLabel("Сканы правоустанавливающих документов, фотографии и планы; одинаковые файлы хранятся один раз")
+	buttons := container.NewHBox(addBtn, removeBtn)
+
+	return container.NewBorder(info, buttons, nil, a.attachmentPreview, a.attachmentsList)
+}
+
+// attachmentTargets разделы паспорта, к которым можно приложить файл
+func (a *App) attachmentTargets() []entity.AttachmentTarget {
+	targets := []entity.AttachmentTarget{{Kind: entity.AttachmentTargetPassport}}
+	for i := range a.owners {
+		targets = append(targets, entity.AttachmentTarget{Kind: entity.AttachmentTargetOwner, OwnerIndex: i})
+	}
+	for _, b := range a.buildings {
+		targets = append(targets, entity.AttachmentTarget{Kind: entity.AttachmentTargetBuilding, Litera: b.Litera})
+	}
+	for _, p := range a.floorPlans {
+		targets = append(targets, entity.AttachmentTarget{Kind: entity.AttachmentTargetFloorPlan, Litera: p.Litera, Floor: p.Floor})
+	}
+	if a.situationPlan != nil {
+		targets = append(targets, entity.AttachmentTarget{Kind: entity.AttachmentTargetSituationPlan})
+	}
+	return targets
+}
+
+// showAddAttachmentDialog выбирает раздел паспорта и файл вложения
+func (a *App) showAddAttachmentDialog() {
+	if err := access.Authorize(a.ctx, entity.PermissionEditPassport); err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+
+	targets := a.attachmentTargets()
+	var options []string
+	for i, t := range targets {
+		label := t.String()
+		if t.Kind == entity.AttachmentTargetOwner {
+			label += ": " + ownerName(a.owners[t.OwnerIndex])
+		}
+		options = append(options, strconv.Itoa(i+1)+". "+label)
+	}
+	targetSelect := widget.NewSelect(options, nil)
+	targetSelect.SetSelectedIndex(0)
+
+	description := widget.NewEntry()
+	description.SetPlaceHolder("Свидетельство о праве собственности")
+
+	items := []*widget.FormItem{
+		widget.NewFormItem("Раздел", targetSelect),
+		widget.NewFormItem("Описание", description),
+	}
+	dialog.ShowForm("Добавить вложение", "Выбрать файл...", "Отмена", items, func(ok bool) {
+		if !ok {
+			return
+		}
+		target := targets[targetSelect.SelectedIndex()]
+
+		dialog.ShowFileOpen(func(r fyne.URIReadCloser, err error) {
+			if err != nil {
+				dialog.ShowError(err, a.window)
+				return
+			}
+			if r == nil {
+				return
+			}
+			defer r.Close()
+
+			data, err := io.ReadAll(r)
+			if err != nil {
+				dialog.ShowError(err, a.window)
+				return
+			}
+			a.addAttachment(r.URI().Name(), data, description.Text, target)
+		}, a.window)
+	}, a.window)
+}
+
+// addAttachment добавляет файл в список; тот же файл для того же раздела
+// повторно не добавляется
+func (a *App) addAttachment(fileName string, data []byte, description string, target entity.AttachmentTarget) {
+	if len(data) == 0 {
+		dialog.ShowError(fmt.Errorf("файл %s пуст", fileName), a.window)
+		return
+	}
+	if len(data) > passport.MaxAttachmentSize {
+		dialog.ShowError(fmt.Errorf("файл %s больше %d МБ", fileName, passport.MaxAttachmentSize>>20), a.window)
+		return
+	}
+
+	for _, att := range a.attachments {
+		if att.target == target && bytes.Equal(att.data, data) {
+			dialog.ShowInformation("Вложения", "Файл "+fileName+" уже приложен: "+target.String(), a.window)
+			return
+		}
+	}
+
+	att := pendingAttachment{
+		fileName:    fileName,
+		data:        data,
+		description: description,
+		target:      target,
+		mimeType:    a.attachmentInspector.DetectMIME(data, fileName),
+	}
+	// Поврежденное изображение прикладывается без миниатюры
+	att.thumbnail, _ = a.attachmentInspector.Thumbnail(a.ctx, data, att.mimeType)
+
+	a.attachments = append(a.attachments, att)
+	a.attachmentsList.Refresh()
+	a.attachmentsList.Select(len(a.attachments) - 1)
+}
+
+// showAttachmentPreview показывает миниатюру изображения
+func (a *App) showAttachmentPreview(att pendingAttachment) {
+	if att.thumbnail == nil {
+		a.attachmentPreview.Resource = nil
+	} else {
+		a.attachmentPreview.Resource = fyne.NewStaticResource(att.fileName+".thumb.png", att.thumbnail)
+	}
+	a.attachmentPreview.Refresh()
+}
+
+// clearAttachmentSelection сбрасывает выбор и миниатюру
+func (a *App) clearAttachmentSelection() {
+	a.selectedAttachment = -1
+	a.attachmentsList.UnselectAll()
+	a.attachmentsList.Refresh()
+	a.showAttachmentPreview(pendingAttachment{})
+}
+
+// saveAttachments прикладывает файлы к сохраненному паспорту
+func (a *App) saveAttachments() error {
+	for _, att := range a.attachments {
+		_, err := a.addAttachmentUC.Execute(a.ctx, passport.AddAttachmentInput{
+			PassportID:  a.currentPassport.ID,
+			FileName:    att.fileName,
+			Data:        att.data,
+			Description: att.description,
+			Target:      att.target,
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", att.fileName, err)
+		}
+	}
+	return nil
+}
+
+// ownerName имя правообладателя для списков
+func ownerName(o entity.Owner) string {
+	if o.PersonType == entity.PersonTypeLegal {
+		return o.CompanyName
+	}
+	return o.FullName
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// showValueBuildingDialog рассчитывает инвентаризационную стоимость выбранного здания
+// по справочнику УПВС; после подтверждения стоимость и расчет записываются в здание
+func (a *App) showValueBuildingDialog() {
+	if a.selectedBuilding < 0 || a.selectedBuilding >= len(a.buildings) {
+		dialog.ShowInformation("Инвентаризационная стоимость", "Выберите здание в списке", a.window)
+		return
+	}
+	if err := access.Authorize(a.ctx, entity.PermissionEditPassport); err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+
+	tables, err := a.valuer.Tables()
+	if err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+	var versions []string
+	current := ""
+	for _, t := range tables {
+		versions = append(versions, t.Version)
+		if t.Default {
+			current = t.Version
+		}
+	}
+	tableSelect := widget.NewSelect(versions, nil)
+	tableSelect.SetSelected(current)
+
+	year := widget.NewEntry()
+	year.SetText(strconv.Itoa(time.Now().Year()))
+
+	region := widget.NewEntry()
+	region.SetText(a.addressForm.GetAddressData()["subject"])
+	region.SetPlaceHolder("г. Москва")
+
+	wear := widget.NewEntry()
+	wear.SetPlaceHolder("по элементам или сроку службы")
+
+	building := a.buildings[a.selectedBuilding]
+	if v := building.Valuation; v != nil {
+		tableSelect.SetSelected(v.Inputs.TableVersion)
+		if v.Inputs.WearPercent != nil {
+			wear.SetText(strconv.FormatFloat(*v.Inputs.WearPercent, 'f', -1, 64))
+		}
+	}
+
+	items := []*widget.FormItem{
+		widget.NewFormItem("Справочник", tableSelect),
+		widget.NewFormItem("Год цен", year),
+		widget.NewFormItem("Субъект РФ", region),
+		widget.NewFormItem("Износ по осмотру, %", wear),
+	}
+	title := fmt.Sprintf("Стоимость литеры %s", building.Litera)
+	dialog.ShowForm(title, "Рассчитать", "Отмена", items, func(ok bool) {
+		if !ok {
+			return
+		}
+
+		inputs := entity.ValuationInputs{
+			TableVersion:   tableSelect.Selected,
+			Region:         strings.TrimSpace(region.Text),
+			ObjectType:     a.currentPassport.ObjectType,
+			Name:           building.Name,
+			WallMaterial:   building.WallMaterial,
+			Volume:         building.Volume,
+			TotalArea:      building.TotalArea,
+			CommissionYear: building.CommissionYear,
+			ElementWear:    entity.ElementWear(building.StructuralElements),
+		}
+		if _, err := fmt.Sscanf(year.Text, "%d", &inputs.Year); err != nil {
+			dialog.ShowError(fmt.Errorf("некорректный год цен: %s", year.Text), a.window)
+			return
+		}
+		if text := strings.TrimSpace(wear.Text); text != "" {
+			value, err := strconv.ParseFloat(strings.ReplaceAll(text, ",", "."), 64)
+			if err != nil {
+				dialog.ShowError(fmt.Errorf("некорректный износ: %s", text), a.window)
+				return
+			}
+			inputs.WearPercent = &value
+		}
+
+		a.calculateBuildingValue(a.selectedBuilding, inputs)
+	}, a.window)
+}
+
+// calculateBuildingValue показывает расчет и после подтверждения записывает его в здание
+func (a *App) calculateBuildingValue(index int, inputs entity.ValuationInputs) {
+	output, err := a.calculateValueUC.Execute(a.ctx, passport.CalculateInventoryValueInput{Inputs: inputs})
+	if err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+	v := output.Valuation
+
+	unit := "куб.м"
+	if v.Unit == entity.ValuationUnitArea {
+		unit = "кв.м"
+	}
+	wearSource := "по осмотру"
+	switch {
+	case v.WearByAge:
+		wearSource = "по сроку службы"
+	case len(v.WearElements) > 0:
+		wearSource = "по конструктивным элементам"
+	}
+
+	var details strings.Builder
+	fmt.Fprintf(&details, "Справочник %s, назначение %s, группа %s\n", v.Inputs.TableVersion, v.Inputs.Purpose, v.WallGroup)
+	fmt.Fprintf(&details, "%.2f %s x %.2f руб. x %.2f (территориальный) x %.2f (индекс %d г.)\n",
+		v.Quantity, unit, v.UnitCost, v.RegionalCoefficient, v.PriceIndex, v.Inputs.Year)
+	fmt.Fprintf(&details, "Восстановительная стоимость: %.0f руб.\n", v.ReplacementCost)
+	fmt.Fprintf(&details, "Износ %s: %.0f%%\n", wearSource, v.Wear)
+	for _, w := range v.Warnings {
+		details.WriteString(w + "\n")
+	}
+	fmt.Fprintf(&details, "\nИнвентаризационная стоимость: %.0f руб. (сейчас %.2f руб.)\nЗаписать в здание?",
+		v.InventoryValue, a.buildings[index].InventoryValue)
+
+	dialog.ShowConfirm("Расчет стоимости", details.String(), func(ok bool) {
+		if !ok {
+			return
+		}
+		a.buildings[index].InventoryValue = v.InventoryValue
+		a.buildings[index].Valuation = v
+		a.buildingsList.Refresh()
+	}, a.window)
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// CommercialFields поля сведений о пожарной безопасности нежилого объекта
+type CommercialFields struct {
+	fireHazardClass   *widget.Select
+	explosionCategory *widget.Select
+	fireResistance    *widget.Select
+	evacuationExits   *widget.Entry
+	separateEntrance  *widget.Check
+	note              *widget.Entry
+}
+
+// createCommercialTab создает вкладку "Нежилое" с пожарной безопасностью
+// и площадями по категориям использования
+func (a *App) createCommercialTab() fyne.CanvasObject {
+	a.commercialFields = &CommercialFields{
+		fireHazardClass:   widget.NewSelect(entity.FireHazardClasses, nil),
+		explosionCategory: widget.NewSelect(entity.ExplosionCategories, nil),
+		fireResistance:    widget.NewSelect(entity.FireResistanceDegrees, nil),
+		evacuationExits:   widget.NewEntry(),
+		separateEntrance:  widget.NewCheck("Отдельный вход с улицы", nil),
+		note:              widget.NewEntry(),
+	}
+	a.commercialFields.evacuationExits.SetPlaceHolder("Например: 2")
+
+	form := &widget.Form{
+		Items: []*widget.FormItem{
+			{Text: "Класс функциональной пожарной опасности:", Widget: a.commercialFields.fireHazardClass},
+			{Text: "Категория по взрывопожарной опасности:", Widget: a.commercialFields.explosionCategory},
+			{Text: "Степень огнестойкости:", Widget: a.commercialFields.fireResistance},
+			{Text: "Эвакуационных выходов:", Widget: a.commercialFields.evacuationExits},
+			{Text: "", Widget: a.commercialFields.separateEntrance},
+			{Text: "Примечание:", Widget: a.commercialFields.note},
+		},
+	}
+
+	a.categorySummary = widget.NewLabel("")
+	a.refreshCategorySummary()
+
+	categoryBtn := widget.NewButton("Категория помещения...", a.showUseCategoryDialog)
+
+	info := widget.NewLabel("Сведения о нежилом объекте заполняются для типа «Нежилое помещение»")
+
+	return container.NewVScroll(container.NewVBox(info, form, widget.NewSeparator(), a.categorySummary, categoryBtn))
+}
+
+// applyObjectProfile включает поля, которые учитываются для выбранного типа объекта
+func (a *App) applyObjectProfile() {
+	profile := a.selectedObjectType().Profile()
+
+	if profile.LivingArea {
+		a.generalFields.livingArea.Enable()
+	} else {
+		a.generalFields.livingArea.SetText("")
+		a.generalFields.livingArea.Disable()
+	}
+
+	if a.commercialFields == nil {
+		return
+	}
+	for _, w := range []fyne.Disableable{
+		a.commercialFields.fireHazardClass,
+		a.commercialFields.explosionCategory,
+		a.commercialFields.fireResistance,
+		a.commercialFields.evacuationExits,
+		a.commercialFields.separateEntrance,
+		a.commercialFields.note,
+	} {
+		if profile.Commercial {
+			w.Enable()
+		} else {
+			w.Disable()
+		}
+	}
+}
+
+// showUseCategoryDialog назначает категорию использования выбранному помещению экспликации
+func (a *App) showUseCategoryDialog() {
+	if a.selectedRoom < 0 || a.selectedRoom >= len(a.rooms) {
+		dialog.ShowInformation("Категория помещения", "Выберите помещение на вкладке «Экспликация»", a.window)
+		return
+	}
+	room := &a.rooms[a.selectedRoom]
+
+	var titles []string
+	for _, c := range entity.UseCategories {
+		titles = append(titles, c.Title())
+	}
+	categorySelect := widget.NewSelect(titles, nil)
+	if room.UseCategory != "" {
+		categorySelect.SetSelected(room.UseCategory.Title())
+	}
+	auxiliary := widget.NewEntry()
+	auxiliary.SetText(formatNumber(room.AuxiliaryArea))
+
+	form := []*widget.FormItem{
+		widget.NewFormItem("Категория:", categorySelect),
+		widget.NewFormItem("Вспомогательная площадь (кв.м):", auxiliary),
+	}
+	title := fmt.Sprintf("Помещение %s (литера %s, этаж %s)", room.RoomNumber, room.Litera, room.Floor)
+
+	dialog.ShowForm(title, "Сохранить", "Отмена", form, func(ok bool) {
+		if !ok {
+			return
+		}
+		area, err := parseNumber(auxiliary.Text)
+		if err != nil || area < 0 || area > room.Area {
+			dialog.ShowError(fmt.Errorf("вспомогательная площадь должна быть от 0 до %.2f кв.м", room.Area), a.window)
+			return
+		}
+		room.AuxiliaryArea = area
+		room.UseCategory = ""
+		for _, c := range entity.UseCategories {
+			if c.Title() == categorySelect.Selected {
+				room.UseCategory = c
+			}
+		}
+		a.roomsList.Refresh()
+		a.refreshCategorySummary()
+	}, a.window)
+}
+
+// refreshCategorySummary обновляет площади по категориям использования
+func (a *App) refreshCategorySummary() {
+	draft := entity.TechnicalPassport{Explication: a.rooms}
+	lines := []string{"Площади по категориям использования:"}
+	for _, c := range draft.UseCategoryTotals() {
+		lines = append(lines, fmt.Sprintf("%s - помещений %d, основная %.2f кв.м, вспомогательная %.2f кв.м",
+			c.Category.Title(), c.Rooms, c.MainArea, c.AuxiliaryArea))
+	}
+	if len(lines) == 1 {
+		lines = append(lines, "экспликация не заполнена")
+	}
+	a.categorySummary.SetText(strings.Join(lines, "\n"))
+}
+
+// commercialInfo собирает сведения о пожарной безопасности из полей вкладки
+func (a *App) commercialInfo() (entity.CommercialInfo, error) {
+	f := a.commercialFields
+	info := entity.CommercialInfo{
+		FireHazardClass:   f.fireHazardClass.Selected,
+		ExplosionCategory: f.explosionCategory.Selected,
+		FireResistance:    f.fireResistance.Selected,
+		SeparateEntrance:  f.separateEntrance.Checked,
+		Note:              f.note.Text,
+	}
+	if text := strings.TrimSpace(f.evacuationExits.Text); text != "" {
+		exits, err := strconv.Atoi(text)
+		if err != nil {
+			return info, fmt.Errorf("некорректное количество эвакуационных выходов: %s", text)
+		}
+		info.EvacuationExits = exits
+	}
+	return info, nil
+}
+
+// saveCommercial записывает сведения о пожарной безопасности в сохраненный паспорт
+func (a *App) saveCommercial() error {
+	if !a.currentPassport.ObjectType.Profile().Commercial {
+		return nil
+	}
+
+	info, err := a.commercialInfo()
+	if err != nil {
+		return err
+	}
+	if info == (entity.CommercialInfo{}) {
+		return nil
+	}
+
+	_, err = a.setCommercialUC.Execute(a.ctx, passport.SetCommercialInfoInput{PassportID: a.currentPassport.ID, Info: info})
+	return err
+}
+
+// clearCommercialFields очищает вкладку "Нежилое"
+func (a *App) clearCommercialFields() {
+	f := a.commercialFields
+	f.fireHazardClass.ClearSelected()
+	f.explosionCategory.ClearSelected()
+	f.fireResistance.ClearSelected()
+	f.evacuationExits.SetText("")
+	f.separateEntrance.SetChecked(false)
+	f.note.SetText("")
+	a.refreshCategorySummary()
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// showDuplicatesDialog показывает вероятные дубликаты сохраненного паспорта
+// и предлагает перенести его сведения в выбранный ранее созданный паспорт
+func (a *App) showDuplicatesDialog(saved string, created *entity.TechnicalPassport, duplicates []passport.DuplicateCandidate) {
+	selected := -1
+
+	list := widget.NewList(
+		func() int { return len(duplicates) },
+		func() fyne.CanvasObject { return widget.NewLabel("") },
+		func(id widget.ListItemID, obj fyne.CanvasObject) {
+			d := duplicates[id]
+			obj.(*widget.Label).SetText(fmt.Sprintf("%s - %s (%s, сходство %.0f%%)",
+				d.Passport.ID, d.Passport.Address.FullAddress(), statusTitle(string(d.Passport.Status)), d.Score*100))
+		},
+	)
+
+	var dlg dialog.Dialog
+	mergeBtn := widget.NewButton("Объединить с выбранным", func() {
+		if selected < 0 {
+			return
+		}
+		target := duplicates[selected].Passport
+		output, err := a.mergeUC.Execute(a.ctx, passport.MergePassportsInput{PassportID: target.ID, SourceID: created.ID})
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		dlg.Hide()
+
+		a.currentPassport = output.Passport
+		dialog.ShowInformation("Паспорта объединены", mergeText(output), a.window)
+	})
+	mergeBtn.Disable()
+	list.OnSelected = func(id widget.ListItemID) {
+		selected = id
+		mergeBtn.Enable()
+	}
+
+	info := widget.NewLabel(saved + "\n\nНайдены паспорта с похожим адресом. Сведения нового паспорта можно\n" +
+		"перенести в выбранный паспорт; новый паспорт при этом будет удален.")
+	content := container.NewBorder(info, mergeBtn, nil, nil, list)
+
+	dlg = dialog.NewCustom("Возможные дубликаты", "Оставить оба", content, a.window)
+	dlg.Resize(fyne.NewSize(750, 450))
+	dlg.Show()
+}
+
+// mergeText описание перенесенных при объединении сведений
+func mergeText(output *passport.MergePassportsOutput) string {
+	r := output.Result
+	lines := []string{
+		fmt.Sprintf("Сведения паспорта %s перенесены в паспорт %s, паспорт %s удален.", output.SourceID, output.Passport.ID, output.SourceID),
+		"",
+		fmt.Sprintf("Здания: %d, сооружения: %d, правообладатели: %d", r.Buildings, r.Structures, r.Owners),
+		fmt.Sprintf("Поэтажные планы: %d, помещения: %d, квартиры: %d, вложения: %d", r.FloorPlans, r.Rooms, r.Flats, r.Attachments),
+	}
+	if len(r.Fields) > 0 {
+		lines = append(lines, "Заполнены поля: "+strings.Join(r.Fields, ", "))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,317 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// createFloorPlansTab создает вкладку "Поэтажные планы": список планов,
+// просмотр выбранного плана и сверка площадей с экспликацией
+func (a *App) createFloorPlansTab() fyne.CanvasObject {
+	a.planPreview = canvas.NewImageFromResource(nil)
+	a.planPreview.FillMode = canvas.ImageFillContain
+
+	a.floorPlansList = widget.NewList(
+		func() int { return len(a.floorPlans) },
+		func() fyne.CanvasObject { return widget.NewLabel("") },
+		func(id widget.ListItemID, obj fyne.CanvasObject) {
+			plan := a.floorPlans[id]
+			text := fmt.Sprintf("Литера %s, этаж %s - помещений: %d", plan.Litera, plan.Floor, len(plan.Rooms))
+			if len(plan.Walls) == 0 && len(plan.Rooms) == 0 {
+				text = "Отсканированный план: " + plan.Background
+			}
+			obj.(*widget.Label).SetText(text)
+		},
+	)
+	a.floorPlansList.OnSelected = func(id widget.ListItemID) {
+		a.selectedPlan = id
+		a.showPlanPreview(&a.floorPlans[id])
+	}
+
+	loadBtn := widget.NewButton("Загрузить план (JSON)...", a.loadFloorPlan)
+	dxfBtn := widget.NewButton("Загрузить чертеж (DXF)...", a.showImportDrawingDialog)
+	removeBtn := widget.NewButton("Удалить план", a.removeFloorPlan)
+	checkBtn := widget.NewButton("Сверить площади", a.checkFloorPlanAreas)
+
+	info := widget.NewLabel("Векторные поэтажные планы; помещения связываются с экспликацией по литере, этажу и номеру")
+	buttons := container.NewHBox(loadBtn, dxfBtn, removeBtn, checkBtn)
+	split := container.NewHSplit(a.floorPlansList, a.planPreview)
+	split.Offset = 0.3
+
+	return container.NewBorder(info, buttons, nil, nil, split)
+}
+
+// loadFloorPlan загружает план из JSON; план той же литеры и этажа заменяется
+func (a *App) loadFloorPlan() {
+	if err := access.Authorize(a.ctx, entity.PermissionEditPassport); err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+
+	open := dialog.NewFileOpen(func(r fyne.URIReadCloser, err error) {
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		if r == nil {
+			return
+		}
+		defer r.Close()
+
+		data, err := io.ReadAll(r)
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+
+		var plan entity.FloorPlan
+		if err := json.Unmarshal(data, &plan); err != nil {
+			dialog.ShowError(fmt.Errorf("некорректный JSON плана: %w", err), a.window)
+			return
+		}
+		if err := plan.IsValid(); err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+
+		index := -1
+		for i := range a.floorPlans {
+			if plan.Litera != "" && a.floorPlans[i].Matches(plan.Litera, plan.Floor) {
+				index = i
+			}
+		}
+		if index >= 0 {
+			a.floorPlans[index] = plan
+		} else {
+			a.floorPlans = append(a.floorPlans, plan)
+			index = len(a.floorPlans) - 1
+		}
+
+		a.floorPlansList.Refresh()
+		a.floorPlansList.Select(index)
+	}, a.window)
+	open.SetFilter(storage.NewExtensionFileFilter([]string{".json"}))
+	open.Show()
+}
+
+// removeFloorPlan удаляет выбранный план
+func (a *App) removeFloorPlan() {
+	if a.selectedPlan < 0 || a.selectedPlan >= len(a.floorPlans) {
+		dialog.ShowInformation("Поэтажные планы", "Выберите план в списке", a.window)
+		return
+	}
+
+	a.floorPlans = append(a.floorPlans[:a.selectedPlan], a.floorPlans[a.selectedPlan+1:]...)
+	a.clearPlanSelection()
+}
+
+// clearPlanSelection сбрасывает выбор и просмотр плана
+func (a *App) clearPlanSelection() {
+	a.selectedPlan = -1
+	a.floorPlansList.UnselectAll()
+	a.floorPlansList.Refresh()
+	a.planPreview.Resource = nil
+	a.planPreview.Refresh()
+}
+
+// showPlanPreview отрисовывает план для просмотра
+func (a *App) showPlanPreview(plan *entity.FloorPlan) {
+	a.planPreview.Resource = nil
+	if len(plan.Walls) > 0 || len(plan.Rooms) > 0 {
+		data, err := a.planRenderer.Render(a.ctx, plan, service.PlanFormatPNG)
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		a.planPreview.Resource = fyne.NewStaticResource("plan.png", data)
+	}
+	a.planPreview.Refresh()
+}
+
+// checkFloorPlanAreas сверяет площади контуров с экспликацией на вкладке "Экспликация"
+func (a *App) checkFloorPlanAreas() {
+	current := *a.currentPassport
+	current.FloorPlans = a.floorPlans
+	current.Explication = a.rooms
+
+	var problems bytes.Buffer
+	checks := passport.CheckFloorPlanAreas(&current, passport.DefaultAreaTolerance)
+	for _, c := range checks {
+		switch c.Status {
+		case passport.AreaCheckMismatch:
+			fmt.Fprintf(&problems, "Литера %s, этаж %s, пом. %s: по плану %.2f, по экспликации %.2f кв.м\n",
+				c.Litera, c.Floor, c.RoomNumber, c.PlanArea, c.RoomArea)
+		case passport.AreaCheckNoRoom:
+			fmt.Fprintf(&problems, "Литера %s, этаж %s, пом. %s: нет в экспликации\n", c.Litera, c.Floor, c.RoomNumber)
+		case passport.AreaCheckNoContour:
+			fmt.Fprintf(&problems, "Литера %s, этаж %s, пом. %s: нет на плане\n", c.Litera, c.Floor, c.RoomNumber)
+		}
+	}
+
+	if len(checks) == 0 {
+		dialog.ShowInformation("Сверка площадей", "Нет векторных планов с помещениями", a.window)
+		return
+	}
+	if problems.Len() == 0 {
+		dialog.ShowInformation("Сверка площадей", fmt.Sprintf("Площади %d помещений совпадают с экспликацией", len(checks)), a.window)
+		return
+	}
+
+	text := widget.NewLabel(strings.TrimSpace(problems.String()))
+	scroll := container.NewVScroll(text)
+	scroll.SetMinSize(fyne.NewSize(600, 300))
+	dialog.ShowCustom("Сверка площадей: расхождения", "Закрыть", scroll, a.window)
+}
+
+// showImportDrawingDialog загружает план этажа из чертежа DXF: контуры помещений
+// и номера, помещений которых нет в экспликации, добавляются черновиками
+func (a *App) showImportDrawingDialog() {
+	if err := access.Authorize(a.ctx, entity.PermissionEditPassport); err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+
+	litera := widget.NewEntry()
+	litera.SetText("А")
+	floor := widget.NewEntry()
+	floor.SetText("1")
+	roomLayers := widget.NewEntry()
+	roomLayers.SetText(strings.Join(passport.DefaultRoomLayers, ","))
+	textLayers := widget.NewEntry()
+	textLayers.SetPlaceHolder("любые")
+	units := widget.NewSelect([]string{"из чертежа", "mm", "cm", "m"}, nil)
+	units.SetSelectedIndex(0)
+
+	items := []*widget.FormItem{
+		widget.NewFormItem("Литера", litera),
+		widget.NewFormItem("Этаж", floor),
+		widget.NewFormItem("Слои помещений", roomLayers),
+		widget.NewFormItem("Слои номеров", textLayers),
+		widget.NewFormItem("Единицы", units),
+	}
+	dialog.ShowForm("Загрузить чертеж DXF", "Выбрать файл...", "Отмена", items, func(ok bool) {
+		if !ok {
+			return
+		}
+
+		options := passport.DrawingImportOptions{
+			Litera:     strings.TrimSpace(litera.Text),
+			Floor:      strings.TrimSpace(floor.Text),
+			RoomLayers: splitLayers(roomLayers.Text),
+			TextLayers: splitLayers(textLayers.Text),
+		}
+		if units.SelectedIndex() > 0 {
+			options.Unit = service.DrawingUnit(units.Selected)
+		}
+		a.importDrawingFile(options)
+	}, a.window)
+}
+
+// importDrawingFile разбирает выбранный чертеж и показывает замечания
+func (a *App) importDrawingFile(options passport.DrawingImportOptions) {
+	open := dialog.NewFileOpen(func(r fyne.URIReadCloser, err error) {
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		if r == nil {
+			return
+		}
+		defer r.Close()
+
+		drawing, err := a.drawingParser.ParseDrawing(a.ctx, r)
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		result, err := passport.BuildFloorPlanFromDrawing(drawing, options)
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		if len(result.Plan.Rooms) == 0 {
+			a.showDrawingIssues(result.Issues, "Ни один контур не связан с номером помещения")
+			return
+		}
+
+		// Помещения, которые уже есть в экспликации, не изменяются
+		added := 0
+		for _, room := range result.Rooms {
+			exists := false
+			for _, r := range a.rooms {
+				if strings.EqualFold(r.Litera, room.Litera) && strings.EqualFold(r.Floor, room.Floor) && strings.EqualFold(r.RoomNumber, room.RoomNumber) {
+					exists = true
+				}
+			}
+			if !exists {
+				a.rooms = append(a.rooms, room)
+				added++
+			}
+		}
+		a.roomsList.Refresh()
+
+		index := -1
+		for i := range a.floorPlans {
+			if a.floorPlans[i].Matches(result.Plan.Litera, result.Plan.Floor) {
+				index = i
+			}
+		}
+		if index >= 0 {
+			a.floorPlans[index] = result.Plan
+		} else {
+			a.floorPlans = append(a.floorPlans, result.Plan)
+			index = len(a.floorPlans) - 1
+		}
+		a.floorPlansList.Refresh()
+		a.floorPlansList.Select(index)
+
+		summary := fmt.Sprintf("Помещений на плане: %d, добавлено в экспликацию: %d", len(result.Plan.Rooms), added)
+		if len(result.Issues) == 0 {
+			dialog.ShowInformation("Чертеж загружен", summary, a.window)
+			return
+		}
+		a.showDrawingIssues(result.Issues, summary)
+	}, a.window)
+	open.SetFilter(storage.NewExtensionFileFilter([]string{".dxf"}))
+	open.Show()
+}
+
+// showDrawingIssues выводит замечания к чертежу для ручной проверки
+func (a *App) showDrawingIssues(issues []passport.DrawingIssue, summary string) {
+	var text bytes.Buffer
+	fmt.Fprintf(&text, "%s\n\nТребуют проверки:\n", summary)
+	for _, issue := range issues {
+		fmt.Fprintf(&text, "%s (слой %s, точка %.0f; %.0f)\n", issue.Message, issue.Layer, issue.At.X, issue.At.Y)
+	}
+
+	label := widget.NewLabel(strings.TrimSpace(text.String()))
+	scroll := container.NewVScroll(label)
+	scroll.SetMinSize(fyne.NewSize(600, 300))
+	dialog.ShowCustom("Замечания к чертежу", "Закрыть", scroll, a.window)
+}
+
+// splitLayers разбирает список слоев через запятую
+func splitLayers(value string) []string {
+	var layers []string
+	for _, l := range strings.Split(value, ",") {
+		if l = strings.TrimSpace(l); l != "" {
+			layers = append(layers, l)
+		}
+	}
+	return layers
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/user"
+)
+
+// roleTitles названия ролей для отображения
+var roleTitles = map[entity.Role]string{
+	entity.RoleTechnician: "Техник",
+	entity.RoleReviewer:   "Проверяющий",
+	entity.RoleAdmin:      "Администратор",
+}
+
+// showLoginDialog показывает диалог входа и устанавливает пользователя сессии
+func (a *App) showLoginDialog() {
+	login := widget.NewEntry()
+	login.SetPlaceHolder("Логин")
+
+	password := widget.NewPasswordEntry()
+	password.SetPlaceHolder("Пароль")
+
+	// При первом запуске пользователей нет - предлагаем создать администратора
+	setupBtn := widget.NewButton("Первый запуск: создать администратора", func() {
+		a.showRegisterAdminDialog()
+	})
+
+	items := []*widget.FormItem{
+		{Text: "Логин:", Widget: login},
+		{Text: "Пароль:", Widget: password},
+		{Text: "", Widget: setupBtn},
+	}
+
+	dlg := dialog.NewForm("Вход в систему", "Войти", "Отмена", items, func(confirmed bool) {
+		if !confirmed {
+			return
+		}
+
+		output, err := a.loginUC.Execute(context.Background(), user.LoginInput{
+			Login:    login.Text,
+			Password: password.Text,
+		})
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+
+		a.setActor(output.User)
+	}, a.window)
+
+	dlg.Resize(fyne.NewSize(450, 220))
+	dlg.Show()
+}
+
+// showRegisterAdminDialog показывает диалог создания первого администратора
+func (a *App) showRegisterAdminDialog() {
+	login := widget.NewEntry()
+	login.SetPlaceHolder("Например: admin")
+
+	fullName := widget.NewEntry()
+	fullName.SetPlaceHolder("Например: Иванов Иван Иванович")
+
+	password := widget.NewPasswordEntry()
+	password.SetPlaceHolder("Не менее 6 символов")
+
+	items := []*widget.FormItem{
+		{Text: "Логин *:", Widget: login},
+		{Text: "ФИО:", Widget: fullName},
+		{Text: "Пароль *:", Widget: password},
+	}
+
+	dlg := dialog.NewForm("Создание администратора", "Создать", "Отмена", items, func(confirmed bool) {
+		if !confirmed {
+			return
+		}
+
+		output, err := a.registerUC.Execute(a.ctx, user.RegisterUserInput{
+			Login:    login.Text,
+			Password: password.Text,
+			FullName: fullName.Text,
+			Role:     entity.RoleAdmin,
+		})
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+
+		a.setActor(output.User)
+	}, a.window)
+
+	dlg.Resize(fyne.NewSize(450, 250))
+	dlg.Show()
+}
+
+// setActor устанавливает пользователя текущей сессии
+func (a *App) setActor(u *entity.User) {
+	a.ctx = access.WithActor(context.Background(), u)
+	a.window.SetTitle(fmt.Sprintf("Технический паспорт недвижимости — %s (%s)", u.Login, roleTitles[u.Role]))
+}
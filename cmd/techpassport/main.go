@@ -3,27 +3,64 @@ package main
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/app"
+	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/dialog"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 
 	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/address"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/attachment"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/coordinates"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/document"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/dxf"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/floorplan"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/geometry"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/search"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/security"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/spreadsheet"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/file"
 	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/valuation"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
 	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/report"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/user"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/webhook"
 )
 
 // App главная структура приложения
 type App struct {
-	fyneApp  fyne.App
-	window   fyne.Window
-	repo     *memory.InMemoryPassportRepository
-	createUC *passport.CreatePassportUseCase
-	addBuildingUC *passport.AddBuildingUseCase
-	removeBuildingUC *passport.RemoveBuildingUseCase
+	fyneApp          fyne.App
+	window           fyne.Window
+	repo             *search.IndexedRepository
+	searchUC         *access.SearchPassportsUseCase
+	mergeUC          *access.MergePassportsUseCase
+	createUC         *access.CreatePassportUseCase
+	addBuildingUC    *access.AddBuildingUseCase
+	removeBuildingUC *access.RemoveBuildingUseCase
+	saveFloorPlanUC  *access.SaveFloorPlanUseCase
+
+	// Обмен таблицами паспорта с Excel
+	tables    service.SpreadsheetCodec
+	previewUC *passport.PreviewSpreadsheetUseCase
+
+	// Отрисовка поэтажных планов для просмотра
+	planRenderer service.FloorPlanRenderer
+
+	// Чтение чертежей поэтажных планов из CAD-программ
+	drawingParser service.DrawingParser
+
+	// Пользователи и сессия
+	loginUC    *user.LoginUseCase
+	registerUC *user.RegisterUserUseCase
+	ctx        context.Context // контекст сессии с текущим пользователем
 
 	// Текущий редактируемый паспорт
 	currentPassport *entity.TechnicalPassport
@@ -38,10 +75,75 @@ type App struct {
 	roomsList       *widget.List
 	rooms           []entity.Room
 	utilitiesFields *UtilitiesFields
+
+	// Сооружения и линейные объекты
+	setStructureUC    *access.SetStructureUseCase
+	removeStructureUC *access.RemoveStructureUseCase
+	structures        []entity.Structure
+	structuresSummary *widget.Label
+
+	// Поэтажные планы
+	floorPlansList *widget.List
+	floorPlans     []entity.FloorPlan
+	selectedPlan   int
+	planPreview    *canvas.Image
+
+	// Обмеры помещений
+	calculateAreaUC *passport.CalculateRoomAreaUseCase
+	selectedRoom    int
+
+	// Ситуационный план
+	saveSituationPlanUC *access.SaveSituationPlanUseCase
+	situationRenderer   service.SituationPlanRenderer
+	coordinateParser    service.CoordinateParser
+	situationPlan       *entity.SituationPlan
+	situationPreview    *canvas.Image
+	situationSummary    *widget.Label
+
+	// Вложения
+	addAttachmentUC     *access.AddAttachmentUseCase
+	attachmentInspector service.AttachmentInspector
+	attachmentsList     *widget.List
+	attachments         []pendingAttachment
+	selectedAttachment  int
+	attachmentPreview   *canvas.Image
+
+	// Инвентаризационная стоимость
+	valuer           service.InventoryValuer
+	calculateValueUC *passport.CalculateInventoryValueUseCase
+	selectedBuilding int
+
+	// Многоквартирный дом: подъезды, квартиры и общее имущество
+	setEntrancesUC   *access.SetEntrancesUseCase
+	setFlatUC        *access.SetFlatUseCase
+	setCommonAreasUC *access.SetCommonAreasUseCase
+	generator        service.DocumentGenerator
+	entrances        []entity.Entrance
+	flats            []entity.Flat
+	commonAreas      []entity.Room
+	flatsList        *widget.List
+	selectedFlat     int
+	apartmentSummary *widget.Label
+
+	// Нежилой объект: пожарная безопасность и категории использования помещений
+	setCommercialUC  *access.SetCommercialInfoUseCase
+	commercialFields *CommercialFields
+	categorySummary  *widget.Label
+
+	// Webhook организаций и журнал доставки уведомлений в каталоге паспортов
+	registerWebhookUC *access.RegisterWebhookUseCase
+	listWebhooksUC    *access.ListWebhooksUseCase
+	removeWebhookUC   *access.RemoveWebhookUseCase
+	listDeliveriesUC  *access.ListDeliveriesUseCase
+
+	// Отчет по реестру паспортов
+	reportUC       *report.RegistryReportUseCase
+	exportReportUC *report.ExportRegistryReportUseCase
 }
 
 // GeneralInfoFields поля общих сведений
 type GeneralInfoFields struct {
+	objectType        *widget.Select
 	orgName           *widget.Entry
 	purpose           *widget.Entry
 	usage             *widget.Entry
@@ -74,15 +176,57 @@ func main() {
 	myApp.Settings().SetTheme(theme.LightTheme())
 
 	app := &App{
-		fyneApp:   myApp,
-		repo:      memory.NewInMemoryPassportRepository(),
-		buildings: []entity.Building{},
-		owners:    []entity.Owner{},
-		rooms:     []entity.Room{},
+		fyneApp:      myApp,
+		repo:         search.NewIndexedRepository(memory.NewInMemoryPassportRepository()),
+		buildings:    []entity.Building{},
+		owners:       []entity.Owner{},
+		rooms:        []entity.Room{},
+		floorPlans:   []entity.FloorPlan{},
+		selectedPlan: -1,
 	}
-	app.createUC = passport.NewCreatePassportUseCase(app.repo)
-	app.addBuildingUC = passport.NewAddBuildingUseCase(app.repo)
-	app.removeBuildingUC = passport.NewRemoveBuildingUseCase(app.repo)
+	app.createUC = access.NewCreatePassportUseCase(passport.NewCreatePassportUseCase(app.repo, address.NewNormalizer()))
+	app.mergeUC = access.NewMergePassportsUseCase(passport.NewMergePassportsUseCase(app.repo))
+	app.searchUC = access.NewSearchPassportsUseCase(passport.NewSearchPassportsUseCase(app.repo))
+	app.addBuildingUC = access.NewAddBuildingUseCase(passport.NewAddBuildingUseCase(app.repo))
+	app.removeBuildingUC = access.NewRemoveBuildingUseCase(passport.NewRemoveBuildingUseCase(app.repo))
+	app.saveFloorPlanUC = access.NewSaveFloorPlanUseCase(passport.NewSaveFloorPlanUseCase(app.repo))
+	app.tables = spreadsheet.NewCodec()
+	app.planRenderer = floorplan.NewRenderer()
+	app.drawingParser = dxf.NewParser()
+	app.previewUC = passport.NewPreviewSpreadsheetUseCase(app.tables)
+	app.calculateAreaUC = passport.NewCalculateRoomAreaUseCase(geometry.NewCalculator())
+	app.selectedRoom = -1
+	app.saveSituationPlanUC = access.NewSaveSituationPlanUseCase(passport.NewSaveSituationPlanUseCase(app.repo))
+	app.situationRenderer = floorplan.NewRenderer()
+	app.coordinateParser = coordinates.NewParser()
+	app.attachmentInspector = attachment.NewInspector()
+	app.addAttachmentUC = access.NewAddAttachmentUseCase(passport.NewAddAttachmentUseCase(app.repo, memory.NewInMemoryAttachmentRepository(), app.attachmentInspector))
+	app.selectedAttachment = -1
+	app.valuer = valuation.NewEngine()
+	app.calculateValueUC = passport.NewCalculateInventoryValueUseCase(app.valuer)
+	app.selectedBuilding = -1
+	app.setEntrancesUC = access.NewSetEntrancesUseCase(passport.NewSetEntrancesUseCase(app.repo))
+	app.setFlatUC = access.NewSetFlatUseCase(passport.NewSetFlatUseCase(app.repo))
+	app.setCommonAreasUC = access.NewSetCommonAreasUseCase(passport.NewSetCommonAreasUseCase(app.repo))
+	app.generator = document.NewGenerator()
+	app.selectedFlat = -1
+	app.setCommercialUC = access.NewSetCommercialInfoUseCase(passport.NewSetCommercialInfoUseCase(app.repo))
+	app.setStructureUC = access.NewSetStructureUseCase(passport.NewSetStructureUseCase(app.repo))
+	app.removeStructureUC = access.NewRemoveStructureUseCase(passport.NewRemoveStructureUseCase(app.repo))
+	webhooks := file.NewJSONWebhookRepository(file.DefaultPassportsDir())
+	app.registerWebhookUC = access.NewRegisterWebhookUseCase(webhook.NewRegisterWebhookUseCase(webhooks))
+	app.listWebhooksUC = access.NewListWebhooksUseCase(webhook.NewListWebhooksUseCase(webhooks))
+	app.removeWebhookUC = access.NewRemoveWebhookUseCase(webhook.NewRemoveWebhookUseCase(webhooks))
+	app.listDeliveriesUC = access.NewListDeliveriesUseCase(webhook.NewListDeliveriesUseCase(webhooks))
+	app.reportUC = report.NewRegistryReportUseCase(app.repo)
+	app.exportReportUC = report.NewExportRegistryReportUseCase(app.repo, document.NewGenerator(), app.tables)
+
+	// Пользователи хранятся локально с хешированными паролями
+	userRepo := file.NewJSONUserRepository(file.DefaultUsersFile())
+	hasher := security.NewBcryptHasher()
+	app.loginUC = user.NewLoginUseCase(userRepo, hasher)
+	app.registerUC = user.NewRegisterUserUseCase(userRepo, hasher)
+	app.ctx = context.Background()
 	app.window = app.fyneApp.NewWindow("Технический паспорт недвижимости")
 
 	// Создаем новый пустой паспорт для редактирования
@@ -103,6 +247,10 @@ func main() {
 
 	app.window.SetContent(content)
 	app.window.Resize(fyne.NewSize(1000, 700))
+
+	// Вход в систему до начала работы
+	app.showLoginDialog()
+
 	app.window.ShowAndRun()
 }
 
@@ -134,12 +282,30 @@ func (a *App) createMenu() *fyne.MainMenu {
 		fyne.NewMenuItem("Экспорт в Word", func() {
 			dialog.ShowInformation("В разработке", "Функция экспорта будет реализована на следующем этапе", a.window)
 		}),
+		fyne.NewMenuItem("Экспорт таблиц в Excel...", func() {
+			a.exportTablesXLSX()
+		}),
+		fyne.NewMenuItem("Импорт из Excel...", func() {
+			a.showImportXLSXWizard()
+		}),
 		fyne.NewMenuItemSeparator(),
+		fyne.NewMenuItem("Сменить пользователя...", func() {
+			a.showLoginDialog()
+		}),
 		fyne.NewMenuItem("Выход", func() {
 			a.fyneApp.Quit()
 		}),
 	)
 
+	serviceMenu := fyne.NewMenu("Сервис",
+		fyne.NewMenuItem("Webhook и журнал доставки...", func() {
+			a.showWebhooksDialog()
+		}),
+		fyne.NewMenuItem("Отчет по реестру...", func() {
+			a.showReportDialog()
+		}),
+	)
+
 	helpMenu := fyne.NewMenu("Справка",
 		fyne.NewMenuItem("О программе", func() {
 			dialog.ShowInformation("О GoTechPasport",
@@ -148,7 +314,7 @@ func (a *App) createMenu() *fyne.MainMenu {
 		}),
 	)
 
-	return fyne.NewMainMenu(fileMenu, helpMenu)
+	return fyne.NewMainMenu(fileMenu, serviceMenu, helpMenu)
 }
 
 // createToolbar создает панель инструментов
@@ -181,13 +347,19 @@ func (a *App) createTabs() *container.AppTabs {
 		container.NewTabItem("Состав объекта", a.createBuildingsTab()),
 		container.NewTabItem("Правообладатели", a.createOwnersTab()),
 		container.NewTabItem("Экспликация", a.createRoomsTab()),
+		container.NewTabItem("Квартиры", a.createFlatsTab()),
+		container.NewTabItem("Нежилое", a.createCommercialTab()),
+		container.NewTabItem("Поэтажные планы", a.createFloorPlansTab()),
+		container.NewTabItem("Ситуационный план", a.createSituationPlanTab()),
 		container.NewTabItem("Благоустройство", a.createUtilitiesTab()),
+		container.NewTabItem("Вложения", a.createAttachmentsTab()),
 	)
 }
 
 // createGeneralInfoTab создает вкладку "Общие сведения"
 func (a *App) createGeneralInfoTab() fyne.CanvasObject {
 	a.generalFields = &GeneralInfoFields{
+		objectType:        newObjectTypeSelect(),
 		orgName:           widget.NewEntry(),
 		purpose:           widget.NewEntry(),
 		usage:             widget.NewEntry(),
@@ -206,9 +378,11 @@ func (a *App) createGeneralInfoTab() fyne.CanvasObject {
 	a.generalFields.livingArea.SetPlaceHolder("Например: 70.0")
 	a.generalFields.floors.SetPlaceHolder("Например: 2")
 	a.generalFields.undergroundFloors.SetPlaceHolder("Например: 0")
+	a.generalFields.objectType.OnChanged = func(string) { a.applyObjectProfile() }
 
 	form := &widget.Form{
 		Items: []*widget.FormItem{
+			{Text: "Тип объекта:", Widget: a.generalFields.objectType},
 			{Text: "Организация техучета:", Widget: a.generalFields.orgName},
 			{Text: "Назначение:", Widget: a.generalFields.purpose},
 			{Text: "Фактическое использование:", Widget: a.generalFields.usage},
@@ -254,6 +428,10 @@ func (a *App) createBuildingsTab() fyne.CanvasObject {
 		},
 	)
 
+	a.buildingsList.OnSelected = func(id widget.ListItemID) {
+		a.selectedBuilding = id
+	}
+
 	addBtn := widget.NewButton("Добавить здание", func() {
 		a.showAddBuildingDialog()
 	})
@@ -267,10 +445,18 @@ func (a *App) createBuildingsTab() fyne.CanvasObject {
 		dialog.ShowInformation("В разработке", "Выберите здание в списке для удаления", a.window)
 	})
 
+	elementsBtn := widget.NewButton("Элементы...", a.showStructuralElementsDialog)
+	valueBtn := widget.NewButton("Стоимость...", a.showValueBuildingDialog)
+	structureBtn := widget.NewButton("Сооружение...", a.showStructureDialog)
+	removeStructureBtn := widget.NewButton("Удалить сооружение", a.removeStructureDialog)
+
+	a.structuresSummary = widget.NewLabel("")
+	a.refreshStructures()
+
 	info := widget.NewLabel("Список зданий и сооружений в составе объекта")
-	buttonBox := container.NewHBox(addBtn, removeBtn)
+	buttonBox := container.NewHBox(addBtn, removeBtn, elementsBtn, valueBtn, structureBtn, removeStructureBtn)
 
-	return container.NewBorder(info, buttonBox, nil, nil, a.buildingsList)
+	return container.NewBorder(info, container.NewVBox(a.structuresSummary, buttonBox), nil, nil, a.buildingsList)
 }
 
 // showAddBuildingDialog показывает диалог добавления здания
@@ -344,8 +530,7 @@ func (a *App) showAddBuildingDialog() {
 						Building:   building,
 					}
 
-					ctx := context.Background()
-					output, err := a.addBuildingUC.Execute(ctx, input)
+					output, err := a.addBuildingUC.Execute(a.ctx, input)
 
 					if err != nil {
 						dialog.ShowError(err, a.window)
@@ -398,18 +583,27 @@ func (a *App) createRoomsTab() fyne.CanvasObject {
 		func() fyne.CanvasObject { return widget.NewLabel("") },
 		func(id widget.ListItemID, obj fyne.CanvasObject) {
 			room := a.rooms[id]
-			obj.(*widget.Label).SetText(fmt.Sprintf("Литера %s, эт. %s, пом. %s - %s (%.2f кв.м)",
-				room.Litera, room.Floor, room.RoomNumber, room.Purpose, room.Area))
+			text := fmt.Sprintf("Литера %s, эт. %s, пом. %s - %s (%.2f кв.м)",
+				room.Litera, room.Floor, room.RoomNumber, room.Purpose, room.Area)
+			if room.UseCategory != "" {
+				text += ", " + strings.ToLower(room.UseCategory.Title())
+			}
+			obj.(*widget.Label).SetText(text)
 		},
 	)
 
+	a.roomsList.OnSelected = func(id widget.ListItemID) {
+		a.selectedRoom = id
+	}
+
 	addBtn := widget.NewButton("Добавить помещение", func() {
 		dialog.ShowInformation("В разработке", "Форма добавления помещения будет реализована на следующем этапе", a.window)
 	})
+	measureBtn := widget.NewButton("Обмер...", a.showMeasureRoomDialog)
 
 	info := widget.NewLabel("Экспликация помещений (расшифровка площадей)")
 
-	return container.NewBorder(info, addBtn, nil, nil, a.roomsList)
+	return container.NewBorder(info, container.NewHBox(addBtn, measureBtn), nil, nil, a.roomsList)
 }
 
 // createUtilitiesTab создает вкладку "Благоустройство"
@@ -485,7 +679,7 @@ func (a *App) savePassport() {
 		GeneralInfo:      a.currentPassport.GeneralInfo,
 	}
 
-	ctx := context.Background()
+	ctx := a.ctx
 	output, err := a.createUC.Execute(ctx, input)
 
 	if err != nil {
@@ -502,71 +696,80 @@ func (a *App) savePassport() {
 			PassportID: a.currentPassport.ID,
 			Building:   building,
 		}
-		a.addBuildingUC.Execute(ctx, buildingInput)
+		if _, err := a.addBuildingUC.Execute(ctx, buildingInput); err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
 	}
 
-	msg := fmt.Sprintf("Технический паспорт успешно сохранен!\n\nID: %s\nАдрес: %s\nЗданий: %d",
-		output.Passport.ID,
-		output.Passport.Address.FullAddress(),
-		len(a.buildings))
-
-	dialog.ShowInformation("Успех", msg, a.window)
-}
-
-// openPassport открывает список паспортов
-func (a *App) openPassport() {
-	ctx := context.Background()
-	passports, err := a.repo.List(ctx)
+	// Сооружения и линейные объекты
+	if err := a.saveStructures(); err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
 
-	if err != nil {
+	// Подъезды, квартиры и общее имущество многоквартирного дома
+	if err := a.saveApartments(); err != nil {
 		dialog.ShowError(err, a.window)
 		return
 	}
 
-	if len(passports) == 0 {
-		dialog.ShowInformation("Информация", "Список паспортов пуст.\nСоздайте новый паспорт.", a.window)
+	// Пожарная безопасность нежилого объекта
+	if err := a.saveCommercial(); err != nil {
+		dialog.ShowError(err, a.window)
 		return
 	}
 
-	// Создаем список для выбора
-	var items []string
-	for _, p := range passports {
-		item := fmt.Sprintf("%s - %s", p.ID, p.Address.FullAddress())
-		items = append(items, item)
+	// Добавляем поэтажные планы
+	for _, plan := range a.floorPlans {
+		if _, err := a.saveFloorPlanUC.Execute(ctx, passport.SaveFloorPlanInput{PassportID: a.currentPassport.ID, Plan: plan}); err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
 	}
 
-	// Показываем список
-	list := widget.NewList(
-		func() int { return len(items) },
-		func() fyne.CanvasObject { return widget.NewLabel("") },
-		func(id widget.ListItemID, obj fyne.CanvasObject) {
-			obj.(*widget.Label).SetText(items[id])
-		},
-	)
+	// Ситуационный план пересчитывает площадь застройки добавленных зданий
+	if a.situationPlan != nil {
+		if _, err := a.saveSituationPlanUC.Execute(ctx, passport.SaveSituationPlanInput{PassportID: a.currentPassport.ID, Plan: *a.situationPlan}); err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+	}
 
-	list.OnSelected = func(id widget.ListItemID) {
-		// TODO: Загрузить паспорт в форму
-		dialog.ShowInformation("В разработке",
-			"Загрузка паспорта будет реализована на следующем этапе",
-			a.window)
+	// Вложения прикладываются после разделов, с которыми они связаны
+	if err := a.saveAttachments(); err != nil {
+		dialog.ShowError(err, a.window)
+		return
 	}
 
-	dialog.ShowCustom("Открыть паспорт", "Закрыть", list, a.window)
+	msg := fmt.Sprintf("Технический паспорт успешно сохранен!\n\nID: %s\nАдрес: %s\nЗданий: %d",
+		output.Passport.ID,
+		output.Passport.Address.FullAddress(),
+		len(a.buildings))
+
+	if len(output.Duplicates) > 0 {
+		// Сохраненный паспорт похож на ранее созданные: предлагаем объединить
+		a.showDuplicatesDialog(msg, output.Passport, output.Duplicates)
+		return
+	}
+
+	dialog.ShowInformation("Успех", msg, a.window)
 }
 
 // collectDataFromFields собирает данные из всех полей формы
 func (a *App) collectDataFromFields() error {
-	// Организация
+	// Тип объекта и организация
+	a.currentPassport.ObjectType = a.selectedObjectType()
 	a.currentPassport.OrganizationName = a.generalFields.orgName.Text
 
 	// Адрес из DaData формы
 	addressData := a.addressForm.GetAddressData()
 	a.currentPassport.Address = entity.Address{
-		Subject: addressData["subject"],
-		City:    addressData["city"],
-		Street:  addressData["street"],
-		House:   addressData["house"],
-		Building: addressData["building"],
+		Subject:   addressData["subject"],
+		City:      addressData["city"],
+		Street:    addressData["street"],
+		House:     addressData["house"],
+		Building:  addressData["building"],
 		Apartment: addressData["apartment"],
 	}
 
@@ -598,6 +801,7 @@ func (a *App) collectDataFromFields() error {
 // clearAllFields очищает все поля формы
 func (a *App) clearAllFields() {
 	// Общие сведения
+	a.generalFields.objectType.SetSelected(objectTypeOptions[0].title)
 	a.generalFields.orgName.SetText("")
 	a.generalFields.purpose.SetText("")
 	a.generalFields.usage.SetText("")
@@ -624,10 +828,29 @@ func (a *App) clearAllFields() {
 
 	// Очищаем списки
 	a.buildings = []entity.Building{}
+	a.structures = nil
 	a.owners = []entity.Owner{}
 	a.rooms = []entity.Room{}
+	a.floorPlans = []entity.FloorPlan{}
 
+	a.selectedBuilding = -1
+	a.buildingsList.UnselectAll()
 	a.buildingsList.Refresh()
+	a.refreshStructures()
 	a.ownersList.Refresh()
+	a.selectedRoom = -1
+	a.roomsList.UnselectAll()
 	a.roomsList.Refresh()
+	a.clearPlanSelection()
+	a.situationPlan = nil
+	a.refreshSituationPlan()
+	a.attachments = nil
+	a.clearAttachmentSelection()
+	a.entrances = nil
+	a.flats = nil
+	a.commonAreas = nil
+	a.selectedFlat = -1
+	a.flatsList.UnselectAll()
+	a.refreshFlats()
+	a.clearCommercialFields()
 }
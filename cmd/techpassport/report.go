@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/report"
+)
+
+// showReportDialog показывает отчет по реестру паспортов за месяц
+// и сохраняет его в Excel или PDF
+func (a *App) showReportDialog() {
+	month := widget.NewEntry()
+	month.SetText(time.Now().Format("2006-01"))
+	month.SetPlaceHolder("ГГГГ-ММ")
+
+	summary := widget.NewLabel("")
+	summary.Wrapping = fyne.TextWrapWord
+
+	var rows []service.ReportRow
+	rowsList := widget.NewList(
+		func() int { return len(rows) },
+		func() fyne.CanvasObject { return widget.NewLabel("") },
+		func(id widget.ListItemID, obj fyne.CanvasObject) {
+			obj.(*widget.Label).SetText(reportRowText(rows[id]))
+		},
+	)
+
+	// period период отчета из поля месяца
+	period := func() (report.RegistryReportInput, bool) {
+		t, err := time.ParseInLocation("2006-01", month.Text, time.Local)
+		if err != nil {
+			dialog.ShowError(fmt.Errorf("месяц должен быть в формате ГГГГ-ММ"), a.window)
+			return report.RegistryReportInput{}, false
+		}
+		from, to := report.MonthPeriod(t)
+		return report.RegistryReportInput{From: from, To: to}, true
+	}
+
+	load := func() bool {
+		input, ok := period()
+		if !ok {
+			return false
+		}
+		output, err := a.reportUC.Execute(a.ctx, input)
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return false
+		}
+
+		r := output.Report
+		summary.SetText(fmt.Sprintf("Отчет %s: %s", r.PeriodTitle(), reportRowText(r.Total)))
+		rows = nil
+		for _, group := range [][]service.ReportRow{r.ByObjectType, r.ByDistrict} {
+			rows = append(rows, group...)
+		}
+		rowsList.Refresh()
+		return true
+	}
+	if !load() {
+		return
+	}
+
+	save := func(format service.DocumentFormat) {
+		input, ok := period()
+		if !ok {
+			return
+		}
+		output, err := a.exportReportUC.Execute(a.ctx, report.ExportRegistryReportInput{RegistryReportInput: input, Format: format})
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+
+		dlg := dialog.NewFileSave(func(w fyne.URIWriteCloser, err error) {
+			if err != nil {
+				dialog.ShowError(err, a.window)
+				return
+			}
+			if w == nil {
+				return
+			}
+			defer w.Close()
+
+			if _, err := w.Write(output.Document); err != nil {
+				dialog.ShowError(err, a.window)
+				return
+			}
+			dialog.ShowInformation("Успех", "Отчет сохранен в файл\n"+w.URI().Name(), a.window)
+		}, a.window)
+		dlg.SetFileName(output.FileName)
+		dlg.SetFilter(storage.NewExtensionFileFilter([]string{"." + string(format)}))
+		dlg.Show()
+	}
+
+	top := container.NewVBox(
+		container.NewBorder(nil, nil, widget.NewLabel("Месяц:"), widget.NewButton("Сформировать", func() { load() }), month),
+		summary,
+		widget.NewLabel("По типам объектов и районам:"),
+	)
+	buttons := container.NewHBox(
+		widget.NewButton("Сохранить в Excel...", func() { save(service.FormatXLSX) }),
+		widget.NewButton("Сохранить в PDF...", func() { save(service.FormatPDF) }),
+	)
+
+	dlg := dialog.NewCustom("Отчет по реестру", "Закрыть", container.NewBorder(top, buttons, nil, nil, rowsList), a.window)
+	dlg.Resize(fyne.NewSize(800, 550))
+	dlg.Show()
+}
+
+// reportRowText строка отчета: составлено, выдано, площадь и стоимость
+func reportRowText(r service.ReportRow) string {
+	text := fmt.Sprintf("%s - составлено %d, выдано %d, площадь %.2f кв.м, стоимость %.2f руб", r.Title, r.Count, r.Issued, r.TotalArea, r.InventoryValue)
+	if r.AverageYear > 0 {
+		text += fmt.Sprintf(", средний год постройки %.0f", r.AverageYear)
+	}
+	return text
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// shapeKindNames названия видов фигур для отчета о расчете
+var shapeKindNames = map[entity.ShapeKind]string{
+	entity.ShapeRectangle:  "прямоугольник",
+	entity.ShapeTriangle:   "треугольник",
+	entity.ShapePolygon:    "многоугольник",
+	entity.ShapeSemicircle: "полукруг",
+}
+
+// shapeRoleNames названия ролей фигур для отчета о расчете
+var shapeRoleNames = map[entity.ShapeRole]string{
+	entity.ShapeRoleMain:      "основная часть",
+	entity.ShapeRoleBayWindow: "эркер",
+	entity.ShapeRoleNiche:     "ниша",
+	entity.ShapeRoleDeduction: "вычет",
+}
+
+// measurementsTemplate обмер по умолчанию: прямоугольная комната с колонной
+const measurementsTemplate = `{
+  "shapes": [
+    {"kind": "rectangle", "role": "main", "length": 5.20, "width": 3.40},
+    {"kind": "rectangle", "role": "deduction", "length": 0.40, "width": 0.40, "note": "колонна"}
+  ]
+}`
+
+// showMeasureRoomDialog открывает обмер выбранного помещения: фигуры задаются в JSON,
+// площадь рассчитывается по правилам БТИ и после подтверждения записывается в экспликацию
+func (a *App) showMeasureRoomDialog() {
+	if a.selectedRoom < 0 || a.selectedRoom >= len(a.rooms) {
+		dialog.ShowInformation("Обмер помещения", "Выберите помещение в списке", a.window)
+		return
+	}
+	if err := access.Authorize(a.ctx, entity.PermissionEditPassport); err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+
+	room := a.rooms[a.selectedRoom]
+	text := measurementsTemplate
+	if room.Measurements != nil {
+		data, err := json.MarshalIndent(room.Measurements, "", "  ")
+		if err == nil {
+			text = string(data)
+		}
+	}
+
+	entry := widget.NewMultiLineEntry()
+	entry.SetText(text)
+	entry.SetMinRowsVisible(12)
+
+	hint := widget.NewLabel("Фигуры: rectangle (length, width), triangle (sides), polygon (sides, diagonals),\n" +
+		"semicircle (diameter); роли: main, bay_window, niche (height), deduction")
+
+	title := fmt.Sprintf("Обмер помещения %s (литера %s, этаж %s)", room.RoomNumber, room.Litera, room.Floor)
+	form := dialog.NewCustomConfirm(title, "Рассчитать", "Отмена", widget.NewForm(
+		widget.NewFormItem("", hint),
+		widget.NewFormItem("Обмер", entry),
+	), func(ok bool) {
+		if !ok {
+			return
+		}
+		a.calculateRoomArea(a.selectedRoom, entry.Text)
+	}, a.window)
+	form.Resize(fyne.NewSize(700, 450))
+	form.Show()
+}
+
+// calculateRoomArea рассчитывает площадь и после подтверждения записывает ее в помещение
+func (a *App) calculateRoomArea(index int, text string) {
+	var measurements entity.RoomMeasurements
+	if err := json.Unmarshal([]byte(text), &measurements); err != nil {
+		dialog.ShowError(fmt.Errorf("некорректный JSON обмера: %w", err), a.window)
+		return
+	}
+
+	output, err := a.calculateAreaUC.Execute(a.ctx, passport.CalculateRoomAreaInput{Measurements: measurements})
+	if err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+
+	var details strings.Builder
+	for _, shape := range output.Calculation.Shapes {
+		sign := "+"
+		switch {
+		case !shape.Counted:
+			sign = " "
+		case shape.Role == entity.ShapeRoleDeduction:
+			sign = "-"
+		}
+		fmt.Fprintf(&details, "%s %d. %s (%s): %.2f кв.м\n", sign, shape.Index+1, shapeKindNames[shape.Kind], shapeRoleNames[shape.Role], shape.Area)
+	}
+	for _, w := range output.Calculation.Warnings {
+		details.WriteString(w + "\n")
+	}
+	fmt.Fprintf(&details, "\nПлощадь помещения: %.1f кв.м (в экспликации %.2f кв.м)\nЗаписать площадь по обмеру?",
+		output.Calculation.Area, a.rooms[index].Area)
+
+	dialog.ShowConfirm("Расчет площади", details.String(), func(ok bool) {
+		if !ok {
+			return
+		}
+		passport.ApplyRoomMeasurements(&a.rooms[index], measurements, output.Calculation.Area)
+		a.roomsList.Refresh()
+	}, a.window)
+}
@@ -0,0 +1,267 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// searchPageSize число паспортов на странице диалога "Открыть..."
+const searchPageSize = 50
+
+// facetAll пункт списка фасета без фильтра
+const facetAll = "Все"
+
+// statusTitles наименования статусов паспорта
+var statusTitles = map[entity.PassportStatus]string{
+	entity.PassportStatusDraft:    "Черновик",
+	entity.PassportStatusApproved: "Утвержден",
+	entity.PassportStatusArchived: "В архиве",
+}
+
+// sortOptions поля сортировки в порядке списка выбора
+var sortOptions = []struct {
+	field repository.SortField
+	title string
+}{
+	{repository.SortRelevance, "Релевантность"},
+	{repository.SortID, "ID"},
+	{repository.SortAddress, "Адрес"},
+	{repository.SortCreated, "Дата создания"},
+	{repository.SortUpdated, "Дата изменения"},
+	{repository.SortYear, "Год постройки"},
+	{repository.SortArea, "Общая площадь"},
+}
+
+// facetSelect список выбора значения фасета с числом паспортов
+type facetSelect struct {
+	widget *widget.Select
+	title  func(value string) string
+	values map[string]string // подпись -> значение
+	value  string            // выбранное значение; пусто - все
+}
+
+// newFacetSelect создает список фасета; onChanged вызывается при выборе пользователем
+func newFacetSelect(title func(value string) string, onChanged func()) *facetSelect {
+	f := &facetSelect{title: title}
+	f.widget = widget.NewSelect([]string{facetAll}, func(label string) {
+		if value, ok := f.values[label]; ok || label == facetAll {
+			if value != f.value {
+				f.value = value
+				onChanged()
+			}
+		}
+	})
+	f.widget.Selected = facetAll
+	return f
+}
+
+// update заменяет пункты списка счетчиками фасета, сохраняя выбранное значение
+func (f *facetSelect) update(counts []repository.FacetCount) {
+	f.values = map[string]string{}
+	options := []string{facetAll}
+	selected := facetAll
+	for _, c := range counts {
+		label := fmt.Sprintf("%s (%d)", f.title(c.Value), c.Count)
+		f.values[label] = c.Value
+		options = append(options, label)
+		if c.Value == f.value {
+			selected = label
+		}
+	}
+	f.widget.Options = options
+	f.widget.Selected = selected
+	f.widget.Refresh()
+}
+
+// objectTypeTitle наименование типа объекта
+func objectTypeTitle(value string) string {
+	for _, o := range objectTypeOptions {
+		if string(o.objectType) == value {
+			return o.title
+		}
+	}
+	return value
+}
+
+// statusTitle наименование статуса паспорта
+func statusTitle(value string) string {
+	if title, ok := statusTitles[entity.PassportStatus(value)]; ok {
+		return title
+	}
+	return value
+}
+
+// openPassport открывает поиск паспортов по адресу, правообладателям
+// и номерам с фильтрами по фасетам
+func (a *App) openPassport() {
+	var (
+		passports []*entity.TechnicalPassport
+		offset    int
+		total     int
+		runSearch func() bool
+	)
+
+	text := widget.NewEntry()
+	text.SetPlaceHolder("Адрес, правообладатель, кадастровый или инвентарный номер")
+	text.OnSubmitted = func(string) { offset = 0; runSearch() }
+
+	onFacet := func() { offset = 0; runSearch() }
+	objectTypes := newFacetSelect(objectTypeTitle, onFacet)
+	statuses := newFacetSelect(statusTitle, onFacet)
+	organizations := newFacetSelect(func(value string) string { return value }, onFacet)
+
+	yearFrom, yearTo := widget.NewEntry(), widget.NewEntry()
+	areaFrom, areaTo := widget.NewEntry(), widget.NewEntry()
+
+	var sortTitles []string
+	for _, o := range sortOptions {
+		sortTitles = append(sortTitles, o.title)
+	}
+	sortSelect := widget.NewSelect(sortTitles, func(string) { offset = 0; runSearch() })
+	sortSelect.Selected = sortOptions[0].title
+	descending := widget.NewCheck("по убыванию", func(bool) { offset = 0; runSearch() })
+
+	list := widget.NewList(
+		func() int { return len(passports) },
+		func() fyne.CanvasObject { return widget.NewLabel("") },
+		func(id widget.ListItemID, obj fyne.CanvasObject) {
+			p := passports[id]
+			obj.(*widget.Label).SetText(fmt.Sprintf("%s - %s (%s, %s)",
+				p.ID, p.Address.FullAddress(), objectTypeTitle(string(p.ObjectType)), statusTitle(string(p.Status))))
+		},
+	)
+	list.OnSelected = func(id widget.ListItemID) {
+		// TODO: Загрузить паспорт в форму
+		dialog.ShowInformation("В разработке",
+			"Загрузка паспорта будет реализована на следующем этапе",
+			a.window)
+	}
+
+	pageLabel := widget.NewLabel("")
+	prevBtn := widget.NewButton("< Назад", func() {
+		offset -= searchPageSize
+		if offset < 0 {
+			offset = 0
+		}
+		runSearch()
+	})
+	nextBtn := widget.NewButton("Вперед >", func() {
+		offset += searchPageSize
+		runSearch()
+	})
+
+	runSearch = func() bool {
+		query := repository.PassportQuery{
+			Text:       text.Text,
+			YearFrom:   parseIntEntry(yearFrom),
+			YearTo:     parseIntEntry(yearTo),
+			AreaFrom:   parseFloatEntry(areaFrom),
+			AreaTo:     parseFloatEntry(areaTo),
+			Descending: descending.Checked,
+			Offset:     offset,
+			Limit:      searchPageSize,
+		}
+		if objectTypes.value != "" {
+			query.ObjectTypes = []entity.ObjectType{entity.ObjectType(objectTypes.value)}
+		}
+		if statuses.value != "" {
+			query.Statuses = []entity.PassportStatus{entity.PassportStatus(statuses.value)}
+		}
+		if organizations.value != "" {
+			query.Organizations = []string{organizations.value}
+		}
+		for _, o := range sortOptions {
+			if o.title == sortSelect.Selected {
+				query.Sort = o.field
+			}
+		}
+
+		output, err := a.searchUC.Execute(a.ctx, passport.SearchPassportsInput{Query: query})
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return false
+		}
+
+		passports = output.Passports
+		total = output.Total
+		objectTypes.update(output.Facets.ObjectTypes)
+		statuses.update(output.Facets.Statuses)
+		organizations.update(output.Facets.Organizations)
+
+		list.UnselectAll()
+		list.Refresh()
+		if total == 0 {
+			pageLabel.SetText("Паспорта не найдены")
+		} else {
+			pageLabel.SetText(fmt.Sprintf("%d-%d из %d", offset+1, offset+len(passports), total))
+		}
+		prevBtn.Disable()
+		if offset > 0 {
+			prevBtn.Enable()
+		}
+		nextBtn.Disable()
+		if offset+len(passports) < total {
+			nextBtn.Enable()
+		}
+
+		yearFrom.SetPlaceHolder(rangePlaceholder(output.Facets.Years.Min, "%.0f"))
+		yearTo.SetPlaceHolder(rangePlaceholder(output.Facets.Years.Max, "%.0f"))
+		areaFrom.SetPlaceHolder(rangePlaceholder(output.Facets.Areas.Min, "%.2f"))
+		areaTo.SetPlaceHolder(rangePlaceholder(output.Facets.Areas.Max, "%.2f"))
+		return true
+	}
+
+	if !runSearch() {
+		return
+	}
+	if total == 0 {
+		dialog.ShowInformation("Информация", "Список паспортов пуст.\nСоздайте новый паспорт.", a.window)
+		return
+	}
+
+	searchBtn := widget.NewButton("Найти", func() { offset = 0; runSearch() })
+	filters := widget.NewForm(
+		widget.NewFormItem("Тип объекта:", objectTypes.widget),
+		widget.NewFormItem("Статус:", statuses.widget),
+		widget.NewFormItem("Организация:", organizations.widget),
+		widget.NewFormItem("Год постройки:", container.NewGridWithColumns(2, yearFrom, yearTo)),
+		widget.NewFormItem("Общая площадь, кв.м:", container.NewGridWithColumns(2, areaFrom, areaTo)),
+		widget.NewFormItem("Сортировка:", container.NewHBox(sortSelect, descending)),
+	)
+	top := container.NewVBox(container.NewBorder(nil, nil, nil, searchBtn, text), filters)
+	bottom := container.NewHBox(prevBtn, pageLabel, nextBtn)
+
+	dlg := dialog.NewCustom("Открыть паспорт", "Закрыть", container.NewBorder(top, bottom, nil, nil, list), a.window)
+	dlg.Resize(fyne.NewSize(900, 650))
+	dlg.Show()
+}
+
+// parseIntEntry целое число из поля ввода; 0 - поле пустое или некорректно
+func parseIntEntry(e *widget.Entry) int {
+	v, _ := strconv.Atoi(strings.TrimSpace(e.Text))
+	return v
+}
+
+// parseFloatEntry число из поля ввода с точкой или запятой; 0 - поле пустое или некорректно
+func parseFloatEntry(e *widget.Entry) float64 {
+	v, _ := strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(e.Text), ",", "."), 64)
+	return v
+}
+
+// rangePlaceholder подсказка поля диапазона по границе фасета
+func rangePlaceholder(value float64, format string) string {
+	if value == 0 {
+		return ""
+	}
+	return fmt.Sprintf(format, value)
+}
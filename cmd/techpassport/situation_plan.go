@@ -0,0 +1,227 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+)
+
+// coordinateSystemOptions варианты системы координат для нового плана
+var coordinateSystemOptions = map[string]entity.CoordinateSystem{
+	"МСК (метры)":          entity.CoordinateMSK,
+	"WGS 84 (шир., долг.)": entity.CoordinateWGS84,
+}
+
+// createSituationPlanTab создает вкладку "Ситуационный план": загрузка плана
+// из JSON или координат из CSV/DXF, просмотр и площади участка и застройки
+func (a *App) createSituationPlanTab() fyne.CanvasObject {
+	a.situationPreview = canvas.NewImageFromResource(nil)
+	a.situationPreview.FillMode = canvas.ImageFillContain
+	a.situationSummary = widget.NewLabel("Ситуационный план не задан")
+
+	loadBtn := widget.NewButton("Загрузить план (JSON)...", a.loadSituationPlan)
+	importBtn := widget.NewButton("Загрузить координаты (CSV, DXF)...", a.showImportPlotDialog)
+	removeBtn := widget.NewButton("Удалить план", func() {
+		a.situationPlan = nil
+		a.refreshSituationPlan()
+	})
+
+	info := widget.NewLabel("Граница участка и контуры зданий по литерам; площадь застройки зданий пересчитывается по контурам")
+	buttons := container.NewHBox(loadBtn, importBtn, removeBtn)
+
+	return container.NewBorder(info, container.NewVBox(a.situationSummary, buttons), nil, nil, a.situationPreview)
+}
+
+// loadSituationPlan загружает ситуационный план из JSON целиком
+func (a *App) loadSituationPlan() {
+	if err := access.Authorize(a.ctx, entity.PermissionEditPassport); err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+
+	open := dialog.NewFileOpen(func(r fyne.URIReadCloser, err error) {
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		if r == nil {
+			return
+		}
+		defer r.Close()
+
+		data, err := io.ReadAll(r)
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+
+		var plan entity.SituationPlan
+		if err := json.Unmarshal(data, &plan); err != nil {
+			dialog.ShowError(fmt.Errorf("некорректный JSON плана: %w", err), a.window)
+			return
+		}
+		a.setSituationPlan(plan)
+	}, a.window)
+	open.SetFilter(storage.NewExtensionFileFilter([]string{".json"}))
+	open.Show()
+}
+
+// showImportPlotDialog загружает границу участка или контур здания из каталога
+// координат; система координат выбирается только для нового плана
+func (a *App) showImportPlotDialog() {
+	if err := access.Authorize(a.ctx, entity.PermissionEditPassport); err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+
+	var literas []string
+	for _, b := range a.buildings {
+		literas = append(literas, b.Litera)
+	}
+	target := widget.NewSelect(append([]string{"Граница участка"}, literas...), nil)
+	target.SetSelectedIndex(0)
+
+	csSelect := widget.NewSelect([]string{"МСК (метры)", "WGS 84 (шир., долг.)"}, nil)
+	csSelect.SetSelectedIndex(0)
+	zone := widget.NewEntry()
+	zone.SetPlaceHolder("МСК-50, зона 2")
+	if a.situationPlan != nil {
+		csSelect.Disable()
+		zone.Disable()
+	}
+
+	list := widget.NewEntry()
+	list.SetText("0")
+
+	items := []*widget.FormItem{
+		widget.NewFormItem("Контур", target),
+		widget.NewFormItem("Система координат", csSelect),
+		widget.NewFormItem("Зона МСК", zone),
+		widget.NewFormItem("Номер полилинии в DXF", list),
+	}
+	dialog.ShowForm("Загрузить координаты", "Выбрать файл...", "Отмена", items, func(ok bool) {
+		if !ok {
+			return
+		}
+
+		var index int
+		if _, err := fmt.Sscanf(list.Text, "%d", &index); err != nil || index < 0 {
+			dialog.ShowError(fmt.Errorf("номер полилинии должен быть целым числом >= 0"), a.window)
+			return
+		}
+		litera := ""
+		if target.SelectedIndex() > 0 {
+			litera = target.Selected
+		}
+
+		a.importPlotFile(litera, index, coordinateSystemOptions[csSelect.Selected], zone.Text)
+	}, a.window)
+}
+
+// importPlotFile читает файл координат и заменяет выбранный контур плана
+func (a *App) importPlotFile(litera string, index int, cs entity.CoordinateSystem, zone string) {
+	open := dialog.NewFileOpen(func(r fyne.URIReadCloser, err error) {
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		if r == nil {
+			return
+		}
+		defer r.Close()
+
+		// Каталоги координат из геодезических программ часто сохраняются как .txt
+		format := service.CoordinateFormatCSV
+		if strings.EqualFold(filepath.Ext(r.URI().Name()), ".dxf") {
+			format = service.CoordinateFormatDXF
+		}
+		lists, err := a.coordinateParser.ParsePoints(a.ctx, r, format)
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		if index >= len(lists) {
+			dialog.ShowError(fmt.Errorf("в файле %d списков точек", len(lists)), a.window)
+			return
+		}
+		points := lists[index].Points
+
+		plan := entity.SituationPlan{CoordinateSystem: cs, Zone: zone}
+		if a.situationPlan != nil {
+			plan = *a.situationPlan
+			plan.Footprints = append([]entity.BuildingFootprint{}, plan.Footprints...)
+		}
+		if litera == "" {
+			plan.Boundary = points
+		} else if f := plan.Footprint(litera); f != nil {
+			f.Points = points
+		} else {
+			plan.Footprints = append(plan.Footprints, entity.BuildingFootprint{Litera: litera, Points: points})
+		}
+		a.setSituationPlan(plan)
+	}, a.window)
+	open.SetFilter(storage.NewExtensionFileFilter([]string{".csv", ".txt", ".dxf"}))
+	open.Show()
+}
+
+// setSituationPlan проверяет план, пересчитывает площадь застройки зданий
+// на вкладке "Состав объекта" и обновляет просмотр
+func (a *App) setSituationPlan(plan entity.SituationPlan) {
+	if err := plan.IsValid(); err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+
+	a.situationPlan = &plan
+	for i := range a.buildings {
+		if f := plan.Footprint(a.buildings[i].Litera); f != nil {
+			a.buildings[i].BuildArea = plan.FootprintArea(*f)
+		}
+	}
+	a.buildingsList.Refresh()
+	a.refreshSituationPlan()
+}
+
+// refreshSituationPlan отрисовывает план и выводит сводку площадей
+func (a *App) refreshSituationPlan() {
+	a.situationPreview.Resource = nil
+	defer a.situationPreview.Refresh()
+
+	plan := a.situationPlan
+	if plan == nil {
+		a.situationSummary.SetText("Ситуационный план не задан")
+		return
+	}
+
+	var summary bytes.Buffer
+	if len(plan.Boundary) > 0 {
+		fmt.Fprintf(&summary, "Площадь участка: %.0f кв.м", plan.PlotArea())
+	} else {
+		summary.WriteString("Граница участка не загружена")
+	}
+	for _, f := range plan.Footprints {
+		fmt.Fprintf(&summary, "; литера %s: %.1f кв.м", f.Litera, plan.FootprintArea(f))
+	}
+	a.situationSummary.SetText(summary.String())
+
+	data, err := a.situationRenderer.RenderSituationPlan(a.ctx, plan, service.PlanFormatPNG)
+	if err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+	a.situationPreview.Resource = fyne.NewStaticResource("situation.png", data)
+}
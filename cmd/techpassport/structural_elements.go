@@ -0,0 +1,169 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+)
+
+// conditionByWear пункт выбора состояния, определяемого по износу
+const conditionByWear = "по износу"
+
+// elementRow строка редактирования конструктивного элемента
+type elementRow struct {
+	kind        entity.StructuralElementKind
+	description *widget.Entry
+	condition   *widget.Select
+	wear        *widget.Entry
+}
+
+// showStructuralElementsDialog описание конструктивных элементов выбранного здания;
+// после подтверждения износ здания взвешивается по удельным весам справочника
+func (a *App) showStructuralElementsDialog() {
+	if a.selectedBuilding < 0 || a.selectedBuilding >= len(a.buildings) {
+		dialog.ShowInformation("Конструктивные элементы", "Выберите здание в списке", a.window)
+		return
+	}
+	if err := access.Authorize(a.ctx, entity.PermissionEditPassport); err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+
+	building := a.buildings[a.selectedBuilding]
+	existing := map[entity.StructuralElementKind]entity.StructuralElement{}
+	for _, e := range building.StructuralElements {
+		existing[e.Kind] = e
+	}
+
+	conditions := []string{conditionByWear}
+	for _, c := range entity.TechnicalConditions {
+		conditions = append(conditions, c.Title())
+	}
+
+	grid := container.NewGridWithColumns(4,
+		widget.NewLabelWithStyle("Элемент", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		widget.NewLabelWithStyle("Описание", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		widget.NewLabelWithStyle("Состояние", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		widget.NewLabelWithStyle("Износ, %", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+	)
+	var rows []elementRow
+	for _, kind := range entity.StructuralElementKinds {
+		row := elementRow{
+			kind:        kind,
+			description: widget.NewEntry(),
+			condition:   widget.NewSelect(conditions, nil),
+			wear:        widget.NewEntry(),
+		}
+		row.condition.SetSelected(conditionByWear)
+		row.wear.SetPlaceHolder("не оценен")
+		if e, ok := existing[kind]; ok {
+			row.description.SetText(e.Description)
+			row.wear.SetText(strconv.FormatFloat(e.Wear, 'f', -1, 64))
+			if e.Condition != "" {
+				row.condition.SetSelected(e.Condition.Title())
+			}
+		}
+		rows = append(rows, row)
+		grid.Add(widget.NewLabel(kind.Title()))
+		grid.Add(row.description)
+		grid.Add(row.condition)
+		grid.Add(row.wear)
+	}
+
+	title := fmt.Sprintf("Конструктивные элементы литеры %s", building.Litera)
+	d := dialog.NewCustomConfirm(title, "Рассчитать износ", "Отмена", container.NewVScroll(grid), func(ok bool) {
+		if !ok {
+			return
+		}
+		elements, err := elementsFromRows(rows)
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		a.assessBuildingWear(a.selectedBuilding, elements)
+	}, a.window)
+	d.Resize(fyne.NewSize(900, 550))
+	d.Show()
+}
+
+// elementsFromRows элементы с указанным износом; строки без износа не оценены
+func elementsFromRows(rows []elementRow) ([]entity.StructuralElement, error) {
+	elements := []entity.StructuralElement{}
+	for _, row := range rows {
+		text := strings.TrimSpace(row.wear.Text)
+		if text == "" {
+			if strings.TrimSpace(row.description.Text) != "" {
+				return nil, fmt.Errorf("%s: укажите износ", row.kind.Title())
+			}
+			continue
+		}
+		wear, err := strconv.ParseFloat(strings.ReplaceAll(text, ",", "."), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s: некорректный износ %s", row.kind.Title(), text)
+		}
+
+		e := entity.StructuralElement{Kind: row.kind, Description: strings.TrimSpace(row.description.Text), Wear: wear}
+		for _, c := range entity.TechnicalConditions {
+			if c.Title() == row.condition.Selected {
+				e.Condition = c
+			}
+		}
+		if err := e.IsValid(); err != nil {
+			return nil, err
+		}
+		elements = append(elements, e)
+	}
+	return elements, nil
+}
+
+// assessBuildingWear показывает взвешенный износ и после подтверждения записывает
+// элементы и износ в здание
+func (a *App) assessBuildingWear(index int, elements []entity.StructuralElement) {
+	building := a.buildings[index]
+	var assessment *entity.WearAssessment
+	var details strings.Builder
+
+	if len(elements) > 0 {
+		result, err := a.valuer.AssessWear(entity.ValuationInputs{
+			WallMaterial: building.WallMaterial,
+			ElementWear:  entity.ElementWear(elements),
+		})
+		var validationErr entity.ValidationError
+		switch {
+		case errors.As(err, &validationErr):
+			fmt.Fprintf(&details, "Износ здания не рассчитан: %s\n", validationErr.Message)
+		case err != nil:
+			dialog.ShowError(err, a.window)
+			return
+		default:
+			assessment = result
+			fmt.Fprintf(&details, "Справочник %s, группа %s\n", result.TableVersion, result.WallGroup)
+			for _, share := range result.Elements {
+				fmt.Fprintf(&details, "%s: %.0f%% x %.0f%%\n", share.Kind.Title(), share.Wear, share.Weight)
+			}
+			for _, w := range result.Warnings {
+				details.WriteString(w + "\n")
+			}
+			fmt.Fprintf(&details, "\nФизический износ здания: %.0f%%\n", result.Wear)
+		}
+	}
+	details.WriteString("Записать в здание?")
+
+	dialog.ShowConfirm("Износ здания", details.String(), func(ok bool) {
+		if !ok {
+			return
+		}
+		a.buildings[index].StructuralElements = elements
+		a.buildings[index].WearAssessment = assessment
+		a.buildingsList.Refresh()
+	}, a.window)
+}
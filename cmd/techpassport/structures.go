@@ -0,0 +1,233 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// showStructureDialog добавляет или заменяет сооружение; трасса линейного объекта
+// вводится построчно "X;Y" в координатах ситуационного плана
+func (a *App) showStructureDialog() {
+	litera := widget.NewEntry()
+	litera.SetPlaceHolder("Например: I")
+
+	var kinds []string
+	for _, k := range entity.StructureKinds {
+		kinds = append(kinds, k.Title())
+	}
+	kind := widget.NewSelect(kinds, nil)
+	name := widget.NewEntry()
+	name.SetPlaceHolder("Например: Забор")
+	year := widget.NewEntry()
+	material := widget.NewEntry()
+	material.SetPlaceHolder("Например: металлический профлист")
+
+	length, width, height := widget.NewEntry(), widget.NewEntry(), widget.NewEntry()
+	diameter, depth, capacity := widget.NewEntry(), widget.NewEntry(), widget.NewEntry()
+	wear := widget.NewEntry()
+	route := widget.NewMultiLineEntry()
+	route.SetPlaceHolder("X;Y - по точке в строке")
+	route.SetMinRowsVisible(4)
+
+	// Выбор литеры существующего сооружения заполняет форму для замены
+	litera.OnChanged = func(text string) {
+		for _, s := range a.structures {
+			if s.Litera != text {
+				continue
+			}
+			kind.SetSelected(s.Kind.Title())
+			name.SetText(s.Name)
+			year.SetText(strconv.Itoa(s.CommissionYear))
+			material.SetText(s.Material)
+			length.SetText(formatNumber(s.Length))
+			width.SetText(formatNumber(s.Width))
+			height.SetText(formatNumber(s.Height))
+			diameter.SetText(formatNumber(s.Diameter))
+			depth.SetText(formatNumber(s.Depth))
+			capacity.SetText(formatNumber(s.Capacity))
+			wear.SetText(formatNumber(s.Wear))
+			var lines []string
+			for _, p := range s.Route {
+				lines = append(lines, formatNumber(p.X)+";"+formatNumber(p.Y))
+			}
+			route.SetText(strings.Join(lines, "\n"))
+		}
+	}
+
+	form := []*widget.FormItem{
+		widget.NewFormItem("Литера *:", litera),
+		widget.NewFormItem("Вид *:", kind),
+		widget.NewFormItem("Наименование *:", name),
+		widget.NewFormItem("Год ввода *:", year),
+		widget.NewFormItem("Материал:", material),
+		widget.NewFormItem("Протяженность (м):", length),
+		widget.NewFormItem("Ширина (м):", width),
+		widget.NewFormItem("Высота (м):", height),
+		widget.NewFormItem("Диаметр (мм):", diameter),
+		widget.NewFormItem("Глубина (м):", depth),
+		widget.NewFormItem("Емкость (куб.м):", capacity),
+		widget.NewFormItem("Износ по осмотру (%):", wear),
+		widget.NewFormItem("Трасса:", route),
+	}
+
+	dlg := dialog.NewForm("Сооружение", "Сохранить", "Отмена", form, func(ok bool) {
+		if !ok {
+			return
+		}
+
+		structure := entity.Structure{Litera: strings.TrimSpace(litera.Text), Name: name.Text, Material: material.Text}
+		for _, k := range entity.StructureKinds {
+			if k.Title() == kind.Selected {
+				structure.Kind = k
+			}
+		}
+		fmt.Sscanf(year.Text, "%d", &structure.CommissionYear)
+
+		for _, field := range []struct {
+			entry *widget.Entry
+			value *float64
+			title string
+		}{
+			{length, &structure.Length, "протяженность"},
+			{width, &structure.Width, "ширина"},
+			{height, &structure.Height, "высота"},
+			{diameter, &structure.Diameter, "диаметр"},
+			{depth, &structure.Depth, "глубина"},
+			{capacity, &structure.Capacity, "емкость"},
+			{wear, &structure.Wear, "износ"},
+		} {
+			v, err := parseNumber(strings.TrimSpace(field.entry.Text))
+			if err != nil {
+				dialog.ShowError(fmt.Errorf("некорректное значение: %s", field.title), a.window)
+				return
+			}
+			*field.value = v
+		}
+
+		points, err := parseRoute(route.Text)
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		structure.Route = points
+
+		if err := a.setStructure(structure); err != nil {
+			dialog.ShowError(err, a.window)
+		}
+	}, a.window)
+	dlg.Resize(dlg.MinSize().AddWidthHeight(200, 0))
+	dlg.Show()
+}
+
+// parseRoute разбирает трассу: точка "X;Y" в строке
+func parseRoute(text string) ([]entity.GeoPoint, error) {
+	var points []entity.GeoPoint
+	for i, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		xText, yText, ok := strings.Cut(line, ";")
+		x, errX := parseNumber(strings.TrimSpace(xText))
+		y, errY := parseNumber(strings.TrimSpace(yText))
+		if !ok || errX != nil || errY != nil {
+			return nil, fmt.Errorf("трасса, строка %d: ожидается \"X;Y\"", i+1)
+		}
+		points = append(points, entity.GeoPoint{X: x, Y: y})
+	}
+	return points, nil
+}
+
+// setStructure проверяет сооружение на черновике паспорта, вычисляя протяженность
+// по трассе, и записывает его в сохраненный паспорт или в локальный список
+func (a *App) setStructure(structure entity.Structure) error {
+	if a.currentPassport.ID != "" {
+		output, err := a.setStructureUC.Execute(a.ctx, passport.SetStructureInput{PassportID: a.currentPassport.ID, Structure: structure})
+		if err != nil {
+			return err
+		}
+		a.currentPassport = output.Passport
+		a.structures = output.Passport.Structures
+		a.refreshStructures()
+		return nil
+	}
+
+	draft := entity.TechnicalPassport{Buildings: a.buildings, Structures: a.structures, SituationPlan: a.situationPlan}
+	if _, err := draft.SetStructure(structure); err != nil {
+		return err
+	}
+	a.structures = draft.Structures
+	a.refreshStructures()
+	return nil
+}
+
+// refreshStructures обновляет перечень сооружений на вкладке "Состав объекта"
+func (a *App) refreshStructures() {
+	if len(a.structures) == 0 {
+		a.structuresSummary.SetText("Сооружений нет")
+		return
+	}
+	lines := []string{"Сооружения:"}
+	for _, s := range a.structures {
+		line := fmt.Sprintf("Лит. %s - %s (%s)", s.Litera, s.Name, strings.ToLower(s.Kind.Title()))
+		if s.Length > 0 {
+			line += fmt.Sprintf(", %.2f м", s.Length)
+		}
+		lines = append(lines, line)
+	}
+	a.structuresSummary.SetText(strings.Join(lines, "\n"))
+}
+
+// saveStructures записывает сооружения в сохраненный паспорт
+func (a *App) saveStructures() error {
+	for _, s := range a.structures {
+		if _, err := a.setStructureUC.Execute(a.ctx, passport.SetStructureInput{PassportID: a.currentPassport.ID, Structure: s}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeStructureDialog удаляет сооружение по литере
+func (a *App) removeStructureDialog() {
+	if len(a.structures) == 0 {
+		dialog.ShowInformation("Сооружения", "Сооружений нет", a.window)
+		return
+	}
+	var literas []string
+	for _, s := range a.structures {
+		literas = append(literas, s.Litera)
+	}
+	litera := widget.NewSelect(literas, nil)
+
+	dialog.ShowCustomConfirm("Удалить сооружение", "Удалить", "Отмена", container.NewVBox(litera), func(ok bool) {
+		if !ok || litera.Selected == "" {
+			return
+		}
+		if a.currentPassport.ID != "" {
+			output, err := a.removeStructureUC.Execute(a.ctx, passport.RemoveStructureInput{PassportID: a.currentPassport.ID, Litera: litera.Selected})
+			if err != nil {
+				dialog.ShowError(err, a.window)
+				return
+			}
+			a.currentPassport = output.Passport
+			a.structures = output.Passport.Structures
+		} else {
+			draft := entity.TechnicalPassport{Structures: a.structures}
+			if err := draft.RemoveStructure(litera.Selected); err != nil {
+				dialog.ShowError(err, a.window)
+				return
+			}
+			a.structures = draft.Structures
+		}
+		a.refreshStructures()
+	}, a.window)
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/webhook"
+)
+
+// showWebhooksDialog показывает webhook организаций каталога паспортов
+// и журнал доставки уведомлений выбранного webhook
+func (a *App) showWebhooksDialog() {
+	var (
+		webhooks   []*entity.Webhook
+		deliveries []entity.WebhookDelivery
+		selected   = -1
+	)
+
+	deliveriesList := widget.NewList(
+		func() int { return len(deliveries) },
+		func() fyne.CanvasObject { return widget.NewLabel("") },
+		func(id widget.ListItemID, obj fyne.CanvasObject) {
+			obj.(*widget.Label).SetText(deliveryText(deliveries[id]))
+		},
+	)
+
+	loadDeliveries := func() {
+		deliveries = nil
+		if selected >= 0 {
+			output, err := a.listDeliveriesUC.Execute(a.ctx, webhook.ListDeliveriesInput{WebhookID: webhooks[selected].ID})
+			if err != nil {
+				dialog.ShowError(err, a.window)
+				return
+			}
+			deliveries = output.Deliveries
+		}
+		deliveriesList.Refresh()
+	}
+
+	webhooksList := widget.NewList(
+		func() int { return len(webhooks) },
+		func() fyne.CanvasObject { return widget.NewLabel("") },
+		func(id widget.ListItemID, obj fyne.CanvasObject) {
+			w := webhooks[id]
+			actions := strings.Join(w.Actions, ", ")
+			if actions == "" {
+				actions = strings.Join(entity.DefaultWebhookActions, ", ")
+			}
+			obj.(*widget.Label).SetText(fmt.Sprintf("%s: %s (%s)", w.Organization, w.URL, actions))
+		},
+	)
+	webhooksList.OnSelected = func(id widget.ListItemID) {
+		selected = id
+		loadDeliveries()
+	}
+
+	loadWebhooks := func() bool {
+		output, err := a.listWebhooksUC.Execute(a.ctx, webhook.ListWebhooksInput{})
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return false
+		}
+		webhooks = output.Webhooks
+		selected = -1
+		webhooksList.UnselectAll()
+		webhooksList.Refresh()
+		loadDeliveries()
+		return true
+	}
+	if !loadWebhooks() {
+		return
+	}
+
+	addBtn := widget.NewButton("Добавить...", func() {
+		a.showAddWebhookDialog(func() { loadWebhooks() })
+	})
+	removeBtn := widget.NewButton("Удалить", func() {
+		if selected < 0 {
+			return
+		}
+		w := webhooks[selected]
+		dialog.ShowConfirm("Удалить webhook", "Удалить webhook "+w.URL+"? Журнал доставки сохранится.", func(ok bool) {
+			if !ok {
+				return
+			}
+			if _, err := a.removeWebhookUC.Execute(a.ctx, webhook.RemoveWebhookInput{WebhookID: w.ID}); err != nil {
+				dialog.ShowError(err, a.window)
+				return
+			}
+			loadWebhooks()
+		}, a.window)
+	})
+	refreshBtn := widget.NewButton("Обновить журнал", loadDeliveries)
+
+	info := widget.NewLabel("Уведомления доставляет techpassport-server или techpassport-cli relay-outbox")
+	top := container.NewBorder(info, container.NewHBox(addBtn, removeBtn, refreshBtn), nil, nil, webhooksList)
+	split := container.NewVSplit(top, container.NewBorder(widget.NewLabel("Журнал доставки (новые первыми):"), nil, nil, nil, deliveriesList))
+	split.Offset = 0.4
+
+	dlg := dialog.NewCustom("Webhook организаций", "Закрыть", split, a.window)
+	dlg.Resize(fyne.NewSize(900, 600))
+	dlg.Show()
+}
+
+// showAddWebhookDialog регистрирует webhook и показывает ключ подписи
+func (a *App) showAddWebhookDialog(onAdded func()) {
+	org := widget.NewEntry()
+	org.SetText(a.generalFields.orgName.Text)
+	url := widget.NewEntry()
+	url.SetPlaceHolder("https://example.com/techpassport")
+	secret := widget.NewPasswordEntry()
+	secret.SetPlaceHolder("сгенерировать")
+
+	var checks []*widget.Check
+	actions := container.NewGridWithColumns(2)
+	for _, action := range entity.WebhookActions {
+		check := widget.NewCheck(action, nil)
+		for _, d := range entity.DefaultWebhookActions {
+			if d == action {
+				check.SetChecked(true)
+			}
+		}
+		checks = append(checks, check)
+		actions.Add(check)
+	}
+
+	items := []*widget.FormItem{
+		widget.NewFormItem("Организация *:", org),
+		widget.NewFormItem("Адрес *:", url),
+		widget.NewFormItem("Ключ подписи:", secret),
+		widget.NewFormItem("Действия:", actions),
+	}
+	dialog.ShowForm("Новый webhook", "Зарегистрировать", "Отмена", items, func(ok bool) {
+		if !ok {
+			return
+		}
+
+		input := webhook.RegisterWebhookInput{Organization: org.Text, URL: url.Text, Secret: secret.Text}
+		for _, check := range checks {
+			if check.Checked {
+				input.Actions = append(input.Actions, check.Text)
+			}
+		}
+		output, err := a.registerWebhookUC.Execute(a.ctx, input)
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		onAdded()
+
+		key := widget.NewEntry()
+		key.SetText(output.Webhook.Secret)
+		dialog.ShowCustom("Webhook зарегистрирован", "OK", container.NewVBox(
+			widget.NewLabel("Передайте получателю ключ подписи заголовка X-Webhook-Signature:"),
+			key,
+		), a.window)
+	}, a.window)
+}
+
+// deliveryText строка журнала доставки
+func deliveryText(d entity.WebhookDelivery) string {
+	text := fmt.Sprintf("%s  %s  %s  попытка %d: %s", d.Timestamp.Format("02.01.2006 15:04:05"), d.PassportID, d.Action, d.Attempt, d.Status)
+	if d.StatusCode != 0 {
+		text += fmt.Sprintf(" (HTTP %d)", d.StatusCode)
+	}
+	if d.Error != "" {
+		text += " - " + d.Error
+	}
+	return text
+}
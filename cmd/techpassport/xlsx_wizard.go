@@ -0,0 +1,387 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// tableTitles названия таблиц паспорта для мастера импорта
+var tableTitles = []struct {
+	kind  passport.TableKind
+	title string
+}{
+	{passport.TableExplication, "Экспликация помещений"},
+	{passport.TableBuildings, "Состав объекта (здания)"},
+}
+
+// noColumn вариант выбора "столбец не сопоставлен"
+const noColumn = "—"
+
+// maxShownErrors количество ошибок строк, которые показываются в мастере
+const maxShownErrors = 15
+
+// exportTablesXLSX выгружает экспликацию и состав объекта текущего паспорта в XLSX
+func (a *App) exportTablesXLSX() {
+	if err := access.Authorize(a.ctx, entity.PermissionViewPassport); err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+
+	if err := a.collectDataFromFields(); err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+	current := *a.currentPassport
+	current.Buildings = a.buildings
+	current.Explication = a.rooms
+
+	data, err := a.tables.ExportTables(a.ctx, &current)
+	if err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+
+	save := dialog.NewFileSave(func(w fyne.URIWriteCloser, err error) {
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		if w == nil {
+			return
+		}
+		defer w.Close()
+
+		if _, err := w.Write(data); err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		dialog.ShowInformation("Успех", "Таблицы выгружены в файл\n"+w.URI().Name(), a.window)
+	}, a.window)
+	save.SetFileName("Экспликация.xlsx")
+	save.SetFilter(storage.NewExtensionFileFilter([]string{".xlsx"}))
+	save.Show()
+}
+
+// showImportXLSXWizard запускает мастер загрузки таблицы из XLSX:
+// выбор файла, листа и строки заголовка, сопоставление столбцов, проверка строк
+func (a *App) showImportXLSXWizard() {
+	if err := access.Authorize(a.ctx, entity.PermissionEditPassport); err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+
+	open := dialog.NewFileOpen(func(r fyne.URIReadCloser, err error) {
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		if r == nil {
+			return
+		}
+		defer r.Close()
+
+		data, err := io.ReadAll(r)
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+
+		w := &xlsxWizard{a: a, data: data}
+		if err := w.load(passport.TableExplication); err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		w.show()
+	}, a.window)
+	open.SetFilter(storage.NewExtensionFileFilter([]string{".xlsx"}))
+	open.Show()
+}
+
+// xlsxWizard состояние мастера импорта из XLSX
+type xlsxWizard struct {
+	a    *App
+	data []byte
+
+	kind    passport.TableKind
+	preview *passport.PreviewSpreadsheetOutput
+	sheet   int
+	header  int // номер строки заголовка с 1
+
+	sheetSelect *widget.Select
+	headerEntry *widget.Entry
+	fieldsForm  *widget.Form
+	selects     map[string]*widget.Select
+	appendCheck *widget.Check
+	result      *widget.Label
+}
+
+// load читает книгу для выбранной таблицы и выбирает первый лист с заголовком
+func (w *xlsxWizard) load(kind passport.TableKind) error {
+	preview, err := w.a.previewUC.Execute(w.a.ctx, passport.PreviewSpreadsheetInput{Data: w.data, Table: kind})
+	if err != nil {
+		return err
+	}
+	if len(preview.Sheets) == 0 {
+		return fmt.Errorf("книга не содержит листов")
+	}
+
+	w.kind = kind
+	w.preview = preview
+	w.sheet = 0
+	for i, s := range preview.Sheets {
+		if s.HeaderRow > 0 {
+			w.sheet = i
+			break
+		}
+	}
+	w.header = preview.Sheets[w.sheet].HeaderRow
+	return nil
+}
+
+// show открывает окно мастера
+func (w *xlsxWizard) show() {
+	tableOptions := make([]string, 0, len(tableTitles))
+	for _, t := range tableTitles {
+		tableOptions = append(tableOptions, t.title)
+	}
+	tableSelect := widget.NewSelect(tableOptions, nil)
+	tableSelect.SetSelectedIndex(0)
+
+	w.sheetSelect = widget.NewSelect(w.sheetNames(), nil)
+	w.sheetSelect.SetSelectedIndex(w.sheet)
+
+	w.headerEntry = widget.NewEntry()
+	w.headerEntry.SetText(strconv.Itoa(w.header))
+
+	w.fieldsForm = widget.NewForm()
+	w.appendCheck = widget.NewCheck("Добавить к существующим строкам (иначе заменить)", nil)
+	w.result = widget.NewLabel("")
+	w.result.Wrapping = fyne.TextWrapWord
+	w.rebuildFields()
+
+	tableSelect.OnChanged = func(string) {
+		kind := tableTitles[tableSelect.SelectedIndex()].kind
+		if err := w.load(kind); err != nil {
+			dialog.ShowError(err, w.a.window)
+			return
+		}
+		w.sheetSelect.SetSelectedIndex(w.sheet)
+		w.headerEntry.SetText(strconv.Itoa(w.header))
+		w.rebuildFields()
+	}
+	w.sheetSelect.OnChanged = func(string) {
+		w.sheet = w.sheetSelect.SelectedIndex()
+		w.header = w.preview.Sheets[w.sheet].HeaderRow
+		w.headerEntry.SetText(strconv.Itoa(w.header))
+		w.rebuildFields()
+	}
+	w.headerEntry.OnChanged = func(text string) {
+		n, err := strconv.Atoi(strings.TrimSpace(text))
+		if err != nil || n == w.header {
+			return
+		}
+		w.header = n
+		w.rebuildFields()
+	}
+
+	checkBtn := widget.NewButton("Проверить строки", func() {
+		w.check()
+	})
+
+	settings := widget.NewForm(
+		widget.NewFormItem("Таблица паспорта:", tableSelect),
+		widget.NewFormItem("Лист:", w.sheetSelect),
+		widget.NewFormItem("Строка заголовка:", w.headerEntry),
+	)
+
+	content := container.NewBorder(
+		container.NewVBox(settings, widget.NewLabel("Сопоставление столбцов:")),
+		container.NewVBox(w.appendCheck, checkBtn, w.result),
+		nil, nil,
+		container.NewVScroll(w.fieldsForm),
+	)
+
+	dlg := dialog.NewCustomConfirm("Импорт из Excel", "Загрузить", "Отмена", content, func(confirmed bool) {
+		if confirmed {
+			w.apply()
+		}
+	}, w.a.window)
+	dlg.Resize(fyne.NewSize(700, 600))
+	dlg.Show()
+}
+
+// sheetNames имена листов книги
+func (w *xlsxWizard) sheetNames() []string {
+	names := make([]string, 0, len(w.preview.Sheets))
+	for _, s := range w.preview.Sheets {
+		names = append(names, s.Name)
+	}
+	return names
+}
+
+// columnOptions варианты столбцов по строке заголовка: "A: Литера"
+func (w *xlsxWizard) columnOptions() []string {
+	options := []string{noColumn}
+
+	rows := w.preview.Sheets[w.sheet].Rows
+	width := 0
+	for _, row := range rows {
+		if len(row) > width {
+			width = len(row)
+		}
+	}
+
+	var header []string
+	if w.header > 0 && w.header <= len(rows) {
+		header = rows[w.header-1]
+	}
+	for col := 0; col < width; col++ {
+		option := columnLetter(col)
+		if col < len(header) && strings.TrimSpace(header[col]) != "" {
+			option += ": " + strings.TrimSpace(header[col])
+		}
+		options = append(options, option)
+	}
+	return options
+}
+
+// rebuildFields пересоздает выбор столбцов с предложенным по заголовку сопоставлением
+func (w *xlsxWizard) rebuildFields() {
+	rows := w.preview.Sheets[w.sheet].Rows
+	suggested := passport.ColumnMapping{}
+	if w.header > 0 && w.header <= len(rows) {
+		suggested = passport.SuggestMapping(w.kind, rows[w.header-1])
+	}
+
+	options := w.columnOptions()
+	w.selects = map[string]*widget.Select{}
+	w.fieldsForm.Items = nil
+	for _, field := range passport.TableFields(w.kind) {
+		sel := widget.NewSelect(options, nil)
+		if col, ok := suggested[field.Key]; ok {
+			sel.SetSelectedIndex(col + 1)
+		} else {
+			sel.SetSelectedIndex(0)
+		}
+		w.selects[field.Key] = sel
+
+		title := field.Title + ":"
+		if field.Required {
+			title = field.Title + " *:"
+		}
+		w.fieldsForm.AppendItem(widget.NewFormItem(title, sel))
+	}
+	w.fieldsForm.Refresh()
+	w.result.SetText("")
+}
+
+// mapping собирает сопоставление полей, выбранное пользователем
+func (w *xlsxWizard) mapping() passport.ColumnMapping {
+	mapping := passport.ColumnMapping{}
+	for key, sel := range w.selects {
+		if i := sel.SelectedIndex(); i > 0 {
+			mapping[key] = i - 1
+		}
+	}
+	return mapping
+}
+
+// parse разбирает строки листа по текущим настройкам мастера
+func (w *xlsxWizard) parse() (*passport.ParsedTable, error) {
+	rows := w.preview.Sheets[w.sheet].Rows
+	if w.header <= 0 || w.header > len(rows) {
+		return nil, entity.ValidationError{Field: "header_row", Message: "укажите строку заголовка таблицы"}
+	}
+	return passport.ParseTable(w.kind, rows, w.header-1, w.mapping())
+}
+
+// check показывает количество корректных строк и ошибки строк
+func (w *xlsxWizard) check() {
+	parsed, err := w.parse()
+	if err != nil {
+		w.result.SetText(err.Error())
+		return
+	}
+	w.result.SetText(w.summary(parsed))
+}
+
+// summary описывает результат разбора строк
+func (w *xlsxWizard) summary(parsed *passport.ParsedTable) string {
+	valid := len(parsed.Rooms) + len(parsed.Buildings)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Корректных строк: %d, строк с ошибками: %d", valid, len(parsed.Errors))
+	for i, e := range parsed.Errors {
+		if i == maxShownErrors {
+			fmt.Fprintf(&b, "\n... и еще %d", len(parsed.Errors)-maxShownErrors)
+			break
+		}
+		b.WriteString("\n" + e.Error())
+	}
+	return b.String()
+}
+
+// apply переносит строки в паспорт; при ошибках строк предлагает
+// загрузить только корректные строки
+func (w *xlsxWizard) apply() {
+	parsed, err := w.parse()
+	if err != nil {
+		dialog.ShowError(err, w.a.window)
+		return
+	}
+
+	if len(parsed.Errors) == 0 {
+		w.store(parsed)
+		return
+	}
+
+	dialog.ShowConfirm("Ошибки в строках",
+		w.summary(parsed)+"\n\nЗагрузить только корректные строки?",
+		func(confirmed bool) {
+			if confirmed {
+				w.store(parsed)
+			}
+		}, w.a.window)
+}
+
+// store заменяет или дополняет таблицу редактируемого паспорта
+func (w *xlsxWizard) store(parsed *passport.ParsedTable) {
+	a := w.a
+	if w.kind == passport.TableBuildings {
+		if !w.appendCheck.Checked {
+			a.buildings = nil
+		}
+		a.buildings = append(a.buildings, parsed.Buildings...)
+		a.buildingsList.Refresh()
+	} else {
+		if !w.appendCheck.Checked {
+			a.rooms = nil
+		}
+		a.rooms = append(a.rooms, parsed.Rooms...)
+		a.roomsList.Refresh()
+	}
+
+	dialog.ShowInformation("Успех",
+		fmt.Sprintf("Загружено строк: %d", len(parsed.Rooms)+len(parsed.Buildings)), a.window)
+}
+
+// columnLetter обозначение столбца по номеру с нуля (A, B, ..., AA)
+func columnLetter(col int) string {
+	name := ""
+	for col++; col > 0; col = (col - 1) / 26 {
+		name = string(rune('A'+(col-1)%26)) + name
+	}
+	return name
+}
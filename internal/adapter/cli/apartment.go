@@ -0,0 +1,206 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// flatReport результат сохранения квартиры с итогами дома
+type flatReport struct {
+	PassportID string                 `json:"passport_id"`
+	Number     string                 `json:"number"`
+	Replaced   bool                   `json:"replaced,omitempty"`
+	TotalArea  float64                `json:"total_area"`
+	LivingArea float64                `json:"living_area"`
+	Totals     entity.ApartmentTotals `json:"totals"`
+}
+
+// apartmentTotalsReport сводные показатели многоквартирного дома
+type apartmentTotalsReport struct {
+	PassportID string `json:"passport_id"`
+	entity.ApartmentTotals
+}
+
+// flatExtractResult результат выгрузки выписки на квартиру
+type flatExtractResult struct {
+	Flat string `json:"flat"`
+	File string `json:"file"`
+}
+
+// runSetEntrances заменяет список подъездов многоквартирного дома:
+// techpassport-cli set-entrances -id ID [-in entrances.json]
+func (a *App) runSetEntrances(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("set-entrances")
+	id := fs.String("id", "", "ID паспорта")
+	in := fs.String("in", "-", "JSON-массив подъездов (- для stdin)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+
+	entrances := []entity.Entrance{}
+	if err := a.readJSON(*in, &entrances); err != nil {
+		return err
+	}
+
+	output, err := a.setEntrancesUC.Execute(ctx, passport.SetEntrancesInput{PassportID: *id, Entrances: entrances})
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(apartmentTotalsReport{PassportID: output.Passport.ID, ApartmentTotals: output.Passport.ApartmentTotals()})
+}
+
+// runSetFlat добавляет или заменяет квартиру с экспликацией из JSON:
+// techpassport-cli set-flat -id ID [-in flat.json]
+func (a *App) runSetFlat(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("set-flat")
+	id := fs.String("id", "", "ID паспорта")
+	in := fs.String("in", "-", "JSON квартиры (- для stdin)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+
+	var flat entity.Flat
+	if err := a.readJSON(*in, &flat); err != nil {
+		return err
+	}
+
+	output, err := a.setFlatUC.Execute(ctx, passport.SetFlatInput{PassportID: *id, Flat: flat})
+	if err != nil {
+		return err
+	}
+
+	saved := output.Passport.FindFlat(flat.Number)
+	return a.writeJSON(flatReport{
+		PassportID: output.Passport.ID,
+		Number:     saved.Number,
+		Replaced:   output.Replaced,
+		TotalArea:  saved.TotalArea(),
+		LivingArea: saved.LivingArea(),
+		Totals:     output.Passport.ApartmentTotals(),
+	})
+}
+
+// runRemoveFlat удаляет квартиру: techpassport-cli remove-flat -id ID -flat 12
+func (a *App) runRemoveFlat(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("remove-flat")
+	id := fs.String("id", "", "ID паспорта")
+	number := fs.String("flat", "", "номер квартиры")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+	if err := requireFlag("flat", *number); err != nil {
+		return err
+	}
+
+	_, err := a.removeFlatUC.Execute(ctx, passport.RemoveFlatInput{PassportID: *id, Number: *number})
+	return err
+}
+
+// runSetCommonAreas заменяет экспликацию помещений общего имущества:
+// techpassport-cli set-common-areas -id ID [-in rooms.json]
+func (a *App) runSetCommonAreas(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("set-common-areas")
+	id := fs.String("id", "", "ID паспорта")
+	in := fs.String("in", "-", "JSON-массив помещений общего имущества (- для stdin)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+
+	rooms := []entity.Room{}
+	if err := a.readJSON(*in, &rooms); err != nil {
+		return err
+	}
+
+	output, err := a.setCommonAreasUC.Execute(ctx, passport.SetCommonAreasInput{PassportID: *id, Rooms: rooms})
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(apartmentTotalsReport{PassportID: output.Passport.ID, ApartmentTotals: output.Passport.ApartmentTotals()})
+}
+
+// runApartmentTotals выводит площади квартир и общего имущества:
+// techpassport-cli apartment-totals -id ID
+func (a *App) runApartmentTotals(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("apartment-totals")
+	id := fs.String("id", "", "ID паспорта")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+
+	output, err := a.apartmentTotalsUC.Execute(ctx, passport.ApartmentTotalsInput{PassportID: *id})
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(apartmentTotalsReport{PassportID: output.Passport.ID, ApartmentTotals: output.Totals})
+}
+
+// runExportFlats выгружает выписки из паспорта на квартиры:
+// techpassport-cli export-flats -id ID [-flat 12 ...] -format pdf|docx [-out DIR]
+func (a *App) runExportFlats(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("export-flats")
+	id := fs.String("id", "", "ID паспорта")
+	var flats idList
+	fs.Var(&flats, "flat", "номер квартиры (можно указать несколько раз; по умолчанию все)")
+	format := fs.String("format", string(service.FormatPDF), "формат документа: pdf или docx")
+	out := fs.String("out", ".", "каталог для документов")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+
+	docFormat := service.DocumentFormat(strings.ToLower(*format))
+	if docFormat != service.FormatPDF && docFormat != service.FormatDOCX {
+		return usageError{message: "флаг -format принимает значения pdf или docx"}
+	}
+
+	output, err := a.exportFlatsUC.Execute(ctx, passport.ExportFlatExtractsInput{
+		PassportID: *id,
+		Format:     docFormat,
+		Flats:      flats,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	results := make([]flatExtractResult, 0, len(output.Extracts))
+	for _, extract := range output.Extracts {
+		path := filepath.Join(*out, extract.FileName)
+		if err := os.WriteFile(path, extract.Document, 0o644); err != nil {
+			return fmt.Errorf("failed to write extract: %w", err)
+		}
+		results = append(results, flatExtractResult{Flat: extract.Number, File: path})
+	}
+
+	return a.writeJSON(results)
+}
@@ -0,0 +1,190 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// attachReport результат добавления вложения
+type attachReport struct {
+	PassportID string                  `json:"passport_id"`
+	Attachment entity.Attachment       `json:"attachment"`
+	Target     entity.AttachmentTarget `json:"target"`
+	Duplicate  bool                    `json:"duplicate,omitempty"`
+}
+
+// runAttach добавляет файл к паспорту:
+// techpassport-cli attach -id ID -in FILE [-owner N | -building А | -floor-plan А -floor 1 | -situation-plan]
+// [-description ТЕКСТ]
+func (a *App) runAttach(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("attach")
+	id := fs.String("id", "", "ID паспорта")
+	in := fs.String("in", "", "файл вложения")
+	owner := fs.Int("owner", 0, "номер правообладателя (с 1)")
+	building := fs.String("building", "", "литера здания")
+	floorPlan := fs.String("floor-plan", "", "литера поэтажного плана")
+	floor := fs.String("floor", "", "этаж поэтажного плана")
+	situationPlan := fs.Bool("situation-plan", false, "приложить к ситуационному плану")
+	description := fs.String("description", "", "описание вложения")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+	if err := requireFlag("in", *in); err != nil {
+		return err
+	}
+
+	target := entity.AttachmentTarget{Kind: entity.AttachmentTargetPassport}
+	targets := 0
+	if *owner != 0 {
+		if *owner < 0 {
+			return usageError{message: "-owner: номер правообладателя начинается с 1"}
+		}
+		target = entity.AttachmentTarget{Kind: entity.AttachmentTargetOwner, OwnerIndex: *owner - 1}
+		targets++
+	}
+	if *building != "" {
+		target = entity.AttachmentTarget{Kind: entity.AttachmentTargetBuilding, Litera: *building}
+		targets++
+	}
+	if *floorPlan != "" {
+		if err := requireFlag("floor", *floor); err != nil {
+			return err
+		}
+		target = entity.AttachmentTarget{Kind: entity.AttachmentTargetFloorPlan, Litera: *floorPlan, Floor: *floor}
+		targets++
+	} else if *floor != "" {
+		return usageError{message: "-floor указывается вместе с -floor-plan"}
+	}
+	if *situationPlan {
+		target = entity.AttachmentTarget{Kind: entity.AttachmentTargetSituationPlan}
+		targets++
+	}
+	if targets > 1 {
+		return usageError{message: "укажите не более одного из -owner, -building, -floor-plan, -situation-plan"}
+	}
+
+	data, err := os.ReadFile(*in)
+	if err != nil {
+		return fmt.Errorf("failed to open input: %w", err)
+	}
+
+	output, err := a.addAttachmentUC.Execute(ctx, passport.AddAttachmentInput{
+		PassportID:  *id,
+		FileName:    filepath.Base(*in),
+		Data:        data,
+		Description: *description,
+		Target:      target,
+	})
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(attachReport{
+		PassportID: output.Passport.ID,
+		Attachment: output.Attachment,
+		Target:     target,
+		Duplicate:  output.Duplicate,
+	})
+}
+
+// runAttachments выводит вложения паспорта со связями:
+// techpassport-cli attachments -id ID
+func (a *App) runAttachments(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("attachments")
+	id := fs.String("id", "", "ID паспорта")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+
+	output, err := a.listAttachmentsUC.Execute(ctx, passport.ListAttachmentsInput{PassportID: *id})
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(output.Attachments)
+}
+
+// runGetAttachment сохраняет содержимое вложения в файл:
+// techpassport-cli get-attachment -id ID -attachment SHA256 [-thumbnail] -out FILE
+func (a *App) runGetAttachment(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("get-attachment")
+	id := fs.String("id", "", "ID паспорта")
+	attachmentID := fs.String("attachment", "", "ID вложения (SHA-256)")
+	thumbnail := fs.Bool("thumbnail", false, "сохранить миниатюру PNG вместо файла")
+	out := fs.String("out", "", "файл результата (- для stdout)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	for _, f := range []struct{ name, value string }{{"id", *id}, {"attachment", *attachmentID}, {"out", *out}} {
+		if err := requireFlag(f.name, f.value); err != nil {
+			return err
+		}
+	}
+
+	output, err := a.getAttachmentUC.Execute(ctx, passport.GetAttachmentInput{
+		PassportID:   *id,
+		AttachmentID: *attachmentID,
+		Thumbnail:    *thumbnail,
+	})
+	if err != nil {
+		return err
+	}
+
+	if *out == "-" {
+		_, err := a.stdout.Write(output.Data)
+		return err
+	}
+
+	if err := os.WriteFile(*out, output.Data, 0o644); err != nil {
+		return fmt.Errorf("failed to write output: %w", err)
+	}
+	fmt.Fprintf(a.stderr, "сохранено: %s (%d байт)\n", *out, len(output.Data))
+
+	return nil
+}
+
+// removeAttachmentReport результат удаления вложения
+type removeAttachmentReport struct {
+	PassportID     string `json:"passport_id"`
+	AttachmentID   string `json:"attachment_id"`
+	ContentDeleted bool   `json:"content_deleted"`
+}
+
+// runRemoveAttachment удаляет вложение из паспорта:
+// techpassport-cli remove-attachment -id ID -attachment SHA256
+func (a *App) runRemoveAttachment(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("remove-attachment")
+	id := fs.String("id", "", "ID паспорта")
+	attachmentID := fs.String("attachment", "", "ID вложения (SHA-256)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+	if err := requireFlag("attachment", *attachmentID); err != nil {
+		return err
+	}
+
+	output, err := a.removeAttachmentUC.Execute(ctx, passport.RemoveAttachmentInput{PassportID: *id, AttachmentID: *attachmentID})
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(removeAttachmentReport{
+		PassportID:     output.Passport.ID,
+		AttachmentID:   *attachmentID,
+		ContentDeleted: output.ContentDeleted,
+	})
+}
@@ -0,0 +1,525 @@
+// Package cli реализует интерфейс командной строки для пакетной работы с паспортами
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/address"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/attachment"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/coordinates"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/document"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/dxf"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/floorplan"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/geometry"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/interchange"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/registry"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/rosreestr"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/search"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/security"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/spreadsheet"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/file"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/valuation"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/report"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/user"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/webhook"
+)
+
+// Коды завершения
+const (
+	ExitOK           = 0 // Успешное выполнение
+	ExitError        = 1 // Ошибка выполнения
+	ExitUsage        = 2 // Некорректные аргументы
+	ExitValidation   = 3 // Данные не прошли валидацию
+	ExitAccessDenied = 4 // Ошибка аутентификации или недостаточно прав
+)
+
+// Переменные окружения (удобны для ночных пакетных заданий)
+const (
+	envDataDir   = "TECHPASSPORT_DATA"
+	envUsersFile = "TECHPASSPORT_USERS"
+	envUser      = "TECHPASSPORT_USER"
+	envPassword  = "TECHPASSPORT_PASSWORD"
+)
+
+// usageError ошибка в аргументах командной строки
+type usageError struct {
+	message string
+}
+
+func (e usageError) Error() string {
+	return e.message
+}
+
+// validationFailedError означает, что один или несколько паспортов не прошли валидацию
+// Подробности уже выведены в stdout в формате JSON
+type validationFailedError struct {
+	count int
+}
+
+func (e validationFailedError) Error() string {
+	return fmt.Sprintf("validation failed for %d passport(s)", e.count)
+}
+
+// command подкоманда CLI
+type command struct {
+	summary string
+	run     func(a *App, ctx context.Context, args []string) error
+}
+
+// commands доступные подкоманды
+var commands = map[string]command{
+	"create":          {"создать паспорт из JSON", (*App).runCreate},
+	"add-building":    {"добавить здание из JSON в паспорт", (*App).runAddBuilding},
+	"remove-building": {"удалить здание по индексу", (*App).runRemoveBuilding},
+	"validate":        {"проверить паспорт (код 3 при ошибках)", (*App).runValidate},
+	"export":          {"экспортировать паспорта в pdf или docx", (*App).runExport},
+	"list":            {"вывести список паспортов в JSON", (*App).runList},
+	"search":          {"найти паспорта по тексту и фильтрам с фасетами", (*App).runSearch},
+	"duplicates":      {"найти вероятные дубликаты паспорта по сходству адресов", (*App).runDuplicates},
+	"merge":           {"перенести сведения дубликата в паспорт и удалить дубликат", (*App).runMerge},
+	"import":          {"импортировать паспорта из JSON", (*App).runImport},
+	"export-json":     {"выгрузить паспорта в формат обмена", (*App).runExportJSON},
+	"import-json":     {"загрузить паспорта из формата обмена", (*App).runImportJSON},
+	"schema":          {"вывести JSON Schema формата обмена", (*App).runSchema},
+	"export-xml":      {"выгрузить паспорт в XML технического плана Росреестра", (*App).runExportXML},
+	"validate-xml":    {"проверить XML технического плана по схеме (код 3 при ошибках)", (*App).runValidateXML},
+	"xml-fields":      {"перечислить поля схемы Росреестра без источника в паспорте и невыгружаемые сведения", (*App).runXMLFields},
+	"export-xlsx":     {"выгрузить экспликацию и состав объекта в XLSX", (*App).runExportXLSX},
+	"import-xlsx":     {"загрузить экспликацию или состав объекта из XLSX (код 3 при ошибках строк)", (*App).runImportXLSX},
+	"import-csv":      {"загрузить паспорта из CSV реестра старой системы (код 3 при ошибках строк)", (*App).runImportCSV},
+
+	// Сооружения и линейные объекты
+	"set-structure":    {"добавить или заменить сооружение из JSON (протяженность по трассе)", (*App).runSetStructure},
+	"remove-structure": {"удалить сооружение по литере", (*App).runRemoveStructure},
+
+	// Поэтажные планы
+	"set-floor-plan":    {"добавить или заменить поэтажный план из JSON", (*App).runSetFloorPlan},
+	"remove-floor-plan": {"удалить поэтажный план литеры и этажа", (*App).runRemoveFloorPlan},
+	"render-plan":       {"отрисовать поэтажный план в svg или png", (*App).runRenderPlan},
+	"check-plan-areas":  {"сверить площади помещений на планах с экспликацией (код 3 при расхождениях)", (*App).runCheckPlanAreas},
+	"import-dxf-plan":   {"загрузить поэтажный план и черновики помещений из чертежа DXF (код 3 при замечаниях)", (*App).runImportDXFPlan},
+
+	// Обмеры помещений
+	"measure-room": {"рассчитать площадь помещения по обмеру из JSON и записать в экспликацию", (*App).runMeasureRoom},
+
+	// Ситуационный план
+	"set-situation-plan":    {"заменить ситуационный план планом из JSON", (*App).runSetSituationPlan},
+	"import-plot":           {"загрузить границу участка или контур здания из CSV или DXF", (*App).runImportPlot},
+	"render-situation-plan": {"отрисовать ситуационный план в svg или png", (*App).runRenderSituationPlan},
+	"remove-situation-plan": {"удалить ситуационный план", (*App).runRemoveSituationPlan},
+
+	// Вложения
+	"attach":            {"приложить файл к паспорту, правообладателю, зданию или плану", (*App).runAttach},
+	"attachments":       {"вывести вложения паспорта со связями", (*App).runAttachments},
+	"get-attachment":    {"сохранить вложение или его миниатюру в файл", (*App).runGetAttachment},
+	"remove-attachment": {"удалить вложение из паспорта", (*App).runRemoveAttachment},
+
+	// Износ и инвентаризационная стоимость
+	"assess-wear":      {"описать конструктивные элементы здания и рассчитать износ", (*App).runAssessWear},
+	"value-building":   {"рассчитать инвентаризационную стоимость здания или сооружения по справочнику УПВС", (*App).runValueBuilding},
+	"check-valuations": {"повторить сохраненные расчеты стоимости (код 3 при расхождениях)", (*App).runCheckValuations},
+	"valuation-tables": {"вывести доступные справочники стоимости", (*App).runValuationTables},
+
+	// Многоквартирный дом
+	"set-entrances":    {"заменить список подъездов многоквартирного дома из JSON", (*App).runSetEntrances},
+	"set-flat":         {"добавить или заменить квартиру с экспликацией из JSON", (*App).runSetFlat},
+	"remove-flat":      {"удалить квартиру по номеру", (*App).runRemoveFlat},
+	"set-common-areas": {"заменить помещения общего имущества из JSON", (*App).runSetCommonAreas},
+	"apartment-totals": {"вывести площади квартир и общего имущества", (*App).runApartmentTotals},
+	"export-flats":     {"выгрузить выписки из паспорта на квартиры в pdf или docx", (*App).runExportFlats},
+
+	// Нежилые объекты
+	"set-commercial": {"заменить сведения о пожарной безопасности нежилого объекта из JSON", (*App).runSetCommercial},
+	"category-areas": {"вывести основную и вспомогательную площадь по категориям использования", (*App).runCategoryAreas},
+
+	// Доставка событий во внешние системы
+	"relay-outbox": {"доставить ожидающие события паспортов в webhook, NATS или файл", (*App).runRelayOutbox},
+	"outbox-dead":  {"вывести недоставленные события или вернуть их в очередь", (*App).runOutboxDead},
+
+	// Webhook организаций
+	"webhook-add":        {"зарегистрировать webhook организации для уведомлений о паспортах", (*App).runWebhookAdd},
+	"webhooks":           {"вывести зарегистрированные webhook", (*App).runWebhooks},
+	"webhook-remove":     {"удалить webhook", (*App).runWebhookRemove},
+	"webhook-deliveries": {"вывести журнал доставки уведомлений webhook", (*App).runWebhookDeliveries},
+
+	// Отчеты
+	"report": {"сформировать отчет по реестру паспортов за период в json, xlsx, pdf или docx", (*App).runReport},
+}
+
+// App CLI приложение с use cases поверх файлового хранилища
+type App struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+
+	// passports хранилище паспортов; outbox ведется, если включен в каталоге данных
+	dataDir   string
+	passports *file.JSONPassportRepository
+	outbox    *file.JSONOutbox
+
+	// webhooks webhook организаций; рассылаются при доставке outbox
+	webhooks          *file.JSONWebhookRepository
+	registerWebhookUC *access.RegisterWebhookUseCase
+	listWebhooksUC    *access.ListWebhooksUseCase
+	removeWebhookUC   *access.RemoveWebhookUseCase
+	listDeliveriesUC  *access.ListDeliveriesUseCase
+
+	reportUC       *report.RegistryReportUseCase
+	exportReportUC *report.ExportRegistryReportUseCase
+
+	createUC         *access.CreatePassportUseCase
+	addBuildingUC    *access.AddBuildingUseCase
+	removeBuildingUC *access.RemoveBuildingUseCase
+	validateUC       *access.ValidatePassportUseCase
+	exportUC         *access.ExportPassportUseCase
+	listUC           *access.ListPassportsUseCase
+	searchUC         *access.SearchPassportsUseCase
+	duplicatesUC     *access.FindDuplicatesUseCase
+	mergeUC          *access.MergePassportsUseCase
+	importUC         *access.ImportPassportUseCase
+	exportJSONUC     *access.ExportInterchangeUseCase
+	exportBundleUC   *access.ExportBundleUseCase
+	importJSONUC     *access.ImportBundleUseCase
+	codec            service.InterchangeCodec
+	exportXMLUC      *access.ExportRosreestrUseCase
+	rosreestr        service.RosreestrExporter
+	exportTablesUC   *access.ExportTablesUseCase
+	importTableUC    *access.ImportTableUseCase
+	importRegistryUC *access.ImportRegistryUseCase
+	loginUC          *user.LoginUseCase
+
+	setStructureUC    *access.SetStructureUseCase
+	removeStructureUC *access.RemoveStructureUseCase
+
+	saveFloorPlanUC   *access.SaveFloorPlanUseCase
+	removeFloorPlanUC *access.RemoveFloorPlanUseCase
+	renderPlanUC      *access.RenderFloorPlanUseCase
+	checkPlanAreasUC  *access.CheckFloorPlanAreasUseCase
+	importDXFPlanUC   *access.ImportFloorPlanDrawingUseCase
+
+	measureRoomUC   *access.MeasureRoomUseCase
+	calculateAreaUC *passport.CalculateRoomAreaUseCase
+
+	saveSituationPlanUC *access.SaveSituationPlanUseCase
+	importPlotUC        *access.ImportPlotCoordinatesUseCase
+	renderSituationUC   *access.RenderSituationPlanUseCase
+	removeSituationUC   *access.RemoveSituationPlanUseCase
+
+	addAttachmentUC    *access.AddAttachmentUseCase
+	listAttachmentsUC  *access.ListAttachmentsUseCase
+	getAttachmentUC    *access.GetAttachmentUseCase
+	removeAttachmentUC *access.RemoveAttachmentUseCase
+
+	assessWearUC       *access.AssessWearUseCase
+	valueBuildingUC    *access.ValueBuildingUseCase
+	verifyValuationsUC *access.VerifyValuationsUseCase
+	valuer             service.InventoryValuer
+
+	setEntrancesUC    *access.SetEntrancesUseCase
+	setFlatUC         *access.SetFlatUseCase
+	removeFlatUC      *access.RemoveFlatUseCase
+	setCommonAreasUC  *access.SetCommonAreasUseCase
+	apartmentTotalsUC *access.ApartmentTotalsUseCase
+	exportFlatsUC     *access.ExportFlatExtractsUseCase
+
+	setCommercialUC *access.SetCommercialInfoUseCase
+	categoryAreasUC *access.UseCategoryTotalsUseCase
+}
+
+// Run разбирает аргументы, выполняет подкоманду и возвращает код завершения
+func Run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("techpassport-cli", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	dataDir := flags.String("data", envOr(envDataDir, file.DefaultPassportsDir()), "каталог с паспортами")
+	usersFile := flags.String("users", envOr(envUsersFile, file.DefaultUsersFile()), "файл пользователей")
+	login := flags.String("user", os.Getenv(envUser), "логин пользователя")
+	password := flags.String("password", "", "пароль пользователя (лучше задавать через "+envPassword+")")
+	flags.Usage = func() { printUsage(stderr, flags) }
+
+	if err := flags.Parse(args); err != nil {
+		return ExitUsage
+	}
+
+	if flags.NArg() == 0 {
+		printUsage(stderr, flags)
+		return ExitUsage
+	}
+
+	name := flags.Arg(0)
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(stderr, "неизвестная команда: %s\n\n", name)
+		printUsage(stderr, flags)
+		return ExitUsage
+	}
+
+	// Пароль из окружения не показываем в справке как значение по умолчанию
+	if *password == "" {
+		*password = os.Getenv(envPassword)
+	}
+
+	app := newApp(*dataDir, *usersFile, stdin, stdout, stderr)
+
+	ctx, err := app.authenticate(context.Background(), *login, *password)
+	if err == nil {
+		err = cmd.run(app, ctx, flags.Args()[1:])
+	}
+
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", name, err)
+		return exitCode(err)
+	}
+
+	return ExitOK
+}
+
+// newApp собирает зависимости CLI
+func newApp(dataDir, usersFile string, stdin io.Reader, stdout, stderr io.Writer) *App {
+	repo := file.NewJSONPassportRepository(dataDir)
+	var events *file.JSONOutbox
+	if file.OutboxEnabled(dataDir) || file.WebhooksEnabled(dataDir) {
+		events = file.NewJSONOutbox(repo)
+	}
+	webhooks := file.NewJSONWebhookRepository(dataDir)
+	userRepo := file.NewJSONUserRepository(usersFile)
+	codec := interchange.NewCodec()
+	exporter := rosreestr.NewExporter(filepath.Join(dataDir, "rosreestr-schemas"))
+	tables := spreadsheet.NewCodec()
+	renderer := floorplan.NewRenderer()
+	calculator := geometry.NewCalculator()
+	attachments := file.NewFileAttachmentRepository(filepath.Join(dataDir, "attachments"))
+	inspector := attachment.NewInspector()
+	bundle := interchange.NewBundle()
+	valuer := valuation.NewEngine(filepath.Join(dataDir, "valuation-tables"))
+	generator := document.NewGenerator()
+	normalizer := address.NewNormalizer()
+
+	return &App{
+		stdin:  stdin,
+		stdout: stdout,
+		stderr: stderr,
+
+		dataDir:   dataDir,
+		passports: repo,
+		outbox:    events,
+
+		webhooks:          webhooks,
+		registerWebhookUC: access.NewRegisterWebhookUseCase(webhook.NewRegisterWebhookUseCase(webhooks)),
+		listWebhooksUC:    access.NewListWebhooksUseCase(webhook.NewListWebhooksUseCase(webhooks)),
+		removeWebhookUC:   access.NewRemoveWebhookUseCase(webhook.NewRemoveWebhookUseCase(webhooks)),
+		listDeliveriesUC:  access.NewListDeliveriesUseCase(webhook.NewListDeliveriesUseCase(webhooks)),
+
+		reportUC:       report.NewRegistryReportUseCase(repo),
+		exportReportUC: report.NewExportRegistryReportUseCase(repo, generator, tables),
+
+		createUC:         access.NewCreatePassportUseCase(passport.NewCreatePassportUseCase(repo, normalizer)),
+		addBuildingUC:    access.NewAddBuildingUseCase(passport.NewAddBuildingUseCase(repo)),
+		removeBuildingUC: access.NewRemoveBuildingUseCase(passport.NewRemoveBuildingUseCase(repo)),
+		validateUC:       access.NewValidatePassportUseCase(passport.NewValidatePassportUseCase(repo)),
+		exportUC:         access.NewExportPassportUseCase(passport.NewExportPassportUseCase(repo, generator)),
+		listUC:           access.NewListPassportsUseCase(passport.NewListPassportsUseCase(repo)),
+		searchUC:         access.NewSearchPassportsUseCase(passport.NewSearchPassportsUseCase(search.NewIndexedRepository(repo))),
+		duplicatesUC:     access.NewFindDuplicatesUseCase(passport.NewFindDuplicatesUseCase(repo, normalizer)),
+		mergeUC:          access.NewMergePassportsUseCase(passport.NewMergePassportsUseCase(repo)),
+		importUC:         access.NewImportPassportUseCase(passport.NewImportPassportUseCase(repo)),
+		exportJSONUC:     access.NewExportInterchangeUseCase(passport.NewExportInterchangeUseCase(repo, codec)),
+		exportBundleUC:   access.NewExportBundleUseCase(passport.NewExportBundleUseCase(repo, attachments, codec, bundle)),
+		importJSONUC:     access.NewImportBundleUseCase(passport.NewImportBundleUseCase(repo, attachments, codec, bundle, inspector)),
+		codec:            codec,
+		exportXMLUC:      access.NewExportRosreestrUseCase(passport.NewExportRosreestrUseCase(repo, exporter)),
+		rosreestr:        exporter,
+		exportTablesUC:   access.NewExportTablesUseCase(passport.NewExportTablesUseCase(repo, tables)),
+		importTableUC:    access.NewImportTableUseCase(passport.NewImportTableUseCase(repo, tables)),
+		importRegistryUC: access.NewImportRegistryUseCase(passport.NewImportRegistryUseCase(repo, registry.NewParser())),
+		loginUC:          user.NewLoginUseCase(userRepo, security.NewBcryptHasher()),
+
+		setStructureUC:    access.NewSetStructureUseCase(passport.NewSetStructureUseCase(repo)),
+		removeStructureUC: access.NewRemoveStructureUseCase(passport.NewRemoveStructureUseCase(repo)),
+
+		saveFloorPlanUC:   access.NewSaveFloorPlanUseCase(passport.NewSaveFloorPlanUseCase(repo)),
+		removeFloorPlanUC: access.NewRemoveFloorPlanUseCase(passport.NewRemoveFloorPlanUseCase(repo)),
+		renderPlanUC:      access.NewRenderFloorPlanUseCase(passport.NewRenderFloorPlanUseCase(repo, renderer)),
+		checkPlanAreasUC:  access.NewCheckFloorPlanAreasUseCase(passport.NewCheckFloorPlanAreasUseCase(repo)),
+		importDXFPlanUC:   access.NewImportFloorPlanDrawingUseCase(passport.NewImportFloorPlanDrawingUseCase(repo, dxf.NewParser())),
+
+		measureRoomUC:   access.NewMeasureRoomUseCase(passport.NewMeasureRoomUseCase(repo, calculator)),
+		calculateAreaUC: passport.NewCalculateRoomAreaUseCase(calculator),
+
+		saveSituationPlanUC: access.NewSaveSituationPlanUseCase(passport.NewSaveSituationPlanUseCase(repo)),
+		importPlotUC:        access.NewImportPlotCoordinatesUseCase(passport.NewImportPlotCoordinatesUseCase(repo, coordinates.NewParser())),
+		renderSituationUC:   access.NewRenderSituationPlanUseCase(passport.NewRenderSituationPlanUseCase(repo, renderer)),
+		removeSituationUC:   access.NewRemoveSituationPlanUseCase(passport.NewRemoveSituationPlanUseCase(repo)),
+
+		addAttachmentUC:    access.NewAddAttachmentUseCase(passport.NewAddAttachmentUseCase(repo, attachments, inspector)),
+		listAttachmentsUC:  access.NewListAttachmentsUseCase(passport.NewListAttachmentsUseCase(repo)),
+		getAttachmentUC:    access.NewGetAttachmentUseCase(passport.NewGetAttachmentUseCase(repo, attachments)),
+		removeAttachmentUC: access.NewRemoveAttachmentUseCase(passport.NewRemoveAttachmentUseCase(repo, attachments)),
+
+		assessWearUC:       access.NewAssessWearUseCase(passport.NewAssessWearUseCase(repo, valuer)),
+		valueBuildingUC:    access.NewValueBuildingUseCase(passport.NewValueBuildingUseCase(repo, valuer)),
+		verifyValuationsUC: access.NewVerifyValuationsUseCase(passport.NewVerifyValuationsUseCase(repo, valuer)),
+		valuer:             valuer,
+
+		setEntrancesUC:    access.NewSetEntrancesUseCase(passport.NewSetEntrancesUseCase(repo)),
+		setFlatUC:         access.NewSetFlatUseCase(passport.NewSetFlatUseCase(repo)),
+		removeFlatUC:      access.NewRemoveFlatUseCase(passport.NewRemoveFlatUseCase(repo)),
+		setCommonAreasUC:  access.NewSetCommonAreasUseCase(passport.NewSetCommonAreasUseCase(repo)),
+		apartmentTotalsUC: access.NewApartmentTotalsUseCase(passport.NewApartmentTotalsUseCase(repo)),
+		exportFlatsUC:     access.NewExportFlatExtractsUseCase(passport.NewExportFlatExtractsUseCase(repo, generator)),
+
+		setCommercialUC: access.NewSetCommercialInfoUseCase(passport.NewSetCommercialInfoUseCase(repo)),
+		categoryAreasUC: access.NewUseCategoryTotalsUseCase(passport.NewUseCategoryTotalsUseCase(repo)),
+	}
+}
+
+// authenticate выполняет вход и возвращает контекст с пользователем
+func (a *App) authenticate(ctx context.Context, login, password string) (context.Context, error) {
+	if login == "" {
+		return nil, entity.AuthenticationError{Message: "укажите -user или " + envUser}
+	}
+
+	output, err := a.loginUC.Execute(ctx, user.LoginInput{Login: login, Password: password})
+	if err != nil {
+		return nil, err
+	}
+
+	return access.WithActor(ctx, output.User), nil
+}
+
+// exitCode сопоставляет ошибку коду завершения
+func exitCode(err error) int {
+	var (
+		usageErr      usageError
+		failedErr     validationFailedError
+		validationErr entity.ValidationError
+		permissionErr entity.PermissionError
+		authErr       entity.AuthenticationError
+	)
+
+	switch {
+	case errors.As(err, &usageErr):
+		return ExitUsage
+	case errors.As(err, &failedErr), errors.As(err, &validationErr):
+		return ExitValidation
+	case errors.As(err, &permissionErr), errors.As(err, &authErr):
+		return ExitAccessDenied
+	default:
+		return ExitError
+	}
+}
+
+// printUsage выводит справку по командам
+func printUsage(w io.Writer, flags *flag.FlagSet) {
+	fmt.Fprintln(w, "Использование: techpassport-cli [флаги] <команда> [аргументы]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Команды:")
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-16s %s\n", name, commands[name].summary)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Флаги:")
+	flags.PrintDefaults()
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "Коды завершения: %d - успех, %d - ошибка, %d - неверные аргументы, %d - ошибки валидации, %d - нет доступа\n",
+		ExitOK, ExitError, ExitUsage, ExitValidation, ExitAccessDenied)
+}
+
+// envOr возвращает значение переменной окружения или значение по умолчанию
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+// readJSON читает JSON из файла или stdin (путь "" или "-")
+func (a *App) readJSON(path string, v interface{}) error {
+	var r io.Reader = a.stdin
+	if path != "" && path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("failed to open input: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return usageError{message: "некорректный JSON: " + err.Error()}
+	}
+
+	return nil
+}
+
+// readInput читает содержимое файла или stdin (путь "" или "-")
+func (a *App) readInput(path string) ([]byte, error) {
+	if path == "" || path == "-" {
+		data, err := io.ReadAll(a.stdin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read stdin: %w", err)
+		}
+		return data, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open input: %w", err)
+	}
+	return data, nil
+}
+
+// writeJSON выводит значение в stdout в формате JSON
+func (a *App) writeJSON(v interface{}) error {
+	encoder := json.NewEncoder(a.stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// newFlagSet создает набор флагов подкоманды
+func (a *App) newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	return fs
+}
+
+// parseFlags разбирает флаги подкоманды
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		return usageError{message: err.Error()}
+	}
+	if fs.NArg() > 0 {
+		return usageError{message: "лишние аргументы: " + strings.Join(fs.Args(), " ")}
+	}
+	return nil
+}
+
+// requireFlag проверяет наличие обязательного значения
+func requireFlag(name, value string) error {
+	if value == "" {
+		return usageError{message: "флаг -" + name + " обязателен"}
+	}
+	return nil
+}
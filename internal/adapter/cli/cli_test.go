@@ -0,0 +1,1149 @@
+package cli_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/adapter/cli"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/outbox"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/security"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/file"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const createJSON = `{
+	"object_type": "residential_house",
+	"organization_name": "ГУП БТИ",
+	"address": {"subject": "г. Москва", "city": "Москва", "street": "ул. Тверская", "house": "1"},
+	"general_info": {"purpose": "Жилое", "construction_year": 2020, "total_area": 100.5, "living_area": 70}
+}`
+
+const buildingJSON = `{"litera": "А", "name": "Жилой дом", "commission_year": 2020, "wall_material": "Кирпич", "total_area": 100.5}`
+
+// cliEnv окружение CLI во временном каталоге
+type cliEnv struct {
+	t       *testing.T
+	dataDir string
+	users   string
+	login   string
+}
+
+func newCLIEnv(t *testing.T, role entity.Role) *cliEnv {
+	dir := t.TempDir()
+	env := &cliEnv{
+		t:       t,
+		dataDir: filepath.Join(dir, "passports"),
+		users:   filepath.Join(dir, "users.json"),
+		login:   "admin",
+	}
+
+	repo := file.NewJSONUserRepository(env.users)
+	register := user.NewRegisterUserUseCase(repo, security.NewBcryptHasher())
+	_, err := register.Execute(context.Background(), user.RegisterUserInput{Login: "admin", Password: "secret1", Role: entity.RoleAdmin})
+	require.NoError(t, err)
+
+	if role != entity.RoleAdmin {
+		admin, err := repo.GetByLogin(context.Background(), "admin")
+		require.NoError(t, err)
+		// Регистрация от имени администратора
+		ctx := access.WithActor(context.Background(), admin)
+		_, err = register.Execute(ctx, user.RegisterUserInput{Login: "other", Password: "secret1", Role: role})
+		require.NoError(t, err)
+		env.login = "other"
+	}
+
+	return env
+}
+
+// run запускает CLI и возвращает код завершения и stdout
+func (e *cliEnv) run(stdin string, args ...string) (int, string) {
+	full := append([]string{"-data", e.dataDir, "-users", e.users, "-user", e.login, "-password", "secret1"}, args...)
+	var stdout, stderr bytes.Buffer
+	code := cli.Run(full, strings.NewReader(stdin), &stdout, &stderr)
+	e.t.Logf("techpassport-cli %s -> %d\nstderr: %s", strings.Join(args, " "), code, stderr.String())
+	return code, stdout.String()
+}
+
+func TestRun_BatchWorkflow(t *testing.T) {
+	env := newCLIEnv(t, entity.RoleAdmin)
+
+	// create
+	code, out := env.run(createJSON, "create")
+	require.Equal(t, cli.ExitOK, code)
+
+	var created entity.TechnicalPassport
+	require.NoError(t, json.Unmarshal([]byte(out), &created))
+	require.NotEmpty(t, created.ID)
+
+	// Паспорт без зданий и правообладателей не готов к экспорту
+	code, out = env.run("", "validate", "-id", created.ID, "-complete")
+	assert.Equal(t, cli.ExitValidation, code)
+	assert.Contains(t, out, `"valid": false`)
+
+	code, _ = env.run("", "export", "-id", created.ID, "-format", "docx", "-out", t.TempDir())
+	assert.Equal(t, cli.ExitValidation, code)
+
+	// add-building
+	code, _ = env.run(buildingJSON, "add-building", "-id", created.ID)
+	require.Equal(t, cli.ExitOK, code)
+
+	code, out = env.run("", "validate", "-id", created.ID)
+	assert.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, `"valid": true`)
+
+	// list
+	code, out = env.run("", "list")
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, created.ID)
+	assert.Contains(t, out, `"buildings": 1`)
+
+	// remove-building
+	code, _ = env.run("", "remove-building", "-id", created.ID, "-index", "0")
+	require.Equal(t, cli.ExitOK, code)
+
+	code, _ = env.run("", "remove-building", "-id", created.ID, "-index", "0")
+	assert.Equal(t, cli.ExitValidation, code)
+}
+
+func TestRun_ImportAndExport(t *testing.T) {
+	env := newCLIEnv(t, entity.RoleAdmin)
+
+	p := entity.NewTechnicalPassport(entity.ObjectTypeResidentialHouse, entity.Address{Subject: "г. Москва", House: "1"})
+	p.ID = "TP-IMPORT-1"
+	p.OrganizationName = "ГУП БТИ"
+	p.GeneralInfo = entity.GeneralInfo{Purpose: "Жилое", ConstructionYear: 2020, TotalArea: 100.0}
+	p.Buildings = []entity.Building{{Litera: "А", Name: "Жилой дом", CommissionYear: 2020, TotalArea: 100.0}}
+	p.Owners = []entity.Owner{{
+		EntryDate:     p.CreatedDate,
+		PersonType:    entity.PersonTypeIndividual,
+		FullName:      "Иванов Иван Иванович",
+		RightType:     "Собственность",
+		RightDocument: "Договор купли-продажи",
+		Share:         "1",
+	}}
+
+	invalid := entity.NewTechnicalPassport(entity.ObjectTypeResidentialHouse, entity.Address{House: "2"})
+	invalid.ID = "TP-IMPORT-2"
+
+	payload, err := json.Marshal([]*entity.TechnicalPassport{p, invalid})
+	require.NoError(t, err)
+
+	code, out := env.run(string(payload), "import")
+	assert.Equal(t, cli.ExitValidation, code)
+	assert.Contains(t, out, "субъект РФ обязателен")
+
+	outDir := t.TempDir()
+	for _, format := range []string{"pdf", "docx"} {
+		code, _ = env.run("", "export", "-all", "-format", format, "-out", outDir)
+		require.Equal(t, cli.ExitOK, code)
+
+		data, err := os.ReadFile(filepath.Join(outDir, "TP-IMPORT-1."+format))
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+	}
+}
+
+func TestRun_AccessAndUsage(t *testing.T) {
+	env := newCLIEnv(t, entity.RoleReviewer)
+
+	code, _ := env.run(createJSON, "create")
+	assert.Equal(t, cli.ExitAccessDenied, code)
+
+	code, _ = env.run("", "list")
+	assert.Equal(t, cli.ExitOK, code)
+
+	code, _ = env.run("", "unknown")
+	assert.Equal(t, cli.ExitUsage, code)
+
+	code, _ = env.run("", "export", "-format", "odt", "-all")
+	assert.Equal(t, cli.ExitUsage, code)
+
+	env.login = "nobody"
+	code, _ = env.run("", "list")
+	assert.Equal(t, cli.ExitAccessDenied, code)
+}
+
+func TestRun_InterchangeBetweenMachines(t *testing.T) {
+	source := newCLIEnv(t, entity.RoleAdmin)
+	target := newCLIEnv(t, entity.RoleAdmin)
+
+	code, out := source.run(createJSON, "create")
+	require.Equal(t, cli.ExitOK, code)
+	var created entity.TechnicalPassport
+	require.NoError(t, json.Unmarshal([]byte(out), &created))
+
+	document := filepath.Join(t.TempDir(), "transfer.json")
+	code, _ = source.run("", "export-json", "-all", "-out", document)
+	require.Equal(t, cli.ExitOK, code)
+
+	data, err := os.ReadFile(document)
+	require.NoError(t, err)
+
+	code, out = target.run(string(data), "import-json")
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, `"action": "created"`)
+	assert.Contains(t, out, created.ID)
+
+	// Повторная загрузка: по умолчанию существующие паспорта пропускаются
+	code, out = target.run(string(data), "import-json")
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, `"action": "skipped"`)
+
+	code, out = target.run(string(data), "import-json", "-conflict", "new")
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, `"action": "renamed"`)
+
+	code, _ = target.run(string(data), "import-json", "-conflict", "replace")
+	assert.Equal(t, cli.ExitUsage, code)
+
+	code, out = target.run(`{"format": "techpassport", "schema_version": 2, "passports": [{"object_type": "castle", "address": {}, "general_info": {}}]}`, "import-json")
+	assert.Equal(t, cli.ExitValidation, code)
+	assert.Contains(t, out, "passports[0].object_type")
+}
+
+func TestRun_RosreestrXML(t *testing.T) {
+	env := newCLIEnv(t, entity.RoleAdmin)
+
+	code, out := env.run(createJSON, "create")
+	require.Equal(t, cli.ExitOK, code)
+	var created entity.TechnicalPassport
+	require.NoError(t, json.Unmarshal([]byte(out), &created))
+
+	outDir := t.TempDir()
+	code, out = env.run("", "export-xml", "-id", created.ID, "-out", outDir)
+	require.Equal(t, cli.ExitOK, code, out)
+
+	var report struct {
+		File    string `json:"file"`
+		Valid   bool   `json:"valid"`
+		Missing []struct {
+			Path       string `json:"path"`
+			ModelField string `json:"model_field"`
+		} `json:"missing"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &report))
+	assert.False(t, report.Valid)
+	assert.NotEmpty(t, report.Missing)
+	require.FileExists(t, report.File)
+
+	// Строгий режим не сохраняет документ, не прошедший проверку
+	code, out = env.run("", "export-xml", "-id", created.ID, "-out", t.TempDir(), "-strict")
+	assert.Equal(t, cli.ExitValidation, code)
+	assert.NotContains(t, out, `"file"`)
+
+	data, err := os.ReadFile(report.File)
+	require.NoError(t, err)
+	code, out = env.run(string(data), "validate-xml")
+	assert.Equal(t, cli.ExitValidation, code)
+	assert.Contains(t, out, "EntitySpatial")
+
+	code, out = env.run("", "xml-fields")
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, "TP/GeneralCadastralWorks/Contractor/NCertificate")
+	assert.Contains(t, out, `"model_field": "explication.area"`)
+
+	code, _ = env.run("", "export-xml")
+	assert.Equal(t, cli.ExitUsage, code)
+}
+
+func TestRun_XLSX(t *testing.T) {
+	env := newCLIEnv(t, entity.RoleAdmin)
+
+	code, out := env.run(createJSON, "create")
+	require.Equal(t, cli.ExitOK, code)
+	var created entity.TechnicalPassport
+	require.NoError(t, json.Unmarshal([]byte(out), &created))
+
+	f := excelize.NewFile()
+	rows := [][]interface{}{
+		{"Обмеры на объекте"},
+		{"Этаж", "Пом.", "Назначение", "Лит", "S, кв.м"},
+		{"1", "1", "Комната", "А", 18.2},
+		{"1", "2", "Кухня", "А", "нет"},
+	}
+	for i, row := range rows {
+		require.NoError(t, f.SetSheetRow("Sheet1", fmt.Sprintf("A%d", i+1), &row))
+	}
+	buf, err := f.WriteToBuffer()
+	require.NoError(t, err)
+	data := buf.String()
+
+	// Заголовок "Пом." не узнается: без сопоставления столбец не выбран
+	code, _ = env.run(data, "import-xlsx", "-id", created.ID)
+	assert.Equal(t, cli.ExitValidation, code)
+
+	mapping := "floor=A,room_number=B,purpose=C,litera=D,area=5"
+	code, out = env.run(data, "import-xlsx", "-id", created.ID, "-header", "2", "-map", mapping)
+	assert.Equal(t, cli.ExitValidation, code)
+	assert.Contains(t, out, "rows[4].area")
+
+	code, out = env.run(data, "import-xlsx", "-id", created.ID, "-header", "2", "-map", mapping, "-skip-invalid")
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, `"imported": 1`)
+
+	code, _ = env.run(data, "import-xlsx", "-id", created.ID, "-map", "square=E")
+	assert.Equal(t, cli.ExitUsage, code)
+
+	outDir := t.TempDir()
+	code, out = env.run("", "export-xlsx", "-id", created.ID, "-out", outDir)
+	require.Equal(t, cli.ExitOK, code)
+	assert.FileExists(t, strings.TrimSpace(out))
+}
+
+func TestRun_ImportCSV(t *testing.T) {
+	env := newCLIEnv(t, entity.RoleAdmin)
+	dir := t.TempDir()
+
+	registry := filepath.Join(dir, "registry.csv")
+	require.NoError(t, os.WriteFile(registry, []byte("Код;Дом;Назначение;Год;Площадь\n"+
+		"1;1;Жилое;1965;54,3\n"+
+		"2;2;Жилое;;80\n"+
+		"3;1;Жилое;1965;54,3\n"), 0o644))
+
+	mapping := filepath.Join(dir, "mapping.json")
+	require.NoError(t, os.WriteFile(mapping, []byte(`{
+		"columns": {"key": "Код", "address.house": "Дом", "general_info.purpose": "Назначение",
+			"general_info.construction_year": "Год", "general_info.total_area": "Площадь"},
+		"defaults": {"object_type": "residential_house", "address.subject": "Тульская область", "address.city": "Тула"}
+	}`), 0o644))
+
+	code, out := env.run("", "import-csv", "-in", registry, "-mapping", mapping, "-dry-run")
+	assert.Equal(t, cli.ExitValidation, code)
+	assert.Contains(t, out, `"dry_run": true`)
+	assert.Contains(t, out, `"line": 3`)
+
+	code, out = env.run("", "list")
+	require.Equal(t, cli.ExitOK, code)
+	assert.NotContains(t, out, "Тула")
+
+	state := filepath.Join(dir, "state.json")
+	report := filepath.Join(dir, "report.jsonl")
+	code, out = env.run("", "import-csv", "-in", registry, "-mapping", mapping, "-state", state, "-report", report)
+	assert.Equal(t, cli.ExitValidation, code)
+	assert.Contains(t, out, `"created": 1`)
+	assert.Contains(t, out, `"skipped": 1`)
+
+	lines, err := os.ReadFile(report)
+	require.NoError(t, err)
+	assert.Len(t, strings.Split(strings.TrimSpace(string(lines)), "\n"), 3)
+
+	// Завершенный импорт при повторном запуске не загружает строки еще раз
+	code, out = env.run("", "import-csv", "-in", registry, "-mapping", mapping, "-state", state)
+	assert.Equal(t, cli.ExitValidation, code)
+	assert.Contains(t, out, `"resumed_after": 4`)
+	assert.Contains(t, out, `"created": 1`)
+
+	code, _ = env.run("", "import-csv", "-in", registry, "-mapping", mapping, "-duplicates", "merge")
+	assert.Equal(t, cli.ExitUsage, code)
+}
+
+func TestRun_FloorPlans(t *testing.T) {
+	env := newCLIEnv(t, entity.RoleAdmin)
+
+	p := entity.NewTechnicalPassport(entity.ObjectTypeResidentialHouse, entity.Address{Subject: "г. Москва", House: "1"})
+	p.ID = "TP-PLAN-1"
+	p.OrganizationName = "ГУП БТИ"
+	p.GeneralInfo = entity.GeneralInfo{Purpose: "Жилое", ConstructionYear: 2020, TotalArea: 30.0}
+	p.Buildings = []entity.Building{{Litera: "А", Name: "Жилой дом", CommissionYear: 2020, TotalArea: 30.0}}
+	p.Owners = []entity.Owner{{
+		EntryDate:     p.CreatedDate,
+		PersonType:    entity.PersonTypeIndividual,
+		FullName:      "Иванов Иван Иванович",
+		RightType:     "Собственность",
+		RightDocument: "Договор купли-продажи",
+		Share:         "1",
+	}}
+	p.Explication = []entity.Room{
+		{Litera: "А", Floor: "1", RoomNumber: "1", Purpose: "Жилая", Area: 20.0},
+		{Litera: "А", Floor: "1", RoomNumber: "2", Purpose: "Кухня", Area: 10.0},
+	}
+	payload, err := json.Marshal([]*entity.TechnicalPassport{p})
+	require.NoError(t, err)
+	code, _ := env.run(string(payload), "import")
+	require.Equal(t, cli.ExitOK, code)
+
+	plan := `{
+		"litera": "А", "floor": "1",
+		"walls": [{"start": {"x": 0, "y": 0}, "end": {"x": 9, "y": 0}, "thickness": 0.4}],
+		"openings": [{"type": "door", "wall": 0, "offset": 1, "width": 0.9}],
+		"rooms": [
+			{"room_number": "1", "points": [{"x": 0, "y": 0}, {"x": 5, "y": 0}, {"x": 5, "y": 4}, {"x": 0, "y": 4}]},
+			{"room_number": "2", "points": [{"x": 5, "y": 0}, {"x": 9, "y": 0}, {"x": 9, "y": 3}, {"x": 5, "y": 3}]}
+		]
+	}`
+	code, out := env.run(plan, "set-floor-plan", "-id", p.ID)
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, `"status": "mismatch"`)
+
+	code, out = env.run("", "check-plan-areas", "-id", p.ID)
+	assert.Equal(t, cli.ExitValidation, code)
+	assert.Contains(t, out, `"problems": 1`)
+
+	code, _ = env.run("", "check-plan-areas", "-id", p.ID, "-tolerance", "2.5")
+	assert.Equal(t, cli.ExitOK, code)
+
+	code, out = env.run("", "render-plan", "-id", p.ID, "-litera", "А", "-floor", "1")
+	require.Equal(t, cli.ExitOK, code)
+	assert.True(t, strings.HasPrefix(out, "<svg"))
+
+	image := filepath.Join(t.TempDir(), "plan.png")
+	code, _ = env.run("", "render-plan", "-id", p.ID, "-litera", "А", "-floor", "1", "-format", "png", "-out", image)
+	require.Equal(t, cli.ExitOK, code)
+	assert.FileExists(t, image)
+
+	code, _ = env.run("", "render-plan", "-id", p.ID, "-format", "gif")
+	assert.Equal(t, cli.ExitUsage, code)
+
+	// План встраивается в документ Word отдельной частью пакета
+	outDir := t.TempDir()
+	code, _ = env.run("", "export", "-id", p.ID, "-format", "docx", "-out", outDir)
+	require.Equal(t, cli.ExitOK, code)
+	docx, err := zip.OpenReader(filepath.Join(outDir, p.ID+".docx"))
+	require.NoError(t, err)
+	defer docx.Close()
+	var parts []string
+	for _, f := range docx.File {
+		parts = append(parts, f.Name)
+	}
+	assert.Contains(t, parts, "word/media/plan1.png")
+	assert.Contains(t, parts, "word/_rels/document.xml.rels")
+
+	code, _ = env.run("", "export", "-id", p.ID, "-format", "pdf", "-out", outDir)
+	require.Equal(t, cli.ExitOK, code)
+
+	code, _ = env.run("", "remove-floor-plan", "-id", p.ID, "-litera", "А", "-floor", "1")
+	require.Equal(t, cli.ExitOK, code)
+	code, _ = env.run("", "remove-floor-plan", "-id", p.ID, "-litera", "А", "-floor", "1")
+	assert.Equal(t, cli.ExitValidation, code)
+}
+
+func TestRun_MeasureRoom(t *testing.T) {
+	env := newCLIEnv(t, entity.RoleTechnician)
+
+	code, out := env.run(createJSON, "create")
+	require.Equal(t, cli.ExitOK, code)
+	var created entity.TechnicalPassport
+	require.NoError(t, json.Unmarshal([]byte(out), &created))
+
+	measurements := `{"shapes": [
+		{"kind": "rectangle", "role": "main", "length": 5.2, "width": 3.4},
+		{"kind": "semicircle", "role": "bay_window", "diameter": 1.6}
+	]}`
+
+	// Предварительный расчет не требует паспорта
+	code, out = env.run(measurements, "measure-room", "-dry-run")
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, `"area": 18.7`) // 17,68 + 1,01
+	assert.Contains(t, out, `"dry_run": true`)
+
+	code, _ = env.run(measurements, "measure-room", "-id", created.ID)
+	assert.Equal(t, cli.ExitUsage, code)
+
+	code, _ = env.run(measurements, "measure-room", "-id", created.ID, "-index", "0")
+	assert.Equal(t, cli.ExitValidation, code, "в экспликации нет помещений")
+
+	p := entity.NewTechnicalPassport(entity.ObjectTypeResidentialHouse, entity.Address{Subject: "г. Москва", House: "2"})
+	p.ID = "TP-MEASURE-1"
+	p.GeneralInfo = entity.GeneralInfo{Purpose: "Жилое", ConstructionYear: 2020, TotalArea: 18.0}
+	p.Explication = []entity.Room{{Litera: "А", Floor: "1", RoomNumber: "1", Purpose: "Жилая", Area: 18.0, LivingArea: 18.0}}
+	payload, err := json.Marshal([]*entity.TechnicalPassport{p})
+	require.NoError(t, err)
+	code, _ = env.run(string(payload), "import")
+	require.Equal(t, cli.ExitOK, code)
+
+	code, out = env.run(measurements, "measure-room", "-id", p.ID, "-index", "0")
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, `"previous_area": 18`)
+
+	code, out = env.run("", "export-json", "-id", p.ID)
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, `"measurements"`)
+	assert.Contains(t, out, `"living_area": 18.7`)
+
+	code, _ = env.run(`{"shapes": [{"kind": "triangle", "role": "main", "sides": [1, 1, 3]}]}`, "measure-room", "-dry-run")
+	assert.Equal(t, cli.ExitValidation, code)
+}
+
+func TestRun_SituationPlan(t *testing.T) {
+	env := newCLIEnv(t, entity.RoleTechnician)
+
+	p := entity.NewTechnicalPassport(entity.ObjectTypeResidentialHouse, entity.Address{Subject: "г. Москва", House: "3"})
+	p.ID = "TP-SITUATION-1"
+	p.GeneralInfo = entity.GeneralInfo{Purpose: "Жилое", ConstructionYear: 2020, TotalArea: 100.0}
+	p.Buildings = []entity.Building{{Litera: "А", Name: "Жилой дом", CommissionYear: 2020, BuildArea: 118.0}}
+	payload, err := json.Marshal([]*entity.TechnicalPassport{p})
+	require.NoError(t, err)
+	code, _ := env.run(string(payload), "import")
+	require.Equal(t, cli.ExitOK, code)
+
+	// Граница участка 30 x 20 м из каталога координат
+	catalog := filepath.Join(t.TempDir(), "plot.csv")
+	require.NoError(t, os.WriteFile(catalog, []byte("н1;470100,00;2200200,00\nн2;470130,00;2200200,00\nн3;470130,00;2200220,00\nн4;470100,00;2200220,00\n"), 0o644))
+
+	code, _ = env.run("", "import-plot", "-id", p.ID, "-in", catalog)
+	assert.Equal(t, cli.ExitValidation, code, "для нового плана нужна система координат")
+
+	code, out := env.run("", "import-plot", "-id", p.ID, "-in", catalog, "-cs", "msk", "-zone", "МСК-77")
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, `"plot_area": 600`)
+
+	plan := `{"coordinate_system": "msk", "boundary": [
+		{"x": 470100, "y": 2200200}, {"x": 470130, "y": 2200200}, {"x": 470130, "y": 2200220}, {"x": 470100, "y": 2200220}
+	], "footprints": [{"litera": "А", "points": [
+		{"x": 470105, "y": 2200205}, {"x": 470115, "y": 2200205}, {"x": 470115, "y": 2200217}, {"x": 470105, "y": 2200217}
+	]}]}`
+	code, out = env.run(plan, "set-situation-plan", "-id", p.ID)
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, `"previous": 118`)
+	assert.Contains(t, out, `"area": 120`)
+
+	code, out = env.run("", "render-situation-plan", "-id", p.ID)
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, "<svg")
+
+	code, _ = env.run("", "render-situation-plan", "-id", p.ID, "-format", "pdf")
+	assert.Equal(t, cli.ExitUsage, code)
+
+	code, _ = env.run("", "import-plot", "-id", p.ID, "-in", filepath.Join(t.TempDir(), "plot.kml"))
+	assert.Equal(t, cli.ExitUsage, code)
+
+	code, _ = env.run("", "remove-situation-plan", "-id", p.ID)
+	require.Equal(t, cli.ExitOK, code)
+
+	code, _ = env.run("", "render-situation-plan", "-id", p.ID)
+	assert.Equal(t, cli.ExitValidation, code)
+}
+
+func TestRun_ImportDXFPlan(t *testing.T) {
+	env := newCLIEnv(t, entity.RoleTechnician)
+
+	p := entity.NewTechnicalPassport(entity.ObjectTypeResidentialHouse, entity.Address{Subject: "г. Москва", House: "4"})
+	p.ID = "TP-DXF-1"
+	p.GeneralInfo = entity.GeneralInfo{Purpose: "Жилое", ConstructionYear: 2020, TotalArea: 12.0}
+	p.Explication = []entity.Room{{Litera: "А", Floor: "1", RoomNumber: "1", Purpose: "Жилая", Area: 12.0}}
+	payload, err := json.Marshal([]*entity.TechnicalPassport{p})
+	require.NoError(t, err)
+	code, _ := env.run(string(payload), "import")
+	require.Equal(t, cli.ExitOK, code)
+
+	// Помещение 1 (4 x 3 м) и помещение 2 без назначения (2 x 3 м), единицы - метры
+	drawing := filepath.Join(t.TempDir(), "floor1.dxf")
+	require.NoError(t, os.WriteFile(drawing, []byte(strings.Join([]string{
+		"0", "SECTION", "2", "ENTITIES",
+		"0", "LWPOLYLINE", "8", "ПОМЕЩЕНИЯ", "90", "4", "70", "1",
+		"10", "0", "20", "0", "10", "4", "20", "0", "10", "4", "20", "3", "10", "0", "20", "3",
+		"0", "LWPOLYLINE", "8", "ПОМЕЩЕНИЯ", "90", "4", "70", "1",
+		"10", "4", "20", "0", "10", "6", "20", "0", "10", "6", "20", "3", "10", "4", "20", "3",
+		"0", "TEXT", "8", "0", "10", "2", "20", "1.5", "1", "1 Жилая",
+		"0", "TEXT", "8", "0", "10", "5", "20", "1.5", "1", "2",
+		"0", "ENDSEC", "0", "EOF",
+	}, "\n")), 0o644))
+
+	code, _ = env.run("", "import-dxf-plan", "-id", p.ID, "-in", drawing, "-litera", "А", "-floor", "1")
+	assert.Equal(t, cli.ExitValidation, code, "в чертеже не указаны единицы")
+
+	code, _ = env.run("", "import-dxf-plan", "-id", p.ID, "-in", drawing, "-litera", "А", "-floor", "1", "-units", "ft")
+	assert.Equal(t, cli.ExitUsage, code)
+
+	code, out := env.run("", "import-dxf-plan", "-id", p.ID, "-in", drawing, "-litera", "А", "-floor", "1", "-units", "m")
+	assert.Equal(t, cli.ExitValidation, code, "помещение 2 без назначения требует проверки")
+	assert.Contains(t, out, `"rooms": 2`)
+	assert.Contains(t, out, `"added": [`)
+	assert.Contains(t, out, `"kind": "no_purpose"`)
+
+	code, out = env.run("", "render-plan", "-id", p.ID, "-litera", "А", "-floor", "1")
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, "<svg")
+}
+
+func TestRun_Attachments(t *testing.T) {
+	env := newCLIEnv(t, entity.RoleTechnician)
+
+	code, out := env.run(createJSON, "create")
+	require.Equal(t, cli.ExitOK, code)
+	var created entity.TechnicalPassport
+	require.NoError(t, json.Unmarshal([]byte(out), &created))
+	code, _ = env.run(buildingJSON, "add-building", "-id", created.ID)
+	require.Equal(t, cli.ExitOK, code)
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, 600, 300))))
+	photo := filepath.Join(t.TempDir(), "facade.png")
+	require.NoError(t, os.WriteFile(photo, buf.Bytes(), 0o644))
+
+	code, _ = env.run("", "attach", "-id", created.ID, "-in", photo, "-building", "Б")
+	assert.Equal(t, cli.ExitValidation, code, "литеры Б нет в составе объекта")
+
+	code, _ = env.run("", "attach", "-id", created.ID, "-in", photo, "-building", "А", "-situation-plan")
+	assert.Equal(t, cli.ExitUsage, code)
+
+	code, out = env.run("", "attach", "-id", created.ID, "-in", photo, "-building", "А", "-description", "Фасад")
+	require.Equal(t, cli.ExitOK, code)
+	var attached struct {
+		Attachment entity.Attachment `json:"attachment"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &attached))
+	assert.Equal(t, entity.AttachmentID(buf.Bytes()), attached.Attachment.ID)
+	assert.Equal(t, "image/png", attached.Attachment.MIMEType)
+	assert.True(t, attached.Attachment.Thumbnail)
+
+	code, out = env.run("", "attachments", "-id", created.ID)
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, `"kind": "building"`)
+	assert.Contains(t, out, `"litera": "А"`)
+
+	thumbnail := filepath.Join(t.TempDir(), "thumb.png")
+	code, _ = env.run("", "get-attachment", "-id", created.ID, "-attachment", attached.Attachment.ID, "-thumbnail", "-out", thumbnail)
+	require.Equal(t, cli.ExitOK, code)
+	data, err := os.ReadFile(thumbnail)
+	require.NoError(t, err)
+	config, err := png.DecodeConfig(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, 256, config.Width)
+	assert.Equal(t, 128, config.Height)
+
+	// Архив переносит вложения в другое хранилище
+	bundle := filepath.Join(t.TempDir(), "passports.techpassport.zip")
+	code, _ = env.run("", "export-json", "-id", created.ID, "-bundle", "-out", bundle)
+	require.Equal(t, cli.ExitOK, code)
+
+	other := newCLIEnv(t, entity.RoleTechnician)
+	code, _ = other.run("", "import-json", "-in", bundle)
+	require.Equal(t, cli.ExitOK, code)
+
+	original := filepath.Join(t.TempDir(), "facade.png")
+	code, _ = other.run("", "get-attachment", "-id", created.ID, "-attachment", attached.Attachment.ID, "-out", original)
+	require.Equal(t, cli.ExitOK, code)
+	data, err = os.ReadFile(original)
+	require.NoError(t, err)
+	assert.Equal(t, buf.Bytes(), data)
+
+	// Документ без архива ссылается на файл, которого нет в новом хранилище
+	code, document := env.run("", "export-json", "-id", created.ID)
+	require.Equal(t, cli.ExitOK, code)
+	code, out = newCLIEnv(t, entity.RoleTechnician).run(document, "import-json")
+	assert.Equal(t, cli.ExitValidation, code)
+	assert.Contains(t, out, "facade.png")
+
+	code, out = env.run("", "remove-attachment", "-id", created.ID, "-attachment", attached.Attachment.ID)
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, `"content_deleted": true`)
+
+	code, _ = env.run("", "get-attachment", "-id", created.ID, "-attachment", attached.Attachment.ID, "-out", original)
+	assert.Equal(t, cli.ExitValidation, code)
+}
+
+func TestRun_ValueBuilding(t *testing.T) {
+	env := newCLIEnv(t, entity.RoleTechnician)
+
+	code, out := env.run(createJSON, "create")
+	require.Equal(t, cli.ExitOK, code)
+	var created entity.TechnicalPassport
+	require.NoError(t, json.Unmarshal([]byte(out), &created))
+	building := `{"litera": "А", "name": "Жилой дом", "commission_year": 2020, "wall_material": "Кирпич", "total_area": 100.5, "volume": 500}`
+	code, _ = env.run(building, "add-building", "-id", created.ID)
+	require.Equal(t, cli.ExitOK, code)
+
+	code, out = env.run("", "valuation-tables")
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, `"version": "upvs-1969-2024"`)
+
+	code, _ = env.run("", "value-building", "-id", created.ID, "-litera", "А", "-year", "2010")
+	assert.Equal(t, cli.ExitValidation, code, "в справочнике нет индекса на 2010 г.")
+
+	code, out = env.run("", "value-building", "-id", created.ID, "-litera", "А", "-year", "2024", "-wear", "20", "-dry-run")
+	require.Equal(t, cli.ExitOK, code)
+	var report struct {
+		PreviousValue float64                  `json:"previous_value"`
+		Valuation     entity.BuildingValuation `json:"valuation"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &report))
+	assert.Equal(t, 987940.0, report.Valuation.InventoryValue) // 500 x 23,50 x 105,10 x 0,8
+	assert.Equal(t, 0.0, report.PreviousValue)
+
+	code, out = env.run("", "check-valuations", "-id", created.ID)
+	require.Equal(t, cli.ExitOK, code)
+	assert.Equal(t, "[]\n", out, "предварительный расчет не сохраняется")
+
+	code, _ = env.run("", "value-building", "-id", created.ID, "-litera", "А", "-year", "2024")
+	require.Equal(t, cli.ExitOK, code)
+	code, out = env.run("", "export-json", "-id", created.ID)
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, `"inventory_value": 1197877`) // износ 3% за 4 года из 150
+
+	code, out = env.run("", "check-valuations", "-id", created.ID)
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, `"status": "ok"`)
+}
+
+func TestRun_AssessWear(t *testing.T) {
+	env := newCLIEnv(t, entity.RoleTechnician)
+
+	code, out := env.run(createJSON, "create")
+	require.Equal(t, cli.ExitOK, code)
+	var created entity.TechnicalPassport
+	require.NoError(t, json.Unmarshal([]byte(out), &created))
+	building := `{"litera": "А", "name": "Жилой дом", "commission_year": 2020, "wall_material": "Кирпич", "total_area": 100.5, "volume": 500}`
+	code, _ = env.run(building, "add-building", "-id", created.ID)
+	require.Equal(t, cli.ExitOK, code)
+
+	code, _ = env.run(`[{"kind": "chimney", "wear": 10}]`, "assess-wear", "-id", created.ID, "-litera", "А")
+	assert.Equal(t, cli.ExitValidation, code)
+
+	elements := `[{"kind": "foundation", "description": "ленточный бутовый", "wear": 20}, {"kind": "walls", "description": "кирпичные", "wear": 40}]`
+	code, out = env.run(elements, "assess-wear", "-id", created.ID, "-litera", "А")
+	require.Equal(t, cli.ExitOK, code)
+	var report struct {
+		Assessment entity.WearAssessment `json:"assessment"`
+		Warnings   []string              `json:"warnings"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &report))
+	assert.Equal(t, 35.0, report.Assessment.Wear)
+	assert.Len(t, report.Warnings, 1)
+
+	code, _ = env.run("", "assess-wear", "-id", created.ID, "-litera", "А", "-recalculate", "-dry-run")
+	require.Equal(t, cli.ExitOK, code)
+
+	// Износ по элементам учитывается в стоимости
+	code, out = env.run("", "value-building", "-id", created.ID, "-litera", "А", "-year", "2024")
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, `"inventory_value": 802701`)
+}
+
+func TestRun_ApartmentBuilding(t *testing.T) {
+	env := newCLIEnv(t, entity.RoleTechnician)
+
+	create := strings.Replace(createJSON, `"residential_house"`, `"apartment_building"`, 1)
+	code, out := env.run(create, "create")
+	require.Equal(t, cli.ExitOK, code)
+	var created entity.TechnicalPassport
+	require.NoError(t, json.Unmarshal([]byte(out), &created))
+
+	code, _ = env.run(`[{"number": "1", "litera": "А", "floors": 5}]`, "set-entrances", "-id", created.ID)
+	require.Equal(t, cli.ExitOK, code)
+
+	flat := `{"number": "7", "litera": "А", "entrance": "1", "floor": "2", "explication": [
+		{"room_number": "1", "purpose": "Жилая комната", "area": 17.4, "living_area": 17.4},
+		{"room_number": "2", "purpose": "Кухня", "area": 6.1, "auxiliary_area": 6.1}]}`
+	code, out = env.run(flat, "set-flat", "-id", created.ID)
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, `"total_area": 23.5`)
+
+	code, _ = env.run(`{"number": "8", "litera": "А", "entrance": "2", "floor": "2"}`, "set-flat", "-id", created.ID)
+	assert.Equal(t, cli.ExitValidation, code, "подъезда 2 нет")
+
+	code, _ = env.run(`[{"litera": "А", "floor": "1", "room_number": "I", "purpose": "Лестничная клетка", "area": 12}]`, "set-common-areas", "-id", created.ID)
+	require.Equal(t, cli.ExitOK, code)
+
+	code, out = env.run("", "apartment-totals", "-id", created.ID)
+	require.Equal(t, cli.ExitOK, code)
+	var totals entity.ApartmentTotals
+	require.NoError(t, json.Unmarshal([]byte(out), &totals))
+	assert.Equal(t, 1, totals.Flats)
+	assert.Equal(t, 17.4, totals.LivingArea)
+	assert.Equal(t, 35.5, totals.TotalArea)
+
+	outDir := t.TempDir()
+	code, out = env.run("", "export-flats", "-id", created.ID, "-format", "docx", "-out", outDir)
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, created.ID+"-kv7.docx")
+	_, err := os.Stat(filepath.Join(outDir, created.ID+"-kv7.docx"))
+	assert.NoError(t, err)
+
+	code, _ = env.run("", "export-flats", "-id", created.ID, "-flat", "99")
+	assert.Equal(t, cli.ExitValidation, code)
+
+	code, _ = env.run("", "remove-flat", "-id", created.ID, "-flat", "7")
+	require.Equal(t, cli.ExitOK, code)
+}
+
+func TestRun_NonResidential(t *testing.T) {
+	env := newCLIEnv(t, entity.RoleAdmin)
+
+	p := entity.NewTechnicalPassport(entity.ObjectTypeNonResidential, entity.Address{Subject: "г. Москва", House: "3"})
+	p.ID = "TP-NONRES"
+	p.OrganizationName = "ГУП БТИ"
+	p.GeneralInfo = entity.GeneralInfo{Purpose: "Нежилое", ConstructionYear: 1990, TotalArea: 50}
+	p.Buildings = []entity.Building{{Litera: "А", Name: "Административное здание", CommissionYear: 1990, TotalArea: 50}}
+	p.Owners = []entity.Owner{{
+		EntryDate:     p.CreatedDate,
+		PersonType:    entity.PersonTypeLegal,
+		CompanyName:   "ООО «Ромашка»",
+		RightType:     "Собственность",
+		RightDocument: "Договор купли-продажи",
+		Share:         "1",
+	}}
+	p.Explication = []entity.Room{
+		{Litera: "А", Floor: "1", RoomNumber: "1", Purpose: "Офис", Area: 30, AuxiliaryArea: 5, UseCategory: entity.UseCategoryOffice},
+		{Litera: "А", Floor: "1", RoomNumber: "2", Purpose: "Склад", Area: 20, UseCategory: entity.UseCategoryStorage},
+	}
+	payload, err := json.Marshal([]*entity.TechnicalPassport{p})
+	require.NoError(t, err)
+	code, out := env.run(string(payload), "import")
+	require.Equal(t, cli.ExitOK, code, out)
+
+	code, out = env.run("", "category-areas", "-id", p.ID)
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, "не указан класс функциональной пожарной опасности")
+
+	// Без сведений о пожарной безопасности паспорт не экспортируется
+	code, _ = env.run("", "export", "-id", p.ID, "-format", "pdf", "-out", t.TempDir())
+	assert.Equal(t, cli.ExitValidation, code)
+
+	code, _ = env.run(`{"fire_hazard_class": "Ф9.1"}`, "set-commercial", "-id", p.ID)
+	assert.Equal(t, cli.ExitValidation, code)
+
+	commercial := `{"fire_hazard_class": "Ф4.3", "explosion_category": "В4", "fire_resistance": "II", "evacuation_exits": 2}`
+	code, out = env.run(commercial, "set-commercial", "-id", p.ID)
+	require.Equal(t, cli.ExitOK, code)
+	var report struct {
+		Categories []entity.UseCategoryArea `json:"categories"`
+		Missing    []string                 `json:"missing"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &report))
+	assert.Empty(t, report.Missing)
+	assert.Equal(t, []entity.UseCategoryArea{
+		{Category: entity.UseCategoryOffice, Rooms: 1, MainArea: 25, AuxiliaryArea: 5, TotalArea: 30},
+		{Category: entity.UseCategoryStorage, Rooms: 1, MainArea: 20, TotalArea: 20},
+	}, report.Categories)
+
+	code, _ = env.run("", "export", "-id", p.ID, "-format", "docx", "-out", t.TempDir())
+	assert.Equal(t, cli.ExitOK, code)
+
+	// Сведения о пожарной безопасности не заполняются для жилого дома
+	code, out = env.run(createJSON, "create")
+	require.Equal(t, cli.ExitOK, code)
+	var house entity.TechnicalPassport
+	require.NoError(t, json.Unmarshal([]byte(out), &house))
+	code, _ = env.run(commercial, "set-commercial", "-id", house.ID)
+	assert.Equal(t, cli.ExitValidation, code)
+	code, _ = env.run("", "category-areas", "-id", house.ID)
+	assert.Equal(t, cli.ExitValidation, code)
+}
+
+func TestRun_Structures(t *testing.T) {
+	env := newCLIEnv(t, entity.RoleTechnician)
+
+	code, out := env.run(createJSON, "create")
+	require.Equal(t, cli.ExitOK, code)
+	var created entity.TechnicalPassport
+	require.NoError(t, json.Unmarshal([]byte(out), &created))
+	code, _ = env.run(buildingJSON, "add-building", "-id", created.ID)
+	require.Equal(t, cli.ExitOK, code)
+
+	// Протяженность ограждения вычисляется по трассе: 30 + 40 м
+	fence := `{"litera": "I", "kind": "fence", "name": "Забор", "commission_year": 2009, "material": "металлический профлист",
+		"route": [{"x": 0, "y": 0}, {"x": 30, "y": 0}, {"x": 30, "y": 40}]}`
+	code, out = env.run(fence, "set-structure", "-id", created.ID)
+	require.Equal(t, cli.ExitOK, code, out)
+	var report struct {
+		Structure entity.Structure `json:"structure"`
+		Replaced  bool             `json:"replaced"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &report))
+	assert.Equal(t, 70.0, report.Structure.Length)
+	assert.False(t, report.Replaced)
+
+	code, _ = env.run(`{"litera": "II", "kind": "well", "name": "Колодец", "commission_year": 2000}`, "set-structure", "-id", created.ID)
+	assert.Equal(t, cli.ExitValidation, code, "колодец без глубины")
+	code, _ = env.run(`{"litera": "А", "kind": "septic", "name": "Септик", "commission_year": 2000, "capacity": 3}`, "set-structure", "-id", created.ID)
+	assert.Equal(t, cli.ExitValidation, code, "литера занята зданием")
+
+	code, out = env.run("", "value-building", "-id", created.ID, "-litera", "I", "-year", "2024")
+	require.Equal(t, cli.ExitOK, code, out)
+	var valued struct {
+		Valuation entity.BuildingValuation `json:"valuation"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &valued))
+	assert.Equal(t, entity.ValuationUnitLength, valued.Valuation.Unit)
+	assert.Equal(t, 16921.0, valued.Valuation.InventoryValue) // 70 x 4,60 x 105,10, износ 50% за 15 лет из 30
+
+	code, out = env.run("", "check-valuations", "-id", created.ID)
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, `"litera": "I"`)
+
+	code, out = env.run("", "export-json", "-id", created.ID)
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, `"structures"`)
+
+	code, _ = env.run("", "remove-structure", "-id", created.ID, "-litera", "I")
+	require.Equal(t, cli.ExitOK, code)
+	code, _ = env.run("", "remove-structure", "-id", created.ID, "-litera", "I")
+	assert.Equal(t, cli.ExitValidation, code)
+}
+
+func TestRun_Outbox(t *testing.T) {
+	env := newCLIEnv(t, entity.RoleAdmin)
+	events := filepath.Join(t.TempDir(), "events.jsonl")
+
+	// Первое обращение включает outbox каталога данных
+	code, out := env.run("", "outbox-dead")
+	require.Equal(t, cli.ExitOK, code)
+	assert.JSONEq(t, `[]`, out)
+
+	code, out = env.run(createJSON, "create")
+	require.Equal(t, cli.ExitOK, code)
+	var created entity.TechnicalPassport
+	require.NoError(t, json.Unmarshal([]byte(out), &created))
+	code, _ = env.run(buildingJSON, "add-building", "-id", created.ID)
+	require.Equal(t, cli.ExitOK, code)
+
+	code, _ = env.run("", "relay-outbox")
+	assert.Equal(t, cli.ExitUsage, code)
+
+	code, out = env.run("", "relay-outbox", "-events-file", events)
+	require.Equal(t, cli.ExitOK, code)
+	assert.JSONEq(t, `{"delivered": 2, "retried": 0, "dead_lettered": 0, "deferred": 0}`, out)
+
+	data, err := os.ReadFile(events)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"type":"passport_created","passport_id":"`+created.ID+`"`)
+	assert.Contains(t, lines[1], `"type":"building_added"`)
+
+	// Повторный проход ничего не отправляет
+	code, out = env.run("", "relay-outbox", "-events-file", events)
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, `"delivered": 0`)
+
+	// Удаление дубликата при объединении записывается в outbox вместе с удалением файла
+	code, out = env.run(createJSON, "create")
+	require.Equal(t, cli.ExitOK, code)
+	var duplicate entity.TechnicalPassport
+	require.NoError(t, json.Unmarshal([]byte(out), &duplicate))
+	code, _ = env.run("", "merge", "-id", created.ID, "-source", duplicate.ID)
+	require.Equal(t, cli.ExitOK, code)
+
+	code, _ = env.run("", "relay-outbox", "-events-file", events)
+	require.Equal(t, cli.ExitOK, code)
+	data, err = os.ReadFile(events)
+	require.NoError(t, err)
+	lines = strings.Split(strings.TrimSpace(string(data)), "\n")
+	assert.Contains(t, lines[len(lines)-1], `"type":"passport_deleted","passport_id":"`+duplicate.ID+`"`)
+
+	code, _ = env.run("", "outbox-dead", "-requeue", "EV-404")
+	assert.Equal(t, cli.ExitError, code)
+
+	technician := newCLIEnv(t, entity.RoleTechnician)
+	code, _ = technician.run("", "relay-outbox", "-events-file", events)
+	assert.Equal(t, cli.ExitAccessDenied, code)
+}
+
+func TestRun_Webhooks(t *testing.T) {
+	env := newCLIEnv(t, entity.RoleAdmin)
+	const secret = "0123456789abcdef"
+
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get(outbox.HeaderSignature) != outbox.Sign(secret, r.Header.Get(outbox.HeaderTimestamp), body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var event entity.DomainEvent
+		require.NoError(t, json.Unmarshal(body, &event))
+		received = append(received, event.Action)
+	}))
+	defer server.Close()
+
+	code, _ := env.run("", "webhook-add", "-org", "ГУП БТИ", "-url", "ftp://example.com")
+	assert.Equal(t, cli.ExitValidation, code)
+
+	code, out := env.run("", "webhook-add", "-org", "ГУП БТИ", "-url", server.URL, "-secret", secret, "-actions", "created,add_building")
+	require.Equal(t, cli.ExitOK, code)
+	var registered entity.Webhook
+	require.NoError(t, json.Unmarshal([]byte(out), &registered))
+	assert.Equal(t, []string{"created", "add_building"}, registered.Actions)
+
+	// Регистрация включает outbox: события паспортов организации рассылаются
+	code, out = env.run(createJSON, "create")
+	require.Equal(t, cli.ExitOK, code)
+	var created entity.TechnicalPassport
+	require.NoError(t, json.Unmarshal([]byte(out), &created))
+	code, _ = env.run(buildingJSON, "add-building", "-id", created.ID)
+	require.Equal(t, cli.ExitOK, code)
+
+	code, out = env.run("", "relay-outbox")
+	require.Equal(t, cli.ExitOK, code)
+	assert.JSONEq(t, `{"delivered": 2, "retried": 0, "dead_lettered": 0, "deferred": 0}`, out)
+	assert.Equal(t, []string{"created", "add_building"}, received)
+
+	code, out = env.run("", "webhook-deliveries", "-id", registered.ID)
+	require.Equal(t, cli.ExitOK, code)
+	var deliveries []entity.WebhookDelivery
+	require.NoError(t, json.Unmarshal([]byte(out), &deliveries))
+	require.Len(t, deliveries, 2)
+	assert.Equal(t, "add_building", deliveries[0].Action)
+	assert.Equal(t, entity.WebhookDelivered, deliveries[0].Status)
+	assert.Equal(t, created.ID, deliveries[1].PassportID)
+
+	code, out = env.run("", "webhooks", "-org", "ГУП БТИ")
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, registered.ID)
+
+	code, _ = env.run("", "webhook-remove", "-id", registered.ID)
+	require.Equal(t, cli.ExitOK, code)
+	code, out = env.run("", "webhooks")
+	require.Equal(t, cli.ExitOK, code)
+	assert.JSONEq(t, `[]`, out)
+
+	technician := newCLIEnv(t, entity.RoleTechnician)
+	code, _ = technician.run("", "webhook-add", "-org", "ГУП БТИ", "-url", server.URL)
+	assert.Equal(t, cli.ExitAccessDenied, code)
+}
+
+func TestRun_Search(t *testing.T) {
+	env := newCLIEnv(t, entity.RoleAdmin)
+
+	code, _ := env.run(createJSON, "create")
+	require.Equal(t, cli.ExitOK, code)
+	other := strings.NewReplacer("ул. Тверская", "ул. Арбат", "2020", "1960", "residential_house", "apartment").Replace(createJSON)
+	code, _ = env.run(other, "create")
+	require.Equal(t, cli.ExitOK, code)
+
+	var report struct {
+		Total     int `json:"total"`
+		Limit     int `json:"limit"`
+		Passports []struct {
+			Address string `json:"address"`
+		} `json:"passports"`
+		Facets struct {
+			ObjectTypes []struct {
+				Value string `json:"value"`
+				Count int    `json:"count"`
+			} `json:"object_types"`
+		} `json:"facets"`
+	}
+
+	code, out := env.run("", "search", "-q", "тверск")
+	require.Equal(t, cli.ExitOK, code)
+	require.NoError(t, json.Unmarshal([]byte(out), &report))
+	assert.Equal(t, 1, report.Total)
+	assert.Equal(t, 50, report.Limit)
+	require.Len(t, report.Passports, 1)
+	assert.Contains(t, report.Passports[0].Address, "Тверская")
+
+	code, out = env.run("", "search", "-type", "apartment")
+	require.Equal(t, cli.ExitOK, code)
+	require.NoError(t, json.Unmarshal([]byte(out), &report))
+	assert.Equal(t, 1, report.Total)
+	assert.Len(t, report.Facets.ObjectTypes, 2)
+
+	code, _ = env.run("", "search", "-year-from", "2000", "-year-to", "1900")
+	assert.Equal(t, cli.ExitValidation, code)
+
+	code, _ = env.run("", "search", "-sort", "owner")
+	assert.Equal(t, cli.ExitValidation, code)
+}
+
+func TestRun_DuplicatesAndMerge(t *testing.T) {
+	env := newCLIEnv(t, entity.RoleAdmin)
+
+	code, out := env.run(createJSON, "create")
+	require.Equal(t, cli.ExitOK, code)
+	var first struct {
+		ID string `json:"id"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &first))
+
+	// Тот же адрес в другой записи
+	same := strings.NewReplacer("г. Москва", "Москва г", "ул. Тверская", "Тверская ул").Replace(createJSON)
+	code, out = env.run(same, "create")
+	require.Equal(t, cli.ExitOK, code)
+	var second struct {
+		ID string `json:"id"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &second))
+
+	var duplicates []struct {
+		ID    string  `json:"id"`
+		Score float64 `json:"score"`
+	}
+	code, out = env.run("", "duplicates", "-id", first.ID)
+	require.Equal(t, cli.ExitOK, code)
+	require.NoError(t, json.Unmarshal([]byte(out), &duplicates))
+	require.Len(t, duplicates, 1)
+	assert.Equal(t, second.ID, duplicates[0].ID)
+	assert.Equal(t, 1.0, duplicates[0].Score)
+
+	code, out = env.run("", "merge", "-id", first.ID, "-source", second.ID)
+	require.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, out, `"removed": "`+second.ID+`"`)
+
+	code, _ = env.run("", "get", "-id", second.ID)
+	assert.NotEqual(t, cli.ExitOK, code)
+
+	code, _ = env.run("", "merge", "-id", first.ID, "-source", first.ID)
+	assert.Equal(t, cli.ExitValidation, code)
+}
+
+func TestRun_Report(t *testing.T) {
+	env := newCLIEnv(t, entity.RoleAdmin)
+
+	code, out := env.run(createJSON, "create")
+	require.Equal(t, cli.ExitOK, code)
+	var created struct {
+		ID string `json:"id"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &created))
+	code, _ = env.run(buildingJSON, "add-building", "-id", created.ID)
+	require.Equal(t, cli.ExitOK, code)
+
+	var report struct {
+		Total struct {
+			Count     int     `json:"count"`
+			TotalArea float64 `json:"total_area"`
+		} `json:"total"`
+		ByWallMaterial []struct {
+			Title string `json:"title"`
+		} `json:"by_wall_material"`
+	}
+	month := time.Now().Format("2006-01")
+	code, out = env.run("", "report", "-month", month)
+	require.Equal(t, cli.ExitOK, code)
+	require.NoError(t, json.Unmarshal([]byte(out), &report))
+	assert.Equal(t, 1, report.Total.Count)
+	assert.InDelta(t, 100.5, report.Total.TotalArea, 0.001)
+	require.Len(t, report.ByWallMaterial, 1)
+	assert.Equal(t, "Кирпич", report.ByWallMaterial[0].Title)
+
+	code, out = env.run("", "report", "-type", "apartment")
+	require.Equal(t, cli.ExitOK, code)
+	require.NoError(t, json.Unmarshal([]byte(out), &report))
+	assert.Equal(t, 0, report.Total.Count)
+
+	dir := t.TempDir()
+	code, out = env.run("", "report", "-month", month, "-format", "xlsx", "-out", dir)
+	require.Equal(t, cli.ExitOK, code)
+	assert.FileExists(t, strings.TrimSpace(out))
+	assert.True(t, strings.HasSuffix(strings.TrimSpace(out), ".xlsx"))
+
+	code, _ = env.run("", "report", "-month", "09.2026")
+	assert.Equal(t, cli.ExitUsage, code)
+
+	code, _ = env.run("", "report", "-from", "2026-09-30", "-to", "2026-09-01")
+	assert.Equal(t, cli.ExitValidation, code)
+
+	code, _ = env.run("", "report", "-format", "csv")
+	assert.Equal(t, cli.ExitValidation, code)
+}
@@ -0,0 +1,379 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// createRequest JSON представление входных данных команды create
+type createRequest struct {
+	ObjectType       entity.ObjectType  `json:"object_type"`
+	Address          entity.Address     `json:"address"`
+	OrganizationName string             `json:"organization_name"`
+	GeneralInfo      entity.GeneralInfo `json:"general_info"`
+}
+
+// passportSummary краткие сведения о паспорте для команд list и search
+type passportSummary struct {
+	ID              string                `json:"id"`
+	Status          entity.PassportStatus `json:"status"`
+	ObjectType      entity.ObjectType     `json:"object_type"`
+	Address         string                `json:"address"`
+	InventoryNumber string                `json:"inventory_number,omitempty"`
+	CadastralNumber string                `json:"cadastral_number,omitempty"`
+	Buildings       int                   `json:"buildings"`
+	UpdatedDate     time.Time             `json:"updated_date"`
+}
+
+// batchResult результат обработки одного паспорта в пакетных командах
+type batchResult struct {
+	ID    string `json:"id,omitempty"`
+	File  string `json:"file,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// validateResult результат команды validate
+type validateResult struct {
+	ID       string                   `json:"id"`
+	Valid    bool                     `json:"valid"`
+	Errors   []entity.ValidationError `json:"errors,omitempty"`
+	Warnings []string                 `json:"warnings,omitempty"`
+}
+
+// runCreate создает паспорт: techpassport-cli create [-in file.json]
+func (a *App) runCreate(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("create")
+	in := fs.String("in", "-", "JSON с данными паспорта (- для stdin)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	var req createRequest
+	if err := a.readJSON(*in, &req); err != nil {
+		return err
+	}
+
+	output, err := a.createUC.Execute(ctx, passport.CreatePassportInput{
+		ObjectType:       req.ObjectType,
+		Address:          req.Address,
+		OrganizationName: req.OrganizationName,
+		GeneralInfo:      req.GeneralInfo,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Паспорт создан; вероятные дубликаты выводятся предупреждением
+	for _, d := range output.Duplicates {
+		fmt.Fprintf(a.stderr, "возможный дубликат: %s (%s, сходство %.2f); объединение: merge -id %s -source %s\n",
+			d.Passport.ID, d.Passport.Address.FullAddress(), d.Score, d.Passport.ID, output.Passport.ID)
+	}
+
+	return a.writeJSON(output.Passport)
+}
+
+// runAddBuilding добавляет здание: techpassport-cli add-building -id ID [-in building.json]
+func (a *App) runAddBuilding(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("add-building")
+	id := fs.String("id", "", "ID паспорта")
+	in := fs.String("in", "-", "JSON с данными здания (- для stdin)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+
+	var building entity.Building
+	if err := a.readJSON(*in, &building); err != nil {
+		return err
+	}
+
+	output, err := a.addBuildingUC.Execute(ctx, passport.AddBuildingInput{
+		PassportID: *id,
+		Building:   building,
+	})
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(output.Passport)
+}
+
+// runRemoveBuilding удаляет здание: techpassport-cli remove-building -id ID -index N
+func (a *App) runRemoveBuilding(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("remove-building")
+	id := fs.String("id", "", "ID паспорта")
+	index := fs.Int("index", -1, "индекс здания (с 0)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+
+	output, err := a.removeBuildingUC.Execute(ctx, passport.RemoveBuildingInput{
+		PassportID:    *id,
+		BuildingIndex: *index,
+	})
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(output.Passport)
+}
+
+// runValidate проверяет паспорта: techpassport-cli validate (-id ID | -all) [-complete]
+func (a *App) runValidate(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("validate")
+	var ids idList
+	fs.Var(&ids, "id", "ID паспорта (можно указать несколько раз)")
+	all := fs.Bool("all", false, "проверить все паспорта")
+	complete := fs.Bool("complete", false, "требовать полноты для экспорта")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	targets, err := a.resolveIDs(ctx, ids, *all)
+	if err != nil {
+		return err
+	}
+
+	results := make([]validateResult, 0, len(targets))
+	failed := 0
+	for _, id := range targets {
+		output, err := a.validateUC.Execute(ctx, passport.ValidatePassportInput{PassportID: id, Complete: *complete})
+		if err != nil {
+			return err
+		}
+
+		results = append(results, validateResult{
+			ID:       id,
+			Valid:    output.Result.Valid,
+			Errors:   output.Result.Errors,
+			Warnings: output.Result.Warnings,
+		})
+		if !output.Result.Valid {
+			failed++
+		}
+	}
+
+	if err := a.writeJSON(results); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return validationFailedError{count: failed}
+	}
+
+	return nil
+}
+
+// runExport экспортирует паспорта: techpassport-cli export (-id ID | -all) -format pdf|docx [-out DIR]
+func (a *App) runExport(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("export")
+	var ids idList
+	fs.Var(&ids, "id", "ID паспорта (можно указать несколько раз)")
+	all := fs.Bool("all", false, "экспортировать все паспорта")
+	format := fs.String("format", string(service.FormatPDF), "формат документа: pdf или docx")
+	out := fs.String("out", ".", "каталог для документов")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	docFormat := service.DocumentFormat(strings.ToLower(*format))
+	if docFormat != service.FormatPDF && docFormat != service.FormatDOCX {
+		return usageError{message: "флаг -format принимает значения pdf или docx"}
+	}
+
+	targets, err := a.resolveIDs(ctx, ids, *all)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	// Ошибка одного паспорта не прерывает пакетную выгрузку
+	results := make([]batchResult, 0, len(targets))
+	failed := 0
+	var firstErr error
+	for _, id := range targets {
+		result := batchResult{ID: id}
+
+		output, err := a.exportUC.Execute(ctx, passport.ExportPassportInput{PassportID: id, Format: docFormat})
+		if err == nil {
+			path := filepath.Join(*out, output.FileName)
+			if err = os.WriteFile(path, output.Document, 0o644); err == nil {
+				result.File = path
+			}
+		}
+
+		if err != nil {
+			result.Error = err.Error()
+			failed++
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+		results = append(results, result)
+	}
+
+	if err := a.writeJSON(results); err != nil {
+		return err
+	}
+
+	return batchError(firstErr, failed)
+}
+
+// runList выводит список паспортов: techpassport-cli list
+func (a *App) runList(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("list")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	output, err := a.listUC.Execute(ctx, passport.ListPassportsInput{})
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(summarize(output.Passports))
+}
+
+// summarize возвращает краткие сведения о паспортах
+func summarize(passports []*entity.TechnicalPassport) []passportSummary {
+	summaries := make([]passportSummary, 0, len(passports))
+	for _, p := range passports {
+		summaries = append(summaries, summary(p))
+	}
+	return summaries
+}
+
+// summary возвращает краткие сведения о паспорте
+func summary(p *entity.TechnicalPassport) passportSummary {
+	return passportSummary{
+		ID:              p.ID,
+		Status:          p.Status,
+		ObjectType:      p.ObjectType,
+		Address:         p.Address.FullAddress(),
+		InventoryNumber: p.InventoryNumber,
+		CadastralNumber: p.CadastralNumber,
+		Buildings:       len(p.Buildings),
+		UpdatedDate:     p.UpdatedDate,
+	}
+}
+
+// runImport импортирует паспорта: techpassport-cli import [-in passports.json]
+// Файл содержит один паспорт (объект) или массив паспортов
+func (a *App) runImport(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("import")
+	in := fs.String("in", "-", "JSON с паспортом или массивом паспортов (- для stdin)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	var raw json.RawMessage
+	if err := a.readJSON(*in, &raw); err != nil {
+		return err
+	}
+
+	var passports []*entity.TechnicalPassport
+	if trimmed := bytes.TrimSpace(raw); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &passports); err != nil {
+			return usageError{message: "некорректный JSON: " + err.Error()}
+		}
+	} else {
+		var single entity.TechnicalPassport
+		if err := json.Unmarshal(trimmed, &single); err != nil {
+			return usageError{message: "некорректный JSON: " + err.Error()}
+		}
+		passports = append(passports, &single)
+	}
+
+	results := make([]batchResult, 0, len(passports))
+	failed := 0
+	var firstErr error
+	for _, p := range passports {
+		result := batchResult{ID: p.ID}
+
+		output, err := a.importUC.Execute(ctx, passport.ImportPassportInput{Passport: p})
+		if err != nil {
+			result.Error = err.Error()
+			failed++
+			if firstErr == nil {
+				firstErr = err
+			}
+		} else {
+			result.ID = output.Passport.ID
+		}
+		results = append(results, result)
+	}
+
+	if err := a.writeJSON(results); err != nil {
+		return err
+	}
+
+	return batchError(firstErr, failed)
+}
+
+// resolveIDs возвращает ID паспортов из флагов -id или все паспорта при -all
+func (a *App) resolveIDs(ctx context.Context, ids idList, all bool) ([]string, error) {
+	if all == (len(ids) > 0) {
+		return nil, usageError{message: "укажите -id или -all"}
+	}
+
+	if !all {
+		return ids, nil
+	}
+
+	output, err := a.listUC.Execute(ctx, passport.ListPassportsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0, len(output.Passports))
+	for _, p := range output.Passports {
+		result = append(result, p.ID)
+	}
+	return result, nil
+}
+
+// batchError возвращает итоговую ошибку пакетной команды
+// Если хотя бы один паспорт не прошел валидацию - код завершения ExitValidation
+func batchError(firstErr error, failed int) error {
+	if firstErr == nil {
+		return nil
+	}
+
+	var validationErr entity.ValidationError
+	if errors.As(firstErr, &validationErr) {
+		return validationFailedError{count: failed}
+	}
+
+	return fmt.Errorf("%d passport(s) failed, first error: %w", failed, firstErr)
+}
+
+// idList значение флага -id, который можно указать несколько раз
+type idList []string
+
+func (l *idList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *idList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// categoryAreasReport площади нежилого объекта по категориям использования
+type categoryAreasReport struct {
+	PassportID string                   `json:"passport_id"`
+	Categories []entity.UseCategoryArea `json:"categories"`
+
+	// Missing сведения, обязательные для экспорта паспорта нежилого объекта
+	Missing []string `json:"missing,omitempty"`
+}
+
+// runSetCommercial заменяет сведения о пожарной безопасности нежилого объекта:
+// techpassport-cli set-commercial -id ID [-in commercial.json]
+func (a *App) runSetCommercial(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("set-commercial")
+	id := fs.String("id", "", "ID паспорта")
+	in := fs.String("in", "-", "JSON сведений о пожарной безопасности (- для stdin)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+
+	var info entity.CommercialInfo
+	if err := a.readJSON(*in, &info); err != nil {
+		return err
+	}
+
+	output, err := a.setCommercialUC.Execute(ctx, passport.SetCommercialInfoInput{PassportID: *id, Info: info})
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(newCategoryAreasReport(output.Passport, output.Passport.UseCategoryTotals()))
+}
+
+// runCategoryAreas выводит площади по категориям использования:
+// techpassport-cli category-areas -id ID
+func (a *App) runCategoryAreas(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("category-areas")
+	id := fs.String("id", "", "ID паспорта")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+
+	output, err := a.categoryAreasUC.Execute(ctx, passport.UseCategoryTotalsInput{PassportID: *id})
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(newCategoryAreasReport(output.Passport, output.Totals))
+}
+
+// newCategoryAreasReport формирует отчет с незаполненными обязательными сведениями
+func newCategoryAreasReport(p *entity.TechnicalPassport, totals []entity.UseCategoryArea) categoryAreasReport {
+	report := categoryAreasReport{PassportID: p.ID, Categories: totals}
+	if report.Categories == nil {
+		report.Categories = []entity.UseCategoryArea{}
+	}
+	for _, e := range p.ProfileErrors() {
+		report.Missing = append(report.Missing, e.Message)
+	}
+	return report
+}
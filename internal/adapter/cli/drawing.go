@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// importDXFReport результат загрузки поэтажного плана из чертежа
+type importDXFReport struct {
+	PassportID string                  `json:"passport_id"`
+	Litera     string                  `json:"litera"`
+	Floor      string                  `json:"floor"`
+	Rooms      int                     `json:"rooms"`
+	Added      []string                `json:"added,omitempty"`
+	Existing   []string                `json:"existing,omitempty"`
+	Issues     []passport.DrawingIssue `json:"issues,omitempty"`
+	DryRun     bool                    `json:"dry_run,omitempty"`
+}
+
+// runImportDXFPlan загружает поэтажный план из чертежа DXF:
+// techpassport-cli import-dxf-plan -id ID -in FILE -litera А -floor 1
+// [-room-layers ПОМЕЩЕНИЯ] [-text-layers НОМЕРА] [-units mm|cm|m] [-dry-run]
+func (a *App) runImportDXFPlan(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("import-dxf-plan")
+	id := fs.String("id", "", "ID паспорта")
+	in := fs.String("in", "", "чертеж DXF (R12 - 2018, текстовый)")
+	litera := fs.String("litera", "", "литера")
+	floor := fs.String("floor", "", "этаж")
+	roomLayers := fs.String("room-layers", strings.Join(passport.DefaultRoomLayers, ","), "слои контуров помещений через запятую")
+	textLayers := fs.String("text-layers", "", "слои номеров помещений через запятую (по умолчанию любые)")
+	units := fs.String("units", "", "единицы чертежа mm, cm или m, если не указаны в чертеже")
+	dryRun := fs.Bool("dry-run", false, "только разобрать чертеж, не изменяя паспорт")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	for _, f := range []struct{ name, value string }{{"id", *id}, {"in", *in}, {"litera", *litera}, {"floor", *floor}} {
+		if err := requireFlag(f.name, f.value); err != nil {
+			return err
+		}
+	}
+
+	unit := service.DrawingUnit(*units)
+	if unit != "" && !unit.IsValid() {
+		return usageError{message: "-units: допустимые значения mm, cm, m"}
+	}
+
+	source, err := os.Open(*in)
+	if err != nil {
+		return fmt.Errorf("failed to open input: %w", err)
+	}
+	defer source.Close()
+
+	output, err := a.importDXFPlanUC.Execute(ctx, passport.ImportFloorPlanDrawingInput{
+		PassportID: *id,
+		Source:     source,
+		Options: passport.DrawingImportOptions{
+			Litera:     *litera,
+			Floor:      *floor,
+			RoomLayers: layerList(*roomLayers),
+			TextLayers: layerList(*textLayers),
+			Unit:       unit,
+		},
+		DryRun: *dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	report := importDXFReport{
+		PassportID: output.Passport.ID,
+		Litera:     *litera,
+		Floor:      *floor,
+		Rooms:      len(output.Plan.Rooms),
+		Existing:   output.Existing,
+		Issues:     output.Issues,
+		DryRun:     *dryRun,
+	}
+	for _, room := range output.Added {
+		report.Added = append(report.Added, room.RoomNumber)
+	}
+	if err := a.writeJSON(report); err != nil {
+		return err
+	}
+
+	// План сохранен, но контуры с замечаниями нужно проверить вручную
+	if len(output.Issues) > 0 {
+		return validationFailedError{count: 1}
+	}
+	return nil
+}
+
+// layerList разбирает список слоев через запятую
+func layerList(value string) []string {
+	var layers []string
+	for _, l := range strings.Split(value, ",") {
+		if l = strings.TrimSpace(l); l != "" {
+			layers = append(layers, l)
+		}
+	}
+	return layers
+}
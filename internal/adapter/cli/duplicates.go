@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// duplicateSummary вероятный дубликат паспорта для команды duplicates
+type duplicateSummary struct {
+	passportSummary
+	Score float64 `json:"score"`
+}
+
+// mergeReport результат команды merge
+type mergeReport struct {
+	ID      string             `json:"id"`
+	Removed string             `json:"removed"`
+	Merged  entity.MergeResult `json:"merged"`
+}
+
+// runDuplicates выводит вероятные дубликаты паспорта по убыванию сходства адресов:
+// techpassport-cli duplicates -id ID [-threshold 0.8]
+func (a *App) runDuplicates(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("duplicates")
+	id := fs.String("id", "", "ID паспорта")
+	threshold := fs.Float64("threshold", 0, "минимальное сходство адресов от 0 до 1 (0 - 0.8)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+
+	output, err := a.duplicatesUC.Execute(ctx, passport.FindDuplicatesInput{PassportID: *id, Threshold: *threshold})
+	if err != nil {
+		return err
+	}
+
+	duplicates := make([]duplicateSummary, 0, len(output.Duplicates))
+	for _, d := range output.Duplicates {
+		duplicates = append(duplicates, duplicateSummary{passportSummary: summary(d.Passport), Score: d.Score})
+	}
+
+	return a.writeJSON(duplicates)
+}
+
+// runMerge переносит сведения дубликата в паспорт и удаляет дубликат:
+// techpassport-cli merge -id ID -source DUPLICATE_ID
+func (a *App) runMerge(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("merge")
+	id := fs.String("id", "", "ID паспорта, который сохраняется")
+	source := fs.String("source", "", "ID дубликата, который удаляется")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+	if err := requireFlag("source", *source); err != nil {
+		return err
+	}
+
+	output, err := a.mergeUC.Execute(ctx, passport.MergePassportsInput{PassportID: *id, SourceID: *source})
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(mergeReport{ID: output.Passport.ID, Removed: output.SourceID, Merged: output.Result})
+}
@@ -0,0 +1,164 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// floorPlanReport результат сохранения плана со сверкой площадей
+type floorPlanReport struct {
+	PassportID string                        `json:"passport_id"`
+	Litera     string                        `json:"litera"`
+	Floor      string                        `json:"floor"`
+	Checks     []passport.FloorPlanAreaCheck `json:"checks,omitempty"`
+}
+
+// checkPlanAreasReport результат сверки площадей планов с экспликацией
+type checkPlanAreasReport struct {
+	PassportID string                        `json:"passport_id"`
+	Problems   int                           `json:"problems"`
+	Checks     []passport.FloorPlanAreaCheck `json:"checks"`
+}
+
+// runSetFloorPlan добавляет или заменяет поэтажный план из JSON:
+// techpassport-cli set-floor-plan -id ID [-in FILE]
+func (a *App) runSetFloorPlan(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("set-floor-plan")
+	id := fs.String("id", "", "ID паспорта")
+	in := fs.String("in", "-", "JSON поэтажного плана (- для stdin)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+
+	var plan entity.FloorPlan
+	if err := a.readJSON(*in, &plan); err != nil {
+		return err
+	}
+
+	output, err := a.saveFloorPlanUC.Execute(ctx, passport.SaveFloorPlanInput{PassportID: *id, Plan: plan})
+	if err != nil {
+		return err
+	}
+
+	// Расхождения площадей не мешают сохранению плана, но выводятся в отчете
+	return a.writeJSON(floorPlanReport{
+		PassportID: output.Passport.ID,
+		Litera:     plan.Litera,
+		Floor:      plan.Floor,
+		Checks:     output.Checks,
+	})
+}
+
+// runRemoveFloorPlan удаляет поэтажный план:
+// techpassport-cli remove-floor-plan -id ID -litera А -floor 1
+func (a *App) runRemoveFloorPlan(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("remove-floor-plan")
+	id := fs.String("id", "", "ID паспорта")
+	litera := fs.String("litera", "", "литера")
+	floor := fs.String("floor", "", "этаж")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+	if err := requireFlag("litera", *litera); err != nil {
+		return err
+	}
+	if err := requireFlag("floor", *floor); err != nil {
+		return err
+	}
+
+	_, err := a.removeFloorPlanUC.Execute(ctx, passport.RemoveFloorPlanInput{
+		PassportID: *id,
+		Litera:     *litera,
+		Floor:      *floor,
+	})
+	return err
+}
+
+// runRenderPlan отрисовывает поэтажный план в SVG или PNG:
+// techpassport-cli render-plan -id ID -litera А -floor 1 [-format svg|png] [-out FILE]
+func (a *App) runRenderPlan(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("render-plan")
+	id := fs.String("id", "", "ID паспорта")
+	litera := fs.String("litera", "", "литера")
+	floor := fs.String("floor", "", "этаж")
+	format := fs.String("format", string(service.PlanFormatSVG), "формат изображения: svg или png")
+	out := fs.String("out", "-", "файл изображения (- для stdout)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+
+	imageFormat := service.PlanImageFormat(*format)
+	if !imageFormat.IsValid() {
+		return usageError{message: "-format: допустимые значения svg, png"}
+	}
+
+	output, err := a.renderPlanUC.Execute(ctx, passport.RenderFloorPlanInput{
+		PassportID: *id,
+		Litera:     *litera,
+		Floor:      *floor,
+		Format:     imageFormat,
+	})
+	if err != nil {
+		return err
+	}
+
+	if *out == "-" {
+		_, err = a.stdout.Write(output.Data)
+		return err
+	}
+	if err := os.WriteFile(*out, output.Data, 0o644); err != nil {
+		return fmt.Errorf("failed to write output: %w", err)
+	}
+	fmt.Fprintln(a.stdout, *out)
+
+	return nil
+}
+
+// runCheckPlanAreas сверяет площади помещений на планах с экспликацией:
+// techpassport-cli check-plan-areas -id ID [-tolerance 0.1]
+func (a *App) runCheckPlanAreas(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("check-plan-areas")
+	id := fs.String("id", "", "ID паспорта")
+	tolerance := fs.Float64("tolerance", passport.DefaultAreaTolerance, "допустимое расхождение, кв.м")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+
+	output, err := a.checkPlanAreasUC.Execute(ctx, passport.CheckFloorPlanAreasInput{
+		PassportID: *id,
+		Tolerance:  *tolerance,
+	})
+	if err != nil {
+		return err
+	}
+
+	checks := output.Checks
+	if checks == nil {
+		checks = []passport.FloorPlanAreaCheck{}
+	}
+	if err := a.writeJSON(checkPlanAreasReport{PassportID: *id, Problems: output.Problems, Checks: checks}); err != nil {
+		return err
+	}
+
+	if output.Problems > 0 {
+		return validationFailedError{count: 1}
+	}
+	return nil
+}
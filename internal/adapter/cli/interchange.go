@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// runExportJSON выгружает паспорта в формат обмена:
+// techpassport-cli export-json (-id ID ... | -all) [-bundle] [-out passports.json]
+func (a *App) runExportJSON(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("export-json")
+	var ids idList
+	fs.Var(&ids, "id", "ID паспорта (можно повторять)")
+	all := fs.Bool("all", false, "выгрузить все паспорта")
+	bundle := fs.Bool("bundle", false, "выгрузить архив ZIP с файлами вложений")
+	out := fs.String("out", "-", "файл документа (- для stdout)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *all == (len(ids) > 0) {
+		return usageError{message: "укажите -id или -all"}
+	}
+
+	input := passport.ExportInterchangeInput{PassportIDs: ids, All: *all}
+	var output *passport.ExportInterchangeOutput
+	var err error
+	if *bundle {
+		output, err = a.exportBundleUC.Execute(ctx, input)
+	} else {
+		output, err = a.exportJSONUC.Execute(ctx, input)
+	}
+	if err != nil {
+		return err
+	}
+
+	if *out == "-" {
+		// Архив выводится как есть, документ JSON - с переводом строки
+		if *bundle {
+			_, err := a.stdout.Write(output.Data)
+			return err
+		}
+		_, err := a.stdout.Write(append(output.Data, '\n'))
+		return err
+	}
+
+	if err := os.WriteFile(*out, output.Data, 0o644); err != nil {
+		return fmt.Errorf("failed to write output: %w", err)
+	}
+	fmt.Fprintf(a.stderr, "выгружено паспортов: %d\n", output.Count)
+
+	return nil
+}
+
+// importJSONReport результат загрузки документа формата обмена
+type importJSONReport struct {
+	SourceVersion int                      `json:"source_version"`
+	Results       []importJSONResult       `json:"results,omitempty"`
+	Errors        []entity.ValidationError `json:"errors,omitempty"`
+}
+
+// importJSONResult итог загрузки паспорта
+type importJSONResult struct {
+	SourceID   string `json:"source_id"`
+	PassportID string `json:"passport_id"`
+	Action     string `json:"action"`
+}
+
+// runImportJSON загружает паспорта из формата обмена:
+// techpassport-cli import-json [-in passports.json|bundle.techpassport.zip] [-conflict skip|overwrite|new] [-keep-status]
+func (a *App) runImportJSON(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("import-json")
+	in := fs.String("in", "-", "документ формата обмена или архив с вложениями (- для stdin)")
+	conflict := fs.String("conflict", string(passport.ConflictSkip), "при совпадении ID: skip, overwrite или new")
+	keepStatus := fs.Bool("keep-status", false, "сохранить статусы паспортов из документа")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	strategy := passport.ConflictStrategy(*conflict)
+	if !strategy.IsValid() {
+		return usageError{message: "-conflict: допустимые значения skip, overwrite, new"}
+	}
+
+	data, err := a.readInput(*in)
+	if err != nil {
+		return err
+	}
+
+	output, err := a.importJSONUC.Execute(ctx, passport.ImportInterchangeInput{
+		Data:       data,
+		Conflict:   strategy,
+		KeepStatus: *keepStatus,
+	})
+
+	var problems entity.ValidationErrors
+	if errors.As(err, &problems) {
+		if writeErr := a.writeJSON(importJSONReport{Errors: problems}); writeErr != nil {
+			return writeErr
+		}
+		return validationFailedError{count: countPassports(problems)}
+	}
+
+	// При ошибке записи output содержит уже загруженные паспорта
+	if output != nil {
+		report := importJSONReport{SourceVersion: output.SourceVersion}
+		for _, r := range output.Results {
+			report.Results = append(report.Results, importJSONResult{
+				SourceID:   r.SourceID,
+				PassportID: r.PassportID,
+				Action:     string(r.Action),
+			})
+		}
+		if writeErr := a.writeJSON(report); writeErr != nil {
+			return writeErr
+		}
+	}
+
+	return err
+}
+
+// runSchema выводит JSON Schema формата обмена: techpassport-cli schema
+func (a *App) runSchema(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("schema")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	_, err := a.stdout.Write(a.codec.Schema())
+	return err
+}
+
+// countPassports считает паспорта, к которым относятся ошибки вида passports[i].field
+func countPassports(problems entity.ValidationErrors) int {
+	seen := map[string]bool{}
+	for _, p := range problems {
+		prefix, _, _ := strings.Cut(p.Field, "]")
+		seen[prefix] = true
+	}
+	return len(seen)
+}
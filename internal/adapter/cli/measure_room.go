@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// measureRoomReport результат расчета площади помещения по обмеру
+type measureRoomReport struct {
+	PassportID   string              `json:"passport_id,omitempty"`
+	RoomIndex    *int                `json:"room_index,omitempty"`
+	Area         float64             `json:"area"`
+	PreviousArea float64             `json:"previous_area,omitempty"`
+	Shapes       []service.ShapeArea `json:"shapes"`
+	Warnings     []string            `json:"warnings,omitempty"`
+	DryRun       bool                `json:"dry_run,omitempty"`
+}
+
+// runMeasureRoom рассчитывает площадь помещения по обмеру из JSON и записывает ее в экспликацию:
+// techpassport-cli measure-room -id ID -index N [-in FILE] [-dry-run]
+func (a *App) runMeasureRoom(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("measure-room")
+	id := fs.String("id", "", "ID паспорта")
+	index := fs.Int("index", -1, "индекс помещения в экспликации (с 0)")
+	in := fs.String("in", "-", "JSON обмера помещения (- для stdin)")
+	dryRun := fs.Bool("dry-run", false, "только рассчитать площадь, не изменяя паспорт")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	var measurements entity.RoomMeasurements
+	if !*dryRun {
+		if err := requireFlag("id", *id); err != nil {
+			return err
+		}
+		if *index < 0 {
+			return usageError{message: "укажите -index"}
+		}
+	}
+	if err := a.readJSON(*in, &measurements); err != nil {
+		return err
+	}
+
+	if *dryRun {
+		output, err := a.calculateAreaUC.Execute(ctx, passport.CalculateRoomAreaInput{Measurements: measurements})
+		if err != nil {
+			return err
+		}
+		return a.writeJSON(measureRoomReport{
+			Area:     output.Calculation.Area,
+			Shapes:   output.Calculation.Shapes,
+			Warnings: output.Calculation.Warnings,
+			DryRun:   true,
+		})
+	}
+
+	output, err := a.measureRoomUC.Execute(ctx, passport.MeasureRoomInput{
+		PassportID:   *id,
+		RoomIndex:    *index,
+		Measurements: measurements,
+	})
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(measureRoomReport{
+		PassportID:   output.Passport.ID,
+		RoomIndex:    index,
+		Area:         output.Calculation.Area,
+		PreviousArea: output.PreviousArea,
+		Shapes:       output.Calculation.Shapes,
+		Warnings:     output.Calculation.Warnings,
+	})
+}
@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/outbox"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/file"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+)
+
+// requeueReport результат возврата недоставленных сообщений в очередь
+type requeueReport struct {
+	Requeued []string `json:"requeued"`
+}
+
+// eventOutbox возвращает outbox каталога данных; первый вызов включает его:
+// с этого момента все программы с тем же каталогом записывают события
+func (a *App) eventOutbox() *file.JSONOutbox {
+	if a.outbox == nil {
+		a.outbox = file.NewJSONOutbox(a.passports)
+	}
+	return a.outbox
+}
+
+// runRelayOutbox доставляет ожидающие события получателям и webhook организаций
+// один раз (для cron):
+// techpassport-cli relay-outbox [-webhook URL] [-nats host:port] [-events-file events.jsonl]
+func (a *App) runRelayOutbox(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("relay-outbox")
+	var sinks outbox.SinkConfig
+	fs.StringVar(&sinks.Webhook, "webhook", "", "адрес webhook")
+	fs.StringVar(&sinks.NATS, "nats", "", "адрес сервера NATS (host:port)")
+	fs.StringVar(&sinks.NATSSubject, "nats-subject", outbox.DefaultNATSSubject, "префикс темы NATS")
+	fs.StringVar(&sinks.File, "events-file", "", "файл JSON Lines для событий")
+	attempts := fs.Int("max-attempts", 0, "число попыток до переноса в недоставленные (0 - 8)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := access.Authorize(ctx, entity.PermissionManageEvents); err != nil {
+		return err
+	}
+
+	// Webhook организаций получают события вместе с заданными получателями
+	receivers := sinks.Sinks()
+	if file.WebhooksEnabled(a.dataDir) {
+		receivers = append(receivers, outbox.NewWebhookDispatcher(a.webhooks, nil))
+	}
+	if len(receivers) == 0 {
+		return usageError{message: "укажите получателя: -webhook, -nats или -events-file"}
+	}
+
+	relay := outbox.NewRelay(a.eventOutbox(), receivers, outbox.Options{MaxAttempts: *attempts})
+	stats, err := relay.RunOnce(ctx)
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(stats)
+}
+
+// runOutboxDead выводит недоставленные события или возвращает их в очередь:
+// techpassport-cli outbox-dead [-requeue ID|all]
+func (a *App) runOutboxDead(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("outbox-dead")
+	requeue := fs.String("requeue", "", "вернуть в очередь сообщение с ID или все (all)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := access.Authorize(ctx, entity.PermissionManageEvents); err != nil {
+		return err
+	}
+
+	dead, err := a.eventOutbox().DeadLetters(ctx)
+	if err != nil {
+		return err
+	}
+	if *requeue == "" {
+		return a.writeJSON(dead)
+	}
+
+	report := requeueReport{Requeued: []string{}}
+	for _, message := range dead {
+		if *requeue != "all" && message.ID != *requeue {
+			continue
+		}
+		if err := a.outbox.Requeue(ctx, message.ID); err != nil {
+			return err
+		}
+		report.Requeued = append(report.Requeued, message.ID)
+	}
+	if *requeue != "all" && len(report.Requeued) == 0 {
+		return fmt.Errorf("dead letter %s %w", *requeue, repository.ErrNotFound)
+	}
+
+	return a.writeJSON(report)
+}
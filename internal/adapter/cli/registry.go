@@ -0,0 +1,160 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/registry"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// importCSVReport итог загрузки реестра
+type importCSVReport struct {
+	DryRun       bool             `json:"dry_run,omitempty"`
+	ResumedAfter int              `json:"resumed_after,omitempty"`
+	Created      int              `json:"created"`
+	Updated      int              `json:"updated"`
+	Skipped      int              `json:"skipped"`
+	Failed       int              `json:"failed"`
+	Errors       []importCSVEntry `json:"errors,omitempty"`
+}
+
+// importCSVEntry итог записи реестра в построчном отчете
+type importCSVEntry struct {
+	Line       int                      `json:"line"`
+	LastLine   int                      `json:"last_line,omitempty"`
+	Key        string                   `json:"key,omitempty"`
+	Action     string                   `json:"action"`
+	PassportID string                   `json:"passport_id,omitempty"`
+	Errors     []entity.ValidationError `json:"errors,omitempty"`
+}
+
+// runImportCSV загружает паспорта из CSV реестра старой системы:
+// techpassport-cli import-csv -in FILE -mapping mapping.json [-duplicates skip|update|create]
+// [-dry-run] [-state FILE] [-report FILE]
+func (a *App) runImportCSV(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("import-csv")
+	in := fs.String("in", "", "CSV файл реестра")
+	mappingFile := fs.String("mapping", "", "JSON описание столбцов реестра")
+	duplicates := fs.String("duplicates", string(passport.DuplicateSkip), "при совпадении адреса: skip, update или create")
+	dryRun := fs.Bool("dry-run", false, "проверить реестр без записи паспортов")
+	state := fs.String("state", "", "файл позиции импорта для продолжения после прерывания")
+	reportFile := fs.String("report", "", "файл построчного отчета (JSON Lines)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("in", *in); err != nil {
+		return err
+	}
+	if err := requireFlag("mapping", *mappingFile); err != nil {
+		return err
+	}
+
+	strategy := passport.DuplicateStrategy(*duplicates)
+	if !strategy.IsValid() {
+		return usageError{message: "-duplicates: допустимые значения skip, update, create"}
+	}
+
+	var mapping service.RegistryMapping
+	if err := a.readJSON(*mappingFile, &mapping); err != nil {
+		return err
+	}
+
+	source, err := os.Open(*in)
+	if err != nil {
+		return fmt.Errorf("failed to open input: %w", err)
+	}
+	defer source.Close()
+
+	info, err := source.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat input: %w", err)
+	}
+
+	input := passport.ImportRegistryInput{
+		Source: source,
+		// Позиция импорта применяется только к тому же файлу того же размера
+		SourceName: fmt.Sprintf("%s (%d байт)", filepath.Base(*in), info.Size()),
+		Mapping:    mapping,
+		Duplicates: strategy,
+		DryRun:     *dryRun,
+	}
+	if *state != "" {
+		input.Checkpoint = registry.NewFileCheckpoint(*state)
+	}
+
+	var report importCSVReport
+	if *reportFile != "" {
+		// При продолжении импорта отчет дописывается
+		f, err := os.OpenFile(*reportFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return fmt.Errorf("failed to open report: %w", err)
+		}
+		defer f.Close()
+
+		encoder := json.NewEncoder(f)
+		input.OnRow = func(r passport.RegistryRowResult) error {
+			return encoder.Encode(newImportCSVEntry(r))
+		}
+	} else {
+		input.OnRow = func(r passport.RegistryRowResult) error {
+			if r.Action == passport.RegistryActionFailed {
+				report.Errors = append(report.Errors, newImportCSVEntry(r))
+			}
+			return nil
+		}
+	}
+
+	// Прерывание (Ctrl+C) завершает импорт после текущей записи
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	output, err := a.importRegistryUC.Execute(ctx, input)
+	if output == nil {
+		return err
+	}
+
+	report.DryRun = output.DryRun
+	report.ResumedAfter = output.ResumedAfter
+	report.Created = output.Created
+	report.Updated = output.Updated
+	report.Skipped = output.Skipped
+	report.Failed = output.Failed
+	if writeErr := a.writeJSON(report); writeErr != nil {
+		return writeErr
+	}
+
+	if errors.Is(err, context.Canceled) && *state != "" {
+		return fmt.Errorf("import interrupted, run the command again with the same -state to resume: %w", err)
+	}
+	if err != nil {
+		return err
+	}
+	if output.Failed > 0 {
+		return validationFailedError{count: output.Failed}
+	}
+	return nil
+}
+
+// newImportCSVEntry преобразует итог записи реестра для отчета
+func newImportCSVEntry(r passport.RegistryRowResult) importCSVEntry {
+	entry := importCSVEntry{
+		Line:       r.Line,
+		Key:        r.Key,
+		Action:     string(r.Action),
+		PassportID: r.PassportID,
+		Errors:     r.Errors,
+	}
+	if r.LastLine != r.Line {
+		entry.LastLine = r.LastLine
+	}
+	return entry
+}
@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/report"
+)
+
+// runReport формирует отчет по реестру паспортов за месяц или период:
+// techpassport-cli report [-month 2026-09 | -from 2026-09-01 -to 2026-09-30]
+// [-type T1,T2] [-org NAME] [-format json|xlsx|pdf|docx] [-out DIR]
+func (a *App) runReport(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("report")
+	month := fs.String("month", "", "месяц отчета ГГГГ-ММ")
+	fromFlag := fs.String("from", "", "начало периода ГГГГ-ММ-ДД")
+	toFlag := fs.String("to", "", "конец периода ГГГГ-ММ-ДД включительно")
+	types := fs.String("type", "", "типы объектов через запятую")
+	org := fs.String("org", "", "организация, составившая паспорта")
+	format := fs.String("format", "json", "формат: json (в stdout), xlsx, pdf или docx")
+	out := fs.String("out", ".", "каталог для документа отчета")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	input, err := reportInput(*month, *fromFlag, *toFlag)
+	if err != nil {
+		return err
+	}
+
+	var specs []repository.PassportSpecification
+	if list := splitList(*types); len(list) > 0 {
+		objectTypes := make([]entity.ObjectType, 0, len(list))
+		for _, t := range list {
+			objectTypes = append(objectTypes, entity.ObjectType(t))
+		}
+		specs = append(specs, repository.ByObjectType(objectTypes...))
+	}
+	if *org != "" {
+		specs = append(specs, repository.ByOrganization(*org))
+	}
+	if len(specs) > 0 {
+		input.Where = repository.And(specs...)
+	}
+
+	if *format == "json" {
+		output, err := a.reportUC.Execute(ctx, input)
+		if err != nil {
+			return err
+		}
+		return a.writeJSON(output.Report)
+	}
+
+	output, err := a.exportReportUC.Execute(ctx, report.ExportRegistryReportInput{
+		RegistryReportInput: input,
+		Format:              service.DocumentFormat(*format),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+	path := filepath.Join(*out, output.FileName)
+	if err := os.WriteFile(path, output.Document, 0o644); err != nil {
+		return fmt.Errorf("failed to write output: %w", err)
+	}
+	fmt.Fprintln(a.stdout, path)
+
+	return nil
+}
+
+// reportInput период отчета из флагов -month или -from/-to
+func reportInput(month, from, to string) (report.RegistryReportInput, error) {
+	var input report.RegistryReportInput
+
+	if month != "" {
+		if from != "" || to != "" {
+			return input, usageError{message: "укажите -month или -from/-to"}
+		}
+		t, err := time.ParseInLocation("2006-01", month, time.Local)
+		if err != nil {
+			return input, usageError{message: "-month должен быть в формате ГГГГ-ММ"}
+		}
+		input.From, input.To = report.MonthPeriod(t)
+		return input, nil
+	}
+
+	if from != "" {
+		t, err := time.ParseInLocation("2006-01-02", from, time.Local)
+		if err != nil {
+			return input, usageError{message: "-from должен быть в формате ГГГГ-ММ-ДД"}
+		}
+		input.From = t
+	}
+	if to != "" {
+		t, err := time.ParseInLocation("2006-01-02", to, time.Local)
+		if err != nil {
+			return input, usageError{message: "-to должен быть в формате ГГГГ-ММ-ДД"}
+		}
+		// Конец периода включительно: паспорта до конца дня
+		input.To = t.AddDate(0, 0, 1)
+	}
+
+	return input, nil
+}
@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// xmlReport отчет о проверке XML технического плана
+type xmlReport struct {
+	PassportID string     `json:"passport_id,omitempty"`
+	File       string     `json:"file,omitempty"`
+	Schema     string     `json:"schema"`
+	Official   bool       `json:"official"`
+	Valid      bool       `json:"valid"`
+	Missing    []xmlIssue `json:"missing,omitempty"`
+	Errors     []xmlIssue `json:"errors,omitempty"`
+	Warnings   []string   `json:"warnings,omitempty"`
+}
+
+// xmlIssue замечание к полю технического плана
+type xmlIssue struct {
+	Path       string `json:"path"`
+	ModelField string `json:"model_field,omitempty"`
+	Message    string `json:"message"`
+}
+
+// runExportXML выгружает паспорт в XML технического плана:
+// techpassport-cli export-xml -id ID [-out DIR] [-strict]
+func (a *App) runExportXML(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("export-xml")
+	id := fs.String("id", "", "ID паспорта")
+	out := fs.String("out", ".", "каталог для XML")
+	strict := fs.Bool("strict", false, "не сохранять XML, не прошедший проверку по схеме (код 3)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *id == "" {
+		return usageError{message: "укажите -id"}
+	}
+
+	output, err := a.exportXMLUC.Execute(ctx, passport.ExportRosreestrInput{PassportID: *id})
+	if err != nil {
+		return err
+	}
+
+	report := toXMLReport(output.Report)
+	report.PassportID = *id
+
+	if output.Report.Valid || !*strict {
+		if err := os.MkdirAll(*out, 0o755); err != nil {
+			return fmt.Errorf("failed to create output directory: %w", err)
+		}
+		path := filepath.Join(*out, output.FileName)
+		if err := os.WriteFile(path, output.Document, 0o644); err != nil {
+			return fmt.Errorf("failed to write output: %w", err)
+		}
+		report.File = path
+	}
+
+	if err := a.writeJSON(report); err != nil {
+		return err
+	}
+
+	if *strict && !output.Report.Valid {
+		return validationFailedError{count: 1}
+	}
+	return nil
+}
+
+// runValidateXML проверяет готовый XML технического плана по схеме:
+// techpassport-cli validate-xml [-in FILE]
+func (a *App) runValidateXML(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("validate-xml")
+	in := fs.String("in", "-", "XML технического плана (- для stdin)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	data, err := a.readInput(*in)
+	if err != nil {
+		return err
+	}
+
+	result, err := a.rosreestr.Validate(ctx, data)
+	if err != nil {
+		return err
+	}
+
+	if err := a.writeJSON(toXMLReport(*result)); err != nil {
+		return err
+	}
+
+	if !result.Valid {
+		return validationFailedError{count: 1}
+	}
+	return nil
+}
+
+// runXMLFields перечисляет обязательные поля схемы, которых нет в модели паспорта,
+// и сведения паспорта, для которых нет места в схеме: techpassport-cli xml-fields
+func (a *App) runXMLFields(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("xml-fields")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	issues, err := a.rosreestr.UnmappedFields()
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(toXMLIssues(issues))
+}
+
+// toXMLReport преобразует отчет о проверке в DTO
+func toXMLReport(r service.RosreestrReport) xmlReport {
+	return xmlReport{
+		Schema:   r.Schema,
+		Official: r.Official,
+		Valid:    r.Valid,
+		Missing:  toXMLIssues(r.Missing),
+		Errors:   toXMLIssues(r.Errors),
+		Warnings: r.Warnings,
+	}
+}
+
+// toXMLIssues преобразует замечания в DTO
+func toXMLIssues(issues []service.RosreestrIssue) []xmlIssue {
+	result := make([]xmlIssue, 0, len(issues))
+	for _, issue := range issues {
+		result = append(result, xmlIssue{Path: issue.Path, ModelField: issue.ModelField, Message: issue.Message})
+	}
+	return result
+}
@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// searchReport результат команды search
+type searchReport struct {
+	Total     int                     `json:"total"`
+	Offset    int                     `json:"offset"`
+	Limit     int                     `json:"limit"`
+	Passports []passportSummary       `json:"passports"`
+	Facets    repository.SearchFacets `json:"facets"`
+}
+
+// runSearch ищет паспорта по тексту и фасетным фильтрам:
+// techpassport-cli search [-q "ленина 12"] [-type apartment,room] [-org "ГУП БТИ"] [-status draft]
+// [-year-from 1950] [-year-to 1980] [-area-from 30] [-area-to 100] [-sort area -desc] [-offset 0] [-limit 50]
+func (a *App) runSearch(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("search")
+	text := fs.String("q", "", "слова для поиска по адресу, правообладателям, номерам и наименованиям зданий")
+	types := fs.String("type", "", "типы объекта через запятую")
+	orgs := fs.String("org", "", "организации через запятую")
+	statuses := fs.String("status", "", "статусы через запятую (draft, approved, archived)")
+	yearFrom := fs.Int("year-from", 0, "год постройки не ранее")
+	yearTo := fs.Int("year-to", 0, "год постройки не позднее")
+	areaFrom := fs.Float64("area-from", 0, "общая площадь не менее, кв.м")
+	areaTo := fs.Float64("area-to", 0, "общая площадь не более, кв.м")
+	sortField := fs.String("sort", "", "сортировка: relevance, id, address, created, updated, year, area")
+	desc := fs.Bool("desc", false, "сортировать по убыванию")
+	offset := fs.Int("offset", 0, "число пропускаемых паспортов")
+	limit := fs.Int("limit", 0, "размер страницы (0 - 50)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	query := repository.PassportQuery{
+		Text:          *text,
+		Organizations: splitList(*orgs),
+		YearFrom:      *yearFrom,
+		YearTo:        *yearTo,
+		AreaFrom:      *areaFrom,
+		AreaTo:        *areaTo,
+		Sort:          repository.SortField(*sortField),
+		Descending:    *desc,
+		Offset:        *offset,
+		Limit:         *limit,
+	}
+	for _, t := range splitList(*types) {
+		query.ObjectTypes = append(query.ObjectTypes, entity.ObjectType(t))
+	}
+	for _, s := range splitList(*statuses) {
+		query.Statuses = append(query.Statuses, entity.PassportStatus(s))
+	}
+
+	output, err := a.searchUC.Execute(ctx, passport.SearchPassportsInput{Query: query})
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(searchReport{
+		Total:     output.Total,
+		Offset:    output.Offset,
+		Limit:     output.Limit,
+		Passports: summarize(output.Passports),
+		Facets:    output.Facets,
+	})
+}
+
+// splitList разбирает значения флага через запятую
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
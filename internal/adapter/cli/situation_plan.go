@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// situationPlanReport результат сохранения ситуационного плана
+type situationPlanReport struct {
+	PassportID string `json:"passport_id"`
+	*passport.SituationPlanSummary
+}
+
+// runSetSituationPlan заменяет ситуационный план планом из JSON:
+// techpassport-cli set-situation-plan -id ID [-in FILE]
+func (a *App) runSetSituationPlan(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("set-situation-plan")
+	id := fs.String("id", "", "ID паспорта")
+	in := fs.String("in", "-", "JSON ситуационного плана (- для stdin)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+
+	var plan entity.SituationPlan
+	if err := a.readJSON(*in, &plan); err != nil {
+		return err
+	}
+
+	output, err := a.saveSituationPlanUC.Execute(ctx, passport.SaveSituationPlanInput{PassportID: *id, Plan: plan})
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(situationPlanReport{PassportID: output.Passport.ID, SituationPlanSummary: output.Summary})
+}
+
+// runImportPlot загружает границу участка или контур здания из каталога координат:
+// techpassport-cli import-plot -id ID -in FILE [-format csv|dxf] [-litera А] [-list N]
+// [-cs msk|wgs84] [-zone "МСК-50, зона 2"]
+func (a *App) runImportPlot(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("import-plot")
+	id := fs.String("id", "", "ID паспорта")
+	in := fs.String("in", "", "файл координат CSV или DXF")
+	format := fs.String("format", "", "формат файла: csv или dxf (по умолчанию по расширению)")
+	litera := fs.String("litera", "", "литера здания; без литеры загружается граница участка")
+	list := fs.Int("list", 0, "номер полилинии или списка точек в файле (с 0)")
+	cs := fs.String("cs", "", "система координат нового плана: msk или wgs84")
+	zone := fs.String("zone", "", "наименование МСК")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+	if err := requireFlag("in", *in); err != nil {
+		return err
+	}
+
+	coordinateFormat := service.CoordinateFormat(*format)
+	if *format == "" {
+		coordinateFormat = service.CoordinateFormat(strings.TrimPrefix(strings.ToLower(filepath.Ext(*in)), "."))
+	}
+	if !coordinateFormat.IsValid() {
+		return usageError{message: "-format: допустимые значения csv, dxf"}
+	}
+
+	source, err := os.Open(*in)
+	if err != nil {
+		return fmt.Errorf("failed to open input: %w", err)
+	}
+	defer source.Close()
+
+	output, err := a.importPlotUC.Execute(ctx, passport.ImportPlotCoordinatesInput{
+		PassportID:       *id,
+		Source:           source,
+		Format:           coordinateFormat,
+		Litera:           *litera,
+		List:             *list,
+		CoordinateSystem: entity.CoordinateSystem(*cs),
+		Zone:             *zone,
+	})
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(situationPlanReport{PassportID: output.Passport.ID, SituationPlanSummary: output.Summary})
+}
+
+// runRenderSituationPlan отрисовывает ситуационный план в SVG или PNG:
+// techpassport-cli render-situation-plan -id ID [-format svg|png] [-out FILE]
+func (a *App) runRenderSituationPlan(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("render-situation-plan")
+	id := fs.String("id", "", "ID паспорта")
+	format := fs.String("format", string(service.PlanFormatSVG), "формат изображения: svg или png")
+	out := fs.String("out", "-", "файл изображения (- для stdout)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+
+	imageFormat := service.PlanImageFormat(*format)
+	if !imageFormat.IsValid() {
+		return usageError{message: "-format: допустимые значения svg, png"}
+	}
+
+	output, err := a.renderSituationUC.Execute(ctx, passport.RenderSituationPlanInput{
+		PassportID: *id,
+		Format:     imageFormat,
+	})
+	if err != nil {
+		return err
+	}
+
+	if *out == "-" {
+		_, err = a.stdout.Write(output.Data)
+		return err
+	}
+	if err := os.WriteFile(*out, output.Data, 0o644); err != nil {
+		return fmt.Errorf("failed to write output: %w", err)
+	}
+	fmt.Fprintln(a.stdout, *out)
+
+	return nil
+}
+
+// runRemoveSituationPlan удаляет векторный ситуационный план:
+// techpassport-cli remove-situation-plan -id ID
+func (a *App) runRemoveSituationPlan(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("remove-situation-plan")
+	id := fs.String("id", "", "ID паспорта")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+
+	_, err := a.removeSituationUC.Execute(ctx, passport.RemoveSituationPlanInput{PassportID: *id})
+	return err
+}
@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// importXLSXReport результат загрузки таблицы из XLSX
+type importXLSXReport struct {
+	PassportID string                   `json:"passport_id"`
+	Table      string                   `json:"table"`
+	Imported   int                      `json:"imported"`
+	Skipped    []entity.ValidationError `json:"skipped,omitempty"`
+	Errors     []entity.ValidationError `json:"errors,omitempty"`
+}
+
+// runExportXLSX выгружает экспликацию и состав объекта в XLSX:
+// techpassport-cli export-xlsx -id ID [-out DIR]
+func (a *App) runExportXLSX(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("export-xlsx")
+	id := fs.String("id", "", "ID паспорта")
+	out := fs.String("out", ".", "каталог для XLSX")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *id == "" {
+		return usageError{message: "укажите -id"}
+	}
+
+	output, err := a.exportTablesUC.Execute(ctx, passport.ExportTablesInput{PassportID: *id})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+	path := filepath.Join(*out, output.FileName)
+	if err := os.WriteFile(path, output.Document, 0o644); err != nil {
+		return fmt.Errorf("failed to write output: %w", err)
+	}
+	fmt.Fprintln(a.stdout, path)
+
+	return nil
+}
+
+// runImportXLSX загружает экспликацию или состав объекта из XLSX:
+// techpassport-cli import-xlsx -id ID -in FILE [-table explication|buildings] [-sheet NAME]
+// [-header N] [-map field=COL,...] [-append] [-skip-invalid]
+func (a *App) runImportXLSX(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("import-xlsx")
+	id := fs.String("id", "", "ID паспорта")
+	in := fs.String("in", "-", "книга XLSX (- для stdin)")
+	table := fs.String("table", string(passport.TableExplication), "таблица паспорта: explication или buildings")
+	sheet := fs.String("sheet", "", "имя листа (по умолчанию первый лист с заголовком таблицы)")
+	header := fs.Int("header", 0, "номер строки заголовка (0 - определить автоматически)")
+	mapFlag := fs.String("map", "", "сопоставление полей столбцам, например area=E,floor=B (по умолчанию по заголовку)")
+	appendRows := fs.Bool("append", false, "добавить строки к таблице паспорта вместо замены")
+	skipInvalid := fs.Bool("skip-invalid", false, "загрузить корректные строки, пропустив строки с ошибками")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *id == "" {
+		return usageError{message: "укажите -id"}
+	}
+
+	kind := passport.TableKind(*table)
+	if !kind.IsValid() {
+		return usageError{message: "-table: допустимые значения explication, buildings"}
+	}
+
+	mapping, err := parseMapping(kind, *mapFlag)
+	if err != nil {
+		return err
+	}
+
+	data, err := a.readInput(*in)
+	if err != nil {
+		return err
+	}
+
+	output, err := a.importTableUC.Execute(ctx, passport.ImportTableInput{
+		PassportID:  *id,
+		Data:        data,
+		Table:       kind,
+		Sheet:       *sheet,
+		HeaderRow:   *header,
+		Mapping:     mapping,
+		Append:      *appendRows,
+		SkipInvalid: *skipInvalid,
+	})
+
+	var problems entity.ValidationErrors
+	if errors.As(err, &problems) {
+		if writeErr := a.writeJSON(importXLSXReport{PassportID: *id, Table: *table, Errors: problems}); writeErr != nil {
+			return writeErr
+		}
+		return validationFailedError{count: 1}
+	}
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(importXLSXReport{
+		PassportID: *id,
+		Table:      *table,
+		Imported:   output.Imported,
+		Skipped:    output.Skipped,
+	})
+}
+
+// parseMapping разбирает значение флага -map; столбец задается буквой (A, AB)
+// или номером с 1. Пустое значение означает сопоставление по заголовку.
+func parseMapping(kind passport.TableKind, value string) (passport.ColumnMapping, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+
+	known := map[string]bool{}
+	for _, f := range passport.TableFields(kind) {
+		known[f.Key] = true
+	}
+
+	mapping := passport.ColumnMapping{}
+	for _, pair := range strings.Split(value, ",") {
+		key, col, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || !known[key] {
+			return nil, usageError{message: fmt.Sprintf("-map: неизвестное поле в %q", pair)}
+		}
+
+		index, err := columnIndex(col)
+		if err != nil {
+			return nil, usageError{message: fmt.Sprintf("-map: некорректный столбец в %q", pair)}
+		}
+		mapping[key] = index
+	}
+
+	return mapping, nil
+}
+
+// columnIndex переводит обозначение столбца (буквы или номер с 1) в номер с нуля
+func columnIndex(col string) (int, error) {
+	col = strings.ToUpper(strings.TrimSpace(col))
+	if n, err := strconv.Atoi(col); err == nil {
+		if n < 1 {
+			return 0, fmt.Errorf("invalid column %q", col)
+		}
+		return n - 1, nil
+	}
+
+	if col == "" {
+		return 0, fmt.Errorf("empty column")
+	}
+	n := 0
+	for _, r := range col {
+		if r < 'A' || r > 'Z' {
+			return 0, fmt.Errorf("invalid column %q", col)
+		}
+		n = n*26 + int(r-'A'+1)
+	}
+	return n - 1, nil
+}
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// assessWearReport результат описания конструктивных элементов
+type assessWearReport struct {
+	PassportID string                     `json:"passport_id"`
+	Litera     string                     `json:"litera"`
+	Elements   []entity.StructuralElement `json:"elements"`
+	Assessment *entity.WearAssessment     `json:"assessment"`
+	Warnings   []string                   `json:"warnings,omitempty"`
+	DryRun     bool                       `json:"dry_run,omitempty"`
+}
+
+// runAssessWear описывает конструктивные элементы здания и рассчитывает износ:
+// techpassport-cli assess-wear -id ID -litera А [-in elements.json | -recalculate]
+// [-table ВЕРСИЯ] [-dry-run]
+func (a *App) runAssessWear(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("assess-wear")
+	id := fs.String("id", "", "ID паспорта")
+	litera := fs.String("litera", "", "литера здания")
+	in := fs.String("in", "-", "JSON-массив конструктивных элементов (- для stdin)")
+	recalculate := fs.Bool("recalculate", false, "пересчитать износ по сохраненному описанию элементов")
+	table := fs.String("table", "", "версия справочника удельных весов (по умолчанию действующая)")
+	dryRun := fs.Bool("dry-run", false, "только рассчитать, не изменяя паспорт")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+	if err := requireFlag("litera", *litera); err != nil {
+		return err
+	}
+
+	var elements []entity.StructuralElement
+	if !*recalculate {
+		elements = []entity.StructuralElement{}
+		if err := a.readJSON(*in, &elements); err != nil {
+			return err
+		}
+	}
+
+	output, err := a.assessWearUC.Execute(ctx, passport.AssessWearInput{
+		PassportID:   *id,
+		Litera:       *litera,
+		Elements:     elements,
+		TableVersion: *table,
+		DryRun:       *dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(assessWearReport{
+		PassportID: output.Passport.ID,
+		Litera:     *litera,
+		Elements:   output.Elements,
+		Assessment: output.Assessment,
+		Warnings:   output.Warnings,
+		DryRun:     *dryRun,
+	})
+}
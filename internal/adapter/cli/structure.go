@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// structureReport результат добавления или замены сооружения
+type structureReport struct {
+	PassportID string           `json:"passport_id"`
+	Structure  entity.Structure `json:"structure"`
+	Replaced   bool             `json:"replaced"`
+}
+
+// runSetStructure добавляет или заменяет сооружение; протяженность линейного
+// объекта вычисляется по трассе: techpassport-cli set-structure -id ID [-in structure.json]
+func (a *App) runSetStructure(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("set-structure")
+	id := fs.String("id", "", "ID паспорта")
+	in := fs.String("in", "-", "JSON сооружения (- для stdin)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+
+	var structure entity.Structure
+	if err := a.readJSON(*in, &structure); err != nil {
+		return err
+	}
+
+	output, err := a.setStructureUC.Execute(ctx, passport.SetStructureInput{PassportID: *id, Structure: structure})
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(structureReport{
+		PassportID: output.Passport.ID,
+		Structure:  output.Structure,
+		Replaced:   output.Replaced,
+	})
+}
+
+// runRemoveStructure удаляет сооружение: techpassport-cli remove-structure -id ID -litera I
+func (a *App) runRemoveStructure(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("remove-structure")
+	id := fs.String("id", "", "ID паспорта")
+	litera := fs.String("litera", "", "литера сооружения")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+	if err := requireFlag("litera", *litera); err != nil {
+		return err
+	}
+
+	output, err := a.removeStructureUC.Execute(ctx, passport.RemoveStructureInput{PassportID: *id, Litera: *litera})
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(output.Passport)
+}
@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// valueBuildingReport результат расчета стоимости здания
+type valueBuildingReport struct {
+	PassportID    string                    `json:"passport_id"`
+	Litera        string                    `json:"litera"`
+	PreviousValue float64                   `json:"previous_value"`
+	Valuation     *entity.BuildingValuation `json:"valuation"`
+	DryRun        bool                      `json:"dry_run,omitempty"`
+}
+
+// runValueBuilding рассчитывает инвентаризационную стоимость здания или сооружения:
+// techpassport-cli value-building -id ID -litera А [-year 2024] [-region "г. Москва"]
+// [-table ВЕРСИЯ] [-purpose residential] [-wear 35] [-dry-run]
+func (a *App) runValueBuilding(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("value-building")
+	id := fs.String("id", "", "ID паспорта")
+	litera := fs.String("litera", "", "литера здания или сооружения")
+	year := fs.Int("year", 0, "год цен (по умолчанию текущий)")
+	region := fs.String("region", "", "субъект РФ (по умолчанию из адреса паспорта)")
+	table := fs.String("table", "", "версия справочника (по умолчанию действующая)")
+	purpose := fs.String("purpose", "", "код назначения справочника (по умолчанию по наименованию здания)")
+	var wear optionalFloat
+	fs.Var(&wear, "wear", "физический износ по осмотру, % (по умолчанию износ сооружения или по сроку службы)")
+	dryRun := fs.Bool("dry-run", false, "только рассчитать, не изменяя паспорт")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+	if err := requireFlag("litera", *litera); err != nil {
+		return err
+	}
+
+	output, err := a.valueBuildingUC.Execute(ctx, passport.ValueBuildingInput{
+		PassportID:   *id,
+		Litera:       *litera,
+		Year:         *year,
+		Region:       *region,
+		TableVersion: *table,
+		Purpose:      *purpose,
+		WearPercent:  wear.value,
+		DryRun:       *dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(valueBuildingReport{
+		PassportID:    output.Passport.ID,
+		Litera:        *litera,
+		PreviousValue: output.PreviousValue,
+		Valuation:     output.Valuation,
+		DryRun:        *dryRun,
+	})
+}
+
+// runCheckValuations повторяет сохраненные расчеты стоимости по их исходным данным:
+// techpassport-cli check-valuations -id ID
+func (a *App) runCheckValuations(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("check-valuations")
+	id := fs.String("id", "", "ID паспорта")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+
+	output, err := a.verifyValuationsUC.Execute(ctx, passport.VerifyValuationsInput{PassportID: *id})
+	if err != nil {
+		return err
+	}
+
+	if err := a.writeJSON(output.Checks); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, c := range output.Checks {
+		if c.Status != passport.ValuationCheckOK {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return validationFailedError{count: failed}
+	}
+	return nil
+}
+
+// runValuationTables выводит доступные справочники стоимости:
+// techpassport-cli valuation-tables
+func (a *App) runValuationTables(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("valuation-tables")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	tables, err := a.valuer.Tables()
+	if err != nil {
+		return err
+	}
+	return a.writeJSON(tables)
+}
+
+// optionalFloat флаг-число, отличающий отсутствие значения от нуля
+type optionalFloat struct {
+	value *float64
+}
+
+func (f *optionalFloat) String() string {
+	if f.value == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*f.value, 'f', -1, 64)
+}
+
+func (f *optionalFloat) Set(s string) error {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return err
+	}
+	f.value = &v
+	return nil
+}
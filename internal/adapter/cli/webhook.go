@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/webhook"
+)
+
+// removeWebhookReport результат удаления webhook
+type removeWebhookReport struct {
+	Removed string `json:"removed"`
+}
+
+// runWebhookAdd регистрирует webhook организации; с этого момента программы
+// с тем же каталогом данных ведут outbox для уведомлений:
+// techpassport-cli webhook-add -org "ГУП БТИ" -url https://... [-secret KEY] [-actions created,approve]
+func (a *App) runWebhookAdd(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("webhook-add")
+	org := fs.String("org", "", "организация, паспорта которой отслеживаются")
+	url := fs.String("url", "", "адрес webhook")
+	secret := fs.String("secret", "", "ключ подписи HMAC-SHA256 (по умолчанию генерируется)")
+	actions := fs.String("actions", "", "действия журнала изменений через запятую (по умолчанию created,approve,issue,delete)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("org", *org); err != nil {
+		return err
+	}
+	if err := requireFlag("url", *url); err != nil {
+		return err
+	}
+
+	input := webhook.RegisterWebhookInput{Organization: *org, URL: *url, Secret: *secret}
+	for _, action := range strings.Split(*actions, ",") {
+		if action = strings.TrimSpace(action); action != "" {
+			input.Actions = append(input.Actions, action)
+		}
+	}
+
+	output, err := a.registerWebhookUC.Execute(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(output.Webhook)
+}
+
+// runWebhooks выводит зарегистрированные webhook:
+// techpassport-cli webhooks [-org "ГУП БТИ"]
+func (a *App) runWebhooks(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("webhooks")
+	org := fs.String("org", "", "организация (по умолчанию все)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	output, err := a.listWebhooksUC.Execute(ctx, webhook.ListWebhooksInput{Organization: *org})
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(output.Webhooks)
+}
+
+// runWebhookRemove удаляет webhook:
+// techpassport-cli webhook-remove -id WH-...
+func (a *App) runWebhookRemove(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("webhook-remove")
+	id := fs.String("id", "", "ID webhook")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlag("id", *id); err != nil {
+		return err
+	}
+
+	output, err := a.removeWebhookUC.Execute(ctx, webhook.RemoveWebhookInput{WebhookID: *id})
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(removeWebhookReport{Removed: output.WebhookID})
+}
+
+// runWebhookDeliveries выводит журнал доставки уведомлений, новые записи первыми:
+// techpassport-cli webhook-deliveries [-id WH-...] [-limit 100]
+func (a *App) runWebhookDeliveries(ctx context.Context, args []string) error {
+	fs := a.newFlagSet("webhook-deliveries")
+	id := fs.String("id", "", "ID webhook (по умолчанию все)")
+	limit := fs.Int("limit", 0, "число последних записей (0 - 100)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	output, err := a.listDeliveriesUC.Execute(ctx, webhook.ListDeliveriesInput{WebhookID: *id, Limit: *limit})
+	if err != nil {
+		return err
+	}
+
+	return a.writeJSON(output.Deliveries)
+}
@@ -0,0 +1,103 @@
+package grpcapi
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/user"
+)
+
+// UnaryInterceptor аутентифицирует унарные вызовы
+func (s *Server) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := s.authenticate(ctx)
+		if err != nil {
+			return nil, s.toStatus(err)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor аутентифицирует потоковые вызовы
+func (s *Server) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := s.authenticate(ss.Context())
+		if err != nil {
+			return s.toStatus(err)
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticatedStream поток с контекстом, содержащим пользователя
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate проверяет учетные данные Basic из метаданных authorization
+func (s *Server) authenticate(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, entity.AuthenticationError{Message: "требуются метаданные authorization"}
+	}
+
+	login, password, ok := parseBasic(values[0])
+	if !ok {
+		return nil, entity.AuthenticationError{Message: "поддерживается только Basic аутентификация"}
+	}
+
+	output, err := s.loginUC.Execute(ctx, user.LoginInput{Login: login, Password: password})
+	if err != nil {
+		return nil, err
+	}
+
+	return access.WithActor(ctx, output.User), nil
+}
+
+// parseBasic разбирает значение "Basic <base64(login:password)>"
+func parseBasic(value string) (string, string, bool) {
+	const prefix = "basic "
+	if len(value) < len(prefix) || !strings.EqualFold(value[:len(prefix)], prefix) {
+		return "", "", false
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value[len(prefix):]))
+	if err != nil {
+		return "", "", false
+	}
+
+	login, password, ok := strings.Cut(string(decoded), ":")
+	return login, password, ok
+}
+
+// BasicCredentials учетные данные клиента для grpc.WithPerRPCCredentials
+type BasicCredentials struct {
+	Login    string
+	Password string
+
+	// Insecure разрешает передачу без TLS (локальная сеть, тесты)
+	Insecure bool
+}
+
+// GetRequestMetadata формирует метаданные authorization
+func (c BasicCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	token := base64.StdEncoding.EncodeToString([]byte(c.Login + ":" + c.Password))
+	return map[string]string{"authorization": "Basic " + token}, nil
+}
+
+// RequireTransportSecurity требует TLS, если не задан Insecure
+func (c BasicCredentials) RequireTransportSecurity() bool {
+	return !c.Insecure
+}
@@ -0,0 +1,885 @@
+package grpcapi
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/adapter/grpcapi/pb"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// Соответствие перечислений домена и protobuf
+var (
+	objectTypeToPB = map[entity.ObjectType]pb.ObjectType{
+		entity.ObjectTypeResidentialHouse:  pb.ObjectType_OBJECT_TYPE_RESIDENTIAL_HOUSE,
+		entity.ObjectTypeApartmentBuilding: pb.ObjectType_OBJECT_TYPE_APARTMENT_BUILDING,
+		entity.ObjectTypeApartment:         pb.ObjectType_OBJECT_TYPE_APARTMENT,
+		entity.ObjectTypeRoom:              pb.ObjectType_OBJECT_TYPE_ROOM,
+		entity.ObjectTypeNonResidential:    pb.ObjectType_OBJECT_TYPE_NON_RESIDENTIAL,
+	}
+
+	personTypeToPB = map[entity.PersonType]pb.PersonType{
+		entity.PersonTypeIndividual: pb.PersonType_PERSON_TYPE_INDIVIDUAL,
+		entity.PersonTypeLegal:      pb.PersonType_PERSON_TYPE_LEGAL,
+	}
+
+	statusToPB = map[entity.PassportStatus]pb.PassportStatus{
+		entity.PassportStatusDraft:    pb.PassportStatus_PASSPORT_STATUS_DRAFT,
+		entity.PassportStatusApproved: pb.PassportStatus_PASSPORT_STATUS_APPROVED,
+		entity.PassportStatusArchived: pb.PassportStatus_PASSPORT_STATUS_ARCHIVED,
+	}
+
+	openingTypeToPB = map[entity.OpeningType]pb.OpeningType{
+		entity.OpeningDoor:   pb.OpeningType_OPENING_TYPE_DOOR,
+		entity.OpeningWindow: pb.OpeningType_OPENING_TYPE_WINDOW,
+	}
+
+	shapeKindToPB = map[entity.ShapeKind]pb.ShapeKind{
+		entity.ShapeRectangle:  pb.ShapeKind_SHAPE_KIND_RECTANGLE,
+		entity.ShapeTriangle:   pb.ShapeKind_SHAPE_KIND_TRIANGLE,
+		entity.ShapePolygon:    pb.ShapeKind_SHAPE_KIND_POLYGON,
+		entity.ShapeSemicircle: pb.ShapeKind_SHAPE_KIND_SEMICIRCLE,
+	}
+
+	shapeRoleToPB = map[entity.ShapeRole]pb.ShapeRole{
+		entity.ShapeRoleMain:      pb.ShapeRole_SHAPE_ROLE_MAIN,
+		entity.ShapeRoleBayWindow: pb.ShapeRole_SHAPE_ROLE_BAY_WINDOW,
+		entity.ShapeRoleNiche:     pb.ShapeRole_SHAPE_ROLE_NICHE,
+		entity.ShapeRoleDeduction: pb.ShapeRole_SHAPE_ROLE_DEDUCTION,
+	}
+
+	coordinateSystemToPB = map[entity.CoordinateSystem]pb.CoordinateSystem{
+		entity.CoordinateMSK:   pb.CoordinateSystem_COORDINATE_SYSTEM_MSK,
+		entity.CoordinateWGS84: pb.CoordinateSystem_COORDINATE_SYSTEM_WGS84,
+	}
+
+	objectTypeFromPB  = invert(objectTypeToPB)
+	personTypeFromPB  = invert(personTypeToPB)
+	statusFromPB      = invert(statusToPB)
+	openingTypeFromPB = invert(openingTypeToPB)
+
+	shapeKindFromPB = invert(shapeKindToPB)
+	shapeRoleFromPB = invert(shapeRoleToPB)
+
+	coordinateSystemFromPB = invert(coordinateSystemToPB)
+)
+
+// invert строит обратное соответствие
+func invert[K, V comparable](m map[K]V) map[V]K {
+	out := make(map[V]K, len(m))
+	for k, v := range m {
+		out[v] = k
+	}
+	return out
+}
+
+// formatFromPB преобразует формат документа
+func formatFromPB(f pb.DocumentFormat) service.DocumentFormat {
+	if f == pb.DocumentFormat_DOCUMENT_FORMAT_DOCX {
+		return service.FormatDOCX
+	}
+	return service.FormatPDF
+}
+
+// timeToPB преобразует время; нулевое время передается как отсутствующее значение
+func timeToPB(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// timeFromPB преобразует время; отсутствующее значение дает нулевое время
+func timeFromPB(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+// passportToPB преобразует паспорт в сообщение protobuf
+func passportToPB(p *entity.TechnicalPassport) *pb.Passport {
+	msg := &pb.Passport{
+		Id:                p.ID,
+		ObjectType:        objectTypeToPB[p.ObjectType],
+		Address:           addressToPB(p.Address),
+		OrganizationName:  p.OrganizationName,
+		InventoryNumber:   p.InventoryNumber,
+		CadastralNumber:   p.CadastralNumber,
+		Status:            statusToPB[p.Status],
+		CreatedDate:       timeToPB(p.CreatedDate),
+		UpdatedDate:       timeToPB(p.UpdatedDate),
+		AsOfDate:          timeToPB(p.AsOfDate),
+		GeneralInfo:       generalInfoToPB(p.GeneralInfo),
+		SituationPlanPath: p.SituationPlanPath,
+		SituationPlan:     situationPlanToPB(p.SituationPlan),
+		Commercial:        commercialToPB(p.Commercial),
+		Utilities:         utilitiesToPB(p.Utilities),
+	}
+
+	for _, b := range p.Buildings {
+		msg.Buildings = append(msg.Buildings, buildingToPB(b))
+	}
+	for _, st := range p.Structures {
+		msg.Structures = append(msg.Structures, structureToPB(st))
+	}
+	for _, o := range p.Owners {
+		msg.Owners = append(msg.Owners, ownerToPB(o))
+	}
+	for _, r := range p.Explication {
+		msg.Explication = append(msg.Explication, roomToPB(r))
+	}
+	for _, plan := range p.FloorPlans {
+		msg.FloorPlans = append(msg.FloorPlans, floorPlanToPB(plan))
+	}
+	for _, e := range p.Entrances {
+		msg.Entrances = append(msg.Entrances, &pb.Entrance{
+			Number:  e.Number,
+			Section: e.Section,
+			Litera:  e.Litera,
+			Floors:  int32(e.Floors),
+			Note:    e.Note,
+		})
+	}
+	for _, f := range p.Flats {
+		msg.Flats = append(msg.Flats, flatToPB(f))
+	}
+	for _, r := range p.CommonAreas {
+		msg.CommonAreas = append(msg.CommonAreas, roomToPB(r))
+	}
+	for _, a := range p.Attachments {
+		msg.Attachments = append(msg.Attachments, &pb.Attachment{
+			Id:          a.ID,
+			FileName:    a.FileName,
+			MimeType:    a.MIMEType,
+			Size:        a.Size,
+			Description: a.Description,
+			Thumbnail:   a.Thumbnail,
+			AddedDate:   timeToPB(a.AddedDate),
+		})
+	}
+	for _, e := range p.AuditLog {
+		msg.AuditLog = append(msg.AuditLog, &pb.AuditEntry{
+			Timestamp:   timeToPB(e.Timestamp),
+			Action:      e.Action,
+			User:        e.User,
+			Description: e.Description,
+		})
+	}
+
+	return msg
+}
+
+// passportFromPB преобразует сообщение protobuf в паспорт
+func passportFromPB(msg *pb.Passport) *entity.TechnicalPassport {
+	p := &entity.TechnicalPassport{
+		ID:                msg.GetId(),
+		ObjectType:        objectTypeFromPB[msg.GetObjectType()],
+		Address:           addressFromPB(msg.GetAddress()),
+		OrganizationName:  msg.GetOrganizationName(),
+		InventoryNumber:   msg.GetInventoryNumber(),
+		CadastralNumber:   msg.GetCadastralNumber(),
+		Status:            statusFromPB[msg.GetStatus()],
+		CreatedDate:       timeFromPB(msg.GetCreatedDate()),
+		UpdatedDate:       timeFromPB(msg.GetUpdatedDate()),
+		AsOfDate:          timeFromPB(msg.GetAsOfDate()),
+		GeneralInfo:       generalInfoFromPB(msg.GetGeneralInfo()),
+		Buildings:         []entity.Building{},
+		Owners:            []entity.Owner{},
+		SituationPlanPath: msg.GetSituationPlanPath(),
+		SituationPlan:     situationPlanFromPB(msg.GetSituationPlan()),
+		Commercial:        commercialFromPB(msg.GetCommercial()),
+		Utilities:         utilitiesFromPB(msg.GetUtilities()),
+		FloorPlans:        []entity.FloorPlan{},
+		Explication:       []entity.Room{},
+	}
+
+	for _, b := range msg.GetBuildings() {
+		p.Buildings = append(p.Buildings, buildingFromPB(b))
+	}
+	for _, st := range msg.GetStructures() {
+		p.Structures = append(p.Structures, structureFromPB(st))
+	}
+	for _, o := range msg.GetOwners() {
+		p.Owners = append(p.Owners, ownerFromPB(o))
+	}
+	for _, r := range msg.GetExplication() {
+		p.Explication = append(p.Explication, roomFromPB(r))
+	}
+	for _, plan := range msg.GetFloorPlans() {
+		p.FloorPlans = append(p.FloorPlans, floorPlanFromPB(plan))
+	}
+	for _, e := range msg.GetEntrances() {
+		p.Entrances = append(p.Entrances, entity.Entrance{
+			Number:  e.GetNumber(),
+			Section: e.GetSection(),
+			Litera:  e.GetLitera(),
+			Floors:  int(e.GetFloors()),
+			Note:    e.GetNote(),
+		})
+	}
+	for _, f := range msg.GetFlats() {
+		p.Flats = append(p.Flats, flatFromPB(f))
+	}
+	for _, r := range msg.GetCommonAreas() {
+		p.CommonAreas = append(p.CommonAreas, roomFromPB(r))
+	}
+	for _, a := range msg.GetAttachments() {
+		p.Attachments = append(p.Attachments, entity.Attachment{
+			ID:          a.GetId(),
+			FileName:    a.GetFileName(),
+			MIMEType:    a.GetMimeType(),
+			Size:        a.GetSize(),
+			Description: a.GetDescription(),
+			Thumbnail:   a.GetThumbnail(),
+			AddedDate:   timeFromPB(a.GetAddedDate()),
+		})
+	}
+	for _, e := range msg.GetAuditLog() {
+		p.AuditLog = append(p.AuditLog, entity.AuditEntry{
+			Timestamp:   timeFromPB(e.GetTimestamp()),
+			Action:      e.GetAction(),
+			User:        e.GetUser(),
+			Description: e.GetDescription(),
+		})
+	}
+
+	return p
+}
+
+func addressToPB(a entity.Address) *pb.Address {
+	return &pb.Address{
+		Subject:      a.Subject,
+		District:     a.District,
+		City:         a.City,
+		CityDistrict: a.CityDistrict,
+		Street:       a.Street,
+		House:        a.House,
+		Building:     a.Building,
+		Apartment:    a.Apartment,
+		Room:         a.Room,
+		PostalCode:   a.PostalCode,
+	}
+}
+
+func addressFromPB(msg *pb.Address) entity.Address {
+	return entity.Address{
+		Subject:      msg.GetSubject(),
+		District:     msg.GetDistrict(),
+		City:         msg.GetCity(),
+		CityDistrict: msg.GetCityDistrict(),
+		Street:       msg.GetStreet(),
+		House:        msg.GetHouse(),
+		Building:     msg.GetBuilding(),
+		Apartment:    msg.GetApartment(),
+		Room:         msg.GetRoom(),
+		PostalCode:   msg.GetPostalCode(),
+	}
+}
+
+func generalInfoToPB(g entity.GeneralInfo) *pb.GeneralInfo {
+	return &pb.GeneralInfo{
+		Purpose:           g.Purpose,
+		ActualUsage:       g.ActualUsage,
+		ConstructionYear:  int32(g.ConstructionYear),
+		TotalArea:         g.TotalArea,
+		LivingArea:        g.LivingArea,
+		FloorsAboveGround: int32(g.FloorsAboveGround),
+		FloorsUnderground: int32(g.FloorsUnderground),
+		Note:              g.Note,
+	}
+}
+
+func generalInfoFromPB(msg *pb.GeneralInfo) entity.GeneralInfo {
+	return entity.GeneralInfo{
+		Purpose:           msg.GetPurpose(),
+		ActualUsage:       msg.GetActualUsage(),
+		ConstructionYear:  int(msg.GetConstructionYear()),
+		TotalArea:         msg.GetTotalArea(),
+		LivingArea:        msg.GetLivingArea(),
+		FloorsAboveGround: int(msg.GetFloorsAboveGround()),
+		FloorsUnderground: int(msg.GetFloorsUnderground()),
+		Note:              msg.GetNote(),
+	}
+}
+
+func buildingToPB(b entity.Building) *pb.Building {
+	msg := &pb.Building{
+		Litera:         b.Litera,
+		Name:           b.Name,
+		CommissionYear: int32(b.CommissionYear),
+		WallMaterial:   b.WallMaterial,
+		TotalArea:      b.TotalArea,
+		BuildArea:      b.BuildArea,
+		Height:         b.Height,
+		Volume:         b.Volume,
+		InventoryValue: b.InventoryValue,
+		Attachments:    b.Attachments,
+		Valuation:      valuationToPB(b.Valuation),
+	}
+	for _, e := range b.StructuralElements {
+		msg.StructuralElements = append(msg.StructuralElements, &pb.StructuralElement{
+			Kind:        string(e.Kind),
+			Description: e.Description,
+			Condition:   string(e.Condition),
+			Wear:        e.Wear,
+		})
+	}
+	if a := b.WearAssessment; a != nil {
+		msg.WearAssessment = &pb.WearAssessment{
+			Date:         timeToPB(a.Date),
+			TableVersion: a.TableVersion,
+			WallGroup:    a.WallGroup,
+			Elements:     wearSharesToPB(a.Elements),
+			Wear:         a.Wear,
+			Warnings:     a.Warnings,
+		}
+	}
+	return msg
+}
+
+func buildingFromPB(msg *pb.Building) entity.Building {
+	b := entity.Building{
+		Litera:         msg.GetLitera(),
+		Name:           msg.GetName(),
+		CommissionYear: int(msg.GetCommissionYear()),
+		WallMaterial:   msg.GetWallMaterial(),
+		TotalArea:      msg.GetTotalArea(),
+		BuildArea:      msg.GetBuildArea(),
+		Height:         msg.GetHeight(),
+		Volume:         msg.GetVolume(),
+		InventoryValue: msg.GetInventoryValue(),
+		Attachments:    msg.GetAttachments(),
+		Valuation:      valuationFromPB(msg.GetValuation()),
+	}
+	for _, e := range msg.GetStructuralElements() {
+		b.StructuralElements = append(b.StructuralElements, entity.StructuralElement{
+			Kind:        entity.StructuralElementKind(e.GetKind()),
+			Description: e.GetDescription(),
+			Condition:   entity.TechnicalCondition(e.GetCondition()),
+			Wear:        e.GetWear(),
+		})
+	}
+	if a := msg.GetWearAssessment(); a != nil {
+		b.WearAssessment = &entity.WearAssessment{
+			Date:         timeFromPB(a.GetDate()),
+			TableVersion: a.GetTableVersion(),
+			WallGroup:    a.GetWallGroup(),
+			Elements:     wearSharesFromPB(a.GetElements()),
+			Wear:         a.GetWear(),
+			Warnings:     a.GetWarnings(),
+		}
+	}
+	return b
+}
+
+func structureToPB(s entity.Structure) *pb.Structure {
+	return &pb.Structure{
+		Litera:         s.Litera,
+		Kind:           string(s.Kind),
+		Name:           s.Name,
+		CommissionYear: int32(s.CommissionYear),
+		Material:       s.Material,
+		Length:         s.Length,
+		Width:          s.Width,
+		Height:         s.Height,
+		Diameter:       s.Diameter,
+		Depth:          s.Depth,
+		Capacity:       s.Capacity,
+		Area:           s.Area,
+		Route:          geoPointsToPB(s.Route),
+		Wear:           s.Wear,
+		InventoryValue: s.InventoryValue,
+		Valuation:      valuationToPB(s.Valuation),
+		Note:           s.Note,
+	}
+}
+
+func structureFromPB(msg *pb.Structure) entity.Structure {
+	return entity.Structure{
+		Litera:         msg.GetLitera(),
+		Kind:           entity.StructureKind(msg.GetKind()),
+		Name:           msg.GetName(),
+		CommissionYear: int(msg.GetCommissionYear()),
+		Material:       msg.GetMaterial(),
+		Length:         msg.GetLength(),
+		Width:          msg.GetWidth(),
+		Height:         msg.GetHeight(),
+		Diameter:       msg.GetDiameter(),
+		Depth:          msg.GetDepth(),
+		Capacity:       msg.GetCapacity(),
+		Area:           msg.GetArea(),
+		Route:          geoPointsFromPB(msg.GetRoute()),
+		Wear:           msg.GetWear(),
+		InventoryValue: msg.GetInventoryValue(),
+		Valuation:      valuationFromPB(msg.GetValuation()),
+		Note:           msg.GetNote(),
+	}
+}
+
+// wearSharesToPB преобразует вклад элементов во взвешенный износ
+func wearSharesToPB(shares []entity.ElementWearShare) []*pb.ElementWearShare {
+	var out []*pb.ElementWearShare
+	for _, s := range shares {
+		out = append(out, &pb.ElementWearShare{Kind: string(s.Kind), Weight: s.Weight, Wear: s.Wear})
+	}
+	return out
+}
+
+func wearSharesFromPB(shares []*pb.ElementWearShare) []entity.ElementWearShare {
+	var out []entity.ElementWearShare
+	for _, s := range shares {
+		out = append(out, entity.ElementWearShare{Kind: entity.StructuralElementKind(s.GetKind()), Weight: s.GetWeight(), Wear: s.GetWear()})
+	}
+	return out
+}
+
+// valuationToPB преобразует расчет стоимости; отсутствующий расчет дает nil
+func valuationToPB(v *entity.BuildingValuation) *pb.BuildingValuation {
+	if v == nil {
+		return nil
+	}
+	return &pb.BuildingValuation{
+		Date: timeToPB(v.Date),
+		Inputs: &pb.ValuationInputs{
+			TableVersion:   v.Inputs.TableVersion,
+			Region:         v.Inputs.Region,
+			Year:           int32(v.Inputs.Year),
+			ObjectType:     objectTypeToPB[v.Inputs.ObjectType],
+			Name:           v.Inputs.Name,
+			Purpose:        v.Inputs.Purpose,
+			StructureKind:  string(v.Inputs.StructureKind),
+			WallMaterial:   v.Inputs.WallMaterial,
+			Length:         v.Inputs.Length,
+			Volume:         v.Inputs.Volume,
+			TotalArea:      v.Inputs.TotalArea,
+			CommissionYear: int32(v.Inputs.CommissionYear),
+			WearPercent:    v.Inputs.WearPercent,
+			ElementWear:    elementWearToPB(v.Inputs.ElementWear),
+		},
+		WallGroup:           v.WallGroup,
+		Unit:                string(v.Unit),
+		Quantity:            v.Quantity,
+		UnitCost:            v.UnitCost,
+		RegionalCoefficient: v.RegionalCoefficient,
+		PriceIndex:          v.PriceIndex,
+		ReplacementCost:     v.ReplacementCost,
+		Wear:                v.Wear,
+		WearByAge:           v.WearByAge,
+		WearElements:        wearSharesToPB(v.WearElements),
+		InventoryValue:      v.InventoryValue,
+		Warnings:            v.Warnings,
+	}
+}
+
+// elementWearToPB преобразует износ элементов исходных данных расчета
+func elementWearToPB(wear map[entity.StructuralElementKind]float64) map[string]float64 {
+	if len(wear) == 0 {
+		return nil
+	}
+	out := make(map[string]float64, len(wear))
+	for kind, w := range wear {
+		out[string(kind)] = w
+	}
+	return out
+}
+
+func elementWearFromPB(wear map[string]float64) map[entity.StructuralElementKind]float64 {
+	if len(wear) == 0 {
+		return nil
+	}
+	out := make(map[entity.StructuralElementKind]float64, len(wear))
+	for kind, w := range wear {
+		out[entity.StructuralElementKind(kind)] = w
+	}
+	return out
+}
+
+func valuationFromPB(msg *pb.BuildingValuation) *entity.BuildingValuation {
+	if msg == nil {
+		return nil
+	}
+	inputs := msg.GetInputs()
+	var wear *float64
+	if inputs != nil && inputs.WearPercent != nil {
+		value := *inputs.WearPercent
+		wear = &value
+	}
+	return &entity.BuildingValuation{
+		Date: timeFromPB(msg.GetDate()),
+		Inputs: entity.ValuationInputs{
+			TableVersion:   inputs.GetTableVersion(),
+			Region:         inputs.GetRegion(),
+			Year:           int(inputs.GetYear()),
+			ObjectType:     objectTypeFromPB[inputs.GetObjectType()],
+			Name:           inputs.GetName(),
+			Purpose:        inputs.GetPurpose(),
+			StructureKind:  entity.StructureKind(inputs.GetStructureKind()),
+			WallMaterial:   inputs.GetWallMaterial(),
+			Length:         inputs.GetLength(),
+			Volume:         inputs.GetVolume(),
+			TotalArea:      inputs.GetTotalArea(),
+			CommissionYear: int(inputs.GetCommissionYear()),
+			WearPercent:    wear,
+			ElementWear:    elementWearFromPB(inputs.GetElementWear()),
+		},
+		WallGroup:           msg.GetWallGroup(),
+		Unit:                entity.ValuationUnit(msg.GetUnit()),
+		Quantity:            msg.GetQuantity(),
+		UnitCost:            msg.GetUnitCost(),
+		RegionalCoefficient: msg.GetRegionalCoefficient(),
+		PriceIndex:          msg.GetPriceIndex(),
+		ReplacementCost:     msg.GetReplacementCost(),
+		Wear:                msg.GetWear(),
+		WearByAge:           msg.GetWearByAge(),
+		WearElements:        wearSharesFromPB(msg.GetWearElements()),
+		InventoryValue:      msg.GetInventoryValue(),
+		Warnings:            msg.GetWarnings(),
+	}
+}
+
+func ownerToPB(o entity.Owner) *pb.Owner {
+	return &pb.Owner{
+		EntryDate:     timeToPB(o.EntryDate),
+		PersonType:    personTypeToPB[o.PersonType],
+		FullName:      o.FullName,
+		PassportData:  o.PassportData,
+		CompanyName:   o.CompanyName,
+		Tin:           o.TIN,
+		RightType:     o.RightType,
+		RightDocument: o.RightDocument,
+		Share:         o.Share,
+		Attachments:   o.Attachments,
+	}
+}
+
+func ownerFromPB(msg *pb.Owner) entity.Owner {
+	return entity.Owner{
+		EntryDate:     timeFromPB(msg.GetEntryDate()),
+		PersonType:    personTypeFromPB[msg.GetPersonType()],
+		FullName:      msg.GetFullName(),
+		PassportData:  msg.GetPassportData(),
+		CompanyName:   msg.GetCompanyName(),
+		TIN:           msg.GetTin(),
+		RightType:     msg.GetRightType(),
+		RightDocument: msg.GetRightDocument(),
+		Share:         msg.GetShare(),
+		Attachments:   msg.GetAttachments(),
+	}
+}
+
+func roomToPB(r entity.Room) *pb.Room {
+	return &pb.Room{
+		Litera:           r.Litera,
+		Floor:            r.Floor,
+		RoomNumber:       r.RoomNumber,
+		Purpose:          r.Purpose,
+		Area:             r.Area,
+		LivingArea:       r.LivingArea,
+		AuxiliaryArea:    r.AuxiliaryArea,
+		Height:           r.Height,
+		UnauthorizedArea: r.UnauthorizedArea,
+		Note:             r.Note,
+		Measurements:     measurementsToPB(r.Measurements),
+		UseCategory:      string(r.UseCategory),
+	}
+}
+
+func roomFromPB(msg *pb.Room) entity.Room {
+	return entity.Room{
+		Litera:           msg.GetLitera(),
+		Floor:            msg.GetFloor(),
+		RoomNumber:       msg.GetRoomNumber(),
+		Purpose:          msg.GetPurpose(),
+		Area:             msg.GetArea(),
+		LivingArea:       msg.GetLivingArea(),
+		AuxiliaryArea:    msg.GetAuxiliaryArea(),
+		Height:           msg.GetHeight(),
+		UnauthorizedArea: msg.GetUnauthorizedArea(),
+		Note:             msg.GetNote(),
+		Measurements:     measurementsFromPB(msg.GetMeasurements()),
+		UseCategory:      entity.UseCategory(msg.GetUseCategory()),
+	}
+}
+
+func flatToPB(f entity.Flat) *pb.Flat {
+	msg := &pb.Flat{
+		Number:          f.Number,
+		Litera:          f.Litera,
+		Entrance:        f.Entrance,
+		Floor:           f.Floor,
+		CadastralNumber: f.CadastralNumber,
+	}
+	for _, r := range f.Explication {
+		msg.Explication = append(msg.Explication, roomToPB(r))
+	}
+	return msg
+}
+
+func flatFromPB(msg *pb.Flat) entity.Flat {
+	f := entity.Flat{
+		Number:          msg.GetNumber(),
+		Litera:          msg.GetLitera(),
+		Entrance:        msg.GetEntrance(),
+		Floor:           msg.GetFloor(),
+		CadastralNumber: msg.GetCadastralNumber(),
+		Explication:     []entity.Room{},
+	}
+	for _, r := range msg.GetExplication() {
+		f.Explication = append(f.Explication, roomFromPB(r))
+	}
+	return f
+}
+
+// measurementsToPB преобразует обмер помещения; отсутствующий обмер дает nil
+func measurementsToPB(m *entity.RoomMeasurements) *pb.RoomMeasurements {
+	if m == nil {
+		return nil
+	}
+	msg := &pb.RoomMeasurements{
+		Area:         m.Area,
+		MeasuredBy:   m.MeasuredBy,
+		MeasuredDate: timeToPB(m.MeasuredDate),
+	}
+	for _, s := range m.Shapes {
+		msg.Shapes = append(msg.Shapes, &pb.MeasuredShape{
+			Kind:      shapeKindToPB[s.Kind],
+			Role:      shapeRoleToPB[s.Role],
+			Length:    s.Length,
+			Width:     s.Width,
+			Sides:     s.Sides,
+			Diagonals: s.Diagonals,
+			Diameter:  s.Diameter,
+			Height:    s.Height,
+			Note:      s.Note,
+		})
+	}
+	return msg
+}
+
+func measurementsFromPB(msg *pb.RoomMeasurements) *entity.RoomMeasurements {
+	if msg == nil {
+		return nil
+	}
+	m := &entity.RoomMeasurements{
+		Area:         msg.GetArea(),
+		MeasuredBy:   msg.GetMeasuredBy(),
+		MeasuredDate: timeFromPB(msg.GetMeasuredDate()),
+	}
+	for _, s := range msg.GetShapes() {
+		m.Shapes = append(m.Shapes, entity.MeasuredShape{
+			Kind:      shapeKindFromPB[s.GetKind()],
+			Role:      shapeRoleFromPB[s.GetRole()],
+			Length:    s.GetLength(),
+			Width:     s.GetWidth(),
+			Sides:     s.GetSides(),
+			Diagonals: s.GetDiagonals(),
+			Diameter:  s.GetDiameter(),
+			Height:    s.GetHeight(),
+			Note:      s.GetNote(),
+		})
+	}
+	return m
+}
+
+func pointToPB(p entity.Point) *pb.Point {
+	return &pb.Point{X: p.X, Y: p.Y}
+}
+
+func pointFromPB(msg *pb.Point) entity.Point {
+	return entity.Point{X: msg.GetX(), Y: msg.GetY()}
+}
+
+func floorPlanToPB(p entity.FloorPlan) *pb.FloorPlan {
+	msg := &pb.FloorPlan{
+		Litera:      p.Litera,
+		Floor:       p.Floor,
+		Background:  p.Background,
+		Attachments: p.Attachments,
+	}
+	for _, w := range p.Walls {
+		msg.Walls = append(msg.Walls, &pb.Wall{Start: pointToPB(w.Start), End: pointToPB(w.End), Thickness: w.Thickness})
+	}
+	for _, o := range p.Openings {
+		msg.Openings = append(msg.Openings, &pb.Opening{
+			Type:   openingTypeToPB[o.Type],
+			Wall:   int32(o.Wall),
+			Offset: o.Offset,
+			Width:  o.Width,
+		})
+	}
+	for _, c := range p.Rooms {
+		contour := &pb.RoomContour{RoomNumber: c.RoomNumber}
+		for _, pt := range c.Points {
+			contour.Points = append(contour.Points, pointToPB(pt))
+		}
+		msg.Rooms = append(msg.Rooms, contour)
+	}
+	return msg
+}
+
+func floorPlanFromPB(msg *pb.FloorPlan) entity.FloorPlan {
+	p := entity.FloorPlan{
+		Litera:      msg.GetLitera(),
+		Floor:       msg.GetFloor(),
+		Background:  msg.GetBackground(),
+		Attachments: msg.GetAttachments(),
+	}
+	for _, w := range msg.GetWalls() {
+		p.Walls = append(p.Walls, entity.Wall{Start: pointFromPB(w.GetStart()), End: pointFromPB(w.GetEnd()), Thickness: w.GetThickness()})
+	}
+	for _, o := range msg.GetOpenings() {
+		p.Openings = append(p.Openings, entity.Opening{
+			Type:   openingTypeFromPB[o.GetType()],
+			Wall:   int(o.GetWall()),
+			Offset: o.GetOffset(),
+			Width:  o.GetWidth(),
+		})
+	}
+	for _, c := range msg.GetRooms() {
+		contour := entity.RoomContour{RoomNumber: c.GetRoomNumber()}
+		for _, pt := range c.GetPoints() {
+			contour.Points = append(contour.Points, pointFromPB(pt))
+		}
+		p.Rooms = append(p.Rooms, contour)
+	}
+	return p
+}
+
+func geoPointToPB(p entity.GeoPoint) *pb.GeoPoint {
+	return &pb.GeoPoint{Name: p.Name, X: p.X, Y: p.Y}
+}
+
+func geoPointFromPB(msg *pb.GeoPoint) entity.GeoPoint {
+	return entity.GeoPoint{Name: msg.GetName(), X: msg.GetX(), Y: msg.GetY()}
+}
+
+func geoPointsToPB(points []entity.GeoPoint) []*pb.GeoPoint {
+	var out []*pb.GeoPoint
+	for _, p := range points {
+		out = append(out, geoPointToPB(p))
+	}
+	return out
+}
+
+func geoPointsFromPB(msgs []*pb.GeoPoint) []entity.GeoPoint {
+	var out []entity.GeoPoint
+	for _, m := range msgs {
+		out = append(out, geoPointFromPB(m))
+	}
+	return out
+}
+
+// commercialToPB преобразует сведения о нежилом объекте; отсутствующие сведения дают nil
+func commercialToPB(c *entity.CommercialInfo) *pb.CommercialInfo {
+	if c == nil {
+		return nil
+	}
+	return &pb.CommercialInfo{
+		FireHazardClass:   c.FireHazardClass,
+		ExplosionCategory: c.ExplosionCategory,
+		FireResistance:    c.FireResistance,
+		EvacuationExits:   int32(c.EvacuationExits),
+		SeparateEntrance:  c.SeparateEntrance,
+		Note:              c.Note,
+	}
+}
+
+func commercialFromPB(msg *pb.CommercialInfo) *entity.CommercialInfo {
+	if msg == nil {
+		return nil
+	}
+	return &entity.CommercialInfo{
+		FireHazardClass:   msg.GetFireHazardClass(),
+		ExplosionCategory: msg.GetExplosionCategory(),
+		FireResistance:    msg.GetFireResistance(),
+		EvacuationExits:   int(msg.GetEvacuationExits()),
+		SeparateEntrance:  msg.GetSeparateEntrance(),
+		Note:              msg.GetNote(),
+	}
+}
+
+// situationPlanToPB преобразует ситуационный план; отсутствующий план дает nil
+func situationPlanToPB(p *entity.SituationPlan) *pb.SituationPlan {
+	if p == nil {
+		return nil
+	}
+	msg := &pb.SituationPlan{
+		CoordinateSystem: coordinateSystemToPB[p.CoordinateSystem],
+		Zone:             p.Zone,
+		Boundary:         geoPointsToPB(p.Boundary),
+		Attachments:      p.Attachments,
+	}
+	for _, f := range p.Footprints {
+		msg.Footprints = append(msg.Footprints, &pb.BuildingFootprint{Litera: f.Litera, Points: geoPointsToPB(f.Points)})
+	}
+	for _, a := range p.Annotations {
+		msg.Annotations = append(msg.Annotations, &pb.PlanAnnotation{Text: a.Text, At: geoPointToPB(a.At)})
+	}
+	return msg
+}
+
+func situationPlanFromPB(msg *pb.SituationPlan) *entity.SituationPlan {
+	if msg == nil {
+		return nil
+	}
+	p := &entity.SituationPlan{
+		CoordinateSystem: coordinateSystemFromPB[msg.GetCoordinateSystem()],
+		Zone:             msg.GetZone(),
+		Boundary:         geoPointsFromPB(msg.GetBoundary()),
+		Attachments:      msg.GetAttachments(),
+	}
+	for _, f := range msg.GetFootprints() {
+		p.Footprints = append(p.Footprints, entity.BuildingFootprint{Litera: f.GetLitera(), Points: geoPointsFromPB(f.GetPoints())})
+	}
+	for _, a := range msg.GetAnnotations() {
+		p.Annotations = append(p.Annotations, entity.PlanAnnotation{Text: a.GetText(), At: geoPointFromPB(a.GetAt())})
+	}
+	return p
+}
+
+func connectionToPB(c entity.UtilityConnection) *pb.UtilityConnection {
+	return &pb.UtilityConnection{Centralized: c.Centralized, Autonomous: c.Autonomous}
+}
+
+func connectionFromPB(msg *pb.UtilityConnection) entity.UtilityConnection {
+	return entity.UtilityConnection{Centralized: msg.GetCentralized(), Autonomous: msg.GetAutonomous()}
+}
+
+func utilitiesToPB(u entity.Utilities) *pb.Utilities {
+	return &pb.Utilities{
+		Water:       connectionToPB(u.Water),
+		Sewerage:    connectionToPB(u.Sewerage),
+		Heating:     connectionToPB(u.Heating),
+		HotWater:    connectionToPB(u.HotWater),
+		Gas:         connectionToPB(u.Gas),
+		Electricity: connectionToPB(u.Electricity),
+		Other:       u.Other,
+	}
+}
+
+func utilitiesFromPB(msg *pb.Utilities) entity.Utilities {
+	return entity.Utilities{
+		Water:       connectionFromPB(msg.GetWater()),
+		Sewerage:    connectionFromPB(msg.GetSewerage()),
+		Heating:     connectionFromPB(msg.GetHeating()),
+		HotWater:    connectionFromPB(msg.GetHotWater()),
+		Gas:         connectionFromPB(msg.GetGas()),
+		Electricity: connectionFromPB(msg.GetElectricity()),
+		Other:       msg.GetOther(),
+	}
+}
+
+// validationToPB преобразует результат валидации
+func validationToPB(result service.ValidationResult) *pb.ValidationResult {
+	msg := &pb.ValidationResult{
+		Valid:    result.Valid,
+		Warnings: result.Warnings,
+	}
+	for _, e := range result.Errors {
+		msg.Errors = append(msg.Errors, &pb.FieldError{Field: e.Field, Message: e.Message})
+	}
+	return msg
+}
@@ -0,0 +1,59 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// toStatus преобразует ошибку use case в статус gRPC
+// Ошибки валидации сопровождаются деталями BadRequest с указанием поля
+func (s *Server) toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var (
+		validationErr entity.ValidationError
+		authErr       entity.AuthenticationError
+		permissionErr entity.PermissionError
+	)
+
+	switch {
+	case errors.As(err, &authErr):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.As(err, &permissionErr):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, repository.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, repository.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.As(err, &validationErr):
+		st := status.New(codes.InvalidArgument, err.Error())
+		detailed, detailErr := st.WithDetails(&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: validationErr.Field, Description: validationErr.Message},
+			},
+		})
+		if detailErr != nil {
+			return st.Err()
+		}
+		return detailed.Err()
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	default:
+		s.logger.Printf("internal error: %v", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
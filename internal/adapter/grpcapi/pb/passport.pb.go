@@ -0,0 +1,6247 @@
+// Описание gRPC API технического паспорта объекта недвижимости.
+// Сообщения повторяют доменные сущности internal/domain/entity.
+//
+// Генерация Go кода: make proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.4
+// source: techpassport/v1/passport.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ObjectType int32
+
+const (
+	ObjectType_OBJECT_TYPE_UNSPECIFIED        ObjectType = 0
+	ObjectType_OBJECT_TYPE_RESIDENTIAL_HOUSE  ObjectType = 1 // Жилой дом
+	ObjectType_OBJECT_TYPE_APARTMENT          ObjectType = 2 // Квартира
+	ObjectType_OBJECT_TYPE_ROOM               ObjectType = 3 // Комната
+	ObjectType_OBJECT_TYPE_NON_RESIDENTIAL    ObjectType = 4 // Нежилое помещение
+	ObjectType_OBJECT_TYPE_APARTMENT_BUILDING ObjectType = 5 // Многоквартирный дом
+)
+
+// Enum value maps for ObjectType.
+var (
+	ObjectType_name = map[int32]string{
+		0: "OBJECT_TYPE_UNSPECIFIED",
+		1: "OBJECT_TYPE_RESIDENTIAL_HOUSE",
+		2: "OBJECT_TYPE_APARTMENT",
+		3: "OBJECT_TYPE_ROOM",
+		4: "OBJECT_TYPE_NON_RESIDENTIAL",
+		5: "OBJECT_TYPE_APARTMENT_BUILDING",
+	}
+	ObjectType_value = map[string]int32{
+		"OBJECT_TYPE_UNSPECIFIED":        0,
+		"OBJECT_TYPE_RESIDENTIAL_HOUSE":  1,
+		"OBJECT_TYPE_APARTMENT":          2,
+		"OBJECT_TYPE_ROOM":               3,
+		"OBJECT_TYPE_NON_RESIDENTIAL":    4,
+		"OBJECT_TYPE_APARTMENT_BUILDING": 5,
+	}
+)
+
+func (x ObjectType) Enum() *ObjectType {
+	p := new(ObjectType)
+	*p = x
+	return p
+}
+
+func (x ObjectType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ObjectType) Descriptor() protoreflect.EnumDescriptor {
+	return file_techpassport_v1_passport_proto_enumTypes[0].Descriptor()
+}
+
+func (ObjectType) Type() protoreflect.EnumType {
+	return &file_techpassport_v1_passport_proto_enumTypes[0]
+}
+
+func (x ObjectType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ObjectType.Descriptor instead.
+func (ObjectType) EnumDescriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{0}
+}
+
+type PersonType int32
+
+const (
+	PersonType_PERSON_TYPE_UNSPECIFIED PersonType = 0
+	PersonType_PERSON_TYPE_INDIVIDUAL  PersonType = 1 // Физическое лицо
+	PersonType_PERSON_TYPE_LEGAL       PersonType = 2 // Юридическое лицо
+)
+
+// Enum value maps for PersonType.
+var (
+	PersonType_name = map[int32]string{
+		0: "PERSON_TYPE_UNSPECIFIED",
+		1: "PERSON_TYPE_INDIVIDUAL",
+		2: "PERSON_TYPE_LEGAL",
+	}
+	PersonType_value = map[string]int32{
+		"PERSON_TYPE_UNSPECIFIED": 0,
+		"PERSON_TYPE_INDIVIDUAL":  1,
+		"PERSON_TYPE_LEGAL":       2,
+	}
+)
+
+func (x PersonType) Enum() *PersonType {
+	p := new(PersonType)
+	*p = x
+	return p
+}
+
+func (x PersonType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PersonType) Descriptor() protoreflect.EnumDescriptor {
+	return file_techpassport_v1_passport_proto_enumTypes[1].Descriptor()
+}
+
+func (PersonType) Type() protoreflect.EnumType {
+	return &file_techpassport_v1_passport_proto_enumTypes[1]
+}
+
+func (x PersonType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PersonType.Descriptor instead.
+func (PersonType) EnumDescriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{1}
+}
+
+type PassportStatus int32
+
+const (
+	PassportStatus_PASSPORT_STATUS_UNSPECIFIED PassportStatus = 0
+	PassportStatus_PASSPORT_STATUS_DRAFT       PassportStatus = 1 // Черновик
+	PassportStatus_PASSPORT_STATUS_APPROVED    PassportStatus = 2 // Утвержден
+	PassportStatus_PASSPORT_STATUS_ARCHIVED    PassportStatus = 3 // В архиве
+)
+
+// Enum value maps for PassportStatus.
+var (
+	PassportStatus_name = map[int32]string{
+		0: "PASSPORT_STATUS_UNSPECIFIED",
+		1: "PASSPORT_STATUS_DRAFT",
+		2: "PASSPORT_STATUS_APPROVED",
+		3: "PASSPORT_STATUS_ARCHIVED",
+	}
+	PassportStatus_value = map[string]int32{
+		"PASSPORT_STATUS_UNSPECIFIED": 0,
+		"PASSPORT_STATUS_DRAFT":       1,
+		"PASSPORT_STATUS_APPROVED":    2,
+		"PASSPORT_STATUS_ARCHIVED":    3,
+	}
+)
+
+func (x PassportStatus) Enum() *PassportStatus {
+	p := new(PassportStatus)
+	*p = x
+	return p
+}
+
+func (x PassportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PassportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_techpassport_v1_passport_proto_enumTypes[2].Descriptor()
+}
+
+func (PassportStatus) Type() protoreflect.EnumType {
+	return &file_techpassport_v1_passport_proto_enumTypes[2]
+}
+
+func (x PassportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PassportStatus.Descriptor instead.
+func (PassportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{2}
+}
+
+type DocumentFormat int32
+
+const (
+	DocumentFormat_DOCUMENT_FORMAT_UNSPECIFIED DocumentFormat = 0 // По умолчанию PDF
+	DocumentFormat_DOCUMENT_FORMAT_PDF         DocumentFormat = 1
+	DocumentFormat_DOCUMENT_FORMAT_DOCX        DocumentFormat = 2
+)
+
+// Enum value maps for DocumentFormat.
+var (
+	DocumentFormat_name = map[int32]string{
+		0: "DOCUMENT_FORMAT_UNSPECIFIED",
+		1: "DOCUMENT_FORMAT_PDF",
+		2: "DOCUMENT_FORMAT_DOCX",
+	}
+	DocumentFormat_value = map[string]int32{
+		"DOCUMENT_FORMAT_UNSPECIFIED": 0,
+		"DOCUMENT_FORMAT_PDF":         1,
+		"DOCUMENT_FORMAT_DOCX":        2,
+	}
+)
+
+func (x DocumentFormat) Enum() *DocumentFormat {
+	p := new(DocumentFormat)
+	*p = x
+	return p
+}
+
+func (x DocumentFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DocumentFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_techpassport_v1_passport_proto_enumTypes[3].Descriptor()
+}
+
+func (DocumentFormat) Type() protoreflect.EnumType {
+	return &file_techpassport_v1_passport_proto_enumTypes[3]
+}
+
+func (x DocumentFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DocumentFormat.Descriptor instead.
+func (DocumentFormat) EnumDescriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{3}
+}
+
+type OpeningType int32
+
+const (
+	OpeningType_OPENING_TYPE_UNSPECIFIED OpeningType = 0
+	OpeningType_OPENING_TYPE_DOOR        OpeningType = 1 // Дверной проем
+	OpeningType_OPENING_TYPE_WINDOW      OpeningType = 2 // Оконный проем
+)
+
+// Enum value maps for OpeningType.
+var (
+	OpeningType_name = map[int32]string{
+		0: "OPENING_TYPE_UNSPECIFIED",
+		1: "OPENING_TYPE_DOOR",
+		2: "OPENING_TYPE_WINDOW",
+	}
+	OpeningType_value = map[string]int32{
+		"OPENING_TYPE_UNSPECIFIED": 0,
+		"OPENING_TYPE_DOOR":        1,
+		"OPENING_TYPE_WINDOW":      2,
+	}
+)
+
+func (x OpeningType) Enum() *OpeningType {
+	p := new(OpeningType)
+	*p = x
+	return p
+}
+
+func (x OpeningType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OpeningType) Descriptor() protoreflect.EnumDescriptor {
+	return file_techpassport_v1_passport_proto_enumTypes[4].Descriptor()
+}
+
+func (OpeningType) Type() protoreflect.EnumType {
+	return &file_techpassport_v1_passport_proto_enumTypes[4]
+}
+
+func (x OpeningType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OpeningType.Descriptor instead.
+func (OpeningType) EnumDescriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{4}
+}
+
+type ShapeKind int32
+
+const (
+	ShapeKind_SHAPE_KIND_UNSPECIFIED ShapeKind = 0
+	ShapeKind_SHAPE_KIND_RECTANGLE   ShapeKind = 1 // Длина и ширина
+	ShapeKind_SHAPE_KIND_TRIANGLE    ShapeKind = 2 // Три стороны
+	ShapeKind_SHAPE_KIND_POLYGON     ShapeKind = 3 // Стороны и диагонали из первой вершины
+	ShapeKind_SHAPE_KIND_SEMICIRCLE  ShapeKind = 4 // Диаметр
+)
+
+// Enum value maps for ShapeKind.
+var (
+	ShapeKind_name = map[int32]string{
+		0: "SHAPE_KIND_UNSPECIFIED",
+		1: "SHAPE_KIND_RECTANGLE",
+		2: "SHAPE_KIND_TRIANGLE",
+		3: "SHAPE_KIND_POLYGON",
+		4: "SHAPE_KIND_SEMICIRCLE",
+	}
+	ShapeKind_value = map[string]int32{
+		"SHAPE_KIND_UNSPECIFIED": 0,
+		"SHAPE_KIND_RECTANGLE":   1,
+		"SHAPE_KIND_TRIANGLE":    2,
+		"SHAPE_KIND_POLYGON":     3,
+		"SHAPE_KIND_SEMICIRCLE":  4,
+	}
+)
+
+func (x ShapeKind) Enum() *ShapeKind {
+	p := new(ShapeKind)
+	*p = x
+	return p
+}
+
+func (x ShapeKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShapeKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_techpassport_v1_passport_proto_enumTypes[5].Descriptor()
+}
+
+func (ShapeKind) Type() protoreflect.EnumType {
+	return &file_techpassport_v1_passport_proto_enumTypes[5]
+}
+
+func (x ShapeKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShapeKind.Descriptor instead.
+func (ShapeKind) EnumDescriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{5}
+}
+
+type ShapeRole int32
+
+const (
+	ShapeRole_SHAPE_ROLE_UNSPECIFIED ShapeRole = 0
+	ShapeRole_SHAPE_ROLE_MAIN        ShapeRole = 1 // Основная часть помещения
+	ShapeRole_SHAPE_ROLE_BAY_WINDOW  ShapeRole = 2 // Эркер
+	ShapeRole_SHAPE_ROLE_NICHE       ShapeRole = 3 // Ниша
+	ShapeRole_SHAPE_ROLE_DEDUCTION   ShapeRole = 4 // Колонна, пилястра, печь (вычитается)
+)
+
+// Enum value maps for ShapeRole.
+var (
+	ShapeRole_name = map[int32]string{
+		0: "SHAPE_ROLE_UNSPECIFIED",
+		1: "SHAPE_ROLE_MAIN",
+		2: "SHAPE_ROLE_BAY_WINDOW",
+		3: "SHAPE_ROLE_NICHE",
+		4: "SHAPE_ROLE_DEDUCTION",
+	}
+	ShapeRole_value = map[string]int32{
+		"SHAPE_ROLE_UNSPECIFIED": 0,
+		"SHAPE_ROLE_MAIN":        1,
+		"SHAPE_ROLE_BAY_WINDOW":  2,
+		"SHAPE_ROLE_NICHE":       3,
+		"SHAPE_ROLE_DEDUCTION":   4,
+	}
+)
+
+func (x ShapeRole) Enum() *ShapeRole {
+	p := new(ShapeRole)
+	*p = x
+	return p
+}
+
+func (x ShapeRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShapeRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_techpassport_v1_passport_proto_enumTypes[6].Descriptor()
+}
+
+func (ShapeRole) Type() protoreflect.EnumType {
+	return &file_techpassport_v1_passport_proto_enumTypes[6]
+}
+
+func (x ShapeRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShapeRole.Descriptor instead.
+func (ShapeRole) EnumDescriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{6}
+}
+
+type CoordinateSystem int32
+
+const (
+	CoordinateSystem_COORDINATE_SYSTEM_UNSPECIFIED CoordinateSystem = 0
+	CoordinateSystem_COORDINATE_SYSTEM_MSK         CoordinateSystem = 1 // Местная система координат, метры
+	CoordinateSystem_COORDINATE_SYSTEM_WGS84       CoordinateSystem = 2 // Широта и долгота, градусы
+)
+
+// Enum value maps for CoordinateSystem.
+var (
+	CoordinateSystem_name = map[int32]string{
+		0: "COORDINATE_SYSTEM_UNSPECIFIED",
+		1: "COORDINATE_SYSTEM_MSK",
+		2: "COORDINATE_SYSTEM_WGS84",
+	}
+	CoordinateSystem_value = map[string]int32{
+		"COORDINATE_SYSTEM_UNSPECIFIED": 0,
+		"COORDINATE_SYSTEM_MSK":         1,
+		"COORDINATE_SYSTEM_WGS84":       2,
+	}
+)
+
+func (x CoordinateSystem) Enum() *CoordinateSystem {
+	p := new(CoordinateSystem)
+	*p = x
+	return p
+}
+
+func (x CoordinateSystem) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CoordinateSystem) Descriptor() protoreflect.EnumDescriptor {
+	return file_techpassport_v1_passport_proto_enumTypes[7].Descriptor()
+}
+
+func (CoordinateSystem) Type() protoreflect.EnumType {
+	return &file_techpassport_v1_passport_proto_enumTypes[7]
+}
+
+func (x CoordinateSystem) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CoordinateSystem.Descriptor instead.
+func (CoordinateSystem) EnumDescriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{7}
+}
+
+type Address struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subject      string `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	District     string `protobuf:"bytes,2,opt,name=district,proto3" json:"district,omitempty"`
+	City         string `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	CityDistrict string `protobuf:"bytes,4,opt,name=city_district,json=cityDistrict,proto3" json:"city_district,omitempty"`
+	Street       string `protobuf:"bytes,5,opt,name=street,proto3" json:"street,omitempty"`
+	House        string `protobuf:"bytes,6,opt,name=house,proto3" json:"house,omitempty"`
+	Building     string `protobuf:"bytes,7,opt,name=building,proto3" json:"building,omitempty"`
+	Apartment    string `protobuf:"bytes,8,opt,name=apartment,proto3" json:"apartment,omitempty"`
+	Room         string `protobuf:"bytes,9,opt,name=room,proto3" json:"room,omitempty"`
+	PostalCode   string `protobuf:"bytes,10,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Address) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Address) GetDistrict() string {
+	if x != nil {
+		return x.District
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetCityDistrict() string {
+	if x != nil {
+		return x.CityDistrict
+	}
+	return ""
+}
+
+func (x *Address) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *Address) GetHouse() string {
+	if x != nil {
+		return x.House
+	}
+	return ""
+}
+
+func (x *Address) GetBuilding() string {
+	if x != nil {
+		return x.Building
+	}
+	return ""
+}
+
+func (x *Address) GetApartment() string {
+	if x != nil {
+		return x.Apartment
+	}
+	return ""
+}
+
+func (x *Address) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *Address) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+type GeneralInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Purpose           string  `protobuf:"bytes,1,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	ActualUsage       string  `protobuf:"bytes,2,opt,name=actual_usage,json=actualUsage,proto3" json:"actual_usage,omitempty"`
+	ConstructionYear  int32   `protobuf:"varint,3,opt,name=construction_year,json=constructionYear,proto3" json:"construction_year,omitempty"`
+	TotalArea         float64 `protobuf:"fixed64,4,opt,name=total_area,json=totalArea,proto3" json:"total_area,omitempty"`
+	LivingArea        float64 `protobuf:"fixed64,5,opt,name=living_area,json=livingArea,proto3" json:"living_area,omitempty"`
+	FloorsAboveGround int32   `protobuf:"varint,6,opt,name=floors_above_ground,json=floorsAboveGround,proto3" json:"floors_above_ground,omitempty"`
+	FloorsUnderground int32   `protobuf:"varint,7,opt,name=floors_underground,json=floorsUnderground,proto3" json:"floors_underground,omitempty"`
+	Note              string  `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *GeneralInfo) Reset() {
+	*x = GeneralInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeneralInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneralInfo) ProtoMessage() {}
+
+func (x *GeneralInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneralInfo.ProtoReflect.Descriptor instead.
+func (*GeneralInfo) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GeneralInfo) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *GeneralInfo) GetActualUsage() string {
+	if x != nil {
+		return x.ActualUsage
+	}
+	return ""
+}
+
+func (x *GeneralInfo) GetConstructionYear() int32 {
+	if x != nil {
+		return x.ConstructionYear
+	}
+	return 0
+}
+
+func (x *GeneralInfo) GetTotalArea() float64 {
+	if x != nil {
+		return x.TotalArea
+	}
+	return 0
+}
+
+func (x *GeneralInfo) GetLivingArea() float64 {
+	if x != nil {
+		return x.LivingArea
+	}
+	return 0
+}
+
+func (x *GeneralInfo) GetFloorsAboveGround() int32 {
+	if x != nil {
+		return x.FloorsAboveGround
+	}
+	return 0
+}
+
+func (x *GeneralInfo) GetFloorsUnderground() int32 {
+	if x != nil {
+		return x.FloorsUnderground
+	}
+	return 0
+}
+
+func (x *GeneralInfo) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type Building struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Litera             string               `protobuf:"bytes,1,opt,name=litera,proto3" json:"litera,omitempty"`
+	Name               string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CommissionYear     int32                `protobuf:"varint,3,opt,name=commission_year,json=commissionYear,proto3" json:"commission_year,omitempty"`
+	WallMaterial       string               `protobuf:"bytes,4,opt,name=wall_material,json=wallMaterial,proto3" json:"wall_material,omitempty"`
+	TotalArea          float64              `protobuf:"fixed64,5,opt,name=total_area,json=totalArea,proto3" json:"total_area,omitempty"`
+	BuildArea          float64              `protobuf:"fixed64,6,opt,name=build_area,json=buildArea,proto3" json:"build_area,omitempty"`
+	Height             float64              `protobuf:"fixed64,7,opt,name=height,proto3" json:"height,omitempty"`
+	Volume             float64              `protobuf:"fixed64,8,opt,name=volume,proto3" json:"volume,omitempty"`
+	InventoryValue     float64              `protobuf:"fixed64,9,opt,name=inventory_value,json=inventoryValue,proto3" json:"inventory_value,omitempty"`
+	Attachments        []string             `protobuf:"bytes,10,rep,name=attachments,proto3" json:"attachments,omitempty"` // ID вложений паспорта
+	Valuation          *BuildingValuation   `protobuf:"bytes,11,opt,name=valuation,proto3" json:"valuation,omitempty"`
+	StructuralElements []*StructuralElement `protobuf:"bytes,12,rep,name=structural_elements,json=structuralElements,proto3" json:"structural_elements,omitempty"`
+	WearAssessment     *WearAssessment      `protobuf:"bytes,13,opt,name=wear_assessment,json=wearAssessment,proto3" json:"wear_assessment,omitempty"`
+}
+
+func (x *Building) Reset() {
+	*x = Building{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Building) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Building) ProtoMessage() {}
+
+func (x *Building) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Building.ProtoReflect.Descriptor instead.
+func (*Building) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Building) GetLitera() string {
+	if x != nil {
+		return x.Litera
+	}
+	return ""
+}
+
+func (x *Building) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Building) GetCommissionYear() int32 {
+	if x != nil {
+		return x.CommissionYear
+	}
+	return 0
+}
+
+func (x *Building) GetWallMaterial() string {
+	if x != nil {
+		return x.WallMaterial
+	}
+	return ""
+}
+
+func (x *Building) GetTotalArea() float64 {
+	if x != nil {
+		return x.TotalArea
+	}
+	return 0
+}
+
+func (x *Building) GetBuildArea() float64 {
+	if x != nil {
+		return x.BuildArea
+	}
+	return 0
+}
+
+func (x *Building) GetHeight() float64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Building) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *Building) GetInventoryValue() float64 {
+	if x != nil {
+		return x.InventoryValue
+	}
+	return 0
+}
+
+func (x *Building) GetAttachments() []string {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Building) GetValuation() *BuildingValuation {
+	if x != nil {
+		return x.Valuation
+	}
+	return nil
+}
+
+func (x *Building) GetStructuralElements() []*StructuralElement {
+	if x != nil {
+		return x.StructuralElements
+	}
+	return nil
+}
+
+func (x *Building) GetWearAssessment() *WearAssessment {
+	if x != nil {
+		return x.WearAssessment
+	}
+	return nil
+}
+
+// Structure сооружение или линейный объект в составе объекта
+type Structure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Litera         string             `protobuf:"bytes,1,opt,name=litera,proto3" json:"litera,omitempty"`
+	Kind           string             `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // fence, well, pipeline, road, septic, other
+	Name           string             `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CommissionYear int32              `protobuf:"varint,4,opt,name=commission_year,json=commissionYear,proto3" json:"commission_year,omitempty"`
+	Material       string             `protobuf:"bytes,5,opt,name=material,proto3" json:"material,omitempty"`
+	Length         float64            `protobuf:"fixed64,6,opt,name=length,proto3" json:"length,omitempty"`      // Протяженность, м
+	Width          float64            `protobuf:"fixed64,7,opt,name=width,proto3" json:"width,omitempty"`        // Ширина, м
+	Height         float64            `protobuf:"fixed64,8,opt,name=height,proto3" json:"height,omitempty"`      // Высота, м
+	Diameter       float64            `protobuf:"fixed64,9,opt,name=diameter,proto3" json:"diameter,omitempty"`  // Диаметр, мм
+	Depth          float64            `protobuf:"fixed64,10,opt,name=depth,proto3" json:"depth,omitempty"`       // Глубина, м
+	Capacity       float64            `protobuf:"fixed64,11,opt,name=capacity,proto3" json:"capacity,omitempty"` // Емкость, куб.м
+	Area           float64            `protobuf:"fixed64,12,opt,name=area,proto3" json:"area,omitempty"`         // Площадь, кв.м
+	Route          []*GeoPoint        `protobuf:"bytes,13,rep,name=route,proto3" json:"route,omitempty"`         // Трасса линейного объекта
+	Wear           float64            `protobuf:"fixed64,14,opt,name=wear,proto3" json:"wear,omitempty"`
+	InventoryValue float64            `protobuf:"fixed64,15,opt,name=inventory_value,json=inventoryValue,proto3" json:"inventory_value,omitempty"`
+	Valuation      *BuildingValuation `protobuf:"bytes,16,opt,name=valuation,proto3" json:"valuation,omitempty"`
+	Note           string             `protobuf:"bytes,17,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *Structure) Reset() {
+	*x = Structure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Structure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Structure) ProtoMessage() {}
+
+func (x *Structure) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Structure.ProtoReflect.Descriptor instead.
+func (*Structure) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Structure) GetLitera() string {
+	if x != nil {
+		return x.Litera
+	}
+	return ""
+}
+
+func (x *Structure) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Structure) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Structure) GetCommissionYear() int32 {
+	if x != nil {
+		return x.CommissionYear
+	}
+	return 0
+}
+
+func (x *Structure) GetMaterial() string {
+	if x != nil {
+		return x.Material
+	}
+	return ""
+}
+
+func (x *Structure) GetLength() float64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Structure) GetWidth() float64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Structure) GetHeight() float64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Structure) GetDiameter() float64 {
+	if x != nil {
+		return x.Diameter
+	}
+	return 0
+}
+
+func (x *Structure) GetDepth() float64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *Structure) GetCapacity() float64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Structure) GetArea() float64 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *Structure) GetRoute() []*GeoPoint {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *Structure) GetWear() float64 {
+	if x != nil {
+		return x.Wear
+	}
+	return 0
+}
+
+func (x *Structure) GetInventoryValue() float64 {
+	if x != nil {
+		return x.InventoryValue
+	}
+	return 0
+}
+
+func (x *Structure) GetValuation() *BuildingValuation {
+	if x != nil {
+		return x.Valuation
+	}
+	return nil
+}
+
+func (x *Structure) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// StructuralElement описание конструктивного элемента и его износ
+type StructuralElement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind        string  `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // foundation, walls, partitions, slabs, roof, floors, openings, finishing, engineering, other
+	Description string  `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Condition   string  `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"` // good, satisfactory, unsatisfactory, dilapidated, emergency; пустое - по износу
+	Wear        float64 `protobuf:"fixed64,4,opt,name=wear,proto3" json:"wear,omitempty"`
+}
+
+func (x *StructuralElement) Reset() {
+	*x = StructuralElement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StructuralElement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StructuralElement) ProtoMessage() {}
+
+func (x *StructuralElement) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StructuralElement.ProtoReflect.Descriptor instead.
+func (*StructuralElement) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StructuralElement) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *StructuralElement) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *StructuralElement) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *StructuralElement) GetWear() float64 {
+	if x != nil {
+		return x.Wear
+	}
+	return 0
+}
+
+// ElementWearShare вклад элемента во взвешенный износ здания
+type ElementWearShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind   string  `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Weight float64 `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"` // Удельный вес, %
+	Wear   float64 `protobuf:"fixed64,3,opt,name=wear,proto3" json:"wear,omitempty"`
+}
+
+func (x *ElementWearShare) Reset() {
+	*x = ElementWearShare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ElementWearShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElementWearShare) ProtoMessage() {}
+
+func (x *ElementWearShare) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElementWearShare.ProtoReflect.Descriptor instead.
+func (*ElementWearShare) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ElementWearShare) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ElementWearShare) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ElementWearShare) GetWear() float64 {
+	if x != nil {
+		return x.Wear
+	}
+	return 0
+}
+
+// WearAssessment физический износ здания по удельным весам элементов
+type WearAssessment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	TableVersion string                 `protobuf:"bytes,2,opt,name=table_version,json=tableVersion,proto3" json:"table_version,omitempty"`
+	WallGroup    string                 `protobuf:"bytes,3,opt,name=wall_group,json=wallGroup,proto3" json:"wall_group,omitempty"`
+	Elements     []*ElementWearShare    `protobuf:"bytes,4,rep,name=elements,proto3" json:"elements,omitempty"`
+	Wear         float64                `protobuf:"fixed64,5,opt,name=wear,proto3" json:"wear,omitempty"`
+	Warnings     []string               `protobuf:"bytes,6,rep,name=warnings,proto3" json:"warnings,omitempty"`
+}
+
+func (x *WearAssessment) Reset() {
+	*x = WearAssessment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WearAssessment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WearAssessment) ProtoMessage() {}
+
+func (x *WearAssessment) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WearAssessment.ProtoReflect.Descriptor instead.
+func (*WearAssessment) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WearAssessment) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *WearAssessment) GetTableVersion() string {
+	if x != nil {
+		return x.TableVersion
+	}
+	return ""
+}
+
+func (x *WearAssessment) GetWallGroup() string {
+	if x != nil {
+		return x.WallGroup
+	}
+	return ""
+}
+
+func (x *WearAssessment) GetElements() []*ElementWearShare {
+	if x != nil {
+		return x.Elements
+	}
+	return nil
+}
+
+func (x *WearAssessment) GetWear() float64 {
+	if x != nil {
+		return x.Wear
+	}
+	return 0
+}
+
+func (x *WearAssessment) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// ValuationInputs исходные данные расчета инвентаризационной стоимости
+type ValuationInputs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TableVersion   string             `protobuf:"bytes,1,opt,name=table_version,json=tableVersion,proto3" json:"table_version,omitempty"`
+	Region         string             `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Year           int32              `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	ObjectType     ObjectType         `protobuf:"varint,4,opt,name=object_type,json=objectType,proto3,enum=techpassport.v1.ObjectType" json:"object_type,omitempty"`
+	Name           string             `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Purpose        string             `protobuf:"bytes,6,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	WallMaterial   string             `protobuf:"bytes,7,opt,name=wall_material,json=wallMaterial,proto3" json:"wall_material,omitempty"`
+	Volume         float64            `protobuf:"fixed64,8,opt,name=volume,proto3" json:"volume,omitempty"`
+	TotalArea      float64            `protobuf:"fixed64,9,opt,name=total_area,json=totalArea,proto3" json:"total_area,omitempty"`
+	CommissionYear int32              `protobuf:"varint,10,opt,name=commission_year,json=commissionYear,proto3" json:"commission_year,omitempty"`
+	WearPercent    *float64           `protobuf:"fixed64,11,opt,name=wear_percent,json=wearPercent,proto3,oneof" json:"wear_percent,omitempty"` // Не задан - износ по элементам или по сроку службы
+	ElementWear    map[string]float64 `protobuf:"bytes,12,rep,name=element_wear,json=elementWear,proto3" json:"element_wear,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	StructureKind  string             `protobuf:"bytes,13,opt,name=structure_kind,json=structureKind,proto3" json:"structure_kind,omitempty"` // Вид сооружения: fence, well, pipeline, road, septic, other
+	Length         float64            `protobuf:"fixed64,14,opt,name=length,proto3" json:"length,omitempty"`                                  // Протяженность сооружения, м
+}
+
+func (x *ValuationInputs) Reset() {
+	*x = ValuationInputs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValuationInputs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValuationInputs) ProtoMessage() {}
+
+func (x *ValuationInputs) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValuationInputs.ProtoReflect.Descriptor instead.
+func (*ValuationInputs) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ValuationInputs) GetTableVersion() string {
+	if x != nil {
+		return x.TableVersion
+	}
+	return ""
+}
+
+func (x *ValuationInputs) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ValuationInputs) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *ValuationInputs) GetObjectType() ObjectType {
+	if x != nil {
+		return x.ObjectType
+	}
+	return ObjectType_OBJECT_TYPE_UNSPECIFIED
+}
+
+func (x *ValuationInputs) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ValuationInputs) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *ValuationInputs) GetWallMaterial() string {
+	if x != nil {
+		return x.WallMaterial
+	}
+	return ""
+}
+
+func (x *ValuationInputs) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *ValuationInputs) GetTotalArea() float64 {
+	if x != nil {
+		return x.TotalArea
+	}
+	return 0
+}
+
+func (x *ValuationInputs) GetCommissionYear() int32 {
+	if x != nil {
+		return x.CommissionYear
+	}
+	return 0
+}
+
+func (x *ValuationInputs) GetWearPercent() float64 {
+	if x != nil && x.WearPercent != nil {
+		return *x.WearPercent
+	}
+	return 0
+}
+
+func (x *ValuationInputs) GetElementWear() map[string]float64 {
+	if x != nil {
+		return x.ElementWear
+	}
+	return nil
+}
+
+func (x *ValuationInputs) GetStructureKind() string {
+	if x != nil {
+		return x.StructureKind
+	}
+	return ""
+}
+
+func (x *ValuationInputs) GetLength() float64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+// BuildingValuation расчет инвентаризационной стоимости здания
+type BuildingValuation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date                *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Inputs              *ValuationInputs       `protobuf:"bytes,2,opt,name=inputs,proto3" json:"inputs,omitempty"`
+	WallGroup           string                 `protobuf:"bytes,3,opt,name=wall_group,json=wallGroup,proto3" json:"wall_group,omitempty"`
+	Unit                string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"` // m3, m2 или m
+	Quantity            float64                `protobuf:"fixed64,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitCost            float64                `protobuf:"fixed64,6,opt,name=unit_cost,json=unitCost,proto3" json:"unit_cost,omitempty"`
+	RegionalCoefficient float64                `protobuf:"fixed64,7,opt,name=regional_coefficient,json=regionalCoefficient,proto3" json:"regional_coefficient,omitempty"`
+	PriceIndex          float64                `protobuf:"fixed64,8,opt,name=price_index,json=priceIndex,proto3" json:"price_index,omitempty"`
+	ReplacementCost     float64                `protobuf:"fixed64,9,opt,name=replacement_cost,json=replacementCost,proto3" json:"replacement_cost,omitempty"`
+	Wear                float64                `protobuf:"fixed64,10,opt,name=wear,proto3" json:"wear,omitempty"`
+	WearByAge           bool                   `protobuf:"varint,11,opt,name=wear_by_age,json=wearByAge,proto3" json:"wear_by_age,omitempty"`
+	InventoryValue      float64                `protobuf:"fixed64,12,opt,name=inventory_value,json=inventoryValue,proto3" json:"inventory_value,omitempty"`
+	Warnings            []string               `protobuf:"bytes,13,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	WearElements        []*ElementWearShare    `protobuf:"bytes,14,rep,name=wear_elements,json=wearElements,proto3" json:"wear_elements,omitempty"`
+}
+
+func (x *BuildingValuation) Reset() {
+	*x = BuildingValuation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildingValuation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildingValuation) ProtoMessage() {}
+
+func (x *BuildingValuation) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildingValuation.ProtoReflect.Descriptor instead.
+func (*BuildingValuation) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BuildingValuation) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *BuildingValuation) GetInputs() *ValuationInputs {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *BuildingValuation) GetWallGroup() string {
+	if x != nil {
+		return x.WallGroup
+	}
+	return ""
+}
+
+func (x *BuildingValuation) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *BuildingValuation) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *BuildingValuation) GetUnitCost() float64 {
+	if x != nil {
+		return x.UnitCost
+	}
+	return 0
+}
+
+func (x *BuildingValuation) GetRegionalCoefficient() float64 {
+	if x != nil {
+		return x.RegionalCoefficient
+	}
+	return 0
+}
+
+func (x *BuildingValuation) GetPriceIndex() float64 {
+	if x != nil {
+		return x.PriceIndex
+	}
+	return 0
+}
+
+func (x *BuildingValuation) GetReplacementCost() float64 {
+	if x != nil {
+		return x.ReplacementCost
+	}
+	return 0
+}
+
+func (x *BuildingValuation) GetWear() float64 {
+	if x != nil {
+		return x.Wear
+	}
+	return 0
+}
+
+func (x *BuildingValuation) GetWearByAge() bool {
+	if x != nil {
+		return x.WearByAge
+	}
+	return false
+}
+
+func (x *BuildingValuation) GetInventoryValue() float64 {
+	if x != nil {
+		return x.InventoryValue
+	}
+	return 0
+}
+
+func (x *BuildingValuation) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *BuildingValuation) GetWearElements() []*ElementWearShare {
+	if x != nil {
+		return x.WearElements
+	}
+	return nil
+}
+
+type Owner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntryDate     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=entry_date,json=entryDate,proto3" json:"entry_date,omitempty"`
+	PersonType    PersonType             `protobuf:"varint,2,opt,name=person_type,json=personType,proto3,enum=techpassport.v1.PersonType" json:"person_type,omitempty"`
+	FullName      string                 `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	PassportData  string                 `protobuf:"bytes,4,opt,name=passport_data,json=passportData,proto3" json:"passport_data,omitempty"`
+	CompanyName   string                 `protobuf:"bytes,5,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	Tin           string                 `protobuf:"bytes,6,opt,name=tin,proto3" json:"tin,omitempty"`
+	RightType     string                 `protobuf:"bytes,7,opt,name=right_type,json=rightType,proto3" json:"right_type,omitempty"`
+	RightDocument string                 `protobuf:"bytes,8,opt,name=right_document,json=rightDocument,proto3" json:"right_document,omitempty"`
+	Share         string                 `protobuf:"bytes,9,opt,name=share,proto3" json:"share,omitempty"`
+	Attachments   []string               `protobuf:"bytes,10,rep,name=attachments,proto3" json:"attachments,omitempty"` // ID вложений паспорта
+}
+
+func (x *Owner) Reset() {
+	*x = Owner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Owner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Owner) ProtoMessage() {}
+
+func (x *Owner) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Owner.ProtoReflect.Descriptor instead.
+func (*Owner) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Owner) GetEntryDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EntryDate
+	}
+	return nil
+}
+
+func (x *Owner) GetPersonType() PersonType {
+	if x != nil {
+		return x.PersonType
+	}
+	return PersonType_PERSON_TYPE_UNSPECIFIED
+}
+
+func (x *Owner) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *Owner) GetPassportData() string {
+	if x != nil {
+		return x.PassportData
+	}
+	return ""
+}
+
+func (x *Owner) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *Owner) GetTin() string {
+	if x != nil {
+		return x.Tin
+	}
+	return ""
+}
+
+func (x *Owner) GetRightType() string {
+	if x != nil {
+		return x.RightType
+	}
+	return ""
+}
+
+func (x *Owner) GetRightDocument() string {
+	if x != nil {
+		return x.RightDocument
+	}
+	return ""
+}
+
+func (x *Owner) GetShare() string {
+	if x != nil {
+		return x.Share
+	}
+	return ""
+}
+
+func (x *Owner) GetAttachments() []string {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type Room struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Litera           string            `protobuf:"bytes,1,opt,name=litera,proto3" json:"litera,omitempty"`
+	Floor            string            `protobuf:"bytes,2,opt,name=floor,proto3" json:"floor,omitempty"`
+	RoomNumber       string            `protobuf:"bytes,3,opt,name=room_number,json=roomNumber,proto3" json:"room_number,omitempty"`
+	Purpose          string            `protobuf:"bytes,4,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	Area             float64           `protobuf:"fixed64,5,opt,name=area,proto3" json:"area,omitempty"`
+	LivingArea       float64           `protobuf:"fixed64,6,opt,name=living_area,json=livingArea,proto3" json:"living_area,omitempty"`
+	AuxiliaryArea    float64           `protobuf:"fixed64,7,opt,name=auxiliary_area,json=auxiliaryArea,proto3" json:"auxiliary_area,omitempty"`
+	Height           float64           `protobuf:"fixed64,8,opt,name=height,proto3" json:"height,omitempty"`
+	UnauthorizedArea float64           `protobuf:"fixed64,9,opt,name=unauthorized_area,json=unauthorizedArea,proto3" json:"unauthorized_area,omitempty"`
+	Note             string            `protobuf:"bytes,10,opt,name=note,proto3" json:"note,omitempty"`
+	Measurements     *RoomMeasurements `protobuf:"bytes,11,opt,name=measurements,proto3" json:"measurements,omitempty"`                  // Обмер, по которому рассчитана площадь
+	UseCategory      string            `protobuf:"bytes,12,opt,name=use_category,json=useCategory,proto3" json:"use_category,omitempty"` // office, retail, storage, catering, production, other
+}
+
+func (x *Room) Reset() {
+	*x = Room{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Room) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Room) ProtoMessage() {}
+
+func (x *Room) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Room.ProtoReflect.Descriptor instead.
+func (*Room) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Room) GetLitera() string {
+	if x != nil {
+		return x.Litera
+	}
+	return ""
+}
+
+func (x *Room) GetFloor() string {
+	if x != nil {
+		return x.Floor
+	}
+	return ""
+}
+
+func (x *Room) GetRoomNumber() string {
+	if x != nil {
+		return x.RoomNumber
+	}
+	return ""
+}
+
+func (x *Room) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *Room) GetArea() float64 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *Room) GetLivingArea() float64 {
+	if x != nil {
+		return x.LivingArea
+	}
+	return 0
+}
+
+func (x *Room) GetAuxiliaryArea() float64 {
+	if x != nil {
+		return x.AuxiliaryArea
+	}
+	return 0
+}
+
+func (x *Room) GetHeight() float64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Room) GetUnauthorizedArea() float64 {
+	if x != nil {
+		return x.UnauthorizedArea
+	}
+	return 0
+}
+
+func (x *Room) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Room) GetMeasurements() *RoomMeasurements {
+	if x != nil {
+		return x.Measurements
+	}
+	return nil
+}
+
+func (x *Room) GetUseCategory() string {
+	if x != nil {
+		return x.UseCategory
+	}
+	return ""
+}
+
+// Entrance подъезд многоквартирного дома
+type Entrance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number  string `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	Section string `protobuf:"bytes,2,opt,name=section,proto3" json:"section,omitempty"`
+	Litera  string `protobuf:"bytes,3,opt,name=litera,proto3" json:"litera,omitempty"`
+	Floors  int32  `protobuf:"varint,4,opt,name=floors,proto3" json:"floors,omitempty"`
+	Note    string `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *Entrance) Reset() {
+	*x = Entrance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Entrance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entrance) ProtoMessage() {}
+
+func (x *Entrance) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entrance.ProtoReflect.Descriptor instead.
+func (*Entrance) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Entrance) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Entrance) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *Entrance) GetLitera() string {
+	if x != nil {
+		return x.Litera
+	}
+	return ""
+}
+
+func (x *Entrance) GetFloors() int32 {
+	if x != nil {
+		return x.Floors
+	}
+	return 0
+}
+
+func (x *Entrance) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// CommercialInfo пожарная безопасность нежилого объекта
+type CommercialInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FireHazardClass   string `protobuf:"bytes,1,opt,name=fire_hazard_class,json=fireHazardClass,proto3" json:"fire_hazard_class,omitempty"`     // Класс функциональной пожарной опасности: Ф1.1-Ф5.3
+	ExplosionCategory string `protobuf:"bytes,2,opt,name=explosion_category,json=explosionCategory,proto3" json:"explosion_category,omitempty"` // Категория по взрывопожарной опасности: А, Б, В1-В4, Г, Д
+	FireResistance    string `protobuf:"bytes,3,opt,name=fire_resistance,json=fireResistance,proto3" json:"fire_resistance,omitempty"`          // Степень огнестойкости: I-V
+	EvacuationExits   int32  `protobuf:"varint,4,opt,name=evacuation_exits,json=evacuationExits,proto3" json:"evacuation_exits,omitempty"`
+	SeparateEntrance  bool   `protobuf:"varint,5,opt,name=separate_entrance,json=separateEntrance,proto3" json:"separate_entrance,omitempty"`
+	Note              string `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *CommercialInfo) Reset() {
+	*x = CommercialInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommercialInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommercialInfo) ProtoMessage() {}
+
+func (x *CommercialInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommercialInfo.ProtoReflect.Descriptor instead.
+func (*CommercialInfo) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CommercialInfo) GetFireHazardClass() string {
+	if x != nil {
+		return x.FireHazardClass
+	}
+	return ""
+}
+
+func (x *CommercialInfo) GetExplosionCategory() string {
+	if x != nil {
+		return x.ExplosionCategory
+	}
+	return ""
+}
+
+func (x *CommercialInfo) GetFireResistance() string {
+	if x != nil {
+		return x.FireResistance
+	}
+	return ""
+}
+
+func (x *CommercialInfo) GetEvacuationExits() int32 {
+	if x != nil {
+		return x.EvacuationExits
+	}
+	return 0
+}
+
+func (x *CommercialInfo) GetSeparateEntrance() bool {
+	if x != nil {
+		return x.SeparateEntrance
+	}
+	return false
+}
+
+func (x *CommercialInfo) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// Flat квартира многоквартирного дома с экспликацией
+type Flat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number          string  `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	Litera          string  `protobuf:"bytes,2,opt,name=litera,proto3" json:"litera,omitempty"`
+	Entrance        string  `protobuf:"bytes,3,opt,name=entrance,proto3" json:"entrance,omitempty"`
+	Floor           string  `protobuf:"bytes,4,opt,name=floor,proto3" json:"floor,omitempty"`
+	CadastralNumber string  `protobuf:"bytes,5,opt,name=cadastral_number,json=cadastralNumber,proto3" json:"cadastral_number,omitempty"`
+	Explication     []*Room `protobuf:"bytes,6,rep,name=explication,proto3" json:"explication,omitempty"`
+}
+
+func (x *Flat) Reset() {
+	*x = Flat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Flat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Flat) ProtoMessage() {}
+
+func (x *Flat) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Flat.ProtoReflect.Descriptor instead.
+func (*Flat) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Flat) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Flat) GetLitera() string {
+	if x != nil {
+		return x.Litera
+	}
+	return ""
+}
+
+func (x *Flat) GetEntrance() string {
+	if x != nil {
+		return x.Entrance
+	}
+	return ""
+}
+
+func (x *Flat) GetFloor() string {
+	if x != nil {
+		return x.Floor
+	}
+	return ""
+}
+
+func (x *Flat) GetCadastralNumber() string {
+	if x != nil {
+		return x.CadastralNumber
+	}
+	return ""
+}
+
+func (x *Flat) GetExplication() []*Room {
+	if x != nil {
+		return x.Explication
+	}
+	return nil
+}
+
+// MeasuredShape фигура обмера помещения, размеры в метрах
+type MeasuredShape struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind      ShapeKind `protobuf:"varint,1,opt,name=kind,proto3,enum=techpassport.v1.ShapeKind" json:"kind,omitempty"`
+	Role      ShapeRole `protobuf:"varint,2,opt,name=role,proto3,enum=techpassport.v1.ShapeRole" json:"role,omitempty"`
+	Length    float64   `protobuf:"fixed64,3,opt,name=length,proto3" json:"length,omitempty"`
+	Width     float64   `protobuf:"fixed64,4,opt,name=width,proto3" json:"width,omitempty"`
+	Sides     []float64 `protobuf:"fixed64,5,rep,packed,name=sides,proto3" json:"sides,omitempty"`
+	Diagonals []float64 `protobuf:"fixed64,6,rep,packed,name=diagonals,proto3" json:"diagonals,omitempty"`
+	Diameter  float64   `protobuf:"fixed64,7,opt,name=diameter,proto3" json:"diameter,omitempty"`
+	Height    float64   `protobuf:"fixed64,8,opt,name=height,proto3" json:"height,omitempty"`
+	Note      string    `protobuf:"bytes,9,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *MeasuredShape) Reset() {
+	*x = MeasuredShape{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeasuredShape) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeasuredShape) ProtoMessage() {}
+
+func (x *MeasuredShape) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeasuredShape.ProtoReflect.Descriptor instead.
+func (*MeasuredShape) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MeasuredShape) GetKind() ShapeKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ShapeKind_SHAPE_KIND_UNSPECIFIED
+}
+
+func (x *MeasuredShape) GetRole() ShapeRole {
+	if x != nil {
+		return x.Role
+	}
+	return ShapeRole_SHAPE_ROLE_UNSPECIFIED
+}
+
+func (x *MeasuredShape) GetLength() float64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *MeasuredShape) GetWidth() float64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *MeasuredShape) GetSides() []float64 {
+	if x != nil {
+		return x.Sides
+	}
+	return nil
+}
+
+func (x *MeasuredShape) GetDiagonals() []float64 {
+	if x != nil {
+		return x.Diagonals
+	}
+	return nil
+}
+
+func (x *MeasuredShape) GetDiameter() float64 {
+	if x != nil {
+		return x.Diameter
+	}
+	return 0
+}
+
+func (x *MeasuredShape) GetHeight() float64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *MeasuredShape) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type RoomMeasurements struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shapes       []*MeasuredShape       `protobuf:"bytes,1,rep,name=shapes,proto3" json:"shapes,omitempty"`
+	Area         float64                `protobuf:"fixed64,2,opt,name=area,proto3" json:"area,omitempty"`
+	MeasuredBy   string                 `protobuf:"bytes,3,opt,name=measured_by,json=measuredBy,proto3" json:"measured_by,omitempty"`
+	MeasuredDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=measured_date,json=measuredDate,proto3" json:"measured_date,omitempty"`
+}
+
+func (x *RoomMeasurements) Reset() {
+	*x = RoomMeasurements{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomMeasurements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomMeasurements) ProtoMessage() {}
+
+func (x *RoomMeasurements) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomMeasurements.ProtoReflect.Descriptor instead.
+func (*RoomMeasurements) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RoomMeasurements) GetShapes() []*MeasuredShape {
+	if x != nil {
+		return x.Shapes
+	}
+	return nil
+}
+
+func (x *RoomMeasurements) GetArea() float64 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *RoomMeasurements) GetMeasuredBy() string {
+	if x != nil {
+		return x.MeasuredBy
+	}
+	return ""
+}
+
+func (x *RoomMeasurements) GetMeasuredDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MeasuredDate
+	}
+	return nil
+}
+
+type UtilityConnection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Centralized float64 `protobuf:"fixed64,1,opt,name=centralized,proto3" json:"centralized,omitempty"`
+	Autonomous  float64 `protobuf:"fixed64,2,opt,name=autonomous,proto3" json:"autonomous,omitempty"`
+}
+
+func (x *UtilityConnection) Reset() {
+	*x = UtilityConnection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UtilityConnection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UtilityConnection) ProtoMessage() {}
+
+func (x *UtilityConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UtilityConnection.ProtoReflect.Descriptor instead.
+func (*UtilityConnection) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UtilityConnection) GetCentralized() float64 {
+	if x != nil {
+		return x.Centralized
+	}
+	return 0
+}
+
+func (x *UtilityConnection) GetAutonomous() float64 {
+	if x != nil {
+		return x.Autonomous
+	}
+	return 0
+}
+
+type Utilities struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Water       *UtilityConnection `protobuf:"bytes,1,opt,name=water,proto3" json:"water,omitempty"`
+	Sewerage    *UtilityConnection `protobuf:"bytes,2,opt,name=sewerage,proto3" json:"sewerage,omitempty"`
+	Heating     *UtilityConnection `protobuf:"bytes,3,opt,name=heating,proto3" json:"heating,omitempty"`
+	HotWater    *UtilityConnection `protobuf:"bytes,4,opt,name=hot_water,json=hotWater,proto3" json:"hot_water,omitempty"`
+	Gas         *UtilityConnection `protobuf:"bytes,5,opt,name=gas,proto3" json:"gas,omitempty"`
+	Electricity *UtilityConnection `protobuf:"bytes,6,opt,name=electricity,proto3" json:"electricity,omitempty"`
+	Other       string             `protobuf:"bytes,7,opt,name=other,proto3" json:"other,omitempty"`
+}
+
+func (x *Utilities) Reset() {
+	*x = Utilities{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Utilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Utilities) ProtoMessage() {}
+
+func (x *Utilities) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Utilities.ProtoReflect.Descriptor instead.
+func (*Utilities) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Utilities) GetWater() *UtilityConnection {
+	if x != nil {
+		return x.Water
+	}
+	return nil
+}
+
+func (x *Utilities) GetSewerage() *UtilityConnection {
+	if x != nil {
+		return x.Sewerage
+	}
+	return nil
+}
+
+func (x *Utilities) GetHeating() *UtilityConnection {
+	if x != nil {
+		return x.Heating
+	}
+	return nil
+}
+
+func (x *Utilities) GetHotWater() *UtilityConnection {
+	if x != nil {
+		return x.HotWater
+	}
+	return nil
+}
+
+func (x *Utilities) GetGas() *UtilityConnection {
+	if x != nil {
+		return x.Gas
+	}
+	return nil
+}
+
+func (x *Utilities) GetElectricity() *UtilityConnection {
+	if x != nil {
+		return x.Electricity
+	}
+	return nil
+}
+
+func (x *Utilities) GetOther() string {
+	if x != nil {
+		return x.Other
+	}
+	return ""
+}
+
+// Point точка поэтажного плана в метрах, ось Y направлена вверх
+type Point struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X float64 `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y float64 `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *Point) Reset() {
+	*x = Point{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Point) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Point) ProtoMessage() {}
+
+func (x *Point) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Point.ProtoReflect.Descriptor instead.
+func (*Point) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Point) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Point) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type Wall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start     *Point  `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End       *Point  `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Thickness float64 `protobuf:"fixed64,3,opt,name=thickness,proto3" json:"thickness,omitempty"`
+}
+
+func (x *Wall) Reset() {
+	*x = Wall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Wall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Wall) ProtoMessage() {}
+
+func (x *Wall) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Wall.ProtoReflect.Descriptor instead.
+func (*Wall) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Wall) GetStart() *Point {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *Wall) GetEnd() *Point {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *Wall) GetThickness() float64 {
+	if x != nil {
+		return x.Thickness
+	}
+	return 0
+}
+
+type Opening struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   OpeningType `protobuf:"varint,1,opt,name=type,proto3,enum=techpassport.v1.OpeningType" json:"type,omitempty"`
+	Wall   int32       `protobuf:"varint,2,opt,name=wall,proto3" json:"wall,omitempty"`      // Индекс стены в FloorPlan.walls
+	Offset float64     `protobuf:"fixed64,3,opt,name=offset,proto3" json:"offset,omitempty"` // От начала стены до начала проема, м
+	Width  float64     `protobuf:"fixed64,4,opt,name=width,proto3" json:"width,omitempty"`
+}
+
+func (x *Opening) Reset() {
+	*x = Opening{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Opening) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Opening) ProtoMessage() {}
+
+func (x *Opening) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Opening.ProtoReflect.Descriptor instead.
+func (*Opening) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Opening) GetType() OpeningType {
+	if x != nil {
+		return x.Type
+	}
+	return OpeningType_OPENING_TYPE_UNSPECIFIED
+}
+
+func (x *Opening) GetWall() int32 {
+	if x != nil {
+		return x.Wall
+	}
+	return 0
+}
+
+func (x *Opening) GetOffset() float64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Opening) GetWidth() float64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+type RoomContour struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomNumber string   `protobuf:"bytes,1,opt,name=room_number,json=roomNumber,proto3" json:"room_number,omitempty"`
+	Points     []*Point `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *RoomContour) Reset() {
+	*x = RoomContour{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomContour) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomContour) ProtoMessage() {}
+
+func (x *RoomContour) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomContour.ProtoReflect.Descriptor instead.
+func (*RoomContour) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RoomContour) GetRoomNumber() string {
+	if x != nil {
+		return x.RoomNumber
+	}
+	return ""
+}
+
+func (x *RoomContour) GetPoints() []*Point {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// FloorPlan векторный поэтажный план этажа литеры
+type FloorPlan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Litera      string         `protobuf:"bytes,1,opt,name=litera,proto3" json:"litera,omitempty"`
+	Floor       string         `protobuf:"bytes,2,opt,name=floor,proto3" json:"floor,omitempty"`
+	Walls       []*Wall        `protobuf:"bytes,3,rep,name=walls,proto3" json:"walls,omitempty"`
+	Openings    []*Opening     `protobuf:"bytes,4,rep,name=openings,proto3" json:"openings,omitempty"`
+	Rooms       []*RoomContour `protobuf:"bytes,5,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	Background  string         `protobuf:"bytes,6,opt,name=background,proto3" json:"background,omitempty"`   // Путь к отсканированному плану
+	Attachments []string       `protobuf:"bytes,7,rep,name=attachments,proto3" json:"attachments,omitempty"` // ID вложений паспорта
+}
+
+func (x *FloorPlan) Reset() {
+	*x = FloorPlan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FloorPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloorPlan) ProtoMessage() {}
+
+func (x *FloorPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloorPlan.ProtoReflect.Descriptor instead.
+func (*FloorPlan) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FloorPlan) GetLitera() string {
+	if x != nil {
+		return x.Litera
+	}
+	return ""
+}
+
+func (x *FloorPlan) GetFloor() string {
+	if x != nil {
+		return x.Floor
+	}
+	return ""
+}
+
+func (x *FloorPlan) GetWalls() []*Wall {
+	if x != nil {
+		return x.Walls
+	}
+	return nil
+}
+
+func (x *FloorPlan) GetOpenings() []*Opening {
+	if x != nil {
+		return x.Openings
+	}
+	return nil
+}
+
+func (x *FloorPlan) GetRooms() []*RoomContour {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+func (x *FloorPlan) GetBackground() string {
+	if x != nil {
+		return x.Background
+	}
+	return ""
+}
+
+func (x *FloorPlan) GetAttachments() []string {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// GeoPoint точка ситуационного плана: в МСК X - на север, Y - на восток;
+// в WGS 84 X - широта, Y - долгота
+type GeoPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	X    float64 `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y    float64 `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *GeoPoint) Reset() {
+	*x = GeoPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPoint) ProtoMessage() {}
+
+func (x *GeoPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GeoPoint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GeoPoint) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *GeoPoint) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type BuildingFootprint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Litera string      `protobuf:"bytes,1,opt,name=litera,proto3" json:"litera,omitempty"`
+	Points []*GeoPoint `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *BuildingFootprint) Reset() {
+	*x = BuildingFootprint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildingFootprint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildingFootprint) ProtoMessage() {}
+
+func (x *BuildingFootprint) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildingFootprint.ProtoReflect.Descriptor instead.
+func (*BuildingFootprint) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BuildingFootprint) GetLitera() string {
+	if x != nil {
+		return x.Litera
+	}
+	return ""
+}
+
+func (x *BuildingFootprint) GetPoints() []*GeoPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type PlanAnnotation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string    `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	At   *GeoPoint `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+}
+
+func (x *PlanAnnotation) Reset() {
+	*x = PlanAnnotation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanAnnotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanAnnotation) ProtoMessage() {}
+
+func (x *PlanAnnotation) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanAnnotation.ProtoReflect.Descriptor instead.
+func (*PlanAnnotation) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PlanAnnotation) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PlanAnnotation) GetAt() *GeoPoint {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+// SituationPlan векторный ситуационный план участка
+type SituationPlan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoordinateSystem CoordinateSystem     `protobuf:"varint,1,opt,name=coordinate_system,json=coordinateSystem,proto3,enum=techpassport.v1.CoordinateSystem" json:"coordinate_system,omitempty"`
+	Zone             string               `protobuf:"bytes,2,opt,name=zone,proto3" json:"zone,omitempty"`
+	Boundary         []*GeoPoint          `protobuf:"bytes,3,rep,name=boundary,proto3" json:"boundary,omitempty"`
+	Footprints       []*BuildingFootprint `protobuf:"bytes,4,rep,name=footprints,proto3" json:"footprints,omitempty"`
+	Annotations      []*PlanAnnotation    `protobuf:"bytes,5,rep,name=annotations,proto3" json:"annotations,omitempty"`
+	Attachments      []string             `protobuf:"bytes,6,rep,name=attachments,proto3" json:"attachments,omitempty"` // ID вложений паспорта
+}
+
+func (x *SituationPlan) Reset() {
+	*x = SituationPlan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SituationPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SituationPlan) ProtoMessage() {}
+
+func (x *SituationPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SituationPlan.ProtoReflect.Descriptor instead.
+func (*SituationPlan) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SituationPlan) GetCoordinateSystem() CoordinateSystem {
+	if x != nil {
+		return x.CoordinateSystem
+	}
+	return CoordinateSystem_COORDINATE_SYSTEM_UNSPECIFIED
+}
+
+func (x *SituationPlan) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+func (x *SituationPlan) GetBoundary() []*GeoPoint {
+	if x != nil {
+		return x.Boundary
+	}
+	return nil
+}
+
+func (x *SituationPlan) GetFootprints() []*BuildingFootprint {
+	if x != nil {
+		return x.Footprints
+	}
+	return nil
+}
+
+func (x *SituationPlan) GetAnnotations() []*PlanAnnotation {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *SituationPlan) GetAttachments() []string {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// Attachment описание вложения; содержимое передается отдельно от паспорта
+type Attachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // SHA-256 содержимого
+	FileName    string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	MimeType    string                 `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Size        int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Description string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Thumbnail   bool                   `protobuf:"varint,6,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	AddedDate   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=added_date,json=addedDate,proto3" json:"added_date,omitempty"`
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Attachment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attachment) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Attachment) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Attachment) GetThumbnail() bool {
+	if x != nil {
+		return x.Thumbnail
+	}
+	return false
+}
+
+func (x *Attachment) GetAddedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedDate
+	}
+	return nil
+}
+
+type AuditEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Action      string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	User        string                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AuditEntry) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type Passport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ObjectType        ObjectType             `protobuf:"varint,2,opt,name=object_type,json=objectType,proto3,enum=techpassport.v1.ObjectType" json:"object_type,omitempty"`
+	Address           *Address               `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	OrganizationName  string                 `protobuf:"bytes,4,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	InventoryNumber   string                 `protobuf:"bytes,5,opt,name=inventory_number,json=inventoryNumber,proto3" json:"inventory_number,omitempty"`
+	CadastralNumber   string                 `protobuf:"bytes,6,opt,name=cadastral_number,json=cadastralNumber,proto3" json:"cadastral_number,omitempty"`
+	Status            PassportStatus         `protobuf:"varint,7,opt,name=status,proto3,enum=techpassport.v1.PassportStatus" json:"status,omitempty"`
+	CreatedDate       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_date,json=createdDate,proto3" json:"created_date,omitempty"`
+	UpdatedDate       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_date,json=updatedDate,proto3" json:"updated_date,omitempty"`
+	AsOfDate          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=as_of_date,json=asOfDate,proto3" json:"as_of_date,omitempty"`
+	GeneralInfo       *GeneralInfo           `protobuf:"bytes,11,opt,name=general_info,json=generalInfo,proto3" json:"general_info,omitempty"`
+	Buildings         []*Building            `protobuf:"bytes,12,rep,name=buildings,proto3" json:"buildings,omitempty"`
+	Owners            []*Owner               `protobuf:"bytes,13,rep,name=owners,proto3" json:"owners,omitempty"`
+	SituationPlanPath string                 `protobuf:"bytes,14,opt,name=situation_plan_path,json=situationPlanPath,proto3" json:"situation_plan_path,omitempty"`
+	Utilities         *Utilities             `protobuf:"bytes,15,opt,name=utilities,proto3" json:"utilities,omitempty"`
+	Explication       []*Room                `protobuf:"bytes,17,rep,name=explication,proto3" json:"explication,omitempty"`
+	AuditLog          []*AuditEntry          `protobuf:"bytes,18,rep,name=audit_log,json=auditLog,proto3" json:"audit_log,omitempty"`
+	FloorPlans        []*FloorPlan           `protobuf:"bytes,19,rep,name=floor_plans,json=floorPlans,proto3" json:"floor_plans,omitempty"`
+	SituationPlan     *SituationPlan         `protobuf:"bytes,20,opt,name=situation_plan,json=situationPlan,proto3" json:"situation_plan,omitempty"`
+	Attachments       []*Attachment          `protobuf:"bytes,21,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Entrances         []*Entrance            `protobuf:"bytes,22,rep,name=entrances,proto3" json:"entrances,omitempty"`                        // Подъезды многоквартирного дома
+	Flats             []*Flat                `protobuf:"bytes,23,rep,name=flats,proto3" json:"flats,omitempty"`                                // Квартиры с экспликацией
+	CommonAreas       []*Room                `protobuf:"bytes,24,rep,name=common_areas,json=commonAreas,proto3" json:"common_areas,omitempty"` // Помещения общего имущества
+	Commercial        *CommercialInfo        `protobuf:"bytes,25,opt,name=commercial,proto3" json:"commercial,omitempty"`                      // Пожарная безопасность нежилого объекта
+	Structures        []*Structure           `protobuf:"bytes,26,rep,name=structures,proto3" json:"structures,omitempty"`                      // Сооружения и линейные объекты
+}
+
+func (x *Passport) Reset() {
+	*x = Passport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Passport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Passport) ProtoMessage() {}
+
+func (x *Passport) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Passport.ProtoReflect.Descriptor instead.
+func (*Passport) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Passport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Passport) GetObjectType() ObjectType {
+	if x != nil {
+		return x.ObjectType
+	}
+	return ObjectType_OBJECT_TYPE_UNSPECIFIED
+}
+
+func (x *Passport) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Passport) GetOrganizationName() string {
+	if x != nil {
+		return x.OrganizationName
+	}
+	return ""
+}
+
+func (x *Passport) GetInventoryNumber() string {
+	if x != nil {
+		return x.InventoryNumber
+	}
+	return ""
+}
+
+func (x *Passport) GetCadastralNumber() string {
+	if x != nil {
+		return x.CadastralNumber
+	}
+	return ""
+}
+
+func (x *Passport) GetStatus() PassportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PassportStatus_PASSPORT_STATUS_UNSPECIFIED
+}
+
+func (x *Passport) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+func (x *Passport) GetUpdatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedDate
+	}
+	return nil
+}
+
+func (x *Passport) GetAsOfDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOfDate
+	}
+	return nil
+}
+
+func (x *Passport) GetGeneralInfo() *GeneralInfo {
+	if x != nil {
+		return x.GeneralInfo
+	}
+	return nil
+}
+
+func (x *Passport) GetBuildings() []*Building {
+	if x != nil {
+		return x.Buildings
+	}
+	return nil
+}
+
+func (x *Passport) GetOwners() []*Owner {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+func (x *Passport) GetSituationPlanPath() string {
+	if x != nil {
+		return x.SituationPlanPath
+	}
+	return ""
+}
+
+func (x *Passport) GetUtilities() *Utilities {
+	if x != nil {
+		return x.Utilities
+	}
+	return nil
+}
+
+func (x *Passport) GetExplication() []*Room {
+	if x != nil {
+		return x.Explication
+	}
+	return nil
+}
+
+func (x *Passport) GetAuditLog() []*AuditEntry {
+	if x != nil {
+		return x.AuditLog
+	}
+	return nil
+}
+
+func (x *Passport) GetFloorPlans() []*FloorPlan {
+	if x != nil {
+		return x.FloorPlans
+	}
+	return nil
+}
+
+func (x *Passport) GetSituationPlan() *SituationPlan {
+	if x != nil {
+		return x.SituationPlan
+	}
+	return nil
+}
+
+func (x *Passport) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Passport) GetEntrances() []*Entrance {
+	if x != nil {
+		return x.Entrances
+	}
+	return nil
+}
+
+func (x *Passport) GetFlats() []*Flat {
+	if x != nil {
+		return x.Flats
+	}
+	return nil
+}
+
+func (x *Passport) GetCommonAreas() []*Room {
+	if x != nil {
+		return x.CommonAreas
+	}
+	return nil
+}
+
+func (x *Passport) GetCommercial() *CommercialInfo {
+	if x != nil {
+		return x.Commercial
+	}
+	return nil
+}
+
+func (x *Passport) GetStructures() []*Structure {
+	if x != nil {
+		return x.Structures
+	}
+	return nil
+}
+
+type CreatePassportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ObjectType       ObjectType   `protobuf:"varint,1,opt,name=object_type,json=objectType,proto3,enum=techpassport.v1.ObjectType" json:"object_type,omitempty"`
+	Address          *Address     `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	OrganizationName string       `protobuf:"bytes,3,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	GeneralInfo      *GeneralInfo `protobuf:"bytes,4,opt,name=general_info,json=generalInfo,proto3" json:"general_info,omitempty"`
+}
+
+func (x *CreatePassportRequest) Reset() {
+	*x = CreatePassportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePassportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePassportRequest) ProtoMessage() {}
+
+func (x *CreatePassportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePassportRequest.ProtoReflect.Descriptor instead.
+func (*CreatePassportRequest) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreatePassportRequest) GetObjectType() ObjectType {
+	if x != nil {
+		return x.ObjectType
+	}
+	return ObjectType_OBJECT_TYPE_UNSPECIFIED
+}
+
+func (x *CreatePassportRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *CreatePassportRequest) GetOrganizationName() string {
+	if x != nil {
+		return x.OrganizationName
+	}
+	return ""
+}
+
+func (x *CreatePassportRequest) GetGeneralInfo() *GeneralInfo {
+	if x != nil {
+		return x.GeneralInfo
+	}
+	return nil
+}
+
+type GetPassportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PassportId string `protobuf:"bytes,1,opt,name=passport_id,json=passportId,proto3" json:"passport_id,omitempty"`
+}
+
+func (x *GetPassportRequest) Reset() {
+	*x = GetPassportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPassportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPassportRequest) ProtoMessage() {}
+
+func (x *GetPassportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPassportRequest.ProtoReflect.Descriptor instead.
+func (*GetPassportRequest) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetPassportRequest) GetPassportId() string {
+	if x != nil {
+		return x.PassportId
+	}
+	return ""
+}
+
+type UpdatePassportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PassportId        string                 `protobuf:"bytes,1,opt,name=passport_id,json=passportId,proto3" json:"passport_id,omitempty"`
+	ObjectType        ObjectType             `protobuf:"varint,2,opt,name=object_type,json=objectType,proto3,enum=techpassport.v1.ObjectType" json:"object_type,omitempty"`
+	Address           *Address               `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	OrganizationName  string                 `protobuf:"bytes,4,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	InventoryNumber   string                 `protobuf:"bytes,5,opt,name=inventory_number,json=inventoryNumber,proto3" json:"inventory_number,omitempty"`
+	CadastralNumber   string                 `protobuf:"bytes,6,opt,name=cadastral_number,json=cadastralNumber,proto3" json:"cadastral_number,omitempty"`
+	AsOfDate          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=as_of_date,json=asOfDate,proto3" json:"as_of_date,omitempty"`
+	GeneralInfo       *GeneralInfo           `protobuf:"bytes,8,opt,name=general_info,json=generalInfo,proto3" json:"general_info,omitempty"`
+	Utilities         *Utilities             `protobuf:"bytes,9,opt,name=utilities,proto3" json:"utilities,omitempty"`
+	SituationPlanPath string                 `protobuf:"bytes,10,opt,name=situation_plan_path,json=situationPlanPath,proto3" json:"situation_plan_path,omitempty"`
+}
+
+func (x *UpdatePassportRequest) Reset() {
+	*x = UpdatePassportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePassportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePassportRequest) ProtoMessage() {}
+
+func (x *UpdatePassportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePassportRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePassportRequest) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdatePassportRequest) GetPassportId() string {
+	if x != nil {
+		return x.PassportId
+	}
+	return ""
+}
+
+func (x *UpdatePassportRequest) GetObjectType() ObjectType {
+	if x != nil {
+		return x.ObjectType
+	}
+	return ObjectType_OBJECT_TYPE_UNSPECIFIED
+}
+
+func (x *UpdatePassportRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *UpdatePassportRequest) GetOrganizationName() string {
+	if x != nil {
+		return x.OrganizationName
+	}
+	return ""
+}
+
+func (x *UpdatePassportRequest) GetInventoryNumber() string {
+	if x != nil {
+		return x.InventoryNumber
+	}
+	return ""
+}
+
+func (x *UpdatePassportRequest) GetCadastralNumber() string {
+	if x != nil {
+		return x.CadastralNumber
+	}
+	return ""
+}
+
+func (x *UpdatePassportRequest) GetAsOfDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOfDate
+	}
+	return nil
+}
+
+func (x *UpdatePassportRequest) GetGeneralInfo() *GeneralInfo {
+	if x != nil {
+		return x.GeneralInfo
+	}
+	return nil
+}
+
+func (x *UpdatePassportRequest) GetUtilities() *Utilities {
+	if x != nil {
+		return x.Utilities
+	}
+	return nil
+}
+
+func (x *UpdatePassportRequest) GetSituationPlanPath() string {
+	if x != nil {
+		return x.SituationPlanPath
+	}
+	return ""
+}
+
+type DeletePassportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PassportId string `protobuf:"bytes,1,opt,name=passport_id,json=passportId,proto3" json:"passport_id,omitempty"`
+}
+
+func (x *DeletePassportRequest) Reset() {
+	*x = DeletePassportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePassportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePassportRequest) ProtoMessage() {}
+
+func (x *DeletePassportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePassportRequest.ProtoReflect.Descriptor instead.
+func (*DeletePassportRequest) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeletePassportRequest) GetPassportId() string {
+	if x != nil {
+		return x.PassportId
+	}
+	return ""
+}
+
+type ListPassportsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset int32 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // 0 - без ограничения
+}
+
+func (x *ListPassportsRequest) Reset() {
+	*x = ListPassportsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPassportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPassportsRequest) ProtoMessage() {}
+
+func (x *ListPassportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPassportsRequest.ProtoReflect.Descriptor instead.
+func (*ListPassportsRequest) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListPassportsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListPassportsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ApprovePassportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PassportId string `protobuf:"bytes,1,opt,name=passport_id,json=passportId,proto3" json:"passport_id,omitempty"`
+}
+
+func (x *ApprovePassportRequest) Reset() {
+	*x = ApprovePassportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApprovePassportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovePassportRequest) ProtoMessage() {}
+
+func (x *ApprovePassportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovePassportRequest.ProtoReflect.Descriptor instead.
+func (*ApprovePassportRequest) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ApprovePassportRequest) GetPassportId() string {
+	if x != nil {
+		return x.PassportId
+	}
+	return ""
+}
+
+type ArchivePassportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PassportId string `protobuf:"bytes,1,opt,name=passport_id,json=passportId,proto3" json:"passport_id,omitempty"`
+}
+
+func (x *ArchivePassportRequest) Reset() {
+	*x = ArchivePassportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchivePassportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivePassportRequest) ProtoMessage() {}
+
+func (x *ArchivePassportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivePassportRequest.ProtoReflect.Descriptor instead.
+func (*ArchivePassportRequest) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ArchivePassportRequest) GetPassportId() string {
+	if x != nil {
+		return x.PassportId
+	}
+	return ""
+}
+
+type ValidatePassportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Target:
+	//	*ValidatePassportRequest_PassportId
+	//	*ValidatePassportRequest_Passport
+	Target   isValidatePassportRequest_Target `protobuf_oneof:"target"`
+	Complete bool                             `protobuf:"varint,3,opt,name=complete,proto3" json:"complete,omitempty"` // Требовать полноты паспорта для экспорта
+}
+
+func (x *ValidatePassportRequest) Reset() {
+	*x = ValidatePassportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatePassportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatePassportRequest) ProtoMessage() {}
+
+func (x *ValidatePassportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatePassportRequest.ProtoReflect.Descriptor instead.
+func (*ValidatePassportRequest) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{37}
+}
+
+func (m *ValidatePassportRequest) GetTarget() isValidatePassportRequest_Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (x *ValidatePassportRequest) GetPassportId() string {
+	if x, ok := x.GetTarget().(*ValidatePassportRequest_PassportId); ok {
+		return x.PassportId
+	}
+	return ""
+}
+
+func (x *ValidatePassportRequest) GetPassport() *Passport {
+	if x, ok := x.GetTarget().(*ValidatePassportRequest_Passport); ok {
+		return x.Passport
+	}
+	return nil
+}
+
+func (x *ValidatePassportRequest) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+type isValidatePassportRequest_Target interface {
+	isValidatePassportRequest_Target()
+}
+
+type ValidatePassportRequest_PassportId struct {
+	PassportId string `protobuf:"bytes,1,opt,name=passport_id,json=passportId,proto3,oneof"` // Проверка сохраненного паспорта
+}
+
+type ValidatePassportRequest_Passport struct {
+	Passport *Passport `protobuf:"bytes,2,opt,name=passport,proto3,oneof"` // Проверка паспорта без сохранения
+}
+
+func (*ValidatePassportRequest_PassportId) isValidatePassportRequest_Target() {}
+
+func (*ValidatePassportRequest_Passport) isValidatePassportRequest_Target() {}
+
+type FieldError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field   string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *FieldError) Reset() {
+	*x = FieldError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldError) ProtoMessage() {}
+
+func (x *FieldError) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldError.ProtoReflect.Descriptor instead.
+func (*FieldError) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *FieldError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ValidationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid    bool          `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Errors   []*FieldError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Warnings []string      `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+}
+
+func (x *ValidationResult) Reset() {
+	*x = ValidationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationResult) ProtoMessage() {}
+
+func (x *ValidationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationResult.ProtoReflect.Descriptor instead.
+func (*ValidationResult) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ValidationResult) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidationResult) GetErrors() []*FieldError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ValidationResult) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type ExportPassportsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PassportIds []string       `protobuf:"bytes,1,rep,name=passport_ids,json=passportIds,proto3" json:"passport_ids,omitempty"`
+	All         bool           `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"` // Экспортировать все паспорта хранилища
+	Format      DocumentFormat `protobuf:"varint,3,opt,name=format,proto3,enum=techpassport.v1.DocumentFormat" json:"format,omitempty"`
+}
+
+func (x *ExportPassportsRequest) Reset() {
+	*x = ExportPassportsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportPassportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPassportsRequest) ProtoMessage() {}
+
+func (x *ExportPassportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPassportsRequest.ProtoReflect.Descriptor instead.
+func (*ExportPassportsRequest) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ExportPassportsRequest) GetPassportIds() []string {
+	if x != nil {
+		return x.PassportIds
+	}
+	return nil
+}
+
+func (x *ExportPassportsRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *ExportPassportsRequest) GetFormat() DocumentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DocumentFormat_DOCUMENT_FORMAT_UNSPECIFIED
+}
+
+// ExportChunk часть документа. Документ каждого паспорта передается
+// последовательностью сообщений с одинаковым passport_id; последнее
+// сообщение документа имеет last = true. Если паспорт не удалось
+// экспортировать, передается одно сообщение с заполненным error.
+type ExportChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PassportId string `protobuf:"bytes,1,opt,name=passport_id,json=passportId,proto3" json:"passport_id,omitempty"`
+	FileName   string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Data       []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Last       bool   `protobuf:"varint,4,opt,name=last,proto3" json:"last,omitempty"`
+	Error      string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ExportChunk) Reset() {
+	*x = ExportChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportChunk) ProtoMessage() {}
+
+func (x *ExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportChunk.ProtoReflect.Descriptor instead.
+func (*ExportChunk) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ExportChunk) GetPassportId() string {
+	if x != nil {
+		return x.PassportId
+	}
+	return ""
+}
+
+func (x *ExportChunk) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportChunk) GetLast() bool {
+	if x != nil {
+		return x.Last
+	}
+	return false
+}
+
+func (x *ExportChunk) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type AddBuildingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PassportId string    `protobuf:"bytes,1,opt,name=passport_id,json=passportId,proto3" json:"passport_id,omitempty"`
+	Building   *Building `protobuf:"bytes,2,opt,name=building,proto3" json:"building,omitempty"`
+}
+
+func (x *AddBuildingRequest) Reset() {
+	*x = AddBuildingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddBuildingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBuildingRequest) ProtoMessage() {}
+
+func (x *AddBuildingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBuildingRequest.ProtoReflect.Descriptor instead.
+func (*AddBuildingRequest) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AddBuildingRequest) GetPassportId() string {
+	if x != nil {
+		return x.PassportId
+	}
+	return ""
+}
+
+func (x *AddBuildingRequest) GetBuilding() *Building {
+	if x != nil {
+		return x.Building
+	}
+	return nil
+}
+
+type UpdateBuildingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PassportId string    `protobuf:"bytes,1,opt,name=passport_id,json=passportId,proto3" json:"passport_id,omitempty"`
+	Index      int32     `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Building   *Building `protobuf:"bytes,3,opt,name=building,proto3" json:"building,omitempty"`
+}
+
+func (x *UpdateBuildingRequest) Reset() {
+	*x = UpdateBuildingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBuildingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBuildingRequest) ProtoMessage() {}
+
+func (x *UpdateBuildingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBuildingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBuildingRequest) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UpdateBuildingRequest) GetPassportId() string {
+	if x != nil {
+		return x.PassportId
+	}
+	return ""
+}
+
+func (x *UpdateBuildingRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *UpdateBuildingRequest) GetBuilding() *Building {
+	if x != nil {
+		return x.Building
+	}
+	return nil
+}
+
+type AddOwnerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PassportId string `protobuf:"bytes,1,opt,name=passport_id,json=passportId,proto3" json:"passport_id,omitempty"`
+	Owner      *Owner `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *AddOwnerRequest) Reset() {
+	*x = AddOwnerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddOwnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOwnerRequest) ProtoMessage() {}
+
+func (x *AddOwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOwnerRequest.ProtoReflect.Descriptor instead.
+func (*AddOwnerRequest) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AddOwnerRequest) GetPassportId() string {
+	if x != nil {
+		return x.PassportId
+	}
+	return ""
+}
+
+func (x *AddOwnerRequest) GetOwner() *Owner {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+type UpdateOwnerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PassportId string `protobuf:"bytes,1,opt,name=passport_id,json=passportId,proto3" json:"passport_id,omitempty"`
+	Index      int32  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Owner      *Owner `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *UpdateOwnerRequest) Reset() {
+	*x = UpdateOwnerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateOwnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOwnerRequest) ProtoMessage() {}
+
+func (x *UpdateOwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOwnerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOwnerRequest) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpdateOwnerRequest) GetPassportId() string {
+	if x != nil {
+		return x.PassportId
+	}
+	return ""
+}
+
+func (x *UpdateOwnerRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *UpdateOwnerRequest) GetOwner() *Owner {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+type AddRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PassportId string `protobuf:"bytes,1,opt,name=passport_id,json=passportId,proto3" json:"passport_id,omitempty"`
+	Room       *Room  `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+}
+
+func (x *AddRoomRequest) Reset() {
+	*x = AddRoomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRoomRequest) ProtoMessage() {}
+
+func (x *AddRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRoomRequest.ProtoReflect.Descriptor instead.
+func (*AddRoomRequest) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AddRoomRequest) GetPassportId() string {
+	if x != nil {
+		return x.PassportId
+	}
+	return ""
+}
+
+func (x *AddRoomRequest) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type UpdateRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PassportId string `protobuf:"bytes,1,opt,name=passport_id,json=passportId,proto3" json:"passport_id,omitempty"`
+	Index      int32  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Room       *Room  `protobuf:"bytes,3,opt,name=room,proto3" json:"room,omitempty"`
+}
+
+func (x *UpdateRoomRequest) Reset() {
+	*x = UpdateRoomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomRequest) ProtoMessage() {}
+
+func (x *UpdateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdateRoomRequest) GetPassportId() string {
+	if x != nil {
+		return x.PassportId
+	}
+	return ""
+}
+
+func (x *UpdateRoomRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *UpdateRoomRequest) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type RemoveItemRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PassportId string `protobuf:"bytes,1,opt,name=passport_id,json=passportId,proto3" json:"passport_id,omitempty"`
+	Index      int32  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *RemoveItemRequest) Reset() {
+	*x = RemoveItemRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_techpassport_v1_passport_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveItemRequest) ProtoMessage() {}
+
+func (x *RemoveItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_techpassport_v1_passport_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveItemRequest.ProtoReflect.Descriptor instead.
+func (*RemoveItemRequest) Descriptor() ([]byte, []int) {
+	return file_techpassport_v1_passport_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RemoveItemRequest) GetPassportId() string {
+	if x != nil {
+		return x.PassportId
+	}
+	return ""
+}
+
+func (x *RemoveItemRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+var File_techpassport_v1_passport_proto protoreflect.FileDescriptor
+
+var file_techpassport_v1_passport_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0f, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76,
+	0x31, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x95, 0x02, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x64, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x69,
+	0x74, 0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x72, 0x65, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65,
+	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x61, 0x6c,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x73,
+	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xaa, 0x02, 0x0a, 0x0b, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x10, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x72, 0x65, 0x61,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6c, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x65,
+	0x61, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x5f, 0x61, 0x62, 0x6f, 0x76,
+	0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11,
+	0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x41, 0x62, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x2d, 0x0a, 0x12, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x5f, 0x75, 0x6e, 0x64, 0x65,
+	0x72, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x66,
+	0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x55, 0x6e, 0x64, 0x65, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x6f, 0x74, 0x65, 0x22, 0x9e, 0x04, 0x0a, 0x08, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x79, 0x65, 0x61, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x6d,
+	0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77,
+	0x61, 0x6c, 0x6c, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x72, 0x65, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x41, 0x72, 0x65, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61,
+	0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x13, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x75, 0x72, 0x61, 0x6c, 0x5f, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x61, 0x6c,
+	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x12, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75,
+	0x72, 0x61, 0x6c, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x48, 0x0a, 0x0f, 0x77,
+	0x65, 0x61, 0x72, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x61, 0x72, 0x41, 0x73, 0x73, 0x65, 0x73,
+	0x73, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0e, 0x77, 0x65, 0x61, 0x72, 0x41, 0x73, 0x73, 0x65, 0x73,
+	0x73, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xfc, 0x03, 0x0a, 0x09, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x64, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
+	0x70, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x72, 0x65, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61,
+	0x12, 0x2f, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x65, 0x61, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x77, 0x65, 0x61, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
+	0x72, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e,
+	0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x40,
+	0x0a, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x6f, 0x74, 0x65, 0x22, 0x7b, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72,
+	0x61, 0x6c, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x77, 0x65, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x77, 0x65, 0x61,
+	0x72, 0x22, 0x52, 0x0a, 0x10, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x61, 0x72,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x77, 0x65, 0x61, 0x72, 0x22, 0xf3, 0x01, 0x0a, 0x0e, 0x57, 0x65, 0x61, 0x72, 0x41, 0x73,
+	0x73, 0x65, 0x73, 0x73, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3d, 0x0a, 0x08,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x61, 0x72, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x77,
+	0x65, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x77, 0x65, 0x61, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xe1, 0x04, 0x0a, 0x0f,
+	0x56, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x79, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72,
+	0x12, 0x3c, 0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x61, 0x6c, 0x6c, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x41, 0x72, 0x65, 0x61, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61,
+	0x72, 0x12, 0x26, 0x0a, 0x0c, 0x77, 0x65, 0x61, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x77, 0x65, 0x61, 0x72, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x54, 0x0a, 0x0c, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x77, 0x65, 0x61, 0x72, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x73, 0x2e, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x61, 0x72, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0b, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x61, 0x72, 0x12,
+	0x25, 0x0a, 0x0e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x1a, 0x3e,
+	0x0a, 0x10, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x61, 0x72, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x77, 0x65, 0x61, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22,
+	0xa9, 0x04, 0x0a, 0x11, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e,
+	0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1b,
+	0x0a, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x14, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69,
+	0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x29, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63,
+	0x6f, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x65,
+	0x61, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x77, 0x65, 0x61, 0x72, 0x12, 0x1e,
+	0x0a, 0x0b, 0x77, 0x65, 0x61, 0x72, 0x5f, 0x62, 0x79, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x65, 0x61, 0x72, 0x42, 0x79, 0x41, 0x67, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
+	0x72, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x46, 0x0a, 0x0d, 0x77, 0x65, 0x61, 0x72, 0x5f, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x65, 0x63,
+	0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x61, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x0c, 0x77,
+	0x65, 0x61, 0x72, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xf5, 0x02, 0x0a, 0x05,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x3c, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70,
+	0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x74, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x69, 0x67, 0x68, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x69,
+	0x67, 0x68, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x8e, 0x03, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06,
+	0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69,
+	0x74, 0x65, 0x72, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f,
+	0x6f, 0x6d, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75,
+	0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x76,
+	0x69, 0x6e, 0x67, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x6c, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x65, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x75,
+	0x78, 0x69, 0x6c, 0x69, 0x61, 0x72, 0x79, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0d, 0x61, 0x75, 0x78, 0x69, 0x6c, 0x69, 0x61, 0x72, 0x79, 0x41, 0x72, 0x65,
+	0x61, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x75, 0x6e, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x75, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x65, 0x64, 0x41, 0x72, 0x65, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x6d, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x0c, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x22, 0x80, 0x01, 0x0a, 0x08, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x6c, 0x6f,
+	0x6f, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x80, 0x02, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x72, 0x63, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x69,
+	0x72, 0x65, 0x5f, 0x68, 0x61, 0x7a, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x69, 0x72, 0x65, 0x48, 0x61, 0x7a, 0x61, 0x72,
+	0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x6c, 0x6f, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x70, 0x6c, 0x6f, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x69, 0x72, 0x65, 0x5f, 0x72, 0x65,
+	0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x66, 0x69, 0x72, 0x65, 0x52, 0x65, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x29,
+	0x0a, 0x10, 0x65, 0x76, 0x61, 0x63, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x69,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x65, 0x76, 0x61, 0x63, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x69, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x65, 0x70,
+	0x61, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0xcc, 0x01, 0x0a, 0x04, 0x46,
+	0x6c, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x69, 0x74, 0x65, 0x72, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x74,
+	0x65, 0x72, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x61, 0x64, 0x61, 0x73, 0x74, 0x72,
+	0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x63, 0x61, 0x64, 0x61, 0x73, 0x74, 0x72, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x37, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x0b, 0x65, 0x78,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x99, 0x02, 0x0a, 0x0d, 0x4d, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x53, 0x68, 0x61, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x63, 0x68,
+	0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x70,
+	0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x63, 0x68,
+	0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x70,
+	0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x64,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x01, 0x52, 0x05, 0x73, 0x69, 0x64, 0x65, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x64, 0x69, 0x61, 0x67, 0x6f, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x01, 0x52, 0x09, 0x64, 0x69, 0x61, 0x67, 0x6f, 0x6e, 0x61, 0x6c, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x64, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0xc0, 0x01, 0x0a, 0x10, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x68,
+	0x61, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x65, 0x63,
+	0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x64, 0x53, 0x68, 0x61, 0x70, 0x65, 0x52, 0x06, 0x73, 0x68, 0x61, 0x70,
+	0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3f, 0x0a, 0x0d, 0x6d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6d, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x55, 0x0a, 0x11, 0x55, 0x74, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0b, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x6e, 0x6f, 0x6d, 0x6f, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x6e, 0x6f, 0x6d, 0x6f, 0x75, 0x73, 0x22,
+	0x96, 0x03, 0x0a, 0x09, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x38, 0x0a,
+	0x05, 0x77, 0x61, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74,
+	0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x05, 0x77, 0x61, 0x74, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x08, 0x73, 0x65, 0x77, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x65, 0x63, 0x68,
+	0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x74, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73,
+	0x65, 0x77, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70,
+	0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x74, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x68, 0x65,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x3f, 0x0a, 0x09, 0x68, 0x6f, 0x74, 0x5f, 0x77, 0x61, 0x74,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70,
+	0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x74, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x68, 0x6f,
+	0x74, 0x57, 0x61, 0x74, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x67, 0x61, 0x73, 0x12, 0x44, 0x0a, 0x0b,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x72, 0x69, 0x63, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x72, 0x69, 0x63, 0x69,
+	0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x22, 0x23, 0x0a, 0x05, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12,
+	0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x22, 0x7c, 0x0a,
+	0x04, 0x57, 0x61, 0x6c, 0x6c, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x28, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x68, 0x69, 0x63, 0x6b, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x74, 0x68, 0x69, 0x63, 0x6b, 0x6e, 0x65, 0x73, 0x73, 0x22, 0x7d, 0x0a, 0x07, 0x4f,
+	0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x61, 0x6c, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x61, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x22, 0x5e, 0x0a, 0x0b, 0x52, 0x6f,
+	0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x6f,
+	0x6d, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x65, 0x63,
+	0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x92, 0x02, 0x0a, 0x09, 0x46,
+	0x6c, 0x6f, 0x6f, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x74, 0x65,
+	0x72, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x05, 0x77, 0x61, 0x6c, 0x6c, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x52, 0x05, 0x77, 0x61,
+	0x6c, 0x6c, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52,
+	0x08, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x72, 0x6f, 0x6f,
+	0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70,
+	0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x43,
+	0x6f, 0x6e, 0x74, 0x6f, 0x75, 0x72, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0x3a, 0x0a, 0x08, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a,
+	0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x22, 0x5e, 0x0a, 0x11, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x6f, 0x74, 0x70, 0x72, 0x69, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70,
+	0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x4f, 0x0a, 0x0e, 0x50,
+	0x6c, 0x61, 0x6e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x29, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x02, 0x61, 0x74, 0x22, 0xd3, 0x02, 0x0a,
+	0x0d, 0x53, 0x69, 0x74, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x4e,
+	0x0a, 0x11, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x74, 0x65, 0x63, 0x68,
+	0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x10, 0x63, 0x6f,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x12,
+	0x0a, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x7a, 0x6f,
+	0x6e, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x08, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x12, 0x42, 0x0a, 0x0a, 0x66, 0x6f, 0x6f,
+	0x74, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x6f, 0x74, 0x70, 0x72, 0x69, 0x6e,
+	0x74, 0x52, 0x0a, 0x66, 0x6f, 0x6f, 0x74, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x41, 0x0a,
+	0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0xe5, 0x01, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12,
+	0x39, 0x0a, 0x0a, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x61, 0x64, 0x64, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x0a, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xed, 0x0a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3c,
+	0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a,
+	0x10, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
+	0x72, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x61, 0x64, 0x61,
+	0x73, 0x74, 0x72, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x63, 0x61, 0x64, 0x61, 0x73, 0x74, 0x72, 0x61, 0x6c, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0c,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x61, 0x73,
+	0x5f, 0x6f, 0x66, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x61, 0x73, 0x4f, 0x66,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x65, 0x63,
+	0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x37, 0x0a, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70,
+	0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2e,
+	0x0a, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x2e,
+	0x0a, 0x13, 0x73, 0x69, 0x74, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x6e,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x69, 0x74,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x38,
+	0x0a, 0x09, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x09, 0x75,
+	0x74, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x18, 0x12,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x3b, 0x0a, 0x0b, 0x66,
+	0x6c, 0x6f, 0x6f, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x0a, 0x66, 0x6c,
+	0x6f, 0x6f, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x45, 0x0a, 0x0e, 0x73, 0x69, 0x74, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x69, 0x74, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e,
+	0x52, 0x0d, 0x73, 0x69, 0x74, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x12,
+	0x3d, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x15,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x37,
+	0x0a, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x65, 0x6e,
+	0x74, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x74, 0x73,
+	0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x61, 0x74, 0x52, 0x05, 0x66,
+	0x6c, 0x61, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x61,
+	0x72, 0x65, 0x61, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x65, 0x63,
+	0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x73, 0x12, 0x3f,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x69, 0x61, 0x6c, 0x18, 0x19, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x69, 0x61, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x69, 0x61, 0x6c, 0x12,
+	0x3a, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x1a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x0a, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x10, 0x10,
+	0x11, 0x22, 0xf7, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x63,
+	0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x35, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x49, 0x64, 0x22, 0x92, 0x04, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x3c, 0x0a,
+	0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74,
+	0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72,
+	0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x61, 0x64, 0x61, 0x73,
+	0x74, 0x72, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x63, 0x61, 0x64, 0x61, 0x73, 0x74, 0x72, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x38, 0x0a, 0x0a, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x08, 0x61, 0x73, 0x4f, 0x66, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x0c,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x38, 0x0a,
+	0x09, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x09, 0x75, 0x74,
+	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x69, 0x74, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x69, 0x74, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6c, 0x61, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x22, 0x38, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x49,
+	0x64, 0x22, 0x44, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x39, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x49, 0x64, 0x22, 0x39, 0x0a, 0x16, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x61, 0x73,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x22, 0x9b, 0x01,
+	0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0b, 0x70, 0x61, 0x73,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x42, 0x08, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x3c, 0x0a, 0x0a, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x79, 0x0a, 0x10, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x49,
+	0x64, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x03, 0x61, 0x6c, 0x6c, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x89, 0x01,
+	0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c,
+	0x61, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x6c, 0x0a, 0x12, 0x41, 0x64, 0x64,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64,
+	0x12, 0x35, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x85, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x35, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x65, 0x63,
+	0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x22,
+	0x60, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x22, 0x79, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61,
+	0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2c,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x5c, 0x0a, 0x0e,
+	0x41, 0x64, 0x64, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12,
+	0x29, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x22, 0x75, 0x0a, 0x11, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x29, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f,
+	0x6d, 0x22, 0x4a, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2a, 0xc2, 0x01,
+	0x0a, 0x0a, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17,
+	0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x4f, 0x42, 0x4a,
+	0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e,
+	0x54, 0x49, 0x41, 0x4c, 0x5f, 0x48, 0x4f, 0x55, 0x53, 0x45, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15,
+	0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x50, 0x41, 0x52,
+	0x54, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x42, 0x4a, 0x45, 0x43,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x10, 0x03, 0x12, 0x1f, 0x0a,
+	0x1b, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x4e,
+	0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x04, 0x12, 0x22,
+	0x0a, 0x1e, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x50,
+	0x41, 0x52, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x49, 0x4e, 0x47,
+	0x10, 0x05, 0x2a, 0x5c, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1b, 0x0a, 0x17, 0x50, 0x45, 0x52, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a,
+	0x16, 0x50, 0x45, 0x52, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x44,
+	0x49, 0x56, 0x49, 0x44, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x45, 0x52,
+	0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x45, 0x47, 0x41, 0x4c, 0x10, 0x02,
+	0x2a, 0x88, 0x01, 0x0a, 0x0e, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x41, 0x53, 0x53, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x41, 0x53, 0x53, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x52, 0x41, 0x46, 0x54, 0x10, 0x01, 0x12,
+	0x1c, 0x0a, 0x18, 0x50, 0x41, 0x53, 0x53, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1c, 0x0a,
+	0x18, 0x50, 0x41, 0x53, 0x53, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x64, 0x0a, 0x0e, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1f, 0x0a,
+	0x1b, 0x44, 0x4f, 0x43, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17,
+	0x0a, 0x13, 0x44, 0x4f, 0x43, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x50, 0x44, 0x46, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x4f, 0x43, 0x55, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x44, 0x4f, 0x43, 0x58, 0x10,
+	0x02, 0x2a, 0x5b, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1c, 0x0a, 0x18, 0x4f, 0x50, 0x45, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15,
+	0x0a, 0x11, 0x4f, 0x50, 0x45, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44,
+	0x4f, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x50, 0x45, 0x4e, 0x49, 0x4e, 0x47,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x10, 0x02, 0x2a, 0x8d,
+	0x01, 0x0a, 0x09, 0x53, 0x68, 0x61, 0x70, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x16,
+	0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x48, 0x41, 0x50,
+	0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x43, 0x54, 0x41, 0x4e, 0x47, 0x4c, 0x45,
+	0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x54, 0x52, 0x49, 0x41, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x53,
+	0x48, 0x41, 0x50, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50, 0x4f, 0x4c, 0x59, 0x47, 0x4f,
+	0x4e, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x53, 0x45, 0x4d, 0x49, 0x43, 0x49, 0x52, 0x43, 0x4c, 0x45, 0x10, 0x04, 0x2a, 0x87,
+	0x01, 0x0a, 0x09, 0x53, 0x68, 0x61, 0x70, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x16,
+	0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x48, 0x41, 0x50,
+	0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x19, 0x0a,
+	0x15, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x42, 0x41, 0x59, 0x5f,
+	0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x48, 0x41, 0x50,
+	0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4e, 0x49, 0x43, 0x48, 0x45, 0x10, 0x03, 0x12, 0x18,
+	0x0a, 0x14, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x44,
+	0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x2a, 0x6d, 0x0a, 0x10, 0x43, 0x6f, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x21, 0x0a, 0x1d,
+	0x43, 0x4f, 0x4f, 0x52, 0x44, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45,
+	0x4d, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4f, 0x52, 0x44, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x59,
+	0x53, 0x54, 0x45, 0x4d, 0x5f, 0x4d, 0x53, 0x4b, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f,
+	0x4f, 0x52, 0x44, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f,
+	0x57, 0x47, 0x53, 0x38, 0x34, 0x10, 0x02, 0x32, 0xd8, 0x0b, 0x0a, 0x0f, 0x50, 0x61, 0x73, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x2e,
+	0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x4d, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x23, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x53, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x26, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x65, 0x63, 0x68,
+	0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x50, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61,
+	0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x53, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61,
+	0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61,
+	0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61,
+	0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x30, 0x01, 0x12, 0x55, 0x0a, 0x0f, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x27,
+	0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61,
+	0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x55, 0x0a, 0x0f, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x61, 0x73,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x27, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50,
+	0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x5f, 0x0a, 0x10, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x28, 0x2e,
+	0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61,
+	0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x5a, 0x0a, 0x0f, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x27, 0x2e,
+	0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x65, 0x63,
+	0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x53, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61,
+	0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x4f, 0x0a, 0x0e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x2e, 0x74,
+	0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x47, 0x0a, 0x08, 0x41,
+	0x64, 0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61,
+	0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x65, 0x63, 0x68,
+	0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x4d, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70,
+	0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x4c, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x22, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x45, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x1f, 0x2e, 0x74,
+	0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x64, 0x64, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x4b, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x22, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x65, 0x63,
+	0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x4b, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52,
+	0x6f, 0x6f, 0x6d, 0x12, 0x22, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x70, 0x61,
+	0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x5a, 0x61, 0x6b, 0x69, 0x72, 0x41, 0x6c, 0x65, 0x6b, 0x70, 0x65, 0x72, 0x6f, 0x76, 0x2f,
+	0x47, 0x6f, 0x54, 0x65, 0x63, 0x68, 0x50, 0x61, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2f, 0x67,
+	0x72, 0x70, 0x63, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_techpassport_v1_passport_proto_rawDescOnce sync.Once
+	file_techpassport_v1_passport_proto_rawDescData = file_techpassport_v1_passport_proto_rawDesc
+)
+
+func file_techpassport_v1_passport_proto_rawDescGZIP() []byte {
+	file_techpassport_v1_passport_proto_rawDescOnce.Do(func() {
+		file_techpassport_v1_passport_proto_rawDescData = protoimpl.X.CompressGZIP(file_techpassport_v1_passport_proto_rawDescData)
+	})
+	return file_techpassport_v1_passport_proto_rawDescData
+}
+
+var file_techpassport_v1_passport_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_techpassport_v1_passport_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+var file_techpassport_v1_passport_proto_goTypes = []interface{}{
+	(ObjectType)(0),                 // 0: techpassport.v1.ObjectType
+	(PersonType)(0),                 // 1: techpassport.v1.PersonType
+	(PassportStatus)(0),             // 2: techpassport.v1.PassportStatus
+	(DocumentFormat)(0),             // 3: techpassport.v1.DocumentFormat
+	(OpeningType)(0),                // 4: techpassport.v1.OpeningType
+	(ShapeKind)(0),                  // 5: techpassport.v1.ShapeKind
+	(ShapeRole)(0),                  // 6: techpassport.v1.ShapeRole
+	(CoordinateSystem)(0),           // 7: techpassport.v1.CoordinateSystem
+	(*Address)(nil),                 // 8: techpassport.v1.Address
+	(*GeneralInfo)(nil),             // 9: techpassport.v1.GeneralInfo
+	(*Building)(nil),                // 10: techpassport.v1.Building
+	(*Structure)(nil),               // 11: techpassport.v1.Structure
+	(*StructuralElement)(nil),       // 12: techpassport.v1.StructuralElement
+	(*ElementWearShare)(nil),        // 13: techpassport.v1.ElementWearShare
+	(*WearAssessment)(nil),          // 14: techpassport.v1.WearAssessment
+	(*ValuationInputs)(nil),         // 15: techpassport.v1.ValuationInputs
+	(*BuildingValuation)(nil),       // 16: techpassport.v1.BuildingValuation
+	(*Owner)(nil),                   // 17: techpassport.v1.Owner
+	(*Room)(nil),                    // 18: techpassport.v1.Room
+	(*Entrance)(nil),                // 19: techpassport.v1.Entrance
+	(*CommercialInfo)(nil),          // 20: techpassport.v1.CommercialInfo
+	(*Flat)(nil),                    // 21: techpassport.v1.Flat
+	(*MeasuredShape)(nil),           // 22: techpassport.v1.MeasuredShape
+	(*RoomMeasurements)(nil),        // 23: techpassport.v1.RoomMeasurements
+	(*UtilityConnection)(nil),       // 24: techpassport.v1.UtilityConnection
+	(*Utilities)(nil),               // 25: techpassport.v1.Utilities
+	(*Point)(nil),                   // 26: techpassport.v1.Point
+	(*Wall)(nil),                    // 27: techpassport.v1.Wall
+	(*Opening)(nil),                 // 28: techpassport.v1.Opening
+	(*RoomContour)(nil),             // 29: techpassport.v1.RoomContour
+	(*FloorPlan)(nil),               // 30: techpassport.v1.FloorPlan
+	(*GeoPoint)(nil),                // 31: techpassport.v1.GeoPoint
+	(*BuildingFootprint)(nil),       // 32: techpassport.v1.BuildingFootprint
+	(*PlanAnnotation)(nil),          // 33: techpassport.v1.PlanAnnotation
+	(*SituationPlan)(nil),           // 34: techpassport.v1.SituationPlan
+	(*Attachment)(nil),              // 35: techpassport.v1.Attachment
+	(*AuditEntry)(nil),              // 36: techpassport.v1.AuditEntry
+	(*Passport)(nil),                // 37: techpassport.v1.Passport
+	(*CreatePassportRequest)(nil),   // 38: techpassport.v1.CreatePassportRequest
+	(*GetPassportRequest)(nil),      // 39: techpassport.v1.GetPassportRequest
+	(*UpdatePassportRequest)(nil),   // 40: techpassport.v1.UpdatePassportRequest
+	(*DeletePassportRequest)(nil),   // 41: techpassport.v1.DeletePassportRequest
+	(*ListPassportsRequest)(nil),    // 42: techpassport.v1.ListPassportsRequest
+	(*ApprovePassportRequest)(nil),  // 43: techpassport.v1.ApprovePassportRequest
+	(*ArchivePassportRequest)(nil),  // 44: techpassport.v1.ArchivePassportRequest
+	(*ValidatePassportRequest)(nil), // 45: techpassport.v1.ValidatePassportRequest
+	(*FieldError)(nil),              // 46: techpassport.v1.FieldError
+	(*ValidationResult)(nil),        // 47: techpassport.v1.ValidationResult
+	(*ExportPassportsRequest)(nil),  // 48: techpassport.v1.ExportPassportsRequest
+	(*ExportChunk)(nil),             // 49: techpassport.v1.ExportChunk
+	(*AddBuildingRequest)(nil),      // 50: techpassport.v1.AddBuildingRequest
+	(*UpdateBuildingRequest)(nil),   // 51: techpassport.v1.UpdateBuildingRequest
+	(*AddOwnerRequest)(nil),         // 52: techpassport.v1.AddOwnerRequest
+	(*UpdateOwnerRequest)(nil),      // 53: techpassport.v1.UpdateOwnerRequest
+	(*AddRoomRequest)(nil),          // 54: techpassport.v1.AddRoomRequest
+	(*UpdateRoomRequest)(nil),       // 55: techpassport.v1.UpdateRoomRequest
+	(*RemoveItemRequest)(nil),       // 56: techpassport.v1.RemoveItemRequest
+	nil,                             // 57: techpassport.v1.ValuationInputs.ElementWearEntry
+	(*timestamppb.Timestamp)(nil),   // 58: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),           // 59: google.protobuf.Empty
+}
+var file_techpassport_v1_passport_proto_depIdxs = []int32{
+	16, // 0: techpassport.v1.Building.valuation:type_name -> techpassport.v1.BuildingValuation
+	12, // 1: techpassport.v1.Building.structural_elements:type_name -> techpassport.v1.StructuralElement
+	14, // 2: techpassport.v1.Building.wear_assessment:type_name -> techpassport.v1.WearAssessment
+	31, // 3: techpassport.v1.Structure.route:type_name -> techpassport.v1.GeoPoint
+	16, // 4: techpassport.v1.Structure.valuation:type_name -> techpassport.v1.BuildingValuation
+	58, // 5: techpassport.v1.WearAssessment.date:type_name -> google.protobuf.Timestamp
+	13, // 6: techpassport.v1.WearAssessment.elements:type_name -> techpassport.v1.ElementWearShare
+	0,  // 7: techpassport.v1.ValuationInputs.object_type:type_name -> techpassport.v1.ObjectType
+	57, // 8: techpassport.v1.ValuationInputs.element_wear:type_name -> techpassport.v1.ValuationInputs.ElementWearEntry
+	58, // 9: techpassport.v1.BuildingValuation.date:type_name -> google.protobuf.Timestamp
+	15, // 10: techpassport.v1.BuildingValuation.inputs:type_name -> techpassport.v1.ValuationInputs
+	13, // 11: techpassport.v1.BuildingValuation.wear_elements:type_name -> techpassport.v1.ElementWearShare
+	58, // 12: techpassport.v1.Owner.entry_date:type_name -> google.protobuf.Timestamp
+	1,  // 13: techpassport.v1.Owner.person_type:type_name -> techpassport.v1.PersonType
+	23, // 14: techpassport.v1.Room.measurements:type_name -> techpassport.v1.RoomMeasurements
+	18, // 15: techpassport.v1.Flat.explication:type_name -> techpassport.v1.Room
+	5,  // 16: techpassport.v1.MeasuredShape.kind:type_name -> techpassport.v1.ShapeKind
+	6,  // 17: techpassport.v1.MeasuredShape.role:type_name -> techpassport.v1.ShapeRole
+	22, // 18: techpassport.v1.RoomMeasurements.shapes:type_name -> techpassport.v1.MeasuredShape
+	58, // 19: techpassport.v1.RoomMeasurements.measured_date:type_name -> google.protobuf.Timestamp
+	24, // 20: techpassport.v1.Utilities.water:type_name -> techpassport.v1.UtilityConnection
+	24, // 21: techpassport.v1.Utilities.sewerage:type_name -> techpassport.v1.UtilityConnection
+	24, // 22: techpassport.v1.Utilities.heating:type_name -> techpassport.v1.UtilityConnection
+	24, // 23: techpassport.v1.Utilities.hot_water:type_name -> techpassport.v1.UtilityConnection
+	24, // 24: techpassport.v1.Utilities.gas:type_name -> techpassport.v1.UtilityConnection
+	24, // 25: techpassport.v1.Utilities.electricity:type_name -> techpassport.v1.UtilityConnection
+	26, // 26: techpassport.v1.Wall.start:type_name -> techpassport.v1.Point
+	26, // 27: techpassport.v1.Wall.end:type_name -> techpassport.v1.Point
+	4,  // 28: techpassport.v1.Opening.type:type_name -> techpassport.v1.OpeningType
+	26, // 29: techpassport.v1.RoomContour.points:type_name -> techpassport.v1.Point
+	27, // 30: techpassport.v1.FloorPlan.walls:type_name -> techpassport.v1.Wall
+	28, // 31: techpassport.v1.FloorPlan.openings:type_name -> techpassport.v1.Opening
+	29, // 32: techpassport.v1.FloorPlan.rooms:type_name -> techpassport.v1.RoomContour
+	31, // 33: techpassport.v1.BuildingFootprint.points:type_name -> techpassport.v1.GeoPoint
+	31, // 34: techpassport.v1.PlanAnnotation.at:type_name -> techpassport.v1.GeoPoint
+	7,  // 35: techpassport.v1.SituationPlan.coordinate_system:type_name -> techpassport.v1.CoordinateSystem
+	31, // 36: techpassport.v1.SituationPlan.boundary:type_name -> techpassport.v1.GeoPoint
+	32, // 37: techpassport.v1.SituationPlan.footprints:type_name -> techpassport.v1.BuildingFootprint
+	33, // 38: techpassport.v1.SituationPlan.annotations:type_name -> techpassport.v1.PlanAnnotation
+	58, // 39: techpassport.v1.Attachment.added_date:type_name -> google.protobuf.Timestamp
+	58, // 40: techpassport.v1.AuditEntry.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 41: techpassport.v1.Passport.object_type:type_name -> techpassport.v1.ObjectType
+	8,  // 42: techpassport.v1.Passport.address:type_name -> techpassport.v1.Address
+	2,  // 43: techpassport.v1.Passport.status:type_name -> techpassport.v1.PassportStatus
+	58, // 44: techpassport.v1.Passport.created_date:type_name -> google.protobuf.Timestamp
+	58, // 45: techpassport.v1.Passport.updated_date:type_name -> google.protobuf.Timestamp
+	58, // 46: techpassport.v1.Passport.as_of_date:type_name -> google.protobuf.Timestamp
+	9,  // 47: techpassport.v1.Passport.general_info:type_name -> techpassport.v1.GeneralInfo
+	10, // 48: techpassport.v1.Passport.buildings:type_name -> techpassport.v1.Building
+	17, // 49: techpassport.v1.Passport.owners:type_name -> techpassport.v1.Owner
+	25, // 50: techpassport.v1.Passport.utilities:type_name -> techpassport.v1.Utilities
+	18, // 51: techpassport.v1.Passport.explication:type_name -> techpassport.v1.Room
+	36, // 52: techpassport.v1.Passport.audit_log:type_name -> techpassport.v1.AuditEntry
+	30, // 53: techpassport.v1.Passport.floor_plans:type_name -> techpassport.v1.FloorPlan
+	34, // 54: techpassport.v1.Passport.situation_plan:type_name -> techpassport.v1.SituationPlan
+	35, // 55: techpassport.v1.Passport.attachments:type_name -> techpassport.v1.Attachment
+	19, // 56: techpassport.v1.Passport.entrances:type_name -> techpassport.v1.Entrance
+	21, // 57: techpassport.v1.Passport.flats:type_name -> techpassport.v1.Flat
+	18, // 58: techpassport.v1.Passport.common_areas:type_name -> techpassport.v1.Room
+	20, // 59: techpassport.v1.Passport.commercial:type_name -> techpassport.v1.CommercialInfo
+	11, // 60: techpassport.v1.Passport.structures:type_name -> techpassport.v1.Structure
+	0,  // 61: techpassport.v1.CreatePassportRequest.object_type:type_name -> techpassport.v1.ObjectType
+	8,  // 62: techpassport.v1.CreatePassportRequest.address:type_name -> techpassport.v1.Address
+	9,  // 63: techpassport.v1.CreatePassportRequest.general_info:type_name -> techpassport.v1.GeneralInfo
+	0,  // 64: techpassport.v1.UpdatePassportRequest.object_type:type_name -> techpassport.v1.ObjectType
+	8,  // 65: techpassport.v1.UpdatePassportRequest.address:type_name -> techpassport.v1.Address
+	58, // 66: techpassport.v1.UpdatePassportRequest.as_of_date:type_name -> google.protobuf.Timestamp
+	9,  // 67: techpassport.v1.UpdatePassportRequest.general_info:type_name -> techpassport.v1.GeneralInfo
+	25, // 68: techpassport.v1.UpdatePassportRequest.utilities:type_name -> techpassport.v1.Utilities
+	37, // 69: techpassport.v1.ValidatePassportRequest.passport:type_name -> techpassport.v1.Passport
+	46, // 70: techpassport.v1.ValidationResult.errors:type_name -> techpassport.v1.FieldError
+	3,  // 71: techpassport.v1.ExportPassportsRequest.format:type_name -> techpassport.v1.DocumentFormat
+	10, // 72: techpassport.v1.AddBuildingRequest.building:type_name -> techpassport.v1.Building
+	10, // 73: techpassport.v1.UpdateBuildingRequest.building:type_name -> techpassport.v1.Building
+	17, // 74: techpassport.v1.AddOwnerRequest.owner:type_name -> techpassport.v1.Owner
+	17, // 75: techpassport.v1.UpdateOwnerRequest.owner:type_name -> techpassport.v1.Owner
+	18, // 76: techpassport.v1.AddRoomRequest.room:type_name -> techpassport.v1.Room
+	18, // 77: techpassport.v1.UpdateRoomRequest.room:type_name -> techpassport.v1.Room
+	38, // 78: techpassport.v1.PassportService.CreatePassport:input_type -> techpassport.v1.CreatePassportRequest
+	39, // 79: techpassport.v1.PassportService.GetPassport:input_type -> techpassport.v1.GetPassportRequest
+	40, // 80: techpassport.v1.PassportService.UpdatePassport:input_type -> techpassport.v1.UpdatePassportRequest
+	41, // 81: techpassport.v1.PassportService.DeletePassport:input_type -> techpassport.v1.DeletePassportRequest
+	42, // 82: techpassport.v1.PassportService.ListPassports:input_type -> techpassport.v1.ListPassportsRequest
+	43, // 83: techpassport.v1.PassportService.ApprovePassport:input_type -> techpassport.v1.ApprovePassportRequest
+	44, // 84: techpassport.v1.PassportService.ArchivePassport:input_type -> techpassport.v1.ArchivePassportRequest
+	45, // 85: techpassport.v1.PassportService.ValidatePassport:input_type -> techpassport.v1.ValidatePassportRequest
+	48, // 86: techpassport.v1.PassportService.ExportPassports:input_type -> techpassport.v1.ExportPassportsRequest
+	50, // 87: techpassport.v1.PassportService.AddBuilding:input_type -> techpassport.v1.AddBuildingRequest
+	51, // 88: techpassport.v1.PassportService.UpdateBuilding:input_type -> techpassport.v1.UpdateBuildingRequest
+	56, // 89: techpassport.v1.PassportService.RemoveBuilding:input_type -> techpassport.v1.RemoveItemRequest
+	52, // 90: techpassport.v1.PassportService.AddOwner:input_type -> techpassport.v1.AddOwnerRequest
+	53, // 91: techpassport.v1.PassportService.UpdateOwner:input_type -> techpassport.v1.UpdateOwnerRequest
+	56, // 92: techpassport.v1.PassportService.RemoveOwner:input_type -> techpassport.v1.RemoveItemRequest
+	54, // 93: techpassport.v1.PassportService.AddRoom:input_type -> techpassport.v1.AddRoomRequest
+	55, // 94: techpassport.v1.PassportService.UpdateRoom:input_type -> techpassport.v1.UpdateRoomRequest
+	56, // 95: techpassport.v1.PassportService.RemoveRoom:input_type -> techpassport.v1.RemoveItemRequest
+	37, // 96: techpassport.v1.PassportService.CreatePassport:output_type -> techpassport.v1.Passport
+	37, // 97: techpassport.v1.PassportService.GetPassport:output_type -> techpassport.v1.Passport
+	37, // 98: techpassport.v1.PassportService.UpdatePassport:output_type -> techpassport.v1.Passport
+	59, // 99: techpassport.v1.PassportService.DeletePassport:output_type -> google.protobuf.Empty
+	37, // 100: techpassport.v1.PassportService.ListPassports:output_type -> techpassport.v1.Passport
+	37, // 101: techpassport.v1.PassportService.ApprovePassport:output_type -> techpassport.v1.Passport
+	37, // 102: techpassport.v1.PassportService.ArchivePassport:output_type -> techpassport.v1.Passport
+	47, // 103: techpassport.v1.PassportService.ValidatePassport:output_type -> techpassport.v1.ValidationResult
+	49, // 104: techpassport.v1.PassportService.ExportPassports:output_type -> techpassport.v1.ExportChunk
+	37, // 105: techpassport.v1.PassportService.AddBuilding:output_type -> techpassport.v1.Passport
+	37, // 106: techpassport.v1.PassportService.UpdateBuilding:output_type -> techpassport.v1.Passport
+	37, // 107: techpassport.v1.PassportService.RemoveBuilding:output_type -> techpassport.v1.Passport
+	37, // 108: techpassport.v1.PassportService.AddOwner:output_type -> techpassport.v1.Passport
+	37, // 109: techpassport.v1.PassportService.UpdateOwner:output_type -> techpassport.v1.Passport
+	37, // 110: techpassport.v1.PassportService.RemoveOwner:output_type -> techpassport.v1.Passport
+	37, // 111: techpassport.v1.PassportService.AddRoom:output_type -> techpassport.v1.Passport
+	37, // 112: techpassport.v1.PassportService.UpdateRoom:output_type -> techpassport.v1.Passport
+	37, // 113: techpassport.v1.PassportService.RemoveRoom:output_type -> techpassport.v1.Passport
+	96, // [96:114] is the sub-list for method output_type
+	78, // [78:96] is the sub-list for method input_type
+	78, // [78:78] is the sub-list for extension type_name
+	78, // [78:78] is the sub-list for extension extendee
+	0,  // [0:78] is the sub-list for field type_name
+}
+
+func init() { file_techpassport_v1_passport_proto_init() }
+func file_techpassport_v1_passport_proto_init() {
+	if File_techpassport_v1_passport_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_techpassport_v1_passport_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Address); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeneralInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Building); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Structure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StructuralElement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ElementWearShare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WearAssessment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValuationInputs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildingValuation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Owner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Room); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Entrance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommercialInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Flat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeasuredShape); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomMeasurements); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UtilityConnection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Utilities); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Point); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Wall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Opening); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomContour); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FloorPlan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildingFootprint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanAnnotation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SituationPlan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Attachment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Passport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePassportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPassportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePassportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePassportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPassportsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApprovePassportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchivePassportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatePassportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportPassportsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddBuildingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBuildingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddOwnerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateOwnerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_techpassport_v1_passport_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveItemRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_techpassport_v1_passport_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_techpassport_v1_passport_proto_msgTypes[37].OneofWrappers = []interface{}{
+		(*ValidatePassportRequest_PassportId)(nil),
+		(*ValidatePassportRequest_Passport)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_techpassport_v1_passport_proto_rawDesc,
+			NumEnums:      8,
+			NumMessages:   50,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_techpassport_v1_passport_proto_goTypes,
+		DependencyIndexes: file_techpassport_v1_passport_proto_depIdxs,
+		EnumInfos:         file_techpassport_v1_passport_proto_enumTypes,
+		MessageInfos:      file_techpassport_v1_passport_proto_msgTypes,
+	}.Build()
+	File_techpassport_v1_passport_proto = out.File
+	file_techpassport_v1_passport_proto_rawDesc = nil
+	file_techpassport_v1_passport_proto_goTypes = nil
+	file_techpassport_v1_passport_proto_depIdxs = nil
+}
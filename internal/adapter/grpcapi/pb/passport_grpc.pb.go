@@ -0,0 +1,817 @@
+// Описание gRPC API технического паспорта объекта недвижимости.
+// Сообщения повторяют доменные сущности internal/domain/entity.
+//
+// Генерация Go кода: make proto
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.24.4
+// source: techpassport/v1/passport.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	PassportService_CreatePassport_FullMethodName   = "/techpassport.v1.PassportService/CreatePassport"
+	PassportService_GetPassport_FullMethodName      = "/techpassport.v1.PassportService/GetPassport"
+	PassportService_UpdatePassport_FullMethodName   = "/techpassport.v1.PassportService/UpdatePassport"
+	PassportService_DeletePassport_FullMethodName   = "/techpassport.v1.PassportService/DeletePassport"
+	PassportService_ListPassports_FullMethodName    = "/techpassport.v1.PassportService/ListPassports"
+	PassportService_ApprovePassport_FullMethodName  = "/techpassport.v1.PassportService/ApprovePassport"
+	PassportService_ArchivePassport_FullMethodName  = "/techpassport.v1.PassportService/ArchivePassport"
+	PassportService_ValidatePassport_FullMethodName = "/techpassport.v1.PassportService/ValidatePassport"
+	PassportService_ExportPassports_FullMethodName  = "/techpassport.v1.PassportService/ExportPassports"
+	PassportService_AddBuilding_FullMethodName      = "/techpassport.v1.PassportService/AddBuilding"
+	PassportService_UpdateBuilding_FullMethodName   = "/techpassport.v1.PassportService/UpdateBuilding"
+	PassportService_RemoveBuilding_FullMethodName   = "/techpassport.v1.PassportService/RemoveBuilding"
+	PassportService_AddOwner_FullMethodName         = "/techpassport.v1.PassportService/AddOwner"
+	PassportService_UpdateOwner_FullMethodName      = "/techpassport.v1.PassportService/UpdateOwner"
+	PassportService_RemoveOwner_FullMethodName      = "/techpassport.v1.PassportService/RemoveOwner"
+	PassportService_AddRoom_FullMethodName          = "/techpassport.v1.PassportService/AddRoom"
+	PassportService_UpdateRoom_FullMethodName       = "/techpassport.v1.PassportService/UpdateRoom"
+	PassportService_RemoveRoom_FullMethodName       = "/techpassport.v1.PassportService/RemoveRoom"
+)
+
+// PassportServiceClient is the client API for PassportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PassportServiceClient interface {
+	// CreatePassport создает новый паспорт (черновик).
+	CreatePassport(ctx context.Context, in *CreatePassportRequest, opts ...grpc.CallOption) (*Passport, error)
+	// GetPassport возвращает паспорт по ID.
+	GetPassport(ctx context.Context, in *GetPassportRequest, opts ...grpc.CallOption) (*Passport, error)
+	// UpdatePassport изменяет общие сведения паспорта.
+	UpdatePassport(ctx context.Context, in *UpdatePassportRequest, opts ...grpc.CallOption) (*Passport, error)
+	// DeletePassport удаляет паспорт.
+	DeletePassport(ctx context.Context, in *DeletePassportRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListPassports потоково передает паспорта хранилища.
+	ListPassports(ctx context.Context, in *ListPassportsRequest, opts ...grpc.CallOption) (PassportService_ListPassportsClient, error)
+	// ApprovePassport утверждает паспорт.
+	ApprovePassport(ctx context.Context, in *ApprovePassportRequest, opts ...grpc.CallOption) (*Passport, error)
+	// ArchivePassport переводит паспорт в архив.
+	ArchivePassport(ctx context.Context, in *ArchivePassportRequest, opts ...grpc.CallOption) (*Passport, error)
+	// ValidatePassport проверяет сохраненный паспорт или переданный в запросе.
+	ValidatePassport(ctx context.Context, in *ValidatePassportRequest, opts ...grpc.CallOption) (*ValidationResult, error)
+	// ExportPassports потоково передает документы паспортов частями.
+	ExportPassports(ctx context.Context, in *ExportPassportsRequest, opts ...grpc.CallOption) (PassportService_ExportPassportsClient, error)
+	AddBuilding(ctx context.Context, in *AddBuildingRequest, opts ...grpc.CallOption) (*Passport, error)
+	UpdateBuilding(ctx context.Context, in *UpdateBuildingRequest, opts ...grpc.CallOption) (*Passport, error)
+	RemoveBuilding(ctx context.Context, in *RemoveItemRequest, opts ...grpc.CallOption) (*Passport, error)
+	AddOwner(ctx context.Context, in *AddOwnerRequest, opts ...grpc.CallOption) (*Passport, error)
+	UpdateOwner(ctx context.Context, in *UpdateOwnerRequest, opts ...grpc.CallOption) (*Passport, error)
+	RemoveOwner(ctx context.Context, in *RemoveItemRequest, opts ...grpc.CallOption) (*Passport, error)
+	AddRoom(ctx context.Context, in *AddRoomRequest, opts ...grpc.CallOption) (*Passport, error)
+	UpdateRoom(ctx context.Context, in *UpdateRoomRequest, opts ...grpc.CallOption) (*Passport, error)
+	RemoveRoom(ctx context.Context, in *RemoveItemRequest, opts ...grpc.CallOption) (*Passport, error)
+}
+
+type passportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPassportServiceClient(cc grpc.ClientConnInterface) PassportServiceClient {
+	return &passportServiceClient{cc}
+}
+
+func (c *passportServiceClient) CreatePassport(ctx context.Context, in *CreatePassportRequest, opts ...grpc.CallOption) (*Passport, error) {
+	out := new(Passport)
+	err := c.cc.Invoke(ctx, PassportService_CreatePassport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passportServiceClient) GetPassport(ctx context.Context, in *GetPassportRequest, opts ...grpc.CallOption) (*Passport, error) {
+	out := new(Passport)
+	err := c.cc.Invoke(ctx, PassportService_GetPassport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passportServiceClient) UpdatePassport(ctx context.Context, in *UpdatePassportRequest, opts ...grpc.CallOption) (*Passport, error) {
+	out := new(Passport)
+	err := c.cc.Invoke(ctx, PassportService_UpdatePassport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passportServiceClient) DeletePassport(ctx context.Context, in *DeletePassportRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PassportService_DeletePassport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passportServiceClient) ListPassports(ctx context.Context, in *ListPassportsRequest, opts ...grpc.CallOption) (PassportService_ListPassportsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PassportService_ServiceDesc.Streams[0], PassportService_ListPassports_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &passportServiceListPassportsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PassportService_ListPassportsClient interface {
+	Recv() (*Passport, error)
+	grpc.ClientStream
+}
+
+type passportServiceListPassportsClient struct {
+	grpc.ClientStream
+}
+
+func (x *passportServiceListPassportsClient) Recv() (*Passport, error) {
+	m := new(Passport)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *passportServiceClient) ApprovePassport(ctx context.Context, in *ApprovePassportRequest, opts ...grpc.CallOption) (*Passport, error) {
+	out := new(Passport)
+	err := c.cc.Invoke(ctx, PassportService_ApprovePassport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passportServiceClient) ArchivePassport(ctx context.Context, in *ArchivePassportRequest, opts ...grpc.CallOption) (*Passport, error) {
+	out := new(Passport)
+	err := c.cc.Invoke(ctx, PassportService_ArchivePassport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passportServiceClient) ValidatePassport(ctx context.Context, in *ValidatePassportRequest, opts ...grpc.CallOption) (*ValidationResult, error) {
+	out := new(ValidationResult)
+	err := c.cc.Invoke(ctx, PassportService_ValidatePassport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passportServiceClient) ExportPassports(ctx context.Context, in *ExportPassportsRequest, opts ...grpc.CallOption) (PassportService_ExportPassportsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PassportService_ServiceDesc.Streams[1], PassportService_ExportPassports_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &passportServiceExportPassportsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PassportService_ExportPassportsClient interface {
+	Recv() (*ExportChunk, error)
+	grpc.ClientStream
+}
+
+type passportServiceExportPassportsClient struct {
+	grpc.ClientStream
+}
+
+func (x *passportServiceExportPassportsClient) Recv() (*ExportChunk, error) {
+	m := new(ExportChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *passportServiceClient) AddBuilding(ctx context.Context, in *AddBuildingRequest, opts ...grpc.CallOption) (*Passport, error) {
+	out := new(Passport)
+	err := c.cc.Invoke(ctx, PassportService_AddBuilding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passportServiceClient) UpdateBuilding(ctx context.Context, in *UpdateBuildingRequest, opts ...grpc.CallOption) (*Passport, error) {
+	out := new(Passport)
+	err := c.cc.Invoke(ctx, PassportService_UpdateBuilding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passportServiceClient) RemoveBuilding(ctx context.Context, in *RemoveItemRequest, opts ...grpc.CallOption) (*Passport, error) {
+	out := new(Passport)
+	err := c.cc.Invoke(ctx, PassportService_RemoveBuilding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passportServiceClient) AddOwner(ctx context.Context, in *AddOwnerRequest, opts ...grpc.CallOption) (*Passport, error) {
+	out := new(Passport)
+	err := c.cc.Invoke(ctx, PassportService_AddOwner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passportServiceClient) UpdateOwner(ctx context.Context, in *UpdateOwnerRequest, opts ...grpc.CallOption) (*Passport, error) {
+	out := new(Passport)
+	err := c.cc.Invoke(ctx, PassportService_UpdateOwner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passportServiceClient) RemoveOwner(ctx context.Context, in *RemoveItemRequest, opts ...grpc.CallOption) (*Passport, error) {
+	out := new(Passport)
+	err := c.cc.Invoke(ctx, PassportService_RemoveOwner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passportServiceClient) AddRoom(ctx context.Context, in *AddRoomRequest, opts ...grpc.CallOption) (*Passport, error) {
+	out := new(Passport)
+	err := c.cc.Invoke(ctx, PassportService_AddRoom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passportServiceClient) UpdateRoom(ctx context.Context, in *UpdateRoomRequest, opts ...grpc.CallOption) (*Passport, error) {
+	out := new(Passport)
+	err := c.cc.Invoke(ctx, PassportService_UpdateRoom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passportServiceClient) RemoveRoom(ctx context.Context, in *RemoveItemRequest, opts ...grpc.CallOption) (*Passport, error) {
+	out := new(Passport)
+	err := c.cc.Invoke(ctx, PassportService_RemoveRoom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PassportServiceServer is the server API for PassportService service.
+// All implementations must embed UnimplementedPassportServiceServer
+// for forward compatibility
+type PassportServiceServer interface {
+	// CreatePassport создает новый паспорт (черновик).
+	CreatePassport(context.Context, *CreatePassportRequest) (*Passport, error)
+	// GetPassport возвращает паспорт по ID.
+	GetPassport(context.Context, *GetPassportRequest) (*Passport, error)
+	// UpdatePassport изменяет общие сведения паспорта.
+	UpdatePassport(context.Context, *UpdatePassportRequest) (*Passport, error)
+	// DeletePassport удаляет паспорт.
+	DeletePassport(context.Context, *DeletePassportRequest) (*emptypb.Empty, error)
+	// ListPassports потоково передает паспорта хранилища.
+	ListPassports(*ListPassportsRequest, PassportService_ListPassportsServer) error
+	// ApprovePassport утверждает паспорт.
+	ApprovePassport(context.Context, *ApprovePassportRequest) (*Passport, error)
+	// ArchivePassport переводит паспорт в архив.
+	ArchivePassport(context.Context, *ArchivePassportRequest) (*Passport, error)
+	// ValidatePassport проверяет сохраненный паспорт или переданный в запросе.
+	ValidatePassport(context.Context, *ValidatePassportRequest) (*ValidationResult, error)
+	// ExportPassports потоково передает документы паспортов частями.
+	ExportPassports(*ExportPassportsRequest, PassportService_ExportPassportsServer) error
+	AddBuilding(context.Context, *AddBuildingRequest) (*Passport, error)
+	UpdateBuilding(context.Context, *UpdateBuildingRequest) (*Passport, error)
+	RemoveBuilding(context.Context, *RemoveItemRequest) (*Passport, error)
+	AddOwner(context.Context, *AddOwnerRequest) (*Passport, error)
+	UpdateOwner(context.Context, *UpdateOwnerRequest) (*Passport, error)
+	RemoveOwner(context.Context, *RemoveItemRequest) (*Passport, error)
+	AddRoom(context.Context, *AddRoomRequest) (*Passport, error)
+	UpdateRoom(context.Context, *UpdateRoomRequest) (*Passport, error)
+	RemoveRoom(context.Context, *RemoveItemRequest) (*Passport, error)
+	mustEmbedUnimplementedPassportServiceServer()
+}
+
+// UnimplementedPassportServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPassportServiceServer struct {
+}
+
+func (UnimplementedPassportServiceServer) CreatePassport(context.Context, *CreatePassportRequest) (*Passport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePassport not implemented")
+}
+func (UnimplementedPassportServiceServer) GetPassport(context.Context, *GetPassportRequest) (*Passport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPassport not implemented")
+}
+func (UnimplementedPassportServiceServer) UpdatePassport(context.Context, *UpdatePassportRequest) (*Passport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePassport not implemented")
+}
+func (UnimplementedPassportServiceServer) DeletePassport(context.Context, *DeletePassportRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePassport not implemented")
+}
+func (UnimplementedPassportServiceServer) ListPassports(*ListPassportsRequest, PassportService_ListPassportsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListPassports not implemented")
+}
+func (UnimplementedPassportServiceServer) ApprovePassport(context.Context, *ApprovePassportRequest) (*Passport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovePassport not implemented")
+}
+func (UnimplementedPassportServiceServer) ArchivePassport(context.Context, *ArchivePassportRequest) (*Passport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivePassport not implemented")
+}
+func (UnimplementedPassportServiceServer) ValidatePassport(context.Context, *ValidatePassportRequest) (*ValidationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatePassport not implemented")
+}
+func (UnimplementedPassportServiceServer) ExportPassports(*ExportPassportsRequest, PassportService_ExportPassportsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportPassports not implemented")
+}
+func (UnimplementedPassportServiceServer) AddBuilding(context.Context, *AddBuildingRequest) (*Passport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBuilding not implemented")
+}
+func (UnimplementedPassportServiceServer) UpdateBuilding(context.Context, *UpdateBuildingRequest) (*Passport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBuilding not implemented")
+}
+func (UnimplementedPassportServiceServer) RemoveBuilding(context.Context, *RemoveItemRequest) (*Passport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBuilding not implemented")
+}
+func (UnimplementedPassportServiceServer) AddOwner(context.Context, *AddOwnerRequest) (*Passport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOwner not implemented")
+}
+func (UnimplementedPassportServiceServer) UpdateOwner(context.Context, *UpdateOwnerRequest) (*Passport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOwner not implemented")
+}
+func (UnimplementedPassportServiceServer) RemoveOwner(context.Context, *RemoveItemRequest) (*Passport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOwner not implemented")
+}
+func (UnimplementedPassportServiceServer) AddRoom(context.Context, *AddRoomRequest) (*Passport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRoom not implemented")
+}
+func (UnimplementedPassportServiceServer) UpdateRoom(context.Context, *UpdateRoomRequest) (*Passport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoom not implemented")
+}
+func (UnimplementedPassportServiceServer) RemoveRoom(context.Context, *RemoveItemRequest) (*Passport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRoom not implemented")
+}
+func (UnimplementedPassportServiceServer) mustEmbedUnimplementedPassportServiceServer() {}
+
+// UnsafePassportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PassportServiceServer will
+// result in compilation errors.
+type UnsafePassportServiceServer interface {
+	mustEmbedUnimplementedPassportServiceServer()
+}
+
+func RegisterPassportServiceServer(s grpc.ServiceRegistrar, srv PassportServiceServer) {
+	s.RegisterService(&PassportService_ServiceDesc, srv)
+}
+
+func _PassportService_CreatePassport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePassportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportServiceServer).CreatePassport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PassportService_CreatePassport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportServiceServer).CreatePassport(ctx, req.(*CreatePassportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassportService_GetPassport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPassportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportServiceServer).GetPassport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PassportService_GetPassport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportServiceServer).GetPassport(ctx, req.(*GetPassportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassportService_UpdatePassport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePassportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportServiceServer).UpdatePassport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PassportService_UpdatePassport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportServiceServer).UpdatePassport(ctx, req.(*UpdatePassportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassportService_DeletePassport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePassportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportServiceServer).DeletePassport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PassportService_DeletePassport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportServiceServer).DeletePassport(ctx, req.(*DeletePassportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassportService_ListPassports_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListPassportsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PassportServiceServer).ListPassports(m, &passportServiceListPassportsServer{stream})
+}
+
+type PassportService_ListPassportsServer interface {
+	Send(*Passport) error
+	grpc.ServerStream
+}
+
+type passportServiceListPassportsServer struct {
+	grpc.ServerStream
+}
+
+func (x *passportServiceListPassportsServer) Send(m *Passport) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PassportService_ApprovePassport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApprovePassportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportServiceServer).ApprovePassport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PassportService_ApprovePassport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportServiceServer).ApprovePassport(ctx, req.(*ApprovePassportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassportService_ArchivePassport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchivePassportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportServiceServer).ArchivePassport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PassportService_ArchivePassport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportServiceServer).ArchivePassport(ctx, req.(*ArchivePassportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassportService_ValidatePassport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatePassportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportServiceServer).ValidatePassport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PassportService_ValidatePassport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportServiceServer).ValidatePassport(ctx, req.(*ValidatePassportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassportService_ExportPassports_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportPassportsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PassportServiceServer).ExportPassports(m, &passportServiceExportPassportsServer{stream})
+}
+
+type PassportService_ExportPassportsServer interface {
+	Send(*ExportChunk) error
+	grpc.ServerStream
+}
+
+type passportServiceExportPassportsServer struct {
+	grpc.ServerStream
+}
+
+func (x *passportServiceExportPassportsServer) Send(m *ExportChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PassportService_AddBuilding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBuildingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportServiceServer).AddBuilding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PassportService_AddBuilding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportServiceServer).AddBuilding(ctx, req.(*AddBuildingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassportService_UpdateBuilding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBuildingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportServiceServer).UpdateBuilding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PassportService_UpdateBuilding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportServiceServer).UpdateBuilding(ctx, req.(*UpdateBuildingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassportService_RemoveBuilding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportServiceServer).RemoveBuilding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PassportService_RemoveBuilding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportServiceServer).RemoveBuilding(ctx, req.(*RemoveItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassportService_AddOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportServiceServer).AddOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PassportService_AddOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportServiceServer).AddOwner(ctx, req.(*AddOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassportService_UpdateOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportServiceServer).UpdateOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PassportService_UpdateOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportServiceServer).UpdateOwner(ctx, req.(*UpdateOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassportService_RemoveOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportServiceServer).RemoveOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PassportService_RemoveOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportServiceServer).RemoveOwner(ctx, req.(*RemoveItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassportService_AddRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportServiceServer).AddRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PassportService_AddRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportServiceServer).AddRoom(ctx, req.(*AddRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassportService_UpdateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportServiceServer).UpdateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PassportService_UpdateRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportServiceServer).UpdateRoom(ctx, req.(*UpdateRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassportService_RemoveRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportServiceServer).RemoveRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PassportService_RemoveRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportServiceServer).RemoveRoom(ctx, req.(*RemoveItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PassportService_ServiceDesc is the grpc.ServiceDesc for PassportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PassportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "techpassport.v1.PassportService",
+	HandlerType: (*PassportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePassport",
+			Handler:    _PassportService_CreatePassport_Handler,
+		},
+		{
+			MethodName: "GetPassport",
+			Handler:    _PassportService_GetPassport_Handler,
+		},
+		{
+			MethodName: "UpdatePassport",
+			Handler:    _PassportService_UpdatePassport_Handler,
+		},
+		{
+			MethodName: "DeletePassport",
+			Handler:    _PassportService_DeletePassport_Handler,
+		},
+		{
+			MethodName: "ApprovePassport",
+			Handler:    _PassportService_ApprovePassport_Handler,
+		},
+		{
+			MethodName: "ArchivePassport",
+			Handler:    _PassportService_ArchivePassport_Handler,
+		},
+		{
+			MethodName: "ValidatePassport",
+			Handler:    _PassportService_ValidatePassport_Handler,
+		},
+		{
+			MethodName: "AddBuilding",
+			Handler:    _PassportService_AddBuilding_Handler,
+		},
+		{
+			MethodName: "UpdateBuilding",
+			Handler:    _PassportService_UpdateBuilding_Handler,
+		},
+		{
+			MethodName: "RemoveBuilding",
+			Handler:    _PassportService_RemoveBuilding_Handler,
+		},
+		{
+			MethodName: "AddOwner",
+			Handler:    _PassportService_AddOwner_Handler,
+		},
+		{
+			MethodName: "UpdateOwner",
+			Handler:    _PassportService_UpdateOwner_Handler,
+		},
+		{
+			MethodName: "RemoveOwner",
+			Handler:    _PassportService_RemoveOwner_Handler,
+		},
+		{
+			MethodName: "AddRoom",
+			Handler:    _PassportService_AddRoom_Handler,
+		},
+		{
+			MethodName: "UpdateRoom",
+			Handler:    _PassportService_UpdateRoom_Handler,
+		},
+		{
+			MethodName: "RemoveRoom",
+			Handler:    _PassportService_RemoveRoom_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListPassports",
+			Handler:       _PassportService_ListPassports_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportPassports",
+			Handler:       _PassportService_ExportPassports_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "techpassport/v1/passport.proto",
+}
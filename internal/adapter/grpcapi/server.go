@@ -0,0 +1,121 @@
+// Package grpcapi реализует gRPC API поверх use cases технического паспорта
+package grpcapi
+
+import (
+	"log"
+	"os"
+
+	"google.golang.org/grpc"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/adapter/grpcapi/pb"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/user"
+)
+
+// exportChunkSize размер части документа в потоке экспорта
+const exportChunkSize = 64 << 10
+
+// Config зависимости gRPC сервера
+type Config struct {
+	// Passports хранилище паспортов (любая реализация PassportRepository)
+	Passports repository.PassportRepository
+
+	// Users хранилище пользователей для Basic аутентификации
+	Users repository.UserRepository
+
+	// Hasher проверка паролей пользователей
+	Hasher service.PasswordHasher
+
+	// Generator генератор документов для экспорта
+	Generator service.DocumentGenerator
+
+	// Normalizer сравнение адресов для поиска дубликатов
+	Normalizer service.AddressNormalizer
+
+	// Logger журнал ошибок (по умолчанию stderr)
+	Logger *log.Logger
+}
+
+// Server реализация PassportService
+type Server struct {
+	pb.UnimplementedPassportServiceServer
+
+	logger *log.Logger
+
+	loginUC *user.LoginUseCase
+
+	createUC   *access.CreatePassportUseCase
+	getUC      *access.GetPassportUseCase
+	updateUC   *access.UpdatePassportUseCase
+	deleteUC   *access.DeletePassportUseCase
+	listUC     *access.ListPassportsUseCase
+	approveUC  *access.ApprovePassportUseCase
+	archiveUC  *access.ArchivePassportUseCase
+	validateUC *access.ValidatePassportUseCase
+	exportUC   *access.ExportPassportUseCase
+
+	addBuildingUC    *access.AddBuildingUseCase
+	updateBuildingUC *access.UpdateBuildingUseCase
+	removeBuildingUC *access.RemoveBuildingUseCase
+	addOwnerUC       *access.AddOwnerUseCase
+	updateOwnerUC    *access.UpdateOwnerUseCase
+	removeOwnerUC    *access.RemoveOwnerUseCase
+	addRoomUC        *access.AddRoomUseCase
+	updateRoomUC     *access.UpdateRoomUseCase
+	removeRoomUC     *access.RemoveRoomUseCase
+}
+
+// NewServer создает реализацию PassportService
+func NewServer(cfg Config) *Server {
+	repo := cfg.Passports
+
+	s := &Server{
+		logger:  cfg.Logger,
+		loginUC: user.NewLoginUseCase(cfg.Users, cfg.Hasher),
+
+		createUC:   access.NewCreatePassportUseCase(passport.NewCreatePassportUseCase(repo, cfg.Normalizer)),
+		getUC:      access.NewGetPassportUseCase(passport.NewGetPassportUseCase(repo)),
+		updateUC:   access.NewUpdatePassportUseCase(passport.NewUpdatePassportUseCase(repo)),
+		deleteUC:   access.NewDeletePassportUseCase(passport.NewDeletePassportUseCase(repo)),
+		listUC:     access.NewListPassportsUseCase(passport.NewListPassportsUseCase(repo)),
+		approveUC:  access.NewApprovePassportUseCase(passport.NewApprovePassportUseCase(repo)),
+		archiveUC:  access.NewArchivePassportUseCase(passport.NewArchivePassportUseCase(repo)),
+		validateUC: access.NewValidatePassportUseCase(passport.NewValidatePassportUseCase(repo)),
+		exportUC:   access.NewExportPassportUseCase(passport.NewExportPassportUseCase(repo, cfg.Generator)),
+
+		addBuildingUC:    access.NewAddBuildingUseCase(passport.NewAddBuildingUseCase(repo)),
+		updateBuildingUC: access.NewUpdateBuildingUseCase(passport.NewUpdateBuildingUseCase(repo)),
+		removeBuildingUC: access.NewRemoveBuildingUseCase(passport.NewRemoveBuildingUseCase(repo)),
+		addOwnerUC:       access.NewAddOwnerUseCase(passport.NewAddOwnerUseCase(repo)),
+		updateOwnerUC:    access.NewUpdateOwnerUseCase(passport.NewUpdateOwnerUseCase(repo)),
+		removeOwnerUC:    access.NewRemoveOwnerUseCase(passport.NewRemoveOwnerUseCase(repo)),
+		addRoomUC:        access.NewAddRoomUseCase(passport.NewAddRoomUseCase(repo)),
+		updateRoomUC:     access.NewUpdateRoomUseCase(passport.NewUpdateRoomUseCase(repo)),
+		removeRoomUC:     access.NewRemoveRoomUseCase(passport.NewRemoveRoomUseCase(repo)),
+	}
+
+	if s.logger == nil {
+		s.logger = log.New(os.Stderr, "grpc: ", log.LstdFlags)
+	}
+
+	return s
+}
+
+// NewGRPCServer создает gRPC сервер с зарегистрированным PassportService
+// и перехватчиками аутентификации
+func NewGRPCServer(cfg Config, opts ...grpc.ServerOption) *grpc.Server {
+	s := NewServer(cfg)
+
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(s.UnaryInterceptor()),
+		grpc.ChainStreamInterceptor(s.StreamInterceptor()),
+	)
+
+	server := grpc.NewServer(opts...)
+	pb.RegisterPassportServiceServer(server, s)
+
+	return server
+}
@@ -0,0 +1,244 @@
+package grpcapi_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/adapter/grpcapi"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/adapter/grpcapi/pb"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/address"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/document"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/security"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/user"
+)
+
+// dialFunc создает клиента с указанными учетными данными
+type dialFunc func(login, password string) pb.PassportServiceClient
+
+// newTestServer запускает сервер на bufconn с пользователями admin и reviewer
+func newTestServer(t *testing.T) dialFunc {
+	users := memory.NewInMemoryUserRepository()
+	hasher := security.NewBcryptHasher()
+	register := user.NewRegisterUserUseCase(users, hasher)
+
+	_, err := register.Execute(context.Background(), user.RegisterUserInput{Login: "admin", Password: "secret1", Role: entity.RoleAdmin})
+	require.NoError(t, err)
+	admin, err := users.GetByLogin(context.Background(), "admin")
+	require.NoError(t, err)
+	_, err = register.Execute(access.WithActor(context.Background(), admin),
+		user.RegisterUserInput{Login: "reviewer", Password: "secret1", Role: entity.RoleReviewer})
+	require.NoError(t, err)
+
+	server := grpcapi.NewGRPCServer(grpcapi.Config{
+		Passports:  memory.NewInMemoryPassportRepository(),
+		Users:      users,
+		Hasher:     hasher,
+		Generator:  document.NewGenerator(),
+		Normalizer: address.NewNormalizer(),
+	})
+
+	listener := bufconn.Listen(1 << 20)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	return func(login, password string) pb.PassportServiceClient {
+		conn, err := grpc.DialContext(context.Background(), "bufnet",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return listener.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithPerRPCCredentials(grpcapi.BasicCredentials{Login: login, Password: password, Insecure: true}),
+		)
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+		return pb.NewPassportServiceClient(conn)
+	}
+}
+
+// newTestClients возвращает клиентов администратора и проверяющего
+func newTestClients(t *testing.T) (pb.PassportServiceClient, pb.PassportServiceClient) {
+	dial := newTestServer(t)
+	return dial("admin", "secret1"), dial("reviewer", "secret1")
+}
+
+func createRequest() *pb.CreatePassportRequest {
+	return &pb.CreatePassportRequest{
+		ObjectType:       pb.ObjectType_OBJECT_TYPE_RESIDENTIAL_HOUSE,
+		OrganizationName: "ГУП БТИ",
+		Address:          &pb.Address{Subject: "г. Москва", City: "Москва", Street: "ул. Тверская", House: "1"},
+		GeneralInfo:      &pb.GeneralInfo{Purpose: "Жилое", ConstructionYear: 2020, TotalArea: 100.5, LivingArea: 70},
+	}
+}
+
+func TestPassportService_Lifecycle(t *testing.T) {
+	client, _ := newTestClients(t)
+	ctx := context.Background()
+
+	created, err := client.CreatePassport(ctx, createRequest())
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.GetId())
+	assert.Equal(t, pb.PassportStatus_PASSPORT_STATUS_DRAFT, created.GetStatus())
+	assert.Equal(t, pb.ObjectType_OBJECT_TYPE_RESIDENTIAL_HOUSE, created.GetObjectType())
+
+	_, err = client.AddBuilding(ctx, &pb.AddBuildingRequest{
+		PassportId: created.GetId(),
+		Building:   &pb.Building{Litera: "А", Name: "Жилой дом", CommissionYear: 2020, WallMaterial: "Кирпич", TotalArea: 100.5},
+	})
+	require.NoError(t, err)
+
+	updated, err := client.AddOwner(ctx, &pb.AddOwnerRequest{
+		PassportId: created.GetId(),
+		Owner: &pb.Owner{
+			EntryDate:     timestamppb.New(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)),
+			PersonType:    pb.PersonType_PERSON_TYPE_INDIVIDUAL,
+			FullName:      "Иванов Иван Иванович",
+			RightType:     "Собственность",
+			RightDocument: "Выписка ЕГРН",
+			Share:         "1/1",
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, updated.GetBuildings(), 1)
+	require.Len(t, updated.GetOwners(), 1)
+	assert.Equal(t, pb.PersonType_PERSON_TYPE_INDIVIDUAL, updated.GetOwners()[0].GetPersonType())
+
+	result, err := client.ValidatePassport(ctx, &pb.ValidatePassportRequest{
+		Target:   &pb.ValidatePassportRequest_PassportId{PassportId: created.GetId()},
+		Complete: true,
+	})
+	require.NoError(t, err)
+	assert.True(t, result.GetValid(), result.GetErrors())
+
+	approved, err := client.ApprovePassport(ctx, &pb.ApprovePassportRequest{PassportId: created.GetId()})
+	require.NoError(t, err)
+	assert.Equal(t, pb.PassportStatus_PASSPORT_STATUS_APPROVED, approved.GetStatus())
+
+	_, err = client.DeletePassport(ctx, &pb.DeletePassportRequest{PassportId: created.GetId()})
+	require.NoError(t, err)
+
+	_, err = client.GetPassport(ctx, &pb.GetPassportRequest{PassportId: created.GetId()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestPassportService_Streaming(t *testing.T) {
+	client, _ := newTestClients(t)
+	ctx := context.Background()
+
+	var complete string
+	for i := 0; i < 3; i++ {
+		created, err := client.CreatePassport(ctx, createRequest())
+		require.NoError(t, err)
+		if i == 0 {
+			complete = created.GetId()
+		}
+	}
+	_, err := client.AddBuilding(ctx, &pb.AddBuildingRequest{
+		PassportId: complete,
+		Building:   &pb.Building{Litera: "А", Name: "Жилой дом", CommissionYear: 2020, TotalArea: 100.5},
+	})
+	require.NoError(t, err)
+	_, err = client.AddOwner(ctx, &pb.AddOwnerRequest{
+		PassportId: complete,
+		Owner: &pb.Owner{
+			EntryDate:     timestamppb.Now(),
+			PersonType:    pb.PersonType_PERSON_TYPE_LEGAL,
+			CompanyName:   "ООО Ромашка",
+			RightType:     "Собственность",
+			RightDocument: "Выписка ЕГРН",
+			Share:         "1/1",
+		},
+	})
+	require.NoError(t, err)
+
+	// Список
+	list, err := client.ListPassports(ctx, &pb.ListPassportsRequest{Limit: 2})
+	require.NoError(t, err)
+	var listed int
+	for {
+		_, err := list.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		listed++
+	}
+	assert.Equal(t, 2, listed)
+
+	// Экспорт: неполные паспорта передаются с ошибкой, не прерывая поток
+	export, err := client.ExportPassports(ctx, &pb.ExportPassportsRequest{All: true, Format: pb.DocumentFormat_DOCUMENT_FORMAT_DOCX})
+	require.NoError(t, err)
+
+	documents := map[string]*bytes.Buffer{}
+	var failed int
+	for {
+		chunk, err := export.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		if chunk.GetError() != "" {
+			failed++
+			continue
+		}
+		if documents[chunk.GetPassportId()] == nil {
+			documents[chunk.GetPassportId()] = &bytes.Buffer{}
+		}
+		documents[chunk.GetPassportId()].Write(chunk.GetData())
+	}
+
+	assert.Equal(t, 2, failed)
+	require.Contains(t, documents, complete)
+	assert.True(t, bytes.HasPrefix(documents[complete].Bytes(), []byte("PK")))
+}
+
+func TestPassportService_StatusCodes(t *testing.T) {
+	dial := newTestServer(t)
+	admin, reviewer := dial("admin", "secret1"), dial("reviewer", "secret1")
+	ctx := context.Background()
+
+	t.Run("ошибка валидации с деталями поля", func(t *testing.T) {
+		req := createRequest()
+		req.Address.House = ""
+		_, err := admin.CreatePassport(ctx, req)
+
+		st := status.Convert(err)
+		require.Equal(t, codes.InvalidArgument, st.Code())
+		require.Len(t, st.Details(), 1)
+		badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+		require.True(t, ok)
+		assert.Equal(t, "house", badRequest.GetFieldViolations()[0].GetField())
+	})
+
+	t.Run("нет прав", func(t *testing.T) {
+		_, err := reviewer.CreatePassport(ctx, createRequest())
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("паспорт не найден", func(t *testing.T) {
+		_, err := admin.GetPassport(ctx, &pb.GetPassportRequest{PassportId: "TP-404"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("без аутентификации", func(t *testing.T) {
+		_, err := dial("admin", "wrong").GetPassport(ctx, &pb.GetPassportRequest{PassportId: "TP-1"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
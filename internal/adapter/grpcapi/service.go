@@ -0,0 +1,304 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/adapter/grpcapi/pb"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// CreatePassport создает новый паспорт
+func (s *Server) CreatePassport(ctx context.Context, req *pb.CreatePassportRequest) (*pb.Passport, error) {
+	output, err := s.createUC.Execute(ctx, passport.CreatePassportInput{
+		ObjectType:       objectTypeFromPB[req.GetObjectType()],
+		Address:          addressFromPB(req.GetAddress()),
+		OrganizationName: req.GetOrganizationName(),
+		GeneralInfo:      generalInfoFromPB(req.GetGeneralInfo()),
+	})
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return passportToPB(output.Passport), nil
+}
+
+// GetPassport возвращает паспорт по ID
+func (s *Server) GetPassport(ctx context.Context, req *pb.GetPassportRequest) (*pb.Passport, error) {
+	output, err := s.getUC.Execute(ctx, passport.GetPassportInput{PassportID: req.GetPassportId()})
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return passportToPB(output.Passport), nil
+}
+
+// UpdatePassport изменяет общие сведения паспорта
+func (s *Server) UpdatePassport(ctx context.Context, req *pb.UpdatePassportRequest) (*pb.Passport, error) {
+	output, err := s.updateUC.Execute(ctx, passport.UpdatePassportInput{
+		PassportID:        req.GetPassportId(),
+		ObjectType:        objectTypeFromPB[req.GetObjectType()],
+		Address:           addressFromPB(req.GetAddress()),
+		OrganizationName:  req.GetOrganizationName(),
+		InventoryNumber:   req.GetInventoryNumber(),
+		CadastralNumber:   req.GetCadastralNumber(),
+		AsOfDate:          timeFromPB(req.GetAsOfDate()),
+		GeneralInfo:       generalInfoFromPB(req.GetGeneralInfo()),
+		Utilities:         utilitiesFromPB(req.GetUtilities()),
+		SituationPlanPath: req.GetSituationPlanPath(),
+	})
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return passportToPB(output.Passport), nil
+}
+
+// DeletePassport удаляет паспорт
+func (s *Server) DeletePassport(ctx context.Context, req *pb.DeletePassportRequest) (*emptypb.Empty, error) {
+	if _, err := s.deleteUC.Execute(ctx, passport.DeletePassportInput{PassportID: req.GetPassportId()}); err != nil {
+		return nil, s.toStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListPassports потоково передает паспорта
+func (s *Server) ListPassports(req *pb.ListPassportsRequest, stream pb.PassportService_ListPassportsServer) error {
+	output, err := s.listUC.Execute(stream.Context(), passport.ListPassportsInput{
+		Offset: int(req.GetOffset()),
+		Limit:  int(req.GetLimit()),
+	})
+	if err != nil {
+		return s.toStatus(err)
+	}
+
+	for _, p := range output.Passports {
+		if err := stream.Send(passportToPB(p)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ApprovePassport утверждает паспорт
+func (s *Server) ApprovePassport(ctx context.Context, req *pb.ApprovePassportRequest) (*pb.Passport, error) {
+	output, err := s.approveUC.Execute(ctx, passport.ApprovePassportInput{PassportID: req.GetPassportId()})
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return passportToPB(output.Passport), nil
+}
+
+// ArchivePassport переводит паспорт в архив
+func (s *Server) ArchivePassport(ctx context.Context, req *pb.ArchivePassportRequest) (*pb.Passport, error) {
+	output, err := s.archiveUC.Execute(ctx, passport.ArchivePassportInput{PassportID: req.GetPassportId()})
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return passportToPB(output.Passport), nil
+}
+
+// ValidatePassport проверяет паспорт
+func (s *Server) ValidatePassport(ctx context.Context, req *pb.ValidatePassportRequest) (*pb.ValidationResult, error) {
+	input := passport.ValidatePassportInput{
+		PassportID: req.GetPassportId(),
+		Complete:   req.GetComplete(),
+	}
+	if msg := req.GetPassport(); msg != nil {
+		input.Passport = passportFromPB(msg)
+	}
+
+	output, err := s.validateUC.Execute(ctx, input)
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+
+	return validationToPB(output.Result), nil
+}
+
+// ExportPassports потоково передает документы паспортов
+// Ошибка экспорта отдельного паспорта не прерывает поток и передается
+// в поле error; ошибки доступа прерывают весь вызов
+func (s *Server) ExportPassports(req *pb.ExportPassportsRequest, stream pb.PassportService_ExportPassportsServer) error {
+	ctx := stream.Context()
+
+	ids := req.GetPassportIds()
+	if req.GetAll() {
+		output, err := s.listUC.Execute(ctx, passport.ListPassportsInput{})
+		if err != nil {
+			return s.toStatus(err)
+		}
+		ids = make([]string, 0, len(output.Passports))
+		for _, p := range output.Passports {
+			ids = append(ids, p.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return s.toStatus(entity.ValidationError{Field: "passport_ids", Message: "укажите паспорта или all"})
+	}
+
+	format := formatFromPB(req.GetFormat())
+
+	for _, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return s.toStatus(err)
+		}
+
+		output, err := s.exportUC.Execute(ctx, passport.ExportPassportInput{PassportID: id, Format: format})
+		if err != nil {
+			var (
+				authErr       entity.AuthenticationError
+				permissionErr entity.PermissionError
+			)
+			if errors.As(err, &authErr) || errors.As(err, &permissionErr) {
+				return s.toStatus(err)
+			}
+			if err := stream.Send(&pb.ExportChunk{PassportId: id, Error: err.Error(), Last: true}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := sendDocument(stream, id, output.FileName, output.Document); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendDocument передает документ частями по exportChunkSize
+func sendDocument(stream pb.PassportService_ExportPassportsServer, id, fileName string, document []byte) error {
+	for offset := 0; ; offset += exportChunkSize {
+		end := offset + exportChunkSize
+		if end > len(document) {
+			end = len(document)
+		}
+
+		chunk := &pb.ExportChunk{
+			PassportId: id,
+			FileName:   fileName,
+			Data:       document[offset:end],
+			Last:       end == len(document),
+		}
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+
+		if chunk.Last {
+			return nil
+		}
+	}
+}
+
+// AddBuilding добавляет здание
+func (s *Server) AddBuilding(ctx context.Context, req *pb.AddBuildingRequest) (*pb.Passport, error) {
+	output, err := s.addBuildingUC.Execute(ctx, passport.AddBuildingInput{
+		PassportID: req.GetPassportId(),
+		Building:   buildingFromPB(req.GetBuilding()),
+	})
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return passportToPB(output.Passport), nil
+}
+
+// UpdateBuilding изменяет здание
+func (s *Server) UpdateBuilding(ctx context.Context, req *pb.UpdateBuildingRequest) (*pb.Passport, error) {
+	output, err := s.updateBuildingUC.Execute(ctx, passport.UpdateBuildingInput{
+		PassportID:    req.GetPassportId(),
+		BuildingIndex: int(req.GetIndex()),
+		Building:      buildingFromPB(req.GetBuilding()),
+	})
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return passportToPB(output.Passport), nil
+}
+
+// RemoveBuilding удаляет здание
+func (s *Server) RemoveBuilding(ctx context.Context, req *pb.RemoveItemRequest) (*pb.Passport, error) {
+	output, err := s.removeBuildingUC.Execute(ctx, passport.RemoveBuildingInput{
+		PassportID:    req.GetPassportId(),
+		BuildingIndex: int(req.GetIndex()),
+	})
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return passportToPB(output.Passport), nil
+}
+
+// AddOwner добавляет правообладателя
+func (s *Server) AddOwner(ctx context.Context, req *pb.AddOwnerRequest) (*pb.Passport, error) {
+	output, err := s.addOwnerUC.Execute(ctx, passport.AddOwnerInput{
+		PassportID: req.GetPassportId(),
+		Owner:      ownerFromPB(req.GetOwner()),
+	})
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return passportToPB(output.Passport), nil
+}
+
+// UpdateOwner изменяет правообладателя
+func (s *Server) UpdateOwner(ctx context.Context, req *pb.UpdateOwnerRequest) (*pb.Passport, error) {
+	output, err := s.updateOwnerUC.Execute(ctx, passport.UpdateOwnerInput{
+		PassportID: req.GetPassportId(),
+		OwnerIndex: int(req.GetIndex()),
+		Owner:      ownerFromPB(req.GetOwner()),
+	})
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return passportToPB(output.Passport), nil
+}
+
+// RemoveOwner удаляет правообладателя
+func (s *Server) RemoveOwner(ctx context.Context, req *pb.RemoveItemRequest) (*pb.Passport, error) {
+	output, err := s.removeOwnerUC.Execute(ctx, passport.RemoveOwnerInput{
+		PassportID: req.GetPassportId(),
+		OwnerIndex: int(req.GetIndex()),
+	})
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return passportToPB(output.Passport), nil
+}
+
+// AddRoom добавляет помещение в экспликацию
+func (s *Server) AddRoom(ctx context.Context, req *pb.AddRoomRequest) (*pb.Passport, error) {
+	output, err := s.addRoomUC.Execute(ctx, passport.AddRoomInput{
+		PassportID: req.GetPassportId(),
+		Room:       roomFromPB(req.GetRoom()),
+	})
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return passportToPB(output.Passport), nil
+}
+
+// UpdateRoom изменяет помещение
+func (s *Server) UpdateRoom(ctx context.Context, req *pb.UpdateRoomRequest) (*pb.Passport, error) {
+	output, err := s.updateRoomUC.Execute(ctx, passport.UpdateRoomInput{
+		PassportID: req.GetPassportId(),
+		RoomIndex:  int(req.GetIndex()),
+		Room:       roomFromPB(req.GetRoom()),
+	})
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return passportToPB(output.Passport), nil
+}
+
+// RemoveRoom удаляет помещение
+func (s *Server) RemoveRoom(ctx context.Context, req *pb.RemoveItemRequest) (*pb.Passport, error) {
+	output, err := s.removeRoomUC.Execute(ctx, passport.RemoveRoomInput{
+		PassportID: req.GetPassportId(),
+		RoomIndex:  int(req.GetIndex()),
+	})
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return passportToPB(output.Passport), nil
+}
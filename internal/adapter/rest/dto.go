@@ -0,0 +1,124 @@
+package rest
+
+import (
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+)
+
+// createPassportRequest тело запроса создания паспорта
+type createPassportRequest struct {
+	ObjectType       entity.ObjectType  `json:"object_type"`
+	Address          entity.Address     `json:"address"`
+	OrganizationName string             `json:"organization_name"`
+	GeneralInfo      entity.GeneralInfo `json:"general_info"`
+}
+
+// updatePassportRequest тело запроса изменения общих сведений паспорта
+type updatePassportRequest struct {
+	ObjectType        entity.ObjectType  `json:"object_type"`
+	Address           entity.Address     `json:"address"`
+	OrganizationName  string             `json:"organization_name"`
+	InventoryNumber   string             `json:"inventory_number,omitempty"`
+	CadastralNumber   string             `json:"cadastral_number,omitempty"`
+	AsOfDate          time.Time          `json:"as_of_date,omitempty"`
+	GeneralInfo       entity.GeneralInfo `json:"general_info"`
+	Utilities         entity.Utilities   `json:"utilities"`
+	SituationPlanPath string             `json:"situation_plan_path,omitempty"`
+}
+
+// issuePassportRequest тело запроса выдачи паспорта
+type issuePassportRequest struct {
+	Recipient string `json:"recipient"`
+}
+
+// mergePassportRequest тело запроса объединения паспорта с дубликатом
+type mergePassportRequest struct {
+	SourceID string `json:"source_id"`
+}
+
+// duplicateCandidate вероятный дубликат паспорта
+type duplicateCandidate struct {
+	passportSummary
+	Score float64 `json:"score"`
+}
+
+// duplicatesResponse вероятные дубликаты паспорта по убыванию сходства адресов
+type duplicatesResponse struct {
+	Items []duplicateCandidate `json:"items"`
+}
+
+// passportSummary краткие сведения о паспорте в списке
+type passportSummary struct {
+	ID               string                `json:"id"`
+	Status           entity.PassportStatus `json:"status"`
+	ObjectType       entity.ObjectType     `json:"object_type"`
+	Address          string                `json:"address"`
+	OrganizationName string                `json:"organization_name"`
+	InventoryNumber  string                `json:"inventory_number,omitempty"`
+	CadastralNumber  string                `json:"cadastral_number,omitempty"`
+	UpdatedDate      time.Time             `json:"updated_date"`
+}
+
+// passportPage страница списка паспортов
+type passportPage struct {
+	Items  []passportSummary `json:"items"`
+	Total  int               `json:"total"`
+	Offset int               `json:"offset"`
+	Limit  int               `json:"limit"`
+
+	// NextCursor курсор следующей страницы; пусто - страница последняя
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// validationResponse результат проверки паспорта
+type validationResponse struct {
+	Valid    bool              `json:"valid"`
+	Errors   []validationIssue `json:"errors"`
+	Warnings []string          `json:"warnings"`
+}
+
+// validationIssue ошибка валидации поля
+type validationIssue struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// errorResponse тело ответа с ошибкой
+type errorResponse struct {
+	Error string `json:"error"`
+	Field string `json:"field,omitempty"`
+}
+
+// toSummary формирует краткие сведения о паспорте
+func toSummary(p *entity.TechnicalPassport) passportSummary {
+	return passportSummary{
+		ID:               p.ID,
+		Status:           p.Status,
+		ObjectType:       p.ObjectType,
+		Address:          p.Address.FullAddress(),
+		OrganizationName: p.OrganizationName,
+		InventoryNumber:  p.InventoryNumber,
+		CadastralNumber:  p.CadastralNumber,
+		UpdatedDate:      p.UpdatedDate,
+	}
+}
+
+// interchangeExportRequest тело запроса выгрузки паспортов в формат обмена
+type interchangeExportRequest struct {
+	PassportIDs []string `json:"passport_ids,omitempty"`
+	All         bool     `json:"all,omitempty"`
+}
+
+// interchangeImportResponse результат загрузки документа формата обмена
+type interchangeImportResponse struct {
+	SourceVersion int                     `json:"source_version"`
+	Results       []interchangeImportItem `json:"results"`
+}
+
+// interchangeImportItem итог загрузки паспорта
+type interchangeImportItem struct {
+	SourceID   string `json:"source_id"`
+	PassportID string `json:"passport_id"`
+	Action     string `json:"action"`
+}
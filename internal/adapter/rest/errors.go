@@ -0,0 +1,81 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// httpError ошибка с явным HTTP статусом
+type httpError struct {
+	status  int
+	message string
+}
+
+func (e httpError) Error() string {
+	return e.message
+}
+
+// badRequest возвращает ошибку 400
+func badRequest(message string) error {
+	return httpError{status: http.StatusBadRequest, message: message}
+}
+
+// statusFor сопоставляет ошибку HTTP статусу
+func statusFor(err error) int {
+	var (
+		httpErr       httpError
+		validationErr entity.ValidationError
+		authErr       entity.AuthenticationError
+		permissionErr entity.PermissionError
+	)
+
+	switch {
+	case errors.As(err, &httpErr):
+		return httpErr.status
+	case errors.As(err, &authErr):
+		return http.StatusUnauthorized
+	case errors.As(err, &permissionErr):
+		return http.StatusForbidden
+	case errors.Is(err, repository.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, repository.ErrAlreadyExists):
+		return http.StatusConflict
+	case errors.As(err, &validationErr):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// writeError выводит ошибку в формате errorResponse
+func (s *Server) writeError(w http.ResponseWriter, err error) {
+	status := statusFor(err)
+
+	body := errorResponse{Error: err.Error()}
+	if status == http.StatusInternalServerError {
+		s.logger.Printf("internal error: %v", err)
+		body.Error = http.StatusText(status)
+	}
+
+	var validationErr entity.ValidationError
+	if errors.As(err, &validationErr) {
+		body.Field = validationErr.Field
+	}
+
+	if status == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", `Basic realm="GoTechPasport", charset="UTF-8"`)
+	}
+
+	writeJSON(w, status, body)
+}
+
+// writeJSON выводит значение в формате JSON
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,62 @@
+package rest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"hash/fnv"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+)
+
+// lockStripes количество блокировок для сериализации изменений паспортов
+const lockStripes = 64
+
+// passportLocks сериализует изменения одного паспорта внутри процесса
+// Это делает проверку If-Match и последующее изменение атомарными
+// для любой реализации PassportRepository
+type passportLocks struct {
+	stripes [lockStripes]sync.Mutex
+}
+
+// lock блокирует паспорт и возвращает функцию разблокировки
+func (l *passportLocks) lock(id string) func() {
+	h := fnv.New32a()
+	h.Write([]byte(id))
+	m := &l.stripes[h.Sum32()%lockStripes]
+	m.Lock()
+	return m.Unlock
+}
+
+// etagOf вычисляет ETag паспорта по его содержимому
+func etagOf(p *entity.TechnicalPassport) string {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// etagMatches проверяет значение заголовка If-Match / If-None-Match
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		candidate = strings.TrimPrefix(candidate, "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// preconditionFailed ошибка несовпадения версии паспорта
+func preconditionFailed() error {
+	return httpError{
+		status:  http.StatusPreconditionFailed,
+		message: "паспорт был изменен другим пользователем, получите актуальную версию",
+	}
+}
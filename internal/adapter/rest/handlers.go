@@ -0,0 +1,474 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+const (
+	// maxBodySize максимальный размер тела запроса
+	maxBodySize = 10 << 20
+
+	// headerPossibleDuplicates ID вероятных дубликатов созданного паспорта
+	headerPossibleDuplicates = "X-Possible-Duplicates"
+
+	// defaultPageLimit размер страницы по умолчанию
+	defaultPageLimit = 50
+
+	// maxPageLimit максимальный размер страницы
+	maxPageLimit = 500
+)
+
+// handleListPassports GET /passports
+func (s *Server) handleListPassports(w http.ResponseWriter, r *http.Request, p params) error {
+	offset, err := queryInt(r, "offset", 0)
+	if err != nil {
+		return err
+	}
+	limit, err := queryInt(r, "limit", defaultPageLimit)
+	if err != nil {
+		return err
+	}
+	if limit <= 0 || limit > maxPageLimit {
+		return badRequest("limit должен быть от 1 до " + strconv.Itoa(maxPageLimit))
+	}
+
+	where, err := listFilter(r)
+	if err != nil {
+		return err
+	}
+
+	query := r.URL.Query()
+	output, err := s.listUC.Execute(r.Context(), passport.ListPassportsInput{
+		Where:      where,
+		Sort:       repository.SortField(query.Get("sort")),
+		Descending: query.Get("desc") == "true",
+		Cursor:     query.Get("cursor"),
+		Offset:     offset,
+		Limit:      limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	page := passportPage{
+		Items:      make([]passportSummary, 0, len(output.Passports)),
+		Total:      output.Total,
+		Offset:     offset,
+		Limit:      limit,
+		NextCursor: output.NextCursor,
+	}
+	for _, item := range output.Passports {
+		page.Items = append(page.Items, toSummary(item))
+	}
+
+	writeJSON(w, http.StatusOK, page)
+	return nil
+}
+
+// handleCreatePassport POST /passports
+func (s *Server) handleCreatePassport(w http.ResponseWriter, r *http.Request, p params) error {
+	var req createPassportRequest
+	if err := decodeBody(r, &req); err != nil {
+		return err
+	}
+
+	output, err := s.createUC.Execute(r.Context(), passport.CreatePassportInput{
+		ObjectType:       req.ObjectType,
+		Address:          req.Address,
+		OrganizationName: req.OrganizationName,
+		GeneralInfo:      req.GeneralInfo,
+	})
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Location", apiPrefix+"/passports/"+output.Passport.ID)
+	if len(output.Duplicates) > 0 {
+		ids := make([]string, 0, len(output.Duplicates))
+		for _, d := range output.Duplicates {
+			ids = append(ids, d.Passport.ID)
+		}
+		w.Header().Set(headerPossibleDuplicates, strings.Join(ids, ", "))
+	}
+	writePassport(w, http.StatusCreated, output.Passport)
+	return nil
+}
+
+// handleGetPassport GET /passports/{id}
+func (s *Server) handleGetPassport(w http.ResponseWriter, r *http.Request, p params) error {
+	output, err := s.getUC.Execute(r.Context(), passport.GetPassportInput{PassportID: p["id"]})
+	if err != nil {
+		return err
+	}
+
+	etag := etagOf(output.Passport)
+	if inm := r.Header.Get("If-None-Match"); inm != "" && etagMatches(inm, etag) {
+		w.Header().Set("ETag", etag)
+		w.WriteHeader(http.StatusNotModified)
+		return nil
+	}
+
+	writePassport(w, http.StatusOK, output.Passport)
+	return nil
+}
+
+// handleUpdatePassport PUT /passports/{id}
+func (s *Server) handleUpdatePassport(w http.ResponseWriter, r *http.Request, p params) error {
+	var req updatePassportRequest
+	if err := decodeBody(r, &req); err != nil {
+		return err
+	}
+
+	return s.mutate(w, r, p["id"], http.StatusOK, func(ctx context.Context) (*entity.TechnicalPassport, error) {
+		output, err := s.updateUC.Execute(ctx, passport.UpdatePassportInput{
+			PassportID:        p["id"],
+			ObjectType:        req.ObjectType,
+			Address:           req.Address,
+			OrganizationName:  req.OrganizationName,
+			InventoryNumber:   req.InventoryNumber,
+			CadastralNumber:   req.CadastralNumber,
+			AsOfDate:          req.AsOfDate,
+			GeneralInfo:       req.GeneralInfo,
+			Utilities:         req.Utilities,
+			SituationPlanPath: req.SituationPlanPath,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return output.Passport, nil
+	})
+}
+
+// handleDeletePassport DELETE /passports/{id}
+func (s *Server) handleDeletePassport(w http.ResponseWriter, r *http.Request, p params) error {
+	id := p["id"]
+
+	unlock := s.locks.lock(id)
+	defer unlock()
+
+	if err := s.checkIfMatch(r, id); err != nil {
+		return err
+	}
+
+	if _, err := s.deleteUC.Execute(r.Context(), passport.DeletePassportInput{PassportID: id}); err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// handleApprovePassport POST /passports/{id}/approve
+func (s *Server) handleApprovePassport(w http.ResponseWriter, r *http.Request, p params) error {
+	return s.mutate(w, r, p["id"], http.StatusOK, func(ctx context.Context) (*entity.TechnicalPassport, error) {
+		output, err := s.approveUC.Execute(ctx, passport.ApprovePassportInput{PassportID: p["id"]})
+		if err != nil {
+			return nil, err
+		}
+		return output.Passport, nil
+	})
+}
+
+// handleArchivePassport POST /passports/{id}/archive
+func (s *Server) handleArchivePassport(w http.ResponseWriter, r *http.Request, p params) error {
+	return s.mutate(w, r, p["id"], http.StatusOK, func(ctx context.Context) (*entity.TechnicalPassport, error) {
+		output, err := s.archiveUC.Execute(ctx, passport.ArchivePassportInput{PassportID: p["id"]})
+		if err != nil {
+			return nil, err
+		}
+		return output.Passport, nil
+	})
+}
+
+// handleIssuePassport POST /passports/{id}/issue
+func (s *Server) handleIssuePassport(w http.ResponseWriter, r *http.Request, p params) error {
+	var req issuePassportRequest
+	if err := decodeBody(r, &req); err != nil {
+		return err
+	}
+
+	return s.mutate(w, r, p["id"], http.StatusOK, func(ctx context.Context) (*entity.TechnicalPassport, error) {
+		output, err := s.issueUC.Execute(ctx, passport.IssuePassportInput{PassportID: p["id"], Recipient: req.Recipient})
+		if err != nil {
+			return nil, err
+		}
+		return output.Passport, nil
+	})
+}
+
+// handleFindDuplicates GET /passports/{id}/duplicates
+func (s *Server) handleFindDuplicates(w http.ResponseWriter, r *http.Request, p params) error {
+	input := passport.FindDuplicatesInput{PassportID: p["id"]}
+	if v := r.URL.Query().Get("threshold"); v != "" {
+		threshold, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return badRequest("параметр threshold должен быть числом")
+		}
+		input.Threshold = threshold
+	}
+
+	output, err := s.duplicatesUC.Execute(r.Context(), input)
+	if err != nil {
+		return err
+	}
+
+	resp := duplicatesResponse{Items: make([]duplicateCandidate, 0, len(output.Duplicates))}
+	for _, d := range output.Duplicates {
+		resp.Items = append(resp.Items, duplicateCandidate{passportSummary: toSummary(d.Passport), Score: d.Score})
+	}
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+// handleMergePassport POST /passports/{id}/merge
+func (s *Server) handleMergePassport(w http.ResponseWriter, r *http.Request, p params) error {
+	var req mergePassportRequest
+	if err := decodeBody(r, &req); err != nil {
+		return err
+	}
+
+	return s.mutate(w, r, p["id"], http.StatusOK, func(ctx context.Context) (*entity.TechnicalPassport, error) {
+		output, err := s.mergeUC.Execute(ctx, passport.MergePassportsInput{PassportID: p["id"], SourceID: req.SourceID})
+		if err != nil {
+			return nil, err
+		}
+		return output.Passport, nil
+	})
+}
+
+// handleValidatePassport GET /passports/{id}/validation
+func (s *Server) handleValidatePassport(w http.ResponseWriter, r *http.Request, p params) error {
+	output, err := s.validateUC.Execute(r.Context(), passport.ValidatePassportInput{
+		PassportID: p["id"],
+		Complete:   r.URL.Query().Get("complete") == "true",
+	})
+	if err != nil {
+		return err
+	}
+
+	writeJSON(w, http.StatusOK, toValidationResponse(output.Result))
+	return nil
+}
+
+// handleValidateDraft POST /validation - проверка паспорта без сохранения
+func (s *Server) handleValidateDraft(w http.ResponseWriter, r *http.Request, p params) error {
+	var draft entity.TechnicalPassport
+	if err := decodeBody(r, &draft); err != nil {
+		return err
+	}
+
+	output, err := s.validateUC.Execute(r.Context(), passport.ValidatePassportInput{
+		Passport: &draft,
+		Complete: r.URL.Query().Get("complete") == "true",
+	})
+	if err != nil {
+		return err
+	}
+
+	writeJSON(w, http.StatusOK, toValidationResponse(output.Result))
+	return nil
+}
+
+// handleExportPassport GET /passports/{id}/export?format=pdf|docx
+func (s *Server) handleExportPassport(w http.ResponseWriter, r *http.Request, p params) error {
+	format := service.DocumentFormat(r.URL.Query().Get("format"))
+	if format == "" {
+		format = service.FormatPDF
+	}
+
+	output, err := s.exportUC.Execute(r.Context(), passport.ExportPassportInput{PassportID: p["id"], Format: format})
+	if err != nil {
+		return err
+	}
+
+	contentType := "application/pdf"
+	if format == service.FormatDOCX {
+		contentType = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", `attachment; filename="`+output.FileName+`"`)
+	w.Header().Set("Content-Length", strconv.Itoa(len(output.Document)))
+	w.WriteHeader(http.StatusOK)
+
+	_, err = io.Copy(w, bytes.NewReader(output.Document))
+	return err
+}
+
+// mutate выполняет изменение паспорта с проверкой заголовка If-Match
+// и возвращает измененный паспорт с новым ETag
+func (s *Server) mutate(w http.ResponseWriter, r *http.Request, id string, status int, fn func(ctx context.Context) (*entity.TechnicalPassport, error)) error {
+	unlock := s.locks.lock(id)
+	defer unlock()
+
+	if err := s.checkIfMatch(r, id); err != nil {
+		return err
+	}
+
+	updated, err := fn(r.Context())
+	if err != nil {
+		return err
+	}
+
+	writePassport(w, status, updated)
+	return nil
+}
+
+// checkIfMatch сравнивает If-Match с текущей версией паспорта
+// Заголовок необязателен: без него изменение выполняется безусловно
+func (s *Server) checkIfMatch(r *http.Request, id string) error {
+	ifMatch := r.Header.Get("If-Match")
+	if ifMatch == "" {
+		return nil
+	}
+
+	output, err := s.getUC.Execute(r.Context(), passport.GetPassportInput{PassportID: id})
+	if err != nil {
+		return err
+	}
+
+	if !etagMatches(ifMatch, etagOf(output.Passport)) {
+		return preconditionFailed()
+	}
+
+	return nil
+}
+
+// writePassport выводит паспорт с заголовком ETag
+func writePassport(w http.ResponseWriter, status int, p *entity.TechnicalPassport) {
+	w.Header().Set("ETag", etagOf(p))
+	writeJSON(w, status, p)
+}
+
+// toValidationResponse преобразует результат валидации в DTO
+func toValidationResponse(result service.ValidationResult) validationResponse {
+	resp := validationResponse{
+		Valid:    result.Valid,
+		Errors:   make([]validationIssue, 0, len(result.Errors)),
+		Warnings: result.Warnings,
+	}
+	if resp.Warnings == nil {
+		resp.Warnings = []string{}
+	}
+	for _, e := range result.Errors {
+		resp.Errors = append(resp.Errors, validationIssue{Field: e.Field, Message: e.Message})
+	}
+	return resp
+}
+
+// decodeBody читает JSON тело запроса
+func decodeBody(r *http.Request, v interface{}) error {
+	decoder := json.NewDecoder(io.LimitReader(r.Body, maxBodySize))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(v); err != nil {
+		if errors.Is(err, io.EOF) {
+			return badRequest("тело запроса пустое")
+		}
+		return badRequest("некорректный JSON: " + err.Error())
+	}
+
+	return nil
+}
+
+// queryInt читает целочисленный параметр строки запроса
+func queryInt(r *http.Request, name string, fallback int) (int, error) {
+	raw := r.URL.Query().Get(name)
+	if raw == "" {
+		return fallback, nil
+	}
+
+	v, err := strconv.Atoi(raw)
+	if err != nil || v < 0 {
+		return 0, badRequest("параметр " + name + " должен быть неотрицательным числом")
+	}
+
+	return v, nil
+}
+
+// listFilter составляет условие отбора списка паспортов из параметров
+// строки запроса; значения одного параметра через запятую объединяются
+// через ИЛИ, разные параметры - через И. Без фильтров возвращает nil.
+func listFilter(r *http.Request) (repository.PassportSpecification, error) {
+	query := r.URL.Query()
+	var specs []repository.PassportSpecification
+
+	if v := query.Get("object_type"); v != "" {
+		var types []entity.ObjectType
+		for _, t := range strings.Split(v, ",") {
+			types = append(types, entity.ObjectType(strings.TrimSpace(t)))
+		}
+		specs = append(specs, repository.ByObjectType(types...))
+	}
+	if v := query.Get("organization"); v != "" {
+		specs = append(specs, repository.ByOrganization(v))
+	}
+	if v := query.Get("status"); v != "" {
+		var statuses []entity.PassportStatus
+		for _, status := range strings.Split(v, ",") {
+			statuses = append(statuses, entity.PassportStatus(strings.TrimSpace(status)))
+		}
+		specs = append(specs, repository.ByStatus(statuses...))
+	}
+	if v := query.Get("owner_tin"); v != "" {
+		specs = append(specs, repository.HasOwnerTIN(v))
+	}
+
+	from, err := queryDate(r, "created_from")
+	if err != nil {
+		return nil, err
+	}
+	to, err := queryDate(r, "created_to")
+	if err != nil {
+		return nil, err
+	}
+	if !from.IsZero() || !to.IsZero() {
+		// created_to включительно: паспорта, созданные до конца дня
+		if !to.IsZero() {
+			to = to.AddDate(0, 0, 1)
+		}
+		specs = append(specs, repository.CreatedBetween(from, to))
+	}
+
+	if len(specs) == 0 {
+		return nil, nil
+	}
+	return repository.And(specs...), nil
+}
+
+// queryDate читает дату ГГГГ-ММ-ДД из строки запроса; пусто - нулевая дата
+func queryDate(r *http.Request, name string) (time.Time, error) {
+	raw := r.URL.Query().Get(name)
+	if raw == "" {
+		return time.Time{}, nil
+	}
+
+	v, err := time.Parse("2006-01-02", raw)
+	if err != nil {
+		return time.Time{}, badRequest("параметр " + name + " должен быть датой в формате ГГГГ-ММ-ДД")
+	}
+
+	return v, nil
+}
+
+// pathIndex читает индекс элемента из пути
+func pathIndex(p params) (int, error) {
+	v, err := strconv.Atoi(p["index"])
+	if err != nil || v < 0 {
+		return 0, badRequest("индекс должен быть неотрицательным числом")
+	}
+	return v, nil
+}
@@ -0,0 +1,87 @@
+package rest
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// handleInterchangeSchema GET /interchange/schema - JSON Schema формата обмена
+func (s *Server) handleInterchangeSchema(w http.ResponseWriter, r *http.Request, p params) error {
+	schema := s.codec.Schema()
+
+	w.Header().Set("Content-Type", "application/schema+json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(schema)))
+	w.WriteHeader(http.StatusOK)
+
+	_, err := w.Write(schema)
+	return err
+}
+
+// handleInterchangeExport POST /interchange/export - выгрузка паспортов
+func (s *Server) handleInterchangeExport(w http.ResponseWriter, r *http.Request, p params) error {
+	var req interchangeExportRequest
+	if err := decodeBody(r, &req); err != nil {
+		return err
+	}
+
+	output, err := s.exportJSONUC.Execute(r.Context(), passport.ExportInterchangeInput{
+		PassportIDs: req.PassportIDs,
+		All:         req.All,
+	})
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+output.FileName+`"`)
+	w.Header().Set("Content-Length", strconv.Itoa(len(output.Data)))
+	w.WriteHeader(http.StatusOK)
+
+	_, err = io.Copy(w, bytes.NewReader(output.Data))
+	return err
+}
+
+// handleInterchangeImport POST /interchange/import?conflict=skip|overwrite|new&keep_status=true
+// Тело запроса - документ формата обмена без изменений
+func (s *Server) handleInterchangeImport(w http.ResponseWriter, r *http.Request, p params) error {
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		return badRequest("не удалось прочитать тело запроса: " + err.Error())
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return badRequest("тело запроса пустое")
+	}
+
+	conflict := passport.ConflictStrategy(r.URL.Query().Get("conflict"))
+	if conflict != "" && !conflict.IsValid() {
+		return badRequest("неизвестная стратегия конфликта: " + string(conflict))
+	}
+
+	output, err := s.importJSONUC.Execute(r.Context(), passport.ImportInterchangeInput{
+		Data:       data,
+		Conflict:   conflict,
+		KeepStatus: r.URL.Query().Get("keep_status") == "true",
+	})
+	if err != nil {
+		return err
+	}
+
+	resp := interchangeImportResponse{
+		SourceVersion: output.SourceVersion,
+		Results:       make([]interchangeImportItem, 0, len(output.Results)),
+	}
+	for _, res := range output.Results {
+		resp.Results = append(resp.Results, interchangeImportItem{
+			SourceID:   res.SourceID,
+			PassportID: res.PassportID,
+			Action:     string(res.Action),
+		})
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+	return nil
+}
@@ -0,0 +1,365 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+)
+
+// openAPIVersion версия API в спецификации
+const openAPIVersion = "1.0.0"
+
+// enumValues допустимые значения перечислимых типов домена
+var enumValues = map[reflect.Type][]string{
+	reflect.TypeOf(entity.ObjectType("")): {
+		string(entity.ObjectTypeResidentialHouse),
+		string(entity.ObjectTypeApartmentBuilding),
+		string(entity.ObjectTypeApartment),
+		string(entity.ObjectTypeRoom),
+		string(entity.ObjectTypeNonResidential),
+	},
+	reflect.TypeOf(entity.PersonType("")): {
+		string(entity.PersonTypeIndividual),
+		string(entity.PersonTypeLegal),
+	},
+	reflect.TypeOf(entity.PassportStatus("")): {
+		string(entity.PassportStatusDraft),
+		string(entity.PassportStatusApproved),
+		string(entity.PassportStatusArchived),
+	},
+	reflect.TypeOf(entity.UseCategory("")):   useCategoryValues(),
+	reflect.TypeOf(entity.StructureKind("")): structureKindValues(),
+}
+
+// useCategoryValues значения категорий использования нежилых помещений
+func useCategoryValues() []string {
+	values := make([]string, 0, len(entity.UseCategories))
+	for _, c := range entity.UseCategories {
+		values = append(values, string(c))
+	}
+	return values
+}
+
+// structureKindValues значения видов сооружений
+func structureKindValues() []string {
+	values := make([]string, 0, len(entity.StructureKinds))
+	for _, k := range entity.StructureKinds {
+		values = append(values, string(k))
+	}
+	return values
+}
+
+// schemaBuilder формирует JSON Schema по типам Go
+// Именованные структуры выносятся в components/schemas и подключаются через $ref
+type schemaBuilder struct {
+	schemas map[string]interface{}
+}
+
+// generateOpenAPI формирует спецификацию OpenAPI 3.0 по таблице маршрутов
+func generateOpenAPI(routes []route) ([]byte, error) {
+	b := &schemaBuilder{schemas: map[string]interface{}{}}
+	errorRef := b.schemaFor(reflect.TypeOf(errorResponse{}))
+
+	paths := map[string]map[string]interface{}{}
+	for _, rt := range routes {
+		op := map[string]interface{}{
+			"summary":     rt.Summary,
+			"operationId": operationID(rt),
+			"tags":        []string{rt.Tag},
+			"responses":   b.responses(rt, errorRef),
+		}
+
+		if rt.Public {
+			op["security"] = []interface{}{}
+		}
+
+		var parameters []interface{}
+		for _, name := range pathParams(rt.Pattern) {
+			parameters = append(parameters, map[string]interface{}{
+				"name":     name,
+				"in":       "path",
+				"required": true,
+				"schema":   pathParamSchema(name),
+			})
+		}
+		for _, q := range rt.Query {
+			schema := map[string]interface{}{"type": q.Type}
+			if len(q.Enum) > 0 {
+				schema["enum"] = q.Enum
+			}
+			parameters = append(parameters, map[string]interface{}{
+				"name":        q.Name,
+				"in":          "query",
+				"description": q.Description,
+				"schema":      schema,
+			})
+		}
+		if rt.Method == http.MethodPut || rt.Method == http.MethodDelete ||
+			(rt.Method == http.MethodPost && strings.Contains(rt.Pattern, "{id}")) {
+			parameters = append(parameters, map[string]interface{}{
+				"name":        "If-Match",
+				"in":          "header",
+				"description": "ETag паспорта для оптимистической блокировки",
+				"schema":      map[string]interface{}{"type": "string"},
+			})
+		}
+		if len(parameters) > 0 {
+			op["parameters"] = parameters
+		}
+
+		if rt.Request != nil {
+			op["requestBody"] = map[string]interface{}{
+				"required": true,
+				"content": map[string]interface{}{
+					"application/json": map[string]interface{}{
+						"schema": b.schemaFor(reflect.TypeOf(rt.Request)),
+					},
+				},
+			}
+		}
+
+		if paths[rt.Pattern] == nil {
+			paths[rt.Pattern] = map[string]interface{}{}
+		}
+		paths[rt.Pattern][strings.ToLower(rt.Method)] = op
+	}
+
+	spec := map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":       "GoTechPasport API",
+			"description": "REST API для работы с техническими паспортами объектов недвижимости",
+			"version":     openAPIVersion,
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": b.schemas,
+			"securitySchemes": map[string]interface{}{
+				"basicAuth": map[string]interface{}{
+					"type":   "http",
+					"scheme": "basic",
+				},
+			},
+		},
+		"security": []interface{}{
+			map[string]interface{}{"basicAuth": []string{}},
+		},
+	}
+
+	data, err := json.MarshalIndent(spec, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal openapi spec: %w", err)
+	}
+
+	return data, nil
+}
+
+// responses формирует описание ответов операции
+func (b *schemaBuilder) responses(rt route, errorRef interface{}) map[string]interface{} {
+	success := map[string]interface{}{
+		"description": http.StatusText(rt.Status),
+	}
+
+	switch {
+	case rt.Response != nil:
+		success["content"] = map[string]interface{}{
+			"application/json": map[string]interface{}{
+				"schema": b.schemaFor(reflect.TypeOf(rt.Response)),
+			},
+		}
+	case rt.ContentType != "":
+		schema := map[string]interface{}{"type": "string"}
+		if rt.ContentType == "application/octet-stream" {
+			schema["format"] = "binary"
+		}
+		success["content"] = map[string]interface{}{
+			rt.ContentType: map[string]interface{}{"schema": schema},
+		}
+	case rt.Pattern == apiPrefix+"/openapi.json":
+		success["content"] = map[string]interface{}{
+			"application/json": map[string]interface{}{"schema": map[string]interface{}{"type": "object"}},
+		}
+	}
+
+	errorContent := map[string]interface{}{
+		"application/json": map[string]interface{}{"schema": errorRef},
+	}
+	errorResp := func(status int) map[string]interface{} {
+		return map[string]interface{}{
+			"description": http.StatusText(status),
+			"content":     errorContent,
+		}
+	}
+
+	responses := map[string]interface{}{
+		strconv.Itoa(rt.Status): success,
+		"500":                   errorResp(http.StatusInternalServerError),
+	}
+
+	if !rt.Public {
+		responses["401"] = errorResp(http.StatusUnauthorized)
+		responses["403"] = errorResp(http.StatusForbidden)
+	}
+	if strings.Contains(rt.Pattern, "{id}") {
+		responses["404"] = errorResp(http.StatusNotFound)
+	}
+	if rt.Request != nil || rt.Query != nil || strings.Contains(rt.Pattern, "{index}") {
+		responses["400"] = errorResp(http.StatusBadRequest)
+	}
+	if rt.Method != http.MethodGet {
+		responses["422"] = errorResp(http.StatusUnprocessableEntity)
+	}
+	if _, ok := responses["400"]; !ok && rt.Method == http.MethodPost {
+		responses["400"] = errorResp(http.StatusBadRequest)
+	}
+	if rt.Method == http.MethodPut || rt.Method == http.MethodDelete ||
+		(rt.Method == http.MethodPost && strings.Contains(rt.Pattern, "{id}")) {
+		responses["412"] = errorResp(http.StatusPreconditionFailed)
+	}
+	if rt.Method == http.MethodGet && rt.Pattern == apiPrefix+"/passports/{id}" {
+		responses["304"] = map[string]interface{}{"description": http.StatusText(http.StatusNotModified)}
+	}
+
+	return responses
+}
+
+// schemaFor возвращает схему для типа Go
+func (b *schemaBuilder) schemaFor(t reflect.Type) interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if values, ok := enumValues[t]; ok {
+		return map[string]interface{}{"type": "string", "enum": values}
+	}
+
+	if t == reflect.TypeOf(time.Time{}) {
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": "string", "format": "byte"}
+		}
+		return map[string]interface{}{"type": "array", "items": b.schemaFor(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": b.schemaFor(t.Elem())}
+	case reflect.Struct:
+		return b.structRef(t)
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+// structRef регистрирует схему структуры в components и возвращает ссылку
+func (b *schemaBuilder) structRef(t reflect.Type) interface{} {
+	name := schemaName(t)
+	ref := map[string]interface{}{"$ref": "#/components/schemas/" + name}
+
+	if _, ok := b.schemas[name]; ok {
+		return ref
+	}
+	// Резервируем имя до обхода полей, чтобы не зациклиться на рекурсивных типах
+	b.schemas[name] = nil
+
+	properties := map[string]interface{}{}
+	var required []string
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		jsonName, opts, _ := strings.Cut(tag, ",")
+		if jsonName == "" {
+			jsonName = field.Name
+		}
+
+		properties[jsonName] = b.schemaFor(field.Type)
+		if !strings.Contains(opts, "omitempty") {
+			required = append(required, jsonName)
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		sort.Strings(required)
+		schema["required"] = required
+	}
+
+	b.schemas[name] = schema
+	return ref
+}
+
+// schemaName имя схемы в components: CamelCase имя типа Go
+func schemaName(t reflect.Type) string {
+	name := t.Name()
+	if name == "" {
+		return "Anonymous"
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// operationID формирует идентификатор операции из метода и пути
+func operationID(rt route) string {
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(rt.Method))
+
+	for _, part := range strings.Split(strings.TrimPrefix(rt.Pattern, apiPrefix), "/") {
+		if part == "" {
+			continue
+		}
+		if strings.HasPrefix(part, "{") {
+			part = "by_" + strings.Trim(part, "{}")
+		}
+		for _, word := range strings.FieldsFunc(part, func(r rune) bool { return r == '_' || r == '.' }) {
+			sb.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+
+	return sb.String()
+}
+
+// pathParams возвращает имена параметров пути шаблона
+func pathParams(pattern string) []string {
+	var names []string
+	for _, part := range strings.Split(pattern, "/") {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			names = append(names, part[1:len(part)-1])
+		}
+	}
+	return names
+}
+
+// pathParamSchema схема параметра пути
+func pathParamSchema(name string) map[string]interface{} {
+	if name == "index" {
+		return map[string]interface{}{"type": "integer", "minimum": 0}
+	}
+	return map[string]interface{}{"type": "string"}
+}
@@ -0,0 +1,62 @@
+package rest
+
+import (
+	"net/http"
+	"strings"
+)
+
+// params параметры пути запроса ({id}, {index})
+type params map[string]string
+
+// handlerFunc обработчик маршрута; ошибка преобразуется в HTTP ответ централизованно
+type handlerFunc func(w http.ResponseWriter, r *http.Request, p params) error
+
+// queryParam описание параметра строки запроса (для OpenAPI)
+type queryParam struct {
+	Name        string
+	Type        string // string, integer, boolean
+	Description string
+	Enum        []string
+}
+
+// route описание маршрута API
+// Одно и то же описание используется для маршрутизации и генерации OpenAPI
+type route struct {
+	Method      string
+	Pattern     string
+	Summary     string
+	Tag         string
+	Public      bool        // не требует аутентификации
+	Request     interface{} // DTO тела запроса (nil - без тела)
+	Response    interface{} // DTO ответа (nil - без тела)
+	Status      int         // код успешного ответа
+	ContentType string      // тип ответа, если не JSON
+	Query       []queryParam
+	Handler     handlerFunc
+}
+
+// match сопоставляет путь запроса с шаблоном маршрута
+func match(pattern, path string) (params, bool) {
+	patternParts := strings.Split(strings.Trim(pattern, "/"), "/")
+	pathParts := strings.Split(strings.Trim(path, "/"), "/")
+
+	if len(patternParts) != len(pathParts) {
+		return nil, false
+	}
+
+	p := params{}
+	for i, part := range patternParts {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			if pathParts[i] == "" {
+				return nil, false
+			}
+			p[part[1:len(part)-1]] = pathParts[i]
+			continue
+		}
+		if part != pathParts[i] {
+			return nil, false
+		}
+	}
+
+	return p, true
+}
@@ -0,0 +1,319 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// buildRoutes формирует таблицу маршрутов API
+func (s *Server) buildRoutes() []route {
+	passportQuery := []queryParam{
+		{Name: "complete", Type: "boolean", Description: "Требовать полноты паспорта для экспорта"},
+	}
+
+	routes := []route{
+		{
+			Method: http.MethodGet, Pattern: "/healthz", Summary: "Проверка работоспособности", Tag: "service",
+			Public: true, Status: http.StatusOK, ContentType: "text/plain", Handler: s.handleHealth,
+		},
+		{
+			Method: http.MethodGet, Pattern: apiPrefix + "/openapi.json", Summary: "Спецификация OpenAPI", Tag: "service",
+			Public: true, Status: http.StatusOK, Handler: s.handleOpenAPI,
+		},
+		{
+			Method: http.MethodGet, Pattern: apiPrefix + "/passports", Summary: "Список паспортов", Tag: "passports",
+			Response: passportPage{}, Status: http.StatusOK, Handler: s.handleListPassports,
+			Query: []queryParam{
+				{Name: "offset", Type: "integer", Description: "Смещение от начала списка"},
+				{Name: "limit", Type: "integer", Description: "Размер страницы (1-500, по умолчанию 50)"},
+				{Name: "cursor", Type: "string", Description: "Курсор следующей страницы (next_cursor); вместо offset"},
+				{Name: "sort", Type: "string", Description: "Поле сортировки (по умолчанию id)", Enum: []string{"id", "address", "created", "updated", "year", "area"}},
+				{Name: "desc", Type: "boolean", Description: "Сортировка по убыванию"},
+				{Name: "object_type", Type: "string", Description: "Типы объекта через запятую"},
+				{Name: "organization", Type: "string", Description: "Организация, составившая паспорт"},
+				{Name: "status", Type: "string", Description: "Статусы паспорта через запятую"},
+				{Name: "created_from", Type: "string", Description: "Создан не раньше даты ГГГГ-ММ-ДД"},
+				{Name: "created_to", Type: "string", Description: "Создан не позже даты ГГГГ-ММ-ДД"},
+				{Name: "owner_tin", Type: "string", Description: "ИНН правообладателя"},
+			},
+		},
+		{
+			Method: http.MethodPost, Pattern: apiPrefix + "/passports", Summary: "Создание паспорта", Tag: "passports",
+			Request: createPassportRequest{}, Response: entity.TechnicalPassport{}, Status: http.StatusCreated,
+			Handler: s.handleCreatePassport,
+		},
+		{
+			Method: http.MethodGet, Pattern: apiPrefix + "/passports/{id}", Summary: "Получение паспорта", Tag: "passports",
+			Response: entity.TechnicalPassport{}, Status: http.StatusOK, Handler: s.handleGetPassport,
+		},
+		{
+			Method: http.MethodPut, Pattern: apiPrefix + "/passports/{id}", Summary: "Изменение общих сведений паспорта", Tag: "passports",
+			Request: updatePassportRequest{}, Response: entity.TechnicalPassport{}, Status: http.StatusOK,
+			Handler: s.handleUpdatePassport,
+		},
+		{
+			Method: http.MethodDelete, Pattern: apiPrefix + "/passports/{id}", Summary: "Удаление паспорта", Tag: "passports",
+			Status: http.StatusNoContent, Handler: s.handleDeletePassport,
+		},
+		{
+			Method: http.MethodPost, Pattern: apiPrefix + "/passports/{id}/approve", Summary: "Утверждение паспорта", Tag: "passports",
+			Response: entity.TechnicalPassport{}, Status: http.StatusOK, Handler: s.handleApprovePassport,
+		},
+		{
+			Method: http.MethodPost, Pattern: apiPrefix + "/passports/{id}/archive", Summary: "Архивирование паспорта", Tag: "passports",
+			Response: entity.TechnicalPassport{}, Status: http.StatusOK, Handler: s.handleArchivePassport,
+		},
+		{
+			Method: http.MethodPost, Pattern: apiPrefix + "/passports/{id}/issue", Summary: "Выдача утвержденного паспорта заказчику", Tag: "passports",
+			Request: issuePassportRequest{}, Response: entity.TechnicalPassport{}, Status: http.StatusOK, Handler: s.handleIssuePassport,
+		},
+		{
+			Method: http.MethodGet, Pattern: apiPrefix + "/passports/{id}/duplicates", Summary: "Вероятные дубликаты паспорта по сходству адресов", Tag: "passports",
+			Response: duplicatesResponse{}, Status: http.StatusOK, Handler: s.handleFindDuplicates,
+			Query: []queryParam{
+				{Name: "threshold", Type: "number", Description: "Минимальное сходство адресов от 0 до 1 (по умолчанию 0.8)"},
+			},
+		},
+		{
+			Method: http.MethodPost, Pattern: apiPrefix + "/passports/{id}/merge", Summary: "Перенос сведений дубликата в паспорт и удаление дубликата", Tag: "passports",
+			Request: mergePassportRequest{}, Response: entity.TechnicalPassport{}, Status: http.StatusOK, Handler: s.handleMergePassport,
+		},
+		{
+			Method: http.MethodGet, Pattern: apiPrefix + "/passports/{id}/validation", Summary: "Проверка сохраненного паспорта", Tag: "validation",
+			Response: validationResponse{}, Status: http.StatusOK, Query: passportQuery, Handler: s.handleValidatePassport,
+		},
+		{
+			Method: http.MethodPost, Pattern: apiPrefix + "/validation", Summary: "Проверка паспорта без сохранения", Tag: "validation",
+			Request: entity.TechnicalPassport{}, Response: validationResponse{}, Status: http.StatusOK, Query: passportQuery,
+			Handler: s.handleValidateDraft,
+		},
+		{
+			Method: http.MethodGet, Pattern: apiPrefix + "/passports/{id}/export", Summary: "Экспорт паспорта в документ", Tag: "export",
+			Status: http.StatusOK, ContentType: "application/octet-stream", Handler: s.handleExportPassport,
+			Query: []queryParam{
+				{Name: "format", Type: "string", Description: "Формат документа (по умолчанию pdf)", Enum: []string{"pdf", "docx"}},
+			},
+		},
+		{
+			Method: http.MethodGet, Pattern: apiPrefix + "/interchange/schema", Summary: "JSON Schema формата обмена", Tag: "interchange",
+			Public: true, Status: http.StatusOK, ContentType: "application/schema+json", Handler: s.handleInterchangeSchema,
+		},
+		{
+			Method: http.MethodPost, Pattern: apiPrefix + "/interchange/export", Summary: "Выгрузка паспортов в формат обмена", Tag: "interchange",
+			Request: interchangeExportRequest{}, Status: http.StatusOK, ContentType: "application/json",
+			Handler: s.handleInterchangeExport,
+		},
+		{
+			Method: http.MethodPost, Pattern: apiPrefix + "/interchange/import", Summary: "Загрузка паспортов из формата обмена", Tag: "interchange",
+			Response: interchangeImportResponse{}, Status: http.StatusOK, Handler: s.handleInterchangeImport,
+			Query: []queryParam{
+				{Name: "conflict", Type: "string", Description: "Поведение при совпадении ID (по умолчанию skip)", Enum: []string{"skip", "overwrite", "new"}},
+				{Name: "keep_status", Type: "boolean", Description: "Сохранить статусы паспортов из документа"},
+			},
+		},
+	}
+
+	routes = append(routes, s.collectionRoutes(collection{
+		Name:   "buildings",
+		Title:  "здания",
+		Plural: "зданий",
+		Item:   entity.Building{},
+		Items:  []entity.Building{},
+		List: func(p *entity.TechnicalPassport) interface{} {
+			return nonNil(p.Buildings)
+		},
+		Add: func(ctx context.Context, id string, r *http.Request) (*entity.TechnicalPassport, error) {
+			var b entity.Building
+			if err := decodeBody(r, &b); err != nil {
+				return nil, err
+			}
+			out, err := s.addBuildingUC.Execute(ctx, passport.AddBuildingInput{PassportID: id, Building: b})
+			if err != nil {
+				return nil, err
+			}
+			return out.Passport, nil
+		},
+		Update: func(ctx context.Context, id string, index int, r *http.Request) (*entity.TechnicalPassport, error) {
+			var b entity.Building
+			if err := decodeBody(r, &b); err != nil {
+				return nil, err
+			}
+			out, err := s.updateBuildingUC.Execute(ctx, passport.UpdateBuildingInput{PassportID: id, BuildingIndex: index, Building: b})
+			if err != nil {
+				return nil, err
+			}
+			return out.Passport, nil
+		},
+		Remove: func(ctx context.Context, id string, index int) (*entity.TechnicalPassport, error) {
+			out, err := s.removeBuildingUC.Execute(ctx, passport.RemoveBuildingInput{PassportID: id, BuildingIndex: index})
+			if err != nil {
+				return nil, err
+			}
+			return out.Passport, nil
+		},
+	})...)
+
+	routes = append(routes, s.collectionRoutes(collection{
+		Name:   "owners",
+		Title:  "правообладателя",
+		Plural: "правообладателей",
+		Item:   entity.Owner{},
+		Items:  []entity.Owner{},
+		List: func(p *entity.TechnicalPassport) interface{} {
+			return nonNil(p.Owners)
+		},
+		Add: func(ctx context.Context, id string, r *http.Request) (*entity.TechnicalPassport, error) {
+			var o entity.Owner
+			if err := decodeBody(r, &o); err != nil {
+				return nil, err
+			}
+			out, err := s.addOwnerUC.Execute(ctx, passport.AddOwnerInput{PassportID: id, Owner: o})
+			if err != nil {
+				return nil, err
+			}
+			return out.Passport, nil
+		},
+		Update: func(ctx context.Context, id string, index int, r *http.Request) (*entity.TechnicalPassport, error) {
+			var o entity.Owner
+			if err := decodeBody(r, &o); err != nil {
+				return nil, err
+			}
+			out, err := s.updateOwnerUC.Execute(ctx, passport.UpdateOwnerInput{PassportID: id, OwnerIndex: index, Owner: o})
+			if err != nil {
+				return nil, err
+			}
+			return out.Passport, nil
+		},
+		Remove: func(ctx context.Context, id string, index int) (*entity.TechnicalPassport, error) {
+			out, err := s.removeOwnerUC.Execute(ctx, passport.RemoveOwnerInput{PassportID: id, OwnerIndex: index})
+			if err != nil {
+				return nil, err
+			}
+			return out.Passport, nil
+		},
+	})...)
+
+	routes = append(routes, s.collectionRoutes(collection{
+		Name:   "rooms",
+		Title:  "помещения",
+		Plural: "помещений",
+		Item:   entity.Room{},
+		Items:  []entity.Room{},
+		List: func(p *entity.TechnicalPassport) interface{} {
+			return nonNil(p.Explication)
+		},
+		Add: func(ctx context.Context, id string, r *http.Request) (*entity.TechnicalPassport, error) {
+			var room entity.Room
+			if err := decodeBody(r, &room); err != nil {
+				return nil, err
+			}
+			out, err := s.addRoomUC.Execute(ctx, passport.AddRoomInput{PassportID: id, Room: room})
+			if err != nil {
+				return nil, err
+			}
+			return out.Passport, nil
+		},
+		Update: func(ctx context.Context, id string, index int, r *http.Request) (*entity.TechnicalPassport, error) {
+			var room entity.Room
+			if err := decodeBody(r, &room); err != nil {
+				return nil, err
+			}
+			out, err := s.updateRoomUC.Execute(ctx, passport.UpdateRoomInput{PassportID: id, RoomIndex: index, Room: room})
+			if err != nil {
+				return nil, err
+			}
+			return out.Passport, nil
+		},
+		Remove: func(ctx context.Context, id string, index int) (*entity.TechnicalPassport, error) {
+			out, err := s.removeRoomUC.Execute(ctx, passport.RemoveRoomInput{PassportID: id, RoomIndex: index})
+			if err != nil {
+				return nil, err
+			}
+			return out.Passport, nil
+		},
+	})...)
+
+	return routes
+}
+
+// collection описание вложенной коллекции паспорта (здания, правообладатели, помещения)
+type collection struct {
+	Name   string      // сегмент пути
+	Title  string      // название элемента в родительном падеже для описаний
+	Plural string      // название элементов во множественном числе для описаний
+	Item   interface{} // DTO элемента
+	Items  interface{} // DTO списка элементов
+	List   func(p *entity.TechnicalPassport) interface{}
+	Add    func(ctx context.Context, id string, r *http.Request) (*entity.TechnicalPassport, error)
+	Update func(ctx context.Context, id string, index int, r *http.Request) (*entity.TechnicalPassport, error)
+	Remove func(ctx context.Context, id string, index int) (*entity.TechnicalPassport, error)
+}
+
+// collectionRoutes формирует CRUD маршруты вложенной коллекции
+func (s *Server) collectionRoutes(c collection) []route {
+	base := apiPrefix + "/passports/{id}/" + c.Name
+	item := base + "/{index}"
+
+	return []route{
+		{
+			Method: http.MethodGet, Pattern: base, Summary: "Список " + c.Plural, Tag: c.Name,
+			Response: c.Items, Status: http.StatusOK,
+			Handler: func(w http.ResponseWriter, r *http.Request, p params) error {
+				out, err := s.getUC.Execute(r.Context(), passport.GetPassportInput{PassportID: p["id"]})
+				if err != nil {
+					return err
+				}
+				w.Header().Set("ETag", etagOf(out.Passport))
+				writeJSON(w, http.StatusOK, c.List(out.Passport))
+				return nil
+			},
+		},
+		{
+			Method: http.MethodPost, Pattern: base, Summary: "Добавление " + c.Title, Tag: c.Name,
+			Request: c.Item, Response: entity.TechnicalPassport{}, Status: http.StatusCreated,
+			Handler: func(w http.ResponseWriter, r *http.Request, p params) error {
+				w.Header().Set("Location", apiPrefix+"/passports/"+p["id"])
+				return s.mutate(w, r, p["id"], http.StatusCreated, func(ctx context.Context) (*entity.TechnicalPassport, error) {
+					return c.Add(ctx, p["id"], r)
+				})
+			},
+		},
+		{
+			Method: http.MethodPut, Pattern: item, Summary: "Изменение " + c.Title, Tag: c.Name,
+			Request: c.Item, Response: entity.TechnicalPassport{}, Status: http.StatusOK,
+			Handler: func(w http.ResponseWriter, r *http.Request, p params) error {
+				index, err := pathIndex(p)
+				if err != nil {
+					return err
+				}
+				return s.mutate(w, r, p["id"], http.StatusOK, func(ctx context.Context) (*entity.TechnicalPassport, error) {
+					return c.Update(ctx, p["id"], index, r)
+				})
+			},
+		},
+		{
+			Method: http.MethodDelete, Pattern: item, Summary: "Удаление " + c.Title, Tag: c.Name,
+			Response: entity.TechnicalPassport{}, Status: http.StatusOK,
+			Handler: func(w http.ResponseWriter, r *http.Request, p params) error {
+				index, err := pathIndex(p)
+				if err != nil {
+					return err
+				}
+				return s.mutate(w, r, p["id"], http.StatusOK, func(ctx context.Context) (*entity.TechnicalPassport, error) {
+					return c.Remove(ctx, p["id"], index)
+				})
+			},
+		},
+	}
+}
+
+// nonNil заменяет nil срез пустым, чтобы в JSON выводился [] вместо null
+func nonNil[T any](items []T) []T {
+	if items == nil {
+		return []T{}
+	}
+	return items
+}
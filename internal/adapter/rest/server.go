@@ -0,0 +1,219 @@
+// Package rest реализует HTTP REST API поверх use cases технического паспорта
+package rest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/user"
+)
+
+// apiPrefix префикс всех маршрутов API
+const apiPrefix = "/api/v1"
+
+// Config зависимости HTTP сервера
+type Config struct {
+	// Passports хранилище паспортов (любая реализация PassportRepository)
+	Passports repository.PassportRepository
+
+	// Users хранилище пользователей для Basic аутентификации
+	Users repository.UserRepository
+
+	// Hasher проверка паролей пользователей
+	Hasher service.PasswordHasher
+
+	// Generator генератор документов для экспорта
+	Generator service.DocumentGenerator
+
+	// Codec формат обмена паспортами
+	Codec service.InterchangeCodec
+
+	// Normalizer сравнение адресов для поиска дубликатов
+	Normalizer service.AddressNormalizer
+
+	// Logger журнал ошибок (по умолчанию stderr)
+	Logger *log.Logger
+}
+
+// Server HTTP сервер REST API
+type Server struct {
+	routes []route
+	locks  passportLocks
+	logger *log.Logger
+	spec   []byte
+
+	loginUC *user.LoginUseCase
+
+	createUC   *access.CreatePassportUseCase
+	getUC      *access.GetPassportUseCase
+	updateUC   *access.UpdatePassportUseCase
+	deleteUC   *access.DeletePassportUseCase
+	listUC     *access.ListPassportsUseCase
+	approveUC  *access.ApprovePassportUseCase
+	archiveUC  *access.ArchivePassportUseCase
+	issueUC    *access.IssuePassportUseCase
+	validateUC *access.ValidatePassportUseCase
+	exportUC   *access.ExportPassportUseCase
+
+	duplicatesUC *access.FindDuplicatesUseCase
+	mergeUC      *access.MergePassportsUseCase
+
+	codec        service.InterchangeCodec
+	exportJSONUC *access.ExportInterchangeUseCase
+	importJSONUC *access.ImportInterchangeUseCase
+
+	addBuildingUC    *access.AddBuildingUseCase
+	updateBuildingUC *access.UpdateBuildingUseCase
+	removeBuildingUC *access.RemoveBuildingUseCase
+	addOwnerUC       *access.AddOwnerUseCase
+	updateOwnerUC    *access.UpdateOwnerUseCase
+	removeOwnerUC    *access.RemoveOwnerUseCase
+	addRoomUC        *access.AddRoomUseCase
+	updateRoomUC     *access.UpdateRoomUseCase
+	removeRoomUC     *access.RemoveRoomUseCase
+}
+
+// NewServer создает HTTP сервер REST API
+func NewServer(cfg Config) (*Server, error) {
+	repo := cfg.Passports
+
+	s := &Server{
+		logger:  cfg.Logger,
+		loginUC: user.NewLoginUseCase(cfg.Users, cfg.Hasher),
+
+		createUC:   access.NewCreatePassportUseCase(passport.NewCreatePassportUseCase(repo, cfg.Normalizer)),
+		getUC:      access.NewGetPassportUseCase(passport.NewGetPassportUseCase(repo)),
+		updateUC:   access.NewUpdatePassportUseCase(passport.NewUpdatePassportUseCase(repo)),
+		deleteUC:   access.NewDeletePassportUseCase(passport.NewDeletePassportUseCase(repo)),
+		listUC:     access.NewListPassportsUseCase(passport.NewListPassportsUseCase(repo)),
+		approveUC:  access.NewApprovePassportUseCase(passport.NewApprovePassportUseCase(repo)),
+		archiveUC:  access.NewArchivePassportUseCase(passport.NewArchivePassportUseCase(repo)),
+		issueUC:    access.NewIssuePassportUseCase(passport.NewIssuePassportUseCase(repo)),
+		validateUC: access.NewValidatePassportUseCase(passport.NewValidatePassportUseCase(repo)),
+		exportUC:   access.NewExportPassportUseCase(passport.NewExportPassportUseCase(repo, cfg.Generator)),
+
+		duplicatesUC: access.NewFindDuplicatesUseCase(passport.NewFindDuplicatesUseCase(repo, cfg.Normalizer)),
+		mergeUC:      access.NewMergePassportsUseCase(passport.NewMergePassportsUseCase(repo)),
+
+		codec:        cfg.Codec,
+		exportJSONUC: access.NewExportInterchangeUseCase(passport.NewExportInterchangeUseCase(repo, cfg.Codec)),
+		importJSONUC: access.NewImportInterchangeUseCase(passport.NewImportInterchangeUseCase(repo, cfg.Codec)),
+
+		addBuildingUC:    access.NewAddBuildingUseCase(passport.NewAddBuildingUseCase(repo)),
+		updateBuildingUC: access.NewUpdateBuildingUseCase(passport.NewUpdateBuildingUseCase(repo)),
+		removeBuildingUC: access.NewRemoveBuildingUseCase(passport.NewRemoveBuildingUseCase(repo)),
+		addOwnerUC:       access.NewAddOwnerUseCase(passport.NewAddOwnerUseCase(repo)),
+		updateOwnerUC:    access.NewUpdateOwnerUseCase(passport.NewUpdateOwnerUseCase(repo)),
+		removeOwnerUC:    access.NewRemoveOwnerUseCase(passport.NewRemoveOwnerUseCase(repo)),
+		addRoomUC:        access.NewAddRoomUseCase(passport.NewAddRoomUseCase(repo)),
+		updateRoomUC:     access.NewUpdateRoomUseCase(passport.NewUpdateRoomUseCase(repo)),
+		removeRoomUC:     access.NewRemoveRoomUseCase(passport.NewRemoveRoomUseCase(repo)),
+	}
+
+	if s.logger == nil {
+		s.logger = log.New(os.Stderr, "rest: ", log.LstdFlags)
+	}
+
+	s.routes = s.buildRoutes()
+
+	spec, err := generateOpenAPI(s.routes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate openapi spec: %w", err)
+	}
+	s.spec = spec
+
+	return s, nil
+}
+
+// OpenAPI возвращает спецификацию API в формате JSON
+func (s *Server) OpenAPI() []byte {
+	return s.spec
+}
+
+// ServeHTTP маршрутизирует запрос
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var allowed []string
+
+	for _, rt := range s.routes {
+		p, ok := match(rt.Pattern, r.URL.Path)
+		if !ok {
+			continue
+		}
+		if rt.Method != r.Method {
+			allowed = append(allowed, rt.Method)
+			continue
+		}
+
+		s.serveRoute(w, r, rt, p)
+		return
+	}
+
+	if len(allowed) > 0 {
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		s.writeError(w, httpError{status: http.StatusMethodNotAllowed, message: "метод не поддерживается"})
+		return
+	}
+
+	s.writeError(w, httpError{status: http.StatusNotFound, message: "ресурс не найден"})
+}
+
+// serveRoute выполняет аутентификацию и обработчик маршрута
+func (s *Server) serveRoute(w http.ResponseWriter, r *http.Request, rt route, p params) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			s.writeError(w, fmt.Errorf("panic: %v", rec))
+		}
+	}()
+
+	if !rt.Public {
+		ctx, err := s.authenticate(r)
+		if err != nil {
+			s.writeError(w, err)
+			return
+		}
+		r = r.WithContext(ctx)
+	}
+
+	if err := rt.Handler(w, r, p); err != nil {
+		s.writeError(w, err)
+	}
+}
+
+// authenticate проверяет учетные данные Basic аутентификации
+func (s *Server) authenticate(r *http.Request) (context.Context, error) {
+	login, password, ok := r.BasicAuth()
+	if !ok {
+		return nil, entity.AuthenticationError{Message: "требуется Basic аутентификация"}
+	}
+
+	output, err := s.loginUC.Execute(r.Context(), user.LoginInput{Login: login, Password: password})
+	if err != nil {
+		return nil, err
+	}
+
+	return access.WithActor(r.Context(), output.User), nil
+}
+
+// handleOpenAPI отдает спецификацию OpenAPI
+func (s *Server) handleOpenAPI(w http.ResponseWriter, r *http.Request, p params) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_, err := w.Write(s.spec)
+	return err
+}
+
+// handleHealth проверка работоспособности
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request, p params) error {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, err := io.WriteString(w, "ok\n")
+	return err
+}
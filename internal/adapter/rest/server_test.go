@@ -0,0 +1,340 @@
+package rest_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/adapter/rest"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/address"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/document"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/interchange"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/security"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const createJSON = `{
+	"object_type": "residential_house",
+	"organization_name": "ГУП БТИ",
+	"address": {"subject": "г. Москва", "city": "Москва", "street": "ул. Тверская", "house": "1"},
+	"general_info": {"purpose": "Жилое", "construction_year": 2020, "total_area": 100.5, "living_area": 70}
+}`
+
+const buildingJSON = `{"litera": "А", "name": "Жилой дом", "commission_year": 2020, "wall_material": "Кирпич", "total_area": 100.5}`
+
+const ownerJSON = `{"entry_date": "2024-01-15T00:00:00Z", "right_document": "Выписка ЕГРН", "person_type": "individual", "full_name": "Иванов Иван Иванович", "share": "1/1", "right_type": "Собственность"}`
+
+// apiClient клиент тестового сервера
+type apiClient struct {
+	t        *testing.T
+	baseURL  string
+	login    string
+	password string
+}
+
+func newTestServer(t *testing.T) (*apiClient, *apiClient) {
+	users := memory.NewInMemoryUserRepository()
+	hasher := security.NewBcryptHasher()
+	register := user.NewRegisterUserUseCase(users, hasher)
+
+	_, err := register.Execute(context.Background(), user.RegisterUserInput{Login: "admin", Password: "secret1", Role: entity.RoleAdmin})
+	require.NoError(t, err)
+
+	admin, err := users.GetByLogin(context.Background(), "admin")
+	require.NoError(t, err)
+	_, err = register.Execute(access.WithActor(context.Background(), admin),
+		user.RegisterUserInput{Login: "reviewer", Password: "secret1", Role: entity.RoleReviewer})
+	require.NoError(t, err)
+
+	server, err := rest.NewServer(rest.Config{
+		Passports:  memory.NewInMemoryPassportRepository(),
+		Users:      users,
+		Hasher:     hasher,
+		Generator:  document.NewGenerator(),
+		Codec:      interchange.NewCodec(),
+		Normalizer: address.NewNormalizer(),
+	})
+	require.NoError(t, err)
+
+	ts := httptest.NewServer(server)
+	t.Cleanup(ts.Close)
+
+	return &apiClient{t: t, baseURL: ts.URL, login: "admin", password: "secret1"},
+		&apiClient{t: t, baseURL: ts.URL, login: "reviewer", password: "secret1"}
+}
+
+// do выполняет запрос и возвращает ответ с прочитанным телом
+func (c *apiClient) do(method, path, body string, headers ...string) (*http.Response, string) {
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+path, reader)
+	require.NoError(c.t, err)
+	if c.login != "" {
+		req.SetBasicAuth(c.login, c.password)
+	}
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(c.t, err)
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(c.t, err)
+
+	return resp, string(data)
+}
+
+func TestServer_PassportLifecycle(t *testing.T) {
+	api, _ := newTestServer(t)
+
+	// Создание
+	resp, body := api.do(http.MethodPost, "/api/v1/passports", createJSON)
+	require.Equal(t, http.StatusCreated, resp.StatusCode, body)
+
+	var created entity.TechnicalPassport
+	require.NoError(t, json.Unmarshal([]byte(body), &created))
+	assert.Equal(t, "/api/v1/passports/"+created.ID, resp.Header.Get("Location"))
+	assert.Equal(t, entity.PassportStatusDraft, created.Status)
+	etag := resp.Header.Get("ETag")
+	require.NotEmpty(t, etag)
+
+	path := "/api/v1/passports/" + created.ID
+
+	// Условный GET
+	resp, _ = api.do(http.MethodGet, path, "", "If-None-Match", etag)
+	assert.Equal(t, http.StatusNotModified, resp.StatusCode)
+
+	// Вложенные коллекции
+	resp, body = api.do(http.MethodPost, path+"/buildings", buildingJSON, "If-Match", etag)
+	require.Equal(t, http.StatusCreated, resp.StatusCode, body)
+	newETag := resp.Header.Get("ETag")
+	assert.NotEqual(t, etag, newETag)
+
+	resp, body = api.do(http.MethodPost, path+"/owners", ownerJSON)
+	require.Equal(t, http.StatusCreated, resp.StatusCode, body)
+
+	resp, body = api.do(http.MethodGet, path+"/buildings", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var buildings []entity.Building
+	require.NoError(t, json.Unmarshal([]byte(body), &buildings))
+	require.Len(t, buildings, 1)
+	assert.Equal(t, "А", buildings[0].Litera)
+
+	// Устаревший ETag отклоняется
+	resp, body = api.do(http.MethodDelete, path+"/buildings/0", "", "If-Match", etag)
+	assert.Equal(t, http.StatusPreconditionFailed, resp.StatusCode, body)
+
+	// Валидация
+	resp, body = api.do(http.MethodGet, path+"/validation?complete=true", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, body, `"valid":true`)
+
+	// Экспорт
+	resp, body = api.do(http.MethodGet, path+"/export?format=docx", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode, body)
+	assert.Contains(t, resp.Header.Get("Content-Disposition"), created.ID+".docx")
+	assert.True(t, strings.HasPrefix(body, "PK"))
+
+	// Список с пагинацией
+	resp, body = api.do(http.MethodGet, "/api/v1/passports?limit=10", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, body, `"total":1`)
+
+	// Удаление
+	resp, _ = api.do(http.MethodDelete, path, "")
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp, body = api.do(http.MethodGet, path, "")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode, body)
+}
+
+func TestServer_ListFilters(t *testing.T) {
+	api, _ := newTestServer(t)
+
+	for _, house := range []string{"1", "2", "3"} {
+		resp, body := api.do(http.MethodPost, "/api/v1/passports", strings.Replace(createJSON, `"house": "1"`, `"house": "`+house+`"`, 1))
+		require.Equal(t, http.StatusCreated, resp.StatusCode, body)
+	}
+	apartment := strings.Replace(createJSON, "residential_house", "apartment", 1)
+	resp, body := api.do(http.MethodPost, "/api/v1/passports", apartment)
+	require.Equal(t, http.StatusCreated, resp.StatusCode, body)
+
+	var page struct {
+		Items []struct {
+			ID string `json:"id"`
+		} `json:"items"`
+		Total      int    `json:"total"`
+		NextCursor string `json:"next_cursor"`
+	}
+
+	resp, body = api.do(http.MethodGet, "/api/v1/passports?object_type=residential_house&sort=address&desc=true&limit=2", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode, body)
+	require.NoError(t, json.Unmarshal([]byte(body), &page))
+	assert.Equal(t, 3, page.Total)
+	require.Len(t, page.Items, 2)
+	require.NotEmpty(t, page.NextCursor)
+	seen := []string{page.Items[0].ID, page.Items[1].ID}
+
+	resp, body = api.do(http.MethodGet, "/api/v1/passports?object_type=residential_house&sort=address&desc=true&limit=2&cursor="+page.NextCursor, "")
+	require.Equal(t, http.StatusOK, resp.StatusCode, body)
+	page.NextCursor = ""
+	require.NoError(t, json.Unmarshal([]byte(body), &page))
+	require.Len(t, page.Items, 1)
+	assert.NotContains(t, seen, page.Items[0].ID)
+	assert.Empty(t, page.NextCursor)
+
+	today := time.Now().Format("2006-01-02")
+	resp, body = api.do(http.MethodGet, "/api/v1/passports?created_from="+today+"&created_to="+today+"&owner_tin=7701234567", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode, body)
+	assert.Contains(t, body, `"total":0`)
+
+	resp, body = api.do(http.MethodGet, "/api/v1/passports?created_from=yesterday", "")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode, body)
+
+	resp, body = api.do(http.MethodGet, "/api/v1/passports?cursor=broken", "")
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode, body)
+}
+
+func TestServer_DuplicatesAndMerge(t *testing.T) {
+	api, reviewer := newTestServer(t)
+
+	resp, body := api.do(http.MethodPost, "/api/v1/passports", createJSON)
+	require.Equal(t, http.StatusCreated, resp.StatusCode, body)
+	assert.Empty(t, resp.Header.Get("X-Possible-Duplicates"))
+	var first entity.TechnicalPassport
+	require.NoError(t, json.Unmarshal([]byte(body), &first))
+
+	same := strings.Replace(createJSON, `"house": "1"`, `"house": "д. 1"`, 1)
+	resp, body = api.do(http.MethodPost, "/api/v1/passports", same)
+	require.Equal(t, http.StatusCreated, resp.StatusCode, body)
+	assert.Equal(t, first.ID, resp.Header.Get("X-Possible-Duplicates"))
+	var second entity.TechnicalPassport
+	require.NoError(t, json.Unmarshal([]byte(body), &second))
+
+	resp, body = api.do(http.MethodGet, "/api/v1/passports/"+first.ID+"/duplicates", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode, body)
+	assert.Contains(t, body, `"id":"`+second.ID+`"`)
+
+	resp, body = api.do(http.MethodGet, "/api/v1/passports/"+first.ID+"/duplicates?threshold=2", "")
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode, body)
+
+	merge := `{"source_id": "` + second.ID + `"}`
+	resp, body = reviewer.do(http.MethodPost, "/api/v1/passports/"+first.ID+"/merge", merge)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode, body)
+
+	resp, body = api.do(http.MethodPost, "/api/v1/passports/"+first.ID+"/merge", merge)
+	require.Equal(t, http.StatusOK, resp.StatusCode, body)
+
+	resp, body = api.do(http.MethodGet, "/api/v1/passports/"+second.ID, "")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode, body)
+}
+
+func TestServer_Errors(t *testing.T) {
+	api, reviewer := newTestServer(t)
+	anonymous := &apiClient{t: t, baseURL: api.baseURL}
+
+	tests := []struct {
+		name   string
+		client *apiClient
+		method string
+		path   string
+		body   string
+		status int
+	}{
+		{"без аутентификации", anonymous, http.MethodGet, "/api/v1/passports", "", http.StatusUnauthorized},
+		{"неверный пароль", &apiClient{t: t, baseURL: api.baseURL, login: "admin", password: "wrong"}, http.MethodGet, "/api/v1/passports", "", http.StatusUnauthorized},
+		{"нет прав на создание", reviewer, http.MethodPost, "/api/v1/passports", createJSON, http.StatusForbidden},
+		{"паспорт не найден", api, http.MethodGet, "/api/v1/passports/TP-404", "", http.StatusNotFound},
+		{"неизвестное поле", api, http.MethodPost, "/api/v1/passports", `{"unknown": 1}`, http.StatusBadRequest},
+		{"ошибка валидации", api, http.MethodPost, "/api/v1/passports", `{"object_type": "residential_house"}`, http.StatusUnprocessableEntity},
+		{"неверный limit", api, http.MethodGet, "/api/v1/passports?limit=0", "", http.StatusBadRequest},
+		{"неизвестный маршрут", api, http.MethodGet, "/api/v1/unknown", "", http.StatusNotFound},
+		{"метод не поддерживается", api, http.MethodPatch, "/api/v1/passports", "", http.StatusMethodNotAllowed},
+		{"health без аутентификации", anonymous, http.MethodGet, "/healthz", "", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, body := tt.client.do(tt.method, tt.path, tt.body)
+			assert.Equal(t, tt.status, resp.StatusCode, body)
+		})
+	}
+}
+
+func TestServer_OpenAPI(t *testing.T) {
+	api, _ := newTestServer(t)
+	anonymous := &apiClient{t: t, baseURL: api.baseURL}
+
+	resp, body := anonymous.do(http.MethodGet, "/api/v1/openapi.json", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var spec struct {
+		OpenAPI    string                            `json:"openapi"`
+		Paths      map[string]map[string]interface{} `json:"paths"`
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]map[string]interface{} `json:"properties"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(body), &spec))
+
+	assert.Equal(t, "3.0.3", spec.OpenAPI)
+	assert.Contains(t, spec.Paths["/api/v1/passports/{id}"], "put")
+	assert.Contains(t, spec.Paths["/api/v1/passports/{id}/rooms/{index}"], "delete")
+	assert.Contains(t, spec.Components.Schemas, "TechnicalPassport")
+	assert.Contains(t, spec.Components.Schemas["TechnicalPassport"].Properties, "buildings")
+	assert.Equal(t, "date-time", spec.Components.Schemas["TechnicalPassport"].Properties["created_date"]["format"])
+}
+
+func TestServer_Interchange(t *testing.T) {
+	api, reviewer := newTestServer(t)
+	anonymous := &apiClient{t: t, baseURL: api.baseURL}
+
+	resp, body := anonymous.do(http.MethodGet, "/api/v1/interchange/schema", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, body, `"$schema"`)
+
+	resp, body = api.do(http.MethodPost, "/api/v1/passports", createJSON)
+	require.Equal(t, http.StatusCreated, resp.StatusCode, body)
+
+	var created entity.TechnicalPassport
+	require.NoError(t, json.Unmarshal([]byte(body), &created))
+
+	resp, document := api.do(http.MethodPost, "/api/v1/interchange/export", `{"all": true}`)
+	require.Equal(t, http.StatusOK, resp.StatusCode, document)
+	assert.Contains(t, resp.Header.Get("Content-Disposition"), created.ID+".techpassport.json")
+
+	// Повторная загрузка в ту же базу: по умолчанию совпадающий паспорт пропускается
+	resp, body = api.do(http.MethodPost, "/api/v1/interchange/import", document)
+	require.Equal(t, http.StatusOK, resp.StatusCode, body)
+	assert.Contains(t, body, `"action":"skipped"`)
+
+	resp, body = api.do(http.MethodPost, "/api/v1/interchange/import?conflict=new", document)
+	require.Equal(t, http.StatusOK, resp.StatusCode, body)
+	assert.Contains(t, body, `"action":"renamed"`)
+
+	resp, _ = api.do(http.MethodPost, "/api/v1/interchange/import?conflict=merge", document)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, _ = api.do(http.MethodPost, "/api/v1/interchange/import", `{"format": "other"}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	resp, _ = reviewer.do(http.MethodPost, "/api/v1/interchange/import", document)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
@@ -0,0 +1,380 @@
+package entity
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// ObjectTypeApartmentBuilding многоквартирный жилой дом: кроме состава объекта
+// паспорт описывает подъезды, квартиры и помещения общего имущества
+const ObjectTypeApartmentBuilding ObjectType = "apartment_building"
+
+// Entrance представляет подъезд (секцию) многоквартирного дома
+type Entrance struct {
+	// Номер подъезда
+	Number string `json:"number"`
+
+	// Секция дома, если подъезды сгруппированы по секциям
+	Section string `json:"section,omitempty"`
+
+	// Литера здания, в котором расположен подъезд
+	Litera string `json:"litera"`
+
+	// Количество этажей в подъезде
+	Floors int `json:"floors"`
+
+	// Примечание
+	Note string `json:"note,omitempty"`
+}
+
+// IsValid проверяет корректность данных подъезда
+func (e *Entrance) IsValid() error {
+	if e.Number == "" {
+		return ValidationError{Field: "number", Message: "номер подъезда обязателен"}
+	}
+
+	if e.Litera == "" {
+		return ValidationError{Field: "litera", Message: "литера обязательна"}
+	}
+
+	if e.Floors < 0 {
+		return ValidationError{Field: "floors", Message: "количество этажей не может быть отрицательным"}
+	}
+
+	return nil
+}
+
+// Flat представляет квартиру многоквартирного дома с собственной экспликацией
+type Flat struct {
+	// Номер квартиры
+	Number string `json:"number"`
+
+	// Литера здания
+	Litera string `json:"litera"`
+
+	// Номер подъезда
+	Entrance string `json:"entrance,omitempty"`
+
+	// Этаж расположения
+	Floor string `json:"floor"`
+
+	// Кадастровый номер квартиры
+	CadastralNumber string `json:"cadastral_number,omitempty"`
+
+	// Экспликация квартиры; литера и этаж помещений по умолчанию берутся из квартиры
+	Explication []Room `json:"explication"`
+}
+
+// IsValid проверяет корректность данных квартиры и ее экспликации
+func (f *Flat) IsValid() error {
+	if f.Number == "" {
+		return ValidationError{Field: "number", Message: "номер квартиры обязателен"}
+	}
+
+	if f.Litera == "" {
+		return ValidationError{Field: "litera", Message: "литера обязательна"}
+	}
+
+	if f.Floor == "" {
+		return ValidationError{Field: "floor", Message: "этаж обязателен"}
+	}
+
+	for i, room := range f.Explication {
+		field := "explication[" + strconv.Itoa(i) + "]"
+		if err := room.IsValid(); err != nil {
+			return ValidationError{Field: field, Message: err.Error()}
+		}
+		for _, other := range f.Explication[:i] {
+			if sameLabel(other.RoomNumber, room.RoomNumber) {
+				return ValidationError{Field: field, Message: "помещение " + room.RoomNumber + " указано дважды"}
+			}
+		}
+	}
+
+	return nil
+}
+
+// fillRooms заполняет литеру и этаж помещений экспликации по квартире
+func (f *Flat) fillRooms() {
+	for i := range f.Explication {
+		if f.Explication[i].Litera == "" {
+			f.Explication[i].Litera = f.Litera
+		}
+		if f.Explication[i].Floor == "" {
+			f.Explication[i].Floor = f.Floor
+		}
+	}
+}
+
+// TotalArea общая площадь квартиры по экспликации
+func (f *Flat) TotalArea() float64 {
+	total := 0.0
+	for _, room := range f.Explication {
+		total += room.Area
+	}
+	return roundArea(total)
+}
+
+// LivingArea жилая площадь квартиры по экспликации
+func (f *Flat) LivingArea() float64 {
+	total := 0.0
+	for _, room := range f.Explication {
+		total += room.LivingArea
+	}
+	return roundArea(total)
+}
+
+// LivingRooms количество жилых комнат квартиры
+func (f *Flat) LivingRooms() int {
+	count := 0
+	for _, room := range f.Explication {
+		if room.LivingArea > 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// EntranceTotals итоги по подъезду
+type EntranceTotals struct {
+	Entrance   string  `json:"entrance"`
+	Flats      int     `json:"flats"`
+	FlatsArea  float64 `json:"flats_area"`
+	LivingArea float64 `json:"living_area"`
+}
+
+// ApartmentTotals сводные показатели многоквартирного дома
+type ApartmentTotals struct {
+	// Flats количество квартир
+	Flats int `json:"flats"`
+
+	// LivingRooms количество жилых комнат во всех квартирах
+	LivingRooms int `json:"living_rooms"`
+
+	// FlatsArea общая площадь квартир
+	FlatsArea float64 `json:"flats_area"`
+
+	// LivingArea жилая площадь квартир
+	LivingArea float64 `json:"living_area"`
+
+	// CommonArea площадь помещений общего имущества
+	CommonArea float64 `json:"common_area"`
+
+	// TotalArea общая площадь дома: квартиры и общее имущество
+	TotalArea float64 `json:"total_area"`
+
+	// Entrances итоги по подъездам; квартиры без подъезда в разбивку не входят
+	Entrances []EntranceTotals `json:"entrances,omitempty"`
+}
+
+// ApartmentTotals рассчитывает сводные показатели квартир и общего имущества
+func (tp *TechnicalPassport) ApartmentTotals() ApartmentTotals {
+	var totals ApartmentTotals
+	for _, e := range tp.Entrances {
+		totals.Entrances = append(totals.Entrances, EntranceTotals{Entrance: e.Number})
+	}
+
+	for i := range tp.Flats {
+		f := &tp.Flats[i]
+		totals.Flats++
+		totals.LivingRooms += f.LivingRooms()
+		totals.FlatsArea += f.TotalArea()
+		totals.LivingArea += f.LivingArea()
+		for j := range totals.Entrances {
+			e := &totals.Entrances[j]
+			if f.Entrance != "" && sameLabel(e.Entrance, f.Entrance) {
+				e.Flats++
+				e.FlatsArea = roundArea(e.FlatsArea + f.TotalArea())
+				e.LivingArea = roundArea(e.LivingArea + f.LivingArea())
+			}
+		}
+	}
+	for _, room := range tp.CommonAreas {
+		totals.CommonArea += room.Area
+	}
+
+	totals.FlatsArea = roundArea(totals.FlatsArea)
+	totals.LivingArea = roundArea(totals.LivingArea)
+	totals.CommonArea = roundArea(totals.CommonArea)
+	totals.TotalArea = roundArea(totals.FlatsArea + totals.CommonArea)
+
+	return totals
+}
+
+// FindFlat возвращает квартиру по номеру или nil
+func (tp *TechnicalPassport) FindFlat(number string) *Flat {
+	for i := range tp.Flats {
+		if sameLabel(tp.Flats[i].Number, number) {
+			return &tp.Flats[i]
+		}
+	}
+	return nil
+}
+
+// FindEntrance возвращает подъезд по номеру или nil
+func (tp *TechnicalPassport) FindEntrance(number string) *Entrance {
+	for i := range tp.Entrances {
+		if sameLabel(tp.Entrances[i].Number, number) {
+			return &tp.Entrances[i]
+		}
+	}
+	return nil
+}
+
+// SetEntrances заменяет список подъездов; подъезды, в которых есть квартиры,
+// удалить нельзя
+func (tp *TechnicalPassport) SetEntrances(entrances []Entrance) error {
+	if err := tp.checkApartmentBuilding("entrances"); err != nil {
+		return err
+	}
+
+	previous := tp.Entrances
+	tp.Entrances = entrances
+	if err := tp.validateApartments(); err != nil {
+		tp.Entrances = previous
+		return err
+	}
+
+	tp.UpdatedDate = time.Now()
+	tp.AddAuditEntry("set_entrances", "Изменен список подъездов: "+strconv.Itoa(len(entrances)))
+
+	return nil
+}
+
+// SetFlat добавляет квартиру или заменяет квартиру с тем же номером
+func (tp *TechnicalPassport) SetFlat(flat Flat) (replaced bool, err error) {
+	if err := tp.checkApartmentBuilding("flats"); err != nil {
+		return false, err
+	}
+
+	flat.fillRooms()
+	if err := flat.IsValid(); err != nil {
+		return false, err
+	}
+	if flat.Entrance != "" && tp.FindEntrance(flat.Entrance) == nil {
+		return false, ValidationError{Field: "entrance", Message: "подъезд " + flat.Entrance + " не найден"}
+	}
+
+	if existing := tp.FindFlat(flat.Number); existing != nil {
+		*existing = flat
+		tp.AddAuditEntry("update_flat", "Изменена квартира № "+flat.Number)
+		replaced = true
+	} else {
+		tp.Flats = append(tp.Flats, flat)
+		tp.AddAuditEntry("add_flat", "Добавлена квартира № "+flat.Number)
+	}
+	SortFlats(tp.Flats)
+	tp.UpdatedDate = time.Now()
+
+	return replaced, nil
+}
+
+// RemoveFlat удаляет квартиру по номеру
+func (tp *TechnicalPassport) RemoveFlat(number string) error {
+	for i := range tp.Flats {
+		if sameLabel(tp.Flats[i].Number, number) {
+			tp.Flats = append(tp.Flats[:i], tp.Flats[i+1:]...)
+			tp.UpdatedDate = time.Now()
+			tp.AddAuditEntry("remove_flat", "Удалена квартира № "+number)
+			return nil
+		}
+	}
+
+	return ValidationError{Field: "flats", Message: "квартира " + number + " не найдена"}
+}
+
+// SetCommonAreas заменяет экспликацию помещений общего имущества
+func (tp *TechnicalPassport) SetCommonAreas(rooms []Room) error {
+	if err := tp.checkApartmentBuilding("common_areas"); err != nil {
+		return err
+	}
+
+	for i, room := range rooms {
+		if err := room.IsValid(); err != nil {
+			return ValidationError{Field: "common_areas[" + strconv.Itoa(i) + "]", Message: err.Error()}
+		}
+	}
+
+	tp.CommonAreas = rooms
+	tp.UpdatedDate = time.Now()
+	tp.AddAuditEntry("set_common_areas", "Изменены помещения общего имущества: "+strconv.Itoa(len(rooms)))
+
+	return nil
+}
+
+// SortFlats упорядочивает квартиры по номеру: сначала числовые номера по возрастанию
+func SortFlats(flats []Flat) {
+	sort.SliceStable(flats, func(i, j int) bool {
+		a, errA := strconv.Atoi(flats[i].Number)
+		b, errB := strconv.Atoi(flats[j].Number)
+		switch {
+		case errA == nil && errB == nil:
+			return a < b
+		case errA == nil:
+			return true
+		case errB == nil:
+			return false
+		}
+		return flats[i].Number < flats[j].Number
+	})
+}
+
+// checkApartmentBuilding проверяет, что паспорт составляется на многоквартирный дом
+func (tp *TechnicalPassport) checkApartmentBuilding(field string) error {
+	if tp.ObjectType != ObjectTypeApartmentBuilding {
+		return ValidationError{Field: field, Message: "подъезды, квартиры и общее имущество описываются только в паспорте многоквартирного дома"}
+	}
+	return nil
+}
+
+// validateApartments проверяет подъезды, квартиры и помещения общего имущества
+func (tp *TechnicalPassport) validateApartments() error {
+	if len(tp.Entrances) == 0 && len(tp.Flats) == 0 && len(tp.CommonAreas) == 0 {
+		return nil
+	}
+	if err := tp.checkApartmentBuilding("flats"); err != nil {
+		return err
+	}
+
+	for i, e := range tp.Entrances {
+		field := "entrances[" + strconv.Itoa(i) + "]"
+		if err := e.IsValid(); err != nil {
+			return ValidationError{Field: field, Message: err.Error()}
+		}
+		for _, other := range tp.Entrances[:i] {
+			if sameLabel(other.Number, e.Number) {
+				return ValidationError{Field: field, Message: "подъезд " + e.Number + " указан дважды"}
+			}
+		}
+	}
+
+	for i, f := range tp.Flats {
+		field := "flats[" + strconv.Itoa(i) + "]"
+		if err := f.IsValid(); err != nil {
+			return ValidationError{Field: field, Message: err.Error()}
+		}
+		for _, other := range tp.Flats[:i] {
+			if sameLabel(other.Number, f.Number) {
+				return ValidationError{Field: field, Message: "квартира " + f.Number + " указана дважды"}
+			}
+		}
+		if f.Entrance != "" && tp.FindEntrance(f.Entrance) == nil {
+			return ValidationError{Field: field, Message: "подъезд " + f.Entrance + " квартиры " + f.Number + " не найден"}
+		}
+	}
+
+	for i, room := range tp.CommonAreas {
+		if err := room.IsValid(); err != nil {
+			return ValidationError{Field: "common_areas[" + strconv.Itoa(i) + "]", Message: err.Error()}
+		}
+	}
+
+	return nil
+}
+
+// roundArea округляет площадь до сотых квадратного метра
+func roundArea(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,298 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+)
+
+// Attachment вложение паспорта: скан правоустанавливающего документа, фотография,
+// отсканированный план. Содержимое хранится отдельно от паспорта
+// (repository.AttachmentRepository) и адресуется SHA-256, поэтому одинаковые
+// файлы хранятся один раз.
+type Attachment struct {
+	// ID SHA-256 содержимого в шестнадцатеричной записи
+	ID string `json:"id"`
+
+	// Имя исходного файла
+	FileName string `json:"file_name"`
+
+	// Тип содержимого (image/jpeg, application/pdf, ...)
+	MIMEType string `json:"mime_type"`
+
+	// Размер (байт)
+	Size int64 `json:"size"`
+
+	Description string `json:"description,omitempty"`
+
+	// Для изображения создана миниатюра
+	Thumbnail bool `json:"thumbnail,omitempty"`
+
+	AddedDate time.Time `json:"added_date"`
+}
+
+// AttachmentID вычисляет идентификатор вложения по содержимому
+func AttachmentID(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// IsAttachmentID проверяет запись идентификатора: 64 шестнадцатеричные цифры
+// в нижнем регистре
+func IsAttachmentID(id string) bool {
+	if len(id) != sha256.Size*2 {
+		return false
+	}
+	for _, c := range id {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// IsValid проверяет корректность описания вложения
+func (a *Attachment) IsValid() error {
+	if !IsAttachmentID(a.ID) {
+		return ValidationError{Field: "id", Message: "идентификатор вложения должен быть SHA-256 содержимого"}
+	}
+
+	if a.FileName == "" {
+		return ValidationError{Field: "file_name", Message: "имя файла обязательно"}
+	}
+
+	if a.Size < 0 {
+		return ValidationError{Field: "size", Message: "размер не может быть отрицательным"}
+	}
+
+	return nil
+}
+
+// AttachmentTargetKind раздел паспорта, к которому относится вложение
+type AttachmentTargetKind string
+
+const (
+	AttachmentTargetPassport      AttachmentTargetKind = "passport"       // Паспорт в целом
+	AttachmentTargetOwner         AttachmentTargetKind = "owner"          // Правообладатель
+	AttachmentTargetBuilding      AttachmentTargetKind = "building"       // Здание по литере
+	AttachmentTargetFloorPlan     AttachmentTargetKind = "floor_plan"     // Поэтажный план литеры и этажа
+	AttachmentTargetSituationPlan AttachmentTargetKind = "situation_plan" // Ситуационный план
+)
+
+// AttachmentTarget ссылка на раздел паспорта
+type AttachmentTarget struct {
+	Kind AttachmentTargetKind `json:"kind"`
+
+	// OwnerIndex индекс правообладателя (для AttachmentTargetOwner)
+	OwnerIndex int `json:"owner_index,omitempty"`
+
+	// Litera и Floor здание или поэтажный план
+	Litera string `json:"litera,omitempty"`
+	Floor  string `json:"floor,omitempty"`
+}
+
+// String описание раздела для сообщений и журнала
+func (t AttachmentTarget) String() string {
+	switch t.Kind {
+	case AttachmentTargetOwner:
+		return "правообладатель " + strconv.Itoa(t.OwnerIndex+1)
+	case AttachmentTargetBuilding:
+		return "литера " + t.Litera
+	case AttachmentTargetFloorPlan:
+		return "поэтажный план литеры " + t.Litera + ", этаж " + t.Floor
+	case AttachmentTargetSituationPlan:
+		return "ситуационный план"
+	default:
+		return "паспорт"
+	}
+}
+
+// FindAttachment возвращает описание вложения по ID или nil
+func (tp *TechnicalPassport) FindAttachment(id string) *Attachment {
+	for i := range tp.Attachments {
+		if tp.Attachments[i].ID == id {
+			return &tp.Attachments[i]
+		}
+	}
+	return nil
+}
+
+// AttachFile добавляет вложение в паспорт и связывает его с разделом. Повторное
+// добавление того же содержимого только добавляет связь; added=false, если
+// вложение уже было в паспорте.
+func (tp *TechnicalPassport) AttachFile(attachment Attachment, target AttachmentTarget) (added bool, err error) {
+	if err := attachment.IsValid(); err != nil {
+		return false, err
+	}
+
+	links, err := tp.attachmentLinks(target)
+	if err != nil {
+		return false, err
+	}
+
+	if tp.FindAttachment(attachment.ID) == nil {
+		tp.Attachments = append(tp.Attachments, attachment)
+		added = true
+	}
+	if links != nil && !containsString(*links, attachment.ID) {
+		*links = append(*links, attachment.ID)
+	}
+
+	tp.UpdatedDate = time.Now()
+	tp.AddAuditEntry("attach_file", "Добавлено вложение "+attachment.FileName+" ("+target.String()+")")
+
+	return added, nil
+}
+
+// RemoveAttachment удаляет вложение из паспорта вместе со всеми связями
+func (tp *TechnicalPassport) RemoveAttachment(id string) error {
+	index := -1
+	for i := range tp.Attachments {
+		if tp.Attachments[i].ID == id {
+			index = i
+		}
+	}
+	if index < 0 {
+		return ValidationError{Field: "attachment_id", Message: "вложение не найдено"}
+	}
+
+	name := tp.Attachments[index].FileName
+	tp.Attachments = append(tp.Attachments[:index], tp.Attachments[index+1:]...)
+	for _, links := range tp.allAttachmentLinks() {
+		*links = removeString(*links, id)
+	}
+
+	tp.UpdatedDate = time.Now()
+	tp.AddAuditEntry("remove_attachment", "Удалено вложение "+name)
+
+	return nil
+}
+
+// AttachmentTargets возвращает разделы, с которыми связано вложение;
+// вложение без связей относится к паспорту в целом
+func (tp *TechnicalPassport) AttachmentTargets(id string) []AttachmentTarget {
+	var targets []AttachmentTarget
+	for i, o := range tp.Owners {
+		if containsString(o.Attachments, id) {
+			targets = append(targets, AttachmentTarget{Kind: AttachmentTargetOwner, OwnerIndex: i})
+		}
+	}
+	for _, b := range tp.Buildings {
+		if containsString(b.Attachments, id) {
+			targets = append(targets, AttachmentTarget{Kind: AttachmentTargetBuilding, Litera: b.Litera})
+		}
+	}
+	for _, p := range tp.FloorPlans {
+		if containsString(p.Attachments, id) {
+			targets = append(targets, AttachmentTarget{Kind: AttachmentTargetFloorPlan, Litera: p.Litera, Floor: p.Floor})
+		}
+	}
+	if tp.SituationPlan != nil && containsString(tp.SituationPlan.Attachments, id) {
+		targets = append(targets, AttachmentTarget{Kind: AttachmentTargetSituationPlan})
+	}
+	if len(targets) == 0 {
+		targets = append(targets, AttachmentTarget{Kind: AttachmentTargetPassport})
+	}
+	return targets
+}
+
+// CheckAttachmentTarget проверяет, что раздел для вложения есть в паспорте
+func (tp *TechnicalPassport) CheckAttachmentTarget(target AttachmentTarget) error {
+	_, err := tp.attachmentLinks(target)
+	return err
+}
+
+// attachmentLinks возвращает список связей раздела; nil для паспорта в целом
+func (tp *TechnicalPassport) attachmentLinks(target AttachmentTarget) (*[]string, error) {
+	switch target.Kind {
+	case AttachmentTargetPassport, "":
+		return nil, nil
+	case AttachmentTargetOwner:
+		if target.OwnerIndex < 0 || target.OwnerIndex >= len(tp.Owners) {
+			return nil, ValidationError{Field: "owner_index", Message: "правообладатель не найден"}
+		}
+		return &tp.Owners[target.OwnerIndex].Attachments, nil
+	case AttachmentTargetBuilding:
+		if b := tp.FindBuilding(target.Litera); b != nil {
+			return &b.Attachments, nil
+		}
+		return nil, ValidationError{Field: "litera", Message: "литеры " + target.Litera + " нет в составе объекта"}
+	case AttachmentTargetFloorPlan:
+		if p := tp.FindFloorPlan(target.Litera, target.Floor); p != nil {
+			return &p.Attachments, nil
+		}
+		return nil, ValidationError{Field: "floor", Message: "поэтажный план не найден"}
+	case AttachmentTargetSituationPlan:
+		if tp.SituationPlan != nil {
+			return &tp.SituationPlan.Attachments, nil
+		}
+		return nil, ValidationError{Field: "situation_plan", Message: "ситуационный план не задан"}
+	default:
+		return nil, ValidationError{Field: "target", Message: "неизвестный раздел паспорта"}
+	}
+}
+
+// allAttachmentLinks возвращает списки связей всех разделов
+func (tp *TechnicalPassport) allAttachmentLinks() []*[]string {
+	var all []*[]string
+	for i := range tp.Owners {
+		all = append(all, &tp.Owners[i].Attachments)
+	}
+	for i := range tp.Buildings {
+		all = append(all, &tp.Buildings[i].Attachments)
+	}
+	for i := range tp.FloorPlans {
+		all = append(all, &tp.FloorPlans[i].Attachments)
+	}
+	if tp.SituationPlan != nil {
+		all = append(all, &tp.SituationPlan.Attachments)
+	}
+	return all
+}
+
+// validateAttachments проверяет описания вложений и то, что связи разделов
+// указывают на вложения паспорта
+func (tp *TechnicalPassport) validateAttachments() error {
+	for i, a := range tp.Attachments {
+		if err := a.IsValid(); err != nil {
+			return ValidationError{Field: "attachments[" + strconv.Itoa(i) + "]", Message: err.Error()}
+		}
+		for _, other := range tp.Attachments[:i] {
+			if other.ID == a.ID {
+				return ValidationError{Field: "attachments[" + strconv.Itoa(i) + "]", Message: "вложение " + a.FileName + " указано дважды"}
+			}
+		}
+	}
+
+	for _, links := range tp.allAttachmentLinks() {
+		for _, id := range *links {
+			if tp.FindAttachment(id) == nil {
+				return ValidationError{Field: "attachments", Message: "ссылка на отсутствующее вложение " + id}
+			}
+		}
+	}
+
+	return nil
+}
+
+// containsString проверяет наличие строки в списке
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// removeString удаляет строку из списка
+func removeString(list []string, s string) []string {
+	var result []string
+	for _, v := range list {
+		if v != s {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -28,6 +28,18 @@ type Building struct {
 
 	// Инвентаризационная стоимость (руб)
 	InventoryValue float64 `json:"inventory_value"`
+
+	// Valuation последний расчет инвентаризационной стоимости с исходными данными
+	Valuation *BuildingValuation `json:"valuation,omitempty"`
+
+	// Описание конструктивных элементов и их износ по результатам осмотра
+	StructuralElements []StructuralElement `json:"structural_elements,omitempty"`
+
+	// WearAssessment физический износ здания, взвешенный по удельным весам элементов
+	WearAssessment *WearAssessment `json:"wear_assessment,omitempty"`
+
+	// Фотографии и документы здания (ID вложений паспорта)
+	Attachments []string `json:"attachments,omitempty"`
 }
 
 // IsValid проверяет корректность данных здания
@@ -56,5 +68,15 @@ func (b *Building) IsValid() error {
 		return ValidationError{Field: "volume", Message: "объем не может быть отрицательным"}
 	}
 
+	if err := ValidateStructuralElements(b.StructuralElements); err != nil {
+		return err
+	}
+
+	if b.Valuation != nil {
+		if err := b.Valuation.IsValid(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,257 @@
+package entity
+
+import (
+	"strconv"
+	"time"
+)
+
+// UseCategory категория использования нежилого помещения
+type UseCategory string
+
+const (
+	UseCategoryOffice     UseCategory = "office"     // Офисное
+	UseCategoryRetail     UseCategory = "retail"     // Торговое
+	UseCategoryStorage    UseCategory = "storage"    // Складское
+	UseCategoryCatering   UseCategory = "catering"   // Общественного питания
+	UseCategoryProduction UseCategory = "production" // Производственное
+	UseCategoryOther      UseCategory = "other"      // Иное
+)
+
+// UseCategories категории использования в порядке вывода в паспорте
+var UseCategories = []UseCategory{
+	UseCategoryOffice,
+	UseCategoryRetail,
+	UseCategoryStorage,
+	UseCategoryCatering,
+	UseCategoryProduction,
+	UseCategoryOther,
+}
+
+// useCategoryTitles наименования категорий использования
+var useCategoryTitles = map[UseCategory]string{
+	UseCategoryOffice:     "Офисное",
+	UseCategoryRetail:     "Торговое",
+	UseCategoryStorage:    "Складское",
+	UseCategoryCatering:   "Общественного питания",
+	UseCategoryProduction: "Производственное",
+	UseCategoryOther:      "Иное",
+}
+
+// IsValid проверяет что категория известна системе
+func (c UseCategory) IsValid() bool {
+	_, ok := useCategoryTitles[c]
+	return ok
+}
+
+// Title возвращает наименование категории для документа
+func (c UseCategory) Title() string {
+	if title, ok := useCategoryTitles[c]; ok {
+		return title
+	}
+	return "Без категории"
+}
+
+// FireHazardClasses классы функциональной пожарной опасности (ст. 32 Федерального закона № 123-ФЗ)
+var FireHazardClasses = []string{
+	"Ф1.1", "Ф1.2", "Ф1.3", "Ф1.4",
+	"Ф2.1", "Ф2.2", "Ф2.3", "Ф2.4",
+	"Ф3.1", "Ф3.2", "Ф3.3", "Ф3.4", "Ф3.5", "Ф3.6",
+	"Ф4.1", "Ф4.2", "Ф4.3", "Ф4.4",
+	"Ф5.1", "Ф5.2", "Ф5.3",
+}
+
+// ExplosionCategories категории помещений по взрывопожарной и пожарной опасности
+var ExplosionCategories = []string{"А", "Б", "В1", "В2", "В3", "В4", "Г", "Д"}
+
+// FireResistanceDegrees степени огнестойкости зданий
+var FireResistanceDegrees = []string{"I", "II", "III", "IV", "V"}
+
+// CommercialInfo сведения о нежилом объекте: пожарная безопасность и эвакуация
+type CommercialInfo struct {
+	// Класс функциональной пожарной опасности (Ф1.1-Ф5.3)
+	FireHazardClass string `json:"fire_hazard_class"`
+
+	// Категория по взрывопожарной и пожарной опасности (А, Б, В1-В4, Г, Д);
+	// обязательна для складских и производственных помещений
+	ExplosionCategory string `json:"explosion_category,omitempty"`
+
+	// Степень огнестойкости здания (I-V)
+	FireResistance string `json:"fire_resistance"`
+
+	// Количество эвакуационных выходов
+	EvacuationExits int `json:"evacuation_exits"`
+
+	// Отдельный вход с улицы
+	SeparateEntrance bool `json:"separate_entrance,omitempty"`
+
+	// Примечание
+	Note string `json:"note,omitempty"`
+}
+
+// IsValid проверяет корректность заполненных значений
+// Обязательность полей проверяется профилем паспорта при экспорте
+func (c *CommercialInfo) IsValid() error {
+	if c.FireHazardClass != "" && !containsString(FireHazardClasses, c.FireHazardClass) {
+		return ValidationError{Field: "fire_hazard_class", Message: "неизвестный класс функциональной пожарной опасности " + c.FireHazardClass}
+	}
+
+	if c.ExplosionCategory != "" && !containsString(ExplosionCategories, c.ExplosionCategory) {
+		return ValidationError{Field: "explosion_category", Message: "неизвестная категория по взрывопожарной опасности " + c.ExplosionCategory}
+	}
+
+	if c.FireResistance != "" && !containsString(FireResistanceDegrees, c.FireResistance) {
+		return ValidationError{Field: "fire_resistance", Message: "неизвестная степень огнестойкости " + c.FireResistance}
+	}
+
+	if c.EvacuationExits < 0 {
+		return ValidationError{Field: "evacuation_exits", Message: "количество эвакуационных выходов не может быть отрицательным"}
+	}
+
+	return nil
+}
+
+// MainArea основная площадь помещения: общая за вычетом вспомогательной
+func (r *Room) MainArea() float64 {
+	if r.AuxiliaryArea >= r.Area {
+		return 0
+	}
+	return roundArea(r.Area - r.AuxiliaryArea)
+}
+
+// UseCategoryArea площади помещений одной категории использования
+type UseCategoryArea struct {
+	// Category категория; пустая - помещения без категории
+	Category      UseCategory `json:"category"`
+	Rooms         int         `json:"rooms"`
+	MainArea      float64     `json:"main_area"`
+	AuxiliaryArea float64     `json:"auxiliary_area"`
+	TotalArea     float64     `json:"total_area"`
+}
+
+// UseCategoryTotals рассчитывает основную и вспомогательную площадь экспликации
+// по категориям использования; помещения без категории выводятся последними
+func (tp *TechnicalPassport) UseCategoryTotals() []UseCategoryArea {
+	order := append(append([]UseCategory{}, UseCategories...), "")
+	byCategory := make(map[UseCategory]*UseCategoryArea)
+
+	for i := range tp.Explication {
+		room := &tp.Explication[i]
+		category := room.UseCategory
+		if !category.IsValid() {
+			category = ""
+		}
+		totals, ok := byCategory[category]
+		if !ok {
+			totals = &UseCategoryArea{Category: category}
+			byCategory[category] = totals
+		}
+		totals.Rooms++
+		totals.MainArea = roundArea(totals.MainArea + room.MainArea())
+		totals.AuxiliaryArea = roundArea(totals.AuxiliaryArea + room.Area - room.MainArea())
+		totals.TotalArea = roundArea(totals.TotalArea + room.Area)
+	}
+
+	var result []UseCategoryArea
+	for _, category := range order {
+		if totals, ok := byCategory[category]; ok {
+			result = append(result, *totals)
+		}
+	}
+	return result
+}
+
+// SetCommercialInfo заменяет сведения о пожарной безопасности нежилого объекта
+func (tp *TechnicalPassport) SetCommercialInfo(info CommercialInfo) error {
+	if !tp.ObjectType.Profile().Commercial {
+		return ValidationError{Field: "commercial", Message: "сведения о пожарной безопасности заполняются только для нежилых объектов"}
+	}
+
+	if err := info.IsValid(); err != nil {
+		return err
+	}
+
+	tp.Commercial = &info
+	tp.UpdatedDate = time.Now()
+	tp.AddAuditEntry("set_commercial", "Изменены сведения о пожарной безопасности")
+
+	return nil
+}
+
+// validateProfile проверяет значения, которые профиль объекта не допускает
+func (tp *TechnicalPassport) validateProfile() error {
+	profile := tp.ObjectType.Profile()
+
+	if tp.Commercial != nil {
+		if !profile.Commercial {
+			return ValidationError{Field: "commercial", Message: "сведения о пожарной безопасности заполняются только для нежилых объектов"}
+		}
+		if err := tp.Commercial.IsValid(); err != nil {
+			ve, _ := err.(ValidationError)
+			return ValidationError{Field: "commercial." + ve.Field, Message: ve.Message}
+		}
+	}
+
+	if profile.LivingArea {
+		return nil
+	}
+
+	if tp.GeneralInfo.LivingArea > 0 {
+		return ValidationError{Field: "living_area", Message: "жилая площадь не указывается для нежилого объекта"}
+	}
+
+	for i, room := range tp.Explication {
+		field := "explication[" + strconv.Itoa(i) + "]"
+		if room.LivingArea > 0 {
+			return ValidationError{Field: field, Message: "помещение " + room.RoomNumber + ": жилая площадь не указывается для нежилого объекта"}
+		}
+		if room.AuxiliaryArea > room.Area {
+			return ValidationError{Field: field, Message: "помещение " + room.RoomNumber + ": вспомогательная площадь превышает общую"}
+		}
+	}
+
+	return nil
+}
+
+// ProfileErrors возвращает незаполненные сведения, обязательные по профилю объекта
+func (tp *TechnicalPassport) ProfileErrors() ValidationErrors {
+	if !tp.ObjectType.Profile().Commercial {
+		return nil
+	}
+
+	var errs ValidationErrors
+	info := tp.Commercial
+	if info == nil {
+		info = &CommercialInfo{}
+	}
+
+	if info.FireHazardClass == "" {
+		errs = append(errs, ValidationError{Field: "commercial.fire_hazard_class", Message: "не указан класс функциональной пожарной опасности"})
+	}
+
+	if info.FireResistance == "" {
+		errs = append(errs, ValidationError{Field: "commercial.fire_resistance", Message: "не указана степень огнестойкости"})
+	}
+
+	if info.EvacuationExits == 0 {
+		errs = append(errs, ValidationError{Field: "commercial.evacuation_exits", Message: "не указано количество эвакуационных выходов"})
+	}
+
+	needsExplosion := false
+	for i, room := range tp.Explication {
+		if room.UseCategory == "" {
+			errs = append(errs, ValidationError{
+				Field:   "explication[" + strconv.Itoa(i) + "].use_category",
+				Message: "помещение " + room.RoomNumber + " (литера " + room.Litera + ", этаж " + room.Floor + "): не указана категория использования",
+			})
+		}
+		if room.UseCategory == UseCategoryStorage || room.UseCategory == UseCategoryProduction {
+			needsExplosion = true
+		}
+	}
+
+	if needsExplosion && info.ExplosionCategory == "" {
+		errs = append(errs, ValidationError{Field: "commercial.explosion_category", Message: "для складских и производственных помещений нужна категория по взрывопожарной опасности"})
+	}
+
+	return errs
+}
@@ -0,0 +1,133 @@
+package entity
+
+import (
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// EventType тип доменного события паспорта
+type EventType string
+
+const (
+	EventPassportCreated EventType = "passport_created" // Паспорт создан
+	EventBuildingAdded   EventType = "building_added"   // Здание добавлено в состав объекта
+	EventBuildingRemoved EventType = "building_removed" // Здание удалено из состава объекта
+	EventOwnerChanged    EventType = "owner_changed"    // Правообладатель добавлен, изменен или удален
+	EventStatusChanged   EventType = "status_changed"   // Статус паспорта изменен
+	EventPassportIssued  EventType = "passport_issued"  // Утвержденный паспорт выдан заказчику
+	EventPassportDeleted EventType = "passport_deleted" // Паспорт удален
+	EventPassportMerged  EventType = "passport_merged"  // В паспорт перенесены сведения дубликата
+)
+
+// OwnerChange вид изменения правообладателя
+type OwnerChange string
+
+const (
+	OwnerAdded   OwnerChange = "added"
+	OwnerUpdated OwnerChange = "updated"
+	OwnerRemoved OwnerChange = "removed"
+)
+
+// DomainEvent событие изменения паспорта. Методы паспорта накапливают события,
+// хранилище передает их подписчикам после успешного сохранения.
+// Заполняются только поля, относящиеся к типу события.
+type DomainEvent struct {
+	// ID уникальный идентификатор события; служит ключом идемпотентности
+	// при доставке во внешние системы
+	ID         string    `json:"id"`
+	Type       EventType `json:"type"`
+	PassportID string    `json:"passport_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+
+	// Action действие журнала изменений паспорта (AuditEntry.Action),
+	// которым записано событие: created, add_building, approve и т.д.
+	Action string `json:"action,omitempty"`
+
+	// Organization организация, ведущая паспорт; по ней выбираются
+	// webhook получателей
+	Organization string `json:"organization,omitempty"`
+
+	// Litera литера здания (building_added, building_removed)
+	Litera string `json:"litera,omitempty"`
+
+	// OwnerIndex и OwnerChange индекс правообладателя и вид изменения (owner_changed)
+	OwnerIndex  int         `json:"owner_index,omitempty"`
+	OwnerChange OwnerChange `json:"owner_change,omitempty"`
+
+	// PreviousStatus и Status статус до и после изменения (status_changed)
+	PreviousStatus PassportStatus `json:"previous_status,omitempty"`
+	Status         PassportStatus `json:"status,omitempty"`
+
+	// Recipient получатель паспорта (passport_issued)
+	Recipient string `json:"recipient,omitempty"`
+
+	// MergedID ID объединенного и удаленного дубликата (passport_merged)
+	MergedID string `json:"merged_id,omitempty"`
+}
+
+// eventSeq счетчик событий процесса для уникальности ID
+var eventSeq atomic.Uint64
+
+// newEventID генерирует ID события
+func newEventID(at time.Time) string {
+	return "EV-" + strconv.FormatInt(at.UnixNano(), 10) + "-" + strconv.FormatUint(eventSeq.Add(1), 10)
+}
+
+// raise записывает событие в паспорт до сохранения; action - действие
+// журнала изменений, которым записано то же изменение
+func (tp *TechnicalPassport) raise(action string, event DomainEvent) {
+	event.Action = action
+	event.OccurredAt = time.Now()
+	event.ID = newEventID(event.OccurredAt)
+	tp.events = append(tp.events, event)
+}
+
+// Events возвращает события, накопленные с последнего сохранения.
+// ID паспорта и организация проставляются при чтении: при создании
+// паспорта они назначаются после NewTechnicalPassport.
+func (tp *TechnicalPassport) Events() []DomainEvent {
+	if len(tp.events) == 0 {
+		return nil
+	}
+	events := make([]DomainEvent, len(tp.events))
+	for i, event := range tp.events {
+		event.PassportID = tp.ID
+		event.Organization = tp.OrganizationName
+		events[i] = event
+	}
+	return events
+}
+
+// PullEvents возвращает накопленные события и очищает их
+func (tp *TechnicalPassport) PullEvents() []DomainEvent {
+	events := tp.Events()
+	tp.events = nil
+	return events
+}
+
+// MarkCreated записывает создание паспорта, загруженного из файла обмена:
+// такой паспорт собирается без NewTechnicalPassport
+func (tp *TechnicalPassport) MarkCreated() {
+	for _, e := range tp.events {
+		if e.Type == EventPassportCreated {
+			return
+		}
+	}
+	tp.raise("created", DomainEvent{Type: EventPassportCreated})
+}
+
+// DeletedEvent формирует событие удаления паспорта. Его передает хранилище
+// после успешного удаления (PassportRepository.Delete), поэтому в паспорт
+// оно не записывается.
+func (tp *TechnicalPassport) DeletedEvent() DomainEvent {
+	at := time.Now()
+	return DomainEvent{
+		ID:           newEventID(at),
+		Type:         EventPassportDeleted,
+		PassportID:   tp.ID,
+		OccurredAt:   at,
+		Action:       "delete",
+		Organization: tp.OrganizationName,
+	}
+}
@@ -0,0 +1,239 @@
+package entity
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Point точка поэтажного плана в метрах; ось X направлена вправо, ось Y - вверх
+type Point struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+// Wall стена поэтажного плана, заданная осевой линией
+type Wall struct {
+	// Начало и конец осевой линии стены
+	Start Point `json:"start"`
+	End   Point `json:"end"`
+
+	// Толщина стены (м)
+	Thickness float64 `json:"thickness"`
+}
+
+// Length возвращает длину стены по оси (м)
+func (w Wall) Length() float64 {
+	return math.Hypot(w.End.X-w.Start.X, w.End.Y-w.Start.Y)
+}
+
+// OpeningType тип проема в стене
+type OpeningType string
+
+const (
+	OpeningDoor   OpeningType = "door"   // Дверной проем
+	OpeningWindow OpeningType = "window" // Оконный проем
+)
+
+// IsValid проверяет что тип проема известен системе
+func (t OpeningType) IsValid() bool {
+	return t == OpeningDoor || t == OpeningWindow
+}
+
+// Opening проем (дверь или окно) в стене поэтажного плана
+type Opening struct {
+	Type OpeningType `json:"type"`
+
+	// Индекс стены в FloorPlan.Walls
+	Wall int `json:"wall"`
+
+	// Расстояние от начала стены до начала проема по оси (м)
+	Offset float64 `json:"offset"`
+
+	// Ширина проема (м)
+	Width float64 `json:"width"`
+}
+
+// RoomContour контур помещения на поэтажном плане.
+// Помещение экспликации определяется литерой и этажом плана и номером помещения.
+type RoomContour struct {
+	// Номер помещения в экспликации
+	RoomNumber string `json:"room_number"`
+
+	// Вершины контура по внутренним граням стен, без повторения первой вершины
+	Points []Point `json:"points"`
+}
+
+// Area вычисляет площадь контура (кв.м) по формуле Гаусса
+func (c RoomContour) Area() float64 {
+	sum := 0.0
+	for i, p := range c.Points {
+		next := c.Points[(i+1)%len(c.Points)]
+		sum += p.X*next.Y - next.X*p.Y
+	}
+	return math.Abs(sum) / 2
+}
+
+// Centroid возвращает центр тяжести контура (точку для подписи помещения)
+func (c RoomContour) Centroid() Point {
+	var cx, cy, sum float64
+	for i, p := range c.Points {
+		next := c.Points[(i+1)%len(c.Points)]
+		cross := p.X*next.Y - next.X*p.Y
+		cx += (p.X + next.X) * cross
+		cy += (p.Y + next.Y) * cross
+		sum += cross
+	}
+	if sum == 0 {
+		return Point{}
+	}
+	return Point{X: cx / (3 * sum), Y: cy / (3 * sum)}
+}
+
+// FloorPlan векторный поэтажный план одного этажа литеры
+type FloorPlan struct {
+	// Литера объекта
+	Litera string `json:"litera"`
+
+	// Этаж (как в экспликации: "1", "подвал", "мансарда")
+	Floor string `json:"floor"`
+
+	Walls    []Wall        `json:"walls,omitempty"`
+	Openings []Opening     `json:"openings,omitempty"`
+	Rooms    []RoomContour `json:"rooms,omitempty"`
+
+	// Путь к отсканированному плану (подложка); в паспортах до появления
+	// векторных планов поэтажные планы хранились только так
+	Background string `json:"background,omitempty"`
+
+	// Отсканированные планы и чертежи обмера (ID вложений паспорта)
+	Attachments []string `json:"attachments,omitempty"`
+}
+
+// UnmarshalJSON читает план; строка считается путем к файлу плана
+// из паспортов, сохраненных до появления векторных планов
+func (p *FloorPlan) UnmarshalJSON(data []byte) error {
+	var path string
+	if err := json.Unmarshal(data, &path); err == nil {
+		*p = FloorPlan{Background: path}
+		return nil
+	}
+
+	type plain FloorPlan
+	return json.Unmarshal(data, (*plain)(p))
+}
+
+// Matches проверяет, относится ли план к литере и этажу (без учета регистра и пробелов)
+func (p *FloorPlan) Matches(litera, floor string) bool {
+	return sameLabel(p.Litera, litera) && sameLabel(p.Floor, floor)
+}
+
+// Contour возвращает контур помещения по номеру или nil
+func (p *FloorPlan) Contour(roomNumber string) *RoomContour {
+	for i := range p.Rooms {
+		if sameLabel(p.Rooms[i].RoomNumber, roomNumber) {
+			return &p.Rooms[i]
+		}
+	}
+	return nil
+}
+
+// IsValid проверяет корректность поэтажного плана
+func (p *FloorPlan) IsValid() error {
+	// План только с подложкой допускается без привязки к этажу
+	if len(p.Walls) == 0 && len(p.Rooms) == 0 {
+		if p.Background == "" {
+			return ValidationError{Field: "rooms", Message: "план не содержит ни стен, ни помещений"}
+		}
+		return nil
+	}
+
+	if p.Litera == "" {
+		return ValidationError{Field: "litera", Message: "литера обязательна"}
+	}
+
+	if p.Floor == "" {
+		return ValidationError{Field: "floor", Message: "этаж обязателен"}
+	}
+
+	for i, w := range p.Walls {
+		field := "walls[" + strconv.Itoa(i) + "]"
+		if w.Length() == 0 {
+			return ValidationError{Field: field, Message: "стена нулевой длины"}
+		}
+		if w.Thickness <= 0 {
+			return ValidationError{Field: field + ".thickness", Message: "толщина стены должна быть больше 0"}
+		}
+	}
+
+	for i, o := range p.Openings {
+		field := "openings[" + strconv.Itoa(i) + "]"
+		if !o.Type.IsValid() {
+			return ValidationError{Field: field + ".type", Message: "неизвестный тип проема"}
+		}
+		if o.Wall < 0 || o.Wall >= len(p.Walls) {
+			return ValidationError{Field: field + ".wall", Message: "стена проема не найдена"}
+		}
+		if o.Width <= 0 {
+			return ValidationError{Field: field + ".width", Message: "ширина проема должна быть больше 0"}
+		}
+		if o.Offset < 0 || o.Offset+o.Width > p.Walls[o.Wall].Length() {
+			return ValidationError{Field: field + ".offset", Message: "проем выходит за пределы стены"}
+		}
+	}
+
+	seen := map[string]bool{}
+	for i, c := range p.Rooms {
+		field := "rooms[" + strconv.Itoa(i) + "]"
+		if c.RoomNumber == "" {
+			return ValidationError{Field: field + ".room_number", Message: "номер помещения обязателен"}
+		}
+		key := strings.ToLower(strings.TrimSpace(c.RoomNumber))
+		if seen[key] {
+			return ValidationError{Field: field + ".room_number", Message: "контур помещения " + c.RoomNumber + " уже есть на плане"}
+		}
+		seen[key] = true
+
+		if len(c.Points) < 3 {
+			return ValidationError{Field: field + ".points", Message: "контур должен содержать не менее 3 вершин"}
+		}
+		if c.Area() == 0 {
+			return ValidationError{Field: field + ".points", Message: "площадь контура равна 0"}
+		}
+	}
+
+	return nil
+}
+
+// Bounds возвращает габариты плана с учетом толщины стен
+func (p *FloorPlan) Bounds() (min, max Point) {
+	min = Point{X: math.Inf(1), Y: math.Inf(1)}
+	max = Point{X: math.Inf(-1), Y: math.Inf(-1)}
+
+	extend := func(pt Point, pad float64) {
+		min.X = math.Min(min.X, pt.X-pad)
+		min.Y = math.Min(min.Y, pt.Y-pad)
+		max.X = math.Max(max.X, pt.X+pad)
+		max.Y = math.Max(max.Y, pt.Y+pad)
+	}
+	for _, w := range p.Walls {
+		extend(w.Start, w.Thickness/2)
+		extend(w.End, w.Thickness/2)
+	}
+	for _, c := range p.Rooms {
+		for _, pt := range c.Points {
+			extend(pt, 0)
+		}
+	}
+
+	if math.IsInf(min.X, 1) {
+		return Point{}, Point{}
+	}
+	return min, max
+}
+
+// sameLabel сравнивает литеры, этажи и номера помещений без учета регистра и пробелов
+func sameLabel(a, b string) bool {
+	return strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+}
@@ -0,0 +1,172 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// MergeResult сведения, перенесенные из дубликата при объединении паспортов
+type MergeResult struct {
+	// Fields заполненные поля паспорта, которые были пустыми
+	Fields []string `json:"fields,omitempty"`
+
+	Buildings   int `json:"buildings"`
+	Structures  int `json:"structures"`
+	Owners      int `json:"owners"`
+	FloorPlans  int `json:"floor_plans"`
+	Rooms       int `json:"rooms"`
+	Flats       int `json:"flats"`
+	Attachments int `json:"attachments"`
+}
+
+// Merge переносит в паспорт сведения дубликата source. Сведения паспорта
+// имеют приоритет: заполняются только пустые поля, здания и сооружения
+// добавляются с новыми литерами, правообладатели - новые, поэтажные планы -
+// для отсутствующих этажей, квартиры - с новыми номерами, экспликация
+// и ситуационный план - если их нет. Вложения дубликата переносятся все,
+// чтобы сохранить ссылки перенесенных сведений. Дубликат не изменяется.
+func (tp *TechnicalPassport) Merge(source *TechnicalPassport) (MergeResult, error) {
+	var result MergeResult
+
+	if source.ID == tp.ID {
+		return result, ValidationError{Field: "source_id", Message: "паспорт нельзя объединить сам с собой"}
+	}
+	if tp.Status == PassportStatusArchived {
+		return result, ValidationError{Field: "status", Message: "паспорт в архиве нельзя изменить"}
+	}
+	if source.Status != PassportStatusDraft {
+		return result, ValidationError{Field: "source_id", Message: "объединить можно только черновик: паспорт " + source.ID + " уже утвержден или в архиве"}
+	}
+
+	fill := func(field string, target *string, value string) {
+		if *target == "" && value != "" {
+			*target = value
+			result.Fields = append(result.Fields, field)
+		}
+	}
+	fill("organization_name", &tp.OrganizationName, source.OrganizationName)
+	fill("inventory_number", &tp.InventoryNumber, source.InventoryNumber)
+	fill("cadastral_number", &tp.CadastralNumber, source.CadastralNumber)
+	fill("address.postal_code", &tp.Address.PostalCode, source.Address.PostalCode)
+	fill("address.district", &tp.Address.District, source.Address.District)
+	fill("address.city", &tp.Address.City, source.Address.City)
+	fill("address.city_district", &tp.Address.CityDistrict, source.Address.CityDistrict)
+	fill("address.street", &tp.Address.Street, source.Address.Street)
+	fill("address.building", &tp.Address.Building, source.Address.Building)
+	fill("general_info.actual_usage", &tp.GeneralInfo.ActualUsage, source.GeneralInfo.ActualUsage)
+	fill("general_info.note", &tp.GeneralInfo.Note, source.GeneralInfo.Note)
+	fill("situation_plan_path", &tp.SituationPlanPath, source.SituationPlanPath)
+
+	if tp.GeneralInfo.LivingArea == 0 && source.GeneralInfo.LivingArea != 0 {
+		tp.GeneralInfo.LivingArea = source.GeneralInfo.LivingArea
+		result.Fields = append(result.Fields, "general_info.living_area")
+	}
+	if tp.GeneralInfo.FloorsAboveGround == 0 && source.GeneralInfo.FloorsAboveGround != 0 {
+		tp.GeneralInfo.FloorsAboveGround = source.GeneralInfo.FloorsAboveGround
+		result.Fields = append(result.Fields, "general_info.floors_above_ground")
+	}
+	if tp.GeneralInfo.FloorsUnderground == 0 && source.GeneralInfo.FloorsUnderground != 0 {
+		tp.GeneralInfo.FloorsUnderground = source.GeneralInfo.FloorsUnderground
+		result.Fields = append(result.Fields, "general_info.floors_underground")
+	}
+
+	for _, b := range source.Buildings {
+		if !tp.hasBuilding(b.Litera) {
+			tp.Buildings = append(tp.Buildings, b)
+			result.Buildings++
+		}
+	}
+	for _, s := range source.Structures {
+		if tp.FindStructure(s.Litera) == nil {
+			tp.Structures = append(tp.Structures, s)
+			result.Structures++
+		}
+	}
+	for _, o := range source.Owners {
+		if !tp.hasOwner(o) {
+			tp.Owners = append(tp.Owners, o)
+			result.Owners++
+		}
+	}
+	for _, plan := range source.FloorPlans {
+		if !tp.hasFloorPlan(plan.Litera, plan.Floor) {
+			tp.FloorPlans = append(tp.FloorPlans, plan)
+			result.FloorPlans++
+		}
+	}
+	if len(tp.Explication) == 0 && len(source.Explication) > 0 {
+		tp.Explication = append(tp.Explication, source.Explication...)
+		result.Rooms = len(source.Explication)
+	}
+	if len(tp.Entrances) == 0 {
+		tp.Entrances = append(tp.Entrances, source.Entrances...)
+	}
+	for _, f := range source.Flats {
+		if tp.FindFlat(f.Number) == nil {
+			tp.Flats = append(tp.Flats, f)
+			result.Flats++
+		}
+	}
+	if len(tp.CommonAreas) == 0 {
+		tp.CommonAreas = append(tp.CommonAreas, source.CommonAreas...)
+	}
+	if tp.SituationPlan == nil && source.SituationPlan != nil {
+		plan := *source.SituationPlan
+		tp.SituationPlan = &plan
+		result.Fields = append(result.Fields, "situation_plan")
+	}
+	if tp.Commercial == nil && source.Commercial != nil {
+		info := *source.Commercial
+		tp.Commercial = &info
+		result.Fields = append(result.Fields, "commercial")
+	}
+	for _, a := range source.Attachments {
+		if tp.FindAttachment(a.ID) == nil {
+			tp.Attachments = append(tp.Attachments, a)
+			result.Attachments++
+		}
+	}
+
+	tp.UpdatedDate = time.Now()
+	tp.AddAuditEntry("merge", "Объединен с дубликатом "+source.ID)
+	tp.raise("merge", DomainEvent{Type: EventPassportMerged, MergedID: source.ID})
+
+	return result, nil
+}
+
+// hasBuilding проверяет наличие здания с литерой
+func (tp *TechnicalPassport) hasBuilding(litera string) bool {
+	for _, b := range tp.Buildings {
+		if sameLabel(b.Litera, litera) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasOwner проверяет наличие правообладателя с тем же наименованием
+// или ФИО и тем же ИНН
+func (tp *TechnicalPassport) hasOwner(owner Owner) bool {
+	for _, o := range tp.Owners {
+		if ownerKey(o) == ownerKey(owner) {
+			return true
+		}
+	}
+	return false
+}
+
+// ownerKey ключ сравнения правообладателей
+func ownerKey(o Owner) string {
+	name := strings.ReplaceAll(strings.ToLower(strings.Join(strings.Fields(o.FullName+" "+o.CompanyName), " ")), "ё", "е")
+	return name + "|" + o.TIN
+}
+
+// hasFloorPlan проверяет наличие плана этажа литеры
+func (tp *TechnicalPassport) hasFloorPlan(litera, floor string) bool {
+	for _, plan := range tp.FloorPlans {
+		if plan.Matches(litera, floor) {
+			return true
+		}
+	}
+	return false
+}
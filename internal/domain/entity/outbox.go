@@ -0,0 +1,41 @@
+package entity
+
+import "time"
+
+// OutboxMessage событие паспорта, ожидающее доставки во внешние системы.
+// Записывается в outbox вместе с изменением паспорта; ID события служит
+// ключом идемпотентности, по которому получатель отбрасывает повторы.
+type OutboxMessage struct {
+	ID    string      `json:"id"`
+	Event DomainEvent `json:"event"`
+
+	// CreatedAt время записи в outbox
+	CreatedAt time.Time `json:"created_at"`
+
+	// Attempts число неудачных попыток доставки
+	Attempts int `json:"attempts"`
+
+	// NextAttemptAt время следующей попытки; нулевое - доставить сразу
+	NextAttemptAt time.Time `json:"next_attempt_at,omitempty"`
+
+	// Delivered получатели, которым сообщение уже доставлено: при повторе
+	// сообщение отправляется только остальным
+	Delivered []string `json:"delivered,omitempty"`
+
+	// LastError ошибка последней попытки
+	LastError string `json:"last_error,omitempty"`
+}
+
+// NewOutboxMessage создает сообщение outbox для события
+func NewOutboxMessage(event DomainEvent) OutboxMessage {
+	return OutboxMessage{
+		ID:        event.ID,
+		Event:     event,
+		CreatedAt: time.Now(),
+	}
+}
+
+// DeliveredTo проверяет что сообщение доставлено получателю
+func (m *OutboxMessage) DeliveredTo(sink string) bool {
+	return containsString(m.Delivered, sink)
+}
@@ -30,6 +30,9 @@ type Owner struct {
 
 	// Доля в праве (например, "1/2", "1" для единоличного собственника)
 	Share string `json:"share"`
+
+	// Сканы правоустанавливающих документов (ID вложений паспорта)
+	Attachments []string `json:"attachments,omitempty"`
 }
 
 // IsValid проверяет корректность данных владельца
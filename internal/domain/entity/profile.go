@@ -0,0 +1,80 @@
+package entity
+
+// PassportSection раздел технического паспорта, выводимый в документ
+type PassportSection string
+
+const (
+	SectionGeneralInfo   PassportSection = "general_info"   // Общие сведения
+	SectionBuildings     PassportSection = "buildings"      // Состав объекта
+	SectionStructural    PassportSection = "structural"     // Конструктивные элементы и износ
+	SectionOwners        PassportSection = "owners"         // Правообладатели
+	SectionSituationPlan PassportSection = "situation_plan" // Ситуационный план
+	SectionUtilities     PassportSection = "utilities"      // Благоустройство
+	SectionExplication   PassportSection = "explication"    // Экспликация
+	SectionFlats         PassportSection = "flats"          // Подъезды и квартиры
+	SectionCommonAreas   PassportSection = "common_areas"   // Помещения общего имущества
+	SectionFireSafety    PassportSection = "fire_safety"    // Пожарная безопасность
+	SectionUseCategories PassportSection = "use_categories" // Площади по категориям использования
+)
+
+// ObjectProfile набор разделов паспорта и правил проверки для типа объекта
+type ObjectProfile struct {
+	// Sections разделы паспорта в порядке вывода
+	Sections []PassportSection
+
+	// LivingArea в паспорте учитывается жилая площадь
+	LivingArea bool
+
+	// Commercial обязательны сведения о пожарной безопасности
+	// и категории использования помещений
+	Commercial bool
+}
+
+// Has проверяет, входит ли раздел в профиль
+func (p ObjectProfile) Has(section PassportSection) bool {
+	for _, s := range p.Sections {
+		if s == section {
+			return true
+		}
+	}
+	return false
+}
+
+// residentialSections разделы паспорта жилого объекта
+var residentialSections = []PassportSection{
+	SectionGeneralInfo,
+	SectionBuildings,
+	SectionStructural,
+	SectionOwners,
+	SectionSituationPlan,
+	SectionUtilities,
+	SectionExplication,
+}
+
+// Profile возвращает профиль паспорта для типа объекта
+func (t ObjectType) Profile() ObjectProfile {
+	switch t {
+	case ObjectTypeApartmentBuilding:
+		return ObjectProfile{
+			Sections:   append(append([]PassportSection{}, residentialSections...), SectionFlats, SectionCommonAreas),
+			LivingArea: true,
+		}
+	case ObjectTypeNonResidential:
+		return ObjectProfile{
+			Sections: []PassportSection{
+				SectionGeneralInfo,
+				SectionBuildings,
+				SectionStructural,
+				SectionOwners,
+				SectionSituationPlan,
+				SectionUtilities,
+				SectionExplication,
+				SectionFireSafety,
+				SectionUseCategories,
+			},
+			Commercial: true,
+		}
+	}
+
+	return ObjectProfile{Sections: residentialSections, LivingArea: true}
+}
@@ -23,6 +23,9 @@ type Room struct {
 	// Вспомогательная площадь (кв.м)
 	AuxiliaryArea float64 `json:"auxiliary_area"`
 
+	// Категория использования нежилого помещения (офисное, торговое, складское и т.п.)
+	UseCategory UseCategory `json:"use_category,omitempty"`
+
 	// Высота помещения (м)
 	Height float64 `json:"height"`
 
@@ -31,6 +34,9 @@ type Room struct {
 
 	// Примечание
 	Note string `json:"note,omitempty"`
+
+	// Обмер, по которому рассчитана площадь (если площадь не введена вручную)
+	Measurements *RoomMeasurements `json:"measurements,omitempty"`
 }
 
 // IsValid проверяет корректность данных помещения
@@ -63,9 +69,20 @@ func (r *Room) IsValid() error {
 		return ValidationError{Field: "auxiliary_area", Message: "вспомогательная площадь не может быть отрицательной"}
 	}
 
+	if r.UseCategory != "" && !r.UseCategory.IsValid() {
+		return ValidationError{Field: "use_category", Message: "неизвестная категория использования " + string(r.UseCategory)}
+	}
+
 	if r.Height < 0 {
 		return ValidationError{Field: "height", Message: "высота не может быть отрицательной"}
 	}
 
+	if r.Measurements != nil {
+		if err := r.Measurements.IsValid(); err != nil {
+			ve, _ := err.(ValidationError)
+			return ValidationError{Field: "measurements." + ve.Field, Message: ve.Message}
+		}
+	}
+
 	return nil
 }
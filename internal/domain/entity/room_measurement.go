@@ -0,0 +1,157 @@
+package entity
+
+import (
+	"strconv"
+	"time"
+)
+
+// ShapeKind вид фигуры, на которые разбивается помещение при обмере
+type ShapeKind string
+
+const (
+	ShapeRectangle  ShapeKind = "rectangle"  // Прямоугольник: длина и ширина
+	ShapeTriangle   ShapeKind = "triangle"   // Треугольник: три стороны
+	ShapePolygon    ShapeKind = "polygon"    // Выпуклый многоугольник: стороны и диагонали из первой вершины
+	ShapeSemicircle ShapeKind = "semicircle" // Полукруг (полукруглый эркер): диаметр
+)
+
+// IsValid проверяет что вид фигуры известен системе
+func (k ShapeKind) IsValid() bool {
+	switch k {
+	case ShapeRectangle, ShapeTriangle, ShapePolygon, ShapeSemicircle:
+		return true
+	}
+	return false
+}
+
+// ShapeRole роль фигуры в площади помещения
+type ShapeRole string
+
+const (
+	ShapeRoleMain      ShapeRole = "main"       // Основная часть помещения
+	ShapeRoleBayWindow ShapeRole = "bay_window" // Эркер (добавляется)
+	ShapeRoleNiche     ShapeRole = "niche"      // Ниша (добавляется при высоте от 2 м)
+	ShapeRoleDeduction ShapeRole = "deduction"  // Колонна, пилястра, печь (вычитается)
+)
+
+// IsValid проверяет что роль фигуры известна системе
+func (r ShapeRole) IsValid() bool {
+	switch r {
+	case ShapeRoleMain, ShapeRoleBayWindow, ShapeRoleNiche, ShapeRoleDeduction:
+		return true
+	}
+	return false
+}
+
+// MeasuredShape фигура обмера помещения; размеры в метрах
+type MeasuredShape struct {
+	Kind ShapeKind `json:"kind"`
+	Role ShapeRole `json:"role"`
+
+	// Прямоугольник
+	Length float64 `json:"length,omitempty"`
+	Width  float64 `json:"width,omitempty"`
+
+	// Треугольник и многоугольник: стороны по порядку обхода
+	Sides []float64 `json:"sides,omitempty"`
+
+	// Многоугольник: диагонали из первой вершины к вершинам 3, 4, ..., n-1
+	Diagonals []float64 `json:"diagonals,omitempty"`
+
+	// Полукруг
+	Diameter float64 `json:"diameter,omitempty"`
+
+	// Высота ниши
+	Height float64 `json:"height,omitempty"`
+
+	// Пояснение (например, "колонна у окна")
+	Note string `json:"note,omitempty"`
+}
+
+// IsValid проверяет наличие размеров, необходимых для вида фигуры
+func (s *MeasuredShape) IsValid() error {
+	if !s.Kind.IsValid() {
+		return ValidationError{Field: "kind", Message: "неизвестный вид фигуры"}
+	}
+
+	if !s.Role.IsValid() {
+		return ValidationError{Field: "role", Message: "неизвестная роль фигуры"}
+	}
+
+	switch s.Kind {
+	case ShapeRectangle:
+		if s.Length <= 0 || s.Width <= 0 {
+			return ValidationError{Field: "length", Message: "длина и ширина прямоугольника должны быть больше 0"}
+		}
+	case ShapeTriangle:
+		if len(s.Sides) != 3 {
+			return ValidationError{Field: "sides", Message: "у треугольника три стороны"}
+		}
+	case ShapePolygon:
+		if len(s.Sides) < 4 {
+			return ValidationError{Field: "sides", Message: "у многоугольника не менее 4 сторон"}
+		}
+		if len(s.Diagonals) != len(s.Sides)-3 {
+			return ValidationError{
+				Field:   "diagonals",
+				Message: "для " + strconv.Itoa(len(s.Sides)) + " сторон нужно " + strconv.Itoa(len(s.Sides)-3) + " диагонали из первой вершины",
+			}
+		}
+	case ShapeSemicircle:
+		if s.Diameter <= 0 {
+			return ValidationError{Field: "diameter", Message: "диаметр должен быть больше 0"}
+		}
+	}
+
+	for i, v := range append(append([]float64{}, s.Sides...), s.Diagonals...) {
+		if v <= 0 {
+			field := "sides"
+			if i >= len(s.Sides) {
+				field = "diagonals"
+			}
+			return ValidationError{Field: field, Message: "размеры должны быть больше 0"}
+		}
+	}
+
+	if s.Role == ShapeRoleNiche && s.Height <= 0 {
+		return ValidationError{Field: "height", Message: "для ниши укажите высоту"}
+	}
+
+	return nil
+}
+
+// RoomMeasurements обмер помещения, по которому рассчитана площадь.
+// Хранится вместе с помещением для проверки расчета.
+type RoomMeasurements struct {
+	Shapes []MeasuredShape `json:"shapes"`
+
+	// Площадь по обмеру (кв.м), округленная до 0,1
+	Area float64 `json:"area"`
+
+	// Кто и когда выполнил обмер
+	MeasuredBy   string    `json:"measured_by,omitempty"`
+	MeasuredDate time.Time `json:"measured_date"`
+}
+
+// IsValid проверяет фигуры обмера
+func (m *RoomMeasurements) IsValid() error {
+	if len(m.Shapes) == 0 {
+		return ValidationError{Field: "shapes", Message: "обмер не содержит фигур"}
+	}
+
+	main := false
+	for i := range m.Shapes {
+		if err := m.Shapes[i].IsValid(); err != nil {
+			ve, _ := err.(ValidationError)
+			return ValidationError{Field: "shapes[" + strconv.Itoa(i) + "]." + ve.Field, Message: ve.Message}
+		}
+		if m.Shapes[i].Role == ShapeRoleMain {
+			main = true
+		}
+	}
+	if !main {
+		return ValidationError{Field: "shapes", Message: "обмер должен содержать основную часть помещения"}
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+package entity
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// CoordinateSystem система координат ситуационного плана
+type CoordinateSystem string
+
+const (
+	// CoordinateMSK местная система координат (МСК) субъекта: X - на север, Y - на восток, метры
+	CoordinateMSK CoordinateSystem = "msk"
+
+	// CoordinateWGS84 географические координаты WGS 84: X - широта, Y - долгота, градусы
+	CoordinateWGS84 CoordinateSystem = "wgs84"
+)
+
+// IsValid проверяет что система координат известна системе
+func (cs CoordinateSystem) IsValid() bool {
+	return cs == CoordinateMSK || cs == CoordinateWGS84
+}
+
+// Параметры эллипсоида WGS 84 для перехода к местной плоской системе
+const (
+	wgs84SemiMajorAxis   = 6378137.0
+	wgs84Eccentricity2   = 6.69437999014e-3
+	degreesToRadians     = math.Pi / 180
+	minSituationVertices = 3
+)
+
+// GeoPoint точка ситуационного плана. Порядок осей геодезический:
+// в МСК X - северная координата, Y - восточная (метры);
+// в WGS 84 X - широта, Y - долгота (градусы).
+type GeoPoint struct {
+	// Номер точки (н1, н2, ...) из каталога координат; может отсутствовать
+	Name string `json:"name,omitempty"`
+
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+// BuildingFootprint контур здания на ситуационном плане по наружным граням стен
+type BuildingFootprint struct {
+	// Литера здания из раздела "Состав объекта"
+	Litera string `json:"litera"`
+
+	Points []GeoPoint `json:"points"`
+}
+
+// PlanAnnotation надпись на ситуационном плане (улица, проезд, смежный участок)
+type PlanAnnotation struct {
+	Text string   `json:"text"`
+	At   GeoPoint `json:"at"`
+}
+
+// SituationPlan векторный ситуационный план: граница земельного участка,
+// контуры зданий по литерам и надписи
+type SituationPlan struct {
+	CoordinateSystem CoordinateSystem `json:"coordinate_system"`
+
+	// Наименование МСК (например, "МСК-50, зона 2"); для WGS 84 не заполняется
+	Zone string `json:"zone,omitempty"`
+
+	// Граница участка: точки по порядку обхода без повторения первой
+	Boundary []GeoPoint `json:"boundary,omitempty"`
+
+	Footprints  []BuildingFootprint `json:"footprints,omitempty"`
+	Annotations []PlanAnnotation    `json:"annotations,omitempty"`
+
+	// Сканы и выписки по участку (ID вложений паспорта)
+	Attachments []string `json:"attachments,omitempty"`
+}
+
+// IsValid проверяет корректность ситуационного плана
+func (p *SituationPlan) IsValid() error {
+	if !p.CoordinateSystem.IsValid() {
+		return ValidationError{Field: "coordinate_system", Message: "неизвестная система координат"}
+	}
+
+	// Граница участка может быть загружена позже контуров зданий
+	if len(p.Boundary) == 0 && len(p.Footprints) == 0 {
+		return ValidationError{Field: "boundary", Message: "план не содержит ни границы участка, ни контуров зданий"}
+	}
+	if len(p.Boundary) > 0 {
+		if err := p.validContour("boundary", p.Boundary); err != nil {
+			return err
+		}
+	}
+
+	seen := map[string]bool{}
+	for i, f := range p.Footprints {
+		field := "footprints[" + strconv.Itoa(i) + "]"
+		if strings.TrimSpace(f.Litera) == "" {
+			return ValidationError{Field: field + ".litera", Message: "литера обязательна"}
+		}
+		key := strings.ToLower(strings.TrimSpace(f.Litera))
+		if seen[key] {
+			return ValidationError{Field: field + ".litera", Message: "контур литеры " + f.Litera + " уже есть на плане"}
+		}
+		seen[key] = true
+
+		if err := p.validContour(field+".points", f.Points); err != nil {
+			return err
+		}
+	}
+
+	for i, a := range p.Annotations {
+		field := "annotations[" + strconv.Itoa(i) + "]"
+		if strings.TrimSpace(a.Text) == "" {
+			return ValidationError{Field: field + ".text", Message: "текст надписи обязателен"}
+		}
+		if err := p.validPoint(field+".at", a.At); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validContour проверяет контур: не менее 3 точек в допустимом диапазоне и ненулевая площадь
+func (p *SituationPlan) validContour(field string, points []GeoPoint) error {
+	if len(points) < minSituationVertices {
+		return ValidationError{Field: field, Message: "контур должен содержать не менее 3 точек"}
+	}
+	for i, pt := range points {
+		if err := p.validPoint(field+"["+strconv.Itoa(i)+"]", pt); err != nil {
+			return err
+		}
+	}
+	if polygonArea(p.Local(points)) == 0 {
+		return ValidationError{Field: field, Message: "площадь контура равна 0"}
+	}
+	return nil
+}
+
+// validPoint проверяет диапазон географических координат
+func (p *SituationPlan) validPoint(field string, pt GeoPoint) error {
+	if p.CoordinateSystem == CoordinateWGS84 && (math.Abs(pt.X) > 90 || math.Abs(pt.Y) > 180) {
+		return ValidationError{Field: field, Message: "широта должна быть в пределах ±90°, долгота - ±180°"}
+	}
+	return nil
+}
+
+// Footprint возвращает контур здания литеры или nil
+func (p *SituationPlan) Footprint(litera string) *BuildingFootprint {
+	for i := range p.Footprints {
+		if sameLabel(p.Footprints[i].Litera, litera) {
+			return &p.Footprints[i]
+		}
+	}
+	return nil
+}
+
+// PlotArea площадь земельного участка (кв.м), округленная до целых
+func (p *SituationPlan) PlotArea() float64 {
+	return math.Round(polygonArea(p.Local(p.Boundary)))
+}
+
+// FootprintArea площадь застройки по контуру здания (кв.м), округленная до 0,1
+func (p *SituationPlan) FootprintArea(f BuildingFootprint) float64 {
+	return math.Round(polygonArea(p.Local(f.Points))*10) / 10
+}
+
+// Local переводит точки в плоскую систему плана в метрах: ось X на восток,
+// ось Y на север. Координаты WGS 84 проецируются на плоскость, касательную
+// к эллипсоиду в центре границы участка; для участков размером до нескольких
+// километров погрешность площади не превышает сотых долей процента.
+func (p *SituationPlan) Local(points []GeoPoint) []Point {
+	out := make([]Point, len(points))
+	if p.CoordinateSystem != CoordinateWGS84 {
+		for i, pt := range points {
+			out[i] = Point{X: pt.Y, Y: pt.X}
+		}
+		return out
+	}
+
+	origin := p.origin()
+	lat := origin.X * degreesToRadians
+	sin2 := math.Sin(lat) * math.Sin(lat)
+	w := math.Sqrt(1 - wgs84Eccentricity2*sin2)
+	// Радиусы кривизны меридиана и первого вертикала
+	meridian := wgs84SemiMajorAxis * (1 - wgs84Eccentricity2) / (w * w * w)
+	vertical := wgs84SemiMajorAxis / w
+
+	for i, pt := range points {
+		out[i] = Point{
+			X: (pt.Y - origin.Y) * degreesToRadians * vertical * math.Cos(lat),
+			Y: (pt.X - origin.X) * degreesToRadians * meridian,
+		}
+	}
+	return out
+}
+
+// origin центр габаритов границы участка (или контуров зданий, если граница не задана)
+func (p *SituationPlan) origin() GeoPoint {
+	points := p.Boundary
+	if len(points) == 0 {
+		for _, f := range p.Footprints {
+			points = append(points, f.Points...)
+		}
+	}
+	if len(points) == 0 {
+		return GeoPoint{}
+	}
+
+	min, max := points[0], points[0]
+	for _, pt := range points[1:] {
+		min.X, min.Y = math.Min(min.X, pt.X), math.Min(min.Y, pt.Y)
+		max.X, max.Y = math.Max(max.X, pt.X), math.Max(max.Y, pt.Y)
+	}
+	return GeoPoint{X: (min.X + max.X) / 2, Y: (min.Y + max.Y) / 2}
+}
+
+// polygonArea площадь многоугольника по формуле Гаусса
+func polygonArea(points []Point) float64 {
+	return RoomContour{Points: points}.Area()
+}
@@ -0,0 +1,249 @@
+package entity
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// StructuralElementKind конструктивный элемент здания по форме 244
+type StructuralElementKind string
+
+const (
+	ElementFoundation  StructuralElementKind = "foundation"  // Фундаменты
+	ElementWalls       StructuralElementKind = "walls"       // Стены наружные и внутренние капитальные
+	ElementPartitions  StructuralElementKind = "partitions"  // Перегородки
+	ElementSlabs       StructuralElementKind = "slabs"       // Перекрытия
+	ElementRoof        StructuralElementKind = "roof"        // Крыша
+	ElementFloors      StructuralElementKind = "floors"      // Полы
+	ElementOpenings    StructuralElementKind = "openings"    // Проемы
+	ElementFinishing   StructuralElementKind = "finishing"   // Отделочные работы
+	ElementEngineering StructuralElementKind = "engineering" // Санитарно-технические и электрические устройства
+	ElementOther       StructuralElementKind = "other"       // Прочие работы
+)
+
+// StructuralElementKinds элементы в порядке описания в паспорте
+var StructuralElementKinds = []StructuralElementKind{
+	ElementFoundation, ElementWalls, ElementPartitions, ElementSlabs, ElementRoof,
+	ElementFloors, ElementOpenings, ElementFinishing, ElementEngineering, ElementOther,
+}
+
+// structuralElementTitles наименования элементов
+var structuralElementTitles = map[StructuralElementKind]string{
+	ElementFoundation:  "Фундаменты",
+	ElementWalls:       "Стены",
+	ElementPartitions:  "Перегородки",
+	ElementSlabs:       "Перекрытия",
+	ElementRoof:        "Крыша",
+	ElementFloors:      "Полы",
+	ElementOpenings:    "Проемы",
+	ElementFinishing:   "Отделочные работы",
+	ElementEngineering: "Инженерные системы",
+	ElementOther:       "Прочие работы",
+}
+
+// Title наименование элемента
+func (k StructuralElementKind) Title() string {
+	if title, ok := structuralElementTitles[k]; ok {
+		return title
+	}
+	return string(k)
+}
+
+// IsValid проверяет, что элемент известен
+func (k StructuralElementKind) IsValid() bool {
+	_, ok := structuralElementTitles[k]
+	return ok
+}
+
+// TechnicalCondition техническое состояние элемента
+type TechnicalCondition string
+
+const (
+	ConditionGood           TechnicalCondition = "good"           // Хорошее, износ 0-20%
+	ConditionSatisfactory   TechnicalCondition = "satisfactory"   // Удовлетворительное, 21-40%
+	ConditionUnsatisfactory TechnicalCondition = "unsatisfactory" // Неудовлетворительное, 41-60%
+	ConditionDilapidated    TechnicalCondition = "dilapidated"    // Ветхое, 61-80%
+	ConditionEmergency      TechnicalCondition = "emergency"      // Аварийное, 81-100%
+)
+
+// TechnicalConditions состояния от лучшего к худшему
+var TechnicalConditions = []TechnicalCondition{
+	ConditionGood, ConditionSatisfactory, ConditionUnsatisfactory, ConditionDilapidated, ConditionEmergency,
+}
+
+// conditionTitles наименования состояний
+var conditionTitles = map[TechnicalCondition]string{
+	ConditionGood:           "хорошее",
+	ConditionSatisfactory:   "удовлетворительное",
+	ConditionUnsatisfactory: "неудовлетворительное",
+	ConditionDilapidated:    "ветхое",
+	ConditionEmergency:      "аварийное",
+}
+
+// Title наименование состояния
+func (c TechnicalCondition) Title() string {
+	if title, ok := conditionTitles[c]; ok {
+		return title
+	}
+	return string(c)
+}
+
+// ConditionForWear техническое состояние, соответствующее износу по шкале ВСН 53-86(р)
+func ConditionForWear(wear float64) TechnicalCondition {
+	switch {
+	case wear <= 20:
+		return ConditionGood
+	case wear <= 40:
+		return ConditionSatisfactory
+	case wear <= 60:
+		return ConditionUnsatisfactory
+	case wear <= 80:
+		return ConditionDilapidated
+	default:
+		return ConditionEmergency
+	}
+}
+
+// StructuralElement описание конструктивного элемента и его износ по результатам осмотра
+type StructuralElement struct {
+	Kind StructuralElementKind `json:"kind"`
+
+	// Description материал и конструкция (ленточный бутовый, кирпичные и т.п.)
+	Description string `json:"description"`
+
+	// Condition техническое состояние; пустое - по износу
+	Condition TechnicalCondition `json:"condition,omitempty"`
+
+	// Wear физический износ элемента (%)
+	Wear float64 `json:"wear"`
+}
+
+// IsValid проверяет описание элемента
+func (e *StructuralElement) IsValid() error {
+	if !e.Kind.IsValid() {
+		return ValidationError{Field: "kind", Message: "неизвестный конструктивный элемент: " + string(e.Kind)}
+	}
+
+	if e.Condition != "" {
+		if _, ok := conditionTitles[e.Condition]; !ok {
+			return ValidationError{Field: "condition", Message: "неизвестное техническое состояние: " + string(e.Condition)}
+		}
+	}
+
+	if e.Wear < 0 || e.Wear > 100 {
+		return ValidationError{Field: "wear", Message: e.Kind.Title() + ": износ должен быть от 0 до 100%"}
+	}
+
+	return nil
+}
+
+// ElementWearShare вклад элемента во взвешенный износ здания
+type ElementWearShare struct {
+	Kind StructuralElementKind `json:"kind"`
+
+	// Weight удельный вес элемента в восстановительной стоимости (%)
+	Weight float64 `json:"weight"`
+
+	Wear float64 `json:"wear"`
+}
+
+// WearAssessment физический износ здания, взвешенный по удельным весам
+// конструктивных элементов справочника
+type WearAssessment struct {
+	// Date дата оценки
+	Date time.Time `json:"date"`
+
+	TableVersion string `json:"table_version"`
+
+	// WallGroup группа капитальности, по которой выбраны удельные веса
+	WallGroup string `json:"wall_group"`
+
+	Elements []ElementWearShare `json:"elements"`
+
+	// Wear износ здания, округленный до процента
+	Wear float64 `json:"wear"`
+
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// ElementWear износ элементов по видам для расчета
+func ElementWear(elements []StructuralElement) map[StructuralElementKind]float64 {
+	if len(elements) == 0 {
+		return nil
+	}
+	wear := make(map[StructuralElementKind]float64, len(elements))
+	for _, e := range elements {
+		wear[e.Kind] = e.Wear
+	}
+	return wear
+}
+
+// ValidateStructuralElements проверяет элементы здания: каждый вид описывается один раз
+func ValidateStructuralElements(elements []StructuralElement) error {
+	seen := map[StructuralElementKind]bool{}
+	for i := range elements {
+		if err := elements[i].IsValid(); err != nil {
+			return err
+		}
+		if seen[elements[i].Kind] {
+			return ValidationError{Field: "structural_elements", Message: elements[i].Kind.Title() + " описаны дважды"}
+		}
+		seen[elements[i].Kind] = true
+	}
+	return nil
+}
+
+// SortStructuralElements упорядочивает элементы как в паспорте
+func SortStructuralElements(elements []StructuralElement) {
+	order := map[StructuralElementKind]int{}
+	for i, k := range StructuralElementKinds {
+		order[k] = i
+	}
+	sort.SliceStable(elements, func(i, j int) bool {
+		return order[elements[i].Kind] < order[elements[j].Kind]
+	})
+}
+
+// ApplyStructuralElements записывает описание конструктивных элементов здания
+// и взвешенный износ (nil, если удельных весов для здания нет)
+func (tp *TechnicalPassport) ApplyStructuralElements(litera string, elements []StructuralElement, assessment *WearAssessment) error {
+	building := tp.FindBuilding(litera)
+	if building == nil {
+		return ValidationError{Field: "litera", Message: "литеры " + litera + " нет в составе объекта"}
+	}
+
+	if err := ValidateStructuralElements(elements); err != nil {
+		return err
+	}
+	SortStructuralElements(elements)
+
+	building.StructuralElements = elements
+	building.WearAssessment = assessment
+
+	details := fmt.Sprintf("Описаны конструктивные элементы литеры %s (%d)", litera, len(elements))
+	if assessment != nil {
+		details += fmt.Sprintf(", физический износ %.0f%% по справочнику %s", assessment.Wear, assessment.TableVersion)
+	}
+	tp.UpdatedDate = time.Now()
+	tp.AddAuditEntry("assess_wear", details)
+
+	return nil
+}
+
+// WearAssessmentOutdated сообщает, изменился ли износ элементов после расчета износа здания
+func (b *Building) WearAssessmentOutdated() bool {
+	if b.WearAssessment == nil {
+		return false
+	}
+	current := ElementWear(b.StructuralElements)
+	if len(current) != len(b.WearAssessment.Elements) {
+		return true
+	}
+	for _, share := range b.WearAssessment.Elements {
+		if wear, ok := current[share.Kind]; !ok || wear != share.Wear {
+			return true
+		}
+	}
+	return false
+}
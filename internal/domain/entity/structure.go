@@ -0,0 +1,295 @@
+package entity
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// StructureKind вид сооружения в составе объекта
+type StructureKind string
+
+const (
+	StructureKindFence    StructureKind = "fence"    // Ограждение
+	StructureKindWell     StructureKind = "well"     // Колодец
+	StructureKindPipeline StructureKind = "pipeline" // Трубопровод, наружная сеть
+	StructureKindRoad     StructureKind = "road"     // Дорога, проезд, замощение
+	StructureKindSeptic   StructureKind = "septic"   // Выгребная яма, септик
+	StructureKindOther    StructureKind = "other"    // Иное сооружение
+)
+
+// StructureKinds виды сооружений в порядке вывода в паспорте
+var StructureKinds = []StructureKind{
+	StructureKindFence,
+	StructureKindWell,
+	StructureKindPipeline,
+	StructureKindRoad,
+	StructureKindSeptic,
+	StructureKindOther,
+}
+
+// structureKindTitles наименования видов сооружений
+var structureKindTitles = map[StructureKind]string{
+	StructureKindFence:    "Ограждение",
+	StructureKindWell:     "Колодец",
+	StructureKindPipeline: "Трубопровод",
+	StructureKindRoad:     "Дорога, замощение",
+	StructureKindSeptic:   "Выгребная яма",
+	StructureKindOther:    "Иное сооружение",
+}
+
+// IsValid проверяет что вид сооружения известен системе
+func (k StructureKind) IsValid() bool {
+	_, ok := structureKindTitles[k]
+	return ok
+}
+
+// Title возвращает наименование вида сооружения
+func (k StructureKind) Title() string {
+	if title, ok := structureKindTitles[k]; ok {
+		return title
+	}
+	return string(k)
+}
+
+// Linear линейный объект: протяженность определяется трассой на ситуационном плане
+func (k StructureKind) Linear() bool {
+	return k == StructureKindFence || k == StructureKindPipeline || k == StructureKindRoad
+}
+
+// Structure представляет сооружение в составе объекта: ограждение, колодец,
+// трубопровод, дорогу. Характеристики, которые нужны виду сооружения,
+// проверяются в IsValid; остальные не заполняются.
+type Structure struct {
+	// Литера (на плане сооружения обозначаются римскими цифрами: I, II, ...)
+	Litera string `json:"litera"`
+
+	// Вид сооружения
+	Kind StructureKind `json:"kind"`
+
+	// Наименование (забор, колодец питьевой, водопровод и т.п.)
+	Name string `json:"name"`
+
+	// Год ввода в эксплуатацию
+	CommissionYear int `json:"commission_year"`
+
+	// Материал (металлический профлист, ж/б кольца, асфальтобетон и т.п.)
+	Material string `json:"material"`
+
+	// Протяженность (м); для линейных объектов с трассой вычисляется по трассе
+	Length float64 `json:"length,omitempty"`
+
+	// Ширина (м) дороги или замощения
+	Width float64 `json:"width,omitempty"`
+
+	// Высота ограждения (м)
+	Height float64 `json:"height,omitempty"`
+
+	// Диаметр трубопровода или колодца (мм)
+	Diameter float64 `json:"diameter,omitempty"`
+
+	// Глубина колодца или заложения трубопровода (м)
+	Depth float64 `json:"depth,omitempty"`
+
+	// Емкость (куб.м)
+	Capacity float64 `json:"capacity,omitempty"`
+
+	// Площадь (кв.м); для дороги с шириной вычисляется по протяженности
+	Area float64 `json:"area,omitempty"`
+
+	// Трасса линейного объекта в координатах ситуационного плана
+	Route []GeoPoint `json:"route,omitempty"`
+
+	// Физический износ по результатам осмотра (%)
+	Wear float64 `json:"wear,omitempty"`
+
+	// Инвентаризационная стоимость (руб)
+	InventoryValue float64 `json:"inventory_value"`
+
+	// Valuation последний расчет инвентаризационной стоимости с исходными данными
+	Valuation *BuildingValuation `json:"valuation,omitempty"`
+
+	// Примечание
+	Note string `json:"note,omitempty"`
+}
+
+// IsValid проверяет корректность данных сооружения и характеристики,
+// обязательные для его вида
+func (s *Structure) IsValid() error {
+	if s.Litera == "" {
+		return ValidationError{Field: "litera", Message: "литера обязательна"}
+	}
+
+	if !s.Kind.IsValid() {
+		return ValidationError{Field: "kind", Message: "неизвестный вид сооружения: " + string(s.Kind)}
+	}
+
+	if s.Name == "" {
+		return ValidationError{Field: "name", Message: "наименование обязательно"}
+	}
+
+	if s.CommissionYear < 1800 || s.CommissionYear > 2100 {
+		return ValidationError{Field: "commission_year", Message: "некорректный год ввода в эксплуатацию"}
+	}
+
+	for field, v := range map[string]float64{
+		"length": s.Length, "width": s.Width, "height": s.Height, "diameter": s.Diameter,
+		"depth": s.Depth, "capacity": s.Capacity, "area": s.Area,
+	} {
+		if v < 0 {
+			return ValidationError{Field: field, Message: "значение не может быть отрицательным"}
+		}
+	}
+
+	if s.Wear < 0 || s.Wear > 100 {
+		return ValidationError{Field: "wear", Message: "износ должен быть от 0 до 100%"}
+	}
+
+	if len(s.Route) > 0 {
+		if !s.Kind.Linear() {
+			return ValidationError{Field: "route", Message: "трасса задается только для линейных объектов"}
+		}
+		if len(s.Route) < 2 {
+			return ValidationError{Field: "route", Message: "трасса должна содержать не менее 2 точек"}
+		}
+	}
+
+	switch {
+	case s.Kind.Linear() && s.Length == 0:
+		return ValidationError{Field: "length", Message: "укажите протяженность или трассу линейного объекта"}
+	case s.Kind == StructureKindRoad && s.Area == 0:
+		return ValidationError{Field: "width", Message: "укажите ширину или площадь дороги"}
+	case s.Kind == StructureKindPipeline && s.Diameter == 0:
+		return ValidationError{Field: "diameter", Message: "укажите диаметр трубопровода"}
+	case s.Kind == StructureKindWell && s.Depth == 0:
+		return ValidationError{Field: "depth", Message: "укажите глубину колодца"}
+	case s.Kind == StructureKindSeptic && s.Capacity == 0:
+		return ValidationError{Field: "capacity", Message: "укажите емкость выгребной ямы"}
+	}
+
+	if s.Valuation != nil {
+		if err := s.Valuation.IsValid(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RouteLength протяженность трассы (м), округленная до 0,01.
+// Трасса задается в системе координат ситуационного плана паспорта;
+// без плана координаты считаются плоскими в метрах.
+func (tp *TechnicalPassport) RouteLength(route []GeoPoint) float64 {
+	plan := tp.SituationPlan
+	if plan == nil {
+		plan = &SituationPlan{CoordinateSystem: CoordinateMSK}
+	}
+
+	points := plan.Local(route)
+	length := 0.0
+	for i := 1; i < len(points); i++ {
+		length += math.Hypot(points[i].X-points[i-1].X, points[i].Y-points[i-1].Y)
+	}
+	return roundArea(length)
+}
+
+// measureStructure вычисляет протяженность по трассе и площадь дороги по ширине
+func (tp *TechnicalPassport) measureStructure(s *Structure) {
+	if len(s.Route) >= 2 {
+		s.Length = tp.RouteLength(s.Route)
+	}
+	if s.Kind == StructureKindRoad && s.Width > 0 {
+		s.Area = roundArea(s.Length * s.Width)
+	}
+}
+
+// FindStructure возвращает сооружение по литере или nil
+func (tp *TechnicalPassport) FindStructure(litera string) *Structure {
+	for i := range tp.Structures {
+		if sameLabel(tp.Structures[i].Litera, litera) {
+			return &tp.Structures[i]
+		}
+	}
+	return nil
+}
+
+// SetStructure добавляет сооружение или заменяет сооружение с той же литерой;
+// протяженность и площадь линейного объекта вычисляются по трассе
+func (tp *TechnicalPassport) SetStructure(structure Structure) (replaced bool, err error) {
+	tp.measureStructure(&structure)
+	if err := structure.IsValid(); err != nil {
+		return false, err
+	}
+	if tp.FindBuilding(structure.Litera) != nil {
+		return false, ValidationError{Field: "litera", Message: "литера " + structure.Litera + " уже присвоена зданию"}
+	}
+
+	if existing := tp.FindStructure(structure.Litera); existing != nil {
+		*existing = structure
+		tp.AddAuditEntry("update_structure", "Изменено сооружение литеры "+structure.Litera)
+		replaced = true
+	} else {
+		tp.Structures = append(tp.Structures, structure)
+		tp.AddAuditEntry("add_structure", "Добавлено сооружение литеры "+structure.Litera)
+	}
+	tp.UpdatedDate = time.Now()
+
+	return replaced, nil
+}
+
+// RemoveStructure удаляет сооружение по литере
+func (tp *TechnicalPassport) RemoveStructure(litera string) error {
+	for i := range tp.Structures {
+		if sameLabel(tp.Structures[i].Litera, litera) {
+			tp.Structures = append(tp.Structures[:i], tp.Structures[i+1:]...)
+			tp.UpdatedDate = time.Now()
+			tp.AddAuditEntry("remove_structure", "Удалено сооружение литеры "+litera)
+			return nil
+		}
+	}
+
+	return ValidationError{Field: "litera", Message: "сооружения литеры " + litera + " нет в составе объекта"}
+}
+
+// validateStructures проверяет сооружения и уникальность их литер в составе объекта
+func (tp *TechnicalPassport) validateStructures() error {
+	for i, s := range tp.Structures {
+		field := "structures[" + strconv.Itoa(i) + "]"
+		if err := s.IsValid(); err != nil {
+			return ValidationError{Field: field, Message: err.Error()}
+		}
+		if tp.FindBuilding(s.Litera) != nil {
+			return ValidationError{Field: field, Message: "литера " + s.Litera + " уже присвоена зданию"}
+		}
+		for _, other := range tp.Structures[:i] {
+			if sameLabel(other.Litera, s.Litera) {
+				return ValidationError{Field: field, Message: "сооружение литеры " + s.Litera + " указано дважды"}
+			}
+		}
+	}
+	return nil
+}
+
+// ValuationInputsForStructure исходные данные расчета стоимости сооружения
+func ValuationInputsForStructure(tp *TechnicalPassport, s *Structure) ValuationInputs {
+	inputs := ValuationInputs{
+		Region:         tp.Address.Subject,
+		ObjectType:     tp.ObjectType,
+		Name:           s.Name,
+		StructureKind:  s.Kind,
+		WallMaterial:   s.Material,
+		Length:         s.Length,
+		TotalArea:      s.Area,
+		Volume:         s.Capacity,
+		CommissionYear: s.CommissionYear,
+	}
+	if s.Kind == StructureKindWell {
+		// Показатель колодца задается на метр глубины
+		inputs.Length = s.Depth
+	}
+	if s.Wear > 0 {
+		wear := s.Wear
+		inputs.WearPercent = &wear
+	}
+	return inputs
+}
@@ -1,6 +1,10 @@
 package entity
 
-import "time"
+import (
+	"strconv"
+	"strings"
+	"time"
+)
 
 // TechnicalPassport представляет технический паспорт объекта недвижимости
 // Это основная агрегатная сущность (Аggregate Root) в домене
@@ -23,6 +27,9 @@ type TechnicalPassport struct {
 	// Кадастровый номер
 	CadastralNumber string `json:"cadastral_number,omitempty"`
 
+	// Статус паспорта (черновик, утвержден, в архиве)
+	Status PassportStatus `json:"status"`
+
 	// Дата создания паспорта
 	CreatedDate time.Time `json:"created_date"`
 
@@ -40,38 +47,61 @@ type TechnicalPassport struct {
 	// 2. Состав объекта (здания, сооружения)
 	Buildings []Building `json:"buildings"`
 
+	// 2. Сооружения и линейные объекты в составе объекта
+	Structures []Structure `json:"structures,omitempty"`
+
 	// 3. Правообладатели
 	Owners []Owner `json:"owners"`
 
 	// 4. Ситуационный план (ссылка на файл или данные)
 	SituationPlanPath string `json:"situation_plan_path,omitempty"`
 
+	// 4. Векторный ситуационный план участка в координатах
+	SituationPlan *SituationPlan `json:"situation_plan,omitempty"`
+
 	// 5. Благоустройство
 	Utilities Utilities `json:"utilities"`
 
 	// 6-7. Поэтажные планы и экспликация
-	FloorPlans  []string `json:"floor_plans,omitempty"`  // пути к файлам
-	Explication []Room   `json:"explication"`
+	FloorPlans  []FloorPlan `json:"floor_plans,omitempty"`
+	Explication []Room      `json:"explication"`
+
+	// Многоквартирный дом: подъезды, квартиры с экспликацией и помещения общего имущества
+	Entrances   []Entrance `json:"entrances,omitempty"`
+	Flats       []Flat     `json:"flats,omitempty"`
+	CommonAreas []Room     `json:"common_areas,omitempty"`
+
+	// Нежилой объект: пожарная безопасность и эвакуация
+	Commercial *CommercialInfo `json:"commercial,omitempty"`
+
+	// Вложения: сканы документов, фотографии, планы
+	Attachments []Attachment `json:"attachments,omitempty"`
 
 	// История изменений
 	AuditLog []AuditEntry `json:"audit_log,omitempty"`
+
+	// События, накопленные с последнего сохранения (не сериализуются)
+	events []DomainEvent
 }
 
 // NewTechnicalPassport создает новый технический паспорт
 func NewTechnicalPassport(objectType ObjectType, address Address) *TechnicalPassport {
 	now := time.Now()
-	return &TechnicalPassport{
+	tp := &TechnicalPassport{
 		ObjectType:  objectType,
 		Address:     address,
+		Status:      PassportStatusDraft,
 		CreatedDate: now,
 		UpdatedDate: now,
 		AsOfDate:    now,
 		Buildings:   []Building{},
 		Owners:      []Owner{},
-		FloorPlans:  []string{},
+		FloorPlans:  []FloorPlan{},
 		Explication: []Room{},
 		AuditLog:    []AuditEntry{},
 	}
+	tp.raise("created", DomainEvent{Type: EventPassportCreated})
+	return tp
 }
 
 // IsValid проверяет корректность заполнения паспорта
@@ -121,6 +151,46 @@ func (tp *TechnicalPassport) IsValid() error {
 		}
 	}
 
+	// Проверка поэтажных планов (если есть)
+	for i, plan := range tp.FloorPlans {
+		field := "floor_plans[" + strconv.Itoa(i) + "]"
+		if err := plan.IsValid(); err != nil {
+			return ValidationError{Field: field, Message: err.Error()}
+		}
+		for _, other := range tp.FloorPlans[:i] {
+			if plan.Litera != "" && other.Matches(plan.Litera, plan.Floor) {
+				return ValidationError{Field: field, Message: "план этажа " + plan.Floor + " литеры " + plan.Litera + " уже есть"}
+			}
+		}
+	}
+
+	// Проверка ситуационного плана (если есть)
+	if tp.SituationPlan != nil {
+		if err := tp.SituationPlan.IsValid(); err != nil {
+			return ValidationError{Field: "situation_plan", Message: err.Error()}
+		}
+	}
+
+	// Проверка сооружений (если есть)
+	if err := tp.validateStructures(); err != nil {
+		return err
+	}
+
+	// Проверка подъездов, квартир и общего имущества (если есть)
+	if err := tp.validateApartments(); err != nil {
+		return err
+	}
+
+	// Проверка значений, которые профиль объекта не допускает
+	if err := tp.validateProfile(); err != nil {
+		return err
+	}
+
+	// Проверка вложений и ссылок на них
+	if err := tp.validateAttachments(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -140,6 +210,11 @@ func (tp *TechnicalPassport) IsComplete() error {
 		return ValidationError{Field: "owners", Message: "должен быть хотя бы один правообладатель"}
 	}
 
+	// Обязательные сведения профиля объекта (для нежилых - пожарная безопасность)
+	if errs := tp.ProfileErrors(); len(errs) > 0 {
+		return errs
+	}
+
 	return nil
 }
 
@@ -151,7 +226,23 @@ func (tp *TechnicalPassport) AddBuilding(building Building) error {
 
 	tp.Buildings = append(tp.Buildings, building)
 	tp.UpdatedDate = time.Now()
-	tp.AddAuditEntry("add_building", "Добавлено здание: " + building.Name)
+	tp.AddAuditEntry("add_building", "Добавлено здание: "+building.Name)
+	tp.raise("add_building", DomainEvent{Type: EventBuildingAdded, Litera: building.Litera})
+
+	return nil
+}
+
+// RemoveBuilding удаляет здание по индексу в составе объекта
+func (tp *TechnicalPassport) RemoveBuilding(index int) error {
+	if index < 0 || index >= len(tp.Buildings) {
+		return ValidationError{Field: "building_index", Message: "здание с таким индексом не найдено"}
+	}
+
+	litera := tp.Buildings[index].Litera
+	tp.Buildings = append(tp.Buildings[:index], tp.Buildings[index+1:]...)
+	tp.UpdatedDate = time.Now()
+	tp.AddAuditEntry("remove_building", "Удалено здание с индексом "+strconv.Itoa(index))
+	tp.raise("remove_building", DomainEvent{Type: EventBuildingRemoved, Litera: litera})
 
 	return nil
 }
@@ -165,6 +256,39 @@ func (tp *TechnicalPassport) AddOwner(owner Owner) error {
 	tp.Owners = append(tp.Owners, owner)
 	tp.UpdatedDate = time.Now()
 	tp.AddAuditEntry("add_owner", "Добавлен правообладатель")
+	tp.raise("add_owner", DomainEvent{Type: EventOwnerChanged, OwnerIndex: len(tp.Owners) - 1, OwnerChange: OwnerAdded})
+
+	return nil
+}
+
+// UpdateOwner заменяет сведения о правообладателе по индексу
+func (tp *TechnicalPassport) UpdateOwner(index int, owner Owner) error {
+	if index < 0 || index >= len(tp.Owners) {
+		return ValidationError{Field: "owner_index", Message: "правообладатель с таким индексом не найден"}
+	}
+
+	if err := owner.IsValid(); err != nil {
+		return err
+	}
+
+	tp.Owners[index] = owner
+	tp.UpdatedDate = time.Now()
+	tp.AddAuditEntry("update_owner", "Изменены сведения о правообладателе с индексом "+strconv.Itoa(index))
+	tp.raise("update_owner", DomainEvent{Type: EventOwnerChanged, OwnerIndex: index, OwnerChange: OwnerUpdated})
+
+	return nil
+}
+
+// RemoveOwner удаляет правообладателя по индексу
+func (tp *TechnicalPassport) RemoveOwner(index int) error {
+	if index < 0 || index >= len(tp.Owners) {
+		return ValidationError{Field: "owner_index", Message: "правообладатель с таким индексом не найден"}
+	}
+
+	tp.Owners = append(tp.Owners[:index], tp.Owners[index+1:]...)
+	tp.UpdatedDate = time.Now()
+	tp.AddAuditEntry("remove_owner", "Удален правообладатель с индексом "+strconv.Itoa(index))
+	tp.raise("remove_owner", DomainEvent{Type: EventOwnerChanged, OwnerIndex: index, OwnerChange: OwnerRemoved})
 
 	return nil
 }
@@ -177,7 +301,170 @@ func (tp *TechnicalPassport) AddRoom(room Room) error {
 
 	tp.Explication = append(tp.Explication, room)
 	tp.UpdatedDate = time.Now()
-	tp.AddAuditEntry("add_room", "Добавлено помещение: " + room.RoomNumber)
+	tp.AddAuditEntry("add_room", "Добавлено помещение: "+room.RoomNumber)
+
+	return nil
+}
+
+// SetFloorPlan добавляет поэтажный план или заменяет план той же литеры и этажа
+func (tp *TechnicalPassport) SetFloorPlan(plan FloorPlan) error {
+	if err := plan.IsValid(); err != nil {
+		return err
+	}
+
+	description := "Поэтажный план: литера " + plan.Litera + ", этаж " + plan.Floor
+	if existing := tp.FindFloorPlan(plan.Litera, plan.Floor); existing != nil && plan.Litera != "" {
+		// Вложения плана сохраняются, если новый план их не задает
+		if plan.Attachments == nil {
+			plan.Attachments = existing.Attachments
+		}
+		*existing = plan
+		tp.AddAuditEntry("update_floor_plan", "Изменен "+description)
+	} else {
+		tp.FloorPlans = append(tp.FloorPlans, plan)
+		tp.AddAuditEntry("add_floor_plan", "Добавлен "+description)
+	}
+	tp.UpdatedDate = time.Now()
+
+	return nil
+}
+
+// RemoveFloorPlan удаляет поэтажный план литеры и этажа
+func (tp *TechnicalPassport) RemoveFloorPlan(litera, floor string) error {
+	for i := range tp.FloorPlans {
+		if tp.FloorPlans[i].Matches(litera, floor) {
+			tp.FloorPlans = append(tp.FloorPlans[:i], tp.FloorPlans[i+1:]...)
+			tp.UpdatedDate = time.Now()
+			tp.AddAuditEntry("remove_floor_plan", "Удален поэтажный план: литера "+litera+", этаж "+floor)
+			return nil
+		}
+	}
+
+	return ValidationError{Field: "floor_plans", Message: "план этажа " + floor + " литеры " + litera + " не найден"}
+}
+
+// FindFloorPlan возвращает поэтажный план литеры и этажа или nil
+func (tp *TechnicalPassport) FindFloorPlan(litera, floor string) *FloorPlan {
+	for i := range tp.FloorPlans {
+		if tp.FloorPlans[i].Matches(litera, floor) {
+			return &tp.FloorPlans[i]
+		}
+	}
+	return nil
+}
+
+// SetSituationPlan заменяет ситуационный план и пересчитывает площадь застройки
+// зданий, для литер которых на плане есть контур
+func (tp *TechnicalPassport) SetSituationPlan(plan SituationPlan) error {
+	if err := plan.IsValid(); err != nil {
+		return err
+	}
+
+	for i := range tp.Buildings {
+		if f := plan.Footprint(tp.Buildings[i].Litera); f != nil {
+			tp.Buildings[i].BuildArea = plan.FootprintArea(*f)
+		}
+	}
+
+	action, verb := "add_situation_plan", "Добавлен"
+	if tp.SituationPlan != nil {
+		action, verb = "update_situation_plan", "Изменен"
+		if plan.Attachments == nil {
+			plan.Attachments = tp.SituationPlan.Attachments
+		}
+	}
+	tp.SituationPlan = &plan
+	tp.UpdatedDate = time.Now()
+	tp.AddAuditEntry(action, verb+" ситуационный план: участок "+strconv.FormatFloat(plan.PlotArea(), 'f', 0, 64)+" кв.м, зданий "+strconv.Itoa(len(plan.Footprints)))
+
+	return nil
+}
+
+// RemoveSituationPlan удаляет векторный ситуационный план
+func (tp *TechnicalPassport) RemoveSituationPlan() error {
+	if tp.SituationPlan == nil {
+		return ValidationError{Field: "situation_plan", Message: "ситуационный план не задан"}
+	}
+
+	tp.SituationPlan = nil
+	tp.UpdatedDate = time.Now()
+	tp.AddAuditEntry("remove_situation_plan", "Удален ситуационный план")
+
+	return nil
+}
+
+// FindBuilding возвращает здание по литере или nil
+func (tp *TechnicalPassport) FindBuilding(litera string) *Building {
+	for i := range tp.Buildings {
+		if sameLabel(tp.Buildings[i].Litera, litera) {
+			return &tp.Buildings[i]
+		}
+	}
+	return nil
+}
+
+// FindRoom возвращает помещение экспликации по литере, этажу и номеру или nil
+func (tp *TechnicalPassport) FindRoom(litera, floor, roomNumber string) *Room {
+	for i := range tp.Explication {
+		r := &tp.Explication[i]
+		if sameLabel(r.Litera, litera) && sameLabel(r.Floor, floor) && sameLabel(r.RoomNumber, roomNumber) {
+			return r
+		}
+	}
+	return nil
+}
+
+// Approve утверждает паспорт
+func (tp *TechnicalPassport) Approve() error {
+	if tp.Status == PassportStatusArchived {
+		return ValidationError{Field: "status", Message: "паспорт находится в архиве"}
+	}
+
+	if tp.Status == PassportStatusApproved {
+		return ValidationError{Field: "status", Message: "паспорт уже утвержден"}
+	}
+
+	if err := tp.IsComplete(); err != nil {
+		return err
+	}
+
+	previous := tp.Status
+	tp.Status = PassportStatusApproved
+	tp.UpdatedDate = time.Now()
+	tp.AddAuditEntry("approve", "Паспорт утвержден")
+	tp.raise("approve", DomainEvent{Type: EventStatusChanged, PreviousStatus: previous, Status: tp.Status})
+
+	return nil
+}
+
+// Archive переводит паспорт в архив
+func (tp *TechnicalPassport) Archive() error {
+	if tp.Status == PassportStatusArchived {
+		return ValidationError{Field: "status", Message: "паспорт уже находится в архиве"}
+	}
+
+	previous := tp.Status
+	tp.Status = PassportStatusArchived
+	tp.UpdatedDate = time.Now()
+	tp.AddAuditEntry("archive", "Паспорт переведен в архив")
+	tp.raise("archive", DomainEvent{Type: EventStatusChanged, PreviousStatus: previous, Status: tp.Status})
+
+	return nil
+}
+
+// Issue записывает выдачу утвержденного паспорта заказчику
+func (tp *TechnicalPassport) Issue(recipient string) error {
+	if tp.Status != PassportStatusApproved {
+		return ValidationError{Field: "status", Message: "выдать можно только утвержденный паспорт"}
+	}
+
+	if strings.TrimSpace(recipient) == "" {
+		return ValidationError{Field: "recipient", Message: "получатель паспорта обязателен"}
+	}
+
+	tp.UpdatedDate = time.Now()
+	tp.AddAuditEntry("issue", "Паспорт выдан: "+recipient)
+	tp.raise("issue", DomainEvent{Type: EventPassportIssued, Recipient: recipient})
 
 	return nil
 }
@@ -207,5 +494,8 @@ func (tp *TechnicalPassport) CalculateTotalInventoryValue() float64 {
 	for _, building := range tp.Buildings {
 		total += building.InventoryValue
 	}
+	for _, structure := range tp.Structures {
+		total += structure.InventoryValue
+	}
 	return total
 }
@@ -1,6 +1,9 @@
 package entity
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // ObjectType представляет тип объекта недвижимости
 type ObjectType string
@@ -12,6 +15,15 @@ const (
 	ObjectTypeNonResidential   ObjectType = "non_residential"   // Нежилое помещение
 )
 
+// IsValid проверяет что тип объекта известен системе
+func (t ObjectType) IsValid() bool {
+	switch t {
+	case ObjectTypeResidentialHouse, ObjectTypeApartmentBuilding, ObjectTypeApartment, ObjectTypeRoom, ObjectTypeNonResidential:
+		return true
+	}
+	return false
+}
+
 // PersonType представляет тип лица (физическое/юридическое)
 type PersonType string
 
@@ -20,12 +32,30 @@ const (
 	PersonTypeLegal      PersonType = "legal"      // Юридическое лицо
 )
 
+// PassportStatus представляет статус технического паспорта
+type PassportStatus string
+
+const (
+	PassportStatusDraft    PassportStatus = "draft"    // Черновик
+	PassportStatusApproved PassportStatus = "approved" // Утвержден
+	PassportStatusArchived PassportStatus = "archived" // В архиве
+)
+
+// IsValid проверяет что статус известен системе
+func (s PassportStatus) IsValid() bool {
+	switch s {
+	case PassportStatusDraft, PassportStatusApproved, PassportStatusArchived:
+		return true
+	}
+	return false
+}
+
 // AuditEntry представляет запись в истории изменений
 type AuditEntry struct {
-	Timestamp   time.Time
-	Action      string
-	User        string
-	Description string
+	Timestamp   time.Time `json:"timestamp"`
+	Action      string    `json:"action"`
+	User        string    `json:"user,omitempty"`
+	Description string    `json:"description"`
 }
 
 // ValidationError представляет ошибку валидации
@@ -37,3 +67,44 @@ type ValidationError struct {
 func (e ValidationError) Error() string {
 	return e.Field + ": " + e.Message
 }
+
+// ValidationErrors представляет набор ошибок валидации
+// errors.As(err, &ValidationError{}) находит первую ошибку набора
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap возвращает ошибки набора для errors.Is / errors.As
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// PermissionError представляет отказ в доступе к операции
+type PermissionError struct {
+	Login      string
+	Role       Role
+	Permission Permission
+}
+
+func (e PermissionError) Error() string {
+	return "доступ запрещен: пользователь " + e.Login + " (" + string(e.Role) + ") не имеет права " + string(e.Permission)
+}
+
+// AuthenticationError представляет ошибку аутентификации
+type AuthenticationError struct {
+	Message string
+}
+
+func (e AuthenticationError) Error() string {
+	return "ошибка аутентификации: " + e.Message
+}
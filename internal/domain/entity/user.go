@@ -0,0 +1,110 @@
+package entity
+
+import "time"
+
+// Role представляет роль пользователя в системе
+type Role string
+
+const (
+	RoleTechnician Role = "technician" // Техник: создает и редактирует черновики
+	RoleReviewer   Role = "reviewer"   // Проверяющий: утверждает паспорта
+	RoleAdmin      Role = "admin"      // Администратор: удаляет и архивирует паспорта
+)
+
+// Permission представляет право на выполнение операции
+type Permission string
+
+const (
+	PermissionViewPassport    Permission = "passport.view"    // Просмотр паспортов
+	PermissionCreatePassport  Permission = "passport.create"  // Создание паспорта
+	PermissionEditPassport    Permission = "passport.edit"    // Редактирование паспорта
+	PermissionApprovePassport Permission = "passport.approve" // Утверждение паспорта
+	PermissionArchivePassport Permission = "passport.archive" // Перевод паспорта в архив
+	PermissionDeletePassport  Permission = "passport.delete"  // Удаление паспорта
+	PermissionManageUsers     Permission = "users.manage"     // Управление пользователями
+	PermissionManageEvents    Permission = "events.manage"    // Доставка событий во внешние системы
+	PermissionManageWebhooks  Permission = "webhooks.manage"  // Регистрация webhook и просмотр журнала доставки
+)
+
+// rolePermissions матрица прав по ролям
+var rolePermissions = map[Role][]Permission{
+	RoleTechnician: {
+		PermissionViewPassport,
+		PermissionCreatePassport,
+		PermissionEditPassport,
+	},
+	RoleReviewer: {
+		PermissionViewPassport,
+		PermissionApprovePassport,
+	},
+	RoleAdmin: {
+		PermissionViewPassport,
+		PermissionCreatePassport,
+		PermissionEditPassport,
+		PermissionApprovePassport,
+		PermissionArchivePassport,
+		PermissionDeletePassport,
+		PermissionManageUsers,
+		PermissionManageEvents,
+		PermissionManageWebhooks,
+	},
+}
+
+// IsValid проверяет что роль известна системе
+func (r Role) IsValid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+// Can проверяет наличие права у роли
+func (r Role) Can(permission Permission) bool {
+	for _, p := range rolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// User представляет пользователя приложения
+type User struct {
+	// Уникальный идентификатор
+	ID string `json:"id"`
+
+	// Логин
+	Login string `json:"login"`
+
+	// ФИО
+	FullName string `json:"full_name,omitempty"`
+
+	// Роль
+	Role Role `json:"role"`
+
+	// Хеш пароля (пароль в открытом виде не хранится)
+	PasswordHash string `json:"password_hash"`
+
+	// Дата создания учетной записи
+	CreatedDate time.Time `json:"created_date"`
+}
+
+// IsValid проверяет корректность данных пользователя
+func (u *User) IsValid() error {
+	if u.Login == "" {
+		return ValidationError{Field: "login", Message: "логин обязателен"}
+	}
+
+	if !u.Role.IsValid() {
+		return ValidationError{Field: "role", Message: "неизвестная роль"}
+	}
+
+	if u.PasswordHash == "" {
+		return ValidationError{Field: "password_hash", Message: "пароль обязателен"}
+	}
+
+	return nil
+}
+
+// Can проверяет наличие права у пользователя
+func (u *User) Can(permission Permission) bool {
+	return u.Role.Can(permission)
+}
@@ -0,0 +1,198 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// ValuationUnit единица укрупненного показателя восстановительной стоимости
+type ValuationUnit string
+
+const (
+	ValuationUnitVolume ValuationUnit = "m3" // Руб. за куб.м строительного объема
+	ValuationUnitArea   ValuationUnit = "m2" // Руб. за кв.м общей площади
+	ValuationUnitLength ValuationUnit = "m"  // Руб. за погонный метр линейного объекта
+)
+
+// ValuationInputs исходные данные расчета инвентаризационной стоимости здания.
+// Сохраняются вместе с результатом: повторный расчет по тем же данным и той же
+// версии справочника дает ту же стоимость.
+type ValuationInputs struct {
+	// TableVersion версия справочника укрупненных показателей; пустая - действующая
+	TableVersion string `json:"table_version"`
+
+	// Region субъект РФ для территориального коэффициента (как в адресе)
+	Region string `json:"region"`
+
+	// Year год, к ценам которого приводится стоимость
+	Year int `json:"year"`
+
+	// ObjectType и Name вида объекта и наименование здания определяют назначение,
+	// если Purpose не задано
+	ObjectType ObjectType `json:"object_type,omitempty"`
+	Name       string     `json:"name,omitempty"`
+
+	// Purpose код назначения в справочнике (residential, auxiliary, ...)
+	Purpose string `json:"purpose"`
+
+	// StructureKind вид сооружения; задан - расчет по показателям сооружений
+	StructureKind StructureKind `json:"structure_kind,omitempty"`
+
+	WallMaterial   string  `json:"wall_material"`
+	Length         float64 `json:"length,omitempty"`
+	Volume         float64 `json:"volume"`
+	TotalArea      float64 `json:"total_area"`
+	CommissionYear int     `json:"commission_year"`
+
+	// WearPercent физический износ по результатам осмотра (%);
+	// не задан - износ взвешивается по элементам или оценивается по сроку службы
+	WearPercent *float64 `json:"wear_percent,omitempty"`
+
+	// ElementWear износ конструктивных элементов (%)
+	ElementWear map[StructuralElementKind]float64 `json:"element_wear,omitempty"`
+}
+
+// IsValid проверяет исходные данные расчета
+func (in *ValuationInputs) IsValid() error {
+	if in.Year < 1900 || in.Year > 2100 {
+		return ValidationError{Field: "year", Message: "некорректный год оценки"}
+	}
+
+	if in.CommissionYear > in.Year {
+		return ValidationError{Field: "commission_year", Message: "здание введено в эксплуатацию позже года оценки"}
+	}
+
+	if in.Volume < 0 || in.TotalArea < 0 || in.Length < 0 {
+		return ValidationError{Field: "volume", Message: "объем, площадь и протяженность не могут быть отрицательными"}
+	}
+
+	if in.StructureKind != "" {
+		if !in.StructureKind.IsValid() {
+			return ValidationError{Field: "structure_kind", Message: "неизвестный вид сооружения: " + string(in.StructureKind)}
+		}
+		if len(in.ElementWear) > 0 {
+			return ValidationError{Field: "element_wear", Message: "износ по конструктивным элементам не применяется к сооружениям"}
+		}
+	}
+
+	if in.WearPercent != nil && (*in.WearPercent < 0 || *in.WearPercent > 100) {
+		return ValidationError{Field: "wear_percent", Message: "износ должен быть от 0 до 100%"}
+	}
+
+	for kind, wear := range in.ElementWear {
+		if !kind.IsValid() {
+			return ValidationError{Field: "element_wear", Message: "неизвестный конструктивный элемент: " + string(kind)}
+		}
+		if wear < 0 || wear > 100 {
+			return ValidationError{Field: "element_wear", Message: kind.Title() + ": износ должен быть от 0 до 100%"}
+		}
+	}
+
+	return nil
+}
+
+// BuildingValuation расчет инвентаризационной стоимости здания по методике БТИ:
+// восстановительная стоимость по укрупненным показателям в базисных ценах,
+// приведенная к ценам года оценки, за вычетом физического износа
+type BuildingValuation struct {
+	// Date дата расчета
+	Date time.Time `json:"date"`
+
+	// Inputs исходные данные с назначением и версией справочника,
+	// определенными при расчете
+	Inputs ValuationInputs `json:"inputs"`
+
+	// WallGroup группа капитальности по материалу стен
+	WallGroup string `json:"wall_group"`
+
+	Unit ValuationUnit `json:"unit"`
+
+	// Quantity объем (куб.м), площадь (кв.м) или протяженность (м),
+	// к которым применен показатель
+	Quantity float64 `json:"quantity"`
+
+	// UnitCost восстановительная стоимость единицы в базисных ценах (руб)
+	UnitCost float64 `json:"unit_cost"`
+
+	RegionalCoefficient float64 `json:"regional_coefficient"`
+
+	// PriceIndex индекс пересчета базисных цен в цены года оценки
+	PriceIndex float64 `json:"price_index"`
+
+	// ReplacementCost восстановительная стоимость в ценах года оценки (руб)
+	ReplacementCost float64 `json:"replacement_cost"`
+
+	// Wear учтенный физический износ (%)
+	Wear float64 `json:"wear"`
+
+	// WearByAge износ оценен по сроку службы, а не по результатам осмотра
+	WearByAge bool `json:"wear_by_age,omitempty"`
+
+	// WearElements вклад конструктивных элементов, если износ взвешен по ним
+	WearElements []ElementWearShare `json:"wear_elements,omitempty"`
+
+	// InventoryValue инвентаризационная стоимость (руб)
+	InventoryValue float64 `json:"inventory_value"`
+
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// IsValid проверяет сохраненный расчет
+func (v *BuildingValuation) IsValid() error {
+	if v.Inputs.TableVersion == "" {
+		return ValidationError{Field: "valuation.inputs.table_version", Message: "версия справочника обязательна"}
+	}
+
+	if err := v.Inputs.IsValid(); err != nil {
+		return ValidationError{Field: "valuation.inputs", Message: err.Error()}
+	}
+
+	if v.InventoryValue < 0 || v.ReplacementCost < 0 {
+		return ValidationError{Field: "valuation.inventory_value", Message: "стоимость не может быть отрицательной"}
+	}
+
+	return nil
+}
+
+// ValuationInputsFor исходные данные расчета по данным здания и паспорта
+func ValuationInputsFor(tp *TechnicalPassport, b *Building) ValuationInputs {
+	return ValuationInputs{
+		Region:         tp.Address.Subject,
+		ObjectType:     tp.ObjectType,
+		Name:           b.Name,
+		WallMaterial:   b.WallMaterial,
+		Volume:         b.Volume,
+		TotalArea:      b.TotalArea,
+		CommissionYear: b.CommissionYear,
+		ElementWear:    ElementWear(b.StructuralElements),
+	}
+}
+
+// ApplyValuation записывает расчет в здание или сооружение: инвентаризационная
+// стоимость заменяется рассчитанной, расчет сохраняется вместе с объектом
+func (tp *TechnicalPassport) ApplyValuation(litera string, valuation BuildingValuation) error {
+	var value *float64
+	var saved **BuildingValuation
+	if building := tp.FindBuilding(litera); building != nil {
+		value, saved = &building.InventoryValue, &building.Valuation
+	} else if structure := tp.FindStructure(litera); structure != nil {
+		value, saved = &structure.InventoryValue, &structure.Valuation
+	} else {
+		return ValidationError{Field: "litera", Message: "литеры " + litera + " нет в составе объекта"}
+	}
+
+	if err := valuation.IsValid(); err != nil {
+		return err
+	}
+
+	previous := *value
+	*value = valuation.InventoryValue
+	*saved = &valuation
+
+	tp.UpdatedDate = time.Now()
+	tp.AddAuditEntry("value_building", fmt.Sprintf(
+		"Инвентаризационная стоимость литеры %s рассчитана по справочнику %s в ценах %d г.: %.2f руб. (было %.2f руб.)",
+		litera, valuation.Inputs.TableVersion, valuation.Inputs.Year, valuation.InventoryValue, previous))
+
+	return nil
+}
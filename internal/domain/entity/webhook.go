@@ -0,0 +1,119 @@
+package entity
+
+import (
+	"net/url"
+	"strings"
+	"time"
+)
+
+// WebhookActions действия журнала изменений паспорта (AuditEntry.Action),
+// о которых может уведомлять webhook: действия, записывающие доменное событие
+var WebhookActions = []string{
+	"created", "add_building", "remove_building", "add_owner", "update_owner", "remove_owner",
+	"approve", "archive", "issue", "delete", "merge",
+}
+
+// DefaultWebhookActions действия жизненного цикла паспорта, о которых webhook
+// уведомляется, если действия не заданы: создание, утверждение, выдача, удаление
+var DefaultWebhookActions = []string{"created", "approve", "issue", "delete"}
+
+// minWebhookSecretLength минимальная длина ключа подписи
+const minWebhookSecretLength = 16
+
+// Webhook адрес организации для уведомлений об изменениях ее паспортов
+type Webhook struct {
+	// Уникальный идентификатор
+	ID string `json:"id"`
+
+	// Organization организация (TechnicalPassport.OrganizationName),
+	// паспорта которой отслеживаются
+	Organization string `json:"organization"`
+
+	// URL адрес, на который отправляется POST запрос с JSON телом события
+	URL string `json:"url"`
+
+	// Secret ключ подписи тела запроса HMAC-SHA256
+	Secret string `json:"secret"`
+
+	// Actions действия журнала изменений, о которых отправляются уведомления;
+	// пусто - DefaultWebhookActions
+	Actions []string `json:"actions,omitempty"`
+
+	// Дата регистрации
+	CreatedDate time.Time `json:"created_date"`
+}
+
+// IsValid проверяет корректность регистрации webhook
+func (w *Webhook) IsValid() error {
+	if strings.TrimSpace(w.Organization) == "" {
+		return ValidationError{Field: "organization", Message: "организация обязательна"}
+	}
+
+	u, err := url.Parse(w.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ValidationError{Field: "url", Message: "адрес должен быть абсолютным URL http или https"}
+	}
+
+	if len(w.Secret) < minWebhookSecretLength {
+		return ValidationError{Field: "secret", Message: "ключ подписи должен содержать не менее 16 символов"}
+	}
+
+	for _, action := range w.Actions {
+		if !isWebhookAction(action) {
+			return ValidationError{Field: "actions", Message: "действие " + action + " не отправляется в webhook"}
+		}
+	}
+
+	return nil
+}
+
+// Subscribed проверяет что webhook уведомляется о действии
+func (w *Webhook) Subscribed(action string) bool {
+	actions := w.Actions
+	if len(actions) == 0 {
+		actions = DefaultWebhookActions
+	}
+	for _, a := range actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+// isWebhookAction проверяет что действие записывает доменное событие
+func isWebhookAction(action string) bool {
+	for _, a := range WebhookActions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDeliveryStatus результат попытки доставки
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDelivered WebhookDeliveryStatus = "delivered" // Получатель ответил 2xx
+	WebhookFailed    WebhookDeliveryStatus = "failed"    // Временная ошибка: попытка будет повторена
+	WebhookRejected  WebhookDeliveryStatus = "rejected"  // Получатель отклонил запрос: повтора не будет
+)
+
+// WebhookDelivery запись журнала доставки: одна попытка отправки события
+type WebhookDelivery struct {
+	WebhookID  string                `json:"webhook_id"`
+	EventID    string                `json:"event_id"`
+	Action     string                `json:"action"`
+	PassportID string                `json:"passport_id"`
+	Attempt    int                   `json:"attempt"`
+	Status     WebhookDeliveryStatus `json:"status"`
+	StatusCode int                   `json:"status_code,omitempty"`
+	Error      string                `json:"error,omitempty"`
+	Timestamp  time.Time             `json:"timestamp"`
+}
+
+// Finished проверяет что после попытки событие больше не отправляется
+func (d WebhookDelivery) Finished() bool {
+	return d.Status == WebhookDelivered || d.Status == WebhookRejected
+}
@@ -0,0 +1,27 @@
+package repository
+
+import "context"
+
+// AttachmentRepository определяет интерфейс хранилища содержимого вложений.
+// Содержимое адресуется SHA-256 (entity.AttachmentID): одинаковые файлы
+// разных паспортов хранятся один раз.
+type AttachmentRepository interface {
+	// Save сохраняет содержимое и возвращает его ID; сохранение уже имеющегося
+	// содержимого не создает копию
+	Save(ctx context.Context, data []byte) (string, error)
+
+	// Load возвращает содержимое по ID
+	Load(ctx context.Context, id string) ([]byte, error)
+
+	// Exists проверяет наличие содержимого
+	Exists(ctx context.Context, id string) (bool, error)
+
+	// Delete удаляет содержимое и миниатюру
+	Delete(ctx context.Context, id string) error
+
+	// SaveThumbnail сохраняет миниатюру изображения (PNG)
+	SaveThumbnail(ctx context.Context, id string, data []byte) error
+
+	// LoadThumbnail возвращает миниатюру изображения
+	LoadThumbnail(ctx context.Context, id string) ([]byte, error)
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+)
+
+// OutboxRepository определяет интерфейс outbox событий паспортов.
+// Сообщения записываются хранилищем паспортов в одной транзакции
+// с изменением паспорта; этот интерфейс нужен доставке.
+type OutboxRepository interface {
+	// Pending возвращает ожидающие сообщения в порядке записи (не более limit; 0 - все)
+	Pending(ctx context.Context, limit int) ([]entity.OutboxMessage, error)
+
+	// Save сохраняет состояние доставки сообщения после неудачной попытки
+	Save(ctx context.Context, message entity.OutboxMessage) error
+
+	// Complete удаляет сообщение, доставленное всем получателям
+	Complete(ctx context.Context, id string) error
+
+	// DeadLetter переносит сообщение, которое не удалось доставить, в хранилище недоставленных
+	DeadLetter(ctx context.Context, message entity.OutboxMessage) error
+
+	// DeadLetters возвращает недоставленные сообщения
+	DeadLetters(ctx context.Context) ([]entity.OutboxMessage, error)
+
+	// Requeue возвращает недоставленное сообщение в очередь со сброшенными попытками
+	Requeue(ctx context.Context, id string) error
+}
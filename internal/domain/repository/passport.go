@@ -2,10 +2,20 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
 )
 
+// Ошибки, которые реализации репозиториев оборачивают через %w
+var (
+	// ErrNotFound запрошенная запись не найдена
+	ErrNotFound = errors.New("not found")
+
+	// ErrAlreadyExists запись с таким идентификатором уже существует
+	ErrAlreadyExists = errors.New("already exists")
+)
+
 // PassportRepository определяет интерфейс для работы с техническими паспортами
 type PassportRepository interface {
 	// Create создает новый технический паспорт
@@ -23,6 +33,11 @@ type PassportRepository interface {
 	// List возвращает список всех паспортов
 	List(ctx context.Context) ([]*entity.TechnicalPassport, error)
 
-	// FindByAddress ищет паспорта по адресу
+	// FindByAddress ищет паспорта того же объекта: адреса сравниваются
+	// в канонической форме, без учета сокращений, регистра и записи номера дома
 	FindByAddress(ctx context.Context, address entity.Address) ([]*entity.TechnicalPassport, error)
+
+	// Find возвращает страницу паспортов, подходящих под условие выборки,
+	// в порядке сортировки. Недействительный курсор - ошибка ErrInvalidCursor.
+	Find(ctx context.Context, criteria PassportCriteria) (*PassportPage, error)
 }
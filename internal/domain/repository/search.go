@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+)
+
+// SortField поле сортировки паспортов в поиске (PassportQuery)
+// и выборке (PassportCriteria)
+type SortField string
+
+const (
+	SortRelevance SortField = "relevance" // Релевантность полнотекстового запроса (по убыванию); только поиск
+	SortID        SortField = "id"        // ID паспорта
+	SortAddress   SortField = "address"   // Полный адрес
+	SortCreated   SortField = "created"   // Дата создания
+	SortUpdated   SortField = "updated"   // Дата изменения
+	SortYear      SortField = "year"      // Год постройки
+	SortArea      SortField = "area"      // Общая площадь
+)
+
+// validateSort проверяет поле сортировки; relevance - допустима ли
+// сортировка по релевантности
+func validateSort(field SortField, relevance bool) error {
+	switch field {
+	case "", SortID, SortAddress, SortCreated, SortUpdated, SortYear, SortArea:
+		return nil
+	case SortRelevance:
+		if relevance {
+			return nil
+		}
+		return entity.ValidationError{Field: "sort", Message: "сортировка по релевантности доступна только в поиске"}
+	}
+	return entity.ValidationError{Field: "sort", Message: "неизвестное поле сортировки: " + string(field)}
+}
+
+// Facet фасет поиска
+type Facet string
+
+const (
+	FacetObjectType   Facet = "object_type"
+	FacetOrganization Facet = "organization"
+	FacetStatus       Facet = "status"
+	FacetYear         Facet = "year"
+	FacetArea         Facet = "area"
+)
+
+// PassportQuery запрос поиска паспортов. Пустые поля не ограничивают выборку;
+// значения одного фасета объединяются через ИЛИ, разные фасеты и Where - через И.
+type PassportQuery struct {
+	// Text полнотекстовый запрос по адресу, правообладателям, кадастровому
+	// и инвентарному номеру и наименованиям зданий. Каждое слово запроса
+	// должно совпасть с началом слова в паспорте.
+	Text string
+
+	ObjectTypes   []entity.ObjectType
+	Organizations []string
+	Statuses      []entity.PassportStatus
+
+	// YearFrom и YearTo границы года постройки включительно (0 - без границы)
+	YearFrom int
+	YearTo   int
+
+	// AreaFrom и AreaTo границы общей площади включительно (0 - без границы)
+	AreaFrom float64
+	AreaTo   float64
+
+	// Where дополнительное условие отбора, не связанное с фасетами; nil - нет
+	Where PassportSpecification
+
+	// Sort поле сортировки; пусто - релевантность при заданном Text, иначе ID.
+	// Descending меняет порядок для всех полей, кроме релевантности.
+	Sort       SortField
+	Descending bool
+
+	// Offset и Limit страница результатов (Limit 0 - все)
+	Offset int
+	Limit  int
+}
+
+// Validate проверяет диапазоны фильтров, сортировку и страницу
+func (q PassportQuery) Validate() error {
+	if q.Offset < 0 {
+		return entity.ValidationError{Field: "offset", Message: "смещение не может быть отрицательным"}
+	}
+
+	if q.Limit < 0 {
+		return entity.ValidationError{Field: "limit", Message: "лимит не может быть отрицательным"}
+	}
+
+	if q.YearFrom < 0 || q.YearTo < 0 || (q.YearTo > 0 && q.YearFrom > q.YearTo) {
+		return entity.ValidationError{Field: "year", Message: "некорректный диапазон года постройки"}
+	}
+
+	if q.AreaFrom < 0 || q.AreaTo < 0 || (q.AreaTo > 0 && q.AreaFrom > q.AreaTo) {
+		return entity.ValidationError{Field: "area", Message: "некорректный диапазон площади"}
+	}
+
+	return validateSort(q.Sort, true)
+}
+
+// Specification условие отбора запроса без полнотекстового запроса:
+// фильтры фасетов и Where. Фильтр фасета except не учитывается - так
+// считаются счетчики самого фасета; пустой except - все фильтры.
+func (q PassportQuery) Specification(except Facet) PassportSpecification {
+	var specs []PassportSpecification
+	if len(q.ObjectTypes) > 0 && except != FacetObjectType {
+		specs = append(specs, ByObjectType(q.ObjectTypes...))
+	}
+	if len(q.Organizations) > 0 && except != FacetOrganization {
+		specs = append(specs, ByOrganization(q.Organizations...))
+	}
+	if len(q.Statuses) > 0 && except != FacetStatus {
+		specs = append(specs, ByStatus(q.Statuses...))
+	}
+	if (q.YearFrom > 0 || q.YearTo > 0) && except != FacetYear {
+		specs = append(specs, ConstructionYearBetween(q.YearFrom, q.YearTo))
+	}
+	if (q.AreaFrom > 0 || q.AreaTo > 0) && except != FacetArea {
+		specs = append(specs, TotalAreaBetween(q.AreaFrom, q.AreaTo))
+	}
+	if q.Where != nil {
+		specs = append(specs, q.Where)
+	}
+	return And(specs...)
+}
+
+// FacetCount значение фасета и число паспортов с ним
+type FacetCount struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// RangeFacet границы числового фасета среди найденных паспортов
+// (паспорта без значения не учитываются)
+type RangeFacet struct {
+	Min float64 `json:"min"`
+	Max float64 `json:"max"`
+}
+
+// SearchFacets фасеты результатов поиска. Счетчики фасета учитывают все
+// фильтры запроса, кроме фильтра по самому фасету: так видно, сколько
+// паспортов добавит выбор еще одного значения.
+type SearchFacets struct {
+	ObjectTypes   []FacetCount `json:"object_types"`
+	Organizations []FacetCount `json:"organizations"`
+	Statuses      []FacetCount `json:"statuses"`
+	Years         RangeFacet   `json:"years"`
+	Areas         RangeFacet   `json:"areas"`
+}
+
+// SearchResult страница результатов поиска
+type SearchResult struct {
+	Passports []*entity.TechnicalPassport
+
+	// Total число найденных паспортов без учета Offset/Limit
+	Total int
+
+	Facets SearchFacets
+}
+
+// PassportSearcher определяет интерфейс запросов поиска паспортов
+type PassportSearcher interface {
+	// Search возвращает страницу паспортов, подходящих под запрос, и фасеты
+	Search(ctx context.Context, query PassportQuery) (*SearchResult, error)
+}
@@ -0,0 +1,263 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+)
+
+// ErrInvalidCursor курсор не выдан хранилищем или получен для другой сортировки
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// PassportSpecification условие отбора паспортов. Условия составляются
+// из конструкторов ByObjectType, ByOrganization, ByStatus, CreatedBetween,
+// ConstructionYearBetween, TotalAreaBetween, HasOwnerTIN и комбинаторов
+// And, Or, Not. Условием отбираются паспорта и в выборке (PassportCriteria),
+// и в поиске (PassportQuery). Хранилища переводят условие
+// в свой язык запросов по конкретному типу (например, в SQL WHERE),
+// хранилища в памяти проверяют паспорта через IsSatisfiedBy.
+type PassportSpecification interface {
+	// IsSatisfiedBy проверяет, подходит ли паспорт под условие
+	IsSatisfiedBy(passport *entity.TechnicalPassport) bool
+}
+
+// ObjectTypeSpec тип объекта - одно из значений
+type ObjectTypeSpec struct {
+	Types []entity.ObjectType
+}
+
+// ByObjectType паспорта объектов указанных типов
+func ByObjectType(types ...entity.ObjectType) ObjectTypeSpec {
+	return ObjectTypeSpec{Types: types}
+}
+
+// IsSatisfiedBy реализует PassportSpecification
+func (s ObjectTypeSpec) IsSatisfiedBy(passport *entity.TechnicalPassport) bool {
+	for _, t := range s.Types {
+		if passport.ObjectType == t {
+			return true
+		}
+	}
+	return false
+}
+
+// OrganizationSpec организация, составившая паспорт, - одно из значений
+// (точное совпадение наименования)
+type OrganizationSpec struct {
+	Names []string
+}
+
+// ByOrganization паспорта, составленные указанными организациями
+func ByOrganization(names ...string) OrganizationSpec {
+	return OrganizationSpec{Names: names}
+}
+
+// IsSatisfiedBy реализует PassportSpecification
+func (s OrganizationSpec) IsSatisfiedBy(passport *entity.TechnicalPassport) bool {
+	for _, name := range s.Names {
+		if passport.OrganizationName == name {
+			return true
+		}
+	}
+	return false
+}
+
+// StatusSpec статус паспорта - одно из значений
+type StatusSpec struct {
+	Statuses []entity.PassportStatus
+}
+
+// ByStatus паспорта в указанных статусах
+func ByStatus(statuses ...entity.PassportStatus) StatusSpec {
+	return StatusSpec{Statuses: statuses}
+}
+
+// IsSatisfiedBy реализует PassportSpecification
+func (s StatusSpec) IsSatisfiedBy(passport *entity.TechnicalPassport) bool {
+	for _, status := range s.Statuses {
+		if passport.Status == status {
+			return true
+		}
+	}
+	return false
+}
+
+// CreatedBetweenSpec дата создания в полуинтервале [From, To);
+// нулевая граница не ограничивает выборку
+type CreatedBetweenSpec struct {
+	From time.Time
+	To   time.Time
+}
+
+// CreatedBetween паспорта, созданные не раньше from и раньше to
+func CreatedBetween(from, to time.Time) CreatedBetweenSpec {
+	return CreatedBetweenSpec{From: from, To: to}
+}
+
+// IsSatisfiedBy реализует PassportSpecification
+func (s CreatedBetweenSpec) IsSatisfiedBy(passport *entity.TechnicalPassport) bool {
+	if !s.From.IsZero() && passport.CreatedDate.Before(s.From) {
+		return false
+	}
+	if !s.To.IsZero() && !passport.CreatedDate.Before(s.To) {
+		return false
+	}
+	return true
+}
+
+// YearSpec год постройки в интервале [From, To] включительно;
+// нулевая граница не ограничивает выборку
+type YearSpec struct {
+	From int
+	To   int
+}
+
+// ConstructionYearBetween паспорта объектов, построенных с from по to
+func ConstructionYearBetween(from, to int) YearSpec {
+	return YearSpec{From: from, To: to}
+}
+
+// IsSatisfiedBy реализует PassportSpecification
+func (s YearSpec) IsSatisfiedBy(passport *entity.TechnicalPassport) bool {
+	year := passport.GeneralInfo.ConstructionYear
+	return (s.From == 0 || year >= s.From) && (s.To == 0 || year <= s.To)
+}
+
+// AreaSpec общая площадь в интервале [From, To] включительно;
+// нулевая граница не ограничивает выборку
+type AreaSpec struct {
+	From float64
+	To   float64
+}
+
+// TotalAreaBetween паспорта объектов с общей площадью от from до to
+func TotalAreaBetween(from, to float64) AreaSpec {
+	return AreaSpec{From: from, To: to}
+}
+
+// IsSatisfiedBy реализует PassportSpecification
+func (s AreaSpec) IsSatisfiedBy(passport *entity.TechnicalPassport) bool {
+	area := passport.GeneralInfo.TotalArea
+	return (s.From == 0 || area >= s.From) && (s.To == 0 || area <= s.To)
+}
+
+// OwnerTINSpec среди правообладателей есть лицо с ИНН
+type OwnerTINSpec struct {
+	TIN string
+}
+
+// HasOwnerTIN паспорта, в которых есть правообладатель с указанным ИНН
+func HasOwnerTIN(tin string) OwnerTINSpec {
+	return OwnerTINSpec{TIN: tin}
+}
+
+// IsSatisfiedBy реализует PassportSpecification
+func (s OwnerTINSpec) IsSatisfiedBy(passport *entity.TechnicalPassport) bool {
+	for _, owner := range passport.Owners {
+		if owner.TIN == s.TIN {
+			return true
+		}
+	}
+	return false
+}
+
+// AndSpec выполнены все условия; пустой список - любой паспорт
+type AndSpec struct {
+	Specs []PassportSpecification
+}
+
+// And объединяет условия через И
+func And(specs ...PassportSpecification) AndSpec {
+	return AndSpec{Specs: specs}
+}
+
+// IsSatisfiedBy реализует PassportSpecification
+func (s AndSpec) IsSatisfiedBy(passport *entity.TechnicalPassport) bool {
+	for _, spec := range s.Specs {
+		if !spec.IsSatisfiedBy(passport) {
+			return false
+		}
+	}
+	return true
+}
+
+// OrSpec выполнено хотя бы одно условие; пустой список - ни один паспорт
+type OrSpec struct {
+	Specs []PassportSpecification
+}
+
+// Or объединяет условия через ИЛИ
+func Or(specs ...PassportSpecification) OrSpec {
+	return OrSpec{Specs: specs}
+}
+
+// IsSatisfiedBy реализует PassportSpecification
+func (s OrSpec) IsSatisfiedBy(passport *entity.TechnicalPassport) bool {
+	for _, spec := range s.Specs {
+		if spec.IsSatisfiedBy(passport) {
+			return true
+		}
+	}
+	return false
+}
+
+// NotSpec условие не выполнено
+type NotSpec struct {
+	Spec PassportSpecification
+}
+
+// Not отрицание условия
+func Not(spec PassportSpecification) NotSpec {
+	return NotSpec{Spec: spec}
+}
+
+// IsSatisfiedBy реализует PassportSpecification
+func (s NotSpec) IsSatisfiedBy(passport *entity.TechnicalPassport) bool {
+	return !s.Spec.IsSatisfiedBy(passport)
+}
+
+// PassportCriteria запрос выборки паспортов с сортировкой и постраничным
+// выводом по курсору. В отличие от смещения курсор не пропускает и не
+// повторяет паспорта, если между запросами страниц паспорта добавлены
+// или удалены.
+type PassportCriteria struct {
+	// Where условие отбора; nil - все паспорта
+	Where PassportSpecification
+
+	// Sort поле сортировки: id (по умолчанию), address, created, updated,
+	// year, area. Равные значения упорядочиваются по ID.
+	Sort       SortField
+	Descending bool
+
+	// Cursor продолжение выборки: NextCursor предыдущей страницы с теми же
+	// Where, Sort и Descending; пусто - первая страница
+	Cursor string
+
+	// Limit размер страницы (0 - все оставшиеся)
+	Limit int
+}
+
+// Validate проверяет поля сортировки и размер страницы
+func (c PassportCriteria) Validate() error {
+	if err := validateSort(c.Sort, false); err != nil {
+		return err
+	}
+
+	if c.Limit < 0 {
+		return entity.ValidationError{Field: "limit", Message: "лимит не может быть отрицательным"}
+	}
+
+	return nil
+}
+
+// PassportPage страница выборки паспортов
+type PassportPage struct {
+	Passports []*entity.TechnicalPassport
+
+	// Total число паспортов, подходящих под условие, без учета курсора и Limit
+	Total int
+
+	// NextCursor курсор следующей страницы; пусто - страница последняя
+	NextCursor string
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+)
+
+// ErrUsersExist возвращается CreateFirst, если в хранилище уже есть пользователи
+var ErrUsersExist = errors.New("users already exist")
+
+// UserRepository определяет интерфейс для работы с пользователями
+type UserRepository interface {
+	// Create создает нового пользователя
+	Create(ctx context.Context, user *entity.User) error
+
+	// CreateFirst создает пользователя, только если хранилище пусто.
+	// Проверка и запись выполняются атомарно; иначе возвращается ErrUsersExist
+	CreateFirst(ctx context.Context, user *entity.User) error
+
+	// GetByLogin возвращает пользователя по логину
+	GetByLogin(ctx context.Context, login string) (*entity.User, error)
+
+	// Update обновляет существующего пользователя
+	Update(ctx context.Context, user *entity.User) error
+
+	// Delete удаляет пользователя по ID
+	Delete(ctx context.Context, id string) error
+
+	// List возвращает список всех пользователей
+	List(ctx context.Context) ([]*entity.User, error)
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+)
+
+// DeliveryFilter отбор записей журнала доставки; пустые поля не ограничивают
+type DeliveryFilter struct {
+	WebhookID string
+	EventID   string
+
+	// Limit число последних записей (0 - все)
+	Limit int
+}
+
+// WebhookRepository определяет интерфейс хранения webhook организаций
+// и журнала доставки уведомлений
+type WebhookRepository interface {
+	// Create регистрирует webhook
+	Create(ctx context.Context, webhook *entity.Webhook) error
+
+	// GetByID возвращает webhook по ID
+	GetByID(ctx context.Context, id string) (*entity.Webhook, error)
+
+	// Delete удаляет webhook; журнал его доставок сохраняется
+	Delete(ctx context.Context, id string) error
+
+	// List возвращает webhook организации (пустая строка - всех организаций)
+	List(ctx context.Context, organization string) ([]*entity.Webhook, error)
+
+	// RecordDelivery добавляет запись в журнал доставки
+	RecordDelivery(ctx context.Context, delivery entity.WebhookDelivery) error
+
+	// Deliveries возвращает записи журнала доставки в порядке записи
+	Deliveries(ctx context.Context, filter DeliveryFilter) ([]entity.WebhookDelivery, error)
+}
@@ -0,0 +1,41 @@
+package service
+
+import "github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+
+// NormalizedAddress адрес в канонической форме для сравнения: нижний регистр,
+// «е» вместо «ё», без типов населенных пунктов и улиц в наименованиях,
+// с единой записью номера дома
+type NormalizedAddress struct {
+	Subject  string `json:"subject"`
+	District string `json:"district,omitempty"`
+	City     string `json:"city,omitempty"`
+
+	// StreetType полное название типа улицы (улица, проспект, переулок);
+	// пусто, если тип не указан
+	StreetType string `json:"street_type,omitempty"`
+
+	// Street наименование улицы без типа, слова по алфавиту:
+	// «ул. Маршала Жукова» и «Жукова Маршала ул» совпадают
+	Street string `json:"street,omitempty"`
+
+	// House номер дома с литерой и дробью: 5, 5а, 5/1
+	House string `json:"house"`
+
+	// Building номер корпуса или строения без обозначения: 2
+	Building string `json:"building,omitempty"`
+
+	Apartment string `json:"apartment,omitempty"`
+	Room      string `json:"room,omitempty"`
+}
+
+// AddressNormalizer приводит адреса к канонической форме и сравнивает их
+type AddressNormalizer interface {
+	// Normalize возвращает адрес в канонической форме
+	Normalize(address entity.Address) NormalizedAddress
+
+	// Similarity оценивает сходство адресов от 0 (разные объекты) до 1
+	// (один объект). Адреса разных домов, корпусов, квартир и комнат
+	// получают 0; опечатки в наименованиях и незаполненные части адреса
+	// снижают оценку.
+	Similarity(a, b entity.Address) float64
+}
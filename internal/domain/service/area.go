@@ -0,0 +1,36 @@
+package service
+
+import "github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+
+// ShapeArea площадь одной фигуры обмера
+type ShapeArea struct {
+	// Index номер фигуры в обмере (с 0)
+	Index int `json:"index"`
+
+	Kind entity.ShapeKind `json:"kind"`
+	Role entity.ShapeRole `json:"role"`
+
+	// Area площадь фигуры (кв.м) по размерам, округленным до сантиметра
+	Area float64 `json:"area"`
+
+	// Counted учтена ли фигура в площади помещения (ниша ниже 2 м не учитывается)
+	Counted bool `json:"counted"`
+}
+
+// AreaCalculation результат расчета площади помещения по обмеру
+type AreaCalculation struct {
+	// Area площадь помещения (кв.м), округленная до 0,1
+	Area float64 `json:"area"`
+
+	Shapes   []ShapeArea `json:"shapes"`
+	Warnings []string    `json:"warnings,omitempty"`
+}
+
+// AreaCalculator вычисляет площадь помещения по обмеру
+// по правилам инструкции о проведении учета жилищного фонда
+type AreaCalculator interface {
+	// Calculate возвращает площадь помещения и площади фигур;
+	// ошибка entity.ValidationError означает невозможную фигуру (например, треугольник
+	// с неверными сторонами)
+	Calculate(m *entity.RoomMeasurements) (*AreaCalculation, error)
+}
@@ -0,0 +1,14 @@
+package service
+
+import "context"
+
+// AttachmentInspector определяет тип содержимого вложений и создает миниатюры изображений
+type AttachmentInspector interface {
+	// DetectMIME определяет тип содержимого по сигнатуре; имя файла уточняет тип
+	// форматов без сигнатуры и архивов Office (docx, xlsx)
+	DetectMIME(data []byte, fileName string) string
+
+	// Thumbnail создает миниатюру PNG; для содержимого, не являющегося
+	// изображением, возвращает nil без ошибки
+	Thumbnail(ctx context.Context, data []byte, mimeType string) ([]byte, error)
+}
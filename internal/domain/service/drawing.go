@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+)
+
+// DrawingUnit единица измерения чертежа
+type DrawingUnit string
+
+const (
+	// DrawingUnitMillimeter миллиметры: обычная единица архитектурных чертежей
+	DrawingUnitMillimeter DrawingUnit = "mm"
+
+	// DrawingUnitCentimeter сантиметры
+	DrawingUnitCentimeter DrawingUnit = "cm"
+
+	// DrawingUnitMeter метры
+	DrawingUnitMeter DrawingUnit = "m"
+)
+
+// IsValid проверяет что единица измерения известна системе
+func (u DrawingUnit) IsValid() bool {
+	return u == DrawingUnitMillimeter || u == DrawingUnitCentimeter || u == DrawingUnitMeter
+}
+
+// Meters возвращает длину единицы чертежа в метрах
+func (u DrawingUnit) Meters() float64 {
+	switch u {
+	case DrawingUnitMillimeter:
+		return 0.001
+	case DrawingUnitCentimeter:
+		return 0.01
+	default:
+		return 1
+	}
+}
+
+// DrawingPolyline полилиния чертежа в единицах чертежа
+type DrawingPolyline struct {
+	Layer  string
+	Points []entity.Point
+
+	// Closed полилиния замкнута флагом или совпадением первой и последней вершин;
+	// замыкающая вершина в Points не повторяется
+	Closed bool
+}
+
+// DrawingText однострочная или многострочная надпись без кодов форматирования
+type DrawingText struct {
+	Layer string
+	Text  string
+	At    entity.Point
+}
+
+// Drawing полилинии и надписи пространства модели чертежа
+type Drawing struct {
+	// Unit единица измерения из заголовка чертежа; пустая, если не указана
+	Unit DrawingUnit
+
+	Polylines []DrawingPolyline
+	Texts     []DrawingText
+}
+
+// DrawingParser читает чертежи поэтажных планов из CAD-программ
+type DrawingParser interface {
+	// ParseDrawing возвращает полилинии и надписи чертежа
+	ParseDrawing(ctx context.Context, r io.Reader) (*Drawing, error)
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+)
+
+// EventHandler обработчик доменных событий паспорта
+type EventHandler func(ctx context.Context, event entity.DomainEvent) error
+
+// EventPublisher передает подписчикам события, записанные методами паспорта.
+// Вызывается после успешного сохранения: ошибки подписчиков не отменяют
+// сохраненные изменения и сообщаются самой реализацией.
+type EventPublisher interface {
+	Publish(ctx context.Context, events []entity.DomainEvent)
+}
+
+// EventSink получатель сообщений outbox во внешней системе
+type EventSink interface {
+	// Name имя получателя; по нему отмечается доставка сообщения
+	Name() string
+
+	// Deliver доставляет сообщение; ID сообщения передается получателю как ключ
+	// идемпотентности, повторная доставка того же сообщения допустима
+	Deliver(ctx context.Context, message entity.OutboxMessage) error
+}
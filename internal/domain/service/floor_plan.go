@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+)
+
+// PlanImageFormat формат изображения поэтажного плана
+type PlanImageFormat string
+
+const (
+	// PlanFormatSVG векторное изображение для просмотра и печати
+	PlanFormatSVG PlanImageFormat = "svg"
+
+	// PlanFormatPNG растровое изображение для вставки в PDF и DOCX
+	PlanFormatPNG PlanImageFormat = "png"
+)
+
+// IsValid проверяет что формат известен системе
+func (f PlanImageFormat) IsValid() bool {
+	return f == PlanFormatSVG || f == PlanFormatPNG
+}
+
+// FloorPlanRenderer отрисовывает поэтажные планы: стены с проемами,
+// контуры помещений с номерами и площадями по контуру
+type FloorPlanRenderer interface {
+	// Render возвращает изображение плана в заданном формате
+	Render(ctx context.Context, plan *entity.FloorPlan, format PlanImageFormat) ([]byte, error)
+}
@@ -15,6 +15,9 @@ const (
 
 	// FormatDOCX генерирует Word документ
 	FormatDOCX DocumentFormat = "docx"
+
+	// FormatXLSX формирует книгу Excel (только для отчетов по реестру)
+	FormatXLSX DocumentFormat = "xlsx"
 )
 
 // GenerateOptions опции для генерации документа
@@ -27,6 +30,10 @@ type GenerateOptions struct {
 
 	// IncludeImages включать ли изображения (планы)
 	IncludeImages bool
+
+	// Flat номер квартиры многоквартирного дома: вместо паспорта формируется
+	// выписка на эту квартиру
+	Flat string
 }
 
 // DocumentGenerator определяет интерфейс для генерации документов
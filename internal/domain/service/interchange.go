@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+)
+
+// InterchangeDocument прочитанный документ формата обмена паспортами
+type InterchangeDocument struct {
+	// SourceVersion версия схемы исходного документа (до миграции)
+	SourceVersion int
+
+	// Passports паспорта документа, приведенные к текущей версии схемы
+	Passports []*entity.TechnicalPassport
+}
+
+// InterchangeCodec определяет интерфейс версионированного формата обмена паспортами
+type InterchangeCodec interface {
+	// Encode формирует документ обмена текущей версии схемы
+	Encode(ctx context.Context, passports []*entity.TechnicalPassport) ([]byte, error)
+
+	// Decode читает документ любой поддерживаемой версии схемы,
+	// последовательно применяя миграции до текущей версии
+	Decode(ctx context.Context, data []byte) (*InterchangeDocument, error)
+
+	// Schema возвращает JSON Schema текущей версии формата
+	Schema() []byte
+}
+
+// InterchangeBundle определяет архив формата обмена с содержимым вложений
+type InterchangeBundle interface {
+	// Pack собирает архив из документа обмена и содержимого вложений по ID
+	Pack(ctx context.Context, document []byte, attachments map[string][]byte) ([]byte, error)
+
+	// Unpack читает архив и возвращает документ обмена и содержимое вложений по ID
+	Unpack(ctx context.Context, data []byte) (document []byte, attachments map[string][]byte, err error)
+
+	// IsBundle проверяет, что данные являются архивом, а не документом JSON
+	IsBundle(data []byte) bool
+}
@@ -0,0 +1,11 @@
+package service
+
+// PasswordHasher определяет интерфейс для хеширования паролей
+type PasswordHasher interface {
+	// Hash возвращает хеш пароля для хранения
+	Hash(password string) (string, error)
+
+	// Compare проверяет соответствие пароля хешу
+	// Возвращает ошибку если пароль не совпадает
+	Compare(hash, password string) error
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"io"
+)
+
+// RegistryMapping декларативное описание реестра паспортов из старой системы:
+// формат файла и сопоставление его столбцов полям паспорта
+type RegistryMapping struct {
+	// Delimiter разделитель полей (по умолчанию ";")
+	Delimiter string `json:"delimiter,omitempty"`
+
+	// Encoding кодировка файла: utf-8 (по умолчанию) или windows-1251
+	Encoding string `json:"encoding,omitempty"`
+
+	// NoHeader в файле нет строки заголовка; столбцы задаются только номерами
+	NoHeader bool `json:"no_header,omitempty"`
+
+	// Columns сопоставление полей паспорта столбцам: ключ - поле
+	// ("address.house", "owners.full_name"), значение - заголовок столбца
+	// или номер столбца с 1 в виде "#3"
+	Columns map[string]string `json:"columns"`
+
+	// Defaults значения полей, если ячейка пуста или столбец не сопоставлен
+	Defaults map[string]string `json:"defaults,omitempty"`
+
+	// Dictionaries перекодировка значений старой системы по полям,
+	// например {"object_type": {"Жилой дом": "residential_house"}}
+	Dictionaries map[string]map[string]string `json:"dictionaries,omitempty"`
+
+	// DateLayout формат дат в нотации Go (по умолчанию 02.01.2006)
+	DateLayout string `json:"date_layout,omitempty"`
+
+	// GroupBy поле, по которому подряд идущие строки собираются в один паспорт
+	// (несколько правообладателей или зданий); по умолчанию "key", если он сопоставлен
+	GroupBy string `json:"group_by,omitempty"`
+}
+
+// RegistryRow строка реестра со значениями, сопоставленными полям паспорта
+type RegistryRow struct {
+	// Line номер строки файла с 1
+	Line int
+
+	// Values значения ячеек по полям паспорта; несопоставленные поля отсутствуют
+	Values map[string]string
+}
+
+// RegistryReader потоковое чтение строк реестра
+type RegistryReader interface {
+	// Next возвращает следующую строку или io.EOF в конце файла
+	Next() (*RegistryRow, error)
+}
+
+// RegistryParser открывает реестр для потокового чтения
+type RegistryParser interface {
+	// Open проверяет формат и заголовок файла и возвращает читатель строк
+	Open(r io.Reader, mapping RegistryMapping) (RegistryReader, error)
+}
+
+// RegistryProgress позиция импорта реестра для возобновления после прерывания
+type RegistryProgress struct {
+	// Source идентификатор файла реестра; позиция другого файла не применяется
+	Source string `json:"source"`
+
+	// Line последняя полностью обработанная строка
+	Line int `json:"line"`
+
+	// Completed файл обработан до конца
+	Completed bool `json:"completed,omitempty"`
+
+	Created int `json:"created"`
+	Updated int `json:"updated"`
+	Skipped int `json:"skipped"`
+	Failed  int `json:"failed"`
+}
+
+// RegistryCheckpoint хранилище позиции импорта
+type RegistryCheckpoint interface {
+	// Load возвращает сохраненную позицию или nil, если импорт не начинался
+	Load(ctx context.Context) (*RegistryProgress, error)
+
+	// Save сохраняет позицию после обработки очередного паспорта
+	Save(ctx context.Context, progress RegistryProgress) error
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// ReportRow показатели группы паспортов отчета по реестру
+type ReportRow struct {
+	// Key значение группировки (код типа объекта, район, материал стен)
+	Key string `json:"key"`
+
+	// Title наименование группы для документа
+	Title string `json:"title"`
+
+	// Count число паспортов, составленных за период; в разбивке
+	// по материалу стен - число зданий этих паспортов
+	Count int `json:"count"`
+
+	// Issued число паспортов, выданных заказчикам за период
+	// (в разбивке по материалу стен не заполняется)
+	Issued int `json:"issued"`
+
+	// TotalArea общая площадь, кв.м
+	TotalArea float64 `json:"total_area"`
+
+	// InventoryValue инвентаризационная стоимость, руб
+	InventoryValue float64 `json:"inventory_value"`
+
+	// AverageYear средний год постройки (ввода в эксплуатацию для зданий);
+	// 0 - нет сведений
+	AverageYear float64 `json:"average_year"`
+}
+
+// RegistryReport сводный отчет по реестру технических паспортов за период.
+// Паспорт относится к периоду по дате, на которую он составлен (AsOfDate);
+// выданные паспорта считаются по записям выдачи в журнале изменений.
+type RegistryReport struct {
+	// From и To период отчета [From, To); нулевая граница - без ограничения
+	From time.Time `json:"from,omitempty"`
+	To   time.Time `json:"to,omitempty"`
+
+	// GeneratedAt время формирования отчета
+	GeneratedAt time.Time `json:"generated_at"`
+
+	// Total итоги по всем паспортам отчета
+	Total ReportRow `json:"total"`
+
+	// Разбивки по убыванию числа паспортов (зданий)
+	ByObjectType   []ReportRow `json:"by_object_type"`
+	ByDistrict     []ReportRow `json:"by_district"`
+	ByWallMaterial []ReportRow `json:"by_wall_material"`
+}
+
+// ReportGenerator формирует документ отчета по реестру (PDF, DOCX)
+type ReportGenerator interface {
+	GenerateReport(ctx context.Context, report *RegistryReport, format DocumentFormat) ([]byte, error)
+}
+
+// PeriodTitle описание периода отчета: «за период с 01.09.2026 по 30.09.2026»
+// (To не входит в период, поэтому выводится предыдущий день)
+func (r *RegistryReport) PeriodTitle() string {
+	const layout = "02.01.2006"
+	switch {
+	case r.From.IsZero() && r.To.IsZero():
+		return "за все время"
+	case r.To.IsZero():
+		return "с " + r.From.Format(layout)
+	case r.From.IsZero():
+		return "по " + r.To.AddDate(0, 0, -1).Format(layout)
+	}
+	return "за период с " + r.From.Format(layout) + " по " + r.To.AddDate(0, 0, -1).Format(layout)
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+)
+
+// RosreestrIssue замечание к XML документу для Росреестра
+type RosreestrIssue struct {
+	// Path путь в XML документе (TP/Package/Building/Area, атрибуты через @)
+	Path string
+
+	// ModelField поле паспорта, из которого заполняется значение;
+	// пусто, если в модели паспорта такого поля нет
+	ModelField string
+
+	// Message описание замечания
+	Message string
+}
+
+// RosreestrReport результат проверки XML документа по XSD схеме Росреестра
+type RosreestrReport struct {
+	// Schema имя схемы, по которой выполнена проверка
+	Schema string
+
+	// Official проверка выполнена по официальным схемам Росреестра;
+	// иначе - по встроенной упрощенной схеме, соответствие которой
+	// не гарантирует прием документа
+	Official bool
+
+	// Valid документ соответствует схеме
+	Valid bool
+
+	// Missing обязательные по схеме поля, которые не заполнены
+	Missing []RosreestrIssue
+
+	// Errors прочие нарушения схемы (формат и допустимые значения)
+	Errors []RosreestrIssue
+
+	// Warnings сведения паспорта, которые не удалось перенести в документ
+	Warnings []string
+}
+
+// RosreestrDocument XML документ технического плана
+type RosreestrDocument struct {
+	// Data содержимое XML документа
+	Data []byte
+
+	// FileName имя файла по правилам Росреестра
+	FileName string
+
+	// Report результат проверки документа по схеме
+	Report RosreestrReport
+}
+
+// RosreestrExporter определяет интерфейс выгрузки паспорта в XML схемы Росреестра
+type RosreestrExporter interface {
+	// Export формирует XML технического плана и проверяет его по схеме.
+	// Несоответствие схеме не является ошибкой: оно отражается в отчете.
+	Export(ctx context.Context, passport *entity.TechnicalPassport) (*RosreestrDocument, error)
+
+	// Validate проверяет готовый XML документ по схеме
+	Validate(ctx context.Context, data []byte) (*RosreestrReport, error)
+
+	// UnmappedFields перечисляет обязательные по схеме поля, для которых
+	// в модели паспорта нет источника данных, а затем сведения паспорта
+	// (ModelField), для которых в схеме нет полей
+	UnmappedFields() ([]RosreestrIssue, error)
+}
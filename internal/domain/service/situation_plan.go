@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+)
+
+// SituationPlanRenderer отрисовывает ситуационный план: границу участка с номерами
+// точек, контуры зданий с литерами и площадями застройки, надписи
+type SituationPlanRenderer interface {
+	// RenderSituationPlan возвращает изображение плана в заданном формате
+	RenderSituationPlan(ctx context.Context, plan *entity.SituationPlan, format PlanImageFormat) ([]byte, error)
+}
+
+// CoordinateFormat формат файла со списком координат
+type CoordinateFormat string
+
+const (
+	// CoordinateFormatCSV каталог координат: номер точки, X, Y (разделитель ";", "," или табуляция)
+	CoordinateFormatCSV CoordinateFormat = "csv"
+
+	// CoordinateFormatDXF чертеж AutoCAD: точки и полилинии
+	CoordinateFormatDXF CoordinateFormat = "dxf"
+)
+
+// IsValid проверяет что формат известен системе
+func (f CoordinateFormat) IsValid() bool {
+	return f == CoordinateFormatCSV || f == CoordinateFormatDXF
+}
+
+// PointList список точек из файла координат
+type PointList struct {
+	// Имя списка: слой DXF или имя файла CSV
+	Name string `json:"name"`
+
+	// Точки в геодезическом порядке осей (X - на север, Y - на восток)
+	Points []entity.GeoPoint `json:"points"`
+}
+
+// CoordinateParser читает списки точек границ и контуров из CSV и DXF
+type CoordinateParser interface {
+	// ParsePoints возвращает списки точек: для CSV один список,
+	// для DXF - по одному на каждую полилинию и один для отдельных точек
+	ParsePoints(ctx context.Context, r io.Reader, format CoordinateFormat) ([]PointList, error)
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+)
+
+// SpreadsheetSheet лист электронной таблицы в виде текстовых значений ячеек
+type SpreadsheetSheet struct {
+	// Name имя листа
+	Name string
+
+	// Rows строки листа; пустые ячейки в конце строки не хранятся
+	Rows [][]string
+}
+
+// SpreadsheetCodec определяет интерфейс обмена таблицами паспорта
+// с электронными таблицами (XLSX)
+type SpreadsheetCodec interface {
+	// ExportTables формирует книгу с экспликацией помещений (по форме
+	// приказа № 244, с итогами по этажам и литерам) и составом объекта
+	ExportTables(ctx context.Context, passport *entity.TechnicalPassport) ([]byte, error)
+
+	// ExportReport формирует книгу отчета по реестру: итоги и разбивки
+	// на отдельных листах
+	ExportReport(ctx context.Context, report *RegistryReport) ([]byte, error)
+
+	// ReadSheets читает значения ячеек всех листов книги
+	ReadSheets(ctx context.Context, data []byte) ([]SpreadsheetSheet, error)
+}
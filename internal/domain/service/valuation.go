@@ -0,0 +1,40 @@
+package service
+
+import "github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+
+// ValuationTable описание справочника укрупненных показателей восстановительной стоимости
+type ValuationTable struct {
+	Version string `json:"version"`
+	Title   string `json:"title"`
+
+	// EffectiveDate дата начала действия (ГГГГ-ММ-ДД); действующим по умолчанию
+	// считается справочник с наибольшей датой
+	EffectiveDate string `json:"effective_date"`
+
+	// BaseYear год базисных цен показателей
+	BaseYear int `json:"base_year"`
+
+	// Years годы, для которых есть индексы пересчета
+	Years []int `json:"years"`
+
+	// Purposes коды назначения зданий
+	Purposes []string `json:"purposes"`
+
+	// Default справочник, применяемый без явной версии
+	Default bool `json:"default,omitempty"`
+}
+
+// InventoryValuer рассчитывает инвентаризационную стоимость зданий по методике БТИ
+type InventoryValuer interface {
+	// Tables возвращает доступные версии справочников
+	Tables() ([]ValuationTable, error)
+
+	// Value выполняет расчет. В результате Inputs содержат версию справочника
+	// и назначение, по которым выполнен расчет. Ошибка entity.ValidationError
+	// означает, что показателя для таких данных нет в справочнике.
+	Value(inputs entity.ValuationInputs) (*entity.BuildingValuation, error)
+
+	// AssessWear взвешивает износ конструктивных элементов (inputs.ElementWear)
+	// по удельным весам справочника для группы капитальности материала стен
+	AssessWear(inputs entity.ValuationInputs) (*entity.WearAssessment, error)
+}
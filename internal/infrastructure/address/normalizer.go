@@ -0,0 +1,253 @@
+// Package address приводит адреса к канонической форме и оценивает
+// их сходство для поиска дубликатов паспортов
+package address
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// streetTypes сокращения и полные названия типов улиц
+var streetTypes = map[string]string{
+	"ул": "улица", "улица": "улица",
+	"пр": "проспект", "пр-т": "проспект", "просп": "проспект", "проспект": "проспект",
+	"пер": "переулок", "переулок": "переулок",
+	"б-р": "бульвар", "бул": "бульвар", "бульвар": "бульвар",
+	"ш": "шоссе", "шоссе": "шоссе",
+	"пл": "площадь", "площадь": "площадь",
+	"наб": "набережная", "набережная": "набережная",
+	"пр-д": "проезд", "проезд": "проезд",
+	"туп": "тупик", "тупик": "тупик",
+	"ал": "аллея", "аллея": "аллея",
+	"мкр": "микрорайон", "мкр-н": "микрорайон", "микрорайон": "микрорайон",
+	"кв-л": "квартал", "квартал": "квартал",
+	"дор": "дорога", "дорога": "дорога",
+	"линия": "линия", "тракт": "тракт",
+}
+
+// localityTypes обозначения типов субъектов, районов и населенных пунктов;
+// в канонической форме не сохраняются
+var localityTypes = map[string]bool{
+	"г": true, "гор": true, "город": true,
+	"обл": true, "область": true,
+	"респ": true, "республика": true, "край": true,
+	"ао": true, "автономный": true, "округ": true, "окр": true,
+	"р-н": true, "район": true, "мо": true,
+	"п": true, "пос": true, "поселок": true, "пгт": true, "рп": true,
+	"с": true, "село": true,
+	"д": true, "дер": true, "деревня": true,
+	"ст-ца": true, "станица": true, "х": true, "хутор": true,
+}
+
+// Обозначения частей адреса перед номерами
+var (
+	housePrefixes     = []string{"домовладение", "владение", "дом", "влд", "вл", "д"}
+	buildingPrefixes  = []string{"строение", "корпус", "литера", "корп", "стр", "лит", "к", "с"}
+	apartmentPrefixes = []string{"квартира", "помещение", "кв", "пом"}
+	roomPrefixes      = []string{"комната", "ком", "к"}
+)
+
+// houseRe номер дома с литерой, дробью и корпусом или строением:
+// 5, 5а, 5/1, 5к2, 5корп2, 5стр1 (после удаления пробелов и дефисов)
+var houseRe = regexp.MustCompile(`^(\d+[а-я]?(?:/\d+[а-я]?)?)(?:(?:корпус|корп|к|строение|стр|с)(\d+[а-я]?))?$`)
+
+// ordinalRe порядковое числительное в наименовании улицы: 1-я, 2-й, 3ая
+var ordinalRe = regexp.MustCompile(`^(\d+)-?(?:я|ая|й|ый|ий|ой)$`)
+
+// Normalizer реализация service.AddressNormalizer
+type Normalizer struct{}
+
+// NewNormalizer создает нормализатор адресов
+func NewNormalizer() *Normalizer {
+	return &Normalizer{}
+}
+
+// Normalize возвращает адрес в канонической форме
+func (n *Normalizer) Normalize(a entity.Address) service.NormalizedAddress {
+	result := service.NormalizedAddress{
+		Subject:   normalizeLocality(a.Subject),
+		District:  normalizeLocality(a.District),
+		City:      normalizeLocality(a.City),
+		Apartment: stripPrefix(a.Apartment, apartmentPrefixes),
+		Room:      stripPrefix(a.Room, roomPrefixes),
+	}
+	result.StreetType, result.Street = normalizeStreet(a.Street)
+	result.House, result.Building = normalizeHouse(a.House)
+	if building := stripPrefix(a.Building, buildingPrefixes); building != "" {
+		result.Building = building
+	}
+	return result
+}
+
+// Similarity оценивает сходство адресов от 0 до 1
+func (n *Normalizer) Similarity(a, b entity.Address) float64 {
+	x, y := n.Normalize(a), n.Normalize(b)
+
+	// Другой дом, квартира или комната - другой объект
+	if !sameOrEmpty(x.Subject, y.Subject) || x.House != y.House || x.Apartment != y.Apartment || x.Room != y.Room {
+		return 0
+	}
+
+	score := 1.0
+	switch {
+	case x.Building == y.Building:
+	case x.Building == "" || y.Building == "":
+		score *= 0.85
+	default:
+		return 0
+	}
+
+	switch {
+	case x.Street == "" && y.Street == "":
+	case x.Street == "" || y.Street == "":
+		score *= 0.5
+	default:
+		score *= textSimilarity(x.Street, y.Street)
+	}
+	if !sameOrEmpty(x.StreetType, y.StreetType) {
+		score *= 0.9
+	}
+
+	if x.City != "" && y.City != "" {
+		score *= textSimilarity(x.City, y.City)
+	}
+	if !sameOrEmpty(x.District, y.District) {
+		score *= 0.9
+	}
+
+	return score
+}
+
+// Same проверяет, что адреса обозначают один объект: канонические формы
+// совпадают, а незаполненные район, населенный пункт и тип улицы
+// не считаются отличием
+func Same(a, b entity.Address) bool {
+	return defaultNormalizer.Similarity(a, b) == 1
+}
+
+// defaultNormalizer нормализатор для Same
+var defaultNormalizer = NewNormalizer()
+
+// sameOrEmpty значения совпадают или одно из них не заполнено
+func sameOrEmpty(a, b string) bool {
+	return a == "" || b == "" || a == b
+}
+
+// words разбивает текст на слова в нижнем регистре с «е» вместо «ё»;
+// дефис и косая черта внутри слова сохраняются
+func words(text string) []string {
+	text = strings.ReplaceAll(strings.ToLower(text), "ё", "е")
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '/'
+	})
+}
+
+// normalizeLocality наименование субъекта, района или населенного пункта
+// без обозначения типа, слова по алфавиту
+func normalizeLocality(text string) string {
+	var names []string
+	for _, w := range words(text) {
+		if !localityTypes[w] {
+			names = append(names, w)
+		}
+	}
+	sort.Strings(names)
+	return strings.Join(names, " ")
+}
+
+// normalizeStreet разделяет тип и наименование улицы
+func normalizeStreet(text string) (streetType, name string) {
+	var names []string
+	for _, w := range words(text) {
+		if t, ok := streetTypes[w]; ok && streetType == "" {
+			streetType = t
+			continue
+		}
+		if m := ordinalRe.FindStringSubmatch(w); m != nil {
+			w = m[1]
+		}
+		names = append(names, w)
+	}
+	sort.Strings(names)
+	return streetType, strings.Join(names, " ")
+}
+
+// normalizeHouse разбирает номер дома; корпус или строение из номера
+// («5 к2», «5 стр. 1») возвращается отдельно
+func normalizeHouse(text string) (house, building string) {
+	compact := compactNumber(stripWords(text, housePrefixes))
+	if m := houseRe.FindStringSubmatch(compact); m != nil {
+		return m[1], m[2]
+	}
+	return compact, ""
+}
+
+// stripPrefix номер части адреса без обозначения: «кв. 12» -> «12»
+func stripPrefix(text string, prefixes []string) string {
+	return compactNumber(stripWords(text, prefixes))
+}
+
+// stripWords убирает обозначение в начале текста: «д. 5», «д.5», «дом 5»
+func stripWords(text string, prefixes []string) string {
+	text = strings.TrimSpace(strings.ReplaceAll(strings.ToLower(text), "ё", "е"))
+	for _, p := range prefixes {
+		rest, ok := strings.CutPrefix(text, p)
+		if !ok {
+			continue
+		}
+		// Обозначение отделено точкой, пробелом или сразу переходит в цифры
+		if rest == "" || rest[0] == '.' || rest[0] == ' ' || unicode.IsDigit(rune(rest[0])) {
+			return strings.TrimLeft(rest, ". ")
+		}
+	}
+	return text
+}
+
+// compactNumber номер без пробелов, точек и дефисов: «5 - А» -> «5а»
+func compactNumber(text string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '.' || r == '-' || r == ',' {
+			return -1
+		}
+		return r
+	}, strings.ReplaceAll(strings.ToLower(text), "ё", "е"))
+}
+
+// textSimilarity сходство строк по расстоянию Левенштейна от 0 до 1
+func textSimilarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	x, y := []rune(a), []rune(b)
+	longest := len(x)
+	if len(y) > longest {
+		longest = len(y)
+	}
+	return 1 - float64(levenshtein(x, y))/float64(longest)
+}
+
+// levenshtein число вставок, удалений и замен символов
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
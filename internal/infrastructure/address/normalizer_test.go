@@ -0,0 +1,100 @@
+package address_test
+
+import (
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/address"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizer_Normalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		address entity.Address
+		want    service.NormalizedAddress
+	}{
+		{
+			name:    "abbreviations and case",
+			address: entity.Address{Subject: "г. Москва", Street: "ул. Ленина", House: "д.5"},
+			want:    service.NormalizedAddress{Subject: "москва", StreetType: "улица", Street: "ленина", House: "5"},
+		},
+		{
+			name:    "street type after name",
+			address: entity.Address{Subject: "Москва г", Street: "Ленина ул", House: "5"},
+			want:    service.NormalizedAddress{Subject: "москва", StreetType: "улица", Street: "ленина", House: "5"},
+		},
+		{
+			name:    "letter yo and word order",
+			address: entity.Address{Subject: "Московская обл.", City: "г Королёв", Street: "Жукова Маршала пр-т", House: "дом 5 А"},
+			want:    service.NormalizedAddress{Subject: "московская", City: "королев", StreetType: "проспект", Street: "жукова маршала", House: "5а"},
+		},
+		{
+			name:    "fraction in house number",
+			address: entity.Address{Subject: "Татарстан Респ", Street: "1-я Парковая", House: "5/1"},
+			want:    service.NormalizedAddress{Subject: "татарстан", Street: "1 парковая", House: "5/1"},
+		},
+		{
+			name:    "building inside house number",
+			address: entity.Address{Subject: "Москва", Street: "Ленина", House: "5 к2", Apartment: "кв. 12"},
+			want:    service.NormalizedAddress{Subject: "москва", Street: "ленина", House: "5", Building: "2", Apartment: "12"},
+		},
+		{
+			name:    "building field overrides house number",
+			address: entity.Address{Subject: "Москва", Street: "Ленина", House: "5 стр. 1", Building: "корп. 3"},
+			want:    service.NormalizedAddress{Subject: "москва", Street: "ленина", House: "5", Building: "3"},
+		},
+		{
+			name:    "house letter is not a building",
+			address: entity.Address{Subject: "Москва", Street: "Ленина", House: "5-к"},
+			want:    service.NormalizedAddress{Subject: "москва", Street: "ленина", House: "5к"},
+		},
+	}
+
+	n := address.NewNormalizer()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, n.Normalize(tt.address))
+		})
+	}
+}
+
+func TestNormalizer_Similarity(t *testing.T) {
+	base := entity.Address{Subject: "г. Москва", Street: "ул. Ленина", House: "д. 5"}
+
+	tests := []struct {
+		name  string
+		other entity.Address
+		min   float64
+		max   float64
+	}{
+		{name: "same house written differently", other: entity.Address{Subject: "Москва", City: "Москва", Street: "Ленина ул", House: "5"}, min: 1, max: 1},
+		{name: "typo in street name", other: entity.Address{Subject: "Москва", Street: "ул. Ленинаа", House: "5"}, min: 0.8, max: 0.9},
+		{name: "other street", other: entity.Address{Subject: "Москва", Street: "ул. Лесная", House: "5"}, max: 0.6},
+		{name: "other house", other: entity.Address{Subject: "Москва", Street: "ул. Ленина", House: "5А"}, max: 0},
+		{name: "apartment in the house", other: entity.Address{Subject: "Москва", Street: "ул. Ленина", House: "5", Apartment: "1"}, max: 0},
+		{name: "building not specified", other: entity.Address{Subject: "Москва", Street: "ул. Ленина", House: "5", Building: "1"}, min: 0.8, max: 0.9},
+	}
+
+	n := address.NewNormalizer()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score := n.Similarity(base, tt.other)
+			assert.GreaterOrEqual(t, score, tt.min)
+			assert.LessOrEqual(t, score, tt.max)
+			assert.Equal(t, score, n.Similarity(tt.other, base))
+		})
+	}
+}
+
+func TestSame(t *testing.T) {
+	assert.True(t, address.Same(
+		entity.Address{Subject: "г. Москва", Street: "ул. Ленина", House: "д.5", Building: "корп. 2"},
+		entity.Address{Subject: "Москва", Street: "Ленина ул", House: "5к2"},
+	))
+	assert.False(t, address.Same(
+		entity.Address{Subject: "г. Москва", Street: "ул. Ленина", House: "5"},
+		entity.Address{Subject: "г. Москва", Street: "ул. Ленина", House: "5", Building: "2"},
+	))
+}
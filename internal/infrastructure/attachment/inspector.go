@@ -0,0 +1,134 @@
+// Package attachment определяет тип содержимого вложений паспорта и создает
+// миниатюры отсканированных документов и фотографий
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"image"
+	_ "image/gif"  // Декодер GIF для image.Decode
+	_ "image/jpeg" // Декодер JPEG для image.Decode
+	"image/png"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	_ "golang.org/x/image/bmp" // Декодер BMP для image.Decode
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/tiff" // Декодер TIFF (сканы) для image.Decode
+	_ "golang.org/x/image/webp" // Декодер WebP для image.Decode
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+const (
+	// ThumbnailSize наибольшая сторона миниатюры (пикселей)
+	ThumbnailSize = 256
+
+	// maxThumbnailPixels изображения больше этого размера не декодируются
+	// для миниатюры, чтобы не расходовать память на поврежденных файлах
+	maxThumbnailPixels = 150_000_000
+)
+
+// extensionTypes типы содержимого по расширению для форматов без сигнатуры
+// и архивов ZIP (документы Office)
+var extensionTypes = map[string]string{
+	".pdf":  "application/pdf",
+	".doc":  "application/msword",
+	".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	".xls":  "application/vnd.ms-excel",
+	".xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	".odt":  "application/vnd.oasis.opendocument.text",
+	".ods":  "application/vnd.oasis.opendocument.spreadsheet",
+	".dxf":  "image/vnd.dxf",
+	".dwg":  "image/vnd.dwg",
+	".csv":  "text/csv",
+	".txt":  "text/plain",
+	".xml":  "application/xml",
+	".json": "application/json",
+	".tif":  "image/tiff",
+	".tiff": "image/tiff",
+}
+
+// thumbnailTypes изображения, для которых создаются миниатюры
+var thumbnailTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/bmp":  true,
+	"image/tiff": true,
+	"image/webp": true,
+}
+
+// Inspector реализация service.AttachmentInspector
+type Inspector struct{}
+
+// NewInspector создает инспектор вложений
+func NewInspector() *Inspector {
+	return &Inspector{}
+}
+
+var _ service.AttachmentInspector = (*Inspector)(nil)
+
+// DetectMIME определяет тип содержимого. Сигнатура важнее расширения: файл
+// photo.pdf с содержимым JPEG считается изображением.
+func (i *Inspector) DetectMIME(data []byte, fileName string) string {
+	// TIFF (типичный формат сканов) не распознается net/http
+	if bytes.HasPrefix(data, []byte("II*\x00")) || bytes.HasPrefix(data, []byte("MM\x00*")) {
+		return "image/tiff"
+	}
+
+	sniffed, _, _ := strings.Cut(http.DetectContentType(data), ";")
+	byExtension := extensionTypes[strings.ToLower(filepath.Ext(fileName))]
+
+	switch sniffed {
+	case "application/octet-stream", "application/zip", "text/plain", "text/xml":
+		if byExtension != "" {
+			return byExtension
+		}
+	}
+	return sniffed
+}
+
+// Thumbnail уменьшает изображение до ThumbnailSize по большей стороне с сохранением
+// пропорций; изображения меньше этого размера не увеличиваются
+func (i *Inspector) Thumbnail(ctx context.Context, data []byte, mimeType string) ([]byte, error) {
+	if !thumbnailTypes[mimeType] {
+		return nil, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if config.Width*config.Height > maxThumbnailPixels {
+		return nil, nil
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width == 0 || height == 0 {
+		return nil, nil
+	}
+	if longest := max(width, height); longest > ThumbnailSize {
+		width = max(1, width*ThumbnailSize/longest)
+		height = max(1, height*ThumbnailSize/longest)
+	}
+
+	thumbnail := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(thumbnail, thumbnail.Bounds(), img, bounds, draw.Src, nil)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, thumbnail); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,81 @@
+package attachment_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/attachment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInspector_DetectMIME(t *testing.T) {
+	var jpg bytes.Buffer
+	require.NoError(t, jpeg.Encode(&jpg, image.NewGray(image.Rect(0, 0, 4, 4)), nil))
+
+	tests := []struct {
+		name     string
+		data     []byte
+		fileName string
+		want     string
+	}{
+		{"jpeg by signature", jpg.Bytes(), "photo.pdf", "image/jpeg"},
+		{"pdf", []byte("%PDF-1.7\n"), "scan.pdf", "application/pdf"},
+		{"tiff little endian", []byte("II*\x00\x08\x00\x00\x00"), "scan.bin", "image/tiff"},
+		{"docx by extension", []byte("PK\x03\x04\x14\x00"), "Договор.DOCX", "application/vnd.openxmlformats-officedocument.wordprocessingml.document"},
+		{"unknown zip", []byte("PK\x03\x04\x14\x00"), "archive.zip", "application/zip"},
+		{"csv by extension", []byte("x;y\n1;2\n"), "points.csv", "text/csv"},
+		{"unknown binary", []byte{0x00, 0x01, 0x02}, "data", "application/octet-stream"},
+	}
+
+	inspector := attachment.NewInspector()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inspector.DetectMIME(tt.data, tt.fileName))
+		})
+	}
+}
+
+func TestInspector_Thumbnail(t *testing.T) {
+	ctx := context.Background()
+	inspector := attachment.NewInspector()
+
+	encode := func(width, height int) []byte {
+		var buf bytes.Buffer
+		require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))))
+		return buf.Bytes()
+	}
+
+	tests := []struct {
+		name          string
+		width, height int
+		wantW, wantH  int
+	}{
+		{"landscape is scaled", 1024, 512, 256, 128},
+		{"portrait is scaled", 300, 1200, 64, 256},
+		{"small image is kept", 100, 50, 100, 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			thumbnail, err := inspector.Thumbnail(ctx, encode(tt.width, tt.height), "image/png")
+			require.NoError(t, err)
+
+			config, err := png.DecodeConfig(bytes.NewReader(thumbnail))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantW, config.Width)
+			assert.Equal(t, tt.wantH, config.Height)
+		})
+	}
+
+	thumbnail, err := inspector.Thumbnail(ctx, []byte("%PDF-1.7"), "application/pdf")
+	require.NoError(t, err)
+	assert.Nil(t, thumbnail, "для документов миниатюра не создается")
+
+	_, err = inspector.Thumbnail(ctx, []byte("not a png"), "image/png")
+	assert.Error(t, err)
+}
@@ -0,0 +1,97 @@
+package coordinates
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// utf8BOM метка порядка байтов, которую добавляет Excel при сохранении в UTF-8
+const utf8BOM = "\ufeff"
+
+// parseCSV читает каталог координат: строки "X;Y" или "номер;X;Y".
+// Разделитель определяется по первой строке; при разделителе ";" или табуляции
+// дробная часть может отделяться запятой. Строка заголовка пропускается.
+func parseCSV(r io.Reader) ([]service.PointList, error) {
+	scanner := bufio.NewScanner(r)
+
+	var (
+		points    []entity.GeoPoint
+		delimiter string
+		line      int
+		first     = true
+	)
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(strings.TrimPrefix(scanner.Text(), utf8BOM))
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		if delimiter == "" {
+			delimiter = detectDelimiter(text)
+		}
+		header := first
+		first = false
+
+		fields := strings.Fields(text)
+		if delimiter != " " {
+			fields = strings.Split(text, delimiter)
+		}
+		for i := range fields {
+			fields[i] = strings.Trim(strings.TrimSpace(fields[i]), `"`)
+		}
+
+		var name string
+		if len(fields) >= 3 {
+			name, fields = fields[0], fields[1:3]
+		}
+		if len(fields) < 2 {
+			return nil, entity.ValidationError{Field: "line " + strconv.Itoa(line), Message: "ожидается X и Y или номер точки, X и Y"}
+		}
+
+		x, errX := parseNumber(fields[0], delimiter)
+		y, errY := parseNumber(fields[1], delimiter)
+		if errX != nil || errY != nil {
+			// Заголовок таблицы координат
+			if header {
+				continue
+			}
+			return nil, entity.ValidationError{Field: "line " + strconv.Itoa(line), Message: "некорректные координаты: " + text}
+		}
+		points = append(points, entity.GeoPoint{Name: name, X: x, Y: y})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(points) == 0 {
+		return nil, nil
+	}
+	return []service.PointList{{Name: "csv", Points: withoutClosingPoint(points)}}, nil
+}
+
+// detectDelimiter выбирает разделитель полей по первой строке
+func detectDelimiter(line string) string {
+	for _, d := range []string{";", "\t"} {
+		if strings.Contains(line, d) {
+			return d
+		}
+	}
+	if strings.Contains(line, ",") {
+		return ","
+	}
+	return " "
+}
+
+// parseNumber разбирает координату; при разделителе полей, отличном от запятой,
+// запятая считается десятичным разделителем
+func parseNumber(value, delimiter string) (float64, error) {
+	if delimiter != "," {
+		value = strings.Replace(value, ",", ".", 1)
+	}
+	return strconv.ParseFloat(strings.ReplaceAll(value, " ", ""), 64)
+}
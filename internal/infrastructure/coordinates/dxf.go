@@ -0,0 +1,71 @@
+package coordinates
+
+import (
+	"io"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/dxf"
+)
+
+// parseDXF читает точки (POINT) и полилинии (LWPOLYLINE, POLYLINE с VERTEX)
+// из секции ENTITIES. Ось X чертежа направлена на восток, ось Y - на север,
+// поэтому при чтении координаты меняются местами.
+func parseDXF(r io.Reader) ([]service.PointList, error) {
+	file, err := dxf.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		lists    []service.PointList
+		points   = map[string][]entity.GeoPoint{}
+		layers   []string
+		polyline *service.PointList
+	)
+	for _, e := range file.Entities {
+		switch e.Kind {
+		case "POINT":
+			if _, ok := points[e.Layer]; !ok {
+				layers = append(layers, e.Layer)
+			}
+			points[e.Layer] = append(points[e.Layer], geoPoints(e.Vertices)...)
+		case "LWPOLYLINE":
+			lists = append(lists, service.PointList{Name: e.Layer, Points: withoutClosingPoint(geoPoints(e.Vertices))})
+		case "POLYLINE":
+			lists = append(lists, service.PointList{Name: e.Layer})
+			polyline = &lists[len(lists)-1]
+		case "VERTEX":
+			if polyline != nil {
+				polyline.Points = append(polyline.Points, geoPoints(e.Vertices)...)
+			}
+		case "SEQEND":
+			if polyline != nil {
+				polyline.Points = withoutClosingPoint(polyline.Points)
+			}
+			polyline = nil
+		}
+	}
+
+	// Отдельные точки слоя образуют один список в порядке чертежа
+	for _, layer := range layers {
+		lists = append(lists, service.PointList{Name: layer, Points: points[layer]})
+	}
+
+	result := lists[:0]
+	for _, l := range lists {
+		if len(l.Points) > 0 {
+			result = append(result, l)
+		}
+	}
+	return result, nil
+}
+
+// geoPoints переводит вершины чертежа в геодезический порядок осей
+func geoPoints(vertices []dxf.Vertex) []entity.GeoPoint {
+	points := make([]entity.GeoPoint, len(vertices))
+	for i, v := range vertices {
+		points[i] = entity.GeoPoint{X: v.Y, Y: v.X}
+	}
+	return points
+}
@@ -0,0 +1,59 @@
+// Package coordinates читает списки координат границ участков и контуров зданий
+// из каталогов координат (CSV) и чертежей AutoCAD (DXF)
+package coordinates
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// Parser реализация service.CoordinateParser
+type Parser struct{}
+
+// NewParser создает парсер файлов координат
+func NewParser() *Parser {
+	return &Parser{}
+}
+
+var _ service.CoordinateParser = (*Parser)(nil)
+
+// ParsePoints читает списки точек в заданном формате
+func (p *Parser) ParsePoints(ctx context.Context, r io.Reader, format service.CoordinateFormat) ([]service.PointList, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var (
+		lists []service.PointList
+		err   error
+	)
+	switch format {
+	case service.CoordinateFormatCSV:
+		lists, err = parseCSV(r)
+	case service.CoordinateFormatDXF:
+		lists, err = parseDXF(r)
+	default:
+		return nil, fmt.Errorf("unsupported coordinate format: %s", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(lists) == 0 {
+		return nil, entity.ValidationError{Field: "source", Message: "в файле нет координат"}
+	}
+	return lists, nil
+}
+
+// withoutClosingPoint убирает последнюю точку, повторяющую первую (замкнутый контур)
+func withoutClosingPoint(points []entity.GeoPoint) []entity.GeoPoint {
+	n := len(points)
+	if n > 1 && points[0].X == points[n-1].X && points[0].Y == points[n-1].Y {
+		return points[:n-1]
+	}
+	return points
+}
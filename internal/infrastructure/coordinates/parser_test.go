@@ -0,0 +1,127 @@
+package coordinates_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/coordinates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParser_CSV(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []entity.GeoPoint
+	}{
+		{
+			name: "catalog with header and decimal comma",
+			data: "\ufeffТочка;X;Y\r\nн1;470100,15;2200200,40\r\nн2;470130,15;2200200,40\r\nн3;470130,15;2200220,40\r\nн1;470100,15;2200200,40\r\n",
+			want: []entity.GeoPoint{
+				{Name: "н1", X: 470100.15, Y: 2200200.40},
+				{Name: "н2", X: 470130.15, Y: 2200200.40},
+				{Name: "н3", X: 470130.15, Y: 2200220.40},
+			},
+		},
+		{
+			name: "comma separated without names",
+			data: "# граница\n55.7500,37.6100\n55.7510,37.6100\n55.7510,37.6115\n",
+			want: []entity.GeoPoint{
+				{X: 55.75, Y: 37.61},
+				{X: 55.751, Y: 37.61},
+				{X: 55.751, Y: 37.6115},
+			},
+		},
+		{
+			name: "tab separated",
+			data: "1\t10.5\t20\n2\t11,5\t20\n3\t11.5\t21\n",
+			want: []entity.GeoPoint{
+				{Name: "1", X: 10.5, Y: 20},
+				{Name: "2", X: 11.5, Y: 20},
+				{Name: "3", X: 11.5, Y: 21},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lists, err := coordinates.NewParser().ParsePoints(context.Background(), strings.NewReader(tt.data), service.CoordinateFormatCSV)
+			require.NoError(t, err)
+			require.Len(t, lists, 1)
+			assert.Equal(t, tt.want, lists[0].Points)
+		})
+	}
+}
+
+func TestParser_CSVErrors(t *testing.T) {
+	_, err := coordinates.NewParser().ParsePoints(context.Background(), strings.NewReader("X;Y\n1;2\n3;abc\n"), service.CoordinateFormatCSV)
+	var ve entity.ValidationError
+	require.ErrorAs(t, err, &ve)
+	assert.Equal(t, "line 3", ve.Field)
+
+	_, err = coordinates.NewParser().ParsePoints(context.Background(), strings.NewReader("X;Y\n"), service.CoordinateFormatCSV)
+	require.ErrorAs(t, err, &ve)
+	assert.Equal(t, "source", ve.Field)
+}
+
+func TestParser_DXF(t *testing.T) {
+	// Заголовок, полилиния R12 с вершинами, облегченная полилиния и точки;
+	// X чертежа - восток, Y - север
+	data := strings.Join([]string{
+		"0", "SECTION", "2", "HEADER", "9", "$ACADVER", "1", "AC1009", "0", "ENDSEC",
+		"0", "SECTION", "2", "ENTITIES",
+		"0", "POLYLINE", "8", "Граница", "66", "1", "70", "1",
+		"0", "VERTEX", "8", "Граница", "10", "100.0", "20", "500.0", "30", "0.0",
+		"0", "VERTEX", "8", "Граница", "10", "120.0", "20", "500.0", "30", "0.0",
+		"0", "VERTEX", "8", "Граница", "10", "120.0", "20", "530.0", "30", "0.0",
+		"0", "SEQEND", "8", "Граница",
+		"0", "LWPOLYLINE", "8", "Здания", "90", "4", "70", "1",
+		"10", "105.0", "20", "505.0",
+		"10", "117.0", "20", "505.0",
+		"10", "117.0", "20", "515.0",
+		"10", "105.0", "20", "505.0",
+		"0", "POINT", "8", "Пикеты", "10", "1.0", "20", "2.0",
+		"0", "POINT", "8", "Пикеты", "10", "3.0", "20", "4.0",
+		"0", "ENDSEC",
+		"0", "EOF",
+	}, "\r\n")
+
+	lists, err := coordinates.NewParser().ParsePoints(context.Background(), strings.NewReader(data), service.CoordinateFormatDXF)
+	require.NoError(t, err)
+	require.Len(t, lists, 3)
+
+	assert.Equal(t, "Граница", lists[0].Name)
+	assert.Equal(t, []entity.GeoPoint{{X: 500, Y: 100}, {X: 500, Y: 120}, {X: 530, Y: 120}}, lists[0].Points)
+
+	assert.Equal(t, "Здания", lists[1].Name)
+	assert.Len(t, lists[1].Points, 3, "замыкающая точка отбрасывается")
+
+	assert.Equal(t, "Пикеты", lists[2].Name)
+	assert.Equal(t, []entity.GeoPoint{{X: 2, Y: 1}, {X: 4, Y: 3}}, lists[2].Points)
+}
+
+func TestParser_DXFErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  string
+		field string
+	}{
+		{name: "bad group code", data: "0\nSECTION\nabc\nENTITIES\n", field: "line 3"},
+		{name: "bad coordinate", data: "0\nSECTION\n2\nENTITIES\n0\nPOINT\n10\n1,5\n", field: "line 8"},
+		{name: "unterminated section", data: "0\nSECTION\n2\nENTITIES\n0\nPOINT\n10\n1\n20\n2\n", field: "source"},
+		{name: "no entities", data: "0\nSECTION\n2\nENTITIES\n0\nENDSEC\n0\nEOF\n", field: "source"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := coordinates.NewParser().ParsePoints(context.Background(), strings.NewReader(tt.data), service.CoordinateFormatDXF)
+			var ve entity.ValidationError
+			require.ErrorAs(t, err, &ve)
+			assert.Equal(t, tt.field, ve.Field)
+		})
+	}
+}
@@ -0,0 +1,588 @@
+package document
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+)
+
+// dateLayout формат дат в документе
+const dateLayout = "02.01.2006"
+
+// objectTypeTitles наименования типов объектов для заголовка паспорта
+var objectTypeTitles = map[entity.ObjectType]string{
+	entity.ObjectTypeResidentialHouse:  "индивидуального жилого дома",
+	entity.ObjectTypeApartmentBuilding: "многоквартирного жилого дома",
+	entity.ObjectTypeApartment:         "жилого помещения (квартиры)",
+	entity.ObjectTypeRoom:              "жилого помещения (комнаты)",
+	entity.ObjectTypeNonResidential:    "нежилого помещения",
+}
+
+// content содержимое документа, общее для всех форматов
+type content struct {
+	Title    string
+	Subtitle string
+	Header   []field
+	Sections []section
+}
+
+// field строка вида "Наименование: значение"
+type field struct {
+	Name  string
+	Value string
+}
+
+// section раздел паспорта
+type section struct {
+	Title  string
+	Fields []field
+	Images []planImage
+	Table  *table
+	Note   string
+}
+
+// planImage изображение поэтажного плана (PNG)
+type planImage struct {
+	Caption string
+	Data    []byte
+
+	// Размер изображения в пикселях
+	Width, Height int
+}
+
+// table табличная часть раздела
+type table struct {
+	Columns []column
+	Rows    [][]string
+	Total   []string // итоговая строка (может отсутствовать)
+}
+
+// column колонка таблицы с относительной шириной
+type column struct {
+	Title  string
+	Weight float64
+}
+
+// buildContent формирует содержимое документа из технического паспорта
+func buildContent(p *entity.TechnicalPassport) content {
+	c := content{
+		Title:    "ТЕХНИЧЕСКИЙ ПАСПОРТ",
+		Subtitle: objectTypeTitles[p.ObjectType],
+		Header: []field{
+			{"Адрес (местоположение)", p.Address.FullAddress()},
+			{"Организация технического учета", p.OrganizationName},
+			{"Инвентарный номер", p.InventoryNumber},
+			{"Кадастровый номер", p.CadastralNumber},
+			{"Составлен по состоянию на", formatDate(p.AsOfDate)},
+		},
+	}
+
+	// Состав разделов зависит от типа объекта
+	for _, s := range p.ObjectType.Profile().Sections {
+		switch s {
+		case entity.SectionGeneralInfo:
+			c.Sections = append(c.Sections, generalInfoSection(p))
+		case entity.SectionBuildings:
+			c.Sections = append(c.Sections, buildingsSection(p))
+		case entity.SectionStructural:
+			c.Sections = append(c.Sections, structuralSections(p)...)
+		case entity.SectionOwners:
+			c.Sections = append(c.Sections, ownersSection(p))
+		case entity.SectionSituationPlan:
+			c.Sections = append(c.Sections, situationPlanSection(p))
+		case entity.SectionUtilities:
+			c.Sections = append(c.Sections, utilitiesSection(p))
+		case entity.SectionExplication:
+			c.Sections = append(c.Sections, explicationSection(p))
+		case entity.SectionFlats:
+			c.Sections = append(c.Sections, flatsSection(p))
+		case entity.SectionCommonAreas:
+			c.Sections = append(c.Sections, commonAreasSection(p))
+		case entity.SectionFireSafety:
+			c.Sections = append(c.Sections, fireSafetySection(p))
+		case entity.SectionUseCategories:
+			c.Sections = append(c.Sections, useCategoriesSection(p))
+		}
+	}
+
+	return c
+}
+
+// buildFlatExtract формирует выписку из паспорта многоквартирного дома на квартиру
+func buildFlatExtract(p *entity.TechnicalPassport, f *entity.Flat) content {
+	address := p.Address.FullAddress() + ", кв. " + f.Number
+	cadastral := f.CadastralNumber
+	if cadastral == "" {
+		cadastral = "-"
+	}
+
+	fields := []field{
+		{"Литера", f.Litera},
+		{"Подъезд", f.Entrance},
+		{"Этаж", f.Floor},
+		{"Количество жилых комнат", fmt.Sprintf("%d", f.LivingRooms())},
+		{"Общая площадь квартиры, кв.м", formatArea(f.TotalArea())},
+		{"Жилая площадь, кв.м", formatArea(f.LivingArea())},
+	}
+	if e := p.FindEntrance(f.Entrance); e != nil && e.Section != "" {
+		fields = append(fields, field{"Секция", e.Section})
+	}
+
+	return content{
+		Title:    "ВЫПИСКА ИЗ ТЕХНИЧЕСКОГО ПАСПОРТА",
+		Subtitle: objectTypeTitles[p.ObjectType] + " на квартиру № " + f.Number,
+		Header: []field{
+			{"Адрес (местоположение)", address},
+			{"Организация технического учета", p.OrganizationName},
+			{"Инвентарный номер дома", p.InventoryNumber},
+			{"Кадастровый номер квартиры", cadastral},
+			{"Составлена по состоянию на", formatDate(p.AsOfDate)},
+		},
+		Sections: []section{
+			{Title: "1. Сведения о квартире", Fields: fields},
+			{Title: explicationTitle, Table: roomsTable(f.Explication)},
+		},
+	}
+}
+
+// generalInfoSection раздел 1. Общие сведения
+func generalInfoSection(p *entity.TechnicalPassport) section {
+	g := p.GeneralInfo
+	fields := []field{
+		{"Назначение", g.Purpose},
+		{"Фактическое использование", g.ActualUsage},
+		{"Год постройки", formatYear(g.ConstructionYear)},
+		{"Общая площадь, кв.м", formatArea(g.TotalArea)},
+	}
+	if p.ObjectType.Profile().LivingArea {
+		fields = append(fields, field{"Жилая площадь, кв.м", formatArea(g.LivingArea)})
+	}
+	fields = append(fields,
+		field{"Число этажей надземной части", fmt.Sprintf("%d", g.FloorsAboveGround)},
+		field{"Число этажей подземной части", fmt.Sprintf("%d", g.FloorsUnderground)},
+		field{"Примечание", g.Note},
+	)
+
+	return section{Title: "1. Общие сведения", Fields: fields}
+}
+
+// buildingsSection раздел 2. Состав объекта
+func buildingsSection(p *entity.TechnicalPassport) section {
+	t := &table{
+		Columns: []column{
+			{"Литера", 1}, {"Наименование", 3}, {"Год ввода", 1.2}, {"Материал стен", 2},
+			{"Общая площадь, кв.м", 1.5}, {"Площадь застройки, кв.м", 1.5},
+			{"Высота, м", 1}, {"Объем, куб.м", 1.2}, {"Инв. стоимость, руб.", 1.6},
+		},
+	}
+
+	for _, b := range p.Buildings {
+		t.Rows = append(t.Rows, []string{
+			b.Litera, b.Name, formatYear(b.CommissionYear), b.WallMaterial,
+			formatArea(b.TotalArea), formatArea(b.BuildArea),
+			formatArea(b.Height), formatArea(b.Volume), formatMoney(b.InventoryValue),
+		})
+	}
+
+	// Сооружения: характеристики вида выводятся в графе наименования
+	for _, st := range p.Structures {
+		t.Rows = append(t.Rows, []string{
+			st.Litera, structureName(st), formatYear(st.CommissionYear), st.Material,
+			"-", formatArea(st.Area), formatArea(st.Height), formatArea(st.Capacity), formatMoney(st.InventoryValue),
+		})
+	}
+
+	if len(p.Buildings) > 0 || len(p.Structures) > 0 {
+		t.Total = []string{"Итого", "", "", "", formatArea(p.CalculateTotalArea()), "", "", "", formatMoney(p.CalculateTotalInventoryValue())}
+	}
+
+	return section{Title: "2. Состав объекта", Table: t}
+}
+
+// structureName наименование сооружения с характеристиками его вида
+func structureName(st entity.Structure) string {
+	var details []string
+	if st.Length > 0 {
+		details = append(details, "протяженность "+formatArea(st.Length)+" м")
+	}
+	if st.Width > 0 {
+		details = append(details, "ширина "+formatArea(st.Width)+" м")
+	}
+	if st.Diameter > 0 {
+		details = append(details, "диаметр "+formatPercent(st.Diameter)+" мм")
+	}
+	if st.Depth > 0 {
+		details = append(details, "глубина "+formatArea(st.Depth)+" м")
+	}
+
+	name := st.Name + " (" + strings.ToLower(st.Kind.Title()) + ")"
+	if len(details) > 0 {
+		name += ": " + strings.Join(details, ", ")
+	}
+	return name
+}
+
+// structuralSections описание конструктивных элементов и износа зданий,
+// для которых элементы описаны (подразделы раздела 2)
+func structuralSections(p *entity.TechnicalPassport) []section {
+	var sections []section
+	for _, b := range p.Buildings {
+		if len(b.StructuralElements) == 0 {
+			continue
+		}
+
+		weights := map[entity.StructuralElementKind]float64{}
+		var totalWeight float64
+		if b.WearAssessment != nil {
+			for _, share := range b.WearAssessment.Elements {
+				weights[share.Kind] = share.Weight
+				totalWeight += share.Weight
+			}
+		}
+
+		t := &table{
+			Columns: []column{
+				{"Конструктивный элемент", 2}, {"Описание", 4}, {"Техническое состояние", 2},
+				{"Уд. вес, %", 1}, {"Износ, %", 1},
+			},
+		}
+		for _, e := range b.StructuralElements {
+			condition := e.Condition
+			if condition == "" {
+				condition = entity.ConditionForWear(e.Wear)
+			}
+			t.Rows = append(t.Rows, []string{
+				e.Kind.Title(), e.Description, condition.Title(), formatArea(weights[e.Kind]), formatPercent(e.Wear),
+			})
+		}
+
+		if b.WearAssessment != nil {
+			t.Total = []string{"Физический износ здания", "", "", formatArea(totalWeight), formatPercent(b.WearAssessment.Wear)}
+		}
+		sections = append(sections, section{
+			Title: fmt.Sprintf("2.%d. Описание конструктивных элементов литеры %s", len(sections)+1, b.Litera),
+			Table: t,
+		})
+	}
+	return sections
+}
+
+// ownersSection раздел 3. Сведения о правообладателях
+func ownersSection(p *entity.TechnicalPassport) section {
+	t := &table{
+		Columns: []column{
+			{"Дата записи", 1.2}, {"Правообладатель", 3}, {"ИНН", 1.4},
+			{"Вид права", 1.4}, {"Документ", 3}, {"Доля", 0.8},
+		},
+	}
+
+	for _, o := range p.Owners {
+		t.Rows = append(t.Rows, []string{
+			formatDate(o.EntryDate), ownerName(o), o.TIN, o.RightType, o.RightDocument, o.Share,
+		})
+	}
+
+	return section{Title: "3. Сведения о правообладателях", Table: t}
+}
+
+// coordinateSystemTitles наименования систем координат ситуационного плана
+var coordinateSystemTitles = map[entity.CoordinateSystem]string{
+	entity.CoordinateMSK:   "местная система координат",
+	entity.CoordinateWGS84: "WGS 84",
+}
+
+// situationPlanTitle заголовок раздела 4; к нему добавляется изображение плана
+const situationPlanTitle = "4. Ситуационный план"
+
+// situationPlanSection раздел 4. Ситуационный план
+func situationPlanSection(p *entity.TechnicalPassport) section {
+	s := section{Title: situationPlanTitle}
+	if p.SituationPlanPath != "" {
+		s.Note = "Ситуационный план: " + p.SituationPlanPath
+	}
+
+	plan := p.SituationPlan
+	if plan == nil {
+		if s.Note == "" {
+			s.Note = "Ситуационный план не приложен"
+		}
+		return s
+	}
+
+	system := coordinateSystemTitles[plan.CoordinateSystem]
+	if plan.Zone != "" {
+		system += " (" + plan.Zone + ")"
+	}
+	s.Fields = []field{{"Система координат", system}}
+	if len(plan.Boundary) == 0 {
+		return s
+	}
+	s.Fields = append(s.Fields, field{"Площадь земельного участка, кв.м", fmt.Sprintf("%.0f", plan.PlotArea())})
+
+	// Каталог координат границы участка
+	format := "%.2f"
+	if plan.CoordinateSystem == entity.CoordinateWGS84 {
+		format = "%.7f"
+	}
+	t := &table{Columns: []column{{"Точка", 1}, {"X", 2}, {"Y", 2}}}
+	for i, pt := range plan.Boundary {
+		name := pt.Name
+		if name == "" {
+			name = fmt.Sprintf("н%d", i+1)
+		}
+		t.Rows = append(t.Rows, []string{
+			name,
+			strings.Replace(fmt.Sprintf(format, pt.X), ".", ",", 1),
+			strings.Replace(fmt.Sprintf(format, pt.Y), ".", ",", 1),
+		})
+	}
+	s.Table = t
+
+	return s
+}
+
+// utilitiesSection раздел 5. Благоустройство
+func utilitiesSection(p *entity.TechnicalPassport) section {
+	u := p.Utilities
+	t := &table{
+		Columns: []column{{"Вид благоустройства", 3}, {"Централизованное, кв.м", 2}, {"Автономное, кв.м", 2}},
+		Rows: [][]string{
+			utilityRow("Водопровод", u.Water),
+			utilityRow("Канализация", u.Sewerage),
+			utilityRow("Отопление", u.Heating),
+			utilityRow("Горячее водоснабжение", u.HotWater),
+			utilityRow("Газоснабжение", u.Gas),
+			utilityRow("Электроснабжение", u.Electricity),
+		},
+	}
+
+	return section{Title: "5. Благоустройство", Table: t, Note: u.Other}
+}
+
+// explicationTitle заголовок разделов 6-7; к нему добавляются изображения планов
+const explicationTitle = "6-7. Экспликация к поэтажному плану"
+
+// explicationSection разделы 6-7. Экспликация к поэтажному плану
+// Для нежилых объектов вместо жилой площади выводятся категория и основная площадь
+func explicationSection(p *entity.TechnicalPassport) section {
+	if !p.ObjectType.Profile().LivingArea {
+		return section{Title: explicationTitle, Table: commercialRoomsTable(p.Explication)}
+	}
+	return section{Title: explicationTitle, Table: roomsTable(p.Explication)}
+}
+
+// flatsSection раздел 8. Квартиры многоквартирного дома с итогами по подъездам
+func flatsSection(p *entity.TechnicalPassport) section {
+	t := &table{
+		Columns: []column{
+			{"№ кв.", 1}, {"Литера", 1}, {"Подъезд", 1}, {"Этаж", 1},
+			{"Жилых комнат", 1.2}, {"Общая площадь, кв.м", 1.6}, {"Жилая, кв.м", 1.4},
+		},
+	}
+
+	for i := range p.Flats {
+		f := &p.Flats[i]
+		t.Rows = append(t.Rows, []string{
+			f.Number, f.Litera, f.Entrance, f.Floor,
+			fmt.Sprintf("%d", f.LivingRooms()), formatArea(f.TotalArea()), formatArea(f.LivingArea()),
+		})
+	}
+
+	totals := p.ApartmentTotals()
+	if len(p.Flats) > 0 {
+		t.Total = []string{"Итого", "", "", "", fmt.Sprintf("%d", totals.LivingRooms), formatArea(totals.FlatsArea), formatArea(totals.LivingArea)}
+	}
+
+	fields := []field{
+		{"Количество подъездов", fmt.Sprintf("%d", len(p.Entrances))},
+		{"Количество квартир", fmt.Sprintf("%d", totals.Flats)},
+	}
+	for _, e := range totals.Entrances {
+		fields = append(fields, field{
+			"Подъезд " + e.Entrance,
+			fmt.Sprintf("квартир %d, общая площадь %s кв.м, жилая %s кв.м", e.Flats, formatArea(e.FlatsArea), formatArea(e.LivingArea)),
+		})
+	}
+	fields = append(fields,
+		field{"Общая площадь квартир, кв.м", formatArea(totals.FlatsArea)},
+		field{"Жилая площадь квартир, кв.м", formatArea(totals.LivingArea)},
+		field{"Площадь общего имущества, кв.м", formatArea(totals.CommonArea)},
+		field{"Общая площадь дома, кв.м", formatArea(totals.TotalArea)},
+	)
+
+	return section{Title: "8. Подъезды и квартиры", Fields: fields, Table: t}
+}
+
+// commonAreasSection раздел 9. Помещения общего имущества
+func commonAreasSection(p *entity.TechnicalPassport) section {
+	return section{Title: "9. Помещения общего имущества", Table: roomsTable(p.CommonAreas)}
+}
+
+// roomsTable таблица экспликации помещений с итоговой строкой
+func roomsTable(rooms []entity.Room) *table {
+	t := &table{
+		Columns: []column{
+			{"Литера", 1}, {"Этаж", 1}, {"№ пом.", 1}, {"Назначение", 3},
+			{"Площадь, кв.м", 1.4}, {"Жилая, кв.м", 1.4}, {"Вспомог., кв.м", 1.4}, {"Высота, м", 1.1},
+		},
+	}
+
+	var area, living, auxiliary float64
+	for _, r := range rooms {
+		t.Rows = append(t.Rows, []string{
+			r.Litera, r.Floor, r.RoomNumber, r.Purpose,
+			formatArea(r.Area), formatArea(r.LivingArea), formatArea(r.AuxiliaryArea), formatArea(r.Height),
+		})
+		area += r.Area
+		living += r.LivingArea
+		auxiliary += r.AuxiliaryArea
+	}
+
+	if len(rooms) > 0 {
+		t.Total = []string{"Итого", "", "", "", formatArea(area), formatArea(living), formatArea(auxiliary), ""}
+	}
+
+	return t
+}
+
+// commercialRoomsTable таблица экспликации нежилых помещений с основной
+// и вспомогательной площадью
+func commercialRoomsTable(rooms []entity.Room) *table {
+	t := &table{
+		Columns: []column{
+			{"Литера", 1}, {"Этаж", 1}, {"№ пом.", 1}, {"Назначение", 2.6}, {"Категория", 1.8},
+			{"Площадь, кв.м", 1.3}, {"Основная, кв.м", 1.3}, {"Вспомог., кв.м", 1.3}, {"Высота, м", 1},
+		},
+	}
+
+	var area, main, auxiliary float64
+	for i := range rooms {
+		r := &rooms[i]
+		category := ""
+		if r.UseCategory != "" {
+			category = r.UseCategory.Title()
+		}
+		t.Rows = append(t.Rows, []string{
+			r.Litera, r.Floor, r.RoomNumber, r.Purpose, category,
+			formatArea(r.Area), formatArea(r.MainArea()), formatArea(r.Area - r.MainArea()), formatArea(r.Height),
+		})
+		area += r.Area
+		main += r.MainArea()
+		auxiliary += r.Area - r.MainArea()
+	}
+
+	if len(rooms) > 0 {
+		t.Total = []string{"Итого", "", "", "", "", formatArea(area), formatArea(main), formatArea(auxiliary), ""}
+	}
+
+	return t
+}
+
+// fireSafetySection раздел 8. Пожарная безопасность нежилого объекта
+func fireSafetySection(p *entity.TechnicalPassport) section {
+	info := p.Commercial
+	if info == nil {
+		info = &entity.CommercialInfo{}
+	}
+
+	separate := "нет"
+	if info.SeparateEntrance {
+		separate = "да"
+	}
+
+	return section{
+		Title: "8. Пожарная безопасность",
+		Fields: []field{
+			{"Класс функциональной пожарной опасности", info.FireHazardClass},
+			{"Категория по взрывопожарной и пожарной опасности", info.ExplosionCategory},
+			{"Степень огнестойкости", info.FireResistance},
+			{"Количество эвакуационных выходов", fmt.Sprintf("%d", info.EvacuationExits)},
+			{"Отдельный вход", separate},
+			{"Примечание", info.Note},
+		},
+	}
+}
+
+// useCategoriesSection раздел 9. Площади по категориям использования
+func useCategoriesSection(p *entity.TechnicalPassport) section {
+	t := &table{
+		Columns: []column{
+			{"Категория использования", 3}, {"Помещений", 1.2},
+			{"Основная, кв.м", 1.6}, {"Вспомог., кв.м", 1.6}, {"Всего, кв.м", 1.6},
+		},
+	}
+
+	var rooms int
+	var main, auxiliary, total float64
+	for _, c := range p.UseCategoryTotals() {
+		t.Rows = append(t.Rows, []string{
+			c.Category.Title(), fmt.Sprintf("%d", c.Rooms),
+			formatArea(c.MainArea), formatArea(c.AuxiliaryArea), formatArea(c.TotalArea),
+		})
+		rooms += c.Rooms
+		main += c.MainArea
+		auxiliary += c.AuxiliaryArea
+		total += c.TotalArea
+	}
+
+	if rooms > 0 {
+		t.Total = []string{"Итого", fmt.Sprintf("%d", rooms), formatArea(main), formatArea(auxiliary), formatArea(total)}
+	}
+
+	return section{Title: "9. Площади по категориям использования", Table: t}
+}
+
+// utilityRow строка таблицы благоустройства
+func utilityRow(name string, c entity.UtilityConnection) []string {
+	return []string{name, formatArea(c.Centralized), formatArea(c.Autonomous)}
+}
+
+// ownerName возвращает наименование правообладателя
+func ownerName(o entity.Owner) string {
+	if o.PersonType == entity.PersonTypeLegal {
+		return o.CompanyName
+	}
+	if o.PassportData != "" {
+		return o.FullName + " (" + o.PassportData + ")"
+	}
+	return o.FullName
+}
+
+// formatArea форматирует площадь (или иную величину) с точностью 0.1
+func formatArea(v float64) string {
+	if v == 0 {
+		return "-"
+	}
+	return strings.Replace(fmt.Sprintf("%.1f", v), ".", ",", 1)
+}
+
+// formatPercent форматирует процент износа
+func formatPercent(v float64) string {
+	return strings.Replace(fmt.Sprintf("%g", v), ".", ",", 1)
+}
+
+// formatMoney форматирует денежную сумму
+func formatMoney(v float64) string {
+	if v == 0 {
+		return "-"
+	}
+	return strings.Replace(fmt.Sprintf("%.2f", v), ".", ",", 1)
+}
+
+// formatYear форматирует год
+func formatYear(y int) string {
+	if y == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d", y)
+}
+
+// formatDate форматирует дату
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Format(dateLayout)
+}
@@ -0,0 +1,220 @@
+package document
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// docxTableWidth ширина таблиц в twips (альбомный A4 за вычетом полей)
+const docxTableWidth = 14570
+
+// Размеры изображений в EMU: планы в масштабе 1:100 (0,2 мм на пиксель),
+// не шире страницы и не выше 15 см
+const (
+	docxEMUPerPixel    = 7200
+	docxImageMaxWidth  = docxTableWidth * 635
+	docxImageMaxHeight = 5400000
+)
+
+// Служебные части пакета Office Open XML
+const (
+	docxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+<Default Extension="xml" ContentType="application/xml"/>
+<Default Extension="png" ContentType="image/png"/>
+<Override PartName="/word/document.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml"/>
+</Types>`
+
+	docxRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="word/document.xml"/>
+</Relationships>`
+
+	docxDocumentRelsStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`
+
+	docxDocumentStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"` +
+		` xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"` +
+		` xmlns:wp="http://schemas.openxmlformats.org/drawingml/2006/wordprocessingDrawing"` +
+		` xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main"` +
+		` xmlns:pic="http://schemas.openxmlformats.org/drawingml/2006/picture"><w:body>`
+
+	// Альбомный A4, поля 1.5 см
+	docxDocumentEnd = `<w:sectPr><w:pgSz w:w="16838" w:h="11906" w:orient="landscape"/>` +
+		`<w:pgMar w:top="850" w:right="850" w:bottom="850" w:left="850" w:header="708" w:footer="708" w:gutter="0"/></w:sectPr>` +
+		`</w:body></w:document>`
+)
+
+// renderDOCX формирует документ Word (Office Open XML)
+func (g *Generator) renderDOCX(c content) ([]byte, error) {
+	var body strings.Builder
+	body.WriteString(docxDocumentStart)
+
+	docxParagraph(&body, c.Title, 32, true, "center")
+	if c.Subtitle != "" {
+		docxParagraph(&body, c.Subtitle, 24, false, "center")
+	}
+	docxFields(&body, c.Header)
+
+	type media struct {
+		name string
+		data []byte
+	}
+	var images []media
+	var rels strings.Builder
+	rels.WriteString(docxDocumentRelsStart)
+
+	for _, s := range c.Sections {
+		docxParagraph(&body, s.Title, 22, true, "left")
+		docxFields(&body, s.Fields)
+		for _, img := range s.Images {
+			n := len(images) + 1
+			name := fmt.Sprintf("media/plan%d.png", n)
+			images = append(images, media{name: "word/" + name, data: img.Data})
+			fmt.Fprintf(&rels, `<Relationship Id="rIdImage%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/image" Target="%s"/>`, n, name)
+
+			docxImage(&body, n, img)
+			docxParagraph(&body, img.Caption, 18, false, "center")
+		}
+		if s.Table != nil {
+			docxTable(&body, s.Table)
+		}
+		if s.Note != "" {
+			docxParagraph(&body, s.Note, 18, false, "left")
+		}
+	}
+
+	body.WriteString(docxDocumentEnd)
+	rels.WriteString(`</Relationships>`)
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	parts := []media{
+		{"[Content_Types].xml", []byte(docxContentTypes)},
+		{"_rels/.rels", []byte(docxRels)},
+		{"word/document.xml", []byte(body.String())},
+		{"word/_rels/document.xml.rels", []byte(rels.String())},
+	}
+	for _, part := range append(parts, images...) {
+		w, err := zw.Create(part.name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create %s: %w", part.name, err)
+		}
+		if _, err := w.Write(part.data); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", part.name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write docx: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// docxImage выводит изображение плана отдельным абзацем по центру
+func docxImage(b *strings.Builder, n int, img planImage) {
+	cx := img.Width * docxEMUPerPixel
+	cy := img.Height * docxEMUPerPixel
+	if cx > docxImageMaxWidth {
+		cx, cy = docxImageMaxWidth, cy*docxImageMaxWidth/cx
+	}
+	if cy > docxImageMaxHeight {
+		cx, cy = cx*docxImageMaxHeight/cy, docxImageMaxHeight
+	}
+
+	b.WriteString(`<w:p><w:pPr><w:jc w:val="center"/></w:pPr><w:r><w:drawing>`)
+	fmt.Fprintf(b, `<wp:inline><wp:extent cx="%d" cy="%d"/><wp:docPr id="%d" name="plan%d.png"/>`, cx, cy, n, n)
+	b.WriteString(`<a:graphic><a:graphicData uri="http://schemas.openxmlformats.org/drawingml/2006/picture"><pic:pic>`)
+	fmt.Fprintf(b, `<pic:nvPicPr><pic:cNvPr id="%d" name="plan%d.png"/><pic:cNvPicPr/></pic:nvPicPr>`, n, n)
+	fmt.Fprintf(b, `<pic:blipFill><a:blip r:embed="rIdImage%d"/><a:stretch><a:fillRect/></a:stretch></pic:blipFill>`, n)
+	fmt.Fprintf(b, `<pic:spPr><a:xfrm><a:off x="0" y="0"/><a:ext cx="%d" cy="%d"/></a:xfrm><a:prstGeom prst="rect"><a:avLst/></a:prstGeom></pic:spPr>`, cx, cy)
+	b.WriteString(`</pic:pic></a:graphicData></a:graphic></wp:inline></w:drawing></w:r></w:p>`)
+}
+
+// docxFields выводит строки "Наименование: значение"
+func docxFields(b *strings.Builder, fields []field) {
+	for _, f := range fields {
+		value := f.Value
+		if value == "" {
+			value = "-"
+		}
+		b.WriteString(`<w:p>`)
+		docxRun(b, f.Name+": ", 18, true)
+		docxRun(b, value, 18, false)
+		b.WriteString(`</w:p>`)
+	}
+}
+
+// docxParagraph выводит абзац с заданным размером шрифта (в полупунктах)
+func docxParagraph(b *strings.Builder, text string, size int, bold bool, align string) {
+	fmt.Fprintf(b, `<w:p><w:pPr><w:jc w:val="%s"/></w:pPr>`, align)
+	docxRun(b, text, size, bold)
+	b.WriteString(`</w:p>`)
+}
+
+// docxRun выводит фрагмент текста
+func docxRun(b *strings.Builder, text string, size int, bold bool) {
+	b.WriteString(`<w:r><w:rPr>`)
+	if bold {
+		b.WriteString(`<w:b/>`)
+	}
+	fmt.Fprintf(b, `<w:sz w:val="%d"/></w:rPr><w:t xml:space="preserve">`, size)
+	xml.EscapeText(b, []byte(text))
+	b.WriteString(`</w:t></w:r>`)
+}
+
+// docxTable выводит таблицу с границами
+func docxTable(b *strings.Builder, t *table) {
+	totalWeight := 0.0
+	for _, col := range t.Columns {
+		totalWeight += col.Weight
+	}
+
+	widths := make([]int, len(t.Columns))
+	header := make([]string, len(t.Columns))
+	for i, col := range t.Columns {
+		widths[i] = int(docxTableWidth * col.Weight / totalWeight)
+		header[i] = col.Title
+	}
+
+	b.WriteString(`<w:tbl><w:tblPr><w:tblW w:w="0" w:type="auto"/><w:tblBorders>`)
+	for _, side := range []string{"top", "left", "bottom", "right", "insideH", "insideV"} {
+		fmt.Fprintf(b, `<w:%s w:val="single" w:sz="4" w:space="0" w:color="000000"/>`, side)
+	}
+	b.WriteString(`</w:tblBorders></w:tblPr><w:tblGrid>`)
+	for _, w := range widths {
+		fmt.Fprintf(b, `<w:gridCol w:w="%d"/>`, w)
+	}
+	b.WriteString(`</w:tblGrid>`)
+
+	docxRow(b, widths, header, true)
+	if len(t.Rows) == 0 {
+		fmt.Fprintf(b, `<w:tr><w:tc><w:tcPr><w:gridSpan w:val="%d"/></w:tcPr><w:p><w:pPr><w:jc w:val="center"/></w:pPr>`, len(widths))
+		docxRun(b, "Сведения отсутствуют", 18, false)
+		b.WriteString(`</w:p></w:tc></w:tr>`)
+	}
+	for _, row := range t.Rows {
+		docxRow(b, widths, row, false)
+	}
+	if t.Total != nil {
+		docxRow(b, widths, t.Total, true)
+	}
+
+	b.WriteString(`</w:tbl>`)
+}
+
+// docxRow выводит строку таблицы
+func docxRow(b *strings.Builder, widths []int, cells []string, bold bool) {
+	b.WriteString(`<w:tr>`)
+	for i, text := range cells {
+		fmt.Fprintf(b, `<w:tc><w:tcPr><w:tcW w:w="%d" w:type="dxa"/></w:tcPr><w:p>`, widths[i])
+		docxRun(b, text, 18, bold)
+		b.WriteString(`</w:p></w:tc>`)
+	}
+	b.WriteString(`</w:tr>`)
+}
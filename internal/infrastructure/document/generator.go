@@ -0,0 +1,152 @@
+package document
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image/png"
+	"os"
+
+	"fyne.io/fyne/v2/theme"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/floorplan"
+)
+
+// Generator реализация DocumentGenerator для форматов PDF и DOCX
+type Generator struct {
+	fontRegular []byte
+	fontBold    []byte
+	plans       service.FloorPlanRenderer
+	situation   service.SituationPlanRenderer
+}
+
+// NewGenerator создает генератор документов
+// Для PDF используется шрифт Noto Sans из поставки Fyne (содержит кириллицу)
+func NewGenerator() *Generator {
+	renderer := floorplan.NewRenderer()
+	return &Generator{
+		fontRegular: theme.DefaultTextFont().Content(),
+		fontBold:    theme.DefaultTextBoldFont().Content(),
+		plans:       renderer,
+		situation:   renderer,
+	}
+}
+
+// Generate генерирует документ из технического паспорта
+func (g *Generator) Generate(ctx context.Context, passport *entity.TechnicalPassport, options service.GenerateOptions) ([]byte, error) {
+	c := buildContent(passport)
+	var flat *entity.Flat
+	if options.Flat != "" {
+		if flat = passport.FindFlat(options.Flat); flat == nil {
+			return nil, fmt.Errorf("flat %s not found", options.Flat)
+		}
+		c = buildFlatExtract(passport, flat)
+	}
+
+	if options.IncludeImages {
+		if err := g.addFloorPlans(ctx, &c, passport, flat); err != nil {
+			return nil, err
+		}
+		// В выписку на квартиру ситуационный план не входит
+		if flat == nil {
+			if err := g.addSituationPlan(ctx, &c, passport); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	switch options.Format {
+	case service.FormatPDF:
+		return g.renderPDF(c)
+	case service.FormatDOCX:
+		return g.renderDOCX(c)
+	default:
+		return nil, fmt.Errorf("unsupported document format: %q", options.Format)
+	}
+}
+
+// SaveToFile генерирует документ и сохраняет его в файл
+func (g *Generator) SaveToFile(ctx context.Context, passport *entity.TechnicalPassport, outputPath string, options service.GenerateOptions) error {
+	data, err := g.Generate(ctx, passport, options)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(outputPath, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write document: %w", err)
+	}
+
+	return nil
+}
+
+// addFloorPlans добавляет изображения векторных поэтажных планов в раздел экспликации;
+// в выписку на квартиру входит только план этажа квартиры
+func (g *Generator) addFloorPlans(ctx context.Context, c *content, passport *entity.TechnicalPassport, flat *entity.Flat) error {
+	var images []planImage
+	for i := range passport.FloorPlans {
+		plan := &passport.FloorPlans[i]
+		// Отсканированные планы без векторных данных в документ не встраиваются
+		if len(plan.Walls) == 0 && len(plan.Rooms) == 0 {
+			continue
+		}
+		if flat != nil && !plan.Matches(flat.Litera, flat.Floor) {
+			continue
+		}
+
+		data, err := g.plans.Render(ctx, plan, service.PlanFormatPNG)
+		if err != nil {
+			return fmt.Errorf("failed to render floor plan: %w", err)
+		}
+		cfg, err := png.DecodeConfig(bytes.NewReader(data))
+		if err != nil {
+			return fmt.Errorf("failed to read floor plan image: %w", err)
+		}
+
+		images = append(images, planImage{
+			Caption: fmt.Sprintf("Поэтажный план: литера %s, этаж %s", plan.Litera, plan.Floor),
+			Data:    data,
+			Width:   cfg.Width,
+			Height:  cfg.Height,
+		})
+	}
+	if len(images) == 0 {
+		return nil
+	}
+
+	for i := range c.Sections {
+		if c.Sections[i].Title == explicationTitle {
+			c.Sections[i].Images = images
+		}
+	}
+	return nil
+}
+
+// addSituationPlan добавляет изображение ситуационного плана в раздел 4
+func (g *Generator) addSituationPlan(ctx context.Context, c *content, passport *entity.TechnicalPassport) error {
+	if passport.SituationPlan == nil {
+		return nil
+	}
+
+	data, err := g.situation.RenderSituationPlan(ctx, passport.SituationPlan, service.PlanFormatPNG)
+	if err != nil {
+		return fmt.Errorf("failed to render situation plan: %w", err)
+	}
+	cfg, err := png.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to read situation plan image: %w", err)
+	}
+
+	for i := range c.Sections {
+		if c.Sections[i].Title == situationPlanTitle {
+			c.Sections[i].Images = []planImage{{
+				Caption: "Ситуационный план",
+				Data:    data,
+				Width:   cfg.Width,
+				Height:  cfg.Height,
+			}}
+		}
+	}
+	return nil
+}
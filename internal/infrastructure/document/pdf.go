@@ -0,0 +1,192 @@
+package document
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+const (
+	pdfFontFamily = "main"
+	pdfMargin     = 15.0
+	pdfLineHeight = 5.0
+	pdfFontSize   = 9.0
+
+	// Масштаб планов 1:100 при 50 пикселях на метр
+	pdfPlanMMPerPixel = 0.2
+	pdfPlanMaxHeight  = 150.0
+)
+
+// renderPDF формирует PDF документ (A4, альбомная ориентация)
+func (g *Generator) renderPDF(c content) ([]byte, error) {
+	pdf := gofpdf.New("L", "mm", "A4", "")
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(true, pdfMargin)
+
+	// Кириллица требует UTF-8 шрифта с соответствующими глифами
+	pdf.AddUTF8FontFromBytes(pdfFontFamily, "", g.fontRegular)
+	pdf.AddUTF8FontFromBytes(pdfFontFamily, "B", g.fontBold)
+
+	pdf.AddPage()
+
+	// Титульная часть
+	pdf.SetFont(pdfFontFamily, "B", 16)
+	pdf.CellFormat(0, 9, c.Title, "", 1, "C", false, 0, "")
+	if c.Subtitle != "" {
+		pdf.SetFont(pdfFontFamily, "", 12)
+		pdf.CellFormat(0, 7, c.Subtitle, "", 1, "C", false, 0, "")
+	}
+	pdf.Ln(4)
+	pdfFields(pdf, c.Header)
+
+	for _, s := range c.Sections {
+		pdf.Ln(4)
+		pdf.SetFont(pdfFontFamily, "B", 11)
+		pdf.CellFormat(0, 7, s.Title, "", 1, "L", false, 0, "")
+
+		pdfFields(pdf, s.Fields)
+		for i, img := range s.Images {
+			pdfImage(pdf, fmt.Sprintf("%s-%d", s.Title, i), img)
+		}
+		if s.Table != nil {
+			pdfTable(pdf, s.Table)
+		}
+		if s.Note != "" {
+			pdf.SetFont(pdfFontFamily, "", pdfFontSize)
+			pdf.MultiCell(0, pdfLineHeight, s.Note, "", "L", false)
+		}
+	}
+
+	if err := pdf.Error(); err != nil {
+		return nil, fmt.Errorf("failed to render pdf: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("failed to write pdf: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// pdfImage выводит изображение плана с подписью, уменьшая его по размеру страницы
+func pdfImage(pdf *gofpdf.Fpdf, name string, img planImage) {
+	pageWidth, pageHeight := pdf.GetPageSize()
+	available := pageWidth - 2*pdfMargin
+
+	w := float64(img.Width) * pdfPlanMMPerPixel
+	h := float64(img.Height) * pdfPlanMMPerPixel
+	if w > available {
+		w, h = available, h*available/w
+	}
+	if h > pdfPlanMaxHeight {
+		w, h = w*pdfPlanMaxHeight/h, pdfPlanMaxHeight
+	}
+
+	// План переносится на новую страницу целиком вместе с подписью
+	if pdf.GetY()+h+pdfLineHeight > pageHeight-pdfMargin {
+		pdf.AddPage()
+	}
+
+	options := gofpdf.ImageOptions{ImageType: "PNG"}
+	pdf.RegisterImageOptionsReader(name, options, bytes.NewReader(img.Data))
+	pdf.ImageOptions(name, pdfMargin+(available-w)/2, pdf.GetY(), w, h, true, options, 0, "")
+
+	pdf.SetFont(pdfFontFamily, "", pdfFontSize)
+	pdf.CellFormat(0, pdfLineHeight, img.Caption, "", 1, "C", false, 0, "")
+	pdf.Ln(2)
+}
+
+// pdfFields выводит строки "Наименование: значение"
+func pdfFields(pdf *gofpdf.Fpdf, fields []field) {
+	for _, f := range fields {
+		value := f.Value
+		if value == "" {
+			value = "-"
+		}
+
+		pdf.SetFont(pdfFontFamily, "B", pdfFontSize)
+		pdf.CellFormat(75, pdfLineHeight, f.Name+":", "", 0, "L", false, 0, "")
+		pdf.SetFont(pdfFontFamily, "", pdfFontSize)
+		pdf.MultiCell(0, pdfLineHeight, value, "", "L", false)
+	}
+}
+
+// pdfTable выводит таблицу с переносом текста в ячейках
+func pdfTable(pdf *gofpdf.Fpdf, t *table) {
+	pageWidth, _ := pdf.GetPageSize()
+	available := pageWidth - 2*pdfMargin
+
+	totalWeight := 0.0
+	for _, col := range t.Columns {
+		totalWeight += col.Weight
+	}
+
+	widths := make([]float64, len(t.Columns))
+	header := make([]string, len(t.Columns))
+	for i, col := range t.Columns {
+		widths[i] = available * col.Weight / totalWeight
+		header[i] = col.Title
+	}
+
+	pdf.SetFont(pdfFontFamily, "B", pdfFontSize-1)
+	pdfRow(pdf, widths, header, true)
+
+	pdf.SetFont(pdfFontFamily, "", pdfFontSize)
+	if len(t.Rows) == 0 {
+		pdf.CellFormat(available, pdfLineHeight+1, "Сведения отсутствуют", "1", 1, "C", false, 0, "")
+	}
+	for _, row := range t.Rows {
+		pdfRow(pdf, widths, row, false)
+	}
+
+	if t.Total != nil {
+		pdf.SetFont(pdfFontFamily, "B", pdfFontSize)
+		pdfRow(pdf, widths, t.Total, false)
+	}
+}
+
+// pdfRow выводит строку таблицы; высота строки подбирается по самой длинной ячейке
+func pdfRow(pdf *gofpdf.Fpdf, widths []float64, cells []string, header bool) {
+	lines := make([][]string, len(cells))
+	maxLines := 1
+	for i, text := range cells {
+		lines[i] = splitText(pdf, text, widths[i]-2)
+		if len(lines[i]) > maxLines {
+			maxLines = len(lines[i])
+		}
+	}
+	height := float64(maxLines)*pdfLineHeight + 1
+
+	// Перенос строки таблицы целиком на новую страницу
+	_, pageHeight := pdf.GetPageSize()
+	if pdf.GetY()+height > pageHeight-pdfMargin {
+		pdf.AddPage()
+	}
+
+	align := "L"
+	if header {
+		align = "C"
+	}
+
+	x, y := pdf.GetXY()
+	for i := range cells {
+		pdf.Rect(x, y, widths[i], height, "D")
+		for j, line := range lines[i] {
+			pdf.SetXY(x+1, y+0.5+float64(j)*pdfLineHeight)
+			pdf.CellFormat(widths[i]-2, pdfLineHeight, line, "", 0, align, false, 0, "")
+		}
+		x += widths[i]
+	}
+	pdf.SetXY(pdfMargin, y+height)
+}
+
+// splitText разбивает текст на строки по ширине ячейки
+func splitText(pdf *gofpdf.Fpdf, text string, width float64) []string {
+	if text == "" {
+		return []string{""}
+	}
+
+	return pdf.SplitText(text, width)
+}
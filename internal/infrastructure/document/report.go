@@ -0,0 +1,116 @@
+package document
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+var _ service.ReportGenerator = (*Generator)(nil)
+
+// GenerateReport формирует документ отчета по реестру паспортов
+func (g *Generator) GenerateReport(ctx context.Context, report *service.RegistryReport, format service.DocumentFormat) ([]byte, error) {
+	c := buildReportContent(report)
+
+	switch format {
+	case service.FormatPDF:
+		return g.renderPDF(c)
+	case service.FormatDOCX:
+		return g.renderDOCX(c)
+	default:
+		return nil, fmt.Errorf("unsupported report format: %q", format)
+	}
+}
+
+// buildReportContent формирует содержимое отчета: итоги и таблицы разбивок
+func buildReportContent(r *service.RegistryReport) content {
+	total := r.Total
+	return content{
+		Title:    "ОТЧЕТ ПО РЕЕСТРУ ТЕХНИЧЕСКИХ ПАСПОРТОВ",
+		Subtitle: r.PeriodTitle(),
+		Header: []field{
+			{"Составлено паспортов", fmt.Sprintf("%d", total.Count)},
+			{"Выдано паспортов", fmt.Sprintf("%d", total.Issued)},
+			{"Общая площадь, кв.м", formatArea(total.TotalArea)},
+			{"Инвентаризационная стоимость, руб", formatMoney(total.InventoryValue)},
+			{"Средний год постройки", formatAverageYear(total.AverageYear)},
+			{"Отчет сформирован", r.GeneratedAt.Format(dateLayout + " 15:04")},
+		},
+		Sections: []section{
+			{Title: "1. Паспорта по типам объектов", Table: reportTable("Тип объекта", r.ByObjectType, total)},
+			{Title: "2. Паспорта по районам", Table: reportTable("Район", r.ByDistrict, total)},
+			{Title: "3. Здания по материалу стен", Table: wallMaterialTable(r.ByWallMaterial)},
+		},
+	}
+}
+
+// reportTable таблица разбивки паспортов с итогами отчета
+func reportTable(group string, rows []service.ReportRow, total service.ReportRow) *table {
+	t := &table{
+		Columns: []column{
+			{group, 4},
+			{"Составлено паспортов", 2},
+			{"Выдано паспортов", 2},
+			{"Общая площадь, кв.м", 2},
+			{"Инвентаризационная стоимость, руб", 3},
+			{"Средний год постройки", 2},
+		},
+	}
+	for _, row := range rows {
+		t.Rows = append(t.Rows, reportRow(row.Title, row))
+	}
+	t.Total = reportRow("Всего", total)
+	return t
+}
+
+// reportRow строка таблицы разбивки паспортов
+func reportRow(title string, r service.ReportRow) []string {
+	return []string{
+		title,
+		fmt.Sprintf("%d", r.Count),
+		fmt.Sprintf("%d", r.Issued),
+		formatArea(r.TotalArea),
+		formatMoney(r.InventoryValue),
+		formatAverageYear(r.AverageYear),
+	}
+}
+
+// wallMaterialTable таблица зданий по материалу стен
+func wallMaterialTable(rows []service.ReportRow) *table {
+	t := &table{
+		Columns: []column{
+			{"Материал стен", 4},
+			{"Зданий", 2},
+			{"Общая площадь, кв.м", 2},
+			{"Инвентаризационная стоимость, руб", 3},
+			{"Средний год ввода в эксплуатацию", 2},
+		},
+	}
+
+	var total service.ReportRow
+	for _, row := range rows {
+		t.Rows = append(t.Rows, []string{
+			row.Title,
+			fmt.Sprintf("%d", row.Count),
+			formatArea(row.TotalArea),
+			formatMoney(row.InventoryValue),
+			formatAverageYear(row.AverageYear),
+		})
+		total.Count += row.Count
+		total.TotalArea += row.TotalArea
+		total.InventoryValue += row.InventoryValue
+	}
+	t.Total = []string{"Всего", fmt.Sprintf("%d", total.Count), formatArea(total.TotalArea), formatMoney(total.InventoryValue), ""}
+
+	return t
+}
+
+// formatAverageYear форматирует средний год с точностью до десятых
+func formatAverageYear(y float64) string {
+	if y == 0 {
+		return "-"
+	}
+	return strings.Replace(fmt.Sprintf("%.1f", y), ".", ",", 1)
+}
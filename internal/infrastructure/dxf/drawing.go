@@ -0,0 +1,149 @@
+package dxf
+
+import (
+	"context"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// Флаги полилинии (группа 70)
+const (
+	flagClosed      = 1
+	flagPolyMesh    = 16
+	flagPolyFace    = 64
+	flagsNotOutline = flagPolyMesh | flagPolyFace
+)
+
+var (
+	// mtextFormat коды форматирования MTEXT со значением до ";" (\f, \H, \C, ...)
+	// и без значения (\L, \O, \K - подчеркивание и зачеркивание)
+	mtextFormat = regexp.MustCompile(`\\[ACcFfHhQqTtWw][^;]*;|\\[LlOoKk]`)
+
+	// textSpecial управляющие коды TEXT (%%u, %%o - подчеркивание и надчеркивание)
+	textSpecial = strings.NewReplacer("%%u", "", "%%U", "", "%%o", "", "%%O", "", "%%d", "°", "%%D", "°", "%%c", "⌀", "%%C", "⌀", "%%p", "±", "%%P", "±")
+)
+
+// Parser реализация service.DrawingParser для чертежей DXF
+type Parser struct{}
+
+// NewParser создает парсер чертежей DXF
+func NewParser() *Parser {
+	return &Parser{}
+}
+
+var _ service.DrawingParser = (*Parser)(nil)
+
+// ParseDrawing читает полилинии (LWPOLYLINE и POLYLINE с VERTEX) и надписи
+// (TEXT, MTEXT) пространства модели. Дуговые сегменты полилиний заменяются хордами.
+func (p *Parser) ParseDrawing(ctx context.Context, r io.Reader) (*service.Drawing, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	file, err := Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	drawing := &service.Drawing{Unit: drawingUnit(file.Units)}
+	polyline := -1
+	for _, e := range file.Entities {
+		switch e.Kind {
+		case "LWPOLYLINE":
+			drawing.Polylines = append(drawing.Polylines, newPolyline(e.Layer, e.Vertices, e.Flags&flagClosed != 0))
+		case "POLYLINE":
+			polyline = -1
+			if e.Flags&flagsNotOutline != 0 {
+				continue
+			}
+			// Точка 10/20 самой полилинии R12 задает только отметку, вершины идут в VERTEX
+			drawing.Polylines = append(drawing.Polylines, service.DrawingPolyline{Layer: e.Layer, Closed: e.Flags&flagClosed != 0})
+			polyline = len(drawing.Polylines) - 1
+		case "VERTEX":
+			if polyline >= 0 {
+				pl := &drawing.Polylines[polyline]
+				for _, v := range e.Vertices {
+					pl.Points = append(pl.Points, entity.Point{X: v.X, Y: v.Y})
+				}
+			}
+		case "SEQEND":
+			if polyline >= 0 {
+				pl := &drawing.Polylines[polyline]
+				*pl = closePolyline(*pl)
+			}
+			polyline = -1
+		case "TEXT", "MTEXT":
+			if len(e.Vertices) == 0 {
+				continue
+			}
+			at := e.Vertices[0]
+			if e.Kind == "TEXT" && e.Aligned && e.Align != nil {
+				at = *e.Align
+			}
+			text := plainText(e.Kind, e.Text)
+			if text == "" {
+				continue
+			}
+			drawing.Texts = append(drawing.Texts, service.DrawingText{Layer: e.Layer, Text: text, At: entity.Point{X: at.X, Y: at.Y}})
+		}
+	}
+
+	// Полилинии без вершин (например, оборванные перед SEQEND) не нужны
+	result := drawing.Polylines[:0]
+	for _, pl := range drawing.Polylines {
+		if len(pl.Points) > 0 {
+			result = append(result, pl)
+		}
+	}
+	drawing.Polylines = result
+
+	return drawing, nil
+}
+
+// newPolyline создает полилинию из вершин
+func newPolyline(layer string, vertices []Vertex, closed bool) service.DrawingPolyline {
+	pl := service.DrawingPolyline{Layer: layer, Closed: closed}
+	for _, v := range vertices {
+		pl.Points = append(pl.Points, entity.Point{X: v.X, Y: v.Y})
+	}
+	return closePolyline(pl)
+}
+
+// closePolyline считает замкнутой полилинию, последняя вершина которой
+// совпадает с первой, и убирает повтор вершины
+func closePolyline(pl service.DrawingPolyline) service.DrawingPolyline {
+	n := len(pl.Points)
+	if n > 2 && pl.Points[0] == pl.Points[n-1] {
+		pl.Points = pl.Points[:n-1]
+		pl.Closed = true
+	}
+	return pl
+}
+
+// plainText убирает коды форматирования надписи
+func plainText(kind, text string) string {
+	if kind == "MTEXT" {
+		text = strings.NewReplacer(`\P`, " ", `\~`, " ", `\\`, `\`, "{", "", "}", "").Replace(mtextFormat.ReplaceAllString(text, ""))
+	} else {
+		text = textSpecial.Replace(text)
+	}
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// drawingUnit переводит $INSUNITS в единицу чертежа
+func drawingUnit(units int) service.DrawingUnit {
+	switch units {
+	case UnitsMillimeters:
+		return service.DrawingUnitMillimeter
+	case UnitsCentimeters:
+		return service.DrawingUnitCentimeter
+	case UnitsMeters:
+		return service.DrawingUnitMeter
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,115 @@
+package dxf_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/dxf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dxfPairs собирает текст DXF из пар "код, значение"
+func dxfPairs(pairs ...string) string {
+	return strings.Join(pairs, "\n") + "\n"
+}
+
+func TestParser_R12(t *testing.T) {
+	// Надпись "1 Кухня" в Windows-1251, выровненная по центру
+	data := dxfPairs(
+		"0", "SECTION", "2", "HEADER",
+		"9", "$ACADVER", "1", "AC1009",
+		"9", "$INSUNITS", "70", "4",
+		"0", "ENDSEC",
+		"0", "SECTION", "2", "ENTITIES",
+		"0", "POLYLINE", "8", "ПОМЕЩЕНИЯ", "66", "1", "10", "0.0", "20", "0.0", "70", "1",
+		"0", "VERTEX", "8", "ПОМЕЩЕНИЯ", "10", "0", "20", "0",
+		"0", "VERTEX", "8", "ПОМЕЩЕНИЯ", "10", "4000", "20", "0",
+		"0", "VERTEX", "8", "ПОМЕЩЕНИЯ", "10", "4000", "20", "3000",
+		"0", "VERTEX", "8", "ПОМЕЩЕНИЯ", "10", "0", "20", "3000",
+		"0", "SEQEND", "8", "ПОМЕЩЕНИЯ",
+		"0", "POLYLINE", "8", "ПОМЕЩЕНИЯ", "70", "16",
+		"0", "VERTEX", "8", "ПОМЕЩЕНИЯ", "10", "1", "20", "1",
+		"0", "SEQEND",
+		"0", "TEXT", "8", "НОМЕРА", "10", "1800", "20", "1400", "11", "2000", "21", "1500", "72", "1",
+		"1", "1 \xca\xf3\xf5\xed\xff%%u",
+		"0", "ENDSEC",
+		"0", "EOF",
+	)
+
+	drawing, err := dxf.NewParser().ParseDrawing(context.Background(), strings.NewReader(data))
+	require.NoError(t, err)
+
+	assert.Equal(t, service.DrawingUnitMillimeter, drawing.Unit)
+	require.Len(t, drawing.Polylines, 1)
+	assert.Equal(t, service.DrawingPolyline{
+		Layer:  "ПОМЕЩЕНИЯ",
+		Points: []entity.Point{{X: 0, Y: 0}, {X: 4000, Y: 0}, {X: 4000, Y: 3000}, {X: 0, Y: 3000}},
+		Closed: true,
+	}, drawing.Polylines[0])
+	assert.Equal(t, []service.DrawingText{{Layer: "НОМЕРА", Text: "1 Кухня", At: entity.Point{X: 2000, Y: 1500}}}, drawing.Texts)
+}
+
+func TestParser_LWPolylineAndMText(t *testing.T) {
+	data := dxfPairs(
+		"0", "SECTION", "2", "ENTITIES",
+		"0", "LWPOLYLINE", "8", "ROOMS", "90", "4", "70", "1",
+		"10", "0", "20", "0", "10", "2", "20", "0", "10", "2", "20", "5", "10", "0", "20", "5",
+		"0", "LWPOLYLINE", "8", "ROOMS", "90", "5", "70", "0",
+		"10", "2", "20", "0", "10", "6", "20", "0", "10", "6", "20", "5", "10", "2", "20", "5", "10", "2", "20", "0",
+		"0", "LWPOLYLINE", "8", "СТЕНЫ", "90", "2", "70", "0",
+		"10", "0", "20", "0", "10", "6", "20", "0",
+		"0", "MTEXT", "8", "0", "10", "0.5", "20", "2.5",
+		"1", `{\fArial|b0|i0;\H0.25;2\PКоридор}`,
+		"0", "ENDSEC",
+		"0", "EOF",
+	)
+
+	drawing, err := dxf.NewParser().ParseDrawing(context.Background(), strings.NewReader(data))
+	require.NoError(t, err)
+
+	assert.Empty(t, drawing.Unit)
+	require.Len(t, drawing.Polylines, 3)
+	assert.True(t, drawing.Polylines[0].Closed)
+	assert.True(t, drawing.Polylines[1].Closed, "последняя вершина совпадает с первой")
+	assert.Len(t, drawing.Polylines[1].Points, 4)
+	assert.False(t, drawing.Polylines[2].Closed)
+	assert.Equal(t, "2 Коридор", drawing.Texts[0].Text)
+}
+
+func TestRead_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  string
+		field string
+	}{
+		{
+			name:  "binary DXF",
+			data:  "AutoCAD Binary DXF\r\n\x1a\x00",
+			field: "source",
+		},
+		{
+			name:  "bad coordinate",
+			data:  dxfPairs("0", "SECTION", "2", "ENTITIES", "0", "POINT", "10", "abc", "20", "1"),
+			field: "line 8",
+		},
+		{
+			name:  "unclosed section",
+			data:  dxfPairs("0", "SECTION", "2", "ENTITIES", "0", "POINT", "10", "1", "20", "1"),
+			field: "source",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := dxf.Read(strings.NewReader(tt.data))
+			var vErr entity.ValidationError
+			require.True(t, errors.As(err, &vErr), "ожидается ValidationError, получено %v", err)
+			assert.Equal(t, tt.field, vErr.Field)
+		})
+	}
+}
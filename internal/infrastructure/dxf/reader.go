@@ -0,0 +1,224 @@
+// Package dxf читает чертежи AutoCAD и nanoCAD в текстовом формате DXF
+// (версии R12 - 2018): переменные заголовка и примитивы пространства модели
+package dxf
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/charmap"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+)
+
+// binarySentinel начало двоичного DXF, который не поддерживается
+const binarySentinel = "AutoCAD Binary DXF"
+
+// Единицы измерения чертежа в переменной заголовка $INSUNITS
+const (
+	UnitsUnspecified = 0
+	UnitsMillimeters = 4
+	UnitsCentimeters = 5
+	UnitsMeters      = 6
+)
+
+// unicodeEscape символ в записи \U+XXXX, которой AutoCAD сохраняет символы
+// вне кодовой страницы чертежа
+var unicodeEscape = regexp.MustCompile(`\\U\+([0-9A-Fa-f]{4})`)
+
+// Vertex точка примитива в единицах чертежа
+type Vertex struct {
+	X float64
+	Y float64
+}
+
+// Entity примитив секции ENTITIES
+type Entity struct {
+	// Kind тип примитива: POINT, LINE, LWPOLYLINE, POLYLINE, VERTEX, SEQEND, TEXT, MTEXT, ...
+	Kind  string
+	Layer string
+
+	// Vertices точки групп 10/20: вершины LWPOLYLINE, положение POINT и VERTEX,
+	// точка вставки текста
+	Vertices []Vertex
+
+	// Align вторая точка выравнивания TEXT (группы 11/21)
+	Align *Vertex
+
+	// Aligned текст выровнен не по левому краю (группы 72/73), и его положение
+	// задает Align
+	Aligned bool
+
+	// Flags флаги группы 70 (для полилиний бит 1 - замкнутая)
+	Flags int
+
+	// Text содержимое TEXT и MTEXT (группы 3 и 1) с кодами форматирования
+	Text string
+}
+
+// File переменные заголовка и примитивы чертежа
+type File struct {
+	// Version версия формата ($ACADVER): AC1009 - R12, AC1015 - 2000
+	Version string
+
+	// Units единицы чертежа ($INSUNITS); UnitsUnspecified, если не указаны
+	Units int
+
+	Entities []Entity
+}
+
+// Read читает чертеж. Блоки (секция BLOCKS) и их вставки не разворачиваются.
+// Строки в кодировке Windows-1251 (чертежи R12 - 2004 с $DWGCODEPAGE ANSI_1251)
+// перекодируются в UTF-8.
+func Read(r io.Reader) (*File, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var (
+		file      File
+		current   *Entity
+		section   string
+		variable  string
+		expecting bool // после "0 SECTION" ожидается имя секции (код 2)
+		x, alignX float64
+		line      int
+	)
+	for {
+		code, value, ok, err := nextPair(scanner, &line)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+
+		switch {
+		case code == 0:
+			current = nil
+			switch value {
+			case "SECTION":
+				expecting = true
+			case "ENDSEC":
+				section = ""
+			case "EOF":
+				return &file, nil
+			default:
+				if section == "ENTITIES" {
+					file.Entities = append(file.Entities, Entity{Kind: value})
+					current = &file.Entities[len(file.Entities)-1]
+				}
+			}
+		case code == 2 && expecting:
+			section = value
+			expecting = false
+		case section == "HEADER":
+			readHeader(&file, &variable, code, value)
+		case current == nil:
+		case code == 8:
+			current.Layer = value
+		case code == 1 || code == 3:
+			current.Text += value
+		case code == 10:
+			if x, err = parseNumber(value, line); err != nil {
+				return nil, err
+			}
+		case code == 20:
+			y, err := parseNumber(value, line)
+			if err != nil {
+				return nil, err
+			}
+			current.Vertices = append(current.Vertices, Vertex{X: x, Y: y})
+		case code == 11:
+			if alignX, err = parseNumber(value, line); err != nil {
+				return nil, err
+			}
+		case code == 21:
+			y, err := parseNumber(value, line)
+			if err != nil {
+				return nil, err
+			}
+			current.Align = &Vertex{X: alignX, Y: y}
+		case code == 70:
+			current.Flags, _ = strconv.Atoi(value)
+		case code == 72 || code == 73:
+			if value != "0" {
+				current.Aligned = true
+			}
+		}
+	}
+
+	if section != "" {
+		return nil, entity.ValidationError{Field: "source", Message: "файл DXF оборван: секция " + section + " не закрыта"}
+	}
+	return &file, nil
+}
+
+// readHeader запоминает нужные переменные заголовка: имя переменной идет
+// с кодом 9, значение - следующей группой
+func readHeader(file *File, variable *string, code int, value string) {
+	if code == 9 {
+		*variable = value
+		return
+	}
+	switch *variable {
+	case "$ACADVER":
+		file.Version = value
+	case "$INSUNITS":
+		file.Units, _ = strconv.Atoi(value)
+	}
+}
+
+// nextPair читает код группы и значение; ok=false в конце файла
+func nextPair(scanner *bufio.Scanner, line *int) (int, string, bool, error) {
+	if !scanner.Scan() {
+		return 0, "", false, scanner.Err()
+	}
+	*line++
+	text := strings.TrimSpace(scanner.Text())
+	if *line == 1 && strings.HasPrefix(text, binarySentinel) {
+		return 0, "", false, entity.ValidationError{Field: "source", Message: "двоичный DXF не поддерживается, сохраните чертеж в текстовом формате"}
+	}
+	code, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, "", false, entity.ValidationError{Field: "line " + strconv.Itoa(*line), Message: "ожидается код группы DXF"}
+	}
+
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return 0, "", false, err
+		}
+		return 0, "", false, entity.ValidationError{Field: "line " + strconv.Itoa(*line), Message: "нет значения группы DXF"}
+	}
+	*line++
+	return code, decode(strings.TrimSpace(scanner.Text())), true, nil
+}
+
+// decode переводит значение в UTF-8: строки не в UTF-8 считаются записанными
+// в Windows-1251, записи \U+XXXX заменяются символами
+func decode(value string) string {
+	if !utf8.ValidString(value) {
+		if decoded, err := charmap.Windows1251.NewDecoder().String(value); err == nil {
+			value = decoded
+		}
+	}
+	if !strings.Contains(value, `\U+`) {
+		return value
+	}
+	return unicodeEscape.ReplaceAllStringFunc(value, func(m string) string {
+		code, _ := strconv.ParseUint(m[3:], 16, 32)
+		return string(rune(code))
+	})
+}
+
+// parseNumber разбирает координату групп 10/20 и 11/21
+func parseNumber(value string, line int) (float64, error) {
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, entity.ValidationError{Field: "line " + strconv.Itoa(line), Message: "некорректная координата " + value}
+	}
+	return v, nil
+}
@@ -0,0 +1,186 @@
+// Package eventbus реализует шину доменных событий паспорта внутри процесса
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// defaultBuffer размер очереди асинхронного подписчика по умолчанию
+const defaultBuffer = 256
+
+// ErrorHandler получает ошибки подписчиков; по умолчанию ошибки отбрасываются
+type ErrorHandler func(event entity.DomainEvent, err error)
+
+// subscriber подписчик шины
+type subscriber struct {
+	name    string
+	types   map[entity.EventType]bool
+	handler service.EventHandler
+
+	// queue очередь асинхронного подписчика; nil - синхронный
+	queue chan queued
+}
+
+// queued событие в очереди асинхронного подписчика
+type queued struct {
+	ctx   context.Context
+	event entity.DomainEvent
+}
+
+// accepts проверяет что подписчик получает события этого типа
+func (s *subscriber) accepts(t entity.EventType) bool {
+	return len(s.types) == 0 || s.types[t]
+}
+
+var _ service.EventPublisher = (*Bus)(nil)
+
+// Bus шина доменных событий. Синхронные подписчики вызываются в порядке
+// подписки в горутине публикации; асинхронные получают события через
+// собственную очередь в отдельной горутине, в порядке публикации.
+type Bus struct {
+	mu          sync.RWMutex
+	subscribers []*subscriber
+	closed      bool
+	wg          sync.WaitGroup
+	onError     ErrorHandler
+}
+
+// NewBus создает шину; onError может быть nil
+func NewBus(onError ErrorHandler) *Bus {
+	if onError == nil {
+		onError = func(entity.DomainEvent, error) {}
+	}
+	return &Bus{onError: onError}
+}
+
+// Subscribe подписывает синхронный обработчик на события указанных типов
+// (без типов - на все события)
+func (b *Bus) Subscribe(name string, handler service.EventHandler, types ...entity.EventType) {
+	b.add(&subscriber{name: name, types: typeSet(types), handler: handler})
+}
+
+// SubscribeAsync подписывает асинхронный обработчик с очередью buffer событий
+// (0 - размер по умолчанию). При заполненной очереди публикация ждет.
+func (b *Bus) SubscribeAsync(name string, buffer int, handler service.EventHandler, types ...entity.EventType) {
+	if buffer <= 0 {
+		buffer = defaultBuffer
+	}
+	s := &subscriber{name: name, types: typeSet(types), handler: handler, queue: make(chan queued, buffer)}
+	if !b.add(s) {
+		return
+	}
+
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		for q := range s.queue {
+			b.handle(q.ctx, s, q.event)
+		}
+	}()
+}
+
+// add регистрирует подписчика; после Close подписка игнорируется
+func (b *Bus) add(s *subscriber) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return false
+	}
+	b.subscribers = append(b.subscribers, s)
+	return true
+}
+
+// Publish передает события подписчикам. Асинхронные подписчики получают
+// контекст без отмены: событие обрабатывается и после завершения запроса.
+func (b *Bus) Publish(ctx context.Context, events []entity.DomainEvent) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.closed {
+		for _, event := range events {
+			b.onError(event, fmt.Errorf("event bus is closed"))
+		}
+		return
+	}
+
+	for _, event := range events {
+		for _, s := range b.subscribers {
+			if !s.accepts(event.Type) {
+				continue
+			}
+			if s.queue == nil {
+				b.handle(ctx, s, event)
+				continue
+			}
+			s.queue <- queued{ctx: context.WithoutCancel(ctx), event: event}
+		}
+	}
+}
+
+// handle вызывает обработчик, ошибки и паники передаются onError
+func (b *Bus) handle(ctx context.Context, s *subscriber, event entity.DomainEvent) {
+	defer func() {
+		if r := recover(); r != nil {
+			b.onError(event, fmt.Errorf("subscriber %s panicked: %v", s.name, r))
+		}
+	}()
+
+	if err := s.handler(ctx, event); err != nil {
+		b.onError(event, fmt.Errorf("subscriber %s: %w", s.name, err))
+	}
+}
+
+// Close перестает принимать события и ждет обработки очередей
+// асинхронных подписчиков
+func (b *Bus) Close() {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return
+	}
+	b.closed = true
+	for _, s := range b.subscribers {
+		if s.queue != nil {
+			close(s.queue)
+		}
+	}
+	b.mu.Unlock()
+
+	b.wg.Wait()
+}
+
+// typeSet множество типов событий подписки
+func typeSet(types []entity.EventType) map[entity.EventType]bool {
+	if len(types) == 0 {
+		return nil
+	}
+	set := make(map[entity.EventType]bool, len(types))
+	for _, t := range types {
+		set[t] = true
+	}
+	return set
+}
+
+// LogHandler обработчик, записывающий события в журнал
+func LogHandler(logger *log.Logger) service.EventHandler {
+	return func(ctx context.Context, event entity.DomainEvent) error {
+		switch event.Type {
+		case entity.EventBuildingAdded, entity.EventBuildingRemoved:
+			logger.Printf("event %s: passport %s, litera %s", event.Type, event.PassportID, event.Litera)
+		case entity.EventOwnerChanged:
+			logger.Printf("event %s: passport %s, owner %d %s", event.Type, event.PassportID, event.OwnerIndex, event.OwnerChange)
+		case entity.EventStatusChanged:
+			logger.Printf("event %s: passport %s, %s -> %s", event.Type, event.PassportID, event.PreviousStatus, event.Status)
+		default:
+			logger.Printf("event %s: passport %s", event.Type, event.PassportID)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,112 @@
+package eventbus_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/eventbus"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recorder запоминает полученные события
+type recorder struct {
+	mu     sync.Mutex
+	events []entity.DomainEvent
+}
+
+func (r *recorder) handle(ctx context.Context, event entity.DomainEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *recorder) types() []entity.EventType {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var types []entity.EventType
+	for _, e := range r.events {
+		types = append(types, e.Type)
+	}
+	return types
+}
+
+func TestBus_Publish(t *testing.T) {
+	var errs []error
+	bus := eventbus.NewBus(func(event entity.DomainEvent, err error) { errs = append(errs, err) })
+
+	all, buildings, async := &recorder{}, &recorder{}, &recorder{}
+	bus.Subscribe("all", all.handle)
+	bus.Subscribe("buildings", buildings.handle, entity.EventBuildingAdded, entity.EventBuildingRemoved)
+	bus.SubscribeAsync("async", 1, async.handle)
+	bus.Subscribe("failing", func(ctx context.Context, event entity.DomainEvent) error {
+		return errors.New("index unavailable")
+	}, entity.EventStatusChanged)
+	bus.Subscribe("panicking", func(ctx context.Context, event entity.DomainEvent) error {
+		panic("boom")
+	}, entity.EventOwnerChanged)
+
+	events := []entity.DomainEvent{
+		{Type: entity.EventPassportCreated, PassportID: "TP-1"},
+		{Type: entity.EventBuildingAdded, PassportID: "TP-1", Litera: "А"},
+		{Type: entity.EventOwnerChanged, PassportID: "TP-1"},
+		{Type: entity.EventStatusChanged, PassportID: "TP-1"},
+	}
+	bus.Publish(context.Background(), events)
+
+	// Синхронные подписчики получили события до возврата из Publish
+	assert.Equal(t, []entity.EventType{
+		entity.EventPassportCreated, entity.EventBuildingAdded, entity.EventOwnerChanged, entity.EventStatusChanged,
+	}, all.types())
+	assert.Equal(t, []entity.EventType{entity.EventBuildingAdded}, buildings.types())
+	require.Len(t, errs, 2)
+	assert.EqualError(t, errs[0], "subscriber panicking panicked: boom")
+	assert.EqualError(t, errs[1], "subscriber failing: index unavailable")
+
+	// Close дожидается асинхронной очереди
+	bus.Close()
+	assert.Equal(t, all.types(), async.types())
+
+	bus.Publish(context.Background(), events[:1])
+	require.Len(t, errs, 3)
+	assert.EqualError(t, errs[2], "event bus is closed")
+}
+
+func TestPublishingRepository(t *testing.T) {
+	ctx := context.Background()
+	bus := eventbus.NewBus(nil)
+	received := &recorder{}
+	bus.Subscribe("test", received.handle)
+	repo := eventbus.NewPublishingRepository(memory.NewInMemoryPassportRepository(), bus)
+
+	p := entity.NewTechnicalPassport(entity.ObjectTypeResidentialHouse, entity.Address{Subject: "г. Москва", House: "1"})
+	p.ID = "TP-EVENTS"
+	require.NoError(t, p.AddBuilding(entity.Building{Litera: "А", Name: "Жилой дом", CommissionYear: 1990}))
+
+	// До сохранения события не публикуются
+	assert.Empty(t, received.types())
+	require.NoError(t, repo.Create(ctx, p))
+	assert.Equal(t, []entity.EventType{entity.EventPassportCreated, entity.EventBuildingAdded}, received.types())
+	assert.Equal(t, "TP-EVENTS", received.events[0].PassportID)
+	assert.Equal(t, "А", received.events[1].Litera)
+	assert.Empty(t, p.Events())
+
+	// События несохраненных изменений отбрасываются
+	other := entity.NewTechnicalPassport(entity.ObjectTypeResidentialHouse, entity.Address{})
+	other.ID = "TP-MISSING"
+	require.Error(t, repo.Update(ctx, other))
+	assert.Empty(t, other.Events())
+	assert.Len(t, received.types(), 2)
+
+	require.NoError(t, p.RemoveBuilding(0))
+	require.NoError(t, repo.Update(ctx, p))
+	assert.Equal(t, entity.DomainEvent{
+		ID: received.events[2].ID, Type: entity.EventBuildingRemoved, PassportID: "TP-EVENTS",
+		OccurredAt: received.events[2].OccurredAt, Action: "remove_building", Litera: "А",
+	}, received.events[2])
+}
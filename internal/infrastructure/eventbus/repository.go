@@ -0,0 +1,67 @@
+package eventbus
+
+import (
+	"context"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// PublishingRepository хранилище паспортов, публикующее события паспорта
+// после успешного Create и Update, а событие passport_deleted - после
+// успешного Delete. Use cases сохраняют паспорт как обычно и не зависят
+// от подписчиков.
+type PublishingRepository struct {
+	repository.PassportRepository
+	publisher service.EventPublisher
+}
+
+// NewPublishingRepository оборачивает хранилище паспортов
+func NewPublishingRepository(repo repository.PassportRepository, publisher service.EventPublisher) *PublishingRepository {
+	return &PublishingRepository{
+		PassportRepository: repo,
+		publisher:          publisher,
+	}
+}
+
+// Create сохраняет паспорт и публикует его события
+func (r *PublishingRepository) Create(ctx context.Context, passport *entity.TechnicalPassport) error {
+	return r.commit(ctx, passport, r.PassportRepository.Create(ctx, passport))
+}
+
+// Update сохраняет изменения паспорта и публикует его события
+func (r *PublishingRepository) Update(ctx context.Context, passport *entity.TechnicalPassport) error {
+	return r.commit(ctx, passport, r.PassportRepository.Update(ctx, passport))
+}
+
+// Delete удаляет паспорт и публикует событие passport_deleted; если
+// удаление не удалось, событие не публикуется
+func (r *PublishingRepository) Delete(ctx context.Context, id string) error {
+	// Организация паспорта нужна событию для выбора подписчиков
+	passport, err := r.PassportRepository.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := r.PassportRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	r.publisher.Publish(ctx, []entity.DomainEvent{passport.DeletedEvent()})
+	return nil
+}
+
+// commit публикует события сохраненного паспорта; события несохраненных
+// изменений отбрасываются
+func (r *PublishingRepository) commit(ctx context.Context, passport *entity.TechnicalPassport, err error) error {
+	events := passport.PullEvents()
+	if err != nil {
+		return err
+	}
+
+	if len(events) > 0 {
+		r.publisher.Publish(ctx, events)
+	}
+	return nil
+}
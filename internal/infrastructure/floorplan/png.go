@@ -0,0 +1,73 @@
+package floorplan
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"math"
+
+	"github.com/srwiley/oksvg"
+	"github.com/srwiley/rasterx"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// writePNG растеризует сцену: фигуры через SVG, подписи шрифтом приложения
+func (r *Renderer) writePNG(s *scene) ([]byte, error) {
+	width, height := int(math.Ceil(s.width)), int(math.Ceil(s.height))
+
+	icon, err := oksvg.ReadIconStream(bytes.NewReader(writeSVG(s, false)), oksvg.IgnoreErrorMode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse plan svg: %w", err)
+	}
+	icon.SetTarget(0, 0, float64(width), float64(height))
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+	scanner := rasterx.NewScannerGV(width, height, img, img.Bounds())
+	icon.Draw(rasterx.NewDasher(width, height, scanner), 1)
+
+	if s.title != "" {
+		if err := r.drawText(img, label{at: pt{s.width / 2, (marginPx + titleHeightPx) / 2}, size: 15, bold: true, text: s.title}); err != nil {
+			return nil, err
+		}
+	}
+	for _, l := range s.labels {
+		if err := r.drawText(img, l); err != nil {
+			return nil, err
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode plan png: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// drawText выводит подпись, выровненную по центру относительно точки
+func (r *Renderer) drawText(img draw.Image, l label) error {
+	f := r.regular
+	if l.bold {
+		f = r.bold
+	}
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{Size: l.size, DPI: 72, Hinting: font.HintingFull})
+	if err != nil {
+		return fmt.Errorf("failed to create font face: %w", err)
+	}
+	defer face.Close()
+
+	d := &font.Drawer{Dst: img, Src: image.NewUniform(color.Black), Face: face}
+	metrics := face.Metrics()
+	width := d.MeasureString(l.text)
+	d.Dot = fixed.Point26_6{
+		X: fixed.Int26_6(l.at.X*64) - width/2,
+		Y: fixed.Int26_6(l.at.Y*64) + (metrics.Ascent-metrics.Descent)/2,
+	}
+	d.DrawString(l.text)
+	return nil
+}
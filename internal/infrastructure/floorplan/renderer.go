@@ -0,0 +1,57 @@
+package floorplan
+
+import (
+	"context"
+	"fmt"
+
+	"fyne.io/fyne/v2/theme"
+	"golang.org/x/image/font/opentype"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// Renderer реализация service.FloorPlanRenderer
+type Renderer struct {
+	regular *opentype.Font
+	bold    *opentype.Font
+}
+
+// NewRenderer создает отрисовщик планов со встроенными шрифтами приложения
+func NewRenderer() *Renderer {
+	return &Renderer{
+		regular: mustParseFont(theme.DefaultTextFont().Content()),
+		bold:    mustParseFont(theme.DefaultTextBoldFont().Content()),
+	}
+}
+
+var _ service.FloorPlanRenderer = (*Renderer)(nil)
+
+// Render отрисовывает векторный план в SVG или PNG
+func (r *Renderer) Render(ctx context.Context, plan *entity.FloorPlan, format service.PlanImageFormat) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if len(plan.Walls) == 0 && len(plan.Rooms) == 0 {
+		return nil, entity.ValidationError{Field: "floor_plan", Message: "план не содержит векторных данных"}
+	}
+
+	s := buildScene(plan)
+	switch format {
+	case service.PlanFormatSVG:
+		return writeSVG(s, true), nil
+	case service.PlanFormatPNG:
+		return r.writePNG(s)
+	default:
+		return nil, fmt.Errorf("unsupported plan image format: %s", format)
+	}
+}
+
+// mustParseFont разбирает встроенный шрифт; ошибка означает поврежденную сборку
+func mustParseFont(data []byte) *opentype.Font {
+	f, err := opentype.Parse(data)
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse embedded font: %v", err))
+	}
+	return f
+}
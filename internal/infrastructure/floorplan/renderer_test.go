@@ -0,0 +1,117 @@
+package floorplan_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"image/png"
+	"io"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/floorplan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// samplePlan две комнаты 4x3 м с дверью между ними и окнами на фасаде
+func samplePlan() *entity.FloorPlan {
+	return &entity.FloorPlan{
+		Litera: "А",
+		Floor:  "1",
+		Walls: []entity.Wall{
+			{Start: entity.Point{X: 0, Y: 0}, End: entity.Point{X: 8, Y: 0}, Thickness: 0.4},
+			{Start: entity.Point{X: 8, Y: 0}, End: entity.Point{X: 8, Y: 3}, Thickness: 0.4},
+			{Start: entity.Point{X: 8, Y: 3}, End: entity.Point{X: 0, Y: 3}, Thickness: 0.4},
+			{Start: entity.Point{X: 0, Y: 3}, End: entity.Point{X: 0, Y: 0}, Thickness: 0.4},
+			{Start: entity.Point{X: 4, Y: 0}, End: entity.Point{X: 4, Y: 3}, Thickness: 0.12},
+		},
+		Openings: []entity.Opening{
+			{Type: entity.OpeningWindow, Wall: 0, Offset: 1.2, Width: 1.5},
+			{Type: entity.OpeningWindow, Wall: 0, Offset: 5.2, Width: 1.5},
+			{Type: entity.OpeningDoor, Wall: 4, Offset: 1, Width: 0.9},
+		},
+		Rooms: []entity.RoomContour{
+			{RoomNumber: "1", Points: []entity.Point{{X: 0.2, Y: 0.2}, {X: 3.94, Y: 0.2}, {X: 3.94, Y: 2.8}, {X: 0.2, Y: 2.8}}},
+			{RoomNumber: "2", Points: []entity.Point{{X: 4.06, Y: 0.2}, {X: 7.8, Y: 0.2}, {X: 7.8, Y: 2.8}, {X: 4.06, Y: 2.8}}},
+		},
+	}
+}
+
+func TestRenderer_SVG(t *testing.T) {
+	data, err := floorplan.NewRenderer().Render(context.Background(), samplePlan(), service.PlanFormatSVG)
+	require.NoError(t, err)
+
+	// Документ должен быть корректным XML
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var texts []string
+	for {
+		tok, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		if cd, ok := tok.(xml.CharData); ok && len(bytes.TrimSpace(cd)) > 0 {
+			texts = append(texts, string(cd))
+		}
+	}
+
+	// Заголовок, номера помещений и площади по контуру (3,74 x 2,6 = 9,724)
+	assert.Equal(t, []string{"Литера А, этаж 1", "1", "9,7", "2", "9,7"}, texts)
+	assert.Contains(t, string(data), `width="480"`)
+}
+
+func TestRenderer_PNG(t *testing.T) {
+	data, err := floorplan.NewRenderer().Render(context.Background(), samplePlan(), service.PlanFormatPNG)
+	require.NoError(t, err)
+
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, 480, img.Bounds().Dx())
+	assert.Equal(t, 260, img.Bounds().Dy())
+
+	// Стена закрашена, середина проема окна - нет
+	r, g, b, _ := img.At(60, 220).RGBA()
+	assert.Less(t, r+g+b, uint32(3*0x8000), "стена")
+	r, g, b, _ = img.At(137, 214).RGBA()
+	assert.Greater(t, r+g+b, uint32(3*0xe000), "окно")
+}
+
+func TestRenderer_BackgroundOnly(t *testing.T) {
+	_, err := floorplan.NewRenderer().Render(context.Background(), &entity.FloorPlan{Background: "plans/1.png"}, service.PlanFormatSVG)
+	var ve entity.ValidationError
+	require.ErrorAs(t, err, &ve)
+}
+
+func TestRenderer_SituationPlan(t *testing.T) {
+	plan := &entity.SituationPlan{
+		CoordinateSystem: entity.CoordinateMSK,
+		Boundary: []entity.GeoPoint{
+			{Name: "н1", X: 100, Y: 200}, {Name: "н2", X: 130, Y: 200}, {Name: "н3", X: 130, Y: 220}, {Name: "н4", X: 100, Y: 220},
+		},
+		Footprints: []entity.BuildingFootprint{{
+			Litera: "А",
+			Points: []entity.GeoPoint{{X: 105, Y: 205}, {X: 115, Y: 205}, {X: 115, Y: 217}, {X: 105, Y: 217}},
+		}},
+	}
+
+	data, err := floorplan.NewRenderer().RenderSituationPlan(context.Background(), plan, service.PlanFormatSVG)
+	require.NoError(t, err)
+	svg := string(data)
+	assert.Contains(t, svg, "Ситуационный план, площадь участка 600 кв.м")
+	assert.Contains(t, svg, ">н3<")
+	assert.Contains(t, svg, ">120,0<")
+
+	// Участок 20 x 30 м в масштабе 10 пикселей на метр
+	data, err = floorplan.NewRenderer().RenderSituationPlan(context.Background(), plan, service.PlanFormatPNG)
+	require.NoError(t, err)
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Greater(t, img.Bounds().Dx(), 200)
+	assert.Greater(t, img.Bounds().Dy(), 300)
+
+	_, err = floorplan.NewRenderer().RenderSituationPlan(context.Background(), &entity.SituationPlan{CoordinateSystem: entity.CoordinateMSK}, service.PlanFormatSVG)
+	var ve entity.ValidationError
+	require.ErrorAs(t, err, &ve)
+}
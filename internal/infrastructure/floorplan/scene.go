@@ -0,0 +1,172 @@
+// Package floorplan отрисовывает векторные поэтажные и ситуационные планы в SVG и PNG
+package floorplan
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+)
+
+// Параметры оформления плана
+const (
+	pixelsPerMeter = 50.0 // масштаб изображения
+	marginPx       = 30.0 // поля вокруг плана
+	titleHeightPx  = 30.0 // строка заголовка над планом
+	arcSegments    = 12   // отрезков в дуге открывания двери
+
+	colorWall    = "#333333"
+	colorRoom    = "#f4efe1"
+	colorOutline = "#8c8c8c"
+	colorOpening = "#ffffff"
+	colorLine    = "#333333"
+)
+
+// pt точка изображения в пикселях, ось Y направлена вниз
+type pt struct {
+	X, Y float64
+}
+
+// shape многоугольник или ломаная изображения
+type shape struct {
+	points []pt
+	closed bool
+	fill   string // "none" - без заливки
+	stroke string // "none" - без обводки
+	width  float64
+}
+
+// label подпись, выровненная по центру относительно точки
+type label struct {
+	at   pt
+	size float64
+	bold bool
+	text string
+}
+
+// scene примитивы изображения плана, общие для SVG и PNG
+type scene struct {
+	width, height float64
+	shapes        []shape
+	labels        []label
+	title         string
+}
+
+// buildScene переводит план в примитивы изображения
+func buildScene(plan *entity.FloorPlan) *scene {
+	min, max := plan.Bounds()
+	s := &scene{
+		width:  (max.X-min.X)*pixelsPerMeter + 2*marginPx,
+		height: (max.Y-min.Y)*pixelsPerMeter + 2*marginPx + titleHeightPx,
+		title:  planTitle(plan),
+	}
+
+	// Переход к координатам изображения: ось Y вниз, заголовок сверху
+	toPx := func(p entity.Point) pt {
+		return pt{
+			X: (p.X-min.X)*pixelsPerMeter + marginPx,
+			Y: (max.Y-p.Y)*pixelsPerMeter + marginPx + titleHeightPx,
+		}
+	}
+
+	// Помещения рисуются под стенами
+	for _, room := range plan.Rooms {
+		outline := shape{closed: true, fill: colorRoom, stroke: colorOutline, width: 1}
+		for _, p := range room.Points {
+			outline.points = append(outline.points, toPx(p))
+		}
+		s.shapes = append(s.shapes, outline)
+
+		center := toPx(room.Centroid())
+		s.labels = append(s.labels,
+			label{at: pt{center.X, center.Y - 7}, size: 13, bold: true, text: room.RoomNumber},
+			label{at: pt{center.X, center.Y + 9}, size: 11, text: formatArea(room.Area())},
+		)
+	}
+
+	openings := make(map[int][]entity.Opening)
+	for _, o := range plan.Openings {
+		openings[o.Wall] = append(openings[o.Wall], o)
+	}
+
+	for i, wall := range plan.Walls {
+		s.addWall(wall, openings[i], toPx)
+	}
+
+	return s
+}
+
+// addWall добавляет стену с вырезанными проемами, окна и двери
+func (s *scene) addWall(wall entity.Wall, openings []entity.Opening, toPx func(entity.Point) pt) {
+	length := wall.Length()
+	dir := entity.Point{X: (wall.End.X - wall.Start.X) / length, Y: (wall.End.Y - wall.Start.Y) / length}
+	normal := entity.Point{X: -dir.Y, Y: dir.X}
+	half := wall.Thickness / 2
+
+	// at точка на расстоянии along от начала стены и side от оси
+	at := func(along, side float64) pt {
+		return toPx(entity.Point{
+			X: wall.Start.X + dir.X*along + normal.X*side,
+			Y: wall.Start.Y + dir.Y*along + normal.Y*side,
+		})
+	}
+	band := func(from, to float64) []pt {
+		return []pt{at(from, -half), at(to, -half), at(to, half), at(from, half)}
+	}
+
+	sort.Slice(openings, func(i, j int) bool { return openings[i].Offset < openings[j].Offset })
+
+	// Сплошные участки стены между проемами; концы стены продлеваются
+	// на половину толщины, чтобы углы смежных стен смыкались
+	from := -half
+	for _, o := range openings {
+		if o.Offset > from {
+			s.shapes = append(s.shapes, shape{points: band(from, o.Offset), closed: true, fill: colorWall, stroke: "none"})
+		}
+		from = math.Max(from, o.Offset+o.Width)
+	}
+	if length+half > from {
+		s.shapes = append(s.shapes, shape{points: band(from, length+half), closed: true, fill: colorWall, stroke: "none"})
+	}
+
+	for _, o := range openings {
+		start, end := o.Offset, o.Offset+o.Width
+		switch o.Type {
+		case entity.OpeningWindow:
+			s.shapes = append(s.shapes,
+				shape{points: band(start, end), closed: true, fill: colorOpening, stroke: colorLine, width: 1},
+				shape{points: []pt{at(start, 0), at(end, 0)}, fill: "none", stroke: colorLine, width: 1},
+			)
+		case entity.OpeningDoor:
+			s.shapes = append(s.shapes, shape{points: band(start, end), closed: true, fill: colorOpening, stroke: "none"})
+
+			// Полотно открывается внутрь помещения слева от направления стены
+			leaf := shape{points: []pt{at(start, half), at(start, half+o.Width)}, fill: "none", stroke: colorLine, width: 1.5}
+			arc := shape{fill: "none", stroke: colorLine, width: 0.75}
+			for k := 0; k <= arcSegments; k++ {
+				angle := math.Pi / 2 * float64(k) / arcSegments
+				arc.points = append(arc.points, at(start+o.Width*math.Sin(angle), half+o.Width*math.Cos(angle)))
+			}
+			s.shapes = append(s.shapes, leaf, arc)
+		}
+	}
+}
+
+// planTitle заголовок изображения плана
+func planTitle(plan *entity.FloorPlan) string {
+	var parts []string
+	if plan.Litera != "" {
+		parts = append(parts, "Литера "+plan.Litera)
+	}
+	if plan.Floor != "" {
+		parts = append(parts, "этаж "+plan.Floor)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatArea площадь с точностью 0,1 кв.м, как в экспликации
+func formatArea(v float64) string {
+	return strings.Replace(fmt.Sprintf("%.1f", v), ".", ",", 1)
+}
@@ -0,0 +1,161 @@
+package floorplan
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// Параметры оформления ситуационного плана
+const (
+	// situationPixelsPerMeter масштаб 1:500 при выводе в документ (0,2 мм на пиксель)
+	situationPixelsPerMeter = 10.0
+
+	// situationMaxSidePx крупные участки уменьшаются до этого размера
+	situationMaxSidePx = 1600.0
+
+	// vertexLabelOffsetPx отступ номера точки границы от вершины наружу
+	vertexLabelOffsetPx = 12.0
+
+	colorPlot      = "#eef5e6"
+	colorBoundary  = "#2e7d32"
+	colorFootprint = "#d9d9d9"
+)
+
+var _ service.SituationPlanRenderer = (*Renderer)(nil)
+
+// RenderSituationPlan отрисовывает ситуационный план в SVG или PNG
+func (r *Renderer) RenderSituationPlan(ctx context.Context, plan *entity.SituationPlan, format service.PlanImageFormat) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if len(plan.Boundary) == 0 && len(plan.Footprints) == 0 {
+		return nil, entity.ValidationError{Field: "situation_plan", Message: "план не содержит координат"}
+	}
+
+	s := buildSituationScene(plan)
+	switch format {
+	case service.PlanFormatSVG:
+		return writeSVG(s, true), nil
+	case service.PlanFormatPNG:
+		return r.writePNG(s)
+	default:
+		return nil, fmt.Errorf("unsupported plan image format: %s", format)
+	}
+}
+
+// buildSituationScene переводит ситуационный план в примитивы изображения
+func buildSituationScene(plan *entity.SituationPlan) *scene {
+	boundary := plan.Local(plan.Boundary)
+
+	// Габариты всех объектов плана в местной системе
+	all := append([]entity.Point{}, boundary...)
+	for _, f := range plan.Footprints {
+		all = append(all, plan.Local(f.Points)...)
+	}
+	for _, a := range plan.Annotations {
+		all = append(all, plan.Local([]entity.GeoPoint{a.At})...)
+	}
+	min, max := all[0], all[0]
+	for _, p := range all[1:] {
+		min.X, min.Y = math.Min(min.X, p.X), math.Min(min.Y, p.Y)
+		max.X, max.Y = math.Max(max.X, p.X), math.Max(max.Y, p.Y)
+	}
+
+	scale := situationPixelsPerMeter
+	if side := math.Max(max.X-min.X, max.Y-min.Y) * scale; side > situationMaxSidePx {
+		scale *= situationMaxSidePx / side
+	}
+
+	s := &scene{
+		width:  (max.X-min.X)*scale + 2*marginPx,
+		height: (max.Y-min.Y)*scale + 2*marginPx + titleHeightPx,
+		title:  "Ситуационный план",
+	}
+	if len(boundary) > 0 {
+		s.title += fmt.Sprintf(", площадь участка %s кв.м", formatWhole(plan.PlotArea()))
+	}
+
+	toPx := func(p entity.Point) pt {
+		return pt{
+			X: (p.X-min.X)*scale + marginPx,
+			Y: (max.Y-p.Y)*scale + marginPx + titleHeightPx,
+		}
+	}
+
+	if len(boundary) > 0 {
+		outline := shape{closed: true, fill: colorPlot, stroke: colorBoundary, width: 2}
+		for _, p := range boundary {
+			outline.points = append(outline.points, toPx(p))
+		}
+		s.shapes = append(s.shapes, outline)
+		s.addVertexLabels(plan.Boundary, outline.points)
+	}
+
+	for _, f := range plan.Footprints {
+		local := plan.Local(f.Points)
+		outline := shape{closed: true, fill: colorFootprint, stroke: colorLine, width: 1.5}
+		for _, p := range local {
+			outline.points = append(outline.points, toPx(p))
+		}
+		s.shapes = append(s.shapes, outline)
+
+		center := toPx(entity.RoomContour{Points: local}.Centroid())
+		s.labels = append(s.labels,
+			label{at: pt{center.X, center.Y - 7}, size: 13, bold: true, text: f.Litera},
+			label{at: pt{center.X, center.Y + 9}, size: 11, text: formatArea(plan.FootprintArea(f))},
+		)
+	}
+
+	for _, a := range plan.Annotations {
+		s.labels = append(s.labels, label{at: toPx(plan.Local([]entity.GeoPoint{a.At})[0]), size: 11, text: a.Text})
+	}
+
+	s.addNorthArrow()
+	return s
+}
+
+// addVertexLabels подписывает точки границы (н1, н2, ... если номера не заданы)
+// с отступом от вершины в сторону от центра участка
+func (s *scene) addVertexLabels(points []entity.GeoPoint, px []pt) {
+	var center pt
+	for _, p := range px {
+		center.X += p.X / float64(len(px))
+		center.Y += p.Y / float64(len(px))
+	}
+
+	for i, p := range px {
+		name := points[i].Name
+		if name == "" {
+			name = fmt.Sprintf("н%d", i+1)
+		}
+		dx, dy := p.X-center.X, p.Y-center.Y
+		if d := math.Hypot(dx, dy); d > 0 {
+			dx, dy = dx/d, dy/d
+		}
+		s.labels = append(s.labels, label{
+			at:   pt{p.X + dx*vertexLabelOffsetPx, p.Y + dy*vertexLabelOffsetPx},
+			size: 9,
+			text: name,
+		})
+	}
+}
+
+// addNorthArrow добавляет стрелку севера в правый верхний угол
+func (s *scene) addNorthArrow() {
+	x := s.width - marginPx/2
+	top := titleHeightPx + 4
+	s.shapes = append(s.shapes,
+		shape{points: []pt{{x, top + 28}, {x, top + 6}}, fill: "none", stroke: colorLine, width: 1.5},
+		shape{points: []pt{{x - 4, top + 12}, {x, top + 4}, {x + 4, top + 12}}, closed: true, fill: colorLine, stroke: colorLine, width: 1},
+	)
+	s.labels = append(s.labels, label{at: pt{x, top - 6}, size: 11, bold: true, text: "С"})
+}
+
+// formatWhole площадь земельного участка в целых квадратных метрах
+func formatWhole(v float64) string {
+	return fmt.Sprintf("%.0f", math.Round(v))
+}
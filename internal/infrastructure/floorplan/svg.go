@@ -0,0 +1,73 @@
+package floorplan
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// fontFamily семейство шрифта подписей SVG
+const fontFamily = "DejaVu Sans, Arial, sans-serif"
+
+// writeSVG формирует SVG документ сцены; withText=false опускает подписи
+// (при растеризации подписи выводятся отдельно шрифтом приложения)
+func writeSVG(s *scene, withText bool) []byte {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%s" viewBox="0 0 %s %s">`+"\n",
+		num(s.width), num(s.height), num(s.width), num(s.height))
+	fmt.Fprintf(&buf, `<rect x="0" y="0" width="%s" height="%s" fill="#ffffff"/>`+"\n", num(s.width), num(s.height))
+
+	for _, sh := range s.shapes {
+		tag := "polyline"
+		if sh.closed {
+			tag = "polygon"
+		}
+		fmt.Fprintf(&buf, `<%s points="%s" fill="%s" stroke="%s"`, tag, points(sh.points), sh.fill, sh.stroke)
+		if sh.stroke != "none" {
+			fmt.Fprintf(&buf, ` stroke-width="%s"`, num(sh.width))
+		}
+		buf.WriteString("/>\n")
+	}
+
+	if withText {
+		if s.title != "" {
+			writeText(&buf, label{at: pt{s.width / 2, (marginPx + titleHeightPx) / 2}, size: 15, bold: true, text: s.title})
+		}
+		for _, l := range s.labels {
+			writeText(&buf, l)
+		}
+	}
+
+	buf.WriteString("</svg>\n")
+	return buf.Bytes()
+}
+
+// writeText добавляет подпись, выровненную по центру
+func writeText(buf *bytes.Buffer, l label) {
+	weight := "normal"
+	if l.bold {
+		weight = "bold"
+	}
+	fmt.Fprintf(buf, `<text x="%s" y="%s" font-family="%s" font-size="%s" font-weight="%s" text-anchor="middle" dominant-baseline="middle" fill="#000000">`,
+		num(l.at.X), num(l.at.Y), fontFamily, num(l.size), weight)
+	xml.EscapeText(buf, []byte(l.text))
+	buf.WriteString("</text>\n")
+}
+
+// points список вершин для атрибута points
+func points(pts []pt) string {
+	parts := make([]string, len(pts))
+	for i, p := range pts {
+		parts[i] = num(p.X) + "," + num(p.Y)
+	}
+	return strings.Join(parts, " ")
+}
+
+// num координата с точностью до сотой пикселя
+func num(v float64) string {
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+}
@@ -0,0 +1,147 @@
+// Package geometry рассчитывает площади помещений по обмерам
+// с округлением по правилам бюро технической инвентаризации
+package geometry
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// Правила инструкции о проведении учета жилищного фонда
+const (
+	// minNicheHeight ниши высотой от 2 м включаются в площадь помещения
+	minNicheHeight = 2.0
+
+	// epsilon запас на погрешность представления чисел при округлении
+	epsilon = 1e-9
+)
+
+// Calculator реализация service.AreaCalculator
+type Calculator struct{}
+
+// NewCalculator создает калькулятор площадей
+func NewCalculator() *Calculator {
+	return &Calculator{}
+}
+
+var _ service.AreaCalculator = (*Calculator)(nil)
+
+// Calculate вычисляет площадь помещения: размеры округляются до сантиметра,
+// площади фигур - до 0,01 кв.м, площадь помещения - до 0,1 кв.м
+func (c *Calculator) Calculate(m *entity.RoomMeasurements) (*service.AreaCalculation, error) {
+	if err := m.IsValid(); err != nil {
+		return nil, err
+	}
+
+	result := &service.AreaCalculation{}
+	total := 0.0
+	for i, shape := range m.Shapes {
+		area, err := shapeArea(shape)
+		if err != nil {
+			return nil, entity.ValidationError{Field: "shapes[" + strconv.Itoa(i) + "]", Message: err.Error()}
+		}
+		area = roundTo(area, 2)
+
+		counted := true
+		switch shape.Role {
+		case entity.ShapeRoleDeduction:
+			total -= area
+		case entity.ShapeRoleNiche:
+			if roundLength(shape.Height) < minNicheHeight {
+				counted = false
+				result.Warnings = append(result.Warnings,
+					fmt.Sprintf("ниша %d высотой %.2f м не включена в площадь (менее 2 м)", i+1, shape.Height))
+				break
+			}
+			total += area
+		default:
+			total += area
+		}
+
+		result.Shapes = append(result.Shapes, service.ShapeArea{
+			Index:   i,
+			Kind:    shape.Kind,
+			Role:    shape.Role,
+			Area:    area,
+			Counted: counted,
+		})
+	}
+
+	result.Area = roundTo(total, 1)
+	if result.Area <= 0 {
+		return nil, entity.ValidationError{Field: "shapes", Message: "площадь вычетов не меньше площади помещения"}
+	}
+
+	return result, nil
+}
+
+// shapeArea площадь фигуры по размерам, округленным до сантиметра
+func shapeArea(s entity.MeasuredShape) (float64, error) {
+	switch s.Kind {
+	case entity.ShapeRectangle:
+		return roundLength(s.Length) * roundLength(s.Width), nil
+	case entity.ShapeTriangle:
+		return heron(roundLength(s.Sides[0]), roundLength(s.Sides[1]), roundLength(s.Sides[2]))
+	case entity.ShapePolygon:
+		return polygonArea(s.Sides, s.Diagonals)
+	case entity.ShapeSemicircle:
+		d := roundLength(s.Diameter)
+		return math.Pi * d * d / 8, nil
+	default:
+		return 0, fmt.Errorf("неизвестный вид фигуры %q", s.Kind)
+	}
+}
+
+// polygonArea площадь выпуклого многоугольника, разбитого диагоналями из первой
+// вершины на треугольники: k-й треугольник образуют вершины 1, k+1, k+2
+func polygonArea(sides, diagonals []float64) (float64, error) {
+	n := len(sides)
+
+	// edge расстояние от первой вершины до вершины с номером v (с 0)
+	edge := func(v int) float64 {
+		switch v {
+		case 1:
+			return roundLength(sides[0])
+		case n - 1:
+			return roundLength(sides[n-1])
+		default:
+			return roundLength(diagonals[v-2])
+		}
+	}
+
+	total := 0.0
+	for k := 1; k <= n-2; k++ {
+		area, err := heron(edge(k), roundLength(sides[k]), edge(k+1))
+		if err != nil {
+			return 0, fmt.Errorf("треугольник %d: %w", k, err)
+		}
+		total += area
+	}
+	return total, nil
+}
+
+// heron площадь треугольника по трем сторонам
+func heron(a, b, c float64) (float64, error) {
+	if a+b <= c+epsilon || a+c <= b+epsilon || b+c <= a+epsilon {
+		return 0, fmt.Errorf("стороны %.2f, %.2f и %.2f не образуют треугольник", a, b, c)
+	}
+
+	p := (a + b + c) / 2
+	return math.Sqrt(p * (p - a) * (p - b) * (p - c)), nil
+}
+
+// roundLength округляет размер до сантиметра
+func roundLength(v float64) float64 {
+	return roundTo(v, 2)
+}
+
+// roundTo округляет до digits знаков после запятой по правилам арифметики
+// (пятерка округляется в большую сторону)
+func roundTo(v float64, digits int) float64 {
+	scale := math.Pow10(digits)
+	return math.Round(v*scale+math.Copysign(epsilon, v)) / scale
+}
@@ -0,0 +1,135 @@
+package geometry_test
+
+import (
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/geometry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculator_Calculate(t *testing.T) {
+	tests := []struct {
+		name     string
+		shapes   []entity.MeasuredShape
+		area     float64
+		warnings int
+	}{
+		{
+			name:   "rectangle",
+			shapes: []entity.MeasuredShape{{Kind: entity.ShapeRectangle, Role: entity.ShapeRoleMain, Length: 5.2, Width: 3.4}},
+			area:   17.7, // 17,68
+		},
+		{
+			name: "lengths rounded to centimetre",
+			// 4,005 x 2,504 -> 4,01 x 2,50 = 10,025 -> 10,03 -> 10,0
+			shapes: []entity.MeasuredShape{{Kind: entity.ShapeRectangle, Role: entity.ShapeRoleMain, Length: 4.005, Width: 2.504}},
+			area:   10.0,
+		},
+		{
+			name: "column deduction",
+			shapes: []entity.MeasuredShape{
+				{Kind: entity.ShapeRectangle, Role: entity.ShapeRoleMain, Length: 6, Width: 4},
+				{Kind: entity.ShapeRectangle, Role: entity.ShapeRoleDeduction, Length: 0.5, Width: 0.5},
+			},
+			area: 23.8, // 24 - 0,25
+		},
+		{
+			name: "bay window and niches",
+			shapes: []entity.MeasuredShape{
+				{Kind: entity.ShapeRectangle, Role: entity.ShapeRoleMain, Length: 4, Width: 3},
+				{Kind: entity.ShapeSemicircle, Role: entity.ShapeRoleBayWindow, Diameter: 2},
+				{Kind: entity.ShapeRectangle, Role: entity.ShapeRoleNiche, Length: 1, Width: 0.5, Height: 2.1},
+				{Kind: entity.ShapeRectangle, Role: entity.ShapeRoleNiche, Length: 1, Width: 0.5, Height: 1.5},
+			},
+			area:     14.1, // 12 + 1,57 + 0,5; низкая ниша не учитывается
+			warnings: 1,
+		},
+		{
+			name:   "triangle",
+			shapes: []entity.MeasuredShape{{Kind: entity.ShapeTriangle, Role: entity.ShapeRoleMain, Sides: []float64{3, 4, 5}}},
+			area:   6.0,
+		},
+		{
+			name: "rectangle as polygon",
+			shapes: []entity.MeasuredShape{{
+				Kind: entity.ShapePolygon, Role: entity.ShapeRoleMain,
+				Sides: []float64{4, 3, 4, 3}, Diagonals: []float64{5},
+			}},
+			area: 12.0,
+		},
+		{
+			name: "pentagon",
+			// Квадрат 4x4 со срезанным углом 1x1: 15,5 кв.м
+			shapes: []entity.MeasuredShape{{
+				Kind: entity.ShapePolygon, Role: entity.ShapeRoleMain,
+				Sides:     []float64{4, 4, 3, 1.41, 3},
+				Diagonals: []float64{5.66, 4.12},
+			}},
+			area: 15.5,
+		},
+	}
+
+	calculator := geometry.NewCalculator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := calculator.Calculate(&entity.RoomMeasurements{Shapes: tt.shapes})
+			require.NoError(t, err)
+			assert.Equal(t, tt.area, result.Area)
+			assert.Len(t, result.Shapes, len(tt.shapes))
+			assert.Len(t, result.Warnings, tt.warnings)
+		})
+	}
+}
+
+func TestCalculator_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		shapes []entity.MeasuredShape
+		field  string
+	}{
+		{
+			name:  "no shapes",
+			field: "shapes",
+		},
+		{
+			name:   "no main shape",
+			shapes: []entity.MeasuredShape{{Kind: entity.ShapeRectangle, Role: entity.ShapeRoleBayWindow, Length: 1, Width: 1}},
+			field:  "shapes",
+		},
+		{
+			name:   "impossible triangle",
+			shapes: []entity.MeasuredShape{{Kind: entity.ShapeTriangle, Role: entity.ShapeRoleMain, Sides: []float64{1, 2, 3}}},
+			field:  "shapes[0]",
+		},
+		{
+			name:   "missing diagonals",
+			shapes: []entity.MeasuredShape{{Kind: entity.ShapePolygon, Role: entity.ShapeRoleMain, Sides: []float64{4, 3, 4, 3}}},
+			field:  "shapes[0].diagonals",
+		},
+		{
+			name:   "niche without height",
+			shapes: []entity.MeasuredShape{{Kind: entity.ShapeRectangle, Role: entity.ShapeRoleMain, Length: 3, Width: 3}, {Kind: entity.ShapeRectangle, Role: entity.ShapeRoleNiche, Length: 1, Width: 1}},
+			field:  "shapes[1].height",
+		},
+		{
+			name: "deduction exceeds room",
+			shapes: []entity.MeasuredShape{
+				{Kind: entity.ShapeRectangle, Role: entity.ShapeRoleMain, Length: 1, Width: 1},
+				{Kind: entity.ShapeRectangle, Role: entity.ShapeRoleDeduction, Length: 2, Width: 2},
+			},
+			field: "shapes",
+		},
+	}
+
+	calculator := geometry.NewCalculator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calculator.Calculate(&entity.RoomMeasurements{Shapes: tt.shapes})
+			var ve entity.ValidationError
+			require.ErrorAs(t, err, &ve)
+			assert.Equal(t, tt.field, ve.Field)
+		})
+	}
+}
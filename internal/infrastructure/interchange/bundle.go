@@ -0,0 +1,139 @@
+package interchange
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+const (
+	// BundleDocument имя документа обмена в архиве
+	BundleDocument = "passports.techpassport.json"
+
+	// bundleAttachmentsDir каталог содержимого вложений в архиве: attachments/<ID>
+	bundleAttachmentsDir = "attachments/"
+)
+
+// zipSignature начало локального заголовка файла ZIP
+var zipSignature = []byte("PK\x03\x04")
+
+// Bundle реализация service.InterchangeBundle: ZIP-архив с документом обмена
+// и файлами вложений, названными по SHA-256 содержимого
+type Bundle struct{}
+
+// NewBundle создает архиватор формата обмена
+func NewBundle() *Bundle {
+	return &Bundle{}
+}
+
+var _ service.InterchangeBundle = (*Bundle)(nil)
+
+// IsBundle проверяет сигнатуру ZIP
+func (b *Bundle) IsBundle(data []byte) bool {
+	return bytes.HasPrefix(data, zipSignature)
+}
+
+// Pack собирает архив; вложения записываются в порядке ID, чтобы архив одних
+// и тех же паспортов не зависел от порядка обхода
+func (b *Bundle) Pack(ctx context.Context, document []byte, attachments map[string][]byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	if err := writeBundleFile(w, BundleDocument, document, zip.Deflate); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(attachments))
+	for id := range attachments {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// Сканы и фотографии уже сжаты, повторное сжатие только замедляет выгрузку
+		if err := writeBundleFile(w, bundleAttachmentsDir+id, attachments[id], zip.Store); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write bundle: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Unpack читает архив. Посторонние файлы архива пропускаются; соответствие
+// содержимого вложений их ID проверяет вызывающая сторона.
+func (b *Bundle) Unpack(ctx context.Context, data []byte) ([]byte, map[string][]byte, error) {
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, nil, entity.ValidationError{Field: "bundle", Message: "некорректный архив: " + err.Error()}
+	}
+
+	var document []byte
+	attachments := map[string][]byte{}
+	for _, f := range r.File {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		name := path.Clean(f.Name)
+		switch {
+		case name == BundleDocument:
+			if document, err = readBundleFile(f); err != nil {
+				return nil, nil, err
+			}
+		case strings.HasPrefix(name, bundleAttachmentsDir):
+			id := strings.TrimPrefix(name, bundleAttachmentsDir)
+			if !entity.IsAttachmentID(id) {
+				return nil, nil, entity.ValidationError{Field: f.Name, Message: "имя файла вложения должно быть SHA-256 содержимого"}
+			}
+			if attachments[id], err = readBundleFile(f); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if document == nil {
+		return nil, nil, entity.ValidationError{Field: "bundle", Message: "в архиве нет документа " + BundleDocument}
+	}
+	return document, attachments, nil
+}
+
+// writeBundleFile добавляет файл в архив
+func writeBundleFile(w *zip.Writer, name string, data []byte, method uint16) error {
+	f, err := w.CreateHeader(&zip.FileHeader{Name: name, Method: method})
+	if err != nil {
+		return fmt.Errorf("failed to write bundle: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		return fmt.Errorf("failed to write bundle: %w", err)
+	}
+	return nil
+}
+
+// readBundleFile читает файл архива
+func readBundleFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, entity.ValidationError{Field: f.Name, Message: "файл архива поврежден: " + err.Error()}
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, entity.ValidationError{Field: f.Name, Message: "файл архива поврежден: " + err.Error()}
+	}
+	return data, nil
+}
@@ -0,0 +1,140 @@
+// Package interchange реализует версионированный JSON формат обмена паспортами
+// между рабочими местами
+package interchange
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+const (
+	// FormatName значение поля format документа обмена
+	FormatName = "techpassport"
+
+	// CurrentVersion текущая версия схемы формата обмена
+	CurrentVersion = 3
+
+	// SchemaID идентификатор JSON Schema текущей версии
+	SchemaID = "urn:gotechpasport:interchange:v3"
+)
+
+//go:embed schema/techpassport.v3.schema.json
+var schemaV3 []byte
+
+// envelope документ обмена текущей версии
+type envelope struct {
+	Schema        string                      `json:"$schema"`
+	Format        string                      `json:"format"`
+	SchemaVersion int                         `json:"schema_version"`
+	ExportedAt    time.Time                   `json:"exported_at"`
+	Generator     string                      `json:"generator,omitempty"`
+	Passports     []*entity.TechnicalPassport `json:"passports"`
+}
+
+// Codec реализация service.InterchangeCodec
+type Codec struct {
+	now func() time.Time
+}
+
+// NewCodec создает кодек формата обмена
+func NewCodec() *Codec {
+	return &Codec{now: time.Now}
+}
+
+var _ service.InterchangeCodec = (*Codec)(nil)
+
+// Schema возвращает JSON Schema текущей версии формата
+func (c *Codec) Schema() []byte {
+	return schemaV3
+}
+
+// Encode формирует документ обмена текущей версии
+func (c *Codec) Encode(ctx context.Context, passports []*entity.TechnicalPassport) ([]byte, error) {
+	doc := envelope{
+		Schema:        SchemaID,
+		Format:        FormatName,
+		SchemaVersion: CurrentVersion,
+		ExportedAt:    c.now().UTC(),
+		Generator:     "GoTechPasport",
+		Passports:     passports,
+	}
+	if doc.Passports == nil {
+		doc.Passports = []*entity.TechnicalPassport{}
+	}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode interchange document: %w", err)
+	}
+
+	return data, nil
+}
+
+// Decode читает документ обмена, выполняя миграцию к текущей версии
+func (c *Codec) Decode(ctx context.Context, data []byte) (*service.InterchangeDocument, error) {
+	var raw interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, entity.ValidationError{Field: "document", Message: "некорректный JSON: " + err.Error()}
+	}
+
+	version, err := detectVersion(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := migrate(raw, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if doc["format"] != FormatName {
+		return nil, entity.ValidationError{Field: "format", Message: fmt.Sprintf("ожидается формат %q", FormatName)}
+	}
+
+	items, ok := doc["passports"].([]interface{})
+	if !ok {
+		return nil, entity.ValidationError{Field: "passports", Message: "ожидается массив паспортов"}
+	}
+
+	result := &service.InterchangeDocument{
+		SourceVersion: version,
+		Passports:     make([]*entity.TechnicalPassport, 0, len(items)),
+	}
+
+	for i, item := range items {
+		passport, err := decodePassport(item)
+		if err != nil {
+			return nil, entity.ValidationError{Field: fmt.Sprintf("passports[%d]", i), Message: err.Error()}
+		}
+		result.Passports = append(result.Passports, passport)
+	}
+
+	return result, nil
+}
+
+// decodePassport строго декодирует паспорт: неизвестные поля считаются ошибкой
+func decodePassport(item interface{}) (*entity.TechnicalPassport, error) {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var passport entity.TechnicalPassport
+	if err := decoder.Decode(&passport); err != nil {
+		return nil, err
+	}
+
+	return &passport, nil
+}
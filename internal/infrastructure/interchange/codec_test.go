@@ -0,0 +1,163 @@
+package interchange_test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/interchange"
+)
+
+func samplePassport() *entity.TechnicalPassport {
+	p := entity.NewTechnicalPassport(entity.ObjectTypeResidentialHouse, entity.Address{Subject: "г. Москва", House: "1"})
+	p.ID = "TP-1"
+	p.OrganizationName = "ГУП БТИ"
+	p.GeneralInfo = entity.GeneralInfo{Purpose: "Жилое", ConstructionYear: 2020, TotalArea: 100.5}
+	p.Buildings = append(p.Buildings, entity.Building{Litera: "А", Name: "Жилой дом", CommissionYear: 2020})
+	p.AddAuditEntry("create", "Создан технический паспорт")
+	return p
+}
+
+func TestCodec_RoundTrip(t *testing.T) {
+	codec := interchange.NewCodec()
+	ctx := context.Background()
+
+	data, err := codec.Encode(ctx, []*entity.TechnicalPassport{samplePassport()})
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"schema_version": 3`)
+	assert.Contains(t, string(data), `"format": "techpassport"`)
+
+	doc, err := codec.Decode(ctx, data)
+	require.NoError(t, err)
+	assert.Equal(t, interchange.CurrentVersion, doc.SourceVersion)
+	require.Len(t, doc.Passports, 1)
+	assert.Equal(t, "TP-1", doc.Passports[0].ID)
+	assert.Equal(t, "А", doc.Passports[0].Buildings[0].Litera)
+	assert.Equal(t, "create", doc.Passports[0].AuditLog[0].Action)
+}
+
+func TestCodec_MigratesVersion1(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		count int
+	}{
+		{
+			name:  "один паспорт без конверта",
+			input: `{"id": "TP-1", "object_type": "apartment", "address": {"subject": "г. Москва", "house": "1"}, "general_info": {}}`,
+			count: 1,
+		},
+		{
+			name: "массив паспортов со старым журналом",
+			input: `[
+				{"id": "TP-1", "object_type": "apartment", "address": {"subject": "г. Москва", "house": "1"}, "general_info": {},
+				 "audit_log": [{"Timestamp": "2024-01-01T00:00:00Z", "Action": "create", "User": "", "Description": "Создан"}]},
+				{"id": "TP-2", "object_type": "room", "status": "approved", "address": {"subject": "г. Москва", "house": "2"}, "general_info": {}}
+			]`,
+			count: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := interchange.NewCodec().Decode(context.Background(), []byte(tt.input))
+			require.NoError(t, err)
+
+			assert.Equal(t, 1, doc.SourceVersion)
+			require.Len(t, doc.Passports, tt.count)
+			assert.Equal(t, entity.PassportStatusDraft, doc.Passports[0].Status)
+			if tt.count > 1 {
+				assert.Equal(t, "create", doc.Passports[0].AuditLog[0].Action)
+				assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), doc.Passports[0].AuditLog[0].Timestamp)
+				assert.Equal(t, entity.PassportStatusApproved, doc.Passports[1].Status)
+			}
+		})
+	}
+}
+
+func TestCodec_MigratesVersion2FloorPlans(t *testing.T) {
+	input := `{"format": "techpassport", "schema_version": 2, "passports": [
+		{"id": "TP-1", "object_type": "apartment", "address": {"subject": "г. Москва", "house": "1"}, "general_info": {},
+		 "floor_plans": ["C:\\БТИ\\планы\\этаж1.png"]}
+	]}`
+
+	doc, err := interchange.NewCodec().Decode(context.Background(), []byte(input))
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, doc.SourceVersion)
+	require.Len(t, doc.Passports[0].FloorPlans, 1)
+	assert.Equal(t, `C:\БТИ\планы\этаж1.png`, doc.Passports[0].FloorPlans[0].Background)
+	assert.NoError(t, doc.Passports[0].FloorPlans[0].IsValid())
+}
+
+func TestCodec_DecodeErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		field string
+	}{
+		{"некорректный JSON", `{`, "document"},
+		{"новая версия схемы", `{"format": "techpassport", "schema_version": 4, "passports": []}`, "schema_version"},
+		{"конверт без версии", `{"format": "techpassport", "passports": []}`, "schema_version"},
+		{"чужой формат", `{"format": "other", "schema_version": 2, "passports": []}`, "format"},
+		{"неизвестное поле паспорта", `{"format": "techpassport", "schema_version": 2, "passports": [{"unknown": 1}]}`, "passports[0]"},
+		{"скаляр вместо документа", `42`, "document"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := interchange.NewCodec().Decode(context.Background(), []byte(tt.input))
+
+			var validationErr entity.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+}
+
+// TestSchema_CoversEntities проверяет, что опубликованная схема описывает
+// все поля сущностей: новое поле без обновления схемы ломает этот тест
+func TestSchema_CoversEntities(t *testing.T) {
+	var schema struct {
+		Defs map[string]struct {
+			Properties map[string]json.RawMessage `json:"properties"`
+		} `json:"$defs"`
+	}
+	require.NoError(t, json.Unmarshal(interchange.NewCodec().Schema(), &schema))
+
+	types := []interface{}{
+		entity.TechnicalPassport{}, entity.Address{}, entity.GeneralInfo{}, entity.Building{},
+		entity.Owner{}, entity.Room{}, entity.Utilities{}, entity.UtilityConnection{}, entity.AuditEntry{},
+		entity.Attachment{}, entity.ValuationInputs{}, entity.BuildingValuation{},
+		entity.StructuralElement{}, entity.ElementWearShare{}, entity.WearAssessment{},
+		entity.Entrance{}, entity.Flat{}, entity.CommercialInfo{}, entity.Structure{},
+	}
+
+	for _, v := range types {
+		typ := reflect.TypeOf(v)
+		def, ok := schema.Defs[typ.Name()]
+		require.True(t, ok, "в схеме нет определения %s", typ.Name())
+
+		fields := map[string]bool{}
+		for i := 0; i < typ.NumField(); i++ {
+			// Неэкспортируемые поля (события паспорта) не сериализуются
+			if !typ.Field(i).IsExported() {
+				continue
+			}
+			name, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+			require.NotEmpty(t, name, "%s.%s без json тега", typ.Name(), typ.Field(i).Name)
+			fields[name] = true
+			assert.Contains(t, def.Properties, name, "%s.%s отсутствует в схеме", typ.Name(), name)
+		}
+		for name := range def.Properties {
+			assert.True(t, fields[name], "%s: свойство %s схемы отсутствует в сущности", typ.Name(), name)
+		}
+	}
+}
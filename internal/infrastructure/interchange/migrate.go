@@ -0,0 +1,155 @@
+package interchange
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+)
+
+// История версий формата обмена:
+//
+//	1 - паспорт или массив паспортов без конверта, как их хранит
+//	    JSONPassportRepository до появления формата обмена. Поле status
+//	    может отсутствовать, ключи записей audit_log записаны с заглавной буквы.
+//	2 - конверт с полями format, schema_version, exported_at и passports.
+//	3 - поэтажные планы (floor_plans) стали векторными объектами вместо путей
+//	    к файлам; прежний путь переносится в поле background плана.
+
+// migration переводит документ с версии N на версию N+1
+type migration func(doc interface{}) (map[string]interface{}, error)
+
+// migrations миграции по исходной версии
+var migrations = map[int]migration{
+	1: migrateV1ToV2,
+	2: migrateV2ToV3,
+}
+
+// detectVersion определяет версию схемы документа
+func detectVersion(raw interface{}) (int, error) {
+	switch doc := raw.(type) {
+	case []interface{}:
+		return 1, nil
+	case map[string]interface{}:
+		value, ok := doc["schema_version"]
+		if !ok {
+			if _, hasFormat := doc["format"]; hasFormat {
+				return 0, entity.ValidationError{Field: "schema_version", Message: "версия схемы обязательна"}
+			}
+			return 1, nil
+		}
+
+		number, ok := value.(json.Number)
+		if !ok {
+			return 0, entity.ValidationError{Field: "schema_version", Message: "версия схемы должна быть числом"}
+		}
+		version, err := number.Int64()
+		if err != nil || version < 1 {
+			return 0, entity.ValidationError{Field: "schema_version", Message: "некорректная версия схемы"}
+		}
+		if version > CurrentVersion {
+			return 0, entity.ValidationError{
+				Field:   "schema_version",
+				Message: fmt.Sprintf("версия схемы %d новее поддерживаемой (%d), обновите приложение", version, CurrentVersion),
+			}
+		}
+		return int(version), nil
+	default:
+		return 0, entity.ValidationError{Field: "document", Message: "ожидается объект или массив паспортов"}
+	}
+}
+
+// migrate последовательно применяет миграции до текущей версии
+func migrate(raw interface{}, version int) (map[string]interface{}, error) {
+	doc := raw
+	for v := version; v < CurrentVersion; v++ {
+		step, ok := migrations[v]
+		if !ok {
+			return nil, fmt.Errorf("no migration from interchange schema version %d", v)
+		}
+
+		next, err := step(doc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to migrate interchange schema from version %d: %w", v, err)
+		}
+		doc = next
+	}
+
+	envelope, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, entity.ValidationError{Field: "document", Message: "ожидается объект с конвертом формата"}
+	}
+
+	return envelope, nil
+}
+
+// migrateV1ToV2 оборачивает паспорта в конверт, заполняет статус
+// и приводит ключи журнала изменений к snake_case
+func migrateV1ToV2(doc interface{}) (map[string]interface{}, error) {
+	var passports []interface{}
+	switch v := doc.(type) {
+	case []interface{}:
+		passports = v
+	case map[string]interface{}:
+		passports = []interface{}{v}
+	default:
+		return nil, entity.ValidationError{Field: "document", Message: "ожидается объект или массив паспортов"}
+	}
+
+	for _, item := range passports {
+		passport, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if status, _ := passport["status"].(string); status == "" {
+			passport["status"] = string(entity.PassportStatusDraft)
+		}
+
+		entries, _ := passport["audit_log"].([]interface{})
+		for i, e := range entries {
+			entry, ok := e.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			normalized := make(map[string]interface{}, len(entry))
+			for key, value := range entry {
+				normalized[strings.ToLower(key)] = value
+			}
+			entries[i] = normalized
+		}
+	}
+
+	return map[string]interface{}{
+		"format":         FormatName,
+		"schema_version": json.Number("2"),
+		"passports":      passports,
+	}, nil
+}
+
+// migrateV2ToV3 переносит пути к файлам поэтажных планов в подложку векторного плана
+func migrateV2ToV3(doc interface{}) (map[string]interface{}, error) {
+	envelope, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, entity.ValidationError{Field: "document", Message: "ожидается объект с конвертом формата"}
+	}
+
+	passports, _ := envelope["passports"].([]interface{})
+	for _, item := range passports {
+		passport, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		plans, _ := passport["floor_plans"].([]interface{})
+		for i, plan := range plans {
+			if path, ok := plan.(string); ok {
+				plans[i] = map[string]interface{}{"background": path}
+			}
+		}
+	}
+
+	envelope["schema_version"] = json.Number("3")
+	return envelope, nil
+}
@@ -0,0 +1,108 @@
+package outbox
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+)
+
+// natsTimeout время на соединение и подтверждение публикации
+const natsTimeout = 10 * time.Second
+
+// NATSSink публикует событие в NATS или совместимый сервер по текстовому
+// протоколу в тему <subject>.<тип события>. Ключ идемпотентности передается
+// заголовком Nats-Msg-Id: JetStream отбрасывает повторы по нему. Публикация
+// подтверждается обменом PING/PONG.
+type NATSSink struct {
+	addr    string
+	subject string
+}
+
+// NewNATSSink создает получателя NATS; addr - host:port сервера
+func NewNATSSink(addr, subject string) *NATSSink {
+	return &NATSSink{
+		addr:    addr,
+		subject: subject,
+	}
+}
+
+// Name имя получателя
+func (s *NATSSink) Name() string {
+	return "nats:" + s.addr + "/" + s.subject
+}
+
+// Deliver публикует событие
+func (s *NATSSink) Deliver(ctx context.Context, message entity.OutboxMessage) error {
+	body, err := payload(message)
+	if err != nil {
+		return err
+	}
+
+	dialer := net.Dialer{Timeout: natsTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to nats: %w", err)
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(natsTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	_ = conn.SetDeadline(deadline)
+
+	reader := bufio.NewReader(conn)
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("failed to read nats info: %w", err)
+	}
+	info, ok := strings.CutPrefix(strings.TrimSpace(line), "INFO ")
+	if !ok {
+		return fmt.Errorf("unexpected nats greeting: %q", strings.TrimSpace(line))
+	}
+	var server struct {
+		Headers bool `json:"headers"`
+	}
+	if err := json.Unmarshal([]byte(info), &server); err != nil {
+		return fmt.Errorf("failed to decode nats info: %w", err)
+	}
+
+	subject := s.subject + "." + string(message.Event.Type)
+	var cmd strings.Builder
+	cmd.WriteString(`CONNECT {"verbose":false,"pedantic":false,"headers":` + fmt.Sprint(server.Headers) + "}\r\n")
+	if server.Headers {
+		headers := "NATS/1.0\r\nNats-Msg-Id: " + message.ID + "\r\n\r\n"
+		fmt.Fprintf(&cmd, "HPUB %s %d %d\r\n%s%s\r\n", subject, len(headers), len(headers)+len(body), headers, body)
+	} else {
+		fmt.Fprintf(&cmd, "PUB %s %d\r\n%s\r\n", subject, len(body), body)
+	}
+	cmd.WriteString("PING\r\n")
+	if _, err := conn.Write([]byte(cmd.String())); err != nil {
+		return fmt.Errorf("failed to publish to nats: %w", err)
+	}
+
+	// Ошибки протокола приходят до PONG
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("failed to confirm nats publish: %w", err)
+		}
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := conn.Write([]byte("PONG\r\n")); err != nil {
+				return fmt.Errorf("failed to answer nats ping: %w", err)
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("nats: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+	}
+}
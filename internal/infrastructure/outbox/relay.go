@@ -0,0 +1,225 @@
+// Package outbox доставляет сообщения outbox событий паспортов во внешние
+// системы: webhook, NATS, файл
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// Параметры доставки по умолчанию
+const (
+	defaultMaxAttempts = 8
+	defaultBackoff     = 5 * time.Second
+	defaultMaxBackoff  = 10 * time.Minute
+	defaultBatchSize   = 100
+)
+
+// permanentError ошибка, повтор после которой не имеет смысла
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent помечает ошибку получателя как окончательную: сообщение сразу
+// переносится в недоставленные
+func Permanent(err error) error {
+	return permanentError{err: err}
+}
+
+// IsPermanent проверяет что ошибка окончательная
+func IsPermanent(err error) bool {
+	var permanent permanentError
+	return errors.As(err, &permanent)
+}
+
+// Options параметры доставки
+type Options struct {
+	// MaxAttempts число попыток, после которого сообщение переносится
+	// в недоставленные (по умолчанию 8)
+	MaxAttempts int
+
+	// Backoff задержка перед первым повтором; каждая следующая вдвое больше,
+	// но не больше MaxBackoff (по умолчанию 5 с и 10 мин)
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+
+	// BatchSize число сообщений за один проход (по умолчанию 100)
+	BatchSize int
+
+	// Now текущее время; nil - time.Now
+	Now func() time.Time
+
+	// OnError получает ошибки проходов Run; nil - ошибки отбрасываются
+	OnError func(error)
+}
+
+// Stats итоги прохода доставки
+type Stats struct {
+	Delivered    int `json:"delivered"`
+	Retried      int `json:"retried"`
+	DeadLettered int `json:"dead_lettered"`
+
+	// Deferred сообщения, время повтора которых не наступило, и сообщения
+	// паспортов, более раннее событие которых еще не доставлено
+	Deferred int `json:"deferred"`
+}
+
+// Relay доставляет сообщения outbox всем получателям. Сообщение удаляется из
+// outbox, когда доставлено каждому получателю; получателям, которые уже приняли
+// сообщение, оно повторно не отправляется. События одного паспорта доставляются
+// по порядку: пока более раннее сообщение ждет повтора, следующие откладываются.
+type Relay struct {
+	outbox repository.OutboxRepository
+	sinks  []service.EventSink
+	opts   Options
+}
+
+// NewRelay создает доставку сообщений outbox получателям sinks
+func NewRelay(outbox repository.OutboxRepository, sinks []service.EventSink, opts Options) *Relay {
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = defaultMaxAttempts
+	}
+	if opts.Backoff <= 0 {
+		opts.Backoff = defaultBackoff
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = defaultMaxBackoff
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultBatchSize
+	}
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+	if opts.OnError == nil {
+		opts.OnError = func(error) {}
+	}
+
+	return &Relay{
+		outbox: outbox,
+		sinks:  sinks,
+		opts:   opts,
+	}
+}
+
+// Run доставляет сообщения каждые interval до отмены ctx
+func (r *Relay) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			r.opts.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce выполняет один проход доставки ожидающих сообщений
+func (r *Relay) RunOnce(ctx context.Context) (Stats, error) {
+	var stats Stats
+
+	if len(r.sinks) == 0 {
+		return stats, fmt.Errorf("no event sinks configured")
+	}
+
+	messages, err := r.outbox.Pending(ctx, r.opts.BatchSize)
+	if err != nil {
+		return stats, fmt.Errorf("failed to read outbox: %w", err)
+	}
+
+	now := r.opts.Now()
+	blocked := map[string]bool{}
+	for _, message := range messages {
+		if err := ctx.Err(); err != nil {
+			return stats, err
+		}
+
+		passportID := message.Event.PassportID
+		if blocked[passportID] || message.NextAttemptAt.After(now) {
+			blocked[passportID] = true
+			stats.Deferred++
+			continue
+		}
+
+		err := r.deliver(ctx, &message)
+		if err == nil {
+			if err := r.outbox.Complete(ctx, message.ID); err != nil {
+				return stats, fmt.Errorf("failed to complete outbox message: %w", err)
+			}
+			stats.Delivered++
+			continue
+		}
+		if ctx.Err() != nil {
+			return stats, ctx.Err()
+		}
+
+		message.Attempts++
+		message.LastError = err.Error()
+		if IsPermanent(err) || message.Attempts >= r.opts.MaxAttempts {
+			if err := r.outbox.DeadLetter(ctx, message); err != nil {
+				return stats, fmt.Errorf("failed to dead-letter outbox message: %w", err)
+			}
+			stats.DeadLettered++
+			continue
+		}
+
+		message.NextAttemptAt = now.Add(r.backoff(message.Attempts))
+		if err := r.outbox.Save(ctx, message); err != nil {
+			return stats, fmt.Errorf("failed to save outbox message: %w", err)
+		}
+		blocked[passportID] = true
+		stats.Retried++
+	}
+
+	return stats, nil
+}
+
+// deliver отправляет сообщение получателям, которым оно еще не доставлено;
+// возвращает первую ошибку, отметив успешные доставки
+func (r *Relay) deliver(ctx context.Context, message *entity.OutboxMessage) error {
+	var first error
+	for _, sink := range r.sinks {
+		if message.DeliveredTo(sink.Name()) {
+			continue
+		}
+		if err := sink.Deliver(ctx, *message); err != nil {
+			if first == nil {
+				first = fmt.Errorf("%s: %w", sink.Name(), err)
+			}
+			continue
+		}
+		message.Delivered = append(message.Delivered, sink.Name())
+	}
+	return first
+}
+
+// backoff задержка перед повтором после attempts неудачных попыток
+func (r *Relay) backoff(attempts int) time.Duration {
+	delay := r.opts.Backoff
+	for i := 1; i < attempts && delay < r.opts.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > r.opts.MaxBackoff {
+		delay = r.opts.MaxBackoff
+	}
+	return delay
+}
@@ -0,0 +1,339 @@
+package outbox_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/outbox"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/file"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// standIn локальный получатель webhook: отвечает кодами из statuses по порядку
+// (затем 200) и запоминает принятые события
+type standIn struct {
+	mu       sync.Mutex
+	statuses []int
+	keys     []string
+	events   []entity.DomainEvent
+}
+
+func (s *standIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status := http.StatusOK
+	if len(s.statuses) > 0 {
+		status, s.statuses = s.statuses[0], s.statuses[1:]
+	}
+	if status == http.StatusOK {
+		var event entity.DomainEvent
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil || r.Header.Get(outbox.HeaderEventType) != string(event.Type) {
+			status = http.StatusBadRequest
+		} else {
+			s.keys = append(s.keys, r.Header.Get(outbox.HeaderIdempotencyKey))
+			s.events = append(s.events, event)
+		}
+	}
+	w.WriteHeader(status)
+}
+
+// newStore каталог паспортов с outbox и паспортом с одним зданием
+func newStore(t *testing.T, dir string) (*file.JSONPassportRepository, *file.JSONOutbox, *entity.TechnicalPassport) {
+	repo := file.NewJSONPassportRepository(dir)
+	box := file.NewJSONOutbox(repo)
+	assert.True(t, file.OutboxEnabled(dir))
+
+	p := entity.NewTechnicalPassport(entity.ObjectTypeResidentialHouse, entity.Address{Subject: "г. Москва", House: "1"})
+	p.ID = "TP-OUTBOX"
+	require.NoError(t, p.AddBuilding(entity.Building{Litera: "А", Name: "Жилой дом", CommissionYear: 1990}))
+	require.NoError(t, repo.Create(context.Background(), p))
+	return repo, box, p
+}
+
+func TestRelay_RetriesAndIdempotency(t *testing.T) {
+	ctx := context.Background()
+	_, box, p := newStore(t, t.TempDir())
+
+	pending, err := box.Pending(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assert.Equal(t, entity.EventPassportCreated, pending[0].Event.Type)
+	assert.Equal(t, p.ID, pending[0].Event.PassportID)
+	assert.Equal(t, pending[0].Event.ID, pending[0].ID)
+
+	receiver := &standIn{statuses: []int{http.StatusServiceUnavailable}}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+	eventsFile := filepath.Join(t.TempDir(), "events.jsonl")
+
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	relay := outbox.NewRelay(box, []service.EventSink{
+		outbox.NewFileSink(eventsFile),
+		outbox.NewWebhookSink(server.URL, server.Client()),
+	}, outbox.Options{Backoff: time.Minute, Now: func() time.Time { return now }})
+
+	// Первое событие не принято webhook: второе событие паспорта ждет его
+	stats, err := relay.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, outbox.Stats{Retried: 1, Deferred: 1}, stats)
+	pending, err = box.Pending(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, pending[0].Attempts)
+	assert.Equal(t, now.Add(time.Minute), pending[0].NextAttemptAt)
+	assert.Equal(t, []string{"file:" + eventsFile}, pending[0].Delivered)
+	assert.Contains(t, pending[0].LastError, "503")
+
+	// До наступления времени повтора сообщения не отправляются
+	stats, err = relay.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, outbox.Stats{Deferred: 2}, stats)
+
+	now = now.Add(time.Minute)
+	stats, err = relay.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, outbox.Stats{Delivered: 2}, stats)
+
+	pending, err = box.Pending(ctx, 0)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	require.Len(t, receiver.events, 2)
+	assert.Equal(t, entity.EventBuildingAdded, receiver.events[1].Type)
+	assert.Equal(t, "А", receiver.events[1].Litera)
+	assert.Equal(t, []string{receiver.events[0].ID, receiver.events[1].ID}, receiver.keys)
+
+	// Файловый получатель принял каждое событие один раз
+	data, err := os.ReadFile(eventsFile)
+	require.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(string(data), "\n"))
+	sink := outbox.NewFileSink(eventsFile)
+	require.NoError(t, sink.Deliver(ctx, entity.OutboxMessage{ID: receiver.keys[0], Event: receiver.events[0]}))
+	data, err = os.ReadFile(eventsFile)
+	require.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(string(data), "\n"))
+}
+
+func TestRelay_DeadLetters(t *testing.T) {
+	ctx := context.Background()
+	_, box, _ := newStore(t, t.TempDir())
+
+	receiver := &standIn{statuses: []int{http.StatusUnprocessableEntity, http.StatusBadGateway, http.StatusBadGateway}}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+	relay := outbox.NewRelay(box, []service.EventSink{outbox.NewWebhookSink(server.URL, server.Client())},
+		outbox.Options{MaxAttempts: 2, Backoff: time.Nanosecond})
+
+	// 422 - окончательная ошибка, 502 - повтор до исчерпания попыток
+	stats, err := relay.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, outbox.Stats{DeadLettered: 1, Retried: 1}, stats)
+	time.Sleep(time.Millisecond)
+	stats, err = relay.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, outbox.Stats{DeadLettered: 1}, stats)
+
+	dead, err := box.DeadLetters(ctx)
+	require.NoError(t, err)
+	require.Len(t, dead, 2)
+	assert.Equal(t, 1, dead[0].Attempts)
+	assert.Contains(t, dead[0].LastError, "422")
+	assert.Equal(t, 2, dead[1].Attempts)
+
+	require.NoError(t, box.Requeue(ctx, dead[0].ID))
+	stats, err = relay.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, outbox.Stats{Delivered: 1}, stats)
+	dead, err = box.DeadLetters(ctx)
+	require.NoError(t, err)
+	assert.Len(t, dead, 1)
+}
+
+func TestJSONOutbox_FailedWriteDiscardsEvents(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	repo, box, _ := newStore(t, dir)
+
+	pending, err := box.Pending(ctx, 0)
+	require.NoError(t, err)
+	for _, m := range pending {
+		require.NoError(t, box.Complete(ctx, m.ID))
+	}
+
+	// Файл паспорта не удается заменить: события изменения не попадают в outbox
+	p, err := repo.GetByID(ctx, "TP-OUTBOX")
+	require.NoError(t, err)
+	p.ID = "TP-BROKEN"
+	path := filepath.Join(dir, "TP-BROKEN.json")
+	require.NoError(t, os.MkdirAll(filepath.Join(path, "locked"), 0o700))
+	require.NoError(t, p.Archive())
+	require.Error(t, repo.Update(ctx, p))
+
+	pending, err = box.Pending(ctx, 0)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+	entries, err := os.ReadDir(filepath.Join(dir, "outbox", "prepared"))
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestNATSSink(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		conn.Write([]byte(`INFO {"server_id":"stand-in","headers":true}` + "\r\n"))
+		reader := bufio.NewReader(conn)
+		var lines []string
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			if strings.TrimSpace(line) == "PING" {
+				break
+			}
+			lines = append(lines, line)
+		}
+		conn.Write([]byte("PONG\r\n"))
+		received <- strings.Join(lines, "")
+	}()
+
+	event := entity.DomainEvent{ID: "EV-1", Type: entity.EventStatusChanged, PassportID: "TP-1", Status: entity.PassportStatusApproved}
+	sink := outbox.NewNATSSink(listener.Addr().String(), "techpassport")
+	require.NoError(t, sink.Deliver(context.Background(), entity.OutboxMessage{ID: "EV-1", Event: event}))
+
+	body, err := json.Marshal(event)
+	require.NoError(t, err)
+	headers := "NATS/1.0\r\nNats-Msg-Id: EV-1\r\n\r\n"
+	msg := <-received
+	assert.True(t, strings.HasPrefix(msg, `CONNECT {"verbose":false,"pedantic":false,"headers":true}`), msg)
+	assert.Contains(t, msg, fmt.Sprintf("HPUB techpassport.status_changed %d %d\r\n%s%s\r\n",
+		len(headers), len(headers)+len(body), headers, body))
+}
+
+// signedReceiver получатель webhook организации: проверяет подпись и отвечает
+// кодами из statuses по порядку (затем 200)
+type signedReceiver struct {
+	mu       sync.Mutex
+	secret   string
+	statuses []int
+	actions  []string
+}
+
+func (s *signedReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+	if r.Header.Get(outbox.HeaderSignature) != outbox.Sign(s.secret, r.Header.Get(outbox.HeaderTimestamp), body) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	status := http.StatusOK
+	if len(s.statuses) > 0 {
+		status, s.statuses = s.statuses[0], s.statuses[1:]
+	}
+	if status == http.StatusOK {
+		var event entity.DomainEvent
+		_ = json.Unmarshal(body, &event)
+		s.actions = append(s.actions, event.Action)
+	}
+	w.WriteHeader(status)
+}
+
+func TestWebhookDispatcher(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	repo := file.NewJSONPassportRepository(dir)
+	box := file.NewJSONOutbox(repo)
+	webhooks := file.NewJSONWebhookRepository(dir)
+	assert.False(t, file.WebhooksEnabled(dir))
+
+	lifecycle := &signedReceiver{secret: "lifecycle-secret-key", statuses: []int{http.StatusServiceUnavailable}}
+	buildings := &signedReceiver{secret: "buildings-secret-key", statuses: []int{http.StatusGone}}
+	other := &signedReceiver{secret: "other-org-secret-key"}
+	for i, hook := range []struct {
+		receiver     *signedReceiver
+		organization string
+		actions      []string
+	}{
+		{lifecycle, "ГУП БТИ", nil},
+		{buildings, "ГУП БТИ", []string{"add_building"}},
+		{other, "ООО Кадастр", nil},
+	} {
+		server := httptest.NewServer(hook.receiver)
+		defer server.Close()
+		require.NoError(t, webhooks.Create(ctx, &entity.Webhook{
+			ID: fmt.Sprintf("WH-%d", i+1), Organization: hook.organization, URL: server.URL,
+			Secret: hook.receiver.secret, Actions: hook.actions,
+		}))
+	}
+	assert.True(t, file.WebhooksEnabled(dir))
+
+	p := entity.NewTechnicalPassport(entity.ObjectTypeResidentialHouse, entity.Address{Subject: "г. Москва", House: "1"})
+	p.ID = "TP-WEBHOOK"
+	p.OrganizationName = "ГУП БТИ"
+	require.NoError(t, p.AddBuilding(entity.Building{Litera: "А", Name: "Жилой дом", CommissionYear: 1990}))
+	require.NoError(t, repo.Create(ctx, p))
+
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	relay := outbox.NewRelay(box, []service.EventSink{outbox.NewWebhookDispatcher(webhooks, nil)},
+		outbox.Options{Backoff: time.Minute, Now: func() time.Time { return now }})
+
+	// Временная ошибка webhook повторяется с задержкой
+	stats, err := relay.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, outbox.Stats{Retried: 1, Deferred: 1}, stats)
+
+	// Отказ 410 записывается в журнал и не задерживает событие
+	now = now.Add(time.Minute)
+	stats, err = relay.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, outbox.Stats{Delivered: 2}, stats)
+
+	assert.Equal(t, []string{"created"}, lifecycle.actions)
+	assert.Empty(t, buildings.actions)
+	assert.Empty(t, other.actions)
+
+	log, err := webhooks.Deliveries(ctx, repository.DeliveryFilter{})
+	require.NoError(t, err)
+	require.Len(t, log, 3)
+	assert.Equal(t, []entity.WebhookDeliveryStatus{entity.WebhookFailed, entity.WebhookDelivered, entity.WebhookRejected},
+		[]entity.WebhookDeliveryStatus{log[0].Status, log[1].Status, log[2].Status})
+	assert.Equal(t, 2, log[1].Attempt)
+	assert.Equal(t, "WH-2", log[2].WebhookID)
+	assert.Equal(t, "add_building", log[2].Action)
+	assert.Equal(t, http.StatusGone, log[2].StatusCode)
+
+	// Повторная доставка события не вызывает webhook, уже принявший его
+	pending := entity.NewOutboxMessage(entity.DomainEvent{
+		ID: log[1].EventID, Type: entity.EventPassportCreated, Action: "created",
+		PassportID: p.ID, Organization: p.OrganizationName,
+	})
+	require.NoError(t, outbox.NewWebhookDispatcher(webhooks, nil).Deliver(ctx, pending))
+	assert.Equal(t, []string{"created"}, lifecycle.actions)
+}
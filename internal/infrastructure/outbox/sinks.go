@@ -0,0 +1,237 @@
+package outbox
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// Заголовки HTTP запроса webhook
+const (
+	HeaderIdempotencyKey = "Idempotency-Key"
+	HeaderEventType      = "X-Event-Type"
+
+	// HeaderTimestamp и HeaderSignature время отправки (Unix, секунды) и подпись
+	// тела запроса для webhook организаций
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+var (
+	_ service.EventSink = (*WebhookSink)(nil)
+	_ service.EventSink = (*FileSink)(nil)
+	_ service.EventSink = (*NATSSink)(nil)
+	_ service.EventSink = (*WebhookDispatcher)(nil)
+)
+
+// payload тело сообщения для получателя: событие паспорта в JSON
+func payload(message entity.OutboxMessage) ([]byte, error) {
+	data, err := json.Marshal(message.Event)
+	if err != nil {
+		return nil, Permanent(fmt.Errorf("failed to encode event: %w", err))
+	}
+	return data, nil
+}
+
+// WebhookSink отправляет событие POST запросом с JSON телом. Ответ 2xx -
+// доставлено; 408, 429 и 5xx - повтор; остальные коды - окончательная ошибка.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink создает получателя webhook; client может быть nil
+func NewWebhookSink(url string, client *http.Client) *WebhookSink {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &WebhookSink{
+		url:    url,
+		client: client,
+	}
+}
+
+// Name имя получателя
+func (s *WebhookSink) Name() string {
+	return "webhook:" + s.url
+}
+
+// Deliver отправляет событие с ключом идемпотентности в заголовке
+func (s *WebhookSink) Deliver(ctx context.Context, message entity.OutboxMessage) error {
+	_, err := post(ctx, s.client, s.url, "", message)
+	return err
+}
+
+// post отправляет событие POST запросом и возвращает код ответа. Тело
+// подписывается ключом secret, если он задан (см. Sign).
+func post(ctx context.Context, client *http.Client, url, secret string, message entity.OutboxMessage) (int, error) {
+	body, err := payload(message)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, Permanent(fmt.Errorf("failed to create request: %w", err))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderIdempotencyKey, message.ID)
+	req.Header.Set(HeaderEventType, string(message.Event.Type))
+	if secret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(HeaderTimestamp, timestamp)
+		req.Header.Set(HeaderSignature, Sign(secret, timestamp, body))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return resp.StatusCode, nil
+	case resp.StatusCode == http.StatusRequestTimeout, resp.StatusCode == http.StatusTooManyRequests, resp.StatusCode >= 500:
+		return resp.StatusCode, fmt.Errorf("webhook responded %s", resp.Status)
+	default:
+		return resp.StatusCode, Permanent(fmt.Errorf("webhook responded %s", resp.Status))
+	}
+}
+
+// FileSink дописывает события в файл построчно (JSON Lines). Повтор
+// уже записанного сообщения пропускается по ключу идемпотентности.
+type FileSink struct {
+	mu   sync.Mutex
+	path string
+	seen map[string]bool
+}
+
+// NewFileSink создает получателя, пишущего в файл path
+func NewFileSink(path string) *FileSink {
+	return &FileSink{
+		path: path,
+	}
+}
+
+// Name имя получателя
+func (s *FileSink) Name() string {
+	return "file:" + s.path
+}
+
+// Deliver дописывает событие в файл
+func (s *FileSink) Deliver(ctx context.Context, message entity.OutboxMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.seen == nil {
+		if err := s.load(); err != nil {
+			return err
+		}
+	}
+	if s.seen[message.ID] {
+		return nil
+	}
+
+	line, err := payload(message)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open events file: %w", err)
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write events file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write events file: %w", err)
+	}
+
+	s.seen[message.ID] = true
+	return nil
+}
+
+// load читает ключи уже записанных событий
+func (s *FileSink) load() error {
+	s.seen = map[string]bool{}
+
+	f, err := os.Open(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open events file: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64<<10), 1<<20)
+	for scanner.Scan() {
+		var event struct {
+			ID string `json:"id"`
+		}
+		if json.Unmarshal(scanner.Bytes(), &event) == nil && event.ID != "" {
+			s.seen[event.ID] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		s.seen = nil
+		return fmt.Errorf("failed to read events file: %w", err)
+	}
+	return nil
+}
+
+// SinkConfig получатели, заданные в настройках приложения
+type SinkConfig struct {
+	// Webhook адрес webhook
+	Webhook string
+
+	// NATS адрес сервера NATS (host:port) и префикс темы
+	NATS        string
+	NATSSubject string
+
+	// File файл JSON Lines
+	File string
+}
+
+// DefaultNATSSubject префикс темы NATS по умолчанию
+const DefaultNATSSubject = "techpassport.events"
+
+// Sinks создает заданных получателей
+func (c SinkConfig) Sinks() []service.EventSink {
+	var sinks []service.EventSink
+	if c.Webhook != "" {
+		sinks = append(sinks, NewWebhookSink(c.Webhook, nil))
+	}
+	if c.NATS != "" {
+		subject := c.NATSSubject
+		if subject == "" {
+			subject = DefaultNATSSubject
+		}
+		sinks = append(sinks, NewNATSSink(c.NATS, subject))
+	}
+	if c.File != "" {
+		sinks = append(sinks, NewFileSink(c.File))
+	}
+	return sinks
+}
@@ -0,0 +1,108 @@
+package outbox
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// Sign подпись тела запроса webhook: "sha256=" и hex HMAC-SHA256 ключом secret
+// от строки "<timestamp>.<body>". Получатель пересчитывает подпись по заголовку
+// X-Webhook-Timestamp и отклоняет устаревшие запросы.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookDispatcher рассылает событие webhook организации паспорта, подписанным
+// на действие события. Каждая попытка записывается в журнал доставки; webhook,
+// уже принявший событие или окончательно отклонивший его, повторно не вызывается.
+// Временная ошибка любого webhook возвращается Relay, и событие повторяется
+// с экспоненциальной задержкой.
+type WebhookDispatcher struct {
+	webhooks repository.WebhookRepository
+	client   *http.Client
+}
+
+// NewWebhookDispatcher создает рассылку по зарегистрированным webhook; client может быть nil
+func NewWebhookDispatcher(webhooks repository.WebhookRepository, client *http.Client) *WebhookDispatcher {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &WebhookDispatcher{
+		webhooks: webhooks,
+		client:   client,
+	}
+}
+
+// Name имя получателя
+func (d *WebhookDispatcher) Name() string {
+	return "webhooks"
+}
+
+// Deliver отправляет событие webhook организации
+func (d *WebhookDispatcher) Deliver(ctx context.Context, message entity.OutboxMessage) error {
+	event := message.Event
+	if event.Organization == "" || event.Action == "" {
+		return nil
+	}
+
+	webhooks, err := d.webhooks.List(ctx, event.Organization)
+	if err != nil {
+		return fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	var first error
+	for _, webhook := range webhooks {
+		if !webhook.Subscribed(event.Action) {
+			continue
+		}
+
+		previous, err := d.webhooks.Deliveries(ctx, repository.DeliveryFilter{WebhookID: webhook.ID, EventID: event.ID})
+		if err != nil {
+			return fmt.Errorf("failed to read delivery log: %w", err)
+		}
+		if len(previous) > 0 && previous[len(previous)-1].Finished() {
+			continue
+		}
+
+		delivery := entity.WebhookDelivery{
+			WebhookID:  webhook.ID,
+			EventID:    event.ID,
+			Action:     event.Action,
+			PassportID: event.PassportID,
+			Attempt:    len(previous) + 1,
+			Status:     entity.WebhookDelivered,
+			Timestamp:  time.Now(),
+		}
+		delivery.StatusCode, err = post(ctx, d.client, webhook.URL, webhook.Secret, message)
+		switch {
+		case err == nil:
+		case IsPermanent(err):
+			delivery.Status = entity.WebhookRejected
+			delivery.Error = err.Error()
+		default:
+			delivery.Status = entity.WebhookFailed
+			delivery.Error = err.Error()
+			if first == nil {
+				first = fmt.Errorf("webhook %s: %w", webhook.ID, err)
+			}
+		}
+
+		if err := d.webhooks.RecordDelivery(ctx, delivery); err != nil {
+			return fmt.Errorf("failed to record delivery: %w", err)
+		}
+	}
+
+	return first
+}
@@ -0,0 +1,65 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// FileCheckpoint хранит позицию импорта реестра в JSON файле
+type FileCheckpoint struct {
+	path string
+}
+
+// NewFileCheckpoint создает хранилище позиции импорта в указанном файле
+func NewFileCheckpoint(path string) *FileCheckpoint {
+	return &FileCheckpoint{path: path}
+}
+
+var _ service.RegistryCheckpoint = (*FileCheckpoint)(nil)
+
+// Load читает позицию; если файла нет, импорт начинается сначала
+func (c *FileCheckpoint) Load(ctx context.Context) (*service.RegistryProgress, error) {
+	data, err := os.ReadFile(c.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+
+	var progress service.RegistryProgress
+	if err := json.Unmarshal(data, &progress); err != nil {
+		return nil, fmt.Errorf("failed to decode checkpoint: %w", err)
+	}
+	return &progress, nil
+}
+
+// Save атомарно записывает позицию через временный файл и переименование,
+// чтобы прерывание во время записи не повредило ее
+func (c *FileCheckpoint) Save(ctx context.Context, progress service.RegistryProgress) error {
+	data, err := json.MarshalIndent(progress, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode checkpoint: %w", err)
+	}
+
+	if dir := filepath.Dir(c.path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("failed to create checkpoint directory: %w", err)
+		}
+	}
+
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	if err := os.Rename(tmp, c.path); err != nil {
+		return fmt.Errorf("failed to replace checkpoint: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+// Package registry реализует потоковое чтение реестров паспортов старых систем (CSV)
+// и хранение позиции импорта для возобновления после прерывания
+package registry
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/charmap"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// defaultDelimiter разделитель полей по умолчанию (выгрузки из Excel в русской локали)
+const defaultDelimiter = ';'
+
+// utf8BOM метка порядка байтов, которую добавляет Excel при сохранении в UTF-8
+const utf8BOM = "\ufeff"
+
+// Parser реализация service.RegistryParser для CSV
+type Parser struct{}
+
+// NewParser создает парсер CSV реестров
+func NewParser() *Parser {
+	return &Parser{}
+}
+
+var _ service.RegistryParser = (*Parser)(nil)
+
+// Open проверяет кодировку и заголовок файла и возвращает потоковый читатель строк
+func (p *Parser) Open(r io.Reader, mapping service.RegistryMapping) (service.RegistryReader, error) {
+	delimiter, err := parseDelimiter(mapping.Delimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(strings.ReplaceAll(mapping.Encoding, "_", "-")) {
+	case "", "utf-8", "utf8":
+	case "windows-1251", "cp1251":
+		r = charmap.Windows1251.NewDecoder().Reader(r)
+	default:
+		return nil, entity.ValidationError{Field: "encoding", Message: "поддерживаются кодировки utf-8 и windows-1251"}
+	}
+
+	cr := csv.NewReader(bufio.NewReader(r))
+	cr.Comma = delimiter
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+
+	reader := &CSVReader{csv: cr, columns: make(map[string]int, len(mapping.Columns))}
+
+	var header []string
+	if !mapping.NoHeader {
+		header, err = cr.Read()
+		if errors.Is(err, io.EOF) {
+			return nil, entity.ValidationError{Field: "source", Message: "файл реестра пуст"}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read header: %w", err)
+		}
+		if len(header) > 0 {
+			header[0] = strings.TrimPrefix(header[0], utf8BOM)
+		}
+	}
+
+	var problems entity.ValidationErrors
+	for field, column := range mapping.Columns {
+		index, err := columnIndex(column, header, mapping.NoHeader)
+		if err != nil {
+			problems = append(problems, entity.ValidationError{Field: "columns." + field, Message: err.Error()})
+			continue
+		}
+		reader.columns[field] = index
+	}
+	if len(problems) > 0 {
+		return nil, problems
+	}
+
+	return reader, nil
+}
+
+// parseDelimiter разбирает разделитель; "\t" и "tab" означают табуляцию
+func parseDelimiter(value string) (rune, error) {
+	switch value {
+	case "":
+		return defaultDelimiter, nil
+	case `\t`, "tab":
+		return '\t', nil
+	}
+
+	r, size := utf8.DecodeRuneInString(value)
+	if size != len(value) || r == '"' || r == '\r' || r == '\n' {
+		return 0, entity.ValidationError{Field: "delimiter", Message: "разделитель должен быть одним символом"}
+	}
+	return r, nil
+}
+
+// columnIndex находит номер столбца по ссылке "#N" или по заголовку без учета регистра
+func columnIndex(column string, header []string, noHeader bool) (int, error) {
+	column = strings.TrimSpace(column)
+
+	if number, ok := strings.CutPrefix(column, "#"); ok {
+		n, err := strconv.Atoi(number)
+		if err != nil || n < 1 {
+			return 0, fmt.Errorf("некорректный номер столбца %q", column)
+		}
+		return n - 1, nil
+	}
+
+	if noHeader {
+		return 0, fmt.Errorf("в файле без заголовка столбец задается номером: #1, #2, ...")
+	}
+	for i, title := range header {
+		if strings.EqualFold(strings.TrimSpace(title), column) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("столбец %q не найден в заголовке", column)
+}
+
+// CSVReader потоковый читатель строк реестра
+type CSVReader struct {
+	csv     *csv.Reader
+	columns map[string]int
+}
+
+// Next возвращает следующую непустую строку или io.EOF
+func (r *CSVReader) Next() (*service.RegistryRow, error) {
+	for {
+		record, err := r.csv.Read()
+		if err != nil {
+			return nil, err
+		}
+		line, _ := r.csv.FieldPos(0)
+
+		if blank(record) {
+			continue
+		}
+
+		row := &service.RegistryRow{Line: line, Values: make(map[string]string, len(r.columns))}
+		for field, index := range r.columns {
+			if index < len(record) {
+				row.Values[field] = record[index]
+			}
+		}
+		return row, nil
+	}
+}
+
+// blank проверяет, что в строке нет значений
+func blank(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,126 @@
+package registry_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/encoding/charmap"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readAll читает все строки реестра
+func readAll(t *testing.T, data []byte, mapping service.RegistryMapping) []*service.RegistryRow {
+	t.Helper()
+
+	rows, err := registry.NewParser().Open(bytes.NewReader(data), mapping)
+	require.NoError(t, err)
+
+	var result []*service.RegistryRow
+	for {
+		row, err := rows.Next()
+		if errors.Is(err, io.EOF) {
+			return result
+		}
+		require.NoError(t, err)
+		result = append(result, row)
+	}
+}
+
+func TestParser_Open(t *testing.T) {
+	const source = "Инв. номер;Адрес; Дом \n" +
+		"101;ул. Ленина;1\n" +
+		";;\n" +
+		"102;\"ул. Мира; корп.\";5а\n"
+
+	cp1251, err := charmap.Windows1251.NewEncoder().String(source)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		data    string
+		mapping service.RegistryMapping
+	}{
+		{
+			name:    "utf-8 с BOM",
+			data:    "\ufeff" + source,
+			mapping: service.RegistryMapping{Columns: map[string]string{"inventory_number": "инв. номер", "address.street": "Адрес", "address.house": "Дом"}},
+		},
+		{
+			name:    "windows-1251",
+			data:    cp1251,
+			mapping: service.RegistryMapping{Encoding: "windows-1251", Columns: map[string]string{"inventory_number": "Инв. номер", "address.street": "Адрес", "address.house": "Дом"}},
+		},
+		{
+			name:    "номера столбцов",
+			data:    "Инв. номер\tАдрес\tДом\n101\tул. Ленина\t1\n\t\t\n102\tул. Мира; корп.\t5а\n",
+			mapping: service.RegistryMapping{Delimiter: `\t`, Columns: map[string]string{"inventory_number": "#1", "address.street": "#2", "address.house": "#3"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows := readAll(t, []byte(tt.data), tt.mapping)
+
+			require.Len(t, rows, 2)
+			assert.Equal(t, 2, rows[0].Line)
+			assert.Equal(t, "101", rows[0].Values["inventory_number"])
+			assert.Equal(t, 4, rows[1].Line)
+			assert.Equal(t, "ул. Мира; корп.", rows[1].Values["address.street"])
+			assert.Equal(t, "5а", rows[1].Values["address.house"])
+		})
+	}
+}
+
+func TestParser_OpenErrors(t *testing.T) {
+	const source = "Номер;Дом\n1;2\n"
+
+	tests := []struct {
+		name    string
+		data    string
+		mapping service.RegistryMapping
+		field   string
+	}{
+		{"нет столбца", source, service.RegistryMapping{Columns: map[string]string{"address.house": "Номер дома"}}, "columns.address.house"},
+		{"заголовок без номера", source, service.RegistryMapping{NoHeader: true, Columns: map[string]string{"address.house": "Дом"}}, "columns.address.house"},
+		{"кодировка", source, service.RegistryMapping{Encoding: "koi8-r", Columns: map[string]string{"address.house": "Дом"}}, "encoding"},
+		{"разделитель", source, service.RegistryMapping{Delimiter: ";;", Columns: map[string]string{"address.house": "Дом"}}, "delimiter"},
+		{"пустой файл", "", service.RegistryMapping{Columns: map[string]string{"address.house": "Дом"}}, "source"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := registry.NewParser().Open(strings.NewReader(tt.data), tt.mapping)
+
+			var ve entity.ValidationError
+			require.ErrorAs(t, err, &ve)
+			assert.Equal(t, tt.field, ve.Field)
+		})
+	}
+}
+
+func TestFileCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	checkpoint := registry.NewFileCheckpoint(filepath.Join(t.TempDir(), "state", "import.json"))
+
+	saved, err := checkpoint.Load(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, saved)
+
+	progress := service.RegistryProgress{Source: "registry.csv (120 байт)", Line: 15, Created: 10, Failed: 2}
+	require.NoError(t, checkpoint.Save(ctx, progress))
+
+	saved, err = checkpoint.Load(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, saved)
+	assert.Equal(t, progress, *saved)
+}
@@ -0,0 +1,183 @@
+package rosreestr
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Коды справочников Росреестра, используемые при выгрузке
+const (
+	realtyBuilding = "002001002000" // Здание
+	realtyFlat     = "002001003000" // Помещение
+
+	assBuildingResidential = "204002000000" // Жилой дом
+	assBuildingApartments  = "204003000000" // Многоквартирный дом
+
+	assFlatNonResidential = "206001000000" // Нежилое помещение
+	assFlatResidential    = "206002000000" // Жилое помещение
+
+	storeyFloor      = "01" // Этаж
+	storeyAttic      = "02" // Мансарда
+	storeyMezzanine  = "03" // Мезонин
+	storeyBasement   = "04" // Подвал
+	storeyBasementFl = "05" // Цокольный этаж
+	storeyTechnical  = "06" // Технический этаж
+	storeyEntresol   = "07" // Антресоль
+)
+
+// keyword соответствие фрагмента текста коду справочника.
+// Списки просматриваются по порядку: более специфичные фрагменты идут раньше.
+type keyword struct {
+	fragment string
+	code     string
+}
+
+// wallMaterials справочник dWall
+var wallMaterials = []keyword{
+	{"железобетон", "04"},
+	{"ж/б", "04"},
+	{"монолит", "07"},
+	{"панел", "05"},
+	{"блок", "06"},
+	{"кирпич", "02"},
+	{"бетон", "03"},
+	{"камен", "01"},
+	{"камн", "01"},
+	{"дерев", "08"},
+	{"брус", "08"},
+	{"бревн", "08"},
+	{"каркас", "08"},
+	{"металл", "09"},
+	{"смешан", "10"},
+}
+
+// regions коды субъектов Российской Федерации
+var regions = []keyword{
+	{"адыге", "01"}, {"башкорт", "02"}, {"бурят", "03"},
+	{"алтайск", "22"}, {"алтай", "04"},
+	{"дагестан", "05"}, {"ингуш", "06"}, {"кабардин", "07"}, {"калмык", "08"},
+	{"карачаев", "09"}, {"карели", "10"}, {"коми", "11"}, {"марий", "12"},
+	{"мордов", "13"}, {"саха", "14"}, {"якути", "14"}, {"осети", "15"},
+	{"татарстан", "16"}, {"тыва", "17"}, {"тува", "17"}, {"удмурт", "18"},
+	{"хакаси", "19"}, {"чечен", "20"}, {"чечн", "20"}, {"чуваш", "21"},
+	{"краснодар", "23"}, {"красноярск", "24"}, {"приморск", "25"}, {"ставрополь", "26"},
+	{"хабаровск", "27"}, {"амурск", "28"}, {"архангельск", "29"}, {"астрахан", "30"},
+	{"белгород", "31"}, {"брянск", "32"}, {"владимир", "33"}, {"волгоград", "34"},
+	{"вологод", "35"}, {"воронеж", "36"}, {"иванов", "37"}, {"иркутск", "38"},
+	{"калининград", "39"}, {"калуж", "40"}, {"камчат", "41"}, {"кемеров", "42"},
+	{"кузбасс", "42"}, {"киров", "43"}, {"костром", "44"}, {"курган", "45"},
+	{"курск", "46"}, {"ленинград", "47"}, {"липецк", "48"}, {"магадан", "49"},
+	{"московск", "50"}, {"мурманск", "51"}, {"нижегород", "52"}, {"новгород", "53"},
+	{"новосибирск", "54"}, {"омск", "55"}, {"оренбург", "56"}, {"орлов", "57"},
+	{"пензен", "58"}, {"пермск", "59"}, {"псков", "60"}, {"ростов", "61"},
+	{"рязан", "62"}, {"самар", "63"}, {"саратов", "64"}, {"сахалин", "65"},
+	{"свердлов", "66"}, {"смоленск", "67"}, {"тамбов", "68"}, {"твер", "69"},
+	{"томск", "70"}, {"туль", "71"}, {"ямало", "89"}, {"ханты", "86"},
+	{"ненец", "83"}, {"тюмен", "72"}, {"ульянов", "73"}, {"челябинск", "74"},
+	{"забайкаль", "75"}, {"ярослав", "76"}, {"москва", "77"}, {"петербург", "78"},
+	{"еврейск", "79"}, {"чукот", "87"}, {"севастопол", "92"}, {"крым", "91"},
+}
+
+// lookup возвращает код первого совпавшего фрагмента
+func lookup(dictionary []keyword, value string) string {
+	value = strings.ToLower(value)
+	for _, k := range dictionary {
+		if strings.Contains(value, k.fragment) {
+			return k.code
+		}
+	}
+	return ""
+}
+
+// cadastralRegion извлекает код региона из кадастрового номера
+var cadastralRegion = regexp.MustCompile(`^([0-9]{2}):`)
+
+// regionCode определяет код субъекта РФ по наименованию, а при неудаче -
+// по кадастровому номеру объекта
+func regionCode(subject, cadastralNumber string) string {
+	if code := lookup(regions, subject); code != "" {
+		return code
+	}
+	if m := cadastralRegion.FindStringSubmatch(cadastralNumber); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// storeyNumber выделяет номер этажа
+var storeyNumber = regexp.MustCompile(`-?[0-9]+`)
+
+// storey определяет номер и тип этажа (dTypeStorey) по записи экспликации:
+// "1", "2 этаж", "подвал", "мансарда", "цоколь"
+func storey(floor string) (number, code string) {
+	lower := strings.ToLower(floor)
+
+	number = storeyNumber.FindString(lower)
+	switch {
+	case strings.Contains(lower, "цокол"):
+		code = storeyBasementFl
+	case strings.Contains(lower, "подвал"):
+		code = storeyBasement
+	case strings.Contains(lower, "мансард"):
+		code = storeyAttic
+	case strings.Contains(lower, "мезонин"):
+		code = storeyMezzanine
+	case strings.Contains(lower, "техн"):
+		code = storeyTechnical
+	case strings.Contains(lower, "антресол"):
+		code = storeyEntresol
+	case number != "":
+		code = storeyFloor
+	}
+
+	if number == "" && code != "" {
+		number = "1"
+	}
+	return number, code
+}
+
+// addressTypes полные наименования типов адресообразующих элементов
+var addressTypes = map[string]string{
+	"улица":      "ул",
+	"переулок":   "пер",
+	"проспект":   "пр-кт",
+	"проезд":     "проезд",
+	"шоссе":      "ш",
+	"бульвар":    "б-р",
+	"площадь":    "пл",
+	"набережная": "наб",
+	"тупик":      "туп",
+	"район":      "р-н",
+	"город":      "г",
+	"поселок":    "п",
+	"посёлок":    "п",
+	"село":       "с",
+	"деревня":    "д",
+	"округ":      "округ",
+}
+
+// splitName делит адресный элемент на наименование и тип:
+// "ул. Тверская" -> Тверская, ул; "Центральный район" -> Центральный, р-н
+func splitName(value, defaultType string) *tpName {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+
+	words := strings.Fields(value)
+	if len(words) > 1 {
+		first := strings.ToLower(words[0])
+		if strings.HasSuffix(first, ".") {
+			return &tpName{Name: strings.Join(words[1:], " "), Type: strings.TrimSuffix(first, ".")}
+		}
+		if short, ok := addressTypes[first]; ok {
+			return &tpName{Name: strings.Join(words[1:], " "), Type: short}
+		}
+		last := strings.ToLower(words[len(words)-1])
+		if short, ok := addressTypes[last]; ok {
+			return &tpName{Name: strings.Join(words[:len(words)-1], " "), Type: short}
+		}
+	}
+
+	return &tpName{Name: value, Type: defaultType}
+}
@@ -0,0 +1,420 @@
+// Package rosreestr реализует выгрузку технического паспорта в XML
+// технического плана по схемам Росреестра с проверкой по XSD: официальным
+// схемам из каталога, если они установлены, иначе встроенной упрощенной схеме
+package rosreestr
+
+import (
+	"context"
+	"crypto/rand"
+	"embed"
+	"encoding/xml"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/rosreestr/xsd"
+)
+
+const (
+	// SchemaName имя основной схемы технического плана
+	SchemaName = "TP_v03"
+
+	// schemaVersion значение атрибута Version корневого элемента
+	schemaVersion = "03"
+
+	// dateLayout формат дат в схемах Росреестра
+	dateLayout = "2006-01-02"
+)
+
+//go:embed schema/*.xsd
+var schemaFS embed.FS
+
+// Exporter реализация service.RosreestrExporter
+type Exporter struct {
+	dirs    []string
+	now     func() time.Time
+	newGUID func() string
+
+	once     sync.Once
+	schema   *xsd.Schema
+	official bool
+	err      error
+}
+
+// NewExporter создает экспортер; dirs - каталоги, в которых ищутся официальные
+// схемы технического плана (файл TP_v03.xsd со всеми подключаемыми схемами
+// без изменений). Без них документы проверяются встроенной упрощенной схемой.
+// Если официальная схема использует конструкции, которые не поддерживает
+// валидатор, проверка завершается ошибкой, а не неполным результатом.
+func NewExporter(dirs ...string) *Exporter {
+	return &Exporter{
+		dirs:    dirs,
+		now:     time.Now,
+		newGUID: newGUID,
+	}
+}
+
+var _ service.RosreestrExporter = (*Exporter)(nil)
+
+// load загружает схему при первом обращении: официальную из первого каталога,
+// где она есть, иначе встроенную
+func (e *Exporter) load() error {
+	e.once.Do(func() {
+		for _, dir := range e.dirs {
+			if _, err := os.Stat(filepath.Join(dir, SchemaName+".xsd")); err != nil {
+				continue
+			}
+			e.schema, e.err = xsd.Load(os.DirFS(dir), SchemaName+".xsd")
+			if e.err != nil {
+				e.err = fmt.Errorf("failed to load official schemas from %s: %w", dir, e.err)
+			}
+			e.official = true
+			return
+		}
+
+		embedded, err := fs.Sub(schemaFS, "schema")
+		if err != nil {
+			e.err = err
+			return
+		}
+		e.schema, e.err = xsd.Load(embedded, SchemaName+".xsd")
+	})
+	return e.err
+}
+
+// Export формирует XML технического плана и проверяет его по схеме
+func (e *Exporter) Export(ctx context.Context, passport *entity.TechnicalPassport) (*service.RosreestrDocument, error) {
+	guid := e.newGUID()
+	doc, warnings := e.build(passport, guid)
+
+	body, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode technical plan: %w", err)
+	}
+	data := append([]byte(xml.Header), body...)
+
+	report, err := e.Validate(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	report.Warnings = warnings
+
+	return &service.RosreestrDocument{
+		Data:     data,
+		FileName: "GKUOKS_" + guid + ".xml",
+		Report:   *report,
+	}, nil
+}
+
+// Validate проверяет XML документ по схеме технического плана
+func (e *Exporter) Validate(ctx context.Context, data []byte) (*service.RosreestrReport, error) {
+	if err := e.load(); err != nil {
+		return nil, err
+	}
+
+	violations, err := e.schema.Validate(data)
+	if err != nil {
+		return nil, entity.ValidationError{Field: "document", Message: err.Error()}
+	}
+
+	report := &service.RosreestrReport{
+		Schema:   SchemaName,
+		Official: e.official,
+		Valid:    len(violations) == 0,
+	}
+	for _, v := range violations {
+		issue := service.RosreestrIssue{Path: v.Path, ModelField: sourceOf(v.Path), Message: v.Message}
+
+		if v.Kind == xsd.ViolationMissing {
+			if issue.ModelField == "" {
+				issue.Message = "в модели паспорта нет сведений для обязательного поля"
+			} else {
+				issue.Message = "обязательное поле не заполнено в паспорте"
+			}
+			report.Missing = append(report.Missing, issue)
+			continue
+		}
+		report.Errors = append(report.Errors, issue)
+	}
+
+	return report, nil
+}
+
+// UnmappedFields перечисляет обязательные по схеме поля без источника в модели паспорта.
+// Вложенные поля элемента, который целиком отсутствует в модели, не перечисляются.
+// В конце перечня - сведения экспликации, для которых нет места в схеме.
+func (e *Exporter) UnmappedFields() ([]service.RosreestrIssue, error) {
+	if err := e.load(); err != nil {
+		return nil, err
+	}
+
+	var (
+		issues   []service.RosreestrIssue
+		unmapped []string
+	)
+
+	for _, field := range e.schema.RequiredFields("TP") {
+		if isMapped(field.Path) || hasPrefix(unmapped, field.Path) {
+			continue
+		}
+		unmapped = append(unmapped, field.Path)
+
+		message := "обязательное поле"
+		if field.Choice {
+			message = "обязательное поле варианта объекта"
+		}
+		issues = append(issues, service.RosreestrIssue{Path: field.Path, Message: message})
+	}
+
+	for _, u := range unexported {
+		issues = append(issues, service.RosreestrIssue{Path: u.path, ModelField: u.field, Message: u.message})
+	}
+
+	return issues, nil
+}
+
+// hasPrefix проверяет, вложен ли путь в один из элементов списка
+func hasPrefix(parents []string, path string) bool {
+	for _, parent := range parents {
+		if strings.HasPrefix(path, parent+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// build сопоставляет паспорт структуре технического плана
+func (e *Exporter) build(p *entity.TechnicalPassport, guid string) (*tpDocument, []string) {
+	var warnings []string
+
+	doc := &tpDocument{
+		GUID:    guid,
+		Version: schemaVersion,
+		GeneralCadastralWorks: generalCadastralWorks{
+			Clients: tpClients{Client: clients(p.Owners)},
+			Contractor: tpEngineer{
+				Date: e.now().Format(dateLayout),
+			},
+		},
+	}
+
+	if !p.AsOfDate.IsZero() {
+		doc.GeneralCadastralWorks.DateCadastral = p.AsOfDate.Format(dateLayout)
+	}
+	if p.OrganizationName != "" {
+		doc.GeneralCadastralWorks.Contractor.Organization = &tpOrganization{Name: p.OrganizationName}
+	}
+
+	switch p.ObjectType {
+	case entity.ObjectTypeApartment, entity.ObjectTypeRoom, entity.ObjectTypeNonResidential:
+		var flatWarnings []string
+		doc.Package.Flat, flatWarnings = flat(p)
+		warnings = append(warnings, flatWarnings...)
+		if len(p.Buildings) > 0 {
+			warnings = append(warnings, "сведения о зданиях не выгружаются в технический план помещения")
+		}
+	default:
+		var buildingWarnings []string
+		doc.Package.Building, buildingWarnings = building(p)
+		warnings = append(warnings, buildingWarnings...)
+		if len(p.Explication) > 0 {
+			warnings = append(warnings, fmt.Sprintf(
+				"экспликация не выгружена (помещений: %d): в техническом плане здания нет раздела помещений", len(p.Explication)))
+		}
+	}
+
+	return doc, warnings
+}
+
+// clients формирует сведения о заказчиках кадастровых работ по правообладателям
+func clients(owners []entity.Owner) []tpClient {
+	result := make([]tpClient, 0, len(owners))
+
+	for _, o := range owners {
+		if o.PersonType == entity.PersonTypeLegal {
+			result = append(result, tpClient{Organization: &tpOrganization{Name: o.CompanyName, INN: o.TIN}})
+			continue
+		}
+
+		person := &tpPerson{}
+		parts := strings.Fields(o.FullName)
+		if len(parts) > 0 {
+			person.FamilyName = parts[0]
+		}
+		if len(parts) > 1 {
+			person.FirstName = parts[1]
+		}
+		if len(parts) > 2 {
+			person.Patronymic = strings.Join(parts[2:], " ")
+		}
+		result = append(result, tpClient{Person: person})
+	}
+
+	return result
+}
+
+// building формирует раздел здания; основным считается первое здание паспорта
+func building(p *entity.TechnicalPassport) (*tpBuilding, []string) {
+	var warnings []string
+
+	b := &tpBuilding{
+		ObjectType:      realtyBuilding,
+		CadastralNumber: p.CadastralNumber,
+		Location:        location(p),
+		Floors:          tpFloors{Floors: p.GeneralInfo.FloorsAboveGround},
+		ExploitationChar: tpExploitationChar{
+			YearBuilt: p.GeneralInfo.ConstructionYear,
+		},
+		Area: area(p.GeneralInfo.TotalArea),
+	}
+
+	if p.GeneralInfo.FloorsUnderground > 0 {
+		underground := p.GeneralInfo.FloorsUnderground
+		b.Floors.UndergroundFloors = &underground
+	}
+
+	switch p.ObjectType {
+	case entity.ObjectTypeResidentialHouse:
+		b.AssignationBuilding = assBuildingResidential
+		if strings.Contains(strings.ToLower(p.GeneralInfo.Purpose), "многоквартир") {
+			b.AssignationBuilding = assBuildingApartments
+		}
+	case entity.ObjectTypeApartmentBuilding:
+		b.AssignationBuilding = assBuildingApartments
+	}
+
+	if len(p.Buildings) == 0 {
+		return b, warnings
+	}
+
+	primary := p.Buildings[0]
+	b.Name = primary.Name
+	b.ExploitationChar.YearUsed = primary.CommissionYear
+
+	if code := lookup(wallMaterials, primary.WallMaterial); code != "" {
+		b.ElementsConstruct.Material = []tpMaterial{{Wall: code}}
+	} else if primary.WallMaterial != "" {
+		warnings = append(warnings, fmt.Sprintf("материал стен %q не сопоставлен справочнику dWall", primary.WallMaterial))
+	}
+
+	if len(p.Buildings) > 1 {
+		literas := make([]string, 0, len(p.Buildings)-1)
+		for _, other := range p.Buildings[1:] {
+			literas = append(literas, other.Litera)
+		}
+		warnings = append(warnings, fmt.Sprintf(
+			"технический план составляется на одно здание: выгружено здание литера %s, не выгружены литеры %s",
+			primary.Litera, strings.Join(literas, ", ")))
+	}
+
+	return b, warnings
+}
+
+// flat формирует раздел помещения
+func flat(p *entity.TechnicalPassport) (*tpFlat, []string) {
+	f := &tpFlat{
+		ObjectType:      realtyFlat,
+		CadastralNumber: p.CadastralNumber,
+		AssignationFlat: assFlatResidential,
+		Location:        location(p),
+		Area:            area(p.GeneralInfo.TotalArea),
+	}
+	if p.ObjectType == entity.ObjectTypeNonResidential {
+		f.AssignationFlat = assFlatNonResidential
+	}
+
+	var warnings []string
+	f.PositionInObject.Levels.Level, warnings = explication(p.Explication)
+
+	return f, warnings
+}
+
+// explication распределяет помещения экспликации по этажам расположения
+// помещения. Площади, высоты и литеры в схеме не предусмотрены: о них
+// сообщается предупреждением, данные остаются в паспорте.
+func explication(rooms []entity.Room) ([]tpStorey, []string) {
+	type key struct{ number, code string }
+
+	var (
+		levels   []tpStorey
+		warnings []string
+		measured int
+	)
+	index := make(map[key]int)
+
+	for _, room := range rooms {
+		if room.Area > 0 || room.LivingArea > 0 || room.AuxiliaryArea > 0 || room.Height > 0 {
+			measured++
+		}
+
+		number, code := storey(room.Floor)
+		if code == "" {
+			warnings = append(warnings, fmt.Sprintf("помещение %s не выгружено: этаж %q не сопоставлен справочнику dTypeStorey", room.RoomNumber, room.Floor))
+			continue
+		}
+
+		k := key{number, code}
+		i, ok := index[k]
+		if !ok {
+			i = len(levels)
+			index[k] = i
+			levels = append(levels, tpStorey{Number: number, Type: code})
+		}
+		levels[i].Position = append(levels[i].Position, tpPosition{NumberOnPlan: room.RoomNumber, Description: room.Purpose})
+	}
+
+	if measured > 0 {
+		warnings = append(warnings, fmt.Sprintf(
+			"площади и высоты помещений экспликации не выгружены (помещений: %d): в схеме технического плана нет для них полей", measured))
+	}
+
+	return levels, warnings
+}
+
+// location формирует адрес объекта
+func location(p *entity.TechnicalPassport) tpAddress {
+	a := p.Address
+
+	addr := tpAddress{
+		PostalCode:    a.PostalCode,
+		Region:        regionCode(a.Subject, p.CadastralNumber),
+		District:      splitName(a.District, "р-н"),
+		City:          splitName(a.City, "г"),
+		UrbanDistrict: splitName(a.CityDistrict, "р-н"),
+		Street:        splitName(a.Street, "ул"),
+		Note:          a.FullAddress(),
+	}
+
+	if a.House != "" {
+		addr.Level1 = &tpLevel{Type: "д", Value: a.House}
+	}
+	if a.Building != "" {
+		addr.Level2 = &tpLevel{Type: "корп", Value: a.Building}
+	}
+	if a.Apartment != "" {
+		addr.Apartment = &tpLevel{Type: "кв", Value: a.Apartment}
+	}
+	if a.Room != "" {
+		addr.Other = "ком. " + a.Room
+	}
+
+	return addr
+}
+
+// newGUID формирует случайный UUID версии 4
+func newGUID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(fmt.Sprintf("failed to generate GUID: %v", err))
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
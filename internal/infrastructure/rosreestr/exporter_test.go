@@ -0,0 +1,247 @@
+package rosreestr_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/rosreestr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newHouse() *entity.TechnicalPassport {
+	p := entity.NewTechnicalPassport(entity.ObjectTypeResidentialHouse, entity.Address{
+		Subject:    "г. Москва",
+		City:       "Москва",
+		Street:     "ул. Тверская",
+		House:      "1",
+		PostalCode: "125009",
+	})
+	p.ID = "TP-1"
+	p.OrganizationName = "ГУП БТИ"
+	p.CadastralNumber = "77:01:0001001:1234"
+	p.AsOfDate = time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	p.GeneralInfo = entity.GeneralInfo{
+		Purpose:           "Жилое",
+		ConstructionYear:  2019,
+		TotalArea:         100.46,
+		LivingArea:        70,
+		FloorsAboveGround: 2,
+		FloorsUnderground: 1,
+	}
+	p.Buildings = []entity.Building{
+		{Litera: "А", Name: "Жилой дом", CommissionYear: 2020, WallMaterial: "Кирпич", TotalArea: 100.46},
+	}
+	p.Owners = []entity.Owner{
+		{PersonType: entity.PersonTypeIndividual, FullName: "Иванов Иван Иванович", Share: "1", RightType: "Собственность"},
+	}
+	p.Explication = []entity.Room{
+		{Litera: "А", Floor: "1", RoomNumber: "1", Purpose: "Жилая комната", Area: 20.04, LivingArea: 20.04, Height: 2.7},
+	}
+	return p
+}
+
+// paths возвращает пути замечаний отчета
+func paths(issues []service.RosreestrIssue) []string {
+	result := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		result = append(result, issue.Path)
+	}
+	return result
+}
+
+func TestExporter_ExportHouse(t *testing.T) {
+	exporter := rosreestr.NewExporter()
+
+	doc, err := exporter.Export(context.Background(), newHouse())
+	require.NoError(t, err)
+
+	xml := string(doc.Data)
+	assert.True(t, strings.HasPrefix(doc.FileName, "GKUOKS_"))
+	assert.Contains(t, xml, `<TP xmlns="`+rosreestr.Namespace+`"`)
+	assert.Contains(t, xml, `<AssignationBuilding>204002000000</AssignationBuilding>`)
+	assert.Contains(t, xml, `<Region>77</Region>`)
+	assert.Contains(t, xml, `<Street Name="Тверская" Type="ул"></Street>`)
+	assert.Contains(t, xml, `<Floors Floors="2" UndergroundFloors="1"></Floors>`)
+	assert.Contains(t, xml, `<Material Wall="02"></Material>`)
+	assert.Contains(t, xml, `<ExploitationChar YearBuilt="2019" YearUsed="2020"></ExploitationChar>`)
+	assert.Contains(t, xml, `<Area>100.5</Area>`)
+	assert.Contains(t, xml, `<FamilyName>Иванов</FamilyName>`)
+	assert.NotContains(t, xml, "Explication")
+
+	// Заполненный паспорт не проходит проверку только из-за сведений,
+	// которых нет в модели паспорта
+	report := doc.Report
+	assert.False(t, report.Valid)
+	assert.False(t, report.Official)
+	assert.Empty(t, report.Errors)
+	assert.Equal(t, []string{"экспликация не выгружена (помещений: 1): в техническом плане здания нет раздела помещений"}, report.Warnings)
+	assert.Equal(t, []string{
+		"TP/GeneralCadastralWorks/Reason",
+		"TP/GeneralCadastralWorks/Contractor/FamilyName",
+		"TP/GeneralCadastralWorks/Contractor/FirstName",
+		"TP/GeneralCadastralWorks/Contractor/SNILS",
+		"TP/GeneralCadastralWorks/Contractor/NCertificate",
+		"TP/GeneralCadastralWorks/Contractor/Telephone",
+		"TP/GeneralCadastralWorks/Contractor/Address",
+		"TP/GeneralCadastralWorks/Contractor/Email",
+		"TP/Package/Building/Location/OKTMO",
+		"TP/Package/Building/EntitySpatial",
+	}, paths(report.Missing))
+	for _, issue := range report.Missing {
+		assert.Empty(t, issue.ModelField, issue.Path)
+	}
+}
+
+func TestExporter_ExportReportsEmptyPassportFields(t *testing.T) {
+	p := newHouse()
+	p.Owners = nil
+	p.GeneralInfo.FloorsAboveGround = 0
+	p.Buildings[0].WallMaterial = "Саман"
+	p.Buildings = append(p.Buildings, entity.Building{Litera: "Г", Name: "Сарай"})
+	p.GeneralInfo.TotalArea = 0.04
+
+	doc, err := rosreestr.NewExporter().Export(context.Background(), p)
+	require.NoError(t, err)
+
+	fields := make(map[string]string)
+	for _, issue := range doc.Report.Missing {
+		fields[issue.Path] = issue.ModelField
+	}
+	assert.Equal(t, "owners", fields["TP/GeneralCadastralWorks/Clients/Client"])
+	assert.Equal(t, "general_info.floors_above_ground", fields["TP/Package/Building/Floors/@Floors"])
+	assert.Equal(t, "buildings.wall_material", fields["TP/Package/Building/ElementsConstruct/Material"])
+
+	require.Len(t, doc.Report.Errors, 1)
+	assert.Equal(t, "TP/Package/Building/Area", doc.Report.Errors[0].Path)
+	assert.Equal(t, "general_info.total_area", doc.Report.Errors[0].ModelField)
+
+	assert.Len(t, doc.Report.Warnings, 3)
+}
+
+func TestExporter_ExportFlat(t *testing.T) {
+	p := newHouse()
+	p.ObjectType = entity.ObjectTypeApartment
+	p.Address.Apartment = "15"
+	p.Buildings = nil
+	p.Explication = append(p.Explication,
+		entity.Room{Litera: "А", Floor: "1", RoomNumber: "2", Purpose: "Кухня", Area: 9},
+		entity.Room{Litera: "А", Floor: "Мансарда", RoomNumber: "3", Purpose: "Жилая комната", Area: 12})
+
+	doc, err := rosreestr.NewExporter().Export(context.Background(), p)
+	require.NoError(t, err)
+
+	xml := string(doc.Data)
+	assert.Contains(t, xml, `<AssignationFlat>206002000000</AssignationFlat>`)
+	assert.Contains(t, xml, `<Apartment Type="кв" Value="15"></Apartment>`)
+	assert.Contains(t, xml, `<Level Number="1" Type="01">
+            <Position NumberOnPlan="1" Description="Жилая комната"></Position>
+            <Position NumberOnPlan="2" Description="Кухня"></Position>
+          </Level>`)
+	assert.Contains(t, xml, `<Level Number="1" Type="02">
+            <Position NumberOnPlan="3" Description="Жилая комната"></Position>
+          </Level>`)
+	assert.Contains(t, paths(doc.Report.Missing), "TP/Package/Flat/ParentCadastralNumber")
+	assert.Empty(t, doc.Report.Errors)
+	assert.Equal(t, []string{
+		"площади и высоты помещений экспликации не выгружены (помещений: 3): в схеме технического плана нет для них полей",
+	}, doc.Report.Warnings)
+
+	// Помещение без номера отмечается как незаполненное поле экспликации
+	p.Explication[1].RoomNumber = ""
+	doc, err = rosreestr.NewExporter().Export(context.Background(), p)
+	require.NoError(t, err)
+	fields := make(map[string]string)
+	for _, issue := range doc.Report.Missing {
+		fields[issue.Path] = issue.ModelField
+	}
+	assert.Equal(t, "explication.room_number", fields["TP/Package/Flat/PositionInObject/Levels/Level[1]/Position[2]/@NumberOnPlan"])
+}
+
+func TestExporter_Validate(t *testing.T) {
+	exporter := rosreestr.NewExporter()
+
+	_, err := exporter.Validate(context.Background(), []byte("<TP"))
+	var validationErr entity.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+
+	report, err := exporter.Validate(context.Background(), []byte(`<Other/>`))
+	require.NoError(t, err)
+	assert.False(t, report.Valid)
+	assert.Equal(t, []string{"Other"}, paths(report.Errors))
+}
+
+func TestExporter_UnmappedFields(t *testing.T) {
+	fields, err := rosreestr.NewExporter().UnmappedFields()
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"TP/GeneralCadastralWorks/Reason",
+		"TP/GeneralCadastralWorks/Contractor/FamilyName",
+		"TP/GeneralCadastralWorks/Contractor/FirstName",
+		"TP/GeneralCadastralWorks/Contractor/SNILS",
+		"TP/GeneralCadastralWorks/Contractor/NCertificate",
+		"TP/GeneralCadastralWorks/Contractor/Telephone",
+		"TP/GeneralCadastralWorks/Contractor/Address",
+		"TP/GeneralCadastralWorks/Contractor/Email",
+		"TP/Package/Building/Location/OKTMO",
+		"TP/Package/Building/EntitySpatial",
+		"TP/Package/Flat/ParentCadastralNumber",
+		"TP/Package/Flat/Location/OKTMO",
+	}, paths(fields[:12]))
+
+	// Сведения экспликации, для которых нет места в схеме
+	unexported := make([]string, 0, len(fields)-12)
+	for _, issue := range fields[12:] {
+		unexported = append(unexported, issue.ModelField)
+	}
+	assert.Equal(t, []string{
+		"explication.litera",
+		"explication.area",
+		"explication.living_area",
+		"explication.auxiliary_area",
+		"explication.height",
+		"explication",
+	}, unexported)
+}
+
+func TestExporter_OfficialSchemas(t *testing.T) {
+	// Каталог без схем пропускается
+	report, err := rosreestr.NewExporter(t.TempDir()).Validate(context.Background(), []byte(`<Other/>`))
+	require.NoError(t, err)
+	assert.False(t, report.Official)
+
+	// Схемы из каталога используются вместо встроенной
+	dir := t.TempDir()
+	entries, err := os.ReadDir("schema")
+	require.NoError(t, err)
+	for _, entry := range entries {
+		data, err := os.ReadFile(filepath.Join("schema", entry.Name()))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, entry.Name()), data, 0o644))
+	}
+
+	doc, err := rosreestr.NewExporter(dir).Export(context.Background(), newHouse())
+	require.NoError(t, err)
+	assert.True(t, doc.Report.Official)
+	assert.Empty(t, doc.Report.Errors)
+
+	// Неполный набор схем - ошибка, а не переход на встроенную схему
+	require.NoError(t, os.Remove(filepath.Join(dir, "dWall_v01.xsd")))
+	_, err = rosreestr.NewExporter(dir).Validate(context.Background(), doc.Data)
+	assert.ErrorContains(t, err, "official schemas")
+
+	// Схема с конструкциями, которые валидатор не проверяет, не загружается:
+	// результат такой проверки не выдается за официальный
+	wall, err := os.ReadFile(filepath.Join("schema", "dWall_v01.xsd"))
+	require.NoError(t, err)
+	wall = []byte(strings.Replace(string(wall), "</xs:schema>", `<xs:complexType name="tExt"><xs:sequence><xs:any/></xs:sequence></xs:complexType></xs:schema>`, 1))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "dWall_v01.xsd"), wall, 0o644))
+	_, err = rosreestr.NewExporter(dir).Validate(context.Background(), doc.Data)
+	assert.ErrorContains(t, err, "unsupported construct xs:any")
+}
@@ -0,0 +1,151 @@
+package rosreestr
+
+import (
+	"encoding/xml"
+	"math"
+	"strconv"
+)
+
+// Namespace пространство имен схемы технического плана
+const Namespace = "urn://x-artefacts-rosreestr-ru/incoming/technical-plan/3.0.1"
+
+// Порядок полей структур соответствует xs:sequence схемы TP_v03.xsd.
+// Незаполненные обязательные поля опускаются, чтобы проверка по схеме
+// сообщила о них как об отсутствующих.
+
+type tpDocument struct {
+	XMLName               xml.Name              `xml:"urn://x-artefacts-rosreestr-ru/incoming/technical-plan/3.0.1 TP"`
+	GUID                  string                `xml:"GUID,attr"`
+	Version               string                `xml:"Version,attr"`
+	GeneralCadastralWorks generalCadastralWorks `xml:"GeneralCadastralWorks"`
+	Package               tpPackage             `xml:"Package"`
+}
+
+type generalCadastralWorks struct {
+	DateCadastral string     `xml:"DateCadastral,attr,omitempty"`
+	Clients       tpClients  `xml:"Clients"`
+	Contractor    tpEngineer `xml:"Contractor"`
+}
+
+type tpClients struct {
+	Client []tpClient `xml:"Client"`
+}
+
+type tpClient struct {
+	Person       *tpPerson       `xml:"Person,omitempty"`
+	Organization *tpOrganization `xml:"Organization,omitempty"`
+}
+
+type tpPerson struct {
+	FamilyName string `xml:"FamilyName,omitempty"`
+	FirstName  string `xml:"FirstName,omitempty"`
+	Patronymic string `xml:"Patronymic,omitempty"`
+}
+
+type tpOrganization struct {
+	Name string `xml:"Name,omitempty"`
+	INN  string `xml:"INN,omitempty"`
+}
+
+// tpEngineer сведения о кадастровом инженере в модели паспорта не ведутся:
+// заполняется только организация
+type tpEngineer struct {
+	Date         string          `xml:"Date,attr,omitempty"`
+	Organization *tpOrganization `xml:"Organization,omitempty"`
+}
+
+type tpPackage struct {
+	Building *tpBuilding `xml:"Building,omitempty"`
+	Flat     *tpFlat     `xml:"Flat,omitempty"`
+}
+
+type tpBuilding struct {
+	ObjectType          string              `xml:"ObjectType"`
+	CadastralNumber     string              `xml:"CadastralNumber,omitempty"`
+	Name                string              `xml:"Name,omitempty"`
+	AssignationBuilding string              `xml:"AssignationBuilding,omitempty"`
+	Location            tpAddress           `xml:"Location"`
+	Floors              tpFloors            `xml:"Floors"`
+	ElementsConstruct   tpElementsConstruct `xml:"ElementsConstruct"`
+	ExploitationChar    tpExploitationChar  `xml:"ExploitationChar"`
+	Area                area                `xml:"Area,omitempty"`
+}
+
+type tpFlat struct {
+	ObjectType       string             `xml:"ObjectType"`
+	CadastralNumber  string             `xml:"CadastralNumber,omitempty"`
+	AssignationFlat  string             `xml:"AssignationFlat,omitempty"`
+	Location         tpAddress          `xml:"Location"`
+	PositionInObject tpPositionInObject `xml:"PositionInObject"`
+	Area             area               `xml:"Area,omitempty"`
+}
+
+type tpAddress struct {
+	PostalCode    string   `xml:"PostalCode,omitempty"`
+	Region        string   `xml:"Region,omitempty"`
+	District      *tpName  `xml:"District,omitempty"`
+	City          *tpName  `xml:"City,omitempty"`
+	UrbanDistrict *tpName  `xml:"UrbanDistrict,omitempty"`
+	Street        *tpName  `xml:"Street,omitempty"`
+	Level1        *tpLevel `xml:"Level1,omitempty"`
+	Level2        *tpLevel `xml:"Level2,omitempty"`
+	Apartment     *tpLevel `xml:"Apartment,omitempty"`
+	Other         string   `xml:"Other,omitempty"`
+	Note          string   `xml:"Note,omitempty"`
+}
+
+type tpName struct {
+	Name string `xml:"Name,attr"`
+	Type string `xml:"Type,attr"`
+}
+
+type tpLevel struct {
+	Type  string `xml:"Type,attr"`
+	Value string `xml:"Value,attr"`
+}
+
+type tpFloors struct {
+	Floors            int  `xml:"Floors,attr,omitempty"`
+	UndergroundFloors *int `xml:"UndergroundFloors,attr,omitempty"`
+}
+
+type tpElementsConstruct struct {
+	Material []tpMaterial `xml:"Material"`
+}
+
+type tpMaterial struct {
+	Wall string `xml:"Wall,attr"`
+}
+
+type tpExploitationChar struct {
+	YearBuilt int `xml:"YearBuilt,attr,omitempty"`
+	YearUsed  int `xml:"YearUsed,attr,omitempty"`
+}
+
+type tpPositionInObject struct {
+	Levels tpLevels `xml:"Levels"`
+}
+
+type tpLevels struct {
+	Level []tpStorey `xml:"Level"`
+}
+
+type tpStorey struct {
+	Number   string       `xml:"Number,attr"`
+	Type     string       `xml:"Type,attr"`
+	Position []tpPosition `xml:"Position"`
+}
+
+// tpPosition помещение экспликации на плане этажа
+type tpPosition struct {
+	NumberOnPlan string `xml:"NumberOnPlan,attr,omitempty"`
+	Description  string `xml:"Description,attr,omitempty"`
+}
+
+// area площадь, выгружаемая с округлением до 0,1 кв.м
+type area float64
+
+// MarshalText реализует encoding.TextMarshaler
+func (a area) MarshalText() ([]byte, error) {
+	return []byte(strconv.FormatFloat(math.Round(float64(a)*10)/10, 'f', 1, 64)), nil
+}
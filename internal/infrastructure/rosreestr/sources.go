@@ -0,0 +1,97 @@
+package rosreestr
+
+import "regexp"
+
+// sources сопоставляет поля технического плана полям паспорта.
+// Ключ - путь в XML без номеров повторяющихся элементов.
+var sources = map[string]string{
+	"TP/GeneralCadastralWorks/@DateCadastral":                              "as_of_date",
+	"TP/GeneralCadastralWorks/Clients/Client":                              "owners",
+	"TP/GeneralCadastralWorks/Clients/Client/Person/FamilyName":            "owners.full_name",
+	"TP/GeneralCadastralWorks/Clients/Client/Person/FirstName":             "owners.full_name",
+	"TP/GeneralCadastralWorks/Clients/Client/Organization/Name":            "owners.company_name",
+	"TP/GeneralCadastralWorks/Clients/Client/Organization/INN":             "owners.tin",
+	"TP/GeneralCadastralWorks/Contractor/Organization/Name":                "organization_name",
+	"TP/Package/Building/CadastralNumber":                                  "cadastral_number",
+	"TP/Package/Building/Name":                                             "buildings.name",
+	"TP/Package/Building/AssignationBuilding":                              "object_type",
+	"TP/Package/Building/Floors/@Floors":                                   "general_info.floors_above_ground",
+	"TP/Package/Building/Floors/@UndergroundFloors":                        "general_info.floors_underground",
+	"TP/Package/Building/ElementsConstruct/Material":                       "buildings.wall_material",
+	"TP/Package/Building/ElementsConstruct/Material/@Wall":                 "buildings.wall_material",
+	"TP/Package/Building/ExploitationChar/@YearBuilt":                      "general_info.construction_year",
+	"TP/Package/Building/ExploitationChar/@YearUsed":                       "buildings.commission_year",
+	"TP/Package/Building/Area":                                             "general_info.total_area",
+	"TP/Package/Flat/CadastralNumber":                                      "cadastral_number",
+	"TP/Package/Flat/AssignationFlat":                                      "object_type",
+	"TP/Package/Flat/PositionInObject/Levels/Level":                        "explication.floor",
+	"TP/Package/Flat/PositionInObject/Levels/Level/@Number":                "explication.floor",
+	"TP/Package/Flat/PositionInObject/Levels/Level/@Type":                  "explication.floor",
+	"TP/Package/Flat/PositionInObject/Levels/Level/Position":               "explication",
+	"TP/Package/Flat/PositionInObject/Levels/Level/Position/@NumberOnPlan": "explication.room_number",
+	"TP/Package/Flat/PositionInObject/Levels/Level/Position/@Description":  "explication.purpose",
+	"TP/Package/Flat/Area":                                                 "general_info.total_area",
+	"TP/Package/Building/Location/Region":                                  "address.subject",
+	"TP/Package/Flat/Location/Region":                                      "address.subject",
+	"TP/Package/Building/Location/PostalCode":                              "address.postal_code",
+	"TP/Package/Flat/Location/PostalCode":                                  "address.postal_code",
+}
+
+// generated поля, которые формируются при выгрузке без исходных данных паспорта:
+// идентификаторы, константы справочников и составные элементы
+var generated = map[string]bool{
+	"TP/@GUID":                         true,
+	"TP/@Version":                      true,
+	"TP/GeneralCadastralWorks":         true,
+	"TP/GeneralCadastralWorks/Clients": true,
+	"TP/GeneralCadastralWorks/Clients/Client/Person":       true,
+	"TP/GeneralCadastralWorks/Clients/Client/Organization": true,
+	"TP/GeneralCadastralWorks/Contractor":                  true,
+	"TP/GeneralCadastralWorks/Contractor/@Date":            true,
+	"TP/Package":                              true,
+	"TP/Package/Building":                     true,
+	"TP/Package/Building/ObjectType":          true,
+	"TP/Package/Building/Location":            true,
+	"TP/Package/Building/Floors":              true,
+	"TP/Package/Building/ElementsConstruct":   true,
+	"TP/Package/Building/ExploitationChar":    true,
+	"TP/Package/Flat":                         true,
+	"TP/Package/Flat/ObjectType":              true,
+	"TP/Package/Flat/Location":                true,
+	"TP/Package/Flat/PositionInObject":        true,
+	"TP/Package/Flat/PositionInObject/Levels": true,
+}
+
+// explicationPosition раздел плана этажа, в который выгружаются помещения экспликации
+const explicationPosition = "TP/Package/Flat/PositionInObject/Levels/Level/Position"
+
+// unexported сведения экспликации, для которых в схеме технического плана
+// нет полей: в документ они не попадают и перечисляются в отчете
+var unexported = []struct {
+	path, field, message string
+}{
+	{explicationPosition, "explication.litera", "литера помещения не предусмотрена схемой"},
+	{explicationPosition, "explication.area", "площадь помещения не предусмотрена схемой"},
+	{explicationPosition, "explication.living_area", "жилая площадь помещения не предусмотрена схемой"},
+	{explicationPosition, "explication.auxiliary_area", "вспомогательная площадь помещения не предусмотрена схемой"},
+	{explicationPosition, "explication.height", "высота помещения не предусмотрена схемой"},
+	{"TP/Package/Building", "explication", "в техническом плане здания нет раздела помещений"},
+}
+
+// indexPattern номера повторяющихся элементов в пути
+var indexPattern = regexp.MustCompile(`\[[0-9]+\]`)
+
+// sourceOf возвращает поле паспорта для пути XML
+func sourceOf(path string) string {
+	return sources[indexPattern.ReplaceAllString(path, "")]
+}
+
+// isMapped проверяет, заполняется ли поле при выгрузке
+func isMapped(path string) bool {
+	path = indexPattern.ReplaceAllString(path, "")
+	if generated[path] {
+		return true
+	}
+	_, ok := sources[path]
+	return ok
+}
@@ -0,0 +1,69 @@
+package xsd
+
+// RequiredField обязательный по схеме элемент или атрибут
+type RequiredField struct {
+	// Path путь от корневого элемента, атрибуты через @
+	Path string
+
+	// Choice элемент обязателен только в выбранной ветви xs:choice
+	Choice bool
+}
+
+// maxDepth ограничение глубины обхода для рекурсивных типов
+const maxDepth = 32
+
+// RequiredFields перечисляет обязательные элементы и атрибуты документа
+// с корневым элементом root в порядке объявления
+func (s *Schema) RequiredFields(root string) []RequiredField {
+	decl, ok := s.elements[root]
+	if !ok {
+		return nil
+	}
+
+	var fields []RequiredField
+	s.collectElement(decl, root, false, 0, &fields)
+	return fields
+}
+
+// collectElement собирает обязательные поля элемента
+func (s *Schema) collectElement(decl *elementDecl, path string, choice bool, depth int, fields *[]RequiredField) {
+	if depth > maxDepth {
+		return
+	}
+
+	_, def := s.elementType(decl)
+	if def.complex == nil {
+		return
+	}
+
+	for _, a := range def.complex.attrs {
+		if a.required {
+			*fields = append(*fields, RequiredField{Path: path + "/@" + a.name, Choice: choice})
+		}
+	}
+
+	s.collectParticle(def.complex.content, path, choice, depth, fields)
+}
+
+// collectParticle собирает обязательные поля частицы модели содержимого
+func (s *Schema) collectParticle(p *particle, path string, choice bool, depth int, fields *[]RequiredField) {
+	if p == nil || p.min == 0 {
+		return
+	}
+
+	switch p.kind {
+	case "element":
+		name, _ := s.elementType(p.element)
+		childPath := path + "/" + name
+		*fields = append(*fields, RequiredField{Path: childPath, Choice: choice})
+		s.collectElement(p.element, childPath, choice, depth+1, fields)
+	case "choice":
+		for _, child := range p.children {
+			s.collectParticle(child, path, true, depth, fields)
+		}
+	default:
+		for _, child := range p.children {
+			s.collectParticle(child, path, choice, depth, fields)
+		}
+	}
+}
@@ -0,0 +1,987 @@
+// Package xsd реализует проверку XML документов по XSD схемам.
+//
+// Поддерживается подмножество XML Schema 1.0, достаточное для схем Росреестра:
+// глобальные и локальные элементы, ссылки ref, complexType с sequence, choice
+// и all, simpleContent и complexContent (extension и restriction), группы
+// xs:group и xs:attributeGroup, атрибуты, simpleType с ограничениями
+// (enumeration, pattern, length, minLength, maxLength, minInclusive,
+// maxInclusive, totalDigits, fractionDigits) и объединениями xs:union,
+// xs:include и xs:import из того же каталога. Имена сопоставляются по
+// локальной части: пространства имен проверяются только у корневого элемента.
+//
+// Схема с любой другой конструкцией (xs:any, xs:list, подстановки, ключи и т.п.)
+// не загружается: проверка по ней дала бы ложный результат.
+package xsd
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// unbounded значение maxOccurs="unbounded"
+const unbounded = -1
+
+// node узел XML документа схемы
+type node struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	Children []node     `xml:",any"`
+}
+
+// attr возвращает значение атрибута узла
+func (n *node) attr(name string) string {
+	for _, a := range n.Attrs {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// Schema скомпилированная XSD схема вместе со всеми подключенными файлами
+type Schema struct {
+	targetNamespace string
+	elements        map[string]*elementDecl
+	types           map[string]*typeDef
+	groups          map[string]*particle
+	attrGroups      map[string]*attrGroup
+	order           []string // порядок объявления глобальных элементов
+}
+
+// elementDecl объявление элемента
+type elementDecl struct {
+	name     string
+	ref      string
+	typeName string
+	inline   *typeDef
+	min, max int
+}
+
+// particle частица модели содержимого
+type particle struct {
+	kind     string // element, sequence, choice, all; group - ссылка до разрешения
+	element  *elementDecl
+	ref      string // имя группы xs:group
+	children []*particle
+	min, max int
+}
+
+// attrDecl объявление атрибута
+type attrDecl struct {
+	name       string
+	required   bool
+	prohibited bool
+	typeName   string
+	inline     *simpleType
+}
+
+// attrGroup группа атрибутов xs:attributeGroup
+type attrGroup struct {
+	attrs []*attrDecl
+	refs  []string
+}
+
+// typeDef именованный или анонимный тип
+type typeDef struct {
+	name    string
+	simple  *simpleType
+	complex *complexType
+}
+
+// complexType составной тип
+type complexType struct {
+	content    *particle
+	attrs      []*attrDecl
+	attrGroups []string
+	text       *simpleType // simpleContent
+
+	// base базовый тип complexContent или simpleContent, extension -
+	// расширение (иначе ограничение); resolved - наследование разрешено
+	base      string
+	extension bool
+	resolved  bool
+}
+
+// simpleType простой тип с ограничениями
+type simpleType struct {
+	base           string
+	enumeration    []string
+	patterns       []*regexp.Regexp
+	length         *int
+	minLength      *int
+	maxLength      *int
+	minInclusive   *float64
+	maxInclusive   *float64
+	totalDigits    *int
+	fractionDigits *int
+
+	// union типы-участники xs:union; baseType - базовый тип текста,
+	// унаследованный от составного типа (simpleContent/restriction)
+	union    []*simpleType
+	baseType *simpleType
+}
+
+// Load читает схему name из fsys вместе с подключаемыми схемами
+func Load(fsys fs.FS, name string) (*Schema, error) {
+	s := &Schema{
+		elements:   make(map[string]*elementDecl),
+		types:      make(map[string]*typeDef),
+		groups:     make(map[string]*particle),
+		attrGroups: make(map[string]*attrGroup),
+	}
+
+	loaded := make(map[string]bool)
+	if err := s.load(fsys, name, loaded, true); err != nil {
+		return nil, err
+	}
+
+	if err := s.resolve(); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkReferences(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// TargetNamespace возвращает целевое пространство имен основной схемы
+func (s *Schema) TargetNamespace() string {
+	return s.targetNamespace
+}
+
+// load разбирает файл схемы и подключенные им файлы
+func (s *Schema) load(fsys fs.FS, name string, loaded map[string]bool, main bool) error {
+	if loaded[name] {
+		return nil
+	}
+	loaded[name] = true
+
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return fmt.Errorf("failed to read schema %s: %w", name, err)
+	}
+
+	var root node
+	if err := xml.Unmarshal(data, &root); err != nil {
+		return fmt.Errorf("failed to parse schema %s: %w", name, err)
+	}
+	if root.XMLName.Local != "schema" {
+		return fmt.Errorf("schema %s: root element must be xs:schema", name)
+	}
+
+	if main {
+		s.targetNamespace = root.attr("targetNamespace")
+	}
+
+	for i := range root.Children {
+		child := &root.Children[i]
+
+		switch child.XMLName.Local {
+		case "include", "import":
+			location := child.attr("schemaLocation")
+			if location == "" {
+				continue
+			}
+			if err := s.load(fsys, path.Join(path.Dir(name), location), loaded, false); err != nil {
+				return err
+			}
+		case "element":
+			decl, err := compileElement(child)
+			if err != nil {
+				return fmt.Errorf("schema %s: %w", name, err)
+			}
+			s.elements[decl.name] = decl
+			s.order = append(s.order, decl.name)
+		case "complexType", "simpleType":
+			def, err := compileType(child)
+			if err != nil {
+				return fmt.Errorf("schema %s: %w", name, err)
+			}
+			s.types[def.name] = def
+		case "group":
+			group, err := compileGroupDef(child)
+			if err != nil {
+				return fmt.Errorf("schema %s: group %s: %w", name, child.attr("name"), err)
+			}
+			s.groups[child.attr("name")] = group
+		case "attributeGroup":
+			group, err := compileAttributeGroup(child)
+			if err != nil {
+				return fmt.Errorf("schema %s: attribute group %s: %w", name, child.attr("name"), err)
+			}
+			s.attrGroups[child.attr("name")] = group
+		case "annotation":
+		default:
+			return fmt.Errorf("schema %s: %w", name, unsupported(child))
+		}
+	}
+
+	return nil
+}
+
+// unsupported ошибка о конструкции схемы, которую валидатор не проверяет
+func unsupported(n *node) error {
+	return fmt.Errorf("unsupported construct xs:%s", n.XMLName.Local)
+}
+
+// unsupportedAttrs возвращает ошибку, если у узла задан один из атрибутов,
+// меняющих смысл проверки, которые валидатор не поддерживает
+func unsupportedAttrs(n *node, names ...string) error {
+	for _, name := range names {
+		if v := n.attr(name); v != "" && v != "false" {
+			return fmt.Errorf("unsupported construct xs:%s/@%s", n.XMLName.Local, name)
+		}
+	}
+	return nil
+}
+
+// compileElement компилирует объявление элемента
+func compileElement(n *node) (*elementDecl, error) {
+	decl := &elementDecl{
+		name:     n.attr("name"),
+		ref:      localName(n.attr("ref")),
+		typeName: n.attr("type"),
+	}
+	if decl.name == "" && decl.ref == "" {
+		return nil, fmt.Errorf("element without name or ref")
+	}
+
+	var err error
+	if decl.min, decl.max, err = occurs(n); err != nil {
+		return nil, fmt.Errorf("element %s: %w", decl.name+decl.ref, err)
+	}
+	if err := unsupportedAttrs(n, "substitutionGroup", "abstract", "nillable", "fixed"); err != nil {
+		return nil, fmt.Errorf("element %s: %w", decl.name+decl.ref, err)
+	}
+
+	for i := range n.Children {
+		child := &n.Children[i]
+
+		switch child.XMLName.Local {
+		case "complexType", "simpleType":
+			if decl.inline, err = compileType(child); err != nil {
+				return nil, fmt.Errorf("element %s: %w", decl.name, err)
+			}
+		case "annotation":
+		default:
+			return nil, fmt.Errorf("element %s: %w", decl.name+decl.ref, unsupported(child))
+		}
+	}
+
+	return decl, nil
+}
+
+// compileType компилирует complexType или simpleType
+func compileType(n *node) (*typeDef, error) {
+	def := &typeDef{name: n.attr("name")}
+
+	if n.XMLName.Local == "simpleType" {
+		st, err := compileSimple(n)
+		if err != nil {
+			return nil, err
+		}
+		def.simple = st
+		return def, nil
+	}
+
+	if err := unsupportedAttrs(n, "mixed"); err != nil {
+		return nil, err
+	}
+
+	ct := &complexType{}
+	if err := compileContent(n, ct); err != nil {
+		return nil, err
+	}
+	for i := range n.Children {
+		child := &n.Children[i]
+
+		switch child.XMLName.Local {
+		case "simpleContent":
+			if err := compileDerivation(child, ct, true); err != nil {
+				return nil, err
+			}
+		case "complexContent":
+			if err := unsupportedAttrs(child, "mixed"); err != nil {
+				return nil, err
+			}
+			if err := compileDerivation(child, ct, false); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	def.complex = ct
+	return def, nil
+}
+
+// compileContent компилирует модель содержимого и атрибуты составного типа
+// или его расширения; simpleContent и complexContent разбираются вызывающим
+func compileContent(n *node, ct *complexType) error {
+	for i := range n.Children {
+		child := &n.Children[i]
+
+		switch child.XMLName.Local {
+		case "sequence", "choice", "all", "group":
+			p, err := compileGroup(child)
+			if err != nil {
+				return err
+			}
+			ct.content = p
+		case "attribute":
+			a, err := compileAttribute(child)
+			if err != nil {
+				return err
+			}
+			ct.attrs = append(ct.attrs, a)
+		case "attributeGroup":
+			ct.attrGroups = append(ct.attrGroups, localName(child.attr("ref")))
+		case "simpleContent", "complexContent", "annotation":
+		default:
+			return unsupported(child)
+		}
+	}
+	return nil
+}
+
+// compileDerivation компилирует xs:extension или xs:restriction внутри
+// simpleContent (text) или complexContent
+func compileDerivation(n *node, ct *complexType, text bool) error {
+	for i := range n.Children {
+		derivation := &n.Children[i]
+
+		switch derivation.XMLName.Local {
+		case "extension", "restriction":
+		case "annotation":
+			continue
+		default:
+			return unsupported(derivation)
+		}
+
+		ct.base = derivation.attr("base")
+		ct.extension = derivation.XMLName.Local == "extension"
+		if !text {
+			if err := compileContent(derivation, ct); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Фасеты ограничения simpleContent относятся к тексту элемента,
+		// атрибуты и группы атрибутов - к самому элементу
+		ct.text = &simpleType{base: ct.base}
+		for j := range derivation.Children {
+			child := &derivation.Children[j]
+
+			switch child.XMLName.Local {
+			case "attribute":
+				a, err := compileAttribute(child)
+				if err != nil {
+					return err
+				}
+				ct.attrs = append(ct.attrs, a)
+			case "attributeGroup":
+				ct.attrGroups = append(ct.attrGroups, localName(child.attr("ref")))
+			case "annotation":
+			default:
+				if ct.extension {
+					return unsupported(child)
+				}
+				if err := compileFacet(child, ct.text); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// compileGroup компилирует sequence, choice, all или ссылку на xs:group
+func compileGroup(n *node) (*particle, error) {
+	p := &particle{kind: n.XMLName.Local}
+
+	var err error
+	if p.min, p.max, err = occurs(n); err != nil {
+		return nil, err
+	}
+
+	if p.kind == "group" {
+		if p.ref = localName(n.attr("ref")); p.ref == "" {
+			return nil, fmt.Errorf("group without ref")
+		}
+		return p, nil
+	}
+
+	for i := range n.Children {
+		child := &n.Children[i]
+
+		switch child.XMLName.Local {
+		case "element":
+			decl, err := compileElement(child)
+			if err != nil {
+				return nil, err
+			}
+			p.children = append(p.children, &particle{kind: "element", element: decl, min: decl.min, max: decl.max})
+		case "sequence", "choice", "all", "group":
+			group, err := compileGroup(child)
+			if err != nil {
+				return nil, err
+			}
+			p.children = append(p.children, group)
+		case "annotation":
+		default:
+			return nil, unsupported(child)
+		}
+	}
+
+	return p, nil
+}
+
+// compileGroupDef компилирует глобальное определение xs:group
+func compileGroupDef(n *node) (*particle, error) {
+	for i := range n.Children {
+		child := &n.Children[i]
+
+		switch child.XMLName.Local {
+		case "sequence", "choice", "all":
+			return compileGroup(child)
+		case "annotation":
+		default:
+			return nil, unsupported(child)
+		}
+	}
+	return nil, fmt.Errorf("empty group")
+}
+
+// compileAttributeGroup компилирует глобальное определение xs:attributeGroup
+func compileAttributeGroup(n *node) (*attrGroup, error) {
+	group := &attrGroup{}
+
+	for i := range n.Children {
+		child := &n.Children[i]
+
+		switch child.XMLName.Local {
+		case "attribute":
+			a, err := compileAttribute(child)
+			if err != nil {
+				return nil, err
+			}
+			group.attrs = append(group.attrs, a)
+		case "attributeGroup":
+			group.refs = append(group.refs, localName(child.attr("ref")))
+		case "annotation":
+		default:
+			return nil, unsupported(child)
+		}
+	}
+
+	return group, nil
+}
+
+// compileAttribute компилирует объявление атрибута
+func compileAttribute(n *node) (*attrDecl, error) {
+	a := &attrDecl{
+		name:       n.attr("name"),
+		required:   n.attr("use") == "required",
+		prohibited: n.attr("use") == "prohibited",
+		typeName:   n.attr("type"),
+	}
+	if a.name == "" {
+		if n.attr("ref") != "" {
+			return nil, fmt.Errorf("attribute %s: unsupported construct xs:attribute/@ref", n.attr("ref"))
+		}
+		return nil, fmt.Errorf("attribute without name")
+	}
+	if err := unsupportedAttrs(n, "fixed"); err != nil {
+		return nil, fmt.Errorf("attribute %s: %w", a.name, err)
+	}
+
+	for i := range n.Children {
+		child := &n.Children[i]
+
+		switch child.XMLName.Local {
+		case "simpleType":
+			st, err := compileSimple(child)
+			if err != nil {
+				return nil, fmt.Errorf("attribute %s: %w", a.name, err)
+			}
+			a.inline = st
+		case "annotation":
+		default:
+			return nil, fmt.Errorf("attribute %s: %w", a.name, unsupported(child))
+		}
+	}
+
+	return a, nil
+}
+
+// compileSimple компилирует xs:simpleType с xs:restriction или xs:union
+func compileSimple(n *node) (*simpleType, error) {
+	st := &simpleType{base: "xs:string"}
+
+	for i := range n.Children {
+		child := &n.Children[i]
+
+		switch child.XMLName.Local {
+		case "restriction":
+			st.base = child.attr("base")
+			if st.base == "" {
+				return nil, fmt.Errorf("unsupported construct xs:restriction without base")
+			}
+			for j := range child.Children {
+				if err := compileFacet(&child.Children[j], st); err != nil {
+					return nil, err
+				}
+			}
+		case "union":
+			// Значение объединения проверяется по каждому из типов-участников
+			st.base = ""
+			for _, member := range strings.Fields(child.attr("memberTypes")) {
+				st.union = append(st.union, &simpleType{base: member})
+			}
+			for j := range child.Children {
+				member := &child.Children[j]
+
+				switch member.XMLName.Local {
+				case "simpleType":
+					ms, err := compileSimple(member)
+					if err != nil {
+						return nil, err
+					}
+					st.union = append(st.union, ms)
+				case "annotation":
+				default:
+					return nil, unsupported(member)
+				}
+			}
+			if len(st.union) == 0 {
+				return nil, fmt.Errorf("empty union")
+			}
+		case "annotation":
+		default:
+			return nil, unsupported(child)
+		}
+	}
+
+	return st, nil
+}
+
+// compileFacet добавляет к простому типу ограничение xs:restriction
+func compileFacet(facet *node, st *simpleType) error {
+	value := facet.attr("value")
+
+	var err error
+	switch facet.XMLName.Local {
+	case "enumeration":
+		st.enumeration = append(st.enumeration, value)
+	case "pattern":
+		var re *regexp.Regexp
+		if re, err = regexp.Compile("^(?:" + value + ")$"); err == nil {
+			st.patterns = append(st.patterns, re)
+		}
+	case "length":
+		st.length, err = intFacet(value)
+	case "minLength":
+		st.minLength, err = intFacet(value)
+	case "maxLength":
+		st.maxLength, err = intFacet(value)
+	case "totalDigits":
+		st.totalDigits, err = intFacet(value)
+	case "fractionDigits":
+		st.fractionDigits, err = intFacet(value)
+	case "minInclusive":
+		st.minInclusive, err = floatFacet(value)
+	case "maxInclusive":
+		st.maxInclusive, err = floatFacet(value)
+	case "annotation":
+	default:
+		return unsupported(facet)
+	}
+	if err != nil {
+		return fmt.Errorf("facet %s=%q: %w", facet.XMLName.Local, value, err)
+	}
+	return nil
+}
+
+// resolve подставляет группы и наследуемое содержимое производных типов
+func (s *Schema) resolve() error {
+	for name, group := range s.groups {
+		if err := s.expandGroups(group, map[string]bool{name: true}); err != nil {
+			return fmt.Errorf("group %s: %w", name, err)
+		}
+	}
+
+	var types []*typeDef
+	for _, def := range s.types {
+		types = append(types, def)
+	}
+	for _, decl := range s.elements {
+		if decl.inline != nil {
+			types = append(types, decl.inline)
+		}
+	}
+
+	// Анонимные типы локальных элементов разрешаются при обходе содержимого
+	for len(types) > 0 {
+		def := types[0]
+		types = types[1:]
+		if def.complex == nil {
+			continue
+		}
+		if err := s.resolveComplex(def.complex, map[*complexType]bool{}); err != nil {
+			if def.name == "" {
+				return err
+			}
+			return fmt.Errorf("type %s: %w", def.name, err)
+		}
+		types = append(types, localTypes(def.complex.content)...)
+	}
+
+	return nil
+}
+
+// localTypes возвращает анонимные типы элементов частицы
+func localTypes(p *particle) []*typeDef {
+	if p == nil {
+		return nil
+	}
+	if p.element != nil {
+		if p.element.inline != nil {
+			return []*typeDef{p.element.inline}
+		}
+		return nil
+	}
+
+	var result []*typeDef
+	for _, child := range p.children {
+		result = append(result, localTypes(child)...)
+	}
+	return result
+}
+
+// expandGroups заменяет ссылки на xs:group их содержимым; visiting - группы
+// на текущем пути подстановки для обнаружения циклов
+func (s *Schema) expandGroups(p *particle, visiting map[string]bool) error {
+	if p == nil {
+		return nil
+	}
+
+	if p.kind == "group" {
+		def, ok := s.groups[p.ref]
+		if !ok {
+			return fmt.Errorf("unknown group reference %q", p.ref)
+		}
+		if visiting[p.ref] {
+			return fmt.Errorf("circular group reference %q", p.ref)
+		}
+		visiting[p.ref] = true
+		defer delete(visiting, p.ref)
+		if err := s.expandGroups(def, visiting); err != nil {
+			return err
+		}
+		p.kind, p.children, p.ref = def.kind, def.children, ""
+		return nil
+	}
+
+	for _, child := range p.children {
+		if err := s.expandGroups(child, visiting); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveComplex подставляет группы атрибутов и наследует содержимое
+// и атрибуты базового типа; visiting - типы на текущем пути наследования
+func (s *Schema) resolveComplex(ct *complexType, visiting map[*complexType]bool) error {
+	if ct.resolved {
+		return nil
+	}
+	if visiting[ct] {
+		return fmt.Errorf("circular type derivation")
+	}
+	visiting[ct] = true
+
+	if err := s.expandGroups(ct.content, map[string]bool{}); err != nil {
+		return err
+	}
+
+	attrs := make([]*attrDecl, 0, len(ct.attrs))
+	for _, name := range ct.attrGroups {
+		groupAttrs, err := s.groupAttributes(name, map[string]bool{})
+		if err != nil {
+			return err
+		}
+		attrs = append(attrs, groupAttrs...)
+	}
+	ct.attrs = append(attrs, ct.attrs...)
+
+	if ct.base != "" && !isBuiltin(ct.base) {
+		def, ok := s.types[localName(ct.base)]
+		if !ok {
+			return fmt.Errorf("unknown type %q", ct.base)
+		}
+		if def.complex != nil {
+			if err := s.resolveComplex(def.complex, visiting); err != nil {
+				return err
+			}
+			if err := s.inherit(ct, def.complex); err != nil {
+				return err
+			}
+		} else if ct.text == nil {
+			return fmt.Errorf("complexContent cannot derive from simple type %q", ct.base)
+		}
+	}
+
+	ct.resolved = true
+	return nil
+}
+
+// inherit переносит в производный тип содержимое и атрибуты базового
+func (s *Schema) inherit(ct, base *complexType) error {
+	switch {
+	case ct.text != nil && base.text == nil:
+		return fmt.Errorf("simpleContent cannot derive from type %q without text content", ct.base)
+	case ct.text == nil && base.text != nil:
+		return fmt.Errorf("unsupported construct xs:complexContent from simpleContent type %q", ct.base)
+	case ct.text != nil && ct.extension:
+		ct.text = base.text
+	case ct.text != nil:
+		// Ограничение текста проверяется вместе с ограничениями базового типа
+		ct.text.base, ct.text.baseType = "", base.text
+	}
+
+	// Атрибуты базового типа наследуются; ограничение может их переопределить
+	// или запретить (use="prohibited")
+	own := make(map[string]bool, len(ct.attrs))
+	for _, a := range ct.attrs {
+		own[a.name] = true
+	}
+	attrs := make([]*attrDecl, 0, len(base.attrs)+len(ct.attrs))
+	for _, a := range base.attrs {
+		if !own[a.name] {
+			attrs = append(attrs, a)
+		}
+	}
+	for _, a := range ct.attrs {
+		if !a.prohibited {
+			attrs = append(attrs, a)
+		}
+	}
+	ct.attrs = attrs
+
+	// Расширение дописывает свою модель содержимого после базовой,
+	// ограничение заменяет ее целиком
+	if !ct.extension || base.content == nil {
+		return nil
+	}
+	if ct.content == nil {
+		ct.content = base.content
+		return nil
+	}
+	ct.content = &particle{kind: "sequence", children: []*particle{base.content, ct.content}, min: 1, max: 1}
+	return nil
+}
+
+// groupAttributes возвращает атрибуты группы xs:attributeGroup вместе
+// с вложенными группами; visiting - группы на текущем пути
+func (s *Schema) groupAttributes(name string, visiting map[string]bool) ([]*attrDecl, error) {
+	group, ok := s.attrGroups[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown attribute group reference %q", name)
+	}
+	if visiting[name] {
+		return nil, fmt.Errorf("circular attribute group reference %q", name)
+	}
+	visiting[name] = true
+	defer delete(visiting, name)
+
+	attrs := append([]*attrDecl(nil), group.attrs...)
+	for _, ref := range group.refs {
+		nested, err := s.groupAttributes(ref, visiting)
+		if err != nil {
+			return nil, err
+		}
+		attrs = append(attrs, nested...)
+	}
+	return attrs, nil
+}
+
+// checkReferences проверяет, что все ссылки на типы и элементы разрешаются
+func (s *Schema) checkReferences() error {
+	var checkParticle func(p *particle) error
+	var checkType func(def *typeDef) error
+
+	checkElement := func(decl *elementDecl) error {
+		if decl.ref != "" {
+			if _, ok := s.elements[decl.ref]; !ok {
+				return fmt.Errorf("unknown element reference %q", decl.ref)
+			}
+			return nil
+		}
+		if decl.inline != nil {
+			return checkType(decl.inline)
+		}
+		if _, err := s.resolveType(decl.typeName); err != nil {
+			return fmt.Errorf("element %s: %w", decl.name, err)
+		}
+		return nil
+	}
+
+	checkParticle = func(p *particle) error {
+		if p == nil {
+			return nil
+		}
+		if p.element != nil {
+			return checkElement(p.element)
+		}
+		for _, child := range p.children {
+			if err := checkParticle(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	checkType = func(def *typeDef) error {
+		if def.simple != nil {
+			return s.checkSimple(def.simple)
+		}
+		for _, a := range def.complex.attrs {
+			if a.inline != nil {
+				if err := s.checkSimple(a.inline); err != nil {
+					return fmt.Errorf("attribute %s: %w", a.name, err)
+				}
+				continue
+			}
+			if attrType, err := s.resolveType(a.typeName); err != nil || attrType.simple == nil {
+				return fmt.Errorf("attribute %s: unknown simple type %q", a.name, a.typeName)
+			}
+		}
+		if def.complex.text != nil {
+			if err := s.checkSimple(def.complex.text); err != nil {
+				return err
+			}
+		}
+		return checkParticle(def.complex.content)
+	}
+
+	for _, name := range s.order {
+		if err := checkElement(s.elements[name]); err != nil {
+			return err
+		}
+	}
+	for _, def := range s.types {
+		if err := checkType(def); err != nil {
+			return fmt.Errorf("type %s: %w", def.name, err)
+		}
+	}
+
+	return nil
+}
+
+// checkSimple проверяет базовые типы простого типа и участников объединения
+func (s *Schema) checkSimple(st *simpleType) error {
+	if st.baseType != nil {
+		return nil
+	}
+	for _, member := range st.union {
+		if err := s.checkSimple(member); err != nil {
+			return err
+		}
+	}
+	return s.checkSimpleBase(st.base)
+}
+
+// checkSimpleBase проверяет базовый тип ограничения
+func (s *Schema) checkSimpleBase(base string) error {
+	if isBuiltin(base) {
+		return nil
+	}
+	def, ok := s.types[localName(base)]
+	if !ok || def.simple == nil {
+		return fmt.Errorf("unknown simple type %q", base)
+	}
+	return nil
+}
+
+// resolveType находит тип по имени; пустое имя означает xs:string
+func (s *Schema) resolveType(name string) (*typeDef, error) {
+	if name == "" || isBuiltin(name) {
+		return &typeDef{name: name, simple: &simpleType{base: name}}, nil
+	}
+	def, ok := s.types[localName(name)]
+	if !ok {
+		return nil, fmt.Errorf("unknown type %q", name)
+	}
+	return def, nil
+}
+
+// elementType возвращает тип объявления элемента с учетом ref
+func (s *Schema) elementType(decl *elementDecl) (string, *typeDef) {
+	if decl.ref != "" {
+		decl = s.elements[decl.ref]
+	}
+	if decl.inline != nil {
+		return decl.name, decl.inline
+	}
+	def, _ := s.resolveType(decl.typeName)
+	return decl.name, def
+}
+
+// occurs читает minOccurs и maxOccurs
+func occurs(n *node) (int, int, error) {
+	min, max := 1, 1
+
+	if v := n.attr("minOccurs"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid minOccurs %q", v)
+		}
+		min = parsed
+	}
+
+	if v := n.attr("maxOccurs"); v != "" {
+		if v == "unbounded" {
+			max = unbounded
+		} else {
+			parsed, err := strconv.Atoi(v)
+			if err != nil {
+				return 0, 0, fmt.Errorf("invalid maxOccurs %q", v)
+			}
+			max = parsed
+		}
+	}
+
+	return min, max, nil
+}
+
+// localName отбрасывает префикс пространства имен
+func localName(qname string) string {
+	if i := strings.IndexByte(qname, ':'); i >= 0 {
+		return qname[i+1:]
+	}
+	return qname
+}
+
+func intFacet(value string) (*int, error) {
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func floatFacet(value string) (*float64, error) {
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
@@ -0,0 +1,502 @@
+package xsd
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ViolationKind вид нарушения схемы
+type ViolationKind string
+
+const (
+	// ViolationMissing отсутствует обязательный элемент или атрибут
+	ViolationMissing ViolationKind = "missing"
+
+	// ViolationInvalid значение не соответствует типу
+	ViolationInvalid ViolationKind = "invalid"
+
+	// ViolationUnexpected элемент или атрибут не предусмотрен схемой
+	ViolationUnexpected ViolationKind = "unexpected"
+)
+
+// Violation нарушение схемы в проверяемом документе
+type Violation struct {
+	// Path путь к элементу: TP/Package/Building/Area, атрибуты через @
+	Path string
+
+	// Kind вид нарушения
+	Kind ViolationKind
+
+	// Message описание нарушения
+	Message string
+}
+
+func (v Violation) Error() string {
+	return v.Path + ": " + v.Message
+}
+
+// instance узел проверяемого документа
+type instance struct {
+	name     xml.Name
+	attrs    []xml.Attr
+	children []*instance
+	text     strings.Builder
+}
+
+// Validate проверяет документ по схеме и возвращает найденные нарушения.
+// Ошибка возвращается, только если документ не является корректным XML.
+func (s *Schema) Validate(data []byte) ([]Violation, error) {
+	root, err := parseInstance(data)
+	if err != nil {
+		return nil, err
+	}
+
+	decl, ok := s.elements[root.name.Local]
+	if !ok {
+		return []Violation{{
+			Path:    root.name.Local,
+			Kind:    ViolationUnexpected,
+			Message: "корневой элемент не объявлен в схеме",
+		}}, nil
+	}
+
+	v := &validator{schema: s}
+	if s.targetNamespace != "" && root.name.Space != s.targetNamespace {
+		v.add(root.name.Local, ViolationInvalid, fmt.Sprintf("ожидалось пространство имен %s", s.targetNamespace))
+	}
+	v.element(root, decl, root.name.Local)
+
+	return v.violations, nil
+}
+
+// parseInstance читает XML документ в дерево узлов
+func parseInstance(data []byte) (*instance, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+
+	var (
+		root  *instance
+		stack []*instance
+	)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid XML: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			n := &instance{name: t.Name}
+			for _, a := range t.Attr {
+				if a.Name.Space == "xmlns" || a.Name.Local == "xmlns" || a.Name.Space == "http://www.w3.org/2001/XMLSchema-instance" {
+					continue
+				}
+				n.attrs = append(n.attrs, a)
+			}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, n)
+			} else if root == nil {
+				root = n
+			}
+			stack = append(stack, n)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(t)
+			}
+		}
+	}
+
+	if root == nil {
+		return nil, fmt.Errorf("invalid XML: document is empty")
+	}
+
+	return root, nil
+}
+
+// validator накапливает нарушения при обходе документа
+type validator struct {
+	schema     *Schema
+	violations []Violation
+}
+
+func (v *validator) add(path string, kind ViolationKind, message string) {
+	v.violations = append(v.violations, Violation{Path: path, Kind: kind, Message: message})
+}
+
+// element проверяет узел документа по объявлению элемента
+func (v *validator) element(n *instance, decl *elementDecl, path string) {
+	_, def := v.schema.elementType(decl)
+
+	if def.simple != nil {
+		if len(n.children) > 0 {
+			v.add(path, ViolationUnexpected, "элемент простого типа не может содержать вложенные элементы")
+		}
+		if len(n.attrs) > 0 {
+			v.add(path+"/@"+n.attrs[0].Name.Local, ViolationUnexpected, "атрибут не предусмотрен схемой")
+		}
+		v.value(path, strings.TrimSpace(n.text.String()), def.simple)
+		return
+	}
+
+	ct := def.complex
+	v.attributes(n, ct, path)
+
+	if ct.text != nil {
+		v.value(path, strings.TrimSpace(n.text.String()), ct.text)
+		return
+	}
+
+	if ct.content == nil {
+		if len(n.children) > 0 {
+			v.add(path+"/"+n.children[0].name.Local, ViolationUnexpected, "элемент не предусмотрен схемой")
+		}
+		return
+	}
+
+	m := &matcher{v: v, children: n.children, path: path, counts: make(map[string]int)}
+	m.particle(ct.content, true)
+	for _, rest := range m.children[m.pos:] {
+		v.add(path+"/"+rest.name.Local, ViolationUnexpected, "элемент не предусмотрен схемой в этом месте")
+	}
+}
+
+// attributes проверяет атрибуты узла
+func (v *validator) attributes(n *instance, ct *complexType, path string) {
+	declared := make(map[string]*attrDecl, len(ct.attrs))
+	for _, a := range ct.attrs {
+		declared[a.name] = a
+	}
+
+	present := make(map[string]bool, len(n.attrs))
+	for _, a := range n.attrs {
+		attrPath := path + "/@" + a.Name.Local
+		decl, ok := declared[a.Name.Local]
+		if !ok {
+			v.add(attrPath, ViolationUnexpected, "атрибут не предусмотрен схемой")
+			continue
+		}
+		present[a.Name.Local] = true
+
+		st := decl.inline
+		if st == nil {
+			def, _ := v.schema.resolveType(decl.typeName)
+			st = def.simple
+		}
+		v.value(attrPath, a.Value, st)
+	}
+
+	for _, a := range ct.attrs {
+		if a.required && !present[a.name] {
+			v.add(path+"/@"+a.name, ViolationMissing, "обязательный атрибут отсутствует")
+		}
+	}
+}
+
+// matcher сопоставляет дочерние элементы с моделью содержимого.
+// Сопоставление жадное, без возвратов: для схем Росреестра этого достаточно.
+type matcher struct {
+	v        *validator
+	children []*instance
+	pos      int
+	path     string
+	counts   map[string]int
+}
+
+// next возвращает имя очередного дочернего элемента
+func (m *matcher) next() string {
+	if m.pos >= len(m.children) {
+		return ""
+	}
+	return m.children[m.pos].name.Local
+}
+
+// particle сопоставляет частицу; report - сообщать ли об отсутствии обязательного содержимого
+func (m *matcher) particle(p *particle, report bool) bool {
+	matched := 0
+	for p.max == unbounded || matched < p.max {
+		start := m.pos
+		if !m.once(p, report && matched < p.min) {
+			break
+		}
+		matched++
+		if m.pos == start {
+			break
+		}
+	}
+
+	if matched < p.min && report && p.kind == "element" {
+		name, _ := m.v.schema.elementType(p.element)
+		m.v.add(m.path+"/"+name, ViolationMissing, "обязательный элемент отсутствует")
+	}
+
+	return matched >= p.min
+}
+
+// once сопоставляет одно вхождение частицы
+func (m *matcher) once(p *particle, report bool) bool {
+	switch p.kind {
+	case "element":
+		name, _ := m.v.schema.elementType(p.element)
+		if m.next() != name {
+			return false
+		}
+		child := m.children[m.pos]
+		m.pos++
+		m.counts[name]++
+		m.v.element(child, p.element, m.childPath(name, p.max))
+		return true
+
+	case "sequence":
+		if !report && !m.startsWith(p) {
+			return false
+		}
+		for _, child := range p.children {
+			m.particle(child, true)
+		}
+		return true
+
+	case "choice":
+		for _, child := range p.children {
+			if m.startsWith(child) {
+				return m.particle(child, true)
+			}
+		}
+		if report {
+			m.v.add(m.path+"/"+m.alternatives(p), ViolationMissing, "обязательный элемент отсутствует")
+		}
+		return false
+
+	case "all":
+		if !report && !m.startsWith(p) {
+			return false
+		}
+		seen := make(map[*particle]bool)
+		for progress := true; progress; {
+			progress = false
+			for _, child := range p.children {
+				if !seen[child] && m.startsWith(child) {
+					m.particle(child, true)
+					seen[child] = true
+					progress = true
+				}
+			}
+		}
+		for _, child := range p.children {
+			if !seen[child] {
+				m.particle(child, true)
+			}
+		}
+		return true
+	}
+
+	return false
+}
+
+// startsWith проверяет, может ли частица начаться с очередного элемента
+func (m *matcher) startsWith(p *particle) bool {
+	next := m.next()
+	if next == "" {
+		return false
+	}
+	return m.first(p)[next]
+}
+
+// first возвращает множество имен, с которых может начаться частица
+func (m *matcher) first(p *particle) map[string]bool {
+	result := make(map[string]bool)
+
+	switch p.kind {
+	case "element":
+		name, _ := m.v.schema.elementType(p.element)
+		result[name] = true
+	case "sequence":
+		for _, child := range p.children {
+			for name := range m.first(child) {
+				result[name] = true
+			}
+			if child.min > 0 {
+				break
+			}
+		}
+	default:
+		for _, child := range p.children {
+			for name := range m.first(child) {
+				result[name] = true
+			}
+		}
+	}
+
+	return result
+}
+
+// alternatives описывает варианты choice для сообщения об ошибке
+func (m *matcher) alternatives(p *particle) string {
+	names := make([]string, 0, len(p.children))
+	for _, child := range p.children {
+		for name := range m.first(child) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return "(" + strings.Join(names, "|") + ")"
+}
+
+// childPath формирует путь дочернего элемента; повторяемые элементы нумеруются с 1
+func (m *matcher) childPath(name string, max int) string {
+	if max == 1 {
+		return m.path + "/" + name
+	}
+	return m.path + "/" + name + "[" + strconv.Itoa(m.counts[name]) + "]"
+}
+
+// value проверяет значение простого типа
+func (v *validator) value(path, value string, st *simpleType) {
+	if err := v.schema.checkValue(value, st); err != nil {
+		v.add(path, ViolationInvalid, err.Error())
+	}
+}
+
+// checkValue проверяет значение с учетом цепочки базовых типов
+func (s *Schema) checkValue(value string, st *simpleType) error {
+	if len(st.union) > 0 {
+		for _, member := range st.union {
+			if s.checkValue(value, member) == nil {
+				return nil
+			}
+		}
+		return fmt.Errorf("значение %q не соответствует ни одному из типов объединения", value)
+	}
+
+	if len(st.enumeration) > 0 {
+		found := false
+		for _, e := range st.enumeration {
+			if e == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("значение %q не входит в перечень допустимых", value)
+		}
+	}
+
+	for _, re := range st.patterns {
+		if !re.MatchString(value) {
+			return fmt.Errorf("значение %q не соответствует шаблону %s", value, strings.TrimSuffix(strings.TrimPrefix(re.String(), "^(?:"), ")$"))
+		}
+	}
+
+	length := utf8.RuneCountInString(value)
+	if st.length != nil && length != *st.length {
+		return fmt.Errorf("длина значения должна быть %d", *st.length)
+	}
+	if st.minLength != nil && length < *st.minLength {
+		return fmt.Errorf("длина значения должна быть не меньше %d", *st.minLength)
+	}
+	if st.maxLength != nil && length > *st.maxLength {
+		return fmt.Errorf("длина значения должна быть не больше %d", *st.maxLength)
+	}
+
+	if st.minInclusive != nil || st.maxInclusive != nil {
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("значение %q не является числом", value)
+		}
+		if st.minInclusive != nil && n < *st.minInclusive {
+			return fmt.Errorf("значение должно быть не меньше %v", *st.minInclusive)
+		}
+		if st.maxInclusive != nil && n > *st.maxInclusive {
+			return fmt.Errorf("значение должно быть не больше %v", *st.maxInclusive)
+		}
+	}
+
+	if st.totalDigits != nil || st.fractionDigits != nil {
+		intPart, fracPart, _ := strings.Cut(strings.TrimLeft(value, "+-"), ".")
+		fracPart = strings.TrimRight(fracPart, "0")
+		if st.fractionDigits != nil && len(fracPart) > *st.fractionDigits {
+			return fmt.Errorf("допускается не более %d знаков после запятой", *st.fractionDigits)
+		}
+		if st.totalDigits != nil && len(strings.TrimLeft(intPart, "0"))+len(fracPart) > *st.totalDigits {
+			return fmt.Errorf("допускается не более %d цифр", *st.totalDigits)
+		}
+	}
+
+	if st.baseType != nil {
+		return s.checkValue(value, st.baseType)
+	}
+	if isBuiltin(st.base) {
+		return checkBuiltin(localName(st.base), value)
+	}
+
+	base, ok := s.types[localName(st.base)]
+	if !ok || base.simple == nil {
+		return fmt.Errorf("неизвестный тип %s", st.base)
+	}
+	return s.checkValue(value, base.simple)
+}
+
+// isBuiltin проверяет, относится ли имя к встроенным типам XML Schema
+func isBuiltin(name string) bool {
+	return strings.HasPrefix(name, "xs:") || strings.HasPrefix(name, "xsd:") || name == ""
+}
+
+// checkBuiltin проверяет лексическую форму встроенного типа
+func checkBuiltin(name, value string) error {
+	var err error
+
+	switch name {
+	case "", "string", "normalizedString", "token", "anyURI":
+		return nil
+	case "decimal":
+		if strings.ContainsAny(value, "eE") {
+			return fmt.Errorf("значение %q не является десятичным числом", value)
+		}
+		_, err = strconv.ParseFloat(value, 64)
+	case "integer", "int", "long", "short":
+		_, err = strconv.ParseInt(value, 10, 64)
+	case "nonNegativeInteger", "unsignedInt":
+		var n int64
+		if n, err = strconv.ParseInt(value, 10, 64); err == nil && n < 0 {
+			return fmt.Errorf("значение должно быть неотрицательным")
+		}
+	case "positiveInteger":
+		var n int64
+		if n, err = strconv.ParseInt(value, 10, 64); err == nil && n <= 0 {
+			return fmt.Errorf("значение должно быть положительным")
+		}
+	case "boolean":
+		switch value {
+		case "true", "false", "1", "0":
+			return nil
+		}
+		return fmt.Errorf("значение %q не является логическим", value)
+	case "date":
+		_, err = time.Parse("2006-01-02", value)
+	case "dateTime":
+		_, err = time.Parse(time.RFC3339, value)
+	case "gYear":
+		if len(value) != 4 {
+			return fmt.Errorf("значение %q не является годом", value)
+		}
+		_, err = strconv.Atoi(value)
+	default:
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("значение %q не соответствует типу xs:%s", value, name)
+	}
+	return nil
+}
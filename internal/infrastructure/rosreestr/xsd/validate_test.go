@@ -0,0 +1,272 @@
+package xsd_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/rosreestr/xsd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const mainSchema = `<?xml version="1.0"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" targetNamespace="urn:test" elementFormDefault="qualified">
+	<xs:include schemaLocation="types.xsd"/>
+	<xs:element name="Order">
+		<xs:complexType>
+			<xs:sequence>
+				<xs:element name="Number" type="sNumber"/>
+				<xs:choice>
+					<xs:element name="Person" type="xs:string"/>
+					<xs:element name="Company" type="xs:string"/>
+				</xs:choice>
+				<xs:element name="Item" type="tItem" maxOccurs="unbounded"/>
+				<xs:element name="Note" type="xs:string" minOccurs="0"/>
+			</xs:sequence>
+			<xs:attribute name="Date" type="xs:date" use="required"/>
+		</xs:complexType>
+	</xs:element>
+	<xs:complexType name="tItem">
+		<xs:sequence>
+			<xs:element name="Kind" type="dKind"/>
+			<xs:element name="Price" type="sPrice"/>
+		</xs:sequence>
+	</xs:complexType>
+</xs:schema>`
+
+const typesSchema = `<?xml version="1.0"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+	<xs:simpleType name="sNumber">
+		<xs:restriction base="xs:string"><xs:pattern value="[0-9]{3}"/></xs:restriction>
+	</xs:simpleType>
+	<xs:simpleType name="sPrice">
+		<xs:restriction base="xs:decimal">
+			<xs:fractionDigits value="1"/>
+			<xs:minInclusive value="0.1"/>
+		</xs:restriction>
+	</xs:simpleType>
+	<xs:simpleType name="dKind">
+		<xs:restriction base="xs:string">
+			<xs:enumeration value="01"/>
+			<xs:enumeration value="02"/>
+		</xs:restriction>
+	</xs:simpleType>
+</xs:schema>`
+
+func loadSchema(t *testing.T) *xsd.Schema {
+	schema, err := xsd.Load(fstest.MapFS{
+		"dir/main.xsd":  {Data: []byte(mainSchema)},
+		"dir/types.xsd": {Data: []byte(typesSchema)},
+	}, "dir/main.xsd")
+	require.NoError(t, err)
+	return schema
+}
+
+func TestSchema_Validate(t *testing.T) {
+	schema := loadSchema(t)
+
+	tests := []struct {
+		name       string
+		document   string
+		violations []xsd.Violation
+	}{
+		{
+			name: "valid",
+			document: `<Order xmlns="urn:test" Date="2024-01-15"><Number>001</Number><Company>ООО</Company>
+				<Item><Kind>01</Kind><Price>10.5</Price></Item><Item><Kind>02</Kind><Price>1</Price></Item></Order>`,
+		},
+		{
+			name:     "missing elements and attribute",
+			document: `<Order xmlns="urn:test"><Number>001</Number></Order>`,
+			violations: []xsd.Violation{
+				{Path: "Order/@Date", Kind: xsd.ViolationMissing, Message: "обязательный атрибут отсутствует"},
+				{Path: "Order/(Company|Person)", Kind: xsd.ViolationMissing, Message: "обязательный элемент отсутствует"},
+				{Path: "Order/Item", Kind: xsd.ViolationMissing, Message: "обязательный элемент отсутствует"},
+			},
+		},
+		{
+			name: "invalid values",
+			document: `<Order xmlns="urn:test" Date="15.01.2024"><Number>1</Number><Person>Иванов</Person>
+				<Item><Kind>03</Kind><Price>10.55</Price></Item></Order>`,
+			violations: []xsd.Violation{
+				{Path: "Order/@Date", Kind: xsd.ViolationInvalid, Message: `значение "15.01.2024" не соответствует типу xs:date`},
+				{Path: "Order/Number", Kind: xsd.ViolationInvalid, Message: `значение "1" не соответствует шаблону [0-9]{3}`},
+				{Path: "Order/Item[1]/Kind", Kind: xsd.ViolationInvalid, Message: `значение "03" не входит в перечень допустимых`},
+				{Path: "Order/Item[1]/Price", Kind: xsd.ViolationInvalid, Message: "допускается не более 1 знаков после запятой"},
+			},
+		},
+		{
+			name: "unexpected element and wrong namespace",
+			document: `<Order xmlns="urn:other" Date="2024-01-15"><Number>001</Number><Person>Иванов</Person>
+				<Item><Kind>01</Kind><Price>1</Price></Item><Extra/></Order>`,
+			violations: []xsd.Violation{
+				{Path: "Order", Kind: xsd.ViolationInvalid, Message: "ожидалось пространство имен urn:test"},
+				{Path: "Order/Extra", Kind: xsd.ViolationUnexpected, Message: "элемент не предусмотрен схемой в этом месте"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations, err := schema.Validate([]byte(tt.document))
+			require.NoError(t, err)
+			assert.Equal(t, tt.violations, violations)
+		})
+	}
+}
+
+func TestSchema_ValidateMalformed(t *testing.T) {
+	_, err := loadSchema(t).Validate([]byte("<Order>"))
+	assert.Error(t, err)
+}
+
+func TestSchema_RequiredFields(t *testing.T) {
+	fields := loadSchema(t).RequiredFields("Order")
+
+	assert.Equal(t, []xsd.RequiredField{
+		{Path: "Order/@Date"},
+		{Path: "Order/Number"},
+		{Path: "Order/Person", Choice: true},
+		{Path: "Order/Company", Choice: true},
+		{Path: "Order/Item"},
+		{Path: "Order/Item/Kind"},
+		{Path: "Order/Item/Price"},
+	}, fields)
+}
+
+func TestLoad_UnknownType(t *testing.T) {
+	_, err := xsd.Load(fstest.MapFS{
+		"main.xsd": {Data: []byte(`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+			<xs:element name="A" type="tMissing"/></xs:schema>`)},
+	}, "main.xsd")
+	assert.ErrorContains(t, err, "unknown type")
+}
+
+const derivedSchema = `<?xml version="1.0"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:t="urn:test" targetNamespace="urn:test">
+	<xs:element name="Doc">
+		<xs:complexType>
+			<xs:sequence>
+				<xs:element name="Owner" type="tOwner"/>
+				<xs:group ref="t:gContacts" minOccurs="0"/>
+				<xs:element name="Area" type="tArea"/>
+			</xs:sequence>
+		</xs:complexType>
+	</xs:element>
+	<xs:complexType name="tParty">
+		<xs:sequence>
+			<xs:element name="Name" type="xs:string"/>
+		</xs:sequence>
+		<xs:attributeGroup ref="t:agIdentity"/>
+	</xs:complexType>
+	<xs:complexType name="tOwner">
+		<xs:complexContent>
+			<xs:extension base="t:tParty">
+				<xs:sequence>
+					<xs:element name="Share" type="xs:positiveInteger"/>
+				</xs:sequence>
+			</xs:extension>
+		</xs:complexContent>
+	</xs:complexType>
+	<xs:group name="gContacts">
+		<xs:sequence>
+			<xs:element name="Phone" type="xs:string"/>
+			<xs:element name="Email" type="xs:string" minOccurs="0"/>
+		</xs:sequence>
+	</xs:group>
+	<xs:attributeGroup name="agIdentity">
+		<xs:attribute name="Code" type="sCode" use="required"/>
+	</xs:attributeGroup>
+	<xs:simpleType name="sCode">
+		<xs:union memberTypes="xs:positiveInteger">
+			<xs:simpleType>
+				<xs:restriction base="xs:string"><xs:enumeration value="none"/></xs:restriction>
+			</xs:simpleType>
+		</xs:union>
+	</xs:simpleType>
+	<xs:complexType name="tMeasure">
+		<xs:simpleContent>
+			<xs:extension base="xs:decimal">
+				<xs:attribute name="Unit" type="xs:string" use="required"/>
+			</xs:extension>
+		</xs:simpleContent>
+	</xs:complexType>
+	<xs:complexType name="tArea">
+		<xs:simpleContent>
+			<xs:restriction base="t:tMeasure">
+				<xs:minInclusive value="0.1"/>
+			</xs:restriction>
+		</xs:simpleContent>
+	</xs:complexType>
+</xs:schema>`
+
+func TestSchema_ValidateDerivedTypes(t *testing.T) {
+	schema, err := xsd.Load(fstest.MapFS{"main.xsd": {Data: []byte(derivedSchema)}}, "main.xsd")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		document   string
+		violations []xsd.Violation
+	}{
+		{
+			name: "valid",
+			document: `<Doc xmlns="urn:test"><Owner Code="none"><Name>Иванов</Name><Share>1</Share></Owner>
+				<Phone>123</Phone><Area Unit="m2">10.5</Area></Doc>`,
+		},
+		{
+			name:     "empty extension reports base and own content",
+			document: `<Doc xmlns="urn:test"><Owner/><Area Unit="m2">0</Area></Doc>`,
+			violations: []xsd.Violation{
+				{Path: "Doc/Owner/@Code", Kind: xsd.ViolationMissing, Message: "обязательный атрибут отсутствует"},
+				{Path: "Doc/Owner/Name", Kind: xsd.ViolationMissing, Message: "обязательный элемент отсутствует"},
+				{Path: "Doc/Owner/Share", Kind: xsd.ViolationMissing, Message: "обязательный элемент отсутствует"},
+				{Path: "Doc/Area", Kind: xsd.ViolationInvalid, Message: "значение должно быть не меньше 0.1"},
+			},
+		},
+		{
+			name: "union and group content",
+			document: `<Doc xmlns="urn:test"><Owner Code="x"><Name>Иванов</Name><Share>1</Share></Owner>
+				<Phone>123</Phone><Email>a@b.ru</Email><Area>1</Area></Doc>`,
+			violations: []xsd.Violation{
+				{Path: "Doc/Owner/@Code", Kind: xsd.ViolationInvalid, Message: `значение "x" не соответствует ни одному из типов объединения`},
+				{Path: "Doc/Area/@Unit", Kind: xsd.ViolationMissing, Message: "обязательный атрибут отсутствует"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations, err := schema.Validate([]byte(tt.document))
+			require.NoError(t, err)
+			assert.Equal(t, tt.violations, violations)
+		})
+	}
+}
+
+func TestLoad_UnsupportedConstruct(t *testing.T) {
+	tests := []struct {
+		name      string
+		construct string
+		want      string
+	}{
+		{name: "any", construct: `<xs:complexType name="t"><xs:sequence><xs:any/></xs:sequence></xs:complexType>`, want: "xs:any"},
+		{name: "any attribute", construct: `<xs:complexType name="t"><xs:anyAttribute/></xs:complexType>`, want: "xs:anyAttribute"},
+		{name: "list", construct: `<xs:simpleType name="s"><xs:list itemType="xs:string"/></xs:simpleType>`, want: "xs:list"},
+		{name: "substitution group", construct: `<xs:element name="B" substitutionGroup="A"/>`, want: "xs:element/@substitutionGroup"},
+		{name: "identity constraint", construct: `<xs:element name="B"><xs:unique name="u"/></xs:element>`, want: "xs:unique"},
+		{name: "mixed content", construct: `<xs:complexType name="t" mixed="true"/>`, want: "xs:complexType/@mixed"},
+		{name: "global attribute", construct: `<xs:attribute name="a"/>`, want: "xs:attribute"},
+		{name: "unknown facet", construct: `<xs:simpleType name="s"><xs:restriction base="xs:int"><xs:minExclusive value="0"/></xs:restriction></xs:simpleType>`, want: "xs:minExclusive"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := xsd.Load(fstest.MapFS{
+				"main.xsd": {Data: []byte(`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+					<xs:element name="A" type="xs:string"/>` + tt.construct + `</xs:schema>`)},
+			}, "main.xsd")
+			assert.ErrorContains(t, err, "unsupported construct "+tt.want)
+		})
+	}
+}
@@ -0,0 +1,356 @@
+// Package search реализует полнотекстовый и фасетный поиск паспортов
+// по индексу в памяти процесса
+package search
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// Веса полей в оценке релевантности; точное совпадение слова весит вдвое
+// больше совпадения начала слова
+const (
+	weightNumber   = 5 // Кадастровый и инвентарный номер
+	weightOwner    = 3 // Правообладатели
+	weightAddress  = 2 // Адрес
+	weightBuilding = 1 // Наименования зданий
+)
+
+// document сведения паспорта, нужные для поиска, фильтров и сортировки
+type document struct {
+	id           string
+	objectType   entity.ObjectType
+	organization string
+	status       entity.PassportStatus
+	year         int
+	area         float64
+	address      string
+	created      time.Time
+	updated      time.Time
+
+	// passport копия паспорта на момент индексации для проверки условий отбора
+	passport *entity.TechnicalPassport
+
+	// tokens слова паспорта с наибольшим весом поля, где они встретились
+	tokens map[string]int
+}
+
+// Index инвертированный индекс паспортов. Безопасен для конкурентного
+// использования.
+type Index struct {
+	mu       sync.RWMutex
+	docs     map[string]*document
+	postings map[string]map[string]int // слово -> ID паспорта -> вес
+
+	// terms отсортированные слова для поиска по началу слова; nil - устарели
+	terms []string
+}
+
+// NewIndex создает пустой индекс
+func NewIndex() *Index {
+	return &Index{
+		docs:     map[string]*document{},
+		postings: map[string]map[string]int{},
+	}
+}
+
+// Add добавляет паспорт в индекс или заменяет ранее добавленный
+func (x *Index) Add(passport *entity.TechnicalPassport) {
+	snapshot := *passport
+	doc := &document{
+		id:           passport.ID,
+		objectType:   passport.ObjectType,
+		organization: passport.OrganizationName,
+		status:       passport.Status,
+		year:         passport.GeneralInfo.ConstructionYear,
+		area:         passport.GeneralInfo.TotalArea,
+		address:      passport.Address.FullAddress(),
+		created:      passport.CreatedDate,
+		updated:      passport.UpdatedDate,
+		passport:     &snapshot,
+		tokens:       map[string]int{},
+	}
+	doc.addText(weightNumber, passport.CadastralNumber, passport.InventoryNumber)
+	doc.addText(weightAddress, doc.address)
+	for _, owner := range passport.Owners {
+		doc.addText(weightOwner, owner.FullName, owner.CompanyName)
+	}
+	for _, building := range passport.Buildings {
+		doc.addText(weightBuilding, building.Name)
+	}
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	x.remove(passport.ID)
+	x.docs[doc.id] = doc
+	for token, weight := range doc.tokens {
+		ids, ok := x.postings[token]
+		if !ok {
+			ids = map[string]int{}
+			x.postings[token] = ids
+			x.terms = nil
+		}
+		ids[doc.id] = weight
+	}
+}
+
+// Remove удаляет паспорт из индекса
+func (x *Index) Remove(id string) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	x.remove(id)
+}
+
+// Len возвращает число паспортов в индексе
+func (x *Index) Len() int {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	return len(x.docs)
+}
+
+// remove удаляет паспорт; вызывается под блокировкой
+func (x *Index) remove(id string) {
+	doc, ok := x.docs[id]
+	if !ok {
+		return
+	}
+	for token := range doc.tokens {
+		delete(x.postings[token], id)
+		if len(x.postings[token]) == 0 {
+			delete(x.postings, token)
+			x.terms = nil
+		}
+	}
+	delete(x.docs, id)
+}
+
+// addText добавляет слова текстов с весом поля
+func (d *document) addText(weight int, texts ...string) {
+	for _, text := range texts {
+		for _, token := range tokenize(text) {
+			if d.tokens[token] < weight {
+				d.tokens[token] = weight
+			}
+		}
+	}
+}
+
+// tokenize разбивает текст на слова в нижнем регистре. Двоеточие, дефис
+// и косая черта внутри слова сохраняются: кадастровый номер 77:01:0001001:123
+// и инвентарный номер 12-345/6 остаются одним словом.
+func tokenize(text string) []string {
+	text = strings.ReplaceAll(strings.ToLower(text), "ё", "е")
+	fields := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != ':' && r != '-' && r != '/'
+	})
+
+	tokens := fields[:0]
+	for _, f := range fields {
+		if f = strings.Trim(f, ":-/"); f != "" {
+			tokens = append(tokens, f)
+		}
+	}
+	return tokens
+}
+
+// Search выполняет запрос и возвращает ID паспортов страницы, общее число
+// найденных паспортов и фасеты
+func (x *Index) Search(query repository.PassportQuery) ([]string, int, repository.SearchFacets) {
+	x.mu.Lock()
+	if x.terms == nil {
+		x.terms = make([]string, 0, len(x.postings))
+		for token := range x.postings {
+			x.terms = append(x.terms, token)
+		}
+		sort.Strings(x.terms)
+	}
+	x.mu.Unlock()
+
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	scores := x.match(query.Text)
+
+	// Каждый фасет считается без собственного фильтра
+	where := query.Specification("")
+	byFacet := map[repository.Facet]repository.PassportSpecification{}
+	for _, facet := range []repository.Facet{
+		repository.FacetObjectType, repository.FacetOrganization, repository.FacetStatus,
+		repository.FacetYear, repository.FacetArea,
+	} {
+		byFacet[facet] = query.Specification(facet)
+	}
+
+	var found []*document
+	facets := repository.SearchFacets{}
+	objectTypes, organizations, statuses := map[string]int{}, map[string]int{}, map[string]int{}
+	for id, doc := range x.docs {
+		if scores != nil {
+			if _, ok := scores[id]; !ok {
+				continue
+			}
+		}
+
+		p := doc.passport
+		if byFacet[repository.FacetObjectType].IsSatisfiedBy(p) {
+			objectTypes[string(doc.objectType)]++
+		}
+		if byFacet[repository.FacetOrganization].IsSatisfiedBy(p) {
+			organizations[doc.organization]++
+		}
+		if byFacet[repository.FacetStatus].IsSatisfiedBy(p) {
+			statuses[string(doc.status)]++
+		}
+		if doc.year > 0 && byFacet[repository.FacetYear].IsSatisfiedBy(p) {
+			extend(&facets.Years, float64(doc.year))
+		}
+		if doc.area > 0 && byFacet[repository.FacetArea].IsSatisfiedBy(p) {
+			extend(&facets.Areas, doc.area)
+		}
+
+		if where.IsSatisfiedBy(p) {
+			found = append(found, doc)
+		}
+	}
+	facets.ObjectTypes = facetCounts(objectTypes)
+	facets.Organizations = facetCounts(organizations)
+	facets.Statuses = facetCounts(statuses)
+
+	sortDocuments(found, scores, query)
+
+	total := len(found)
+	start := query.Offset
+	if start > total {
+		start = total
+	}
+	end := total
+	if query.Limit > 0 && start+query.Limit < end {
+		end = start + query.Limit
+	}
+
+	ids := make([]string, 0, end-start)
+	for _, doc := range found[start:end] {
+		ids = append(ids, doc.id)
+	}
+	return ids, total, facets
+}
+
+// match возвращает оценки паспортов, в которых каждое слово запроса совпало
+// с началом слова паспорта; nil - запрос пустой
+func (x *Index) match(text string) map[string]int {
+	words := tokenize(text)
+	if len(words) == 0 {
+		return nil
+	}
+
+	var scores map[string]int
+	for _, word := range words {
+		// Лучший вес слова запроса в каждом паспорте
+		best := map[string]int{}
+		for i := sort.SearchStrings(x.terms, word); i < len(x.terms) && strings.HasPrefix(x.terms[i], word); i++ {
+			factor := 1
+			if x.terms[i] == word {
+				factor = 2
+			}
+			for id, weight := range x.postings[x.terms[i]] {
+				if weight*factor > best[id] {
+					best[id] = weight * factor
+				}
+			}
+		}
+
+		if scores == nil {
+			scores = best
+			continue
+		}
+		for id := range scores {
+			if w, ok := best[id]; ok {
+				scores[id] += w
+			} else {
+				delete(scores, id)
+			}
+		}
+	}
+	return scores
+}
+
+// extend расширяет границы числового фасета значением
+func extend(r *repository.RangeFacet, value float64) {
+	if r.Min == 0 || value < r.Min {
+		r.Min = value
+	}
+	if value > r.Max {
+		r.Max = value
+	}
+}
+
+// facetCounts значения фасета по убыванию числа паспортов, затем по значению
+func facetCounts(counts map[string]int) []repository.FacetCount {
+	result := make([]repository.FacetCount, 0, len(counts))
+	for value, count := range counts {
+		result = append(result, repository.FacetCount{Value: value, Count: count})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].Value < result[j].Value
+	})
+	return result
+}
+
+// sortDocuments упорядочивает найденные паспорта; равные значения - по ID
+func sortDocuments(docs []*document, scores map[string]int, query repository.PassportQuery) {
+	field := query.Sort
+	if field == "" {
+		field = repository.SortID
+		if scores != nil {
+			field = repository.SortRelevance
+		}
+	}
+
+	sort.Slice(docs, func(i, j int) bool {
+		a, b := docs[i], docs[j]
+		if field == repository.SortRelevance {
+			if scores[a.id] != scores[b.id] {
+				return scores[a.id] > scores[b.id]
+			}
+			return a.id < b.id
+		}
+
+		var cmp int
+		switch field {
+		case repository.SortAddress:
+			cmp = strings.Compare(a.address, b.address)
+		case repository.SortCreated:
+			cmp = a.created.Compare(b.created)
+		case repository.SortUpdated:
+			cmp = a.updated.Compare(b.updated)
+		case repository.SortYear:
+			cmp = a.year - b.year
+		case repository.SortArea:
+			switch {
+			case a.area < b.area:
+				cmp = -1
+			case a.area > b.area:
+				cmp = 1
+			}
+		}
+		if cmp == 0 {
+			cmp = strings.Compare(a.id, b.id)
+		}
+		if query.Descending {
+			return cmp > 0
+		}
+		return cmp < 0
+	})
+}
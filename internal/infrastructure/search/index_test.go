@@ -0,0 +1,236 @@
+package search_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/search"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// seedPassports создает паспорта для поиска
+func seedPassports(t *testing.T, repo repository.PassportRepository) {
+	t.Helper()
+
+	seeds := []struct {
+		id, street, house, cadastral, owner, org string
+		objectType                               entity.ObjectType
+		status                                   entity.PassportStatus
+		year                                     int
+		area                                     float64
+	}{
+		{"TP-1", "ул. Ленина", "1", "77:01:0001001:101", "Иванов Иван Иванович", "ГУП БТИ", entity.ObjectTypeResidentialHouse, entity.PassportStatusDraft, 1965, 120},
+		{"TP-2", "ул. Лермонтова", "5", "77:01:0001001:102", "Петров Петр", "ГУП БТИ", entity.ObjectTypeApartment, entity.PassportStatusApproved, 1980, 54.5},
+		{"TP-3", "пр. Мира", "10", "77:02:0002002:201", "Ленинская Ольга", "Ростехинвентаризация", entity.ObjectTypeApartment, entity.PassportStatusApproved, 2005, 75},
+		{"TP-4", "ул. Пушкина", "7", "50:11:0003003:301", "Сёмин Алексей", "Ростехинвентаризация", entity.ObjectTypeNonResidential, entity.PassportStatusArchived, 0, 300},
+	}
+	created := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for i, s := range seeds {
+		p := entity.NewTechnicalPassport(s.objectType, entity.Address{Subject: "г. Москва", Street: s.street, House: s.house})
+		p.ID = s.id
+		p.CreatedDate = created.AddDate(0, 0, i)
+		p.CadastralNumber = s.cadastral
+		p.OrganizationName = s.org
+		p.Status = s.status
+		p.GeneralInfo.ConstructionYear = s.year
+		p.GeneralInfo.TotalArea = s.area
+		p.Owners = []entity.Owner{{PersonType: entity.PersonTypeIndividual, FullName: s.owner}}
+		require.NoError(t, repo.Create(context.Background(), p))
+	}
+}
+
+func resultIDs(result *repository.SearchResult) []string {
+	ids := make([]string, 0, len(result.Passports))
+	for _, p := range result.Passports {
+		ids = append(ids, p.ID)
+	}
+	return ids
+}
+
+func TestIndexedRepository_Search(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     repository.PassportQuery
+		wantIDs   []string
+		wantTotal int
+	}{
+		{
+			name:      "empty query returns all passports by id",
+			query:     repository.PassportQuery{},
+			wantIDs:   []string{"TP-1", "TP-2", "TP-3", "TP-4"},
+			wantTotal: 4,
+		},
+		{
+			name:      "prefix matches address and owner, owner ranks higher",
+			query:     repository.PassportQuery{Text: "ленин"},
+			wantIDs:   []string{"TP-3", "TP-1"},
+			wantTotal: 2,
+		},
+		{
+			name:      "every word must match",
+			query:     repository.PassportQuery{Text: "ленин иванов"},
+			wantIDs:   []string{"TP-1"},
+			wantTotal: 1,
+		},
+		{
+			name:      "cadastral number prefix",
+			query:     repository.PassportQuery{Text: "77:01"},
+			wantIDs:   []string{"TP-1", "TP-2"},
+			wantTotal: 2,
+		},
+		{
+			name:      "letter yo is folded",
+			query:     repository.PassportQuery{Text: "СЁМИН"},
+			wantIDs:   []string{"TP-4"},
+			wantTotal: 1,
+		},
+		{
+			name:      "facets are combined with and",
+			query:     repository.PassportQuery{ObjectTypes: []entity.ObjectType{entity.ObjectTypeApartment}, Organizations: []string{"ГУП БТИ"}},
+			wantIDs:   []string{"TP-2"},
+			wantTotal: 1,
+		},
+		{
+			name:      "year and area ranges",
+			query:     repository.PassportQuery{YearFrom: 1970, AreaTo: 100},
+			wantIDs:   []string{"TP-2", "TP-3"},
+			wantTotal: 2,
+		},
+		{
+			name:      "arbitrary specification narrows the facets",
+			query:     repository.PassportQuery{Where: repository.Not(repository.ByStatus(entity.PassportStatusArchived)), AreaFrom: 60},
+			wantIDs:   []string{"TP-1", "TP-3"},
+			wantTotal: 2,
+		},
+		{
+			name:      "sort by creation date descending",
+			query:     repository.PassportQuery{Sort: repository.SortCreated, Descending: true, Limit: 1},
+			wantIDs:   []string{"TP-4"},
+			wantTotal: 4,
+		},
+		{
+			name:      "sort by area descending with paging",
+			query:     repository.PassportQuery{Sort: repository.SortArea, Descending: true, Offset: 1, Limit: 2},
+			wantIDs:   []string{"TP-1", "TP-3"},
+			wantTotal: 4,
+		},
+		{
+			name:      "offset past the end",
+			query:     repository.PassportQuery{Offset: 10},
+			wantIDs:   []string{},
+			wantTotal: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			repo := search.NewIndexedRepository(memory.NewInMemoryPassportRepository())
+			seedPassports(t, repo)
+
+			// Act
+			result, err := repo.Search(context.Background(), tt.query)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantIDs, resultIDs(result))
+			assert.Equal(t, tt.wantTotal, result.Total)
+		})
+	}
+}
+
+func TestIndexedRepository_Facets(t *testing.T) {
+	repo := search.NewIndexedRepository(memory.NewInMemoryPassportRepository())
+	seedPassports(t, repo)
+
+	result, err := repo.Search(context.Background(), repository.PassportQuery{
+		ObjectTypes: []entity.ObjectType{entity.ObjectTypeApartment},
+	})
+	require.NoError(t, err)
+
+	// Фасет типа объекта считается без собственного фильтра
+	assert.Equal(t, []repository.FacetCount{
+		{Value: "apartment", Count: 2},
+		{Value: "non_residential", Count: 1},
+		{Value: "residential_house", Count: 1},
+	}, result.Facets.ObjectTypes)
+	assert.Equal(t, []repository.FacetCount{
+		{Value: "ГУП БТИ", Count: 1},
+		{Value: "Ростехинвентаризация", Count: 1},
+	}, result.Facets.Organizations)
+	assert.Equal(t, repository.RangeFacet{Min: 1980, Max: 2005}, result.Facets.Years)
+	assert.Equal(t, repository.RangeFacet{Min: 54.5, Max: 75}, result.Facets.Areas)
+}
+
+func TestIndexedRepository_KeepsIndexInSync(t *testing.T) {
+	ctx := context.Background()
+	inner := memory.NewInMemoryPassportRepository()
+
+	// Паспорта, сохраненные до обертки, попадают в индекс при первом поиске
+	seedPassports(t, inner)
+	repo := search.NewIndexedRepository(inner)
+
+	result, err := repo.Search(ctx, repository.PassportQuery{Text: "петров"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"TP-2"}, resultIDs(result))
+
+	passport, err := repo.GetByID(ctx, "TP-2")
+	require.NoError(t, err)
+	passport.Owners[0].FullName = "Смирнов Петр"
+	require.NoError(t, repo.Update(ctx, passport))
+
+	result, err = repo.Search(ctx, repository.PassportQuery{Text: "петров"})
+	require.NoError(t, err)
+	assert.Empty(t, result.Passports)
+
+	result, err = repo.Search(ctx, repository.PassportQuery{Text: "смирнов"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"TP-2"}, resultIDs(result))
+
+	require.NoError(t, repo.Delete(ctx, "TP-2"))
+	result, err = repo.Search(ctx, repository.PassportQuery{Text: "смирнов"})
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Total)
+}
+
+// flakyListRepository хранилище, первое чтение списка которого не удается
+type flakyListRepository struct {
+	repository.PassportRepository
+	failures int
+}
+
+func (r *flakyListRepository) List(ctx context.Context) ([]*entity.TechnicalPassport, error) {
+	if r.failures > 0 {
+		r.failures--
+		return nil, errors.New("unreadable file")
+	}
+	return r.PassportRepository.List(ctx)
+}
+
+func TestIndexedRepository_RecoversFromStoreErrors(t *testing.T) {
+	ctx := context.Background()
+	inner := memory.NewInMemoryPassportRepository()
+	seedPassports(t, inner)
+	repo := search.NewIndexedRepository(&flakyListRepository{PassportRepository: inner, failures: 1})
+
+	// Ошибка построения индекса не сохраняется: следующий поиск строит его заново
+	_, err := repo.Search(ctx, repository.PassportQuery{Text: "петров"})
+	require.Error(t, err)
+
+	result, err := repo.Search(ctx, repository.PassportQuery{Text: "петров"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"TP-2"}, resultIDs(result))
+
+	// Паспорт, удаленный в обход индекса, пропускается
+	require.NoError(t, inner.Delete(ctx, "TP-2"))
+	result, err = repo.Search(ctx, repository.PassportQuery{ObjectTypes: []entity.ObjectType{entity.ObjectTypeApartment}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"TP-3"}, resultIDs(result))
+	assert.Equal(t, 1, result.Total)
+}
@@ -0,0 +1,112 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// IndexedRepository хранилище паспортов с поисковым индексом. Индекс
+// строится из List при первом поиске и далее обновляется при Create,
+// Update и Delete через это хранилище.
+type IndexedRepository struct {
+	repository.PassportRepository
+
+	mu     sync.Mutex
+	loaded bool
+	index  *Index
+}
+
+// NewIndexedRepository оборачивает хранилище паспортов
+func NewIndexedRepository(repo repository.PassportRepository) *IndexedRepository {
+	return &IndexedRepository{
+		PassportRepository: repo,
+		index:              NewIndex(),
+	}
+}
+
+// Create сохраняет паспорт и добавляет его в индекс
+func (r *IndexedRepository) Create(ctx context.Context, passport *entity.TechnicalPassport) error {
+	if err := r.PassportRepository.Create(ctx, passport); err != nil {
+		return err
+	}
+
+	r.index.Add(passport)
+	return nil
+}
+
+// Update сохраняет изменения паспорта и переиндексирует его
+func (r *IndexedRepository) Update(ctx context.Context, passport *entity.TechnicalPassport) error {
+	if err := r.PassportRepository.Update(ctx, passport); err != nil {
+		return err
+	}
+
+	r.index.Add(passport)
+	return nil
+}
+
+// Delete удаляет паспорт из хранилища и индекса
+func (r *IndexedRepository) Delete(ctx context.Context, id string) error {
+	if err := r.PassportRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	r.index.Remove(id)
+	return nil
+}
+
+// Search ищет паспорта по индексу и загружает паспорта страницы из хранилища
+func (r *IndexedRepository) Search(ctx context.Context, query repository.PassportQuery) (*repository.SearchResult, error) {
+	if err := r.load(ctx); err != nil {
+		return nil, err
+	}
+
+	ids, total, facets := r.index.Search(query)
+
+	result := &repository.SearchResult{
+		Passports: make([]*entity.TechnicalPassport, 0, len(ids)),
+		Total:     total,
+		Facets:    facets,
+	}
+	for _, id := range ids {
+		passport, err := r.PassportRepository.GetByID(ctx, id)
+		if errors.Is(err, repository.ErrNotFound) {
+			// Паспорт удален в обход обертки (другим процессом): убираем из индекса
+			r.index.Remove(id)
+			result.Total--
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to load passport %s: %w", id, err)
+		}
+		result.Passports = append(result.Passports, passport)
+	}
+
+	return result, nil
+}
+
+// load строит индекс из всех паспортов хранилища при первом успешном
+// чтении; после ошибки следующий поиск строит индекс заново. Паспорта,
+// сохраненные до этого через обертку, индексируются повторно без вреда
+func (r *IndexedRepository) load(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.loaded {
+		return nil
+	}
+
+	passports, err := r.PassportRepository.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to build search index: %w", err)
+	}
+	for _, passport := range passports {
+		r.index.Add(passport)
+	}
+	r.loaded = true
+	return nil
+}
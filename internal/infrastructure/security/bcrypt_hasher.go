@@ -0,0 +1,34 @@
+package security
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// BcryptHasher реализация PasswordHasher на основе bcrypt
+type BcryptHasher struct {
+	cost int
+}
+
+// NewBcryptHasher создает hasher со стоимостью bcrypt по умолчанию
+func NewBcryptHasher() *BcryptHasher {
+	return &BcryptHasher{
+		cost: bcrypt.DefaultCost,
+	}
+}
+
+// Hash возвращает bcrypt-хеш пароля
+func (h *BcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	return string(hash), nil
+}
+
+// Compare проверяет соответствие пароля bcrypt-хешу
+func (h *BcryptHasher) Compare(hash, password string) error {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+}
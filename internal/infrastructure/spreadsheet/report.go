@@ -0,0 +1,162 @@
+package spreadsheet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// Листы книги отчета по реестру
+const (
+	SheetReportObjectTypes   = "Типы объектов"
+	SheetReportDistricts     = "Районы"
+	SheetReportWallMaterials = "Материалы стен"
+)
+
+// reportColumns заголовки разбивок паспортов; первый столбец - группа
+var reportColumns = []column{
+	{"", 34},
+	{"Составлено паспортов", 14},
+	{"Выдано паспортов", 14},
+	{"Общая площадь, кв.м", 16},
+	{"Инвентаризационная стоимость, руб", 20},
+	{"Средний год постройки", 14},
+}
+
+// wallMaterialColumns заголовки разбивки зданий по материалу стен
+var wallMaterialColumns = []column{
+	{"Материал стен", 34},
+	{"Зданий", 14},
+	{"Общая площадь, кв.м", 16},
+	{"Инвентаризационная стоимость, руб", 20},
+	{"Средний год ввода в эксплуатацию", 14},
+}
+
+// ExportReport формирует книгу отчета по реестру: по листу на разбивку
+// с итоговой строкой
+func (c *Codec) ExportReport(ctx context.Context, report *service.RegistryReport) ([]byte, error) {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if err := f.SetSheetName(f.GetSheetName(0), SheetReportObjectTypes); err != nil {
+		return nil, fmt.Errorf("failed to create sheet: %w", err)
+	}
+	for _, name := range []string{SheetReportDistricts, SheetReportWallMaterials} {
+		if _, err := f.NewSheet(name); err != nil {
+			return nil, fmt.Errorf("failed to create sheet: %w", err)
+		}
+	}
+
+	st, err := newStyles(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create styles: %w", err)
+	}
+
+	w := &writer{f: f, st: st}
+	w.reportRows(SheetReportObjectTypes, "Тип объекта", report, report.ByObjectType)
+	w.reportRows(SheetReportDistricts, "Район", report, report.ByDistrict)
+	w.wallMaterials(report)
+	if w.err != nil {
+		return nil, fmt.Errorf("failed to write report: %w", w.err)
+	}
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode workbook: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// reportHead выводит наименование отчета, период и заголовок таблицы
+func (w *writer) reportHead(sheet, title string, report *service.RegistryReport, columns []column) {
+	w.set(sheet, 1, 1, title, w.st.title)
+	w.set(sheet, 1, 2, report.PeriodTitle(), w.st.title)
+	for row := 1; row <= 2 && w.err == nil; row++ {
+		w.err = w.f.MergeCell(sheet, cell(1, row), cell(len(columns), row))
+	}
+
+	for i, c := range columns {
+		w.set(sheet, i+1, headerRow, c.title, w.st.header)
+		if w.err == nil {
+			name, _ := excelize.ColumnNumberToName(i + 1)
+			w.err = w.f.SetColWidth(sheet, name, name, c.width)
+		}
+	}
+	if w.err == nil {
+		w.err = w.f.SetRowHeight(sheet, headerRow, 45)
+	}
+}
+
+// reportRows выводит разбивку паспортов; итоговая строка - показатели
+// всего отчета (средний год не складывается из строк)
+func (w *writer) reportRows(sheet, group string, report *service.RegistryReport, rows []service.ReportRow) {
+	columns := append([]column{{group, reportColumns[0].width}}, reportColumns[1:]...)
+	w.reportHead(sheet, "Отчет по реестру технических паспортов: "+group, report, columns)
+
+	row := headerRow + 1
+	for _, r := range rows {
+		w.set(sheet, 1, row, r.Title, w.st.text)
+		w.set(sheet, 2, row, r.Count, w.st.text)
+		w.set(sheet, 3, row, r.Issued, w.st.text)
+		w.set(sheet, 4, row, r.TotalArea, w.st.area)
+		w.set(sheet, 5, row, r.InventoryValue, w.st.money)
+		w.set(sheet, 6, row, reportYear(r.AverageYear), w.st.area)
+		row++
+	}
+
+	total := report.Total
+	w.set(sheet, 1, row, "Всего", w.st.total)
+	w.reportSum(sheet, 2, row, float64(total.Count), w.st.total)
+	w.reportSum(sheet, 3, row, float64(total.Issued), w.st.total)
+	w.reportSum(sheet, 4, row, total.TotalArea, w.st.totArea)
+	w.reportSum(sheet, 5, row, total.InventoryValue, w.st.totArea)
+	w.set(sheet, 6, row, reportYear(total.AverageYear), w.st.totArea)
+}
+
+// wallMaterials выводит разбивку зданий по материалу стен
+func (w *writer) wallMaterials(report *service.RegistryReport) {
+	sheet := SheetReportWallMaterials
+	w.reportHead(sheet, "Отчет по реестру технических паспортов: материалы стен зданий", report, wallMaterialColumns)
+
+	row := headerRow + 1
+	totals := make([]float64, len(wallMaterialColumns)+1)
+	for _, r := range report.ByWallMaterial {
+		w.set(sheet, 1, row, r.Title, w.st.text)
+		w.set(sheet, 2, row, r.Count, w.st.text)
+		w.set(sheet, 3, row, r.TotalArea, w.st.area)
+		w.set(sheet, 4, row, r.InventoryValue, w.st.money)
+		w.set(sheet, 5, row, reportYear(r.AverageYear), w.st.area)
+
+		totals[2] += float64(r.Count)
+		totals[3] += r.TotalArea
+		totals[4] += r.InventoryValue
+		row++
+	}
+
+	w.set(sheet, 1, row, "Всего", w.st.total)
+	w.reportSum(sheet, 2, row, totals[2], w.st.total)
+	w.reportSum(sheet, 3, row, totals[3], w.st.totArea)
+	w.reportSum(sheet, 4, row, totals[4], w.st.totArea)
+	w.set(sheet, 5, row, "", w.st.total)
+}
+
+// reportSum записывает сумму столбца над итоговой строкой
+func (w *writer) reportSum(sheet string, col, row int, value float64, style int) {
+	if row == headerRow+1 {
+		w.set(sheet, col, row, value, style)
+		return
+	}
+	name, _ := excelize.ColumnNumberToName(col)
+	w.sum(sheet, col, row, fmt.Sprintf("SUM(%s%d:%s%d)", name, headerRow+1, name, row-1), value, style)
+}
+
+// reportYear средний год для ячейки; без сведений - пустая ячейка
+func reportYear(year float64) interface{} {
+	if year == 0 {
+		return ""
+	}
+	return year
+}
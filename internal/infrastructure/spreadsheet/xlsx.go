@@ -0,0 +1,443 @@
+// Package spreadsheet реализует обмен таблицами технического паспорта
+// с книгами Excel (XLSX)
+package spreadsheet
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+const (
+	// SheetExplication имя листа экспликации помещений
+	SheetExplication = "Экспликация"
+
+	// SheetBuildings имя листа состава объекта
+	SheetBuildings = "Состав объекта"
+
+	// headerRow строка заголовка таблиц на обоих листах
+	headerRow = 4
+)
+
+// explicationColumns заголовки экспликации по форме приказа № 244
+var explicationColumns = []column{
+	{"Литера", 8},
+	{"Этаж", 8},
+	{"№ помещения", 11},
+	{"Назначение частей помещения", 30},
+	{"Общая площадь, кв.м", 12},
+	{"Жилая (основная) площадь, кв.м", 14},
+	{"Вспомогательная площадь, кв.м", 16},
+	{"Высота, м", 9},
+	{"Самовольно переустроенная площадь, кв.м", 18},
+	{"Примечание", 24},
+}
+
+// buildingColumns заголовки состава объекта
+var buildingColumns = []column{
+	{"Литера", 8},
+	{"Наименование", 28},
+	{"Год ввода в эксплуатацию", 12},
+	{"Материал стен", 20},
+	{"Общая площадь, кв.м", 12},
+	{"Площадь застройки, кв.м", 12},
+	{"Высота, м", 9},
+	{"Объем, куб.м", 12},
+	{"Инвентаризационная стоимость, руб", 18},
+}
+
+// explicationSums столбцы экспликации, по которым подводятся итоги
+var explicationSums = []int{5, 6, 7, 9}
+
+// buildingSums столбцы состава объекта, по которым подводятся итоги
+var buildingSums = []int{5, 6, 8, 9}
+
+type column struct {
+	title string
+	width float64
+}
+
+// Codec реализация service.SpreadsheetCodec на основе excelize
+type Codec struct{}
+
+// NewCodec создает кодек XLSX
+func NewCodec() *Codec {
+	return &Codec{}
+}
+
+var _ service.SpreadsheetCodec = (*Codec)(nil)
+
+// ExportTables формирует книгу с листами экспликации и состава объекта
+func (c *Codec) ExportTables(ctx context.Context, passport *entity.TechnicalPassport) ([]byte, error) {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if err := f.SetSheetName(f.GetSheetName(0), SheetExplication); err != nil {
+		return nil, fmt.Errorf("failed to create sheet: %w", err)
+	}
+	if _, err := f.NewSheet(SheetBuildings); err != nil {
+		return nil, fmt.Errorf("failed to create sheet: %w", err)
+	}
+
+	st, err := newStyles(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create styles: %w", err)
+	}
+
+	w := &writer{f: f, st: st}
+	w.explication(passport)
+	w.buildings(passport)
+	if w.err != nil {
+		return nil, fmt.Errorf("failed to write tables: %w", w.err)
+	}
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode workbook: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// ReadSheets читает значения ячеек всех листов книги.
+// Числа возвращаются без форматирования ячейки, формулы - последним вычисленным значением.
+func (c *Codec) ReadSheets(ctx context.Context, data []byte) ([]service.SpreadsheetSheet, error) {
+	f, err := excelize.OpenReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, entity.ValidationError{Field: "data", Message: "файл не является книгой XLSX: " + err.Error()}
+	}
+	defer f.Close()
+
+	var sheets []service.SpreadsheetSheet
+	for _, name := range f.GetSheetList() {
+		rows, err := f.GetRows(name, excelize.Options{RawCellValue: true})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read sheet %s: %w", name, err)
+		}
+		sheets = append(sheets, service.SpreadsheetSheet{Name: name, Rows: rows})
+	}
+
+	return sheets, nil
+}
+
+// styles стили ячеек книги
+type styles struct {
+	title    int
+	header   int
+	text     int
+	area     int
+	height   int
+	money    int
+	subtotal int
+	subArea  int
+	total    int
+	totArea  int
+}
+
+func newStyles(f *excelize.File) (*styles, error) {
+	border := []excelize.Border{
+		{Type: "left", Color: "000000", Style: 1},
+		{Type: "top", Color: "000000", Style: 1},
+		{Type: "right", Color: "000000", Style: 1},
+		{Type: "bottom", Color: "000000", Style: 1},
+	}
+	areaFmt := "0.0"
+	heightFmt := "0.00"
+	moneyFmt := "#,##0.00"
+
+	st := &styles{}
+	defs := []struct {
+		target *int
+		style  excelize.Style
+	}{
+		{&st.title, excelize.Style{Font: &excelize.Font{Bold: true, Size: 13}, Alignment: &excelize.Alignment{Horizontal: "center"}}},
+		{&st.header, excelize.Style{Border: border, Font: &excelize.Font{Bold: true}, Alignment: &excelize.Alignment{Horizontal: "center", Vertical: "center", WrapText: true}}},
+		{&st.text, excelize.Style{Border: border, Alignment: &excelize.Alignment{WrapText: true}}},
+		{&st.area, excelize.Style{Border: border, CustomNumFmt: &areaFmt}},
+		{&st.height, excelize.Style{Border: border, CustomNumFmt: &heightFmt}},
+		{&st.money, excelize.Style{Border: border, CustomNumFmt: &moneyFmt}},
+		{&st.subtotal, excelize.Style{Border: border, Font: &excelize.Font{Italic: true}}},
+		{&st.subArea, excelize.Style{Border: border, Font: &excelize.Font{Italic: true}, CustomNumFmt: &areaFmt}},
+		{&st.total, excelize.Style{Border: border, Font: &excelize.Font{Bold: true}}},
+		{&st.totArea, excelize.Style{Border: border, Font: &excelize.Font{Bold: true}, CustomNumFmt: &areaFmt}},
+	}
+
+	for _, d := range defs {
+		id, err := f.NewStyle(&d.style)
+		if err != nil {
+			return nil, err
+		}
+		*d.target = id
+	}
+	return st, nil
+}
+
+// writer заполняет листы книги; первая ошибка сохраняется в err,
+// последующие операции пропускаются
+type writer struct {
+	f   *excelize.File
+	st  *styles
+	err error
+}
+
+// cell возвращает адрес ячейки по номерам столбца и строки с 1
+func cell(col, row int) string {
+	name, _ := excelize.CoordinatesToCellName(col, row)
+	return name
+}
+
+func (w *writer) set(sheet string, col, row int, value interface{}, style int) {
+	if w.err != nil {
+		return
+	}
+	if w.err = w.f.SetCellValue(sheet, cell(col, row), value); w.err != nil {
+		return
+	}
+	w.err = w.f.SetCellStyle(sheet, cell(col, row), cell(col, row), style)
+}
+
+// sum записывает формулу суммы с вычисленным значением для программ,
+// которые не пересчитывают формулы при открытии
+func (w *writer) sum(sheet string, col, row int, formula string, value float64, style int) {
+	w.set(sheet, col, row, value, style)
+	if w.err != nil {
+		return
+	}
+	w.err = w.f.SetCellFormula(sheet, cell(col, row), formula)
+}
+
+// head выводит наименование таблицы, адрес объекта и заголовок
+func (w *writer) head(sheet, title string, p *entity.TechnicalPassport, columns []column) {
+	if w.err != nil {
+		return
+	}
+	last := len(columns)
+
+	w.set(sheet, 1, 1, title, w.st.title)
+	w.set(sheet, 1, 2, "Адрес: "+p.Address.FullAddress(), w.st.title)
+	for row := 1; row <= 2 && w.err == nil; row++ {
+		w.err = w.f.MergeCell(sheet, cell(1, row), cell(last, row))
+	}
+
+	for i, c := range columns {
+		w.set(sheet, i+1, headerRow, c.title, w.st.header)
+		if w.err == nil {
+			name, _ := excelize.ColumnNumberToName(i + 1)
+			w.err = w.f.SetColWidth(sheet, name, name, c.width)
+		}
+	}
+	if w.err == nil {
+		w.err = w.f.SetRowHeight(sheet, headerRow, 45)
+	}
+	if w.err == nil {
+		w.err = w.f.SetPanes(sheet, &excelize.Panes{Freeze: true, YSplit: headerRow, TopLeftCell: cell(1, headerRow+1), ActivePane: "bottomLeft"})
+	}
+}
+
+// group помещения одного этажа литеры
+type group struct {
+	litera string
+	floor  string
+	rooms  []entity.Room
+}
+
+// groupRooms группирует помещения по литерам и этажам в порядке первого упоминания
+func groupRooms(rooms []entity.Room) [][]group {
+	var (
+		literas [][]group
+		index   = map[string]int{}
+	)
+
+	for _, r := range rooms {
+		li, ok := index[r.Litera]
+		if !ok {
+			li = len(literas)
+			index[r.Litera] = li
+			literas = append(literas, nil)
+		}
+
+		floors := literas[li]
+		fi := -1
+		for i := range floors {
+			if floors[i].floor == r.Floor {
+				fi = i
+				break
+			}
+		}
+		if fi < 0 {
+			fi = len(floors)
+			literas[li] = append(floors, group{litera: r.Litera, floor: r.Floor})
+		}
+		literas[li][fi].rooms = append(literas[li][fi].rooms, r)
+	}
+
+	return literas
+}
+
+// explication выводит экспликацию с итогами по этажам, литерам и общим итогом
+func (w *writer) explication(p *entity.TechnicalPassport) {
+	sheet := SheetExplication
+	w.head(sheet, "Экспликация к поэтажному плану", p, explicationColumns)
+
+	row := headerRow + 1
+	var literaTotals []int
+	totals := make([]float64, len(explicationColumns)+1)
+
+	for _, floors := range groupRooms(p.Explication) {
+		var floorTotals []int
+		literaSums := make([]float64, len(explicationColumns)+1)
+
+		for _, g := range floors {
+			first := row
+			sums := make([]float64, len(explicationColumns)+1)
+			for _, r := range g.rooms {
+				w.room(sheet, row, r)
+				for col, v := range roomAreas(r) {
+					sums[col] += v
+				}
+				row++
+			}
+
+			w.totalRow(sheet, row, "Итого по этажу "+g.floor, explicationSums, w.st.subtotal, w.st.subArea,
+				func(col string) string { return fmt.Sprintf("SUM(%s%d:%s%d)", col, first, col, row-1) }, sums)
+			floorTotals = append(floorTotals, row)
+			for col, v := range sums {
+				literaSums[col] += v
+			}
+			row++
+		}
+
+		litera := ""
+		if len(floors) > 0 {
+			litera = floors[0].litera
+		}
+		w.totalRow(sheet, row, "Итого по литере "+litera, explicationSums, w.st.subtotal, w.st.subArea,
+			func(col string) string { return "SUM(" + refs(col, floorTotals) + ")" }, literaSums)
+		literaTotals = append(literaTotals, row)
+		for col, v := range literaSums {
+			totals[col] += v
+		}
+		row++
+	}
+
+	w.totalRow(sheet, row, "Всего", explicationSums, w.st.total, w.st.totArea,
+		func(col string) string {
+			if len(literaTotals) == 0 {
+				return "0"
+			}
+			return "SUM(" + refs(col, literaTotals) + ")"
+		}, totals)
+}
+
+// room выводит строку помещения
+func (w *writer) room(sheet string, row int, r entity.Room) {
+	w.set(sheet, 1, row, r.Litera, w.st.text)
+	w.set(sheet, 2, row, r.Floor, w.st.text)
+	w.set(sheet, 3, row, r.RoomNumber, w.st.text)
+	w.set(sheet, 4, row, r.Purpose, w.st.text)
+	w.set(sheet, 5, row, r.Area, w.st.area)
+	w.set(sheet, 6, row, r.LivingArea, w.st.area)
+	w.set(sheet, 7, row, r.AuxiliaryArea, w.st.area)
+	w.set(sheet, 8, row, r.Height, w.st.height)
+	w.set(sheet, 9, row, r.UnauthorizedArea, w.st.area)
+	w.set(sheet, 10, row, r.Note, w.st.text)
+}
+
+// roomAreas площади помещения по номерам столбцов экспликации
+func roomAreas(r entity.Room) map[int]float64 {
+	return map[int]float64{5: r.Area, 6: r.LivingArea, 7: r.AuxiliaryArea, 9: r.UnauthorizedArea}
+}
+
+// totalRow выводит строку итогов: подпись в первых столбцах и формулы сумм
+func (w *writer) totalRow(sheet string, row int, label string, sumCols []int, textStyle, numStyle int, formula func(col string) string, values []float64) {
+	w.set(sheet, 1, row, label, textStyle)
+	if w.err == nil {
+		w.err = w.f.MergeCell(sheet, cell(1, row), cell(sumCols[0]-1, row))
+	}
+
+	last := len(explicationColumns)
+	if sheet == SheetBuildings {
+		last = len(buildingColumns)
+	}
+	for col := sumCols[0]; col <= last; col++ {
+		if !contains(sumCols, col) {
+			w.set(sheet, col, row, "", textStyle)
+			continue
+		}
+		name, _ := excelize.ColumnNumberToName(col)
+		w.sum(sheet, col, row, formula(name), values[col], numStyle)
+	}
+}
+
+// buildings выводит состав объекта с итоговой строкой
+func (w *writer) buildings(p *entity.TechnicalPassport) {
+	sheet := SheetBuildings
+	w.head(sheet, "Состав объекта", p, buildingColumns)
+
+	row := headerRow + 1
+	totals := make([]float64, len(buildingColumns)+1)
+	for _, b := range p.Buildings {
+		w.set(sheet, 1, row, b.Litera, w.st.text)
+		w.set(sheet, 2, row, b.Name, w.st.text)
+		w.set(sheet, 3, row, b.CommissionYear, w.st.text)
+		w.set(sheet, 4, row, b.WallMaterial, w.st.text)
+		w.set(sheet, 5, row, b.TotalArea, w.st.area)
+		w.set(sheet, 6, row, b.BuildArea, w.st.area)
+		w.set(sheet, 7, row, b.Height, w.st.height)
+		w.set(sheet, 8, row, b.Volume, w.st.area)
+		w.set(sheet, 9, row, b.InventoryValue, w.st.money)
+
+		totals[5] += b.TotalArea
+		totals[6] += b.BuildArea
+		totals[8] += b.Volume
+		totals[9] += b.InventoryValue
+		row++
+	}
+
+	// Сооружения не имеют площади и объема здания: в итог входит только стоимость
+	for _, st := range p.Structures {
+		w.set(sheet, 1, row, st.Litera, w.st.text)
+		w.set(sheet, 2, row, st.Name+" ("+strings.ToLower(st.Kind.Title())+")", w.st.text)
+		w.set(sheet, 3, row, st.CommissionYear, w.st.text)
+		w.set(sheet, 4, row, st.Material, w.st.text)
+		for col := 5; col <= 8; col++ {
+			w.set(sheet, col, row, "", w.st.text)
+		}
+		w.set(sheet, 9, row, st.InventoryValue, w.st.money)
+
+		totals[9] += st.InventoryValue
+		row++
+	}
+
+	last := row - 1
+	w.totalRow(sheet, row, "Всего", buildingSums, w.st.total, w.st.totArea,
+		func(col string) string {
+			if last < headerRow+1 {
+				return "0"
+			}
+			return fmt.Sprintf("SUM(%s%d:%s%d)", col, headerRow+1, col, last)
+		}, totals)
+}
+
+// refs перечисляет ячейки столбца через запятую
+func refs(col string, rows []int) string {
+	parts := make([]string, 0, len(rows))
+	for _, r := range rows {
+		parts = append(parts, fmt.Sprintf("%s%d", col, r))
+	}
+	return strings.Join(parts, ",")
+}
+
+func contains(values []int, v int) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
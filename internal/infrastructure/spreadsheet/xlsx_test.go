@@ -0,0 +1,119 @@
+package spreadsheet_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/spreadsheet"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPassport() *entity.TechnicalPassport {
+	p := entity.NewTechnicalPassport(entity.ObjectTypeResidentialHouse, entity.Address{Subject: "г. Москва", House: "1"})
+	p.ID = "TP-1"
+	p.Explication = []entity.Room{
+		{Litera: "А", Floor: "1", RoomNumber: "1", Purpose: "Кухня", Area: 10.5, AuxiliaryArea: 10.5, Height: 2.7},
+		{Litera: "А", Floor: "1", RoomNumber: "2", Purpose: "Жилая комната", Area: 20.25, LivingArea: 20.25, Height: 2.7},
+		{Litera: "А", Floor: "2", RoomNumber: "3", Purpose: "Жилая комната", Area: 15, LivingArea: 15, Height: 2.5},
+		{Litera: "Б", Floor: "1", RoomNumber: "1", Purpose: "Гараж", Area: 18, AuxiliaryArea: 18, Note: "пристройка"},
+	}
+	p.Buildings = []entity.Building{
+		{Litera: "А", Name: "Жилой дом", CommissionYear: 2010, WallMaterial: "Кирпич", TotalArea: 45.75, Volume: 300},
+		{Litera: "Б", Name: "Гараж", CommissionYear: 2015, TotalArea: 18, InventoryValue: 150000},
+	}
+	return p
+}
+
+func TestCodec_ExportTablesSubtotals(t *testing.T) {
+	data, err := spreadsheet.NewCodec().ExportTables(context.Background(), newPassport())
+	require.NoError(t, err)
+
+	f, err := excelize.OpenReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	defer f.Close()
+
+	assert.Equal(t, []string{spreadsheet.SheetExplication, spreadsheet.SheetBuildings}, f.GetSheetList())
+
+	// Строки: 5-6 этаж 1, 7 итог этажа, 8 этаж 2, 9 итог этажа, 10 итог литеры А,
+	// 11 литера Б, 12 итог этажа, 13 итог литеры Б, 14 всего
+	tests := []struct {
+		cell    string
+		label   string
+		formula string
+		value   string
+	}{
+		{"E7", "Итого по этажу 1", "SUM(E5:E6)", "30.75"},
+		{"E9", "Итого по этажу 2", "SUM(E8:E8)", "15"},
+		{"E10", "Итого по литере А", "SUM(E7,E9)", "45.75"},
+		{"E13", "Итого по литере Б", "SUM(E12)", "18"},
+		{"E14", "Всего", "SUM(E10,E13)", "63.75"},
+		{"F14", "Всего", "SUM(F10,F13)", "35.25"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cell, func(t *testing.T) {
+			label, err := f.GetCellValue(spreadsheet.SheetExplication, "A"+tt.cell[1:])
+			require.NoError(t, err)
+			assert.Equal(t, tt.label, label)
+
+			formula, err := f.GetCellFormula(spreadsheet.SheetExplication, tt.cell)
+			require.NoError(t, err)
+			assert.Equal(t, tt.formula, formula)
+
+			value, err := f.GetCellValue(spreadsheet.SheetExplication, tt.cell, excelize.Options{RawCellValue: true})
+			require.NoError(t, err)
+			assert.Equal(t, tt.value, value)
+		})
+	}
+
+	formula, err := f.GetCellFormula(spreadsheet.SheetBuildings, "E7")
+	require.NoError(t, err)
+	assert.Equal(t, "SUM(E5:E6)", formula)
+}
+
+func TestCodec_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	codec := spreadsheet.NewCodec()
+	source := newPassport()
+
+	data, err := codec.ExportTables(ctx, source)
+	require.NoError(t, err)
+
+	sheets, err := codec.ReadSheets(ctx, data)
+	require.NoError(t, err)
+	require.Len(t, sheets, 2)
+
+	rooms := sheets[0].Rows
+	header := passport.DetectHeaderRow(passport.TableExplication, rooms)
+	require.Equal(t, 3, header)
+	mapping := passport.SuggestMapping(passport.TableExplication, rooms[header])
+	assert.Len(t, mapping, len(passport.TableFields(passport.TableExplication)))
+
+	parsed, err := passport.ParseTable(passport.TableExplication, rooms, header, mapping)
+	require.NoError(t, err)
+	assert.Empty(t, parsed.Errors)
+	assert.Equal(t, source.Explication, parsed.Rooms)
+
+	buildings := sheets[1].Rows
+	header = passport.DetectHeaderRow(passport.TableBuildings, buildings)
+	require.Equal(t, 3, header)
+	mapping = passport.SuggestMapping(passport.TableBuildings, buildings[header])
+	assert.Len(t, mapping, len(passport.TableFields(passport.TableBuildings)))
+
+	parsed, err = passport.ParseTable(passport.TableBuildings, buildings, header, mapping)
+	require.NoError(t, err)
+	assert.Empty(t, parsed.Errors)
+	assert.Equal(t, source.Buildings, parsed.Buildings)
+}
+
+func TestCodec_ReadSheetsRejectsGarbage(t *testing.T) {
+	_, err := spreadsheet.NewCodec().ReadSheets(context.Background(), []byte("not a workbook"))
+
+	var ve entity.ValidationError
+	assert.ErrorAs(t, err, &ve)
+}
@@ -0,0 +1,165 @@
+// Package criteria выполняет выборки repository.PassportCriteria в памяти
+// для хранилищ без собственного языка запросов
+package criteria
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// timeLayout формат дат в ключе сортировки: постоянная длина, поэтому
+// строки сравниваются в хронологическом порядке
+const timeLayout = "2006-01-02T15:04:05.000000000Z"
+
+// key значение поля сортировки паспорта
+type key struct {
+	Text   string  `json:"t,omitempty"`
+	Number float64 `json:"n,omitempty"`
+}
+
+// cursor позиция последнего паспорта страницы
+type cursor struct {
+	Sort       repository.SortField `json:"s"`
+	Descending bool                 `json:"d,omitempty"`
+	Key        key                  `json:"k"`
+	ID         string               `json:"id"`
+}
+
+// item паспорт с ключом сортировки
+type item struct {
+	passport *entity.TechnicalPassport
+	key      key
+}
+
+// Apply отбирает паспорта по условию, сортирует и возвращает страницу
+// после курсора
+func Apply(passports []*entity.TechnicalPassport, criteria repository.PassportCriteria) (*repository.PassportPage, error) {
+	if err := criteria.Validate(); err != nil {
+		return nil, err
+	}
+
+	field := criteria.Sort
+	if field == "" {
+		field = repository.SortID
+	}
+
+	var after *cursor
+	if criteria.Cursor != "" {
+		c, err := decodeCursor(criteria.Cursor)
+		if err != nil || c.Sort != field || c.Descending != criteria.Descending {
+			return nil, fmt.Errorf("cursor %q: %w", criteria.Cursor, repository.ErrInvalidCursor)
+		}
+		after = c
+	}
+
+	items := make([]item, 0, len(passports))
+	for _, p := range passports {
+		if criteria.Where == nil || criteria.Where.IsSatisfiedBy(p) {
+			items = append(items, item{passport: p, key: keyOf(p, field)})
+		}
+	}
+
+	order := func(k key, id string, other item) int {
+		result := compare(k, other.key)
+		if result == 0 {
+			result = strings.Compare(id, other.passport.ID)
+		}
+		if criteria.Descending {
+			return -result
+		}
+		return result
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return order(items[i].key, items[i].passport.ID, items[j]) < 0
+	})
+
+	// Первый паспорт строго после позиции курсора; паспорт курсора
+	// мог быть удален, поэтому позиция ищется по ключу, а не по ID
+	start := 0
+	if after != nil {
+		start = sort.Search(len(items), func(i int) bool {
+			return order(after.Key, after.ID, items[i]) < 0
+		})
+	}
+	end := len(items)
+	if criteria.Limit > 0 && start+criteria.Limit < end {
+		end = start + criteria.Limit
+	}
+
+	page := &repository.PassportPage{
+		Passports: make([]*entity.TechnicalPassport, 0, end-start),
+		Total:     len(items),
+	}
+	for _, it := range items[start:end] {
+		page.Passports = append(page.Passports, it.passport)
+	}
+	if end < len(items) {
+		last := items[end-1]
+		page.NextCursor = encodeCursor(cursor{Sort: field, Descending: criteria.Descending, Key: last.key, ID: last.passport.ID})
+	}
+
+	return page, nil
+}
+
+// keyOf значение поля сортировки; для сортировки по ID ключ пустой,
+// порядок задает ID
+func keyOf(p *entity.TechnicalPassport, field repository.SortField) key {
+	switch field {
+	case repository.SortAddress:
+		return key{Text: strings.ToLower(p.Address.FullAddress())}
+	case repository.SortCreated:
+		return key{Text: p.CreatedDate.UTC().Format(timeLayout)}
+	case repository.SortUpdated:
+		return key{Text: p.UpdatedDate.UTC().Format(timeLayout)}
+	case repository.SortYear:
+		return key{Number: float64(p.GeneralInfo.ConstructionYear)}
+	case repository.SortArea:
+		return key{Number: p.GeneralInfo.TotalArea}
+	}
+	return key{}
+}
+
+// compare сравнивает ключи сортировки
+func compare(a, b key) int {
+	if a.Text != b.Text {
+		return strings.Compare(a.Text, b.Text)
+	}
+	switch {
+	case a.Number < b.Number:
+		return -1
+	case a.Number > b.Number:
+		return 1
+	}
+	return 0
+}
+
+// encodeCursor кодирует позицию в непрозрачную строку
+func encodeCursor(c cursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor разбирает строку курсора
+func decodeCursor(s string) (*cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+	if c.ID == "" {
+		return nil, errors.New("cursor without passport ID")
+	}
+
+	return &c, nil
+}
@@ -0,0 +1,144 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// thumbnailSuffix окончание имени файла миниатюры
+const thumbnailSuffix = ".thumb.png"
+
+// FileAttachmentRepository реализация AttachmentRepository на файловой системе
+// Содержимое хранится в файлах <dir>/<первые два символа ID>/<ID>, миниатюры -
+// рядом с окончанием .thumb.png. Файлы не изменяются после записи, поэтому
+// блокировки не нужны.
+type FileAttachmentRepository struct {
+	dir string
+}
+
+// NewFileAttachmentRepository создает хранилище вложений в каталоге dir
+// Каталог создается при первой записи
+func NewFileAttachmentRepository(dir string) *FileAttachmentRepository {
+	return &FileAttachmentRepository{
+		dir: dir,
+	}
+}
+
+// Save сохраняет содержимое и возвращает его ID
+func (r *FileAttachmentRepository) Save(ctx context.Context, data []byte) (string, error) {
+	id := entity.AttachmentID(data)
+	path, err := r.pathFor(id)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := os.Stat(path); err == nil {
+		return id, nil
+	}
+
+	if err := writeFileAtomic(path, data); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// Load возвращает содержимое по ID
+func (r *FileAttachmentRepository) Load(ctx context.Context, id string) ([]byte, error) {
+	path, err := r.pathFor(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return readAttachmentFile(path, "attachment "+id)
+}
+
+// Exists проверяет наличие содержимого
+func (r *FileAttachmentRepository) Exists(ctx context.Context, id string) (bool, error) {
+	path, err := r.pathFor(id)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = os.Stat(path)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, os.ErrNotExist):
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to check attachment: %w", err)
+	}
+}
+
+// Delete удаляет содержимое и миниатюру
+func (r *FileAttachmentRepository) Delete(ctx context.Context, id string) error {
+	path, err := r.pathFor(id)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("attachment %s %w", id, repository.ErrNotFound)
+		}
+		return fmt.Errorf("failed to delete attachment: %w", err)
+	}
+
+	if err := os.Remove(path + thumbnailSuffix); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete thumbnail: %w", err)
+	}
+
+	return nil
+}
+
+// SaveThumbnail сохраняет миниатюру изображения
+func (r *FileAttachmentRepository) SaveThumbnail(ctx context.Context, id string, data []byte) error {
+	exists, err := r.Exists(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("attachment %s %w", id, repository.ErrNotFound)
+	}
+
+	path, _ := r.pathFor(id)
+	return writeFileAtomic(path+thumbnailSuffix, data)
+}
+
+// LoadThumbnail возвращает миниатюру изображения
+func (r *FileAttachmentRepository) LoadThumbnail(ctx context.Context, id string) ([]byte, error) {
+	path, err := r.pathFor(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return readAttachmentFile(path+thumbnailSuffix, "thumbnail of attachment "+id)
+}
+
+// pathFor возвращает путь к файлу содержимого; ID проверяется, чтобы не выйти
+// за пределы каталога
+func (r *FileAttachmentRepository) pathFor(id string) (string, error) {
+	if !entity.IsAttachmentID(id) {
+		return "", entity.ValidationError{Field: "attachment_id", Message: "некорректный ID вложения"}
+	}
+
+	return filepath.Join(r.dir, id[:2], id), nil
+}
+
+// readAttachmentFile читает файл хранилища
+func readAttachmentFile(path, what string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%s %w", what, repository.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", what, err)
+	}
+	return data, nil
+}
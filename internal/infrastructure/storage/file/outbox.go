@@ -0,0 +1,392 @@
+package file
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// Каталоги outbox внутри каталога паспортов
+const (
+	outboxDir      = "outbox"
+	outboxPrepared = "prepared" // Сообщения записываемого паспорта до замены его файла
+	outboxPending  = "pending"  // Сообщения, ожидающие доставки
+	outboxDead     = "dead"     // Недоставленные сообщения
+)
+
+// OutboxEnabled проверяет что в каталоге паспортов ведется outbox
+func OutboxEnabled(dir string) bool {
+	info, err := os.Stat(filepath.Join(dir, outboxDir))
+	return err == nil && info.IsDir()
+}
+
+// preparedRecord сообщения одной записи паспорта. Запись завершена, если файл
+// паспорта совпадает с Hash, а при удалении (пустой Hash) - если файла паспорта
+// нет: тогда сообщения переносятся в pending, иначе (сбой до замены или
+// удаления файла паспорта) отбрасываются.
+type preparedRecord struct {
+	PassportID string                 `json:"passport_id"`
+	Hash       string                 `json:"hash"`
+	Messages   []entity.OutboxMessage `json:"messages"`
+}
+
+// JSONOutbox реализация OutboxRepository в каталоге паспортов.
+// Файл паспорта заменяется атомарно, поэтому события сначала записываются
+// в prepared с хешем нового содержимого паспорта, затем заменяется паспорт,
+// затем сообщения переносятся в pending. Незавершенные записи после сбоя
+// разбираются перед следующей записью или чтением outbox.
+type JSONOutbox struct {
+	repo *JSONPassportRepository
+	dir  string
+}
+
+// NewJSONOutbox подключает outbox к хранилищу паспортов: с этого момента
+// события паспорта записываются вместе с ним при Create и Update.
+// Каталог outbox создается сразу: по нему OutboxEnabled определяет, что
+// другие процессы с тем же каталогом паспортов тоже должны вести outbox.
+func NewJSONOutbox(repo *JSONPassportRepository) *JSONOutbox {
+	outbox := &JSONOutbox{
+		repo: repo,
+		dir:  filepath.Join(repo.dir, outboxDir),
+	}
+	_ = os.MkdirAll(outbox.dir, 0o700)
+	repo.mu.Lock()
+	repo.outbox = outbox
+	repo.mu.Unlock()
+	return outbox
+}
+
+// Pending возвращает ожидающие сообщения в порядке возникновения событий
+func (o *JSONOutbox) Pending(ctx context.Context, limit int) ([]entity.OutboxMessage, error) {
+	o.repo.mu.Lock()
+	defer o.repo.mu.Unlock()
+
+	if err := o.recover(); err != nil {
+		return nil, err
+	}
+
+	messages, err := o.readMessages(outboxPending)
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 && len(messages) > limit {
+		messages = messages[:limit]
+	}
+	return messages, nil
+}
+
+// Save сохраняет состояние доставки ожидающего сообщения
+func (o *JSONOutbox) Save(ctx context.Context, message entity.OutboxMessage) error {
+	o.repo.mu.Lock()
+	defer o.repo.mu.Unlock()
+
+	path, err := o.pathFor(outboxPending, message.ID)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("outbox message %s %w", message.ID, repository.ErrNotFound)
+	}
+	return writeJSON(path, message)
+}
+
+// Complete удаляет доставленное сообщение
+func (o *JSONOutbox) Complete(ctx context.Context, id string) error {
+	o.repo.mu.Lock()
+	defer o.repo.mu.Unlock()
+
+	return o.remove(outboxPending, id)
+}
+
+// DeadLetter переносит сообщение в хранилище недоставленных
+func (o *JSONOutbox) DeadLetter(ctx context.Context, message entity.OutboxMessage) error {
+	o.repo.mu.Lock()
+	defer o.repo.mu.Unlock()
+
+	path, err := o.pathFor(outboxDead, message.ID)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(path, message); err != nil {
+		return err
+	}
+	return o.remove(outboxPending, message.ID)
+}
+
+// DeadLetters возвращает недоставленные сообщения
+func (o *JSONOutbox) DeadLetters(ctx context.Context) ([]entity.OutboxMessage, error) {
+	o.repo.mu.RLock()
+	defer o.repo.mu.RUnlock()
+
+	return o.readMessages(outboxDead)
+}
+
+// Requeue возвращает недоставленное сообщение в очередь: попытки сбрасываются,
+// получатели, которым сообщение доставлено, сохраняются
+func (o *JSONOutbox) Requeue(ctx context.Context, id string) error {
+	o.repo.mu.Lock()
+	defer o.repo.mu.Unlock()
+
+	path, err := o.pathFor(outboxDead, id)
+	if err != nil {
+		return err
+	}
+	message, err := readMessage(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("dead letter %s %w", id, repository.ErrNotFound)
+	}
+	if err != nil {
+		return err
+	}
+
+	message.Attempts = 0
+	message.NextAttemptAt = time.Time{}
+	message.LastError = ""
+	pending, err := o.pathFor(outboxPending, id)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(pending, message); err != nil {
+		return err
+	}
+	return o.remove(outboxDead, id)
+}
+
+// store записывает паспорт и его новые события; вызывается под блокировкой хранилища
+func (o *JSONOutbox) store(path string, passport *entity.TechnicalPassport, data []byte) error {
+	if err := o.recover(); err != nil {
+		return err
+	}
+
+	messages := o.newMessages(passport.Events())
+	if len(messages) == 0 {
+		return writeFileAtomic(path, data)
+	}
+
+	sum := sha256.Sum256(data)
+	record := preparedRecord{PassportID: passport.ID, Hash: hex.EncodeToString(sum[:]), Messages: messages}
+	prepared, err := o.pathFor(outboxPrepared, passport.ID)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(prepared, record); err != nil {
+		return err
+	}
+
+	if err := writeFileAtomic(path, data); err != nil {
+		os.Remove(prepared)
+		return err
+	}
+
+	// Паспорт уже записан: при ошибке переноса сообщения останутся в prepared
+	// и будут перенесены при разборе незавершенных записей
+	_ = o.commit(prepared, record)
+	return nil
+}
+
+// delete удаляет файл паспорта id и записывает события удаления; вызывается
+// под блокировкой хранилища
+func (o *JSONOutbox) delete(path, id string, events []entity.DomainEvent) error {
+	if err := o.recover(); err != nil {
+		return err
+	}
+
+	messages := o.newMessages(events)
+	if len(messages) == 0 {
+		return removeFile(path)
+	}
+
+	record := preparedRecord{PassportID: id, Messages: messages}
+	prepared, err := o.pathFor(outboxPrepared, id)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(prepared, record); err != nil {
+		return err
+	}
+
+	if err := removeFile(path); err != nil {
+		os.Remove(prepared)
+		return err
+	}
+
+	_ = o.commit(prepared, record)
+	return nil
+}
+
+// newMessages сообщения событий, которых еще нет в outbox
+func (o *JSONOutbox) newMessages(events []entity.DomainEvent) []entity.OutboxMessage {
+	var messages []entity.OutboxMessage
+	for _, event := range events {
+		if o.exists(event.ID) {
+			continue
+		}
+		messages = append(messages, entity.NewOutboxMessage(event))
+	}
+	return messages
+}
+
+// recover разбирает записи, прерванные сбоем
+func (o *JSONOutbox) recover() error {
+	entries, err := os.ReadDir(filepath.Join(o.dir, outboxPrepared))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read outbox: %w", err)
+	}
+
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".json" {
+			continue
+		}
+		path := filepath.Join(o.dir, outboxPrepared, e.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read outbox: %w", err)
+		}
+		var record preparedRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			return fmt.Errorf("failed to decode %s: %w", e.Name(), err)
+		}
+
+		passportPath, err := o.repo.pathFor(record.PassportID)
+		if err != nil {
+			return err
+		}
+		current, err := os.ReadFile(passportPath)
+		sum := sha256.Sum256(current)
+		completed := err == nil && hex.EncodeToString(sum[:]) == record.Hash
+		if record.Hash == "" {
+			completed = errors.Is(err, os.ErrNotExist)
+		}
+		if completed {
+			if err := o.commit(path, record); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := os.Remove(path); err != nil {
+			return fmt.Errorf("failed to discard outbox record: %w", err)
+		}
+	}
+	return nil
+}
+
+// commit переносит сообщения завершенной записи в pending
+func (o *JSONOutbox) commit(prepared string, record preparedRecord) error {
+	for _, message := range record.Messages {
+		path, err := o.pathFor(outboxPending, message.ID)
+		if err != nil {
+			return err
+		}
+		if o.exists(message.ID) {
+			continue
+		}
+		if err := writeJSON(path, message); err != nil {
+			return err
+		}
+	}
+	if err := os.Remove(prepared); err != nil {
+		return fmt.Errorf("failed to complete outbox record: %w", err)
+	}
+	return nil
+}
+
+// exists проверяет что сообщение уже записано в pending или dead
+func (o *JSONOutbox) exists(id string) bool {
+	for _, sub := range []string{outboxPending, outboxDead} {
+		if _, err := os.Stat(filepath.Join(o.dir, sub, id+".json")); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// pathFor возвращает путь к файлу outbox, не допуская выхода за пределы каталога
+func (o *JSONOutbox) pathFor(sub, id string) (string, error) {
+	if id == "" || id != filepath.Base(id) || strings.HasPrefix(id, ".") {
+		return "", entity.ValidationError{Field: "id", Message: "некорректный ID сообщения"}
+	}
+	return filepath.Join(o.dir, sub, id+".json"), nil
+}
+
+// remove удаляет сообщение из каталога
+func (o *JSONOutbox) remove(sub, id string) error {
+	path, err := o.pathFor(sub, id)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("outbox message %s %w", id, repository.ErrNotFound)
+		}
+		return fmt.Errorf("failed to remove outbox message: %w", err)
+	}
+	return nil
+}
+
+// readMessages читает сообщения каталога в порядке возникновения событий
+func (o *JSONOutbox) readMessages(sub string) ([]entity.OutboxMessage, error) {
+	entries, err := os.ReadDir(filepath.Join(o.dir, sub))
+	if errors.Is(err, os.ErrNotExist) {
+		return []entity.OutboxMessage{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read outbox: %w", err)
+	}
+
+	messages := make([]entity.OutboxMessage, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".json" {
+			continue
+		}
+		message, err := readMessage(filepath.Join(o.dir, sub, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+
+	sort.SliceStable(messages, func(i, j int) bool {
+		a, b := messages[i].Event.OccurredAt, messages[j].Event.OccurredAt
+		if !a.Equal(b) {
+			return a.Before(b)
+		}
+		return messages[i].ID < messages[j].ID
+	})
+	return messages, nil
+}
+
+// readMessage читает сообщение из файла
+func readMessage(path string) (entity.OutboxMessage, error) {
+	var message entity.OutboxMessage
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return message, err
+	}
+	if err := json.Unmarshal(data, &message); err != nil {
+		return message, fmt.Errorf("failed to decode %s: %w", filepath.Base(path), err)
+	}
+	return message, nil
+}
+
+// writeJSON атомарно записывает значение в JSON
+func writeJSON(path string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode outbox: %w", err)
+	}
+	return writeFileAtomic(path, data)
+}
@@ -0,0 +1,239 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/address"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/criteria"
+)
+
+// JSONPassportRepository реализация PassportRepository на файловой системе
+// Каждый паспорт хранится в отдельном файле <ID>.json в указанном каталоге
+type JSONPassportRepository struct {
+	mu  sync.RWMutex
+	dir string
+
+	// outbox подключенный outbox событий; nil - события не записываются
+	outbox *JSONOutbox
+}
+
+// NewJSONPassportRepository создает репозиторий паспортов в каталоге dir
+// Каталог создается при первой записи
+func NewJSONPassportRepository(dir string) *JSONPassportRepository {
+	return &JSONPassportRepository{
+		dir: dir,
+	}
+}
+
+// Create сохраняет новый паспорт
+func (r *JSONPassportRepository) Create(ctx context.Context, passport *entity.TechnicalPassport) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	path, err := r.pathFor(passport.ID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(path); err == nil {
+		return fmt.Errorf("passport with ID %s %w", passport.ID, repository.ErrAlreadyExists)
+	}
+
+	return r.write(path, passport)
+}
+
+// GetByID возвращает паспорт по ID
+func (r *JSONPassportRepository) GetByID(ctx context.Context, id string) (*entity.TechnicalPassport, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	path, err := r.pathFor(id)
+	if err != nil {
+		return nil, err
+	}
+
+	passport, err := r.read(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("passport with ID %s %w", id, repository.ErrNotFound)
+	}
+
+	return passport, err
+}
+
+// Update обновляет существующий паспорт
+func (r *JSONPassportRepository) Update(ctx context.Context, passport *entity.TechnicalPassport) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	path, err := r.pathFor(passport.ID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("passport with ID %s %w", passport.ID, repository.ErrNotFound)
+	}
+
+	return r.write(path, passport)
+}
+
+// Delete удаляет паспорт; при подключенном outbox событие passport_deleted
+// записывается в той же транзакции
+func (r *JSONPassportRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	path, err := r.pathFor(id)
+	if err != nil {
+		return err
+	}
+
+	if r.outbox == nil {
+		if err := os.Remove(path); err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("passport with ID %s %w", id, repository.ErrNotFound)
+			}
+			return fmt.Errorf("failed to delete passport: %w", err)
+		}
+		return nil
+	}
+
+	// Организация паспорта нужна событию для выбора webhook получателей
+	passport, err := r.read(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("passport with ID %s %w", id, repository.ErrNotFound)
+	}
+	if err != nil {
+		return err
+	}
+
+	return r.outbox.delete(path, id, []entity.DomainEvent{passport.DeletedEvent()})
+}
+
+// List возвращает все паспорта, упорядоченные по ID
+func (r *JSONPassportRepository) List(ctx context.Context) ([]*entity.TechnicalPassport, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.readAll()
+}
+
+// FindByAddress ищет паспорта по адресу без учета сокращений, регистра
+// и записи номера дома
+func (r *JSONPassportRepository) FindByAddress(ctx context.Context, addr entity.Address) ([]*entity.TechnicalPassport, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	passports, err := r.readAll()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.TechnicalPassport, 0)
+	for _, passport := range passports {
+		if address.Same(passport.Address, addr) {
+			result = append(result, passport)
+		}
+	}
+
+	return result, nil
+}
+
+// Find возвращает страницу паспортов по условию выборки
+func (r *JSONPassportRepository) Find(ctx context.Context, query repository.PassportCriteria) (*repository.PassportPage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	passports, err := r.readAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return criteria.Apply(passports, query)
+}
+
+// pathFor возвращает путь к файлу паспорта, не допуская выхода за пределы каталога
+func (r *JSONPassportRepository) pathFor(id string) (string, error) {
+	if id == "" || id != filepath.Base(id) || strings.HasPrefix(id, ".") {
+		return "", entity.ValidationError{Field: "id", Message: "некорректный ID паспорта"}
+	}
+
+	return filepath.Join(r.dir, id+".json"), nil
+}
+
+// read читает паспорт из файла
+func (r *JSONPassportRepository) read(path string) (*entity.TechnicalPassport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var passport entity.TechnicalPassport
+	if err := json.Unmarshal(data, &passport); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", filepath.Base(path), err)
+	}
+
+	return &passport, nil
+}
+
+// readAll читает все паспорта каталога
+func (r *JSONPassportRepository) readAll() ([]*entity.TechnicalPassport, error) {
+	entries, err := os.ReadDir(r.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return []*entity.TechnicalPassport{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read passports directory: %w", err)
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() && filepath.Ext(e.Name()) == ".json" {
+			names = append(names, e.Name())
+		}
+	}
+	sort.Strings(names)
+
+	result := make([]*entity.TechnicalPassport, 0, len(names))
+	for _, name := range names {
+		passport, err := r.read(filepath.Join(r.dir, name))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, passport)
+	}
+
+	return result, nil
+}
+
+// removeFile удаляет файл паспорта
+func removeFile(path string) error {
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("failed to delete passport: %w", err)
+	}
+	return nil
+}
+
+// write атомарно записывает паспорт в файл; при подключенном outbox
+// события паспорта записываются в той же транзакции
+func (r *JSONPassportRepository) write(path string, passport *entity.TechnicalPassport) error {
+	data, err := json.MarshalIndent(passport, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode passport: %w", err)
+	}
+
+	if r.outbox != nil {
+		return r.outbox.store(path, passport, data)
+	}
+	return writeFileAtomic(path, data)
+}
@@ -0,0 +1,26 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// DefaultDir возвращает каталог данных приложения по умолчанию
+// (каталог конфигурации пользователя, например ~/.config/GoTechPasport)
+func DefaultDir() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		dir = "."
+	}
+	return filepath.Join(dir, "GoTechPasport")
+}
+
+// DefaultUsersFile возвращает путь к файлу пользователей по умолчанию
+func DefaultUsersFile() string {
+	return filepath.Join(DefaultDir(), "users.json")
+}
+
+// DefaultPassportsDir возвращает каталог паспортов по умолчанию
+func DefaultPassportsDir() string {
+	return filepath.Join(DefaultDir(), "passports")
+}
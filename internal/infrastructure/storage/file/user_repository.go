@@ -0,0 +1,178 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// JSONUserRepository реализация UserRepository в локальном JSON файле
+// Пароли хранятся только в виде хешей (entity.User.PasswordHash)
+type JSONUserRepository struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewJSONUserRepository создает репозиторий пользователей в указанном файле
+// Файл и каталог создаются при первой записи
+func NewJSONUserRepository(path string) *JSONUserRepository {
+	return &JSONUserRepository{
+		path: path,
+	}
+}
+
+// Create сохраняет нового пользователя
+func (r *JSONUserRepository) Create(ctx context.Context, user *entity.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	users, err := r.load()
+	if err != nil {
+		return err
+	}
+
+	for _, u := range users {
+		if u.Login == user.Login {
+			return fmt.Errorf("user with login %s %w", user.Login, repository.ErrAlreadyExists)
+		}
+	}
+
+	return r.save(append(users, user))
+}
+
+// CreateFirst сохраняет пользователя, если других пользователей нет
+func (r *JSONUserRepository) CreateFirst(ctx context.Context, user *entity.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	users, err := r.load()
+	if err != nil {
+		return err
+	}
+
+	if len(users) > 0 {
+		return repository.ErrUsersExist
+	}
+
+	return r.save([]*entity.User{user})
+}
+
+// GetByLogin возвращает пользователя по логину
+func (r *JSONUserRepository) GetByLogin(ctx context.Context, login string) (*entity.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	users, err := r.load()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, u := range users {
+		if u.Login == login {
+			return u, nil
+		}
+	}
+
+	return nil, fmt.Errorf("user with login %s %w", login, repository.ErrNotFound)
+}
+
+// Update обновляет существующего пользователя
+func (r *JSONUserRepository) Update(ctx context.Context, user *entity.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	users, err := r.load()
+	if err != nil {
+		return err
+	}
+
+	for i, u := range users {
+		if u.Login == user.Login {
+			users[i] = user
+			return r.save(users)
+		}
+	}
+
+	return fmt.Errorf("user with login %s %w", user.Login, repository.ErrNotFound)
+}
+
+// Delete удаляет пользователя по ID
+func (r *JSONUserRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	users, err := r.load()
+	if err != nil {
+		return err
+	}
+
+	for i, u := range users {
+		if u.ID == id {
+			return r.save(append(users[:i], users[i+1:]...))
+		}
+	}
+
+	return fmt.Errorf("user with ID %s %w", id, repository.ErrNotFound)
+}
+
+// List возвращает всех пользователей
+func (r *JSONUserRepository) List(ctx context.Context) ([]*entity.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.load()
+}
+
+// load читает пользователей из файла (отсутствующий файл - пустой список)
+func (r *JSONUserRepository) load() ([]*entity.User, error) {
+	data, err := os.ReadFile(r.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return []*entity.User{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read users file: %w", err)
+	}
+
+	var users []*entity.User
+	if err := json.Unmarshal(data, &users); err != nil {
+		return nil, fmt.Errorf("failed to decode users file: %w", err)
+	}
+
+	return users, nil
+}
+
+// save атомарно записывает пользователей в файл
+func (r *JSONUserRepository) save(users []*entity.User) error {
+	data, err := json.MarshalIndent(users, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode users: %w", err)
+	}
+
+	return writeFileAtomic(r.path, data)
+}
+
+// writeFileAtomic записывает файл через временный файл и переименование,
+// чтобы прерванная запись не повредила данные
+func writeFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to replace file: %w", err)
+	}
+
+	return nil
+}
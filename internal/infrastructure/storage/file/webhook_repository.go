@@ -0,0 +1,219 @@
+package file
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// Файлы webhook внутри каталога паспортов
+const (
+	webhooksDir        = "webhooks"
+	webhooksFile       = "webhooks.json"    // Зарегистрированные webhook
+	webhookDeliveryLog = "deliveries.jsonl" // Журнал доставки, запись в строке
+)
+
+// WebhooksEnabled проверяет что в каталоге паспортов регистрировались webhook
+func WebhooksEnabled(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, webhooksDir, webhooksFile))
+	return err == nil
+}
+
+// JSONWebhookRepository реализация WebhookRepository в каталоге паспортов:
+// регистрации хранятся в JSON файле, журнал доставки дописывается построчно
+type JSONWebhookRepository struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// NewJSONWebhookRepository создает репозиторий webhook каталога паспортов dir
+func NewJSONWebhookRepository(dir string) *JSONWebhookRepository {
+	return &JSONWebhookRepository{
+		dir: filepath.Join(dir, webhooksDir),
+	}
+}
+
+// Create регистрирует webhook
+func (r *JSONWebhookRepository) Create(ctx context.Context, webhook *entity.Webhook) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	webhooks, err := r.load()
+	if err != nil {
+		return err
+	}
+
+	for _, w := range webhooks {
+		if w.ID == webhook.ID {
+			return fmt.Errorf("webhook with ID %s %w", webhook.ID, repository.ErrAlreadyExists)
+		}
+	}
+
+	return r.save(append(webhooks, webhook))
+}
+
+// GetByID возвращает webhook по ID
+func (r *JSONWebhookRepository) GetByID(ctx context.Context, id string) (*entity.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	webhooks, err := r.load()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, w := range webhooks {
+		if w.ID == id {
+			return w, nil
+		}
+	}
+
+	return nil, fmt.Errorf("webhook with ID %s %w", id, repository.ErrNotFound)
+}
+
+// Delete удаляет webhook
+func (r *JSONWebhookRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	webhooks, err := r.load()
+	if err != nil {
+		return err
+	}
+
+	for i, w := range webhooks {
+		if w.ID == id {
+			return r.save(append(webhooks[:i], webhooks[i+1:]...))
+		}
+	}
+
+	return fmt.Errorf("webhook with ID %s %w", id, repository.ErrNotFound)
+}
+
+// List возвращает webhook организации
+func (r *JSONWebhookRepository) List(ctx context.Context, organization string) ([]*entity.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	webhooks, err := r.load()
+	if err != nil {
+		return nil, err
+	}
+	if organization == "" {
+		return webhooks, nil
+	}
+
+	result := []*entity.Webhook{}
+	for _, w := range webhooks {
+		if w.Organization == organization {
+			result = append(result, w)
+		}
+	}
+	return result, nil
+}
+
+// RecordDelivery дописывает запись в журнал доставки
+func (r *JSONWebhookRepository) RecordDelivery(ctx context.Context, delivery entity.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	line, err := json.Marshal(delivery)
+	if err != nil {
+		return fmt.Errorf("failed to encode delivery: %w", err)
+	}
+
+	if err := os.MkdirAll(r.dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	f, err := os.OpenFile(filepath.Join(r.dir, webhookDeliveryLog), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open delivery log: %w", err)
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write delivery log: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write delivery log: %w", err)
+	}
+
+	return nil
+}
+
+// Deliveries возвращает записи журнала доставки по фильтру
+func (r *JSONWebhookRepository) Deliveries(ctx context.Context, filter repository.DeliveryFilter) ([]entity.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deliveries := []entity.WebhookDelivery{}
+
+	f, err := os.Open(filepath.Join(r.dir, webhookDeliveryLog))
+	if errors.Is(err, os.ErrNotExist) {
+		return deliveries, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open delivery log: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64<<10), 1<<20)
+	for scanner.Scan() {
+		var d entity.WebhookDelivery
+		// Недописанная при сбое строка пропускается
+		if json.Unmarshal(scanner.Bytes(), &d) != nil {
+			continue
+		}
+		if filter.WebhookID != "" && d.WebhookID != filter.WebhookID {
+			continue
+		}
+		if filter.EventID != "" && d.EventID != filter.EventID {
+			continue
+		}
+		deliveries = append(deliveries, d)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read delivery log: %w", err)
+	}
+
+	if filter.Limit > 0 && len(deliveries) > filter.Limit {
+		deliveries = deliveries[len(deliveries)-filter.Limit:]
+	}
+	return deliveries, nil
+}
+
+// load читает регистрации webhook (отсутствующий файл - пустой список)
+func (r *JSONWebhookRepository) load() ([]*entity.Webhook, error) {
+	data, err := os.ReadFile(filepath.Join(r.dir, webhooksFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return []*entity.Webhook{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhooks file: %w", err)
+	}
+
+	var webhooks []*entity.Webhook
+	if err := json.Unmarshal(data, &webhooks); err != nil {
+		return nil, fmt.Errorf("failed to decode webhooks file: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+// save атомарно записывает регистрации webhook
+func (r *JSONWebhookRepository) save(webhooks []*entity.Webhook) error {
+	data, err := json.MarshalIndent(webhooks, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode webhooks: %w", err)
+	}
+
+	return writeFileAtomic(filepath.Join(r.dir, webhooksFile), data)
+}
@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// InMemoryAttachmentRepository реализация AttachmentRepository в памяти
+type InMemoryAttachmentRepository struct {
+	mu         sync.RWMutex
+	contents   map[string][]byte
+	thumbnails map[string][]byte
+}
+
+// NewInMemoryAttachmentRepository создает новый in-memory репозиторий вложений
+func NewInMemoryAttachmentRepository() *InMemoryAttachmentRepository {
+	return &InMemoryAttachmentRepository{
+		contents:   make(map[string][]byte),
+		thumbnails: make(map[string][]byte),
+	}
+}
+
+// Save сохраняет содержимое и возвращает его ID
+func (r *InMemoryAttachmentRepository) Save(ctx context.Context, data []byte) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	id := entity.AttachmentID(data)
+	if _, exists := r.contents[id]; !exists {
+		r.contents[id] = append([]byte(nil), data...)
+	}
+	return id, nil
+}
+
+// Load возвращает содержимое по ID
+func (r *InMemoryAttachmentRepository) Load(ctx context.Context, id string) ([]byte, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	data, exists := r.contents[id]
+	if !exists {
+		return nil, fmt.Errorf("attachment %s %w", id, repository.ErrNotFound)
+	}
+	return data, nil
+}
+
+// Exists проверяет наличие содержимого
+func (r *InMemoryAttachmentRepository) Exists(ctx context.Context, id string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, exists := r.contents[id]
+	return exists, nil
+}
+
+// Delete удаляет содержимое и миниатюру
+func (r *InMemoryAttachmentRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.contents[id]; !exists {
+		return fmt.Errorf("attachment %s %w", id, repository.ErrNotFound)
+	}
+
+	delete(r.contents, id)
+	delete(r.thumbnails, id)
+	return nil
+}
+
+// SaveThumbnail сохраняет миниатюру изображения
+func (r *InMemoryAttachmentRepository) SaveThumbnail(ctx context.Context, id string, data []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.contents[id]; !exists {
+		return fmt.Errorf("attachment %s %w", id, repository.ErrNotFound)
+	}
+
+	r.thumbnails[id] = append([]byte(nil), data...)
+	return nil
+}
+
+// LoadThumbnail возвращает миниатюру изображения
+func (r *InMemoryAttachmentRepository) LoadThumbnail(ctx context.Context, id string) ([]byte, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	data, exists := r.thumbnails[id]
+	if !exists {
+		return nil, fmt.Errorf("thumbnail of attachment %s %w", id, repository.ErrNotFound)
+	}
+	return data, nil
+}
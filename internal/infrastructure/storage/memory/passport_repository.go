@@ -6,6 +6,9 @@ import (
 	"sync"
 
 	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/address"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/criteria"
 )
 
 // InMemoryPassportRepository реализация PassportRepository в памяти
@@ -28,7 +31,7 @@ func (r *InMemoryPassportRepository) Create(ctx context.Context, passport *entit
 	defer r.mu.Unlock()
 
 	if _, exists := r.passports[passport.ID]; exists {
-		return fmt.Errorf("passport with ID %s already exists", passport.ID)
+		return fmt.Errorf("passport with ID %s %w", passport.ID, repository.ErrAlreadyExists)
 	}
 
 	r.passports[passport.ID] = passport
@@ -42,7 +45,7 @@ func (r *InMemoryPassportRepository) GetByID(ctx context.Context, id string) (*e
 
 	passport, exists := r.passports[id]
 	if !exists {
-		return nil, fmt.Errorf("passport with ID %s not found", id)
+		return nil, fmt.Errorf("passport with ID %s %w", id, repository.ErrNotFound)
 	}
 
 	return passport, nil
@@ -54,7 +57,7 @@ func (r *InMemoryPassportRepository) Update(ctx context.Context, passport *entit
 	defer r.mu.Unlock()
 
 	if _, exists := r.passports[passport.ID]; !exists {
-		return fmt.Errorf("passport with ID %s not found", passport.ID)
+		return fmt.Errorf("passport with ID %s %w", passport.ID, repository.ErrNotFound)
 	}
 
 	r.passports[passport.ID] = passport
@@ -67,7 +70,7 @@ func (r *InMemoryPassportRepository) Delete(ctx context.Context, id string) erro
 	defer r.mu.Unlock()
 
 	if _, exists := r.passports[id]; !exists {
-		return fmt.Errorf("passport with ID %s not found", id)
+		return fmt.Errorf("passport with ID %s %w", id, repository.ErrNotFound)
 	}
 
 	delete(r.passports, id)
@@ -87,14 +90,15 @@ func (r *InMemoryPassportRepository) List(ctx context.Context) ([]*entity.Techni
 	return result, nil
 }
 
-// FindByAddress ищет паспорта по адресу
-func (r *InMemoryPassportRepository) FindByAddress(ctx context.Context, address entity.Address) ([]*entity.TechnicalPassport, error) {
+// FindByAddress ищет паспорта по адресу без учета сокращений, регистра
+// и записи номера дома
+func (r *InMemoryPassportRepository) FindByAddress(ctx context.Context, addr entity.Address) ([]*entity.TechnicalPassport, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	result := make([]*entity.TechnicalPassport, 0)
 	for _, passport := range r.passports {
-		if addressMatches(passport.Address, address) {
+		if address.Same(passport.Address, addr) {
 			result = append(result, passport)
 		}
 	}
@@ -102,12 +106,15 @@ func (r *InMemoryPassportRepository) FindByAddress(ctx context.Context, address
 	return result, nil
 }
 
-// addressMatches проверяет совпадение адресов
-func addressMatches(a, b entity.Address) bool {
-	return a.Subject == b.Subject &&
-		a.District == b.District &&
-		a.City == b.City &&
-		a.Street == b.Street &&
-		a.House == b.House &&
-		a.Building == b.Building
+// Find возвращает страницу паспортов по условию выборки
+func (r *InMemoryPassportRepository) Find(ctx context.Context, query repository.PassportCriteria) (*repository.PassportPage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	passports := make([]*entity.TechnicalPassport, 0, len(r.passports))
+	for _, passport := range r.passports {
+		passports = append(passports, passport)
+	}
+
+	return criteria.Apply(passports, query)
 }
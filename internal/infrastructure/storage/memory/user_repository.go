@@ -0,0 +1,104 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// InMemoryUserRepository реализация UserRepository в памяти
+// Используется для тестирования и прототипирования
+type InMemoryUserRepository struct {
+	mu    sync.RWMutex
+	users map[string]*entity.User // ключ - логин
+}
+
+// NewInMemoryUserRepository создает новый in-memory репозиторий пользователей
+func NewInMemoryUserRepository() *InMemoryUserRepository {
+	return &InMemoryUserRepository{
+		users: make(map[string]*entity.User),
+	}
+}
+
+// Create сохраняет нового пользователя
+func (r *InMemoryUserRepository) Create(ctx context.Context, user *entity.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.users[user.Login]; exists {
+		return fmt.Errorf("user with login %s %w", user.Login, repository.ErrAlreadyExists)
+	}
+
+	r.users[user.Login] = user
+	return nil
+}
+
+// CreateFirst сохраняет пользователя, если других пользователей нет
+func (r *InMemoryUserRepository) CreateFirst(ctx context.Context, user *entity.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.users) > 0 {
+		return repository.ErrUsersExist
+	}
+
+	r.users[user.Login] = user
+	return nil
+}
+
+// GetByLogin возвращает пользователя по логину
+func (r *InMemoryUserRepository) GetByLogin(ctx context.Context, login string) (*entity.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	user, exists := r.users[login]
+	if !exists {
+		return nil, fmt.Errorf("user with login %s %w", login, repository.ErrNotFound)
+	}
+
+	return user, nil
+}
+
+// Update обновляет существующего пользователя
+func (r *InMemoryUserRepository) Update(ctx context.Context, user *entity.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.users[user.Login]; !exists {
+		return fmt.Errorf("user with login %s %w", user.Login, repository.ErrNotFound)
+	}
+
+	r.users[user.Login] = user
+	return nil
+}
+
+// Delete удаляет пользователя по ID
+func (r *InMemoryUserRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for login, user := range r.users {
+		if user.ID == id {
+			delete(r.users, login)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("user with ID %s %w", id, repository.ErrNotFound)
+}
+
+// List возвращает всех пользователей
+func (r *InMemoryUserRepository) List(ctx context.Context) ([]*entity.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*entity.User, 0, len(r.users))
+	for _, user := range r.users {
+		result = append(result, user)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,401 @@
+// Package valuation рассчитывает инвентаризационную стоимость зданий и сооружений по методике
+// БТИ: укрупненные показатели восстановительной стоимости в базисных ценах,
+// территориальные коэффициенты, индексы пересчета в цены года оценки и физический износ
+package valuation
+
+import (
+	"embed"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// tablesFS справочники, поставляемые с программой
+//
+//go:embed tables/*.json
+var tablesFS embed.FS
+
+// Engine реализация service.InventoryValuer. Справочники загружаются при первом
+// обращении: встроенные и файлы *.json из каталогов организации.
+type Engine struct {
+	dirs []string
+	now  func() time.Time
+
+	once   sync.Once
+	tables map[string]*table
+	err    error
+}
+
+// NewEngine создает расчет стоимости; dirs - каталоги с дополнительными
+// справочниками (отсутствующий каталог пропускается)
+func NewEngine(dirs ...string) *Engine {
+	return &Engine{dirs: dirs, now: time.Now}
+}
+
+var _ service.InventoryValuer = (*Engine)(nil)
+
+// Tables возвращает справочники по дате начала действия
+func (e *Engine) Tables() ([]service.ValuationTable, error) {
+	if err := e.load(); err != nil {
+		return nil, err
+	}
+
+	current := e.current()
+	var tables []service.ValuationTable
+	for _, t := range e.tables {
+		info := service.ValuationTable{
+			Version:       t.Version,
+			Title:         t.Title,
+			EffectiveDate: t.EffectiveDate,
+			BaseYear:      t.BaseYear,
+			Years:         t.years(),
+			Default:       t == current,
+		}
+		for _, p := range t.Purposes {
+			info.Purposes = append(info.Purposes, p.Code)
+		}
+		tables = append(tables, info)
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		if tables[i].EffectiveDate != tables[j].EffectiveDate {
+			return tables[i].EffectiveDate < tables[j].EffectiveDate
+		}
+		return tables[i].Version < tables[j].Version
+	})
+	return tables, nil
+}
+
+// Value рассчитывает стоимость: восстановительная стоимость округляется до рубля,
+// износ по сроку службы - до процента, инвентаризационная стоимость - до рубля
+func (e *Engine) Value(inputs entity.ValuationInputs) (*entity.BuildingValuation, error) {
+	if err := inputs.IsValid(); err != nil {
+		return nil, err
+	}
+	if err := e.load(); err != nil {
+		return nil, err
+	}
+	if inputs.StructureKind != "" {
+		return e.valueStructure(inputs)
+	}
+
+	t, group, err := e.resolve(inputs.TableVersion, inputs.WallMaterial)
+	if err != nil {
+		return nil, err
+	}
+	inputs.TableVersion = t.Version
+
+	if inputs.Purpose == "" {
+		inputs.Purpose = t.purpose(inputs.Name, inputs.ObjectType)
+	}
+	r := t.rate(inputs.Purpose, group.Code)
+	if r == nil {
+		return nil, entity.ValidationError{Field: "purpose", Message: fmt.Sprintf("в справочнике нет показателя для назначения %q и группы %q", inputs.Purpose, group.Code)}
+	}
+
+	valuation := &entity.BuildingValuation{
+		Date:      e.now(),
+		WallGroup: group.Code,
+		Unit:      r.Unit,
+		UnitCost:  r.Cost,
+	}
+
+	switch r.Unit {
+	case entity.ValuationUnitArea:
+		valuation.Quantity = inputs.TotalArea
+		if valuation.Quantity == 0 {
+			return nil, entity.ValidationError{Field: "total_area", Message: "показатель задан на кв.м: укажите общую площадь здания"}
+		}
+	default:
+		valuation.Quantity = inputs.Volume
+		if valuation.Quantity == 0 {
+			return nil, entity.ValidationError{Field: "volume", Message: "показатель задан на куб.м: укажите строительный объем здания"}
+		}
+	}
+
+	if err := price(t, inputs, valuation); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case inputs.WearPercent != nil:
+		valuation.Wear = *inputs.WearPercent
+	case len(inputs.ElementWear) > 0:
+		shares, wear, warnings, err := weightWear(t, group, inputs.ElementWear)
+		if err != nil {
+			return nil, err
+		}
+		valuation.WearElements = shares
+		valuation.Wear = wear
+		valuation.Warnings = append(valuation.Warnings, warnings...)
+	default:
+		if err := ageWear(t, inputs, group.ServiceLife, valuation); err != nil {
+			return nil, err
+		}
+	}
+	valuation.InventoryValue = math.Round(valuation.ReplacementCost * (1 - valuation.Wear/100))
+
+	valuation.Inputs = inputs
+	return valuation, nil
+}
+
+// valueStructure рассчитывает стоимость сооружения по показателю его вида
+// и материала: на погонный метр, кв.м или куб.м емкости
+func (e *Engine) valueStructure(inputs entity.ValuationInputs) (*entity.BuildingValuation, error) {
+	t := e.current()
+	if inputs.TableVersion != "" {
+		t = e.tables[inputs.TableVersion]
+	}
+	if t == nil {
+		return nil, entity.ValidationError{Field: "table_version", Message: "справочника " + inputs.TableVersion + " нет"}
+	}
+	inputs.TableVersion = t.Version
+
+	r := t.structureRate(inputs.StructureKind, inputs.WallMaterial)
+	if r == nil {
+		return nil, entity.ValidationError{Field: "structure_kind", Message: fmt.Sprintf(
+			"в справочнике %s нет показателя для сооружения «%s» из материала «%s»", t.Version, inputs.StructureKind.Title(), inputs.WallMaterial)}
+	}
+	inputs.Purpose = r.Code
+
+	valuation := &entity.BuildingValuation{
+		Date:      e.now(),
+		WallGroup: r.Code,
+		Unit:      r.Unit,
+		UnitCost:  r.Cost,
+	}
+
+	switch r.Unit {
+	case entity.ValuationUnitLength:
+		valuation.Quantity = inputs.Length
+		if valuation.Quantity == 0 {
+			return nil, entity.ValidationError{Field: "length", Message: "показатель задан на погонный метр: укажите протяженность сооружения"}
+		}
+	case entity.ValuationUnitArea:
+		valuation.Quantity = inputs.TotalArea
+		if valuation.Quantity == 0 {
+			return nil, entity.ValidationError{Field: "total_area", Message: "показатель задан на кв.м: укажите площадь сооружения"}
+		}
+	default:
+		valuation.Quantity = inputs.Volume
+		if valuation.Quantity == 0 {
+			return nil, entity.ValidationError{Field: "volume", Message: "показатель задан на куб.м: укажите емкость сооружения"}
+		}
+	}
+
+	if err := price(t, inputs, valuation); err != nil {
+		return nil, err
+	}
+
+	if inputs.WearPercent != nil {
+		valuation.Wear = *inputs.WearPercent
+	} else if err := ageWear(t, inputs, r.ServiceLife, valuation); err != nil {
+		return nil, err
+	}
+	valuation.InventoryValue = math.Round(valuation.ReplacementCost * (1 - valuation.Wear/100))
+
+	valuation.Inputs = inputs
+	return valuation, nil
+}
+
+// price приводит стоимость единицы к ценам года оценки с территориальным
+// коэффициентом и записывает восстановительную стоимость
+func price(t *table, inputs entity.ValuationInputs, valuation *entity.BuildingValuation) error {
+	k, found := t.region(inputs.Region)
+	if k == 0 {
+		return entity.ValidationError{Field: "region", Message: "субъекта «" + inputs.Region + "» нет в справочнике"}
+	}
+	if !found {
+		valuation.Warnings = append(valuation.Warnings, fmt.Sprintf("субъекта «%s» нет в справочнике, применен коэффициент %.2f", inputs.Region, k))
+	}
+	valuation.RegionalCoefficient = k
+
+	index, ok := t.Indices[strconv.Itoa(inputs.Year)]
+	if !ok {
+		years := t.years()
+		return entity.ValidationError{Field: "year", Message: fmt.Sprintf("в справочнике %s нет индекса на %d г. (есть %d-%d)", t.Version, inputs.Year, years[0], years[len(years)-1])}
+	}
+	valuation.PriceIndex = index
+	valuation.ReplacementCost = math.Round(valuation.Quantity * valuation.UnitCost * k * index)
+	return nil
+}
+
+// ageWear оценивает износ по сроку службы, ограничивая его предельным износом справочника
+func ageWear(t *table, inputs entity.ValuationInputs, serviceLife int, valuation *entity.BuildingValuation) error {
+	if inputs.CommissionYear == 0 {
+		return entity.ValidationError{Field: "commission_year", Message: "для износа по сроку службы нужен год ввода в эксплуатацию"}
+	}
+	valuation.WearByAge = true
+	valuation.Wear = math.Round(float64(inputs.Year-inputs.CommissionYear) / float64(serviceLife) * 100)
+	if valuation.Wear > t.MaxAgeWear {
+		valuation.Warnings = append(valuation.Warnings, fmt.Sprintf(
+			"износ по сроку службы %.0f%% ограничен %.0f%%: больший износ устанавливается осмотром", valuation.Wear, t.MaxAgeWear))
+		valuation.Wear = t.MaxAgeWear
+	}
+	return nil
+}
+
+// AssessWear взвешивает износ конструктивных элементов по удельным весам группы
+// капитальности; износ здания округляется до процента
+func (e *Engine) AssessWear(inputs entity.ValuationInputs) (*entity.WearAssessment, error) {
+	if err := e.load(); err != nil {
+		return nil, err
+	}
+
+	t, group, err := e.resolve(inputs.TableVersion, inputs.WallMaterial)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, wear, warnings, err := weightWear(t, group, inputs.ElementWear)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.WearAssessment{
+		Date:         e.now(),
+		TableVersion: t.Version,
+		WallGroup:    group.Code,
+		Elements:     shares,
+		Wear:         wear,
+		Warnings:     warnings,
+	}, nil
+}
+
+// weightWear износ здания по износу элементов: сумма произведений износа на
+// удельный вес. Если оценены не все элементы с удельным весом, веса оцененных
+// приводятся к 100%.
+func weightWear(t *table, group *wallGroup, elementWear map[entity.StructuralElementKind]float64) ([]entity.ElementWearShare, float64, []string, error) {
+	if len(elementWear) == 0 {
+		return nil, 0, nil, entity.ValidationError{Field: "element_wear", Message: "не указан износ конструктивных элементов"}
+	}
+	if len(group.ElementWeights) == 0 {
+		return nil, 0, nil, entity.ValidationError{Field: "wall_material", Message: fmt.Sprintf(
+			"в справочнике %s нет удельных весов элементов для группы %q", t.Version, group.Code)}
+	}
+
+	var (
+		shares         []entity.ElementWearShare
+		warnings       []string
+		missing        []string
+		weighted, sumW float64
+	)
+	for _, kind := range entity.StructuralElementKinds {
+		weight := group.ElementWeights[kind]
+		wear, assessed := elementWear[kind]
+		switch {
+		case assessed && weight == 0:
+			warnings = append(warnings, fmt.Sprintf("у элемента «%s» нет удельного веса в справочнике, износ не учитывается", kind.Title()))
+		case !assessed && weight > 0:
+			missing = append(missing, strings.ToLower(kind.Title()))
+			continue
+		case !assessed:
+			continue
+		}
+		shares = append(shares, entity.ElementWearShare{Kind: kind, Weight: weight, Wear: wear})
+		weighted += weight * wear
+		sumW += weight
+	}
+	if sumW == 0 {
+		return nil, 0, nil, entity.ValidationError{Field: "element_wear", Message: "ни один из оцененных элементов не имеет удельного веса в справочнике"}
+	}
+	if len(missing) > 0 {
+		warnings = append(warnings, fmt.Sprintf(
+			"не оценены: %s; удельные веса оцененных элементов приведены к 100%%", strings.Join(missing, ", ")))
+	}
+
+	return shares, math.Round(weighted / sumW), warnings, nil
+}
+
+// resolve справочник по версии (пустая - действующий) и группа капитальности по материалу стен
+func (e *Engine) resolve(version, wallMaterial string) (*table, *wallGroup, error) {
+	t := e.current()
+	if version != "" {
+		t = e.tables[version]
+	}
+	if t == nil {
+		return nil, nil, entity.ValidationError{Field: "table_version", Message: "справочника " + version + " нет"}
+	}
+
+	group := t.wallGroup(wallMaterial)
+	if group == nil {
+		return nil, nil, entity.ValidationError{Field: "wall_material", Message: "материал стен «" + wallMaterial + "» не относится ни к одной группе капитальности справочника"}
+	}
+	return t, group, nil
+}
+
+// load загружает справочники один раз
+func (e *Engine) load() error {
+	e.once.Do(func() {
+		e.tables = map[string]*table{}
+
+		embedded, err := tablesFS.ReadDir("tables")
+		if err != nil {
+			e.err = err
+			return
+		}
+		for _, f := range embedded {
+			data, err := tablesFS.ReadFile("tables/" + f.Name())
+			if err != nil {
+				e.err = err
+				return
+			}
+			if e.err = e.add(f.Name(), data); e.err != nil {
+				return
+			}
+		}
+
+		for _, dir := range e.dirs {
+			files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+			if err != nil {
+				e.err = err
+				return
+			}
+			for _, name := range files {
+				data, err := os.ReadFile(name)
+				if err != nil {
+					e.err = fmt.Errorf("failed to read valuation table: %w", err)
+					return
+				}
+				if e.err = e.add(name, data); e.err != nil {
+					return
+				}
+			}
+		}
+	})
+	return e.err
+}
+
+// add добавляет справочник; версии не могут повторяться, чтобы сохраненные
+// расчеты однозначно указывали на справочник
+func (e *Engine) add(name string, data []byte) error {
+	t, err := parseTable(data)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	if _, ok := e.tables[t.Version]; ok {
+		return fmt.Errorf("%s: valuation table version %s is already loaded", name, t.Version)
+	}
+	e.tables[t.Version] = t
+	return nil
+}
+
+// current действующий справочник: с наибольшей датой начала действия
+func (e *Engine) current() *table {
+	var current *table
+	for _, t := range e.tables {
+		if current == nil || t.EffectiveDate > current.EffectiveDate ||
+			(t.EffectiveDate == current.EffectiveDate && strings.Compare(t.Version, current.Version) > 0) {
+			current = t
+		}
+	}
+	return current
+}
@@ -0,0 +1,272 @@
+package valuation_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/valuation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func wear(v float64) *float64 {
+	return &v
+}
+
+func TestEngine_Value(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputs      entity.ValuationInputs
+		purpose     string
+		replacement float64
+		wear        float64
+		value       float64
+		warnings    int
+	}{
+		{
+			name: "masonry house, wear by age",
+			inputs: entity.ValuationInputs{
+				Region: "г. Москва", Year: 2024, ObjectType: entity.ObjectTypeResidentialHouse, Name: "Жилой дом",
+				WallMaterial: "Кирпич", Volume: 500, CommissionYear: 1974,
+			},
+			purpose:     "residential",
+			replacement: 1234925, // 500 x 23,50 x 1,00 x 105,10
+			wear:        33,      // 50 / 150 лет
+			value:       827400,
+		},
+		{
+			name: "wear by inspection",
+			inputs: entity.ValuationInputs{
+				Region: "г. Санкт-Петербург", Year: 2024, Name: "Жилой дом",
+				WallMaterial: "брус", Volume: 300, CommissionYear: 2000, WearPercent: wear(40),
+			},
+			purpose:     "residential",
+			replacement: 488841, // 300 x 15,20 x 1,02 x 105,10 = 488 841,12
+			wear:        40,
+			value:       293305,
+		},
+		{
+			name: "wear by age capped",
+			inputs: entity.ValuationInputs{
+				Region: "г. Москва", Year: 2024, Name: "Жилой дом",
+				WallMaterial: "Деревянные рубленые", Volume: 100, CommissionYear: 1950,
+			},
+			purpose:     "residential",
+			replacement: 159752, // 100 x 15,20 x 105,10
+			wear:        70,
+			value:       47926,
+			warnings:    1,
+		},
+		{
+			name: "auxiliary building priced per square metre",
+			inputs: entity.ValuationInputs{
+				Region: "Неизвестный край", Year: 2024, Name: "Навес",
+				WallMaterial: "профлист", TotalArea: 20, CommissionYear: 2020, WearPercent: wear(10),
+			},
+			purpose:     "auxiliary",
+			replacement: 30479, // 20 x 14,50 x 105,10
+			wear:        10,
+			value:       27431,
+			warnings:    1, // коэффициент по умолчанию
+		},
+		{
+			name: "metal fence priced per running metre",
+			inputs: entity.ValuationInputs{
+				Region: "г. Москва", Year: 2024, Name: "Забор", StructureKind: entity.StructureKindFence,
+				WallMaterial: "Металлический профлист", Length: 120, CommissionYear: 2009,
+			},
+			purpose:     "fence_metal",
+			replacement: 58015, // 120 x 4,60 x 105,10 = 58 015,20
+			wear:        50,    // 15 / 30 лет
+			value:       29008,
+		},
+		{
+			name: "asphalt road priced per square metre",
+			inputs: entity.ValuationInputs{
+				Region: "г. Москва", Year: 2024, Name: "Проезд", StructureKind: entity.StructureKindRoad,
+				WallMaterial: "асфальтобетон", TotalArea: 150, CommissionYear: 2020, WearPercent: wear(20),
+			},
+			purpose:     "road_asphalt",
+			replacement: 45719, // 150 x 2,90 x 105,10 = 45 718,50
+			wear:        20,
+			value:       36575,
+		},
+	}
+
+	engine := valuation.NewEngine()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := engine.Value(tt.inputs)
+			require.NoError(t, err)
+			require.NoError(t, v.IsValid())
+			assert.Equal(t, "upvs-1969-2024", v.Inputs.TableVersion)
+			assert.Equal(t, tt.purpose, v.Inputs.Purpose)
+			assert.Equal(t, tt.replacement, v.ReplacementCost)
+			assert.Equal(t, tt.wear, v.Wear)
+			assert.Equal(t, tt.value, v.InventoryValue)
+			assert.Len(t, v.Warnings, tt.warnings)
+
+			// Повторный расчет по сохраненным данным дает ту же стоимость
+			again, err := engine.Value(v.Inputs)
+			require.NoError(t, err)
+			assert.Equal(t, v.InventoryValue, again.InventoryValue)
+		})
+	}
+}
+
+func TestEngine_Value_Invalid(t *testing.T) {
+	valid := entity.ValuationInputs{Region: "г. Москва", Year: 2024, Name: "Жилой дом", WallMaterial: "кирпич", Volume: 500, CommissionYear: 1974}
+
+	tests := []struct {
+		name   string
+		modify func(in *entity.ValuationInputs)
+		field  string
+	}{
+		{name: "unknown table", modify: func(in *entity.ValuationInputs) { in.TableVersion = "upvs-1900" }, field: "table_version"},
+		{name: "unknown wall material", modify: func(in *entity.ValuationInputs) { in.WallMaterial = "саман" }, field: "wall_material"},
+		{name: "unknown purpose", modify: func(in *entity.ValuationInputs) { in.Purpose = "industrial" }, field: "purpose"},
+		{name: "no volume", modify: func(in *entity.ValuationInputs) { in.Volume = 0 }, field: "volume"},
+		{name: "no index for year", modify: func(in *entity.ValuationInputs) { in.Year = 2010 }, field: "year"},
+		{name: "no commission year", modify: func(in *entity.ValuationInputs) { in.CommissionYear = 0 }, field: "commission_year"},
+		{name: "wear out of range", modify: func(in *entity.ValuationInputs) { in.WearPercent = wear(120) }, field: "wear_percent"},
+		{name: "structure without length", modify: func(in *entity.ValuationInputs) { in.StructureKind = entity.StructureKindPipeline }, field: "length"},
+		{name: "structure with element wear", modify: func(in *entity.ValuationInputs) {
+			in.StructureKind = entity.StructureKindFence
+			in.ElementWear = map[entity.StructuralElementKind]float64{entity.ElementWalls: 30}
+		}, field: "element_wear"},
+	}
+
+	engine := valuation.NewEngine()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inputs := valid
+			tt.modify(&inputs)
+			_, err := engine.Value(inputs)
+			var validationErr entity.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+}
+
+const extraTable = `{
+  "version": "org-2025",
+  "title": "Справочник организации",
+  "effective_date": "2025-01-01",
+  "base_year": 1969,
+  "max_age_wear": 70,
+  "wall_groups": [{"code": "masonry", "name": "Кирпичные", "materials": ["кирпич"], "service_life": 150}],
+  "purposes": [{"code": "residential", "name": "Жилые", "names": ["дом"]}],
+  "rates": [{"purpose": "residential", "wall_group": "masonry", "unit": "m3", "cost": 30}],
+  "regions": {"*": 1.1},
+  "indices": {"2025": 100}
+}`
+
+func TestEngine_Tables(t *testing.T) {
+	tables, err := valuation.NewEngine(filepath.Join(t.TempDir(), "missing")).Tables()
+	require.NoError(t, err)
+	require.Len(t, tables, 1)
+	assert.Equal(t, "upvs-1969-2024", tables[0].Version)
+	assert.True(t, tables[0].Default)
+	assert.Equal(t, []string{"auxiliary", "non_residential", "residential"}, tables[0].Purposes)
+	assert.Contains(t, tables[0].Years, 2024)
+
+	// Справочник организации с более поздней датой становится действующим
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "org.json"), []byte(extraTable), 0o644))
+	engine := valuation.NewEngine(dir)
+	tables, err = engine.Tables()
+	require.NoError(t, err)
+	require.Len(t, tables, 2)
+	assert.False(t, tables[0].Default)
+	assert.Equal(t, "org-2025", tables[1].Version)
+	assert.True(t, tables[1].Default)
+
+	v, err := engine.Value(entity.ValuationInputs{Year: 2025, Name: "Дом", WallMaterial: "кирпич", Volume: 100, CommissionYear: 2025})
+	require.NoError(t, err)
+	assert.Equal(t, "org-2025", v.Inputs.TableVersion)
+	assert.Equal(t, 330000.0, v.InventoryValue) // 100 x 30 x 1,1 x 100
+
+	// Прежняя версия остается доступной для повторения расчетов
+	v, err = engine.Value(entity.ValuationInputs{TableVersion: "upvs-1969-2024", Region: "г. Москва", Year: 2024, Name: "Дом", WallMaterial: "кирпич", Volume: 500, CommissionYear: 1974})
+	require.NoError(t, err)
+	assert.Equal(t, 827400.0, v.InventoryValue)
+}
+
+func TestEngine_Tables_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "duplicate version", content: strings.Replace(extraTable, "org-2025", "upvs-1969-2024", 1)},
+		{name: "unknown field", content: `{"version": "x", "rate": []}`},
+		{name: "rate for unknown group", content: `{"version": "x", "base_year": 1969, "max_age_wear": 70, "indices": {"2024": 1},
+			"purposes": [{"code": "residential"}], "rates": [{"purpose": "residential", "wall_group": "stone", "unit": "m3", "cost": 1}]}`},
+		{name: "structure rate with building unit", content: `{"version": "x", "base_year": 1969, "max_age_wear": 70, "indices": {"2024": 1},
+			"structure_rates": [{"kind": "fence", "code": "fence", "unit": "m4", "cost": 1, "service_life": 10}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "bad.json"), []byte(tt.content), 0o644))
+			_, err := valuation.NewEngine(dir).Tables()
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestEngine_AssessWear(t *testing.T) {
+	all := map[entity.StructuralElementKind]float64{
+		entity.ElementFoundation: 20, entity.ElementWalls: 25, entity.ElementPartitions: 30,
+		entity.ElementSlabs: 20, entity.ElementRoof: 40, entity.ElementFloors: 35, entity.ElementOpenings: 30,
+		entity.ElementFinishing: 50, entity.ElementEngineering: 40, entity.ElementOther: 30,
+	}
+
+	tests := []struct {
+		name     string
+		wear     map[entity.StructuralElementKind]float64
+		expected float64
+		warnings int
+	}{
+		{name: "all elements", wear: all, expected: 30}, // 2970 / 100 = 29,7
+		{
+			name:     "weights of assessed elements scaled to 100%",
+			wear:     map[entity.StructuralElementKind]float64{entity.ElementFoundation: 20, entity.ElementWalls: 40},
+			expected: 35, // (9 x 20 + 30 x 40) / 39 = 35,4
+			warnings: 1,
+		},
+	}
+
+	engine := valuation.NewEngine()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assessment, err := engine.AssessWear(entity.ValuationInputs{WallMaterial: "Кирпич", ElementWear: tt.wear})
+			require.NoError(t, err)
+			assert.Equal(t, "upvs-1969-2024", assessment.TableVersion)
+			assert.Equal(t, "masonry", assessment.WallGroup)
+			assert.Len(t, assessment.Elements, len(tt.wear))
+			assert.Equal(t, tt.expected, assessment.Wear)
+			assert.Len(t, assessment.Warnings, tt.warnings)
+		})
+	}
+
+	// Износ по элементам учитывается в стоимости, если износ по осмотру не задан
+	v, err := engine.Value(entity.ValuationInputs{
+		Region: "г. Москва", Year: 2024, Name: "Жилой дом", WallMaterial: "Кирпич", Volume: 500, CommissionYear: 1974,
+		ElementWear: tests[1].wear,
+	})
+	require.NoError(t, err)
+	assert.False(t, v.WearByAge)
+	assert.Len(t, v.WearElements, 2)
+	assert.Equal(t, 35.0, v.Wear)
+	assert.Equal(t, 802701.0, v.InventoryValue) // 1 234 925 x 0,65
+
+	_, err = engine.AssessWear(entity.ValuationInputs{WallMaterial: "Кирпич"})
+	var validationErr entity.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "element_wear", validationErr.Field)
+}
@@ -0,0 +1,262 @@
+package valuation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+)
+
+// DefaultRegion ключ территориального коэффициента для субъектов,
+// которых нет в справочнике
+const DefaultRegion = "*"
+
+// table справочник укрупненных показателей восстановительной стоимости (УПВС)
+type table struct {
+	Version       string `json:"version"`
+	Title         string `json:"title"`
+	EffectiveDate string `json:"effective_date"`
+	BaseYear      int    `json:"base_year"`
+
+	// MaxAgeWear предельный износ, оцениваемый по сроку службы (%);
+	// больший износ устанавливается только осмотром
+	MaxAgeWear float64 `json:"max_age_wear"`
+
+	// WallGroups группы капитальности в порядке сопоставления материала стен
+	WallGroups []wallGroup `json:"wall_groups"`
+
+	// Purposes назначения в порядке сопоставления наименования здания
+	Purposes []purpose `json:"purposes"`
+
+	// DefaultPurposes назначение по виду объекта, если наименование не сопоставлено
+	DefaultPurposes map[entity.ObjectType]string `json:"default_purposes"`
+
+	Rates []rate `json:"rates"`
+
+	// StructureRates показатели сооружений в порядке сопоставления материала
+	StructureRates []structureRate `json:"structure_rates,omitempty"`
+
+	// Regions территориальные коэффициенты по наименованию субъекта
+	Regions map[string]float64 `json:"regions"`
+
+	// Indices индексы пересчета базисных цен в цены года
+	Indices map[string]float64 `json:"indices"`
+}
+
+// wallGroup группа капитальности: подстроки материала стен, нормативный срок службы
+// и удельные веса конструктивных элементов в восстановительной стоимости (%)
+type wallGroup struct {
+	Code           string                                   `json:"code"`
+	Name           string                                   `json:"name"`
+	Materials      []string                                 `json:"materials"`
+	ServiceLife    int                                      `json:"service_life"`
+	ElementWeights map[entity.StructuralElementKind]float64 `json:"element_weights,omitempty"`
+}
+
+// purpose назначение здания и подстроки наименования
+type purpose struct {
+	Code  string   `json:"code"`
+	Name  string   `json:"name"`
+	Names []string `json:"names"`
+}
+
+// rate показатель стоимости единицы для назначения и группы капитальности
+type rate struct {
+	Purpose   string               `json:"purpose"`
+	WallGroup string               `json:"wall_group"`
+	Unit      entity.ValuationUnit `json:"unit"`
+	Cost      float64              `json:"cost"`
+}
+
+// structureRate показатель стоимости сооружения: вид, подстроки материала
+// (пустой список - любой материал), единица и нормативный срок службы
+type structureRate struct {
+	Kind        entity.StructureKind `json:"kind"`
+	Code        string               `json:"code"`
+	Name        string               `json:"name"`
+	Materials   []string             `json:"materials,omitempty"`
+	Unit        entity.ValuationUnit `json:"unit"`
+	Cost        float64              `json:"cost"`
+	ServiceLife int                  `json:"service_life"`
+}
+
+// parseTable читает и проверяет справочник
+func parseTable(data []byte) (*table, error) {
+	var t table
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&t); err != nil {
+		return nil, fmt.Errorf("invalid valuation table: %w", err)
+	}
+	if err := t.validate(); err != nil {
+		return nil, fmt.Errorf("invalid valuation table %s: %w", t.Version, err)
+	}
+	return &t, nil
+}
+
+// validate проверяет согласованность справочника
+func (t *table) validate() error {
+	switch {
+	case t.Version == "":
+		return fmt.Errorf("version is required")
+	case t.BaseYear == 0:
+		return fmt.Errorf("base_year is required")
+	case t.MaxAgeWear <= 0 || t.MaxAgeWear > 100:
+		return fmt.Errorf("max_age_wear must be in (0, 100]")
+	case len(t.Indices) == 0:
+		return fmt.Errorf("indices are required")
+	}
+
+	groups := map[string]bool{}
+	for _, g := range t.WallGroups {
+		if g.ServiceLife <= 0 {
+			return fmt.Errorf("wall group %s: service_life must be positive", g.Code)
+		}
+		if len(g.ElementWeights) > 0 {
+			var total float64
+			for kind, weight := range g.ElementWeights {
+				if !kind.IsValid() || weight < 0 {
+					return fmt.Errorf("wall group %s: unknown element %s or negative weight", g.Code, kind)
+				}
+				total += weight
+			}
+			if math.Abs(total-100) > 0.01 {
+				return fmt.Errorf("wall group %s: element weights sum to %.2f, not 100", g.Code, total)
+			}
+		}
+		groups[g.Code] = true
+	}
+	purposes := map[string]bool{}
+	for _, p := range t.Purposes {
+		purposes[p.Code] = true
+	}
+	for objectType, code := range t.DefaultPurposes {
+		if !purposes[code] {
+			return fmt.Errorf("default purpose of %s: unknown purpose %s", objectType, code)
+		}
+	}
+
+	for i, r := range t.Rates {
+		if !groups[r.WallGroup] || !purposes[r.Purpose] {
+			return fmt.Errorf("rates[%d]: unknown purpose %s or wall group %s", i, r.Purpose, r.WallGroup)
+		}
+		if r.Unit != entity.ValuationUnitVolume && r.Unit != entity.ValuationUnitArea {
+			return fmt.Errorf("rates[%d]: unit must be m3 or m2", i)
+		}
+		if r.Cost <= 0 {
+			return fmt.Errorf("rates[%d]: cost must be positive", i)
+		}
+	}
+
+	codes := map[string]bool{}
+	for i, r := range t.StructureRates {
+		switch {
+		case !r.Kind.IsValid():
+			return fmt.Errorf("structure_rates[%d]: unknown structure kind %s", i, r.Kind)
+		case r.Code == "" || codes[r.Code]:
+			return fmt.Errorf("structure_rates[%d]: code is required and must be unique", i)
+		case r.Unit != entity.ValuationUnitLength && r.Unit != entity.ValuationUnitArea && r.Unit != entity.ValuationUnitVolume:
+			return fmt.Errorf("structure_rates[%d]: unit must be m, m2 or m3", i)
+		case r.Cost <= 0:
+			return fmt.Errorf("structure_rates[%d]: cost must be positive", i)
+		case r.ServiceLife <= 0:
+			return fmt.Errorf("structure_rates[%d]: service_life must be positive", i)
+		}
+		codes[r.Code] = true
+	}
+
+	for region, k := range t.Regions {
+		if k <= 0 {
+			return fmt.Errorf("region %s: coefficient must be positive", region)
+		}
+	}
+	for year, index := range t.Indices {
+		if _, err := strconv.Atoi(year); err != nil || index <= 0 {
+			return fmt.Errorf("index %s: year must be a number and index positive", year)
+		}
+	}
+
+	return nil
+}
+
+// years годы индексов по возрастанию
+func (t *table) years() []int {
+	years := make([]int, 0, len(t.Indices))
+	for year := range t.Indices {
+		y, _ := strconv.Atoi(year)
+		years = append(years, y)
+	}
+	sort.Ints(years)
+	return years
+}
+
+// wallGroup группа капитальности по материалу стен
+func (t *table) wallGroup(material string) *wallGroup {
+	material = strings.ToLower(material)
+	for i, g := range t.WallGroups {
+		for _, m := range g.Materials {
+			if strings.Contains(material, m) {
+				return &t.WallGroups[i]
+			}
+		}
+	}
+	return nil
+}
+
+// purpose назначение по наименованию здания или по виду объекта
+func (t *table) purpose(name string, objectType entity.ObjectType) string {
+	name = strings.ToLower(name)
+	for _, p := range t.Purposes {
+		for _, n := range p.Names {
+			if strings.Contains(name, n) {
+				return p.Code
+			}
+		}
+	}
+	return t.DefaultPurposes[objectType]
+}
+
+// rate показатель для назначения и группы капитальности
+func (t *table) rate(purpose, group string) *rate {
+	for i, r := range t.Rates {
+		if r.Purpose == purpose && r.WallGroup == group {
+			return &t.Rates[i]
+		}
+	}
+	return nil
+}
+
+// structureRate показатель сооружения по виду и материалу
+func (t *table) structureRate(kind entity.StructureKind, material string) *structureRate {
+	material = strings.ToLower(material)
+	for i, r := range t.StructureRates {
+		if r.Kind != kind {
+			continue
+		}
+		if len(r.Materials) == 0 {
+			return &t.StructureRates[i]
+		}
+		for _, m := range r.Materials {
+			if strings.Contains(material, m) {
+				return &t.StructureRates[i]
+			}
+		}
+	}
+	return nil
+}
+
+// region территориальный коэффициент; found=false, если применен коэффициент по умолчанию
+func (t *table) region(name string) (k float64, found bool) {
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	for region, k := range t.Regions {
+		if region != DefaultRegion && strings.ToLower(region) == normalized {
+			return k, true
+		}
+	}
+	return t.Regions[DefaultRegion], false
+}
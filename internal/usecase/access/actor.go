@@ -0,0 +1,39 @@
+package access
+
+import (
+	"context"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+)
+
+// actorKey ключ контекста для текущего пользователя
+type actorKey struct{}
+
+// WithActor возвращает контекст с пользователем, от имени которого выполняются операции
+func WithActor(ctx context.Context, user *entity.User) context.Context {
+	return context.WithValue(ctx, actorKey{}, user)
+}
+
+// ActorFromContext возвращает пользователя из контекста
+func ActorFromContext(ctx context.Context) (*entity.User, bool) {
+	user, ok := ctx.Value(actorKey{}).(*entity.User)
+	return user, ok && user != nil
+}
+
+// Authorize проверяет что пользователь из контекста имеет указанное право
+func Authorize(ctx context.Context, permission entity.Permission) error {
+	user, ok := ActorFromContext(ctx)
+	if !ok {
+		return entity.AuthenticationError{Message: "пользователь не авторизован"}
+	}
+
+	if !user.Can(permission) {
+		return entity.PermissionError{
+			Login:      user.Login,
+			Role:       user.Role,
+			Permission: permission,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,1272 @@
+package access
+
+import (
+	"context"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+)
+
+// CreatePassportUseCase оборачивает passport.CreatePassportUseCase проверкой права entity.PermissionCreatePassport
+type CreatePassportUseCase struct {
+	next *passport.CreatePassportUseCase
+}
+
+// NewCreatePassportUseCase создает use case с проверкой прав
+func NewCreatePassportUseCase(next *passport.CreatePassportUseCase) *CreatePassportUseCase {
+	return &CreatePassportUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет создание паспорта
+func (uc *CreatePassportUseCase) Execute(ctx context.Context, input passport.CreatePassportInput) (*passport.CreatePassportOutput, error) {
+	if err := Authorize(ctx, entity.PermissionCreatePassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// AddBuildingUseCase оборачивает passport.AddBuildingUseCase проверкой права entity.PermissionEditPassport
+type AddBuildingUseCase struct {
+	next *passport.AddBuildingUseCase
+}
+
+// NewAddBuildingUseCase создает use case с проверкой прав
+func NewAddBuildingUseCase(next *passport.AddBuildingUseCase) *AddBuildingUseCase {
+	return &AddBuildingUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет добавление здания
+func (uc *AddBuildingUseCase) Execute(ctx context.Context, input passport.AddBuildingInput) (*passport.AddBuildingOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// RemoveBuildingUseCase оборачивает passport.RemoveBuildingUseCase проверкой права entity.PermissionEditPassport
+type RemoveBuildingUseCase struct {
+	next *passport.RemoveBuildingUseCase
+}
+
+// NewRemoveBuildingUseCase создает use case с проверкой прав
+func NewRemoveBuildingUseCase(next *passport.RemoveBuildingUseCase) *RemoveBuildingUseCase {
+	return &RemoveBuildingUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет удаление здания
+func (uc *RemoveBuildingUseCase) Execute(ctx context.Context, input passport.RemoveBuildingInput) (*passport.RemoveBuildingOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// ApprovePassportUseCase оборачивает passport.ApprovePassportUseCase проверкой права entity.PermissionApprovePassport
+type ApprovePassportUseCase struct {
+	next *passport.ApprovePassportUseCase
+}
+
+// NewApprovePassportUseCase создает use case с проверкой прав
+func NewApprovePassportUseCase(next *passport.ApprovePassportUseCase) *ApprovePassportUseCase {
+	return &ApprovePassportUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет утверждение паспорта
+func (uc *ApprovePassportUseCase) Execute(ctx context.Context, input passport.ApprovePassportInput) (*passport.ApprovePassportOutput, error) {
+	if err := Authorize(ctx, entity.PermissionApprovePassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// ArchivePassportUseCase оборачивает passport.ArchivePassportUseCase проверкой права entity.PermissionArchivePassport
+type ArchivePassportUseCase struct {
+	next *passport.ArchivePassportUseCase
+}
+
+// NewArchivePassportUseCase создает use case с проверкой прав
+func NewArchivePassportUseCase(next *passport.ArchivePassportUseCase) *ArchivePassportUseCase {
+	return &ArchivePassportUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет перевод паспорта в архив
+func (uc *ArchivePassportUseCase) Execute(ctx context.Context, input passport.ArchivePassportInput) (*passport.ArchivePassportOutput, error) {
+	if err := Authorize(ctx, entity.PermissionArchivePassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// DeletePassportUseCase оборачивает passport.DeletePassportUseCase проверкой права entity.PermissionDeletePassport
+type DeletePassportUseCase struct {
+	next *passport.DeletePassportUseCase
+}
+
+// NewDeletePassportUseCase создает use case с проверкой прав
+func NewDeletePassportUseCase(next *passport.DeletePassportUseCase) *DeletePassportUseCase {
+	return &DeletePassportUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет удаление паспорта
+func (uc *DeletePassportUseCase) Execute(ctx context.Context, input passport.DeletePassportInput) (*passport.DeletePassportOutput, error) {
+	if err := Authorize(ctx, entity.PermissionDeletePassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// ValidatePassportUseCase оборачивает passport.ValidatePassportUseCase проверкой права entity.PermissionViewPassport
+type ValidatePassportUseCase struct {
+	next *passport.ValidatePassportUseCase
+}
+
+// NewValidatePassportUseCase создает use case с проверкой прав
+func NewValidatePassportUseCase(next *passport.ValidatePassportUseCase) *ValidatePassportUseCase {
+	return &ValidatePassportUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет проверку паспорта
+func (uc *ValidatePassportUseCase) Execute(ctx context.Context, input passport.ValidatePassportInput) (*passport.ValidatePassportOutput, error) {
+	if err := Authorize(ctx, entity.PermissionViewPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// ExportPassportUseCase оборачивает passport.ExportPassportUseCase проверкой права entity.PermissionViewPassport
+type ExportPassportUseCase struct {
+	next *passport.ExportPassportUseCase
+}
+
+// NewExportPassportUseCase создает use case с проверкой прав
+func NewExportPassportUseCase(next *passport.ExportPassportUseCase) *ExportPassportUseCase {
+	return &ExportPassportUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет экспорт паспорта
+func (uc *ExportPassportUseCase) Execute(ctx context.Context, input passport.ExportPassportInput) (*passport.ExportPassportOutput, error) {
+	if err := Authorize(ctx, entity.PermissionViewPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// ExportRosreestrUseCase оборачивает passport.ExportRosreestrUseCase проверкой права entity.PermissionViewPassport
+type ExportRosreestrUseCase struct {
+	next *passport.ExportRosreestrUseCase
+}
+
+// NewExportRosreestrUseCase создает use case с проверкой прав
+func NewExportRosreestrUseCase(next *passport.ExportRosreestrUseCase) *ExportRosreestrUseCase {
+	return &ExportRosreestrUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет выгрузку паспорта в XML Росреестра
+func (uc *ExportRosreestrUseCase) Execute(ctx context.Context, input passport.ExportRosreestrInput) (*passport.ExportRosreestrOutput, error) {
+	if err := Authorize(ctx, entity.PermissionViewPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// ExportTablesUseCase оборачивает passport.ExportTablesUseCase проверкой права entity.PermissionViewPassport
+type ExportTablesUseCase struct {
+	next *passport.ExportTablesUseCase
+}
+
+// NewExportTablesUseCase создает use case с проверкой прав
+func NewExportTablesUseCase(next *passport.ExportTablesUseCase) *ExportTablesUseCase {
+	return &ExportTablesUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет выгрузку таблиц паспорта в XLSX
+func (uc *ExportTablesUseCase) Execute(ctx context.Context, input passport.ExportTablesInput) (*passport.ExportTablesOutput, error) {
+	if err := Authorize(ctx, entity.PermissionViewPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// ImportTableUseCase оборачивает passport.ImportTableUseCase проверкой права entity.PermissionEditPassport
+type ImportTableUseCase struct {
+	next *passport.ImportTableUseCase
+}
+
+// NewImportTableUseCase создает use case с проверкой прав
+func NewImportTableUseCase(next *passport.ImportTableUseCase) *ImportTableUseCase {
+	return &ImportTableUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет загрузку таблицы паспорта из XLSX
+func (uc *ImportTableUseCase) Execute(ctx context.Context, input passport.ImportTableInput) (*passport.ImportTableOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// ListPassportsUseCase оборачивает passport.ListPassportsUseCase проверкой права entity.PermissionViewPassport
+type ListPassportsUseCase struct {
+	next *passport.ListPassportsUseCase
+}
+
+// NewListPassportsUseCase создает use case с проверкой прав
+func NewListPassportsUseCase(next *passport.ListPassportsUseCase) *ListPassportsUseCase {
+	return &ListPassportsUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет получение списка паспортов
+func (uc *ListPassportsUseCase) Execute(ctx context.Context, input passport.ListPassportsInput) (*passport.ListPassportsOutput, error) {
+	if err := Authorize(ctx, entity.PermissionViewPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// ImportPassportUseCase оборачивает passport.ImportPassportUseCase проверкой права entity.PermissionCreatePassport
+type ImportPassportUseCase struct {
+	next *passport.ImportPassportUseCase
+}
+
+// NewImportPassportUseCase создает use case с проверкой прав
+func NewImportPassportUseCase(next *passport.ImportPassportUseCase) *ImportPassportUseCase {
+	return &ImportPassportUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет импорт паспорта
+func (uc *ImportPassportUseCase) Execute(ctx context.Context, input passport.ImportPassportInput) (*passport.ImportPassportOutput, error) {
+	if err := Authorize(ctx, entity.PermissionCreatePassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// GetPassportUseCase оборачивает passport.GetPassportUseCase проверкой права entity.PermissionViewPassport
+type GetPassportUseCase struct {
+	next *passport.GetPassportUseCase
+}
+
+// NewGetPassportUseCase создает use case с проверкой прав
+func NewGetPassportUseCase(next *passport.GetPassportUseCase) *GetPassportUseCase {
+	return &GetPassportUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет получение паспорта
+func (uc *GetPassportUseCase) Execute(ctx context.Context, input passport.GetPassportInput) (*passport.GetPassportOutput, error) {
+	if err := Authorize(ctx, entity.PermissionViewPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// UpdatePassportUseCase оборачивает passport.UpdatePassportUseCase проверкой права entity.PermissionEditPassport
+type UpdatePassportUseCase struct {
+	next *passport.UpdatePassportUseCase
+}
+
+// NewUpdatePassportUseCase создает use case с проверкой прав
+func NewUpdatePassportUseCase(next *passport.UpdatePassportUseCase) *UpdatePassportUseCase {
+	return &UpdatePassportUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет изменение паспорта
+func (uc *UpdatePassportUseCase) Execute(ctx context.Context, input passport.UpdatePassportInput) (*passport.UpdatePassportOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// UpdateBuildingUseCase оборачивает passport.UpdateBuildingUseCase проверкой права entity.PermissionEditPassport
+type UpdateBuildingUseCase struct {
+	next *passport.UpdateBuildingUseCase
+}
+
+// NewUpdateBuildingUseCase создает use case с проверкой прав
+func NewUpdateBuildingUseCase(next *passport.UpdateBuildingUseCase) *UpdateBuildingUseCase {
+	return &UpdateBuildingUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет изменение здания
+func (uc *UpdateBuildingUseCase) Execute(ctx context.Context, input passport.UpdateBuildingInput) (*passport.UpdateBuildingOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// AddOwnerUseCase оборачивает passport.AddOwnerUseCase проверкой права entity.PermissionEditPassport
+type AddOwnerUseCase struct {
+	next *passport.AddOwnerUseCase
+}
+
+// NewAddOwnerUseCase создает use case с проверкой прав
+func NewAddOwnerUseCase(next *passport.AddOwnerUseCase) *AddOwnerUseCase {
+	return &AddOwnerUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет добавление правообладателя
+func (uc *AddOwnerUseCase) Execute(ctx context.Context, input passport.AddOwnerInput) (*passport.AddOwnerOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// UpdateOwnerUseCase оборачивает passport.UpdateOwnerUseCase проверкой права entity.PermissionEditPassport
+type UpdateOwnerUseCase struct {
+	next *passport.UpdateOwnerUseCase
+}
+
+// NewUpdateOwnerUseCase создает use case с проверкой прав
+func NewUpdateOwnerUseCase(next *passport.UpdateOwnerUseCase) *UpdateOwnerUseCase {
+	return &UpdateOwnerUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет изменение правообладателя
+func (uc *UpdateOwnerUseCase) Execute(ctx context.Context, input passport.UpdateOwnerInput) (*passport.UpdateOwnerOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// RemoveOwnerUseCase оборачивает passport.RemoveOwnerUseCase проверкой права entity.PermissionEditPassport
+type RemoveOwnerUseCase struct {
+	next *passport.RemoveOwnerUseCase
+}
+
+// NewRemoveOwnerUseCase создает use case с проверкой прав
+func NewRemoveOwnerUseCase(next *passport.RemoveOwnerUseCase) *RemoveOwnerUseCase {
+	return &RemoveOwnerUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет удаление правообладателя
+func (uc *RemoveOwnerUseCase) Execute(ctx context.Context, input passport.RemoveOwnerInput) (*passport.RemoveOwnerOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// AddRoomUseCase оборачивает passport.AddRoomUseCase проверкой права entity.PermissionEditPassport
+type AddRoomUseCase struct {
+	next *passport.AddRoomUseCase
+}
+
+// NewAddRoomUseCase создает use case с проверкой прав
+func NewAddRoomUseCase(next *passport.AddRoomUseCase) *AddRoomUseCase {
+	return &AddRoomUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет добавление помещения
+func (uc *AddRoomUseCase) Execute(ctx context.Context, input passport.AddRoomInput) (*passport.AddRoomOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// UpdateRoomUseCase оборачивает passport.UpdateRoomUseCase проверкой права entity.PermissionEditPassport
+type UpdateRoomUseCase struct {
+	next *passport.UpdateRoomUseCase
+}
+
+// NewUpdateRoomUseCase создает use case с проверкой прав
+func NewUpdateRoomUseCase(next *passport.UpdateRoomUseCase) *UpdateRoomUseCase {
+	return &UpdateRoomUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет изменение помещения
+func (uc *UpdateRoomUseCase) Execute(ctx context.Context, input passport.UpdateRoomInput) (*passport.UpdateRoomOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// RemoveRoomUseCase оборачивает passport.RemoveRoomUseCase проверкой права entity.PermissionEditPassport
+type RemoveRoomUseCase struct {
+	next *passport.RemoveRoomUseCase
+}
+
+// NewRemoveRoomUseCase создает use case с проверкой прав
+func NewRemoveRoomUseCase(next *passport.RemoveRoomUseCase) *RemoveRoomUseCase {
+	return &RemoveRoomUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет удаление помещения
+func (uc *RemoveRoomUseCase) Execute(ctx context.Context, input passport.RemoveRoomInput) (*passport.RemoveRoomOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// ExportInterchangeUseCase оборачивает passport.ExportInterchangeUseCase проверкой права entity.PermissionViewPassport
+type ExportInterchangeUseCase struct {
+	next *passport.ExportInterchangeUseCase
+}
+
+// NewExportInterchangeUseCase создает use case с проверкой прав
+func NewExportInterchangeUseCase(next *passport.ExportInterchangeUseCase) *ExportInterchangeUseCase {
+	return &ExportInterchangeUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет выгрузку паспортов
+func (uc *ExportInterchangeUseCase) Execute(ctx context.Context, input passport.ExportInterchangeInput) (*passport.ExportInterchangeOutput, error) {
+	if err := Authorize(ctx, entity.PermissionViewPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// ImportInterchangeUseCase оборачивает passport.ImportInterchangeUseCase проверкой права entity.PermissionCreatePassport
+// Замена существующих паспортов дополнительно требует entity.PermissionEditPassport,
+// сохранение статусов из документа - entity.PermissionApprovePassport
+type ImportInterchangeUseCase struct {
+	next *passport.ImportInterchangeUseCase
+}
+
+// NewImportInterchangeUseCase создает use case с проверкой прав
+func NewImportInterchangeUseCase(next *passport.ImportInterchangeUseCase) *ImportInterchangeUseCase {
+	return &ImportInterchangeUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет загрузку паспортов
+func (uc *ImportInterchangeUseCase) Execute(ctx context.Context, input passport.ImportInterchangeInput) (*passport.ImportInterchangeOutput, error) {
+	if err := authorizeImport(ctx, input); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// authorizeImport проверяет права на загрузку документа формата обмена
+func authorizeImport(ctx context.Context, input passport.ImportInterchangeInput) error {
+	if err := Authorize(ctx, entity.PermissionCreatePassport); err != nil {
+		return err
+	}
+	if input.Conflict == passport.ConflictOverwrite {
+		if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+			return err
+		}
+	}
+	if input.KeepStatus {
+		if err := Authorize(ctx, entity.PermissionApprovePassport); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ImportRegistryUseCase оборачивает passport.ImportRegistryUseCase проверкой права entity.PermissionCreatePassport
+// Обновление найденных по адресу паспортов дополнительно требует entity.PermissionEditPassport
+type ImportRegistryUseCase struct {
+	next *passport.ImportRegistryUseCase
+}
+
+// NewImportRegistryUseCase создает use case с проверкой прав
+func NewImportRegistryUseCase(next *passport.ImportRegistryUseCase) *ImportRegistryUseCase {
+	return &ImportRegistryUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет загрузку реестра паспортов
+func (uc *ImportRegistryUseCase) Execute(ctx context.Context, input passport.ImportRegistryInput) (*passport.ImportRegistryOutput, error) {
+	if err := Authorize(ctx, entity.PermissionCreatePassport); err != nil {
+		return nil, err
+	}
+	if input.Duplicates == passport.DuplicateUpdate {
+		if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+			return nil, err
+		}
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// SaveFloorPlanUseCase оборачивает passport.SaveFloorPlanUseCase проверкой права entity.PermissionEditPassport
+type SaveFloorPlanUseCase struct {
+	next *passport.SaveFloorPlanUseCase
+}
+
+// NewSaveFloorPlanUseCase создает use case с проверкой прав
+func NewSaveFloorPlanUseCase(next *passport.SaveFloorPlanUseCase) *SaveFloorPlanUseCase {
+	return &SaveFloorPlanUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет сохранение поэтажного плана
+func (uc *SaveFloorPlanUseCase) Execute(ctx context.Context, input passport.SaveFloorPlanInput) (*passport.SaveFloorPlanOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// RemoveFloorPlanUseCase оборачивает passport.RemoveFloorPlanUseCase проверкой права entity.PermissionEditPassport
+type RemoveFloorPlanUseCase struct {
+	next *passport.RemoveFloorPlanUseCase
+}
+
+// NewRemoveFloorPlanUseCase создает use case с проверкой прав
+func NewRemoveFloorPlanUseCase(next *passport.RemoveFloorPlanUseCase) *RemoveFloorPlanUseCase {
+	return &RemoveFloorPlanUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет удаление поэтажного плана
+func (uc *RemoveFloorPlanUseCase) Execute(ctx context.Context, input passport.RemoveFloorPlanInput) (*passport.RemoveFloorPlanOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// RenderFloorPlanUseCase оборачивает passport.RenderFloorPlanUseCase проверкой права entity.PermissionViewPassport
+type RenderFloorPlanUseCase struct {
+	next *passport.RenderFloorPlanUseCase
+}
+
+// NewRenderFloorPlanUseCase создает use case с проверкой прав
+func NewRenderFloorPlanUseCase(next *passport.RenderFloorPlanUseCase) *RenderFloorPlanUseCase {
+	return &RenderFloorPlanUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет отрисовку поэтажного плана
+func (uc *RenderFloorPlanUseCase) Execute(ctx context.Context, input passport.RenderFloorPlanInput) (*passport.RenderFloorPlanOutput, error) {
+	if err := Authorize(ctx, entity.PermissionViewPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// CheckFloorPlanAreasUseCase оборачивает passport.CheckFloorPlanAreasUseCase проверкой права entity.PermissionViewPassport
+type CheckFloorPlanAreasUseCase struct {
+	next *passport.CheckFloorPlanAreasUseCase
+}
+
+// NewCheckFloorPlanAreasUseCase создает use case с проверкой прав
+func NewCheckFloorPlanAreasUseCase(next *passport.CheckFloorPlanAreasUseCase) *CheckFloorPlanAreasUseCase {
+	return &CheckFloorPlanAreasUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет сверку площадей
+func (uc *CheckFloorPlanAreasUseCase) Execute(ctx context.Context, input passport.CheckFloorPlanAreasInput) (*passport.CheckFloorPlanAreasOutput, error) {
+	if err := Authorize(ctx, entity.PermissionViewPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// MeasureRoomUseCase оборачивает passport.MeasureRoomUseCase проверкой права entity.PermissionEditPassport
+type MeasureRoomUseCase struct {
+	next *passport.MeasureRoomUseCase
+}
+
+// NewMeasureRoomUseCase создает use case с проверкой прав
+func NewMeasureRoomUseCase(next *passport.MeasureRoomUseCase) *MeasureRoomUseCase {
+	return &MeasureRoomUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет расчет площади помещения по обмеру
+func (uc *MeasureRoomUseCase) Execute(ctx context.Context, input passport.MeasureRoomInput) (*passport.MeasureRoomOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// SaveSituationPlanUseCase оборачивает passport.SaveSituationPlanUseCase проверкой права entity.PermissionEditPassport
+type SaveSituationPlanUseCase struct {
+	next *passport.SaveSituationPlanUseCase
+}
+
+// NewSaveSituationPlanUseCase создает use case с проверкой прав
+func NewSaveSituationPlanUseCase(next *passport.SaveSituationPlanUseCase) *SaveSituationPlanUseCase {
+	return &SaveSituationPlanUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет сохранение ситуационного плана
+func (uc *SaveSituationPlanUseCase) Execute(ctx context.Context, input passport.SaveSituationPlanInput) (*passport.SaveSituationPlanOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// ImportPlotCoordinatesUseCase оборачивает passport.ImportPlotCoordinatesUseCase проверкой права entity.PermissionEditPassport
+type ImportPlotCoordinatesUseCase struct {
+	next *passport.ImportPlotCoordinatesUseCase
+}
+
+// NewImportPlotCoordinatesUseCase создает use case с проверкой прав
+func NewImportPlotCoordinatesUseCase(next *passport.ImportPlotCoordinatesUseCase) *ImportPlotCoordinatesUseCase {
+	return &ImportPlotCoordinatesUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет загрузку координат ситуационного плана
+func (uc *ImportPlotCoordinatesUseCase) Execute(ctx context.Context, input passport.ImportPlotCoordinatesInput) (*passport.ImportPlotCoordinatesOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// RemoveSituationPlanUseCase оборачивает passport.RemoveSituationPlanUseCase проверкой права entity.PermissionEditPassport
+type RemoveSituationPlanUseCase struct {
+	next *passport.RemoveSituationPlanUseCase
+}
+
+// NewRemoveSituationPlanUseCase создает use case с проверкой прав
+func NewRemoveSituationPlanUseCase(next *passport.RemoveSituationPlanUseCase) *RemoveSituationPlanUseCase {
+	return &RemoveSituationPlanUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет удаление ситуационного плана
+func (uc *RemoveSituationPlanUseCase) Execute(ctx context.Context, input passport.RemoveSituationPlanInput) (*passport.RemoveSituationPlanOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// RenderSituationPlanUseCase оборачивает passport.RenderSituationPlanUseCase проверкой права entity.PermissionViewPassport
+type RenderSituationPlanUseCase struct {
+	next *passport.RenderSituationPlanUseCase
+}
+
+// NewRenderSituationPlanUseCase создает use case с проверкой прав
+func NewRenderSituationPlanUseCase(next *passport.RenderSituationPlanUseCase) *RenderSituationPlanUseCase {
+	return &RenderSituationPlanUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет отрисовку ситуационного плана
+func (uc *RenderSituationPlanUseCase) Execute(ctx context.Context, input passport.RenderSituationPlanInput) (*passport.RenderSituationPlanOutput, error) {
+	if err := Authorize(ctx, entity.PermissionViewPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// ImportFloorPlanDrawingUseCase оборачивает passport.ImportFloorPlanDrawingUseCase проверкой права
+// entity.PermissionEditPassport; для пробного разбора чертежа достаточно права просмотра
+type ImportFloorPlanDrawingUseCase struct {
+	next *passport.ImportFloorPlanDrawingUseCase
+}
+
+// NewImportFloorPlanDrawingUseCase создает use case с проверкой прав
+func NewImportFloorPlanDrawingUseCase(next *passport.ImportFloorPlanDrawingUseCase) *ImportFloorPlanDrawingUseCase {
+	return &ImportFloorPlanDrawingUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет загрузку поэтажного плана из чертежа
+func (uc *ImportFloorPlanDrawingUseCase) Execute(ctx context.Context, input passport.ImportFloorPlanDrawingInput) (*passport.ImportFloorPlanDrawingOutput, error) {
+	permission := entity.PermissionEditPassport
+	if input.DryRun {
+		permission = entity.PermissionViewPassport
+	}
+	if err := Authorize(ctx, permission); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// AddAttachmentUseCase оборачивает passport.AddAttachmentUseCase проверкой права entity.PermissionEditPassport
+type AddAttachmentUseCase struct {
+	next *passport.AddAttachmentUseCase
+}
+
+// NewAddAttachmentUseCase создает use case с проверкой прав
+func NewAddAttachmentUseCase(next *passport.AddAttachmentUseCase) *AddAttachmentUseCase {
+	return &AddAttachmentUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и добавляет вложение
+func (uc *AddAttachmentUseCase) Execute(ctx context.Context, input passport.AddAttachmentInput) (*passport.AddAttachmentOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// ListAttachmentsUseCase оборачивает passport.ListAttachmentsUseCase проверкой права entity.PermissionViewPassport
+type ListAttachmentsUseCase struct {
+	next *passport.ListAttachmentsUseCase
+}
+
+// NewListAttachmentsUseCase создает use case с проверкой прав
+func NewListAttachmentsUseCase(next *passport.ListAttachmentsUseCase) *ListAttachmentsUseCase {
+	return &ListAttachmentsUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и возвращает вложения паспорта
+func (uc *ListAttachmentsUseCase) Execute(ctx context.Context, input passport.ListAttachmentsInput) (*passport.ListAttachmentsOutput, error) {
+	if err := Authorize(ctx, entity.PermissionViewPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// GetAttachmentUseCase оборачивает passport.GetAttachmentUseCase проверкой права entity.PermissionViewPassport
+type GetAttachmentUseCase struct {
+	next *passport.GetAttachmentUseCase
+}
+
+// NewGetAttachmentUseCase создает use case с проверкой прав
+func NewGetAttachmentUseCase(next *passport.GetAttachmentUseCase) *GetAttachmentUseCase {
+	return &GetAttachmentUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и возвращает содержимое вложения
+func (uc *GetAttachmentUseCase) Execute(ctx context.Context, input passport.GetAttachmentInput) (*passport.GetAttachmentOutput, error) {
+	if err := Authorize(ctx, entity.PermissionViewPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// RemoveAttachmentUseCase оборачивает passport.RemoveAttachmentUseCase проверкой права entity.PermissionEditPassport
+type RemoveAttachmentUseCase struct {
+	next *passport.RemoveAttachmentUseCase
+}
+
+// NewRemoveAttachmentUseCase создает use case с проверкой прав
+func NewRemoveAttachmentUseCase(next *passport.RemoveAttachmentUseCase) *RemoveAttachmentUseCase {
+	return &RemoveAttachmentUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и удаляет вложение
+func (uc *RemoveAttachmentUseCase) Execute(ctx context.Context, input passport.RemoveAttachmentInput) (*passport.RemoveAttachmentOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// ExportBundleUseCase оборачивает passport.ExportBundleUseCase проверкой права entity.PermissionViewPassport
+type ExportBundleUseCase struct {
+	next *passport.ExportBundleUseCase
+}
+
+// NewExportBundleUseCase создает use case с проверкой прав
+func NewExportBundleUseCase(next *passport.ExportBundleUseCase) *ExportBundleUseCase {
+	return &ExportBundleUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет выгрузку паспортов с вложениями
+func (uc *ExportBundleUseCase) Execute(ctx context.Context, input passport.ExportInterchangeInput) (*passport.ExportInterchangeOutput, error) {
+	if err := Authorize(ctx, entity.PermissionViewPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// ImportBundleUseCase оборачивает passport.ImportBundleUseCase теми же проверками прав,
+// что и ImportInterchangeUseCase
+type ImportBundleUseCase struct {
+	next *passport.ImportBundleUseCase
+}
+
+// NewImportBundleUseCase создает use case с проверкой прав
+func NewImportBundleUseCase(next *passport.ImportBundleUseCase) *ImportBundleUseCase {
+	return &ImportBundleUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет загрузку паспортов с вложениями
+func (uc *ImportBundleUseCase) Execute(ctx context.Context, input passport.ImportInterchangeInput) (*passport.ImportInterchangeOutput, error) {
+	if err := authorizeImport(ctx, input); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// ValueBuildingUseCase оборачивает passport.ValueBuildingUseCase проверкой права
+// entity.PermissionEditPassport; для расчета без записи достаточно права просмотра
+type ValueBuildingUseCase struct {
+	next *passport.ValueBuildingUseCase
+}
+
+// NewValueBuildingUseCase создает use case с проверкой прав
+func NewValueBuildingUseCase(next *passport.ValueBuildingUseCase) *ValueBuildingUseCase {
+	return &ValueBuildingUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет расчет стоимости здания
+func (uc *ValueBuildingUseCase) Execute(ctx context.Context, input passport.ValueBuildingInput) (*passport.ValueBuildingOutput, error) {
+	permission := entity.PermissionEditPassport
+	if input.DryRun {
+		permission = entity.PermissionViewPassport
+	}
+	if err := Authorize(ctx, permission); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// VerifyValuationsUseCase оборачивает passport.VerifyValuationsUseCase проверкой права entity.PermissionViewPassport
+type VerifyValuationsUseCase struct {
+	next *passport.VerifyValuationsUseCase
+}
+
+// NewVerifyValuationsUseCase создает use case с проверкой прав
+func NewVerifyValuationsUseCase(next *passport.VerifyValuationsUseCase) *VerifyValuationsUseCase {
+	return &VerifyValuationsUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и повторяет расчеты стоимости
+func (uc *VerifyValuationsUseCase) Execute(ctx context.Context, input passport.VerifyValuationsInput) (*passport.VerifyValuationsOutput, error) {
+	if err := Authorize(ctx, entity.PermissionViewPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// AssessWearUseCase оборачивает passport.AssessWearUseCase проверкой права
+// entity.PermissionEditPassport; для расчета без записи достаточно права просмотра
+type AssessWearUseCase struct {
+	next *passport.AssessWearUseCase
+}
+
+// NewAssessWearUseCase создает use case с проверкой прав
+func NewAssessWearUseCase(next *passport.AssessWearUseCase) *AssessWearUseCase {
+	return &AssessWearUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет оценку износа здания
+func (uc *AssessWearUseCase) Execute(ctx context.Context, input passport.AssessWearInput) (*passport.AssessWearOutput, error) {
+	permission := entity.PermissionEditPassport
+	if input.DryRun {
+		permission = entity.PermissionViewPassport
+	}
+	if err := Authorize(ctx, permission); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// SetEntrancesUseCase оборачивает passport.SetEntrancesUseCase проверкой права entity.PermissionEditPassport
+type SetEntrancesUseCase struct {
+	next *passport.SetEntrancesUseCase
+}
+
+// NewSetEntrancesUseCase создает use case с проверкой прав
+func NewSetEntrancesUseCase(next *passport.SetEntrancesUseCase) *SetEntrancesUseCase {
+	return &SetEntrancesUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет замену списка подъездов
+func (uc *SetEntrancesUseCase) Execute(ctx context.Context, input passport.SetEntrancesInput) (*passport.SetEntrancesOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// SetFlatUseCase оборачивает passport.SetFlatUseCase проверкой права entity.PermissionEditPassport
+type SetFlatUseCase struct {
+	next *passport.SetFlatUseCase
+}
+
+// NewSetFlatUseCase создает use case с проверкой прав
+func NewSetFlatUseCase(next *passport.SetFlatUseCase) *SetFlatUseCase {
+	return &SetFlatUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет сохранение квартиры
+func (uc *SetFlatUseCase) Execute(ctx context.Context, input passport.SetFlatInput) (*passport.SetFlatOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// RemoveFlatUseCase оборачивает passport.RemoveFlatUseCase проверкой права entity.PermissionEditPassport
+type RemoveFlatUseCase struct {
+	next *passport.RemoveFlatUseCase
+}
+
+// NewRemoveFlatUseCase создает use case с проверкой прав
+func NewRemoveFlatUseCase(next *passport.RemoveFlatUseCase) *RemoveFlatUseCase {
+	return &RemoveFlatUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет удаление квартиры
+func (uc *RemoveFlatUseCase) Execute(ctx context.Context, input passport.RemoveFlatInput) (*passport.RemoveFlatOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// SetCommonAreasUseCase оборачивает passport.SetCommonAreasUseCase проверкой права entity.PermissionEditPassport
+type SetCommonAreasUseCase struct {
+	next *passport.SetCommonAreasUseCase
+}
+
+// NewSetCommonAreasUseCase создает use case с проверкой прав
+func NewSetCommonAreasUseCase(next *passport.SetCommonAreasUseCase) *SetCommonAreasUseCase {
+	return &SetCommonAreasUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет замену помещений общего имущества
+func (uc *SetCommonAreasUseCase) Execute(ctx context.Context, input passport.SetCommonAreasInput) (*passport.SetCommonAreasOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// ApartmentTotalsUseCase оборачивает passport.ApartmentTotalsUseCase проверкой права entity.PermissionViewPassport
+type ApartmentTotalsUseCase struct {
+	next *passport.ApartmentTotalsUseCase
+}
+
+// NewApartmentTotalsUseCase создает use case с проверкой прав
+func NewApartmentTotalsUseCase(next *passport.ApartmentTotalsUseCase) *ApartmentTotalsUseCase {
+	return &ApartmentTotalsUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и рассчитывает сводные показатели многоквартирного дома
+func (uc *ApartmentTotalsUseCase) Execute(ctx context.Context, input passport.ApartmentTotalsInput) (*passport.ApartmentTotalsOutput, error) {
+	if err := Authorize(ctx, entity.PermissionViewPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// ExportFlatExtractsUseCase оборачивает passport.ExportFlatExtractsUseCase проверкой права entity.PermissionViewPassport
+type ExportFlatExtractsUseCase struct {
+	next *passport.ExportFlatExtractsUseCase
+}
+
+// NewExportFlatExtractsUseCase создает use case с проверкой прав
+func NewExportFlatExtractsUseCase(next *passport.ExportFlatExtractsUseCase) *ExportFlatExtractsUseCase {
+	return &ExportFlatExtractsUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет выгрузку выписок на квартиры
+func (uc *ExportFlatExtractsUseCase) Execute(ctx context.Context, input passport.ExportFlatExtractsInput) (*passport.ExportFlatExtractsOutput, error) {
+	if err := Authorize(ctx, entity.PermissionViewPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// SetCommercialInfoUseCase оборачивает passport.SetCommercialInfoUseCase проверкой права entity.PermissionEditPassport
+type SetCommercialInfoUseCase struct {
+	next *passport.SetCommercialInfoUseCase
+}
+
+// NewSetCommercialInfoUseCase создает use case с проверкой прав
+func NewSetCommercialInfoUseCase(next *passport.SetCommercialInfoUseCase) *SetCommercialInfoUseCase {
+	return &SetCommercialInfoUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет замену сведений о пожарной безопасности
+func (uc *SetCommercialInfoUseCase) Execute(ctx context.Context, input passport.SetCommercialInfoInput) (*passport.SetCommercialInfoOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// UseCategoryTotalsUseCase оборачивает passport.UseCategoryTotalsUseCase проверкой права entity.PermissionViewPassport
+type UseCategoryTotalsUseCase struct {
+	next *passport.UseCategoryTotalsUseCase
+}
+
+// NewUseCategoryTotalsUseCase создает use case с проверкой прав
+func NewUseCategoryTotalsUseCase(next *passport.UseCategoryTotalsUseCase) *UseCategoryTotalsUseCase {
+	return &UseCategoryTotalsUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и рассчитывает площади по категориям использования
+func (uc *UseCategoryTotalsUseCase) Execute(ctx context.Context, input passport.UseCategoryTotalsInput) (*passport.UseCategoryTotalsOutput, error) {
+	if err := Authorize(ctx, entity.PermissionViewPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// SetStructureUseCase оборачивает passport.SetStructureUseCase проверкой права entity.PermissionEditPassport
+type SetStructureUseCase struct {
+	next *passport.SetStructureUseCase
+}
+
+// NewSetStructureUseCase создает use case с проверкой прав
+func NewSetStructureUseCase(next *passport.SetStructureUseCase) *SetStructureUseCase {
+	return &SetStructureUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет добавление или замену сооружения
+func (uc *SetStructureUseCase) Execute(ctx context.Context, input passport.SetStructureInput) (*passport.SetStructureOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// RemoveStructureUseCase оборачивает passport.RemoveStructureUseCase проверкой права entity.PermissionEditPassport
+type RemoveStructureUseCase struct {
+	next *passport.RemoveStructureUseCase
+}
+
+// NewRemoveStructureUseCase создает use case с проверкой прав
+func NewRemoveStructureUseCase(next *passport.RemoveStructureUseCase) *RemoveStructureUseCase {
+	return &RemoveStructureUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет удаление сооружения
+func (uc *RemoveStructureUseCase) Execute(ctx context.Context, input passport.RemoveStructureInput) (*passport.RemoveStructureOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// IssuePassportUseCase оборачивает passport.IssuePassportUseCase проверкой права entity.PermissionApprovePassport
+type IssuePassportUseCase struct {
+	next *passport.IssuePassportUseCase
+}
+
+// NewIssuePassportUseCase создает use case с проверкой прав
+func NewIssuePassportUseCase(next *passport.IssuePassportUseCase) *IssuePassportUseCase {
+	return &IssuePassportUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет выдачу паспорта
+func (uc *IssuePassportUseCase) Execute(ctx context.Context, input passport.IssuePassportInput) (*passport.IssuePassportOutput, error) {
+	if err := Authorize(ctx, entity.PermissionApprovePassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// SearchPassportsUseCase оборачивает passport.SearchPassportsUseCase проверкой права entity.PermissionViewPassport
+type SearchPassportsUseCase struct {
+	next *passport.SearchPassportsUseCase
+}
+
+// NewSearchPassportsUseCase создает use case с проверкой прав
+func NewSearchPassportsUseCase(next *passport.SearchPassportsUseCase) *SearchPassportsUseCase {
+	return &SearchPassportsUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет поиск паспортов
+func (uc *SearchPassportsUseCase) Execute(ctx context.Context, input passport.SearchPassportsInput) (*passport.SearchPassportsOutput, error) {
+	if err := Authorize(ctx, entity.PermissionViewPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// FindDuplicatesUseCase оборачивает passport.FindDuplicatesUseCase проверкой права entity.PermissionViewPassport
+type FindDuplicatesUseCase struct {
+	next *passport.FindDuplicatesUseCase
+}
+
+// NewFindDuplicatesUseCase создает use case с проверкой прав
+func NewFindDuplicatesUseCase(next *passport.FindDuplicatesUseCase) *FindDuplicatesUseCase {
+	return &FindDuplicatesUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет поиск дубликатов
+func (uc *FindDuplicatesUseCase) Execute(ctx context.Context, input passport.FindDuplicatesInput) (*passport.FindDuplicatesOutput, error) {
+	if err := Authorize(ctx, entity.PermissionViewPassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// MergePassportsUseCase оборачивает passport.MergePassportsUseCase проверкой прав
+// entity.PermissionEditPassport и entity.PermissionDeletePassport: дубликат удаляется
+type MergePassportsUseCase struct {
+	next *passport.MergePassportsUseCase
+}
+
+// NewMergePassportsUseCase создает use case с проверкой прав
+func NewMergePassportsUseCase(next *passport.MergePassportsUseCase) *MergePassportsUseCase {
+	return &MergePassportsUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет объединение паспортов
+func (uc *MergePassportsUseCase) Execute(ctx context.Context, input passport.MergePassportsInput) (*passport.MergePassportsOutput, error) {
+	if err := Authorize(ctx, entity.PermissionEditPassport); err != nil {
+		return nil, err
+	}
+	if err := Authorize(ctx, entity.PermissionDeletePassport); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
@@ -0,0 +1,144 @@
+package access_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/address"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validCreateInput() passport.CreatePassportInput {
+	return passport.CreatePassportInput{
+		ObjectType:       entity.ObjectTypeResidentialHouse,
+		OrganizationName: "ГУП БТИ",
+		Address:          entity.Address{Subject: "г. Москва", House: "1"},
+		GeneralInfo: entity.GeneralInfo{
+			Purpose:          "Жилое",
+			ConstructionYear: 2020,
+			TotalArea:        100.0,
+		},
+	}
+}
+
+func TestCreatePassportUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name     string
+		actor    *entity.User
+		wantErr  bool
+		checkErr func(*testing.T, error)
+	}{
+		{
+			name:    "technician creates draft",
+			actor:   &entity.User{Login: "tech", Role: entity.RoleTechnician},
+			wantErr: false,
+		},
+		{
+			name:    "admin creates passport",
+			actor:   &entity.User{Login: "admin", Role: entity.RoleAdmin},
+			wantErr: false,
+		},
+		{
+			name:    "reviewer is denied",
+			actor:   &entity.User{Login: "rev", Role: entity.RoleReviewer},
+			wantErr: true,
+			checkErr: func(t *testing.T, err error) {
+				var permErr entity.PermissionError
+				require.ErrorAs(t, err, &permErr)
+				assert.Equal(t, entity.PermissionCreatePassport, permErr.Permission)
+				assert.Equal(t, entity.RoleReviewer, permErr.Role)
+			},
+		},
+		{
+			name:    "anonymous is not authenticated",
+			actor:   nil,
+			wantErr: true,
+			checkErr: func(t *testing.T, err error) {
+				var authErr entity.AuthenticationError
+				require.ErrorAs(t, err, &authErr)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			repo := memory.NewInMemoryPassportRepository()
+			useCase := access.NewCreatePassportUseCase(passport.NewCreatePassportUseCase(repo, address.NewNormalizer()))
+			ctx := context.Background()
+			if tt.actor != nil {
+				ctx = access.WithActor(ctx, tt.actor)
+			}
+
+			// Act
+			output, err := useCase.Execute(ctx, validCreateInput())
+
+			// Assert
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Nil(t, output)
+				tt.checkErr(t, err)
+
+				all, _ := repo.List(context.Background())
+				assert.Empty(t, all)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, output)
+				assert.Equal(t, entity.PassportStatusDraft, output.Passport.Status)
+			}
+		})
+	}
+}
+
+func TestApproveAndDelete_RolePermissions(t *testing.T) {
+	repo := memory.NewInMemoryPassportRepository()
+	ctx := context.Background()
+
+	p := entity.NewTechnicalPassport(entity.ObjectTypeResidentialHouse, entity.Address{Subject: "г. Москва", House: "1"})
+	p.ID = "TEST-1"
+	p.GeneralInfo = entity.GeneralInfo{Purpose: "Жилое", ConstructionYear: 2020, TotalArea: 100.0}
+	p.Buildings = []entity.Building{{Litera: "А", Name: "Жилой дом", CommissionYear: 2020, TotalArea: 100.0}}
+	p.Owners = []entity.Owner{{
+		EntryDate:     p.CreatedDate,
+		PersonType:    entity.PersonTypeIndividual,
+		FullName:      "Иванов Иван Иванович",
+		RightType:     "Собственность",
+		RightDocument: "Договор купли-продажи",
+		Share:         "1",
+	}}
+	require.NoError(t, repo.Create(ctx, p))
+
+	technician := access.WithActor(ctx, &entity.User{Login: "tech", Role: entity.RoleTechnician})
+	reviewer := access.WithActor(ctx, &entity.User{Login: "rev", Role: entity.RoleReviewer})
+	admin := access.WithActor(ctx, &entity.User{Login: "admin", Role: entity.RoleAdmin})
+
+	approveUC := access.NewApprovePassportUseCase(passport.NewApprovePassportUseCase(repo))
+	deleteUC := access.NewDeletePassportUseCase(passport.NewDeletePassportUseCase(repo))
+
+	// Техник не может утверждать
+	_, err := approveUC.Execute(technician, passport.ApprovePassportInput{PassportID: p.ID})
+	var permErr entity.PermissionError
+	require.ErrorAs(t, err, &permErr)
+	assert.Equal(t, entity.PermissionApprovePassport, permErr.Permission)
+
+	// Проверяющий утверждает
+	out, err := approveUC.Execute(reviewer, passport.ApprovePassportInput{PassportID: p.ID})
+	require.NoError(t, err)
+	assert.Equal(t, entity.PassportStatusApproved, out.Passport.Status)
+
+	// Проверяющий не может удалять
+	_, err = deleteUC.Execute(reviewer, passport.DeletePassportInput{PassportID: p.ID})
+	require.ErrorAs(t, err, &permErr)
+
+	// Администратор удаляет
+	_, err = deleteUC.Execute(admin, passport.DeletePassportInput{PassportID: p.ID})
+	require.NoError(t, err)
+
+	_, err = repo.GetByID(ctx, p.ID)
+	assert.Error(t, err)
+}
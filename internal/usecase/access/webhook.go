@@ -0,0 +1,92 @@
+package access
+
+import (
+	"context"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/webhook"
+)
+
+// RegisterWebhookUseCase оборачивает webhook.RegisterWebhookUseCase проверкой права entity.PermissionManageWebhooks
+type RegisterWebhookUseCase struct {
+	next *webhook.RegisterWebhookUseCase
+}
+
+// NewRegisterWebhookUseCase создает use case с проверкой прав
+func NewRegisterWebhookUseCase(next *webhook.RegisterWebhookUseCase) *RegisterWebhookUseCase {
+	return &RegisterWebhookUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет регистрацию webhook
+func (uc *RegisterWebhookUseCase) Execute(ctx context.Context, input webhook.RegisterWebhookInput) (*webhook.RegisterWebhookOutput, error) {
+	if err := Authorize(ctx, entity.PermissionManageWebhooks); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// ListWebhooksUseCase оборачивает webhook.ListWebhooksUseCase проверкой права entity.PermissionManageWebhooks
+type ListWebhooksUseCase struct {
+	next *webhook.ListWebhooksUseCase
+}
+
+// NewListWebhooksUseCase создает use case с проверкой прав
+func NewListWebhooksUseCase(next *webhook.ListWebhooksUseCase) *ListWebhooksUseCase {
+	return &ListWebhooksUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и возвращает webhook организации
+func (uc *ListWebhooksUseCase) Execute(ctx context.Context, input webhook.ListWebhooksInput) (*webhook.ListWebhooksOutput, error) {
+	if err := Authorize(ctx, entity.PermissionManageWebhooks); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// RemoveWebhookUseCase оборачивает webhook.RemoveWebhookUseCase проверкой права entity.PermissionManageWebhooks
+type RemoveWebhookUseCase struct {
+	next *webhook.RemoveWebhookUseCase
+}
+
+// NewRemoveWebhookUseCase создает use case с проверкой прав
+func NewRemoveWebhookUseCase(next *webhook.RemoveWebhookUseCase) *RemoveWebhookUseCase {
+	return &RemoveWebhookUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и выполняет удаление webhook
+func (uc *RemoveWebhookUseCase) Execute(ctx context.Context, input webhook.RemoveWebhookInput) (*webhook.RemoveWebhookOutput, error) {
+	if err := Authorize(ctx, entity.PermissionManageWebhooks); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
+
+// ListDeliveriesUseCase оборачивает webhook.ListDeliveriesUseCase проверкой права entity.PermissionManageWebhooks
+type ListDeliveriesUseCase struct {
+	next *webhook.ListDeliveriesUseCase
+}
+
+// NewListDeliveriesUseCase создает use case с проверкой прав
+func NewListDeliveriesUseCase(next *webhook.ListDeliveriesUseCase) *ListDeliveriesUseCase {
+	return &ListDeliveriesUseCase{
+		next: next,
+	}
+}
+
+// Execute проверяет права и возвращает журнал доставки уведомлений
+func (uc *ListDeliveriesUseCase) Execute(ctx context.Context, input webhook.ListDeliveriesInput) (*webhook.ListDeliveriesOutput, error) {
+	if err := Authorize(ctx, entity.PermissionManageWebhooks); err != nil {
+		return nil, err
+	}
+
+	return uc.next.Execute(ctx, input)
+}
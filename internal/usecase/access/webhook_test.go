@@ -0,0 +1,110 @@
+package access_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/file"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterWebhookUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name     string
+		actor    *entity.User
+		wantErr  bool
+		checkErr func(*testing.T, error)
+	}{
+		{
+			name:    "admin registers webhook",
+			actor:   &entity.User{Login: "admin", Role: entity.RoleAdmin},
+			wantErr: false,
+		},
+		{
+			name:    "technician is denied",
+			actor:   &entity.User{Login: "tech", Role: entity.RoleTechnician},
+			wantErr: true,
+			checkErr: func(t *testing.T, err error) {
+				var permErr entity.PermissionError
+				require.ErrorAs(t, err, &permErr)
+				assert.Equal(t, entity.PermissionManageWebhooks, permErr.Permission)
+				assert.Equal(t, entity.RoleTechnician, permErr.Role)
+			},
+		},
+		{
+			name:    "reviewer is denied",
+			actor:   &entity.User{Login: "rev", Role: entity.RoleReviewer},
+			wantErr: true,
+			checkErr: func(t *testing.T, err error) {
+				var permErr entity.PermissionError
+				require.ErrorAs(t, err, &permErr)
+				assert.Equal(t, entity.PermissionManageWebhooks, permErr.Permission)
+			},
+		},
+		{
+			name:    "anonymous is not authenticated",
+			actor:   nil,
+			wantErr: true,
+			checkErr: func(t *testing.T, err error) {
+				var authErr entity.AuthenticationError
+				require.ErrorAs(t, err, &authErr)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			repo := file.NewJSONWebhookRepository(t.TempDir())
+			useCase := access.NewRegisterWebhookUseCase(webhook.NewRegisterWebhookUseCase(repo))
+			ctx := context.Background()
+			if tt.actor != nil {
+				ctx = access.WithActor(ctx, tt.actor)
+			}
+
+			// Act
+			output, err := useCase.Execute(ctx, webhook.RegisterWebhookInput{Organization: "ГУП БТИ", URL: "https://example.com/hooks"})
+
+			// Assert
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Nil(t, output)
+				tt.checkErr(t, err)
+
+				list, err := repo.List(context.Background(), "ГУП БТИ")
+				require.NoError(t, err)
+				assert.Empty(t, list)
+			} else {
+				require.NoError(t, err)
+				assert.NotEmpty(t, output.Webhook.ID)
+			}
+		})
+	}
+}
+
+func TestWebhookUseCases_RequireManageWebhooks(t *testing.T) {
+	repo := file.NewJSONWebhookRepository(t.TempDir())
+	tech := access.WithActor(context.Background(), &entity.User{Login: "tech", Role: entity.RoleTechnician})
+	admin := access.WithActor(context.Background(), &entity.User{Login: "admin", Role: entity.RoleAdmin})
+
+	list := access.NewListWebhooksUseCase(webhook.NewListWebhooksUseCase(repo))
+	remove := access.NewRemoveWebhookUseCase(webhook.NewRemoveWebhookUseCase(repo))
+	deliveries := access.NewListDeliveriesUseCase(webhook.NewListDeliveriesUseCase(repo))
+
+	var permErr entity.PermissionError
+	_, err := list.Execute(tech, webhook.ListWebhooksInput{})
+	require.ErrorAs(t, err, &permErr)
+	_, err = remove.Execute(tech, webhook.RemoveWebhookInput{WebhookID: "WH-1"})
+	require.ErrorAs(t, err, &permErr)
+	_, err = deliveries.Execute(tech, webhook.ListDeliveriesInput{})
+	require.ErrorAs(t, err, &permErr)
+
+	_, err = list.Execute(admin, webhook.ListWebhooksInput{})
+	require.NoError(t, err)
+	_, err = deliveries.Execute(admin, webhook.ListDeliveriesInput{})
+	require.NoError(t, err)
+}
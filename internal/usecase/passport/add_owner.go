@@ -0,0 +1,64 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// AddOwnerInput входные данные для добавления правообладателя
+type AddOwnerInput struct {
+	PassportID string
+	Owner      entity.Owner
+}
+
+// AddOwnerOutput результат добавления правообладателя
+type AddOwnerOutput struct {
+	Passport *entity.TechnicalPassport
+}
+
+// AddOwnerUseCase use case для добавления правообладателя в паспорт
+type AddOwnerUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewAddOwnerUseCase создает новый use case
+func NewAddOwnerUseCase(repo repository.PassportRepository) *AddOwnerUseCase {
+	return &AddOwnerUseCase{
+		repo: repo,
+	}
+}
+
+// Execute выполняет добавление правообладателя
+func (uc *AddOwnerUseCase) Execute(ctx context.Context, input AddOwnerInput) (*AddOwnerOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if err := input.Owner.IsValid(); err != nil {
+		return nil, fmt.Errorf("invalid owner data: %w", err)
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	// Добавляем правообладатель
+	if err := passport.AddOwner(input.Owner); err != nil {
+		return nil, fmt.Errorf("failed to add owner: %w", err)
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &AddOwnerOutput{
+		Passport: passport,
+	}, nil
+}
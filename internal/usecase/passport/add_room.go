@@ -0,0 +1,64 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// AddRoomInput входные данные для добавления помещения
+type AddRoomInput struct {
+	PassportID string
+	Room       entity.Room
+}
+
+// AddRoomOutput результат добавления помещения
+type AddRoomOutput struct {
+	Passport *entity.TechnicalPassport
+}
+
+// AddRoomUseCase use case для добавления помещения в паспорт
+type AddRoomUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewAddRoomUseCase создает новый use case
+func NewAddRoomUseCase(repo repository.PassportRepository) *AddRoomUseCase {
+	return &AddRoomUseCase{
+		repo: repo,
+	}
+}
+
+// Execute выполняет добавление помещения
+func (uc *AddRoomUseCase) Execute(ctx context.Context, input AddRoomInput) (*AddRoomOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if err := input.Room.IsValid(); err != nil {
+		return nil, fmt.Errorf("invalid room data: %w", err)
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	// Добавляем помещение
+	if err := passport.AddRoom(input.Room); err != nil {
+		return nil, fmt.Errorf("failed to add room: %w", err)
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &AddRoomOutput{
+		Passport: passport,
+	}, nil
+}
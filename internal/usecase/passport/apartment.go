@@ -0,0 +1,364 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// SetEntrancesInput входные данные для замены списка подъездов
+type SetEntrancesInput struct {
+	PassportID string
+	Entrances  []entity.Entrance
+}
+
+// SetEntrancesOutput результат замены списка подъездов
+type SetEntrancesOutput struct {
+	Passport *entity.TechnicalPassport
+}
+
+// SetEntrancesUseCase use case для замены списка подъездов многоквартирного дома
+type SetEntrancesUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewSetEntrancesUseCase создает новый use case
+func NewSetEntrancesUseCase(repo repository.PassportRepository) *SetEntrancesUseCase {
+	return &SetEntrancesUseCase{
+		repo: repo,
+	}
+}
+
+// Execute заменяет список подъездов
+func (uc *SetEntrancesUseCase) Execute(ctx context.Context, input SetEntrancesInput) (*SetEntrancesOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	if err := passport.SetEntrances(input.Entrances); err != nil {
+		return nil, err
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &SetEntrancesOutput{
+		Passport: passport,
+	}, nil
+}
+
+// SetFlatInput входные данные для сохранения квартиры
+type SetFlatInput struct {
+	PassportID string
+	Flat       entity.Flat
+}
+
+// SetFlatOutput результат сохранения квартиры
+type SetFlatOutput struct {
+	Passport *entity.TechnicalPassport
+
+	// Replaced квартира с тем же номером была заменена
+	Replaced bool
+}
+
+// SetFlatUseCase use case для добавления или замены квартиры многоквартирного дома
+type SetFlatUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewSetFlatUseCase создает новый use case
+func NewSetFlatUseCase(repo repository.PassportRepository) *SetFlatUseCase {
+	return &SetFlatUseCase{
+		repo: repo,
+	}
+}
+
+// Execute сохраняет квартиру; квартира с тем же номером заменяется
+func (uc *SetFlatUseCase) Execute(ctx context.Context, input SetFlatInput) (*SetFlatOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	replaced, err := passport.SetFlat(input.Flat)
+	if err != nil {
+		return nil, err
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &SetFlatOutput{
+		Passport: passport,
+		Replaced: replaced,
+	}, nil
+}
+
+// RemoveFlatInput входные данные для удаления квартиры
+type RemoveFlatInput struct {
+	PassportID string
+	Number     string
+}
+
+// RemoveFlatOutput результат удаления квартиры
+type RemoveFlatOutput struct {
+	Passport *entity.TechnicalPassport
+}
+
+// RemoveFlatUseCase use case для удаления квартиры
+type RemoveFlatUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewRemoveFlatUseCase создает новый use case
+func NewRemoveFlatUseCase(repo repository.PassportRepository) *RemoveFlatUseCase {
+	return &RemoveFlatUseCase{
+		repo: repo,
+	}
+}
+
+// Execute удаляет квартиру по номеру
+func (uc *RemoveFlatUseCase) Execute(ctx context.Context, input RemoveFlatInput) (*RemoveFlatOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if input.Number == "" {
+		return nil, entity.ValidationError{Field: "number", Message: "номер квартиры обязателен"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	if err := passport.RemoveFlat(input.Number); err != nil {
+		return nil, err
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &RemoveFlatOutput{
+		Passport: passport,
+	}, nil
+}
+
+// SetCommonAreasInput входные данные для замены помещений общего имущества
+type SetCommonAreasInput struct {
+	PassportID string
+	Rooms      []entity.Room
+}
+
+// SetCommonAreasOutput результат замены помещений общего имущества
+type SetCommonAreasOutput struct {
+	Passport *entity.TechnicalPassport
+}
+
+// SetCommonAreasUseCase use case для замены экспликации помещений общего имущества
+type SetCommonAreasUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewSetCommonAreasUseCase создает новый use case
+func NewSetCommonAreasUseCase(repo repository.PassportRepository) *SetCommonAreasUseCase {
+	return &SetCommonAreasUseCase{
+		repo: repo,
+	}
+}
+
+// Execute заменяет помещения общего имущества
+func (uc *SetCommonAreasUseCase) Execute(ctx context.Context, input SetCommonAreasInput) (*SetCommonAreasOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	if err := passport.SetCommonAreas(input.Rooms); err != nil {
+		return nil, err
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &SetCommonAreasOutput{
+		Passport: passport,
+	}, nil
+}
+
+// ApartmentTotalsInput входные данные для расчета сводных показателей
+type ApartmentTotalsInput struct {
+	PassportID string
+}
+
+// ApartmentTotalsOutput сводные показатели многоквартирного дома
+type ApartmentTotalsOutput struct {
+	Passport *entity.TechnicalPassport
+	Totals   entity.ApartmentTotals
+}
+
+// ApartmentTotalsUseCase use case для расчета площадей квартир и общего имущества
+type ApartmentTotalsUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewApartmentTotalsUseCase создает новый use case
+func NewApartmentTotalsUseCase(repo repository.PassportRepository) *ApartmentTotalsUseCase {
+	return &ApartmentTotalsUseCase{
+		repo: repo,
+	}
+}
+
+// Execute рассчитывает сводные показатели, не изменяя паспорт
+func (uc *ApartmentTotalsUseCase) Execute(ctx context.Context, input ApartmentTotalsInput) (*ApartmentTotalsOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	if passport.ObjectType != entity.ObjectTypeApartmentBuilding {
+		return nil, entity.ValidationError{Field: "object_type", Message: "паспорт составлен не на многоквартирный дом"}
+	}
+
+	return &ApartmentTotalsOutput{
+		Passport: passport,
+		Totals:   passport.ApartmentTotals(),
+	}, nil
+}
+
+// ExportFlatExtractsInput входные данные для выгрузки выписок на квартиры
+type ExportFlatExtractsInput struct {
+	PassportID string
+	Format     service.DocumentFormat
+
+	// Flats номера квартир; пустой список - выписки на все квартиры
+	Flats []string
+}
+
+// FlatExtract выписка из технического паспорта на одну квартиру
+type FlatExtract struct {
+	Number   string
+	Document []byte
+
+	// FileName рекомендуемое имя файла
+	FileName string
+}
+
+// ExportFlatExtractsOutput результат выгрузки выписок
+type ExportFlatExtractsOutput struct {
+	Extracts []FlatExtract
+}
+
+// ExportFlatExtractsUseCase use case для выгрузки выписок из паспорта
+// многоквартирного дома на отдельные квартиры
+type ExportFlatExtractsUseCase struct {
+	repo      repository.PassportRepository
+	generator service.DocumentGenerator
+}
+
+// NewExportFlatExtractsUseCase создает новый use case
+func NewExportFlatExtractsUseCase(repo repository.PassportRepository, generator service.DocumentGenerator) *ExportFlatExtractsUseCase {
+	return &ExportFlatExtractsUseCase{
+		repo:      repo,
+		generator: generator,
+	}
+}
+
+// flatFileNames заменяет символы номера квартиры, недопустимые в имени файла
+var flatFileNames = strings.NewReplacer("/", "-", "\\", "-", " ", "")
+
+// Execute формирует выписки на квартиры
+func (uc *ExportFlatExtractsUseCase) Execute(ctx context.Context, input ExportFlatExtractsInput) (*ExportFlatExtractsOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if input.Format != service.FormatPDF && input.Format != service.FormatDOCX {
+		return nil, entity.ValidationError{Field: "format", Message: "поддерживаются форматы pdf и docx"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	if err := passport.IsValid(); err != nil {
+		return nil, err
+	}
+
+	numbers := input.Flats
+	if len(numbers) == 0 {
+		for _, f := range passport.Flats {
+			numbers = append(numbers, f.Number)
+		}
+	}
+	if len(numbers) == 0 {
+		return nil, entity.ValidationError{Field: "flats", Message: "в паспорте нет квартир"}
+	}
+
+	output := &ExportFlatExtractsOutput{}
+	for _, number := range numbers {
+		flat := passport.FindFlat(number)
+		if flat == nil {
+			return nil, entity.ValidationError{Field: "flats", Message: "квартира " + number + " не найдена"}
+		}
+
+		document, err := uc.generator.Generate(ctx, passport, service.GenerateOptions{
+			Format:        input.Format,
+			IncludeImages: true,
+			Flat:          flat.Number,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate extract for flat %s: %w", flat.Number, err)
+		}
+
+		output.Extracts = append(output.Extracts, FlatExtract{
+			Number:   flat.Number,
+			Document: document,
+			FileName: passport.ID + "-kv" + flatFileNames.Replace(flat.Number) + "." + string(input.Format),
+		})
+	}
+
+	return output, nil
+}
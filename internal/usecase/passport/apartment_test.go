@@ -0,0 +1,154 @@
+package passport_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// apartmentPassport паспорт многоквартирного дома с двумя подъездами
+func apartmentPassport(t *testing.T, repo *memory.InMemoryPassportRepository) *entity.TechnicalPassport {
+	p := entity.NewTechnicalPassport(entity.ObjectTypeApartmentBuilding, entity.Address{Subject: "г. Москва", House: "5"})
+	p.ID = "TP-MKD"
+	p.OrganizationName = "ГУП БТИ"
+	p.GeneralInfo = entity.GeneralInfo{Purpose: "Многоквартирный жилой дом", ConstructionYear: 1975, TotalArea: 150, LivingArea: 60}
+	p.Buildings = []entity.Building{{Litera: "А", Name: "Жилой дом", CommissionYear: 1975, WallMaterial: "Кирпич", TotalArea: 150}}
+	require.NoError(t, repo.Create(context.Background(), p))
+
+	_, err := passport.NewSetEntrancesUseCase(repo).Execute(context.Background(), passport.SetEntrancesInput{
+		PassportID: p.ID,
+		Entrances:  []entity.Entrance{{Number: "1", Litera: "А", Floors: 5}, {Number: "2", Litera: "А", Floors: 5}},
+	})
+	require.NoError(t, err)
+	return p
+}
+
+// flatRooms экспликация однокомнатной квартиры
+func flatRooms(living float64) []entity.Room {
+	return []entity.Room{
+		{RoomNumber: "1", Purpose: "Жилая комната", Area: living, LivingArea: living},
+		{RoomNumber: "2", Purpose: "Кухня", Area: 8.5, AuxiliaryArea: 8.5},
+		{RoomNumber: "3", Purpose: "Санузел", Area: 3.2, AuxiliaryArea: 3.2},
+	}
+}
+
+func TestApartmentUseCases(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	p := apartmentPassport(t, repo)
+	setFlat := passport.NewSetFlatUseCase(repo)
+
+	for _, flat := range []entity.Flat{
+		{Number: "10", Litera: "А", Entrance: "2", Floor: "1", Explication: flatRooms(20)},
+		{Number: "2", Litera: "А", Entrance: "1", Floor: "1", Explication: flatRooms(18.3)},
+	} {
+		output, err := setFlat.Execute(ctx, passport.SetFlatInput{PassportID: p.ID, Flat: flat})
+		require.NoError(t, err)
+		assert.False(t, output.Replaced)
+	}
+
+	saved, err := repo.GetByID(ctx, p.ID)
+	require.NoError(t, err)
+	require.Len(t, saved.Flats, 2)
+	assert.Equal(t, "2", saved.Flats[0].Number, "квартиры упорядочены по номеру")
+	assert.Equal(t, "А", saved.Flats[0].Explication[0].Litera, "литера помещений берется из квартиры")
+	assert.Equal(t, "1", saved.Flats[0].Explication[0].Floor)
+
+	// Замена квартиры с тем же номером
+	output, err := setFlat.Execute(ctx, passport.SetFlatInput{
+		PassportID: p.ID,
+		Flat:       entity.Flat{Number: "10", Litera: "А", Entrance: "2", Floor: "2", Explication: flatRooms(21)},
+	})
+	require.NoError(t, err)
+	assert.True(t, output.Replaced)
+
+	_, err = passport.NewSetCommonAreasUseCase(repo).Execute(ctx, passport.SetCommonAreasInput{
+		PassportID: p.ID,
+		Rooms:      []entity.Room{{Litera: "А", Floor: "1", RoomNumber: "I", Purpose: "Лестничная клетка", Area: 24.6}},
+	})
+	require.NoError(t, err)
+
+	totals, err := passport.NewApartmentTotalsUseCase(repo).Execute(ctx, passport.ApartmentTotalsInput{PassportID: p.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 2, totals.Totals.Flats)
+	assert.Equal(t, 2, totals.Totals.LivingRooms)
+	assert.Equal(t, 62.7, totals.Totals.FlatsArea) // 18,3 + 21 + 2 x (8,5 + 3,2)
+	assert.Equal(t, 39.3, totals.Totals.LivingArea)
+	assert.Equal(t, 24.6, totals.Totals.CommonArea)
+	assert.Equal(t, 87.3, totals.Totals.TotalArea)
+	assert.Equal(t, []entity.EntranceTotals{
+		{Entrance: "1", Flats: 1, FlatsArea: 30, LivingArea: 18.3},
+		{Entrance: "2", Flats: 1, FlatsArea: 32.7, LivingArea: 21},
+	}, totals.Totals.Entrances)
+
+	// Общие сведения не совпадают с итогами по квартирам
+	validated, err := passport.NewValidatePassportUseCase(repo).Execute(ctx, passport.ValidatePassportInput{PassportID: p.ID})
+	require.NoError(t, err)
+	assert.True(t, validated.Result.Valid)
+	assert.Contains(t, validated.Result.Warnings, "общая площадь 150.0 кв.м не совпадает с суммой площадей квартир и общего имущества 87.3 кв.м")
+	assert.Contains(t, validated.Result.Warnings, "жилая площадь 60.0 кв.м не совпадает с жилой площадью квартир 39.3 кв.м")
+
+	// Подъезд с квартирами удалить нельзя
+	_, err = passport.NewSetEntrancesUseCase(repo).Execute(ctx, passport.SetEntrancesInput{
+		PassportID: p.ID,
+		Entrances:  []entity.Entrance{{Number: "1", Litera: "А"}},
+	})
+	var validationErr entity.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "flats[1]", validationErr.Field)
+
+	_, err = passport.NewRemoveFlatUseCase(repo).Execute(ctx, passport.RemoveFlatInput{PassportID: p.ID, Number: "10"})
+	require.NoError(t, err)
+	saved, err = repo.GetByID(ctx, p.ID)
+	require.NoError(t, err)
+	assert.Len(t, saved.Flats, 1)
+	assert.Equal(t, "remove_flat", saved.AuditLog[len(saved.AuditLog)-1].Action)
+}
+
+func TestSetFlatUseCase_Invalid(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	p := apartmentPassport(t, repo)
+	uc := passport.NewSetFlatUseCase(repo)
+
+	tests := []struct {
+		name  string
+		flat  entity.Flat
+		field string
+	}{
+		{name: "no number", flat: entity.Flat{Litera: "А", Floor: "1"}, field: "number"},
+		{name: "no floor", flat: entity.Flat{Number: "1", Litera: "А"}, field: "floor"},
+		{name: "unknown entrance", flat: entity.Flat{Number: "1", Litera: "А", Floor: "1", Entrance: "3"}, field: "entrance"},
+		{
+			name:  "invalid room",
+			flat:  entity.Flat{Number: "1", Litera: "А", Floor: "1", Explication: []entity.Room{{RoomNumber: "1", Purpose: "Кухня"}}},
+			field: "explication[0]",
+		},
+		{
+			name:  "room listed twice",
+			flat:  entity.Flat{Number: "1", Litera: "А", Floor: "1", Explication: append(flatRooms(15), flatRooms(15)[0])},
+			field: "explication[3]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.Execute(ctx, passport.SetFlatInput{PassportID: p.ID, Flat: tt.flat})
+			var validationErr entity.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+
+	// Квартиры описываются только в паспорте многоквартирного дома
+	house := planPassport(t, repo)
+	_, err := uc.Execute(ctx, passport.SetFlatInput{PassportID: house.ID, Flat: entity.Flat{Number: "1", Litera: "А", Floor: "1"}})
+	var validationErr entity.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "flats", validationErr.Field)
+}
@@ -0,0 +1,59 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// ApprovePassportInput входные данные для утверждения паспорта
+type ApprovePassportInput struct {
+	PassportID string
+}
+
+// ApprovePassportOutput результат утверждения паспорта
+type ApprovePassportOutput struct {
+	Passport *entity.TechnicalPassport
+}
+
+// ApprovePassportUseCase use case для утверждения паспорта
+type ApprovePassportUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewApprovePassportUseCase создает новый use case
+func NewApprovePassportUseCase(repo repository.PassportRepository) *ApprovePassportUseCase {
+	return &ApprovePassportUseCase{
+		repo: repo,
+	}
+}
+
+// Execute выполняет утверждение паспорта
+func (uc *ApprovePassportUseCase) Execute(ctx context.Context, input ApprovePassportInput) (*ApprovePassportOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	// Меняем статус
+	if err := passport.Approve(); err != nil {
+		return nil, fmt.Errorf("failed to approve passport: %w", err)
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &ApprovePassportOutput{
+		Passport: passport,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// ArchivePassportInput входные данные для перевода паспорта в архив
+type ArchivePassportInput struct {
+	PassportID string
+}
+
+// ArchivePassportOutput результат перевода паспорта в архив
+type ArchivePassportOutput struct {
+	Passport *entity.TechnicalPassport
+}
+
+// ArchivePassportUseCase use case для перевода паспорта в архив
+type ArchivePassportUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewArchivePassportUseCase создает новый use case
+func NewArchivePassportUseCase(repo repository.PassportRepository) *ArchivePassportUseCase {
+	return &ArchivePassportUseCase{
+		repo: repo,
+	}
+}
+
+// Execute выполняет перевод паспорта в архив
+func (uc *ArchivePassportUseCase) Execute(ctx context.Context, input ArchivePassportInput) (*ArchivePassportOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	// Меняем статус
+	if err := passport.Archive(); err != nil {
+		return nil, fmt.Errorf("failed to archive passport: %w", err)
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &ArchivePassportOutput{
+		Passport: passport,
+	}, nil
+}
@@ -0,0 +1,338 @@
+package passport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// MaxAttachmentSize наибольший размер вложения (байт)
+const MaxAttachmentSize = 50 << 20
+
+// storeAttachment сохраняет содержимое и миниатюру изображения. Миниатюра не
+// обязательна: поврежденное изображение сохраняется без нее.
+func storeAttachment(ctx context.Context, attachments repository.AttachmentRepository, inspector service.AttachmentInspector, data []byte, mimeType string) (id string, thumbnail bool, err error) {
+	id, err = attachments.Save(ctx, data)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to save attachment: %w", err)
+	}
+
+	preview, err := inspector.Thumbnail(ctx, data, mimeType)
+	if err != nil || preview == nil {
+		return id, false, nil
+	}
+	if err := attachments.SaveThumbnail(ctx, id, preview); err != nil {
+		return "", false, fmt.Errorf("failed to save thumbnail: %w", err)
+	}
+
+	return id, true, nil
+}
+
+// AddAttachmentInput входные данные для добавления вложения
+type AddAttachmentInput struct {
+	PassportID string
+
+	// FileName имя исходного файла (путь, в том числе Windows, отбрасывается)
+	FileName string
+
+	Data        []byte
+	Description string
+
+	// Target раздел паспорта; пустой - паспорт в целом
+	Target entity.AttachmentTarget
+}
+
+// AddAttachmentOutput результат добавления вложения
+type AddAttachmentOutput struct {
+	Passport   *entity.TechnicalPassport
+	Attachment entity.Attachment
+
+	// Duplicate такое же содержимое уже было в паспорте; добавлена только связь
+	Duplicate bool
+}
+
+// AddAttachmentUseCase use case для добавления скана, фотографии или другого файла
+// к паспорту со связью с правообладателем, зданием или планом
+type AddAttachmentUseCase struct {
+	repo        repository.PassportRepository
+	attachments repository.AttachmentRepository
+	inspector   service.AttachmentInspector
+}
+
+// NewAddAttachmentUseCase создает новый use case
+func NewAddAttachmentUseCase(repo repository.PassportRepository, attachments repository.AttachmentRepository, inspector service.AttachmentInspector) *AddAttachmentUseCase {
+	return &AddAttachmentUseCase{
+		repo:        repo,
+		attachments: attachments,
+		inspector:   inspector,
+	}
+}
+
+// Execute сохраняет содержимое и связывает вложение с разделом паспорта
+func (uc *AddAttachmentUseCase) Execute(ctx context.Context, input AddAttachmentInput) (*AddAttachmentOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	fileName := path.Base(strings.ReplaceAll(input.FileName, `\`, "/"))
+	if strings.TrimSpace(input.FileName) == "" || fileName == "." || fileName == "/" {
+		return nil, entity.ValidationError{Field: "file_name", Message: "имя файла обязательно"}
+	}
+
+	if len(input.Data) == 0 {
+		return nil, entity.ValidationError{Field: "data", Message: "файл пуст"}
+	}
+
+	if len(input.Data) > MaxAttachmentSize {
+		return nil, entity.ValidationError{Field: "data", Message: fmt.Sprintf("файл больше %d МБ", MaxAttachmentSize>>20)}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	attachment := entity.Attachment{
+		ID:          entity.AttachmentID(input.Data),
+		FileName:    fileName,
+		MIMEType:    uc.inspector.DetectMIME(input.Data, fileName),
+		Size:        int64(len(input.Data)),
+		Description: input.Description,
+		AddedDate:   time.Now(),
+	}
+
+	// Раздел проверяется до записи содержимого в хранилище
+	if err := passport.CheckAttachmentTarget(input.Target); err != nil {
+		return nil, err
+	}
+
+	// Повторно добавленный файл сохраняет исходное описание
+	if existing := passport.FindAttachment(attachment.ID); existing != nil {
+		attachment = *existing
+	} else if _, attachment.Thumbnail, err = storeAttachment(ctx, uc.attachments, uc.inspector, input.Data, attachment.MIMEType); err != nil {
+		return nil, err
+	}
+
+	added, err := passport.AttachFile(attachment, input.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &AddAttachmentOutput{
+		Passport:   passport,
+		Attachment: attachment,
+		Duplicate:  !added,
+	}, nil
+}
+
+// AttachmentInfo вложение и разделы паспорта, с которыми оно связано
+type AttachmentInfo struct {
+	entity.Attachment
+	Targets []entity.AttachmentTarget `json:"targets"`
+}
+
+// ListAttachmentsInput входные данные для списка вложений
+type ListAttachmentsInput struct {
+	PassportID string
+}
+
+// ListAttachmentsOutput вложения паспорта
+type ListAttachmentsOutput struct {
+	Attachments []AttachmentInfo
+}
+
+// ListAttachmentsUseCase use case для списка вложений паспорта со связями
+type ListAttachmentsUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewListAttachmentsUseCase создает новый use case
+func NewListAttachmentsUseCase(repo repository.PassportRepository) *ListAttachmentsUseCase {
+	return &ListAttachmentsUseCase{
+		repo: repo,
+	}
+}
+
+// Execute возвращает вложения паспорта в порядке добавления
+func (uc *ListAttachmentsUseCase) Execute(ctx context.Context, input ListAttachmentsInput) (*ListAttachmentsOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	output := &ListAttachmentsOutput{Attachments: []AttachmentInfo{}}
+	for _, a := range passport.Attachments {
+		output.Attachments = append(output.Attachments, AttachmentInfo{Attachment: a, Targets: passport.AttachmentTargets(a.ID)})
+	}
+
+	return output, nil
+}
+
+// GetAttachmentInput входные данные для получения содержимого вложения
+type GetAttachmentInput struct {
+	PassportID   string
+	AttachmentID string
+
+	// Thumbnail вернуть миниатюру вместо содержимого
+	Thumbnail bool
+}
+
+// GetAttachmentOutput содержимое вложения
+type GetAttachmentOutput struct {
+	Attachment entity.Attachment
+	Data       []byte
+}
+
+// GetAttachmentUseCase use case для получения содержимого вложения паспорта
+type GetAttachmentUseCase struct {
+	repo        repository.PassportRepository
+	attachments repository.AttachmentRepository
+}
+
+// NewGetAttachmentUseCase создает новый use case
+func NewGetAttachmentUseCase(repo repository.PassportRepository, attachments repository.AttachmentRepository) *GetAttachmentUseCase {
+	return &GetAttachmentUseCase{
+		repo:        repo,
+		attachments: attachments,
+	}
+}
+
+// Execute возвращает содержимое вложения. Вложение ищется среди вложений
+// паспорта, чтобы по ID нельзя было получить файл другого паспорта.
+func (uc *GetAttachmentUseCase) Execute(ctx context.Context, input GetAttachmentInput) (*GetAttachmentOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if input.AttachmentID == "" {
+		return nil, entity.ValidationError{Field: "attachment_id", Message: "ID вложения обязателен"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	attachment := passport.FindAttachment(input.AttachmentID)
+	if attachment == nil {
+		return nil, entity.ValidationError{Field: "attachment_id", Message: "вложение не найдено"}
+	}
+
+	if input.Thumbnail {
+		if !attachment.Thumbnail {
+			return nil, entity.ValidationError{Field: "thumbnail", Message: "для вложения " + attachment.FileName + " нет миниатюры"}
+		}
+		data, err := uc.attachments.LoadThumbnail(ctx, attachment.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load thumbnail: %w", err)
+		}
+		return &GetAttachmentOutput{Attachment: *attachment, Data: data}, nil
+	}
+
+	data, err := uc.attachments.Load(ctx, attachment.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load attachment: %w", err)
+	}
+
+	return &GetAttachmentOutput{Attachment: *attachment, Data: data}, nil
+}
+
+// RemoveAttachmentInput входные данные для удаления вложения
+type RemoveAttachmentInput struct {
+	PassportID   string
+	AttachmentID string
+}
+
+// RemoveAttachmentOutput результат удаления вложения
+type RemoveAttachmentOutput struct {
+	Passport *entity.TechnicalPassport
+
+	// ContentDeleted содержимое удалено из хранилища: других ссылок на него нет
+	ContentDeleted bool
+}
+
+// RemoveAttachmentUseCase use case для удаления вложения из паспорта
+type RemoveAttachmentUseCase struct {
+	repo        repository.PassportRepository
+	attachments repository.AttachmentRepository
+}
+
+// NewRemoveAttachmentUseCase создает новый use case
+func NewRemoveAttachmentUseCase(repo repository.PassportRepository, attachments repository.AttachmentRepository) *RemoveAttachmentUseCase {
+	return &RemoveAttachmentUseCase{
+		repo:        repo,
+		attachments: attachments,
+	}
+}
+
+// Execute удаляет вложение со всеми связями. Содержимое удаляется из хранилища,
+// только если тот же файл не приложен к другим паспортам.
+func (uc *RemoveAttachmentUseCase) Execute(ctx context.Context, input RemoveAttachmentInput) (*RemoveAttachmentOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if input.AttachmentID == "" {
+		return nil, entity.ValidationError{Field: "attachment_id", Message: "ID вложения обязателен"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	if err := passport.RemoveAttachment(input.AttachmentID); err != nil {
+		return nil, err
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	output := &RemoveAttachmentOutput{Passport: passport}
+
+	passports, err := uc.repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list passports: %w", err)
+	}
+	for _, p := range passports {
+		if p.FindAttachment(input.AttachmentID) != nil {
+			return output, nil
+		}
+	}
+
+	err = uc.attachments.Delete(ctx, input.AttachmentID)
+	switch {
+	case err == nil:
+		output.ContentDeleted = true
+	case !errors.Is(err, repository.ErrNotFound):
+		return nil, fmt.Errorf("failed to delete attachment: %w", err)
+	}
+
+	return output, nil
+}
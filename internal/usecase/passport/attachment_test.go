@@ -0,0 +1,136 @@
+package passport_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/attachment"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPNG(t *testing.T, width, height int) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height))))
+	return buf.Bytes()
+}
+
+func TestAddAttachmentUseCase(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	attachments := memory.NewInMemoryAttachmentRepository()
+
+	p := newInterchangePassport("TP-1", "ГУП БТИ")
+	p.Owners = []entity.Owner{{PersonType: entity.PersonTypeIndividual, FullName: "Иванов И.И."}}
+	require.NoError(t, repo.Create(ctx, p))
+
+	uc := passport.NewAddAttachmentUseCase(repo, attachments, attachment.NewInspector())
+	photo := newPNG(t, 512, 128)
+
+	_, err := uc.Execute(ctx, passport.AddAttachmentInput{
+		PassportID: "TP-1", FileName: "photo.png", Data: photo,
+		Target: entity.AttachmentTarget{Kind: entity.AttachmentTargetOwner, OwnerIndex: 1},
+	})
+	require.Error(t, err)
+	exists, err := attachments.Exists(ctx, entity.AttachmentID(photo))
+	require.NoError(t, err)
+	assert.False(t, exists, "содержимое не сохраняется для несуществующего раздела")
+
+	output, err := uc.Execute(ctx, passport.AddAttachmentInput{
+		PassportID: "TP-1", FileName: `C:\Сканы\photo.png`, Data: photo, Description: "Фасад",
+	})
+	require.NoError(t, err)
+	assert.False(t, output.Duplicate)
+	assert.Equal(t, "photo.png", output.Attachment.FileName)
+	assert.Equal(t, "image/png", output.Attachment.MIMEType)
+	assert.Equal(t, int64(len(photo)), output.Attachment.Size)
+	assert.True(t, output.Attachment.Thumbnail)
+
+	// Повторное добавление того же файла только связывает его с правообладателем
+	output, err = uc.Execute(ctx, passport.AddAttachmentInput{
+		PassportID: "TP-1", FileName: "copy.png", Data: photo,
+		Target: entity.AttachmentTarget{Kind: entity.AttachmentTargetOwner},
+	})
+	require.NoError(t, err)
+	assert.True(t, output.Duplicate)
+	assert.Equal(t, "photo.png", output.Attachment.FileName)
+	assert.Equal(t, "Фасад", output.Attachment.Description)
+
+	list, err := passport.NewListAttachmentsUseCase(repo).Execute(ctx, passport.ListAttachmentsInput{PassportID: "TP-1"})
+	require.NoError(t, err)
+	require.Len(t, list.Attachments, 1)
+	assert.Equal(t, []entity.AttachmentTarget{{Kind: entity.AttachmentTargetOwner}}, list.Attachments[0].Targets)
+
+	get := passport.NewGetAttachmentUseCase(repo, attachments)
+	content, err := get.Execute(ctx, passport.GetAttachmentInput{PassportID: "TP-1", AttachmentID: output.Attachment.ID})
+	require.NoError(t, err)
+	assert.Equal(t, photo, content.Data)
+
+	thumbnail, err := get.Execute(ctx, passport.GetAttachmentInput{PassportID: "TP-1", AttachmentID: output.Attachment.ID, Thumbnail: true})
+	require.NoError(t, err)
+	config, err := png.DecodeConfig(bytes.NewReader(thumbnail.Data))
+	require.NoError(t, err)
+	assert.Equal(t, 256, config.Width)
+	assert.Equal(t, 64, config.Height)
+}
+
+func TestAddAttachmentUseCase_Validation(t *testing.T) {
+	tests := []struct {
+		name  string
+		input passport.AddAttachmentInput
+		field string
+	}{
+		{"no passport", passport.AddAttachmentInput{FileName: "a.pdf", Data: []byte("%PDF")}, "passport_id"},
+		{"no file name", passport.AddAttachmentInput{PassportID: "TP-1", FileName: " ", Data: []byte("%PDF")}, "file_name"},
+		{"empty file", passport.AddAttachmentInput{PassportID: "TP-1", FileName: "a.pdf"}, "data"},
+		{"too large", passport.AddAttachmentInput{PassportID: "TP-1", FileName: "a.pdf", Data: make([]byte, passport.MaxAttachmentSize+1)}, "data"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := passport.NewAddAttachmentUseCase(memory.NewInMemoryPassportRepository(), memory.NewInMemoryAttachmentRepository(), attachment.NewInspector())
+			_, err := uc.Execute(context.Background(), tt.input)
+
+			var validationErr entity.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+}
+
+func TestRemoveAttachmentUseCase_KeepsSharedContent(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	attachments := memory.NewInMemoryAttachmentRepository()
+	require.NoError(t, repo.Create(ctx, newInterchangePassport("TP-1", "ГУП БТИ")))
+	require.NoError(t, repo.Create(ctx, newInterchangePassport("TP-2", "ГУП БТИ")))
+
+	add := passport.NewAddAttachmentUseCase(repo, attachments, attachment.NewInspector())
+	scan := []byte("%PDF-1.4 свидетельство")
+	for _, id := range []string{"TP-1", "TP-2"} {
+		_, err := add.Execute(ctx, passport.AddAttachmentInput{PassportID: id, FileName: "right.pdf", Data: scan})
+		require.NoError(t, err)
+	}
+
+	remove := passport.NewRemoveAttachmentUseCase(repo, attachments)
+	id := entity.AttachmentID(scan)
+
+	output, err := remove.Execute(ctx, passport.RemoveAttachmentInput{PassportID: "TP-1", AttachmentID: id})
+	require.NoError(t, err)
+	assert.False(t, output.ContentDeleted, "файл приложен к TP-2")
+	assert.Empty(t, output.Passport.Attachments)
+
+	output, err = remove.Execute(ctx, passport.RemoveAttachmentInput{PassportID: "TP-2", AttachmentID: id})
+	require.NoError(t, err)
+	assert.True(t, output.ContentDeleted)
+
+	exists, err := attachments.Exists(ctx, id)
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
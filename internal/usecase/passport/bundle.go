@@ -0,0 +1,170 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// ExportBundleUseCase use case для выгрузки паспортов в архив формата обмена
+// вместе с содержимым вложений
+type ExportBundleUseCase struct {
+	export      *ExportInterchangeUseCase
+	attachments repository.AttachmentRepository
+	bundle      service.InterchangeBundle
+}
+
+// NewExportBundleUseCase создает новый use case
+func NewExportBundleUseCase(repo repository.PassportRepository, attachments repository.AttachmentRepository, codec service.InterchangeCodec, bundle service.InterchangeBundle) *ExportBundleUseCase {
+	return &ExportBundleUseCase{
+		export:      NewExportInterchangeUseCase(repo, codec),
+		attachments: attachments,
+		bundle:      bundle,
+	}
+}
+
+// Execute выполняет выгрузку: документ обмена и файлы вложений всех выгружаемых паспортов
+func (uc *ExportBundleUseCase) Execute(ctx context.Context, input ExportInterchangeInput) (*ExportInterchangeOutput, error) {
+	passports, err := uc.export.passports(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := uc.export.codec.Encode(ctx, passports)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode passports: %w", err)
+	}
+
+	contents := map[string][]byte{}
+	for _, passport := range passports {
+		for _, a := range passport.Attachments {
+			if _, ok := contents[a.ID]; ok {
+				continue
+			}
+			data, err := uc.attachments.Load(ctx, a.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load attachment %s of passport %s: %w", a.FileName, passport.ID, err)
+			}
+			contents[a.ID] = data
+		}
+	}
+
+	data, err := uc.bundle.Pack(ctx, document, contents)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack bundle: %w", err)
+	}
+
+	return &ExportInterchangeOutput{
+		Data:     data,
+		Count:    len(passports),
+		FileName: exportFileName(passports, ".techpassport.zip"),
+	}, nil
+}
+
+// ImportBundleUseCase use case для загрузки паспортов из архива формата обмена
+// с вложениями или из документа JSON, вложения которого уже есть в хранилище
+type ImportBundleUseCase struct {
+	importer    *ImportInterchangeUseCase
+	attachments repository.AttachmentRepository
+	inspector   service.AttachmentInspector
+	bundle      service.InterchangeBundle
+}
+
+// NewImportBundleUseCase создает новый use case
+func NewImportBundleUseCase(repo repository.PassportRepository, attachments repository.AttachmentRepository, codec service.InterchangeCodec, bundle service.InterchangeBundle, inspector service.AttachmentInspector) *ImportBundleUseCase {
+	return &ImportBundleUseCase{
+		importer:    NewImportInterchangeUseCase(repo, codec),
+		attachments: attachments,
+		inspector:   inspector,
+		bundle:      bundle,
+	}
+}
+
+// Execute выполняет загрузку. Паспорта проверяются вместе с вложениями: каждое
+// вложение должно быть в архиве или уже в хранилище, а содержимое файлов
+// архива - совпадать с их SHA-256. Файлы вложений записываются до паспортов;
+// при ошибке записи паспорта они остаются в хранилище без ссылок.
+func (uc *ImportBundleUseCase) Execute(ctx context.Context, input ImportInterchangeInput) (*ImportInterchangeOutput, error) {
+	// Валидация входных данных
+	if len(input.Data) == 0 {
+		return nil, entity.ValidationError{Field: "data", Message: "документ пуст"}
+	}
+
+	conflict := input.Conflict
+	if conflict == "" {
+		conflict = ConflictSkip
+	}
+	if !conflict.IsValid() {
+		return nil, entity.ValidationError{Field: "conflict", Message: "допустимые стратегии: skip, overwrite, new"}
+	}
+
+	document, contents := input.Data, map[string][]byte{}
+	if uc.bundle.IsBundle(input.Data) {
+		var err error
+		if document, contents, err = uc.bundle.Unpack(ctx, input.Data); err != nil {
+			return nil, fmt.Errorf("failed to unpack bundle: %w", err)
+		}
+	}
+
+	doc, err := uc.importer.codec.Decode(ctx, document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode interchange document: %w", err)
+	}
+
+	if len(doc.Passports) == 0 {
+		return nil, entity.ValidationError{Field: "passports", Message: "документ не содержит паспортов"}
+	}
+
+	// Паспорта и вложения проверяются до записи файлов в хранилище
+	problems := validateDocument(doc)
+	ids := make([]string, 0, len(contents))
+	for id := range contents {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if entity.AttachmentID(contents[id]) != id {
+			problems = append(problems, entity.ValidationError{Field: "attachments/" + id, Message: "содержимое не совпадает с SHA-256 в имени файла"})
+		}
+	}
+
+	for i, passport := range doc.Passports {
+		for j, a := range passport.Attachments {
+			if _, ok := contents[a.ID]; ok {
+				continue
+			}
+			exists, err := uc.attachments.Exists(ctx, a.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check attachment: %w", err)
+			}
+			if !exists {
+				problems = append(problems, entity.ValidationError{
+					Field:   fmt.Sprintf("passports[%d].attachments[%d]", i, j),
+					Message: "файла вложения " + a.FileName + " нет ни в архиве, ни в хранилище",
+				})
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("interchange document validation failed: %w", problems)
+	}
+
+	// Миниатюры создаются заново: их нет в архиве
+	for _, passport := range doc.Passports {
+		for i, a := range passport.Attachments {
+			data, ok := contents[a.ID]
+			if !ok {
+				continue
+			}
+			if _, passport.Attachments[i].Thumbnail, err = storeAttachment(ctx, uc.attachments, uc.inspector, data, a.MIMEType); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return uc.importer.importDocument(ctx, doc, conflict, input.KeepStatus)
+}
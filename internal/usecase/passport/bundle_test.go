@@ -0,0 +1,96 @@
+package passport_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/attachment"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/interchange"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundle_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	codec, bundle, inspector := interchange.NewCodec(), interchange.NewBundle(), attachment.NewInspector()
+
+	source := memory.NewInMemoryPassportRepository()
+	sourceFiles := memory.NewInMemoryAttachmentRepository()
+	require.NoError(t, source.Create(ctx, newInterchangePassport("TP-1", "ГУП БТИ")))
+
+	photo := newPNG(t, 64, 64)
+	_, err := passport.NewAddAttachmentUseCase(source, sourceFiles, inspector).Execute(ctx, passport.AddAttachmentInput{
+		PassportID: "TP-1", FileName: "photo.png", Data: photo,
+	})
+	require.NoError(t, err)
+
+	exported, err := passport.NewExportBundleUseCase(source, sourceFiles, codec, bundle).Execute(ctx, passport.ExportInterchangeInput{All: true})
+	require.NoError(t, err)
+	assert.True(t, bundle.IsBundle(exported.Data))
+	assert.Equal(t, "TP-1.techpassport.zip", exported.FileName)
+
+	target := memory.NewInMemoryPassportRepository()
+	targetFiles := memory.NewInMemoryAttachmentRepository()
+	output, err := passport.NewImportBundleUseCase(target, targetFiles, codec, bundle, inspector).Execute(ctx, passport.ImportInterchangeInput{Data: exported.Data})
+	require.NoError(t, err)
+	require.Len(t, output.Results, 1)
+
+	imported, err := target.GetByID(ctx, output.Results[0].PassportID)
+	require.NoError(t, err)
+	require.Len(t, imported.Attachments, 1)
+	assert.True(t, imported.Attachments[0].Thumbnail)
+
+	data, err := targetFiles.Load(ctx, entity.AttachmentID(photo))
+	require.NoError(t, err)
+	assert.Equal(t, photo, data)
+	_, err = targetFiles.LoadThumbnail(ctx, entity.AttachmentID(photo))
+	assert.NoError(t, err, "миниатюра создается заново при загрузке")
+}
+
+func TestImportBundleUseCase_RejectsBrokenAttachments(t *testing.T) {
+	ctx := context.Background()
+	codec, bundle := interchange.NewCodec(), interchange.NewBundle()
+
+	scan := []byte("%PDF-1.4 скан")
+	p := newInterchangePassport("TP-1", "ГУП БТИ")
+	_, err := p.AttachFile(entity.Attachment{ID: entity.AttachmentID(scan), FileName: "scan.pdf", MIMEType: "application/pdf", Size: int64(len(scan))}, entity.AttachmentTarget{})
+	require.NoError(t, err)
+	document, err := codec.Encode(ctx, []*entity.TechnicalPassport{p})
+	require.NoError(t, err)
+
+	tampered, err := bundle.Pack(ctx, document, map[string][]byte{entity.AttachmentID(scan): []byte("другое содержимое")})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		data  []byte
+		field string
+	}{
+		{"content does not match id", tampered, "attachments/" + entity.AttachmentID(scan)},
+		{"attachment missing in plain document", document, "passports[0].attachments[0]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := memory.NewInMemoryPassportRepository()
+			files := memory.NewInMemoryAttachmentRepository()
+			uc := passport.NewImportBundleUseCase(repo, files, codec, bundle, attachment.NewInspector())
+
+			_, err := uc.Execute(ctx, passport.ImportInterchangeInput{Data: tt.data})
+
+			var problems entity.ValidationErrors
+			require.ErrorAs(t, err, &problems)
+			assert.Equal(t, tt.field, problems[0].Field)
+
+			all, err := repo.List(ctx)
+			require.NoError(t, err)
+			assert.Empty(t, all)
+			exists, err := files.Exists(ctx, entity.AttachmentID(scan))
+			require.NoError(t, err)
+			assert.False(t, exists)
+		})
+	}
+}
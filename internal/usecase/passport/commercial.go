@@ -0,0 +1,107 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// SetCommercialInfoInput входные данные для замены сведений о нежилом объекте
+type SetCommercialInfoInput struct {
+	PassportID string
+	Info       entity.CommercialInfo
+}
+
+// SetCommercialInfoOutput результат замены сведений о нежилом объекте
+type SetCommercialInfoOutput struct {
+	Passport *entity.TechnicalPassport
+}
+
+// SetCommercialInfoUseCase use case для замены сведений о пожарной безопасности
+// нежилого объекта
+type SetCommercialInfoUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewSetCommercialInfoUseCase создает новый use case
+func NewSetCommercialInfoUseCase(repo repository.PassportRepository) *SetCommercialInfoUseCase {
+	return &SetCommercialInfoUseCase{
+		repo: repo,
+	}
+}
+
+// Execute заменяет сведения о пожарной безопасности
+func (uc *SetCommercialInfoUseCase) Execute(ctx context.Context, input SetCommercialInfoInput) (*SetCommercialInfoOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	if err := passport.SetCommercialInfo(input.Info); err != nil {
+		return nil, err
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &SetCommercialInfoOutput{
+		Passport: passport,
+	}, nil
+}
+
+// UseCategoryTotalsInput входные данные для расчета площадей по категориям
+type UseCategoryTotalsInput struct {
+	PassportID string
+}
+
+// UseCategoryTotalsOutput площади помещений по категориям использования
+type UseCategoryTotalsOutput struct {
+	Passport *entity.TechnicalPassport
+	Totals   []entity.UseCategoryArea
+}
+
+// UseCategoryTotalsUseCase use case для расчета основной и вспомогательной
+// площади нежилого объекта по категориям использования
+type UseCategoryTotalsUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewUseCategoryTotalsUseCase создает новый use case
+func NewUseCategoryTotalsUseCase(repo repository.PassportRepository) *UseCategoryTotalsUseCase {
+	return &UseCategoryTotalsUseCase{
+		repo: repo,
+	}
+}
+
+// Execute рассчитывает площади по категориям, не изменяя паспорт
+func (uc *UseCategoryTotalsUseCase) Execute(ctx context.Context, input UseCategoryTotalsInput) (*UseCategoryTotalsOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	if !passport.ObjectType.Profile().Commercial {
+		return nil, entity.ValidationError{Field: "object_type", Message: "паспорт составлен не на нежилой объект"}
+	}
+
+	return &UseCategoryTotalsOutput{
+		Passport: passport,
+		Totals:   passport.UseCategoryTotals(),
+	}, nil
+}
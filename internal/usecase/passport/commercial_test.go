@@ -0,0 +1,131 @@
+package passport_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// commercialPassport паспорт нежилого помещения с офисом и складом
+func commercialPassport(t *testing.T, repo *memory.InMemoryPassportRepository) *entity.TechnicalPassport {
+	p := entity.NewTechnicalPassport(entity.ObjectTypeNonResidential, entity.Address{Subject: "г. Москва", House: "3"})
+	p.ID = "TP-NONRES"
+	p.OrganizationName = "ГУП БТИ"
+	p.GeneralInfo = entity.GeneralInfo{Purpose: "Нежилое", ConstructionYear: 1990, TotalArea: 62.5}
+	p.Buildings = []entity.Building{{Litera: "А", Name: "Административное здание", CommissionYear: 1990, TotalArea: 62.5}}
+	p.Owners = []entity.Owner{{
+		EntryDate:     p.CreatedDate,
+		PersonType:    entity.PersonTypeLegal,
+		CompanyName:   "ООО «Ромашка»",
+		RightType:     "Собственность",
+		RightDocument: "Договор купли-продажи",
+		Share:         "1",
+	}}
+	p.Explication = []entity.Room{
+		{Litera: "А", Floor: "1", RoomNumber: "1", Purpose: "Кабинет", Area: 24.3, UseCategory: entity.UseCategoryOffice},
+		{Litera: "А", Floor: "1", RoomNumber: "2", Purpose: "Коридор", Area: 8.2, AuxiliaryArea: 8.2, UseCategory: entity.UseCategoryOffice},
+		{Litera: "А", Floor: "1", RoomNumber: "3", Purpose: "Торговый зал", Area: 30},
+	}
+	require.NoError(t, repo.Create(context.Background(), p))
+	return p
+}
+
+func TestValidatePassport_NonResidentialProfile(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	p := commercialPassport(t, repo)
+	validate := passport.NewValidatePassportUseCase(repo)
+
+	// Незаполненные сведения профиля - предупреждения при сохранении
+	output, err := validate.Execute(ctx, passport.ValidatePassportInput{PassportID: p.ID})
+	require.NoError(t, err)
+	assert.True(t, output.Result.Valid)
+	assert.Contains(t, output.Result.Warnings, "не указан класс функциональной пожарной опасности")
+	assert.Contains(t, output.Result.Warnings, "помещение 3 (литера А, этаж 1): не указана категория использования")
+
+	// и ошибки при проверке для экспорта
+	output, err = validate.Execute(ctx, passport.ValidatePassportInput{PassportID: p.ID, Complete: true})
+	require.NoError(t, err)
+	assert.False(t, output.Result.Valid)
+	var fields []string
+	for _, e := range output.Result.Errors {
+		fields = append(fields, e.Field)
+	}
+	assert.Equal(t, []string{
+		"commercial.fire_hazard_class", "commercial.fire_resistance", "commercial.evacuation_exits", "explication[2].use_category",
+	}, fields)
+
+	_, err = passport.NewSetCommercialInfoUseCase(repo).Execute(ctx, passport.SetCommercialInfoInput{
+		PassportID: p.ID,
+		Info:       entity.CommercialInfo{FireHazardClass: "Ф4.3", FireResistance: "II", EvacuationExits: 1},
+	})
+	require.NoError(t, err)
+	saved, err := repo.GetByID(ctx, p.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.ValidationErrors{{
+		Field:   "explication[2].use_category",
+		Message: "помещение 3 (литера А, этаж 1): не указана категория использования",
+	}}, saved.ProfileErrors())
+
+	totals, err := passport.NewUseCategoryTotalsUseCase(repo).Execute(ctx, passport.UseCategoryTotalsInput{PassportID: p.ID})
+	require.NoError(t, err)
+	assert.Equal(t, []entity.UseCategoryArea{
+		{Category: entity.UseCategoryOffice, Rooms: 2, MainArea: 24.3, AuxiliaryArea: 8.2, TotalArea: 32.5},
+		{Category: "", Rooms: 1, MainArea: 30, TotalArea: 30},
+	}, totals.Totals)
+}
+
+func TestTechnicalPassport_ProfileRestrictions(t *testing.T) {
+	repo := memory.NewInMemoryPassportRepository()
+
+	tests := []struct {
+		name   string
+		modify func(p *entity.TechnicalPassport)
+		field  string
+	}{
+		{
+			name:   "living area of non-residential object",
+			modify: func(p *entity.TechnicalPassport) { p.GeneralInfo.LivingArea = 10 },
+			field:  "living_area",
+		},
+		{
+			name:   "living room in non-residential explication",
+			modify: func(p *entity.TechnicalPassport) { p.Explication[0].LivingArea = 5 },
+			field:  "explication[0]",
+		},
+		{
+			name:   "auxiliary area exceeds room area",
+			modify: func(p *entity.TechnicalPassport) { p.Explication[1].AuxiliaryArea = 9 },
+			field:  "explication[1]",
+		},
+		{
+			name:   "unknown fire hazard class",
+			modify: func(p *entity.TechnicalPassport) { p.Commercial = &entity.CommercialInfo{FireHazardClass: "Ф6.1"} },
+			field:  "commercial.fire_hazard_class",
+		},
+		{
+			name: "commercial info for residential house",
+			modify: func(p *entity.TechnicalPassport) {
+				p.ObjectType = entity.ObjectTypeResidentialHouse
+				p.Commercial = &entity.CommercialInfo{}
+			},
+			field: "commercial",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := commercialPassport(t, repo)
+			tt.modify(p)
+			var validationErr entity.ValidationError
+			require.ErrorAs(t, p.IsValid(), &validationErr)
+			assert.Equal(t, tt.field, validationErr.Field)
+			require.NoError(t, repo.Delete(context.Background(), p.ID))
+		})
+	}
+}
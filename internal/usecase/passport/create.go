@@ -7,6 +7,7 @@ import (
 
 	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
 	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
 )
 
 // CreatePassportInput входные данные для создания паспорта
@@ -20,17 +21,23 @@ type CreatePassportInput struct {
 // CreatePassportOutput результат создания паспорта
 type CreatePassportOutput struct {
 	Passport *entity.TechnicalPassport
+
+	// Duplicates ранее созданные паспорта с похожим адресом; паспорт
+	// создается в любом случае, дубликаты объединяются MergePassportsUseCase
+	Duplicates []DuplicateCandidate
 }
 
 // CreatePassportUseCase use case для создания технического паспорта
 type CreatePassportUseCase struct {
-	repo repository.PassportRepository
+	repo       repository.PassportRepository
+	normalizer service.AddressNormalizer
 }
 
 // NewCreatePassportUseCase создает новый use case
-func NewCreatePassportUseCase(repo repository.PassportRepository) *CreatePassportUseCase {
+func NewCreatePassportUseCase(repo repository.PassportRepository, normalizer service.AddressNormalizer) *CreatePassportUseCase {
 	return &CreatePassportUseCase{
-		repo: repo,
+		repo:       repo,
+		normalizer: normalizer,
 	}
 }
 
@@ -62,8 +69,13 @@ func (uc *CreatePassportUseCase) Execute(ctx context.Context, input CreatePasspo
 
 	passport.AddAuditEntry("created", "Технический паспорт создан")
 
+	// Ищем вероятные дубликаты по сходству адресов. Паспорт уже сохранен,
+	// поэтому ошибка поиска не возвращается: повтор создал бы еще один паспорт.
+	duplicates, _ := findDuplicates(ctx, uc.repo, uc.normalizer, passport, DefaultDuplicateThreshold)
+
 	return &CreatePassportOutput{
-		Passport: passport,
+		Passport:   passport,
+		Duplicates: duplicates,
 	}, nil
 }
 
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/address"
 	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
 	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
 	"github.com/stretchr/testify/assert"
@@ -99,7 +100,7 @@ func TestCreatePassportUseCase_Execute(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// Arrange
 			repo := memory.NewInMemoryPassportRepository()
-			useCase := passport.NewCreatePassportUseCase(repo)
+			useCase := passport.NewCreatePassportUseCase(repo, address.NewNormalizer())
 			ctx := context.Background()
 
 			// Act
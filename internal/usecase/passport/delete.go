@@ -0,0 +1,49 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// DeletePassportInput входные данные для удаления паспорта
+type DeletePassportInput struct {
+	PassportID string
+}
+
+// DeletePassportOutput результат удаления паспорта
+type DeletePassportOutput struct {
+	PassportID string
+}
+
+// DeletePassportUseCase use case для удаления технического паспорта
+type DeletePassportUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewDeletePassportUseCase создает новый use case
+func NewDeletePassportUseCase(repo repository.PassportRepository) *DeletePassportUseCase {
+	return &DeletePassportUseCase{
+		repo: repo,
+	}
+}
+
+// Execute выполняет удаление паспорта
+func (uc *DeletePassportUseCase) Execute(ctx context.Context, input DeletePassportInput) (*DeletePassportOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	// Удаляем паспорт: событие удаления передает подписчикам и в outbox
+	// хранилище после успешного удаления
+	if err := uc.repo.Delete(ctx, input.PassportID); err != nil {
+		return nil, fmt.Errorf("failed to delete passport: %w", err)
+	}
+
+	return &DeletePassportOutput{
+		PassportID: input.PassportID,
+	}, nil
+}
@@ -0,0 +1,112 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// DefaultDuplicateThreshold минимальное сходство адресов, при котором
+// паспорт считается вероятным дубликатом
+const DefaultDuplicateThreshold = 0.8
+
+// DuplicateCandidate вероятный дубликат паспорта
+type DuplicateCandidate struct {
+	Passport *entity.TechnicalPassport
+
+	// Score сходство адресов от 0 до 1
+	Score float64
+}
+
+// FindDuplicatesInput входные данные для поиска дубликатов
+type FindDuplicatesInput struct {
+	// PassportID паспорт, для которого ищутся дубликаты
+	PassportID string
+
+	// Threshold минимальное сходство адресов (0 - DefaultDuplicateThreshold)
+	Threshold float64
+}
+
+// FindDuplicatesOutput результат поиска дубликатов
+type FindDuplicatesOutput struct {
+	// Duplicates вероятные дубликаты по убыванию сходства
+	Duplicates []DuplicateCandidate
+}
+
+// FindDuplicatesUseCase use case для поиска вероятных дубликатов паспорта
+// по сходству адресов
+type FindDuplicatesUseCase struct {
+	repo       repository.PassportRepository
+	normalizer service.AddressNormalizer
+}
+
+// NewFindDuplicatesUseCase создает новый use case
+func NewFindDuplicatesUseCase(repo repository.PassportRepository, normalizer service.AddressNormalizer) *FindDuplicatesUseCase {
+	return &FindDuplicatesUseCase{
+		repo:       repo,
+		normalizer: normalizer,
+	}
+}
+
+// Execute ищет паспорта с похожим адресом
+func (uc *FindDuplicatesUseCase) Execute(ctx context.Context, input FindDuplicatesInput) (*FindDuplicatesOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if input.Threshold < 0 || input.Threshold > 1 {
+		return nil, entity.ValidationError{Field: "threshold", Message: "порог сходства должен быть от 0 до 1"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	duplicates, err := findDuplicates(ctx, uc.repo, uc.normalizer, passport, input.Threshold)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FindDuplicatesOutput{
+		Duplicates: duplicates,
+	}, nil
+}
+
+// findDuplicates возвращает другие паспорта с адресом, похожим на адрес
+// паспорта, по убыванию сходства, затем по ID
+func findDuplicates(ctx context.Context, repo repository.PassportRepository, normalizer service.AddressNormalizer, passport *entity.TechnicalPassport, threshold float64) ([]DuplicateCandidate, error) {
+	if threshold == 0 {
+		threshold = DefaultDuplicateThreshold
+	}
+
+	passports, err := repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list passports: %w", err)
+	}
+
+	duplicates := []DuplicateCandidate{}
+	for _, p := range passports {
+		if p.ID == passport.ID {
+			continue
+		}
+		if score := normalizer.Similarity(passport.Address, p.Address); score >= threshold {
+			duplicates = append(duplicates, DuplicateCandidate{Passport: p, Score: score})
+		}
+	}
+
+	sort.Slice(duplicates, func(i, j int) bool {
+		if duplicates[i].Score != duplicates[j].Score {
+			return duplicates[i].Score > duplicates[j].Score
+		}
+		return duplicates[i].Passport.ID < duplicates[j].Passport.ID
+	})
+
+	return duplicates, nil
+}
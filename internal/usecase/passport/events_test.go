@@ -0,0 +1,173 @@
+package passport_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/address"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/eventbus"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUseCases_PublishEvents(t *testing.T) {
+	ctx := context.Background()
+	var events []entity.DomainEvent
+	bus := eventbus.NewBus(nil)
+	bus.Subscribe("test", func(ctx context.Context, event entity.DomainEvent) error {
+		events = append(events, event)
+		return nil
+	})
+	repo := eventbus.NewPublishingRepository(memory.NewInMemoryPassportRepository(), bus)
+
+	created, err := passport.NewCreatePassportUseCase(repo, address.NewNormalizer()).Execute(ctx, passport.CreatePassportInput{
+		ObjectType:       entity.ObjectTypeResidentialHouse,
+		OrganizationName: "ГУП БТИ",
+		Address:          entity.Address{Subject: "г. Москва", House: "1"},
+		GeneralInfo:      entity.GeneralInfo{Purpose: "Жилое", ConstructionYear: 2020, TotalArea: 100.5},
+	})
+	require.NoError(t, err)
+	id := created.Passport.ID
+
+	owner := entity.Owner{
+		EntryDate:     created.Passport.CreatedDate,
+		PersonType:    entity.PersonTypeIndividual,
+		FullName:      "Иванов Иван Иванович",
+		RightType:     "Собственность",
+		RightDocument: "Договор купли-продажи",
+		Share:         "1",
+	}
+	_, err = passport.NewAddBuildingUseCase(repo).Execute(ctx, passport.AddBuildingInput{
+		PassportID: id,
+		Building:   entity.Building{Litera: "А", Name: "Жилой дом", CommissionYear: 2020},
+	})
+	require.NoError(t, err)
+	_, err = passport.NewAddOwnerUseCase(repo).Execute(ctx, passport.AddOwnerInput{PassportID: id, Owner: owner})
+	require.NoError(t, err)
+	_, err = passport.NewUpdateOwnerUseCase(repo).Execute(ctx, passport.UpdateOwnerInput{PassportID: id, Owner: owner})
+	require.NoError(t, err)
+	_, err = passport.NewRemoveOwnerUseCase(repo).Execute(ctx, passport.RemoveOwnerInput{PassportID: id})
+	require.NoError(t, err)
+	_, err = passport.NewRemoveBuildingUseCase(repo).Execute(ctx, passport.RemoveBuildingInput{PassportID: id})
+	require.NoError(t, err)
+	_, err = passport.NewArchivePassportUseCase(repo).Execute(ctx, passport.ArchivePassportInput{PassportID: id})
+	require.NoError(t, err)
+
+	// Ошибка use case не публикует событий
+	_, err = passport.NewRemoveBuildingUseCase(repo).Execute(ctx, passport.RemoveBuildingInput{PassportID: id})
+	require.Error(t, err)
+
+	// Сравниваем события без ID паспорта и времени
+	var got []entity.DomainEvent
+	for _, e := range events {
+		assert.Equal(t, id, e.PassportID)
+		assert.False(t, e.OccurredAt.IsZero())
+		got = append(got, entity.DomainEvent{
+			Type: e.Type, Litera: e.Litera, OwnerIndex: e.OwnerIndex, OwnerChange: e.OwnerChange,
+			PreviousStatus: e.PreviousStatus, Status: e.Status,
+		})
+	}
+	assert.Equal(t, []entity.DomainEvent{
+		{Type: entity.EventPassportCreated},
+		{Type: entity.EventBuildingAdded, Litera: "А"},
+		{Type: entity.EventOwnerChanged, OwnerChange: entity.OwnerAdded},
+		{Type: entity.EventOwnerChanged, OwnerChange: entity.OwnerUpdated},
+		{Type: entity.EventOwnerChanged, OwnerChange: entity.OwnerRemoved},
+		{Type: entity.EventBuildingRemoved, Litera: "А"},
+		{Type: entity.EventStatusChanged, PreviousStatus: entity.PassportStatusDraft, Status: entity.PassportStatusArchived},
+	}, got)
+}
+
+func TestUseCases_LifecycleEvents(t *testing.T) {
+	ctx := context.Background()
+	var events []entity.DomainEvent
+	bus := eventbus.NewBus(nil)
+	bus.Subscribe("test", func(ctx context.Context, event entity.DomainEvent) error {
+		events = append(events, event)
+		return nil
+	})
+	repo := eventbus.NewPublishingRepository(memory.NewInMemoryPassportRepository(), bus)
+
+	p := entity.NewTechnicalPassport(entity.ObjectTypeResidentialHouse, entity.Address{Subject: "г. Москва", House: "1"})
+	p.ID = "TP-LIFECYCLE"
+	p.OrganizationName = "ГУП БТИ"
+	p.GeneralInfo = entity.GeneralInfo{Purpose: "Жилое", ConstructionYear: 2020, TotalArea: 100.5}
+	require.NoError(t, p.AddBuilding(entity.Building{Litera: "А", Name: "Жилой дом", CommissionYear: 2020}))
+	require.NoError(t, p.AddOwner(entity.Owner{
+		EntryDate:     p.CreatedDate,
+		PersonType:    entity.PersonTypeIndividual,
+		FullName:      "Иванов Иван Иванович",
+		RightType:     "Собственность",
+		RightDocument: "Договор купли-продажи",
+		Share:         "1",
+	}))
+	require.NoError(t, repo.Create(ctx, p))
+	id := p.ID
+
+	// Выдать можно только утвержденный паспорт
+	issue := passport.NewIssuePassportUseCase(repo)
+	_, err := issue.Execute(ctx, passport.IssuePassportInput{PassportID: id, Recipient: "Иванов И.И."})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "только утвержденный")
+
+	_, err = passport.NewApprovePassportUseCase(repo).Execute(ctx, passport.ApprovePassportInput{PassportID: id})
+	require.NoError(t, err)
+	_, err = issue.Execute(ctx, passport.IssuePassportInput{PassportID: id})
+	require.Error(t, err)
+	issued, err := issue.Execute(ctx, passport.IssuePassportInput{PassportID: id, Recipient: "Иванов И.И."})
+	require.NoError(t, err)
+	assert.Equal(t, "issue", issued.Passport.AuditLog[len(issued.Passport.AuditLog)-1].Action)
+
+	_, err = passport.NewDeletePassportUseCase(repo).Execute(ctx, passport.DeletePassportInput{PassportID: id})
+	require.NoError(t, err)
+	_, err = repo.GetByID(ctx, id)
+	require.Error(t, err)
+
+	// Действие события совпадает с записью журнала изменений паспорта
+	var actions []string
+	for _, e := range events {
+		assert.Equal(t, "ГУП БТИ", e.Organization)
+		actions = append(actions, e.Action)
+	}
+	assert.Equal(t, []string{"created", "add_building", "add_owner", "approve", "issue", "delete"}, actions)
+	assert.Equal(t, entity.EventPassportIssued, events[4].Type)
+	assert.Equal(t, "Иванов И.И.", events[4].Recipient)
+	assert.Equal(t, entity.EventPassportDeleted, events[5].Type)
+}
+
+// failingDeleteRepository хранилище, в котором удаление паспорта не удается
+type failingDeleteRepository struct {
+	repository.PassportRepository
+}
+
+func (r failingDeleteRepository) Delete(ctx context.Context, id string) error {
+	return errors.New("disk failure")
+}
+
+func TestDeletePassportUseCase_FailedDeleteIsNotPublished(t *testing.T) {
+	ctx := context.Background()
+	var events []entity.DomainEvent
+	bus := eventbus.NewBus(nil)
+	bus.Subscribe("test", func(ctx context.Context, event entity.DomainEvent) error {
+		events = append(events, event)
+		return nil
+	})
+	repo := eventbus.NewPublishingRepository(failingDeleteRepository{memory.NewInMemoryPassportRepository()}, bus)
+
+	p := entity.NewTechnicalPassport(entity.ObjectTypeResidentialHouse, entity.Address{Subject: "г. Москва", House: "1"})
+	p.ID = "TP-DELETE"
+	require.NoError(t, repo.Create(ctx, p))
+	events = nil
+
+	_, err := passport.NewDeletePassportUseCase(repo).Execute(ctx, passport.DeletePassportInput{PassportID: p.ID})
+	require.Error(t, err)
+	assert.Empty(t, events)
+
+	_, err = repo.GetByID(ctx, p.ID)
+	assert.NoError(t, err)
+}
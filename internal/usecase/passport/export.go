@@ -0,0 +1,76 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// ExportPassportInput входные данные для экспорта паспорта в документ
+type ExportPassportInput struct {
+	PassportID string
+	Format     service.DocumentFormat
+}
+
+// ExportPassportOutput результат экспорта паспорта
+type ExportPassportOutput struct {
+	// Document содержимое сгенерированного документа
+	Document []byte
+
+	// FileName рекомендуемое имя файла
+	FileName string
+}
+
+// ExportPassportUseCase use case для экспорта паспорта в PDF/DOCX
+type ExportPassportUseCase struct {
+	repo      repository.PassportRepository
+	generator service.DocumentGenerator
+}
+
+// NewExportPassportUseCase создает новый use case
+func NewExportPassportUseCase(repo repository.PassportRepository, generator service.DocumentGenerator) *ExportPassportUseCase {
+	return &ExportPassportUseCase{
+		repo:      repo,
+		generator: generator,
+	}
+}
+
+// Execute выполняет экспорт паспорта
+func (uc *ExportPassportUseCase) Execute(ctx context.Context, input ExportPassportInput) (*ExportPassportOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if input.Format != service.FormatPDF && input.Format != service.FormatDOCX {
+		return nil, entity.ValidationError{Field: "format", Message: "поддерживаются форматы pdf и docx"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	// Экспортировать можно только полностью заполненный паспорт
+	if err := passport.IsComplete(); err != nil {
+		return nil, fmt.Errorf("passport is not complete: %w", err)
+	}
+
+	// Генерируем документ
+	document, err := uc.generator.Generate(ctx, passport, service.GenerateOptions{
+		Format:        input.Format,
+		IncludeImages: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate document: %w", err)
+	}
+
+	return &ExportPassportOutput{
+		Document: document,
+		FileName: passport.ID + "." + string(input.Format),
+	}, nil
+}
@@ -0,0 +1,100 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// ExportInterchangeInput входные данные для выгрузки паспортов в формат обмена
+type ExportInterchangeInput struct {
+	// PassportIDs выгружаемые паспорта
+	PassportIDs []string
+
+	// All выгрузить все паспорта хранилища (PassportIDs игнорируется)
+	All bool
+}
+
+// ExportInterchangeOutput результат выгрузки паспортов
+type ExportInterchangeOutput struct {
+	// Data документ формата обмена
+	Data []byte
+
+	// Count количество выгруженных паспортов
+	Count int
+
+	// FileName рекомендуемое имя файла
+	FileName string
+}
+
+// ExportInterchangeUseCase use case для выгрузки паспортов в версионированный JSON формат обмена
+type ExportInterchangeUseCase struct {
+	repo  repository.PassportRepository
+	codec service.InterchangeCodec
+}
+
+// NewExportInterchangeUseCase создает новый use case
+func NewExportInterchangeUseCase(repo repository.PassportRepository, codec service.InterchangeCodec) *ExportInterchangeUseCase {
+	return &ExportInterchangeUseCase{
+		repo:  repo,
+		codec: codec,
+	}
+}
+
+// Execute выполняет выгрузку паспортов
+func (uc *ExportInterchangeUseCase) Execute(ctx context.Context, input ExportInterchangeInput) (*ExportInterchangeOutput, error) {
+	passports, err := uc.passports(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := uc.codec.Encode(ctx, passports)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode passports: %w", err)
+	}
+
+	return &ExportInterchangeOutput{
+		Data:     data,
+		Count:    len(passports),
+		FileName: exportFileName(passports, ".techpassport.json"),
+	}, nil
+}
+
+// passports возвращает выгружаемые паспорта
+func (uc *ExportInterchangeUseCase) passports(ctx context.Context, input ExportInterchangeInput) ([]*entity.TechnicalPassport, error) {
+	var passports []*entity.TechnicalPassport
+
+	if input.All {
+		all, err := uc.repo.List(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list passports: %w", err)
+		}
+		passports = all
+	} else {
+		// Валидация входных данных
+		if len(input.PassportIDs) == 0 {
+			return nil, entity.ValidationError{Field: "passport_ids", Message: "укажите хотя бы один паспорт"}
+		}
+
+		for _, id := range input.PassportIDs {
+			passport, err := uc.repo.GetByID(ctx, id)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get passport: %w", err)
+			}
+			passports = append(passports, passport)
+		}
+	}
+
+	return passports, nil
+}
+
+// exportFileName рекомендуемое имя файла выгрузки
+func exportFileName(passports []*entity.TechnicalPassport, extension string) string {
+	if len(passports) == 1 {
+		return passports[0].ID + extension
+	}
+	return "passports" + extension
+}
@@ -0,0 +1,67 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// ExportRosreestrInput входные данные для выгрузки паспорта в XML Росреестра
+type ExportRosreestrInput struct {
+	PassportID string
+}
+
+// ExportRosreestrOutput результат выгрузки паспорта в XML Росреестра
+type ExportRosreestrOutput struct {
+	// Document XML технического плана
+	Document []byte
+
+	// FileName имя файла по правилам Росреестра
+	FileName string
+
+	// Report результат проверки XML по схеме и перечень незаполненных полей
+	Report service.RosreestrReport
+}
+
+// ExportRosreestrUseCase use case для выгрузки паспорта в XML технического плана.
+// Документ формируется и для неполного паспорта: недостающие сведения
+// перечисляются в отчете, чтобы инженер мог дополнить их до подачи.
+type ExportRosreestrUseCase struct {
+	repo     repository.PassportRepository
+	exporter service.RosreestrExporter
+}
+
+// NewExportRosreestrUseCase создает новый use case
+func NewExportRosreestrUseCase(repo repository.PassportRepository, exporter service.RosreestrExporter) *ExportRosreestrUseCase {
+	return &ExportRosreestrUseCase{
+		repo:     repo,
+		exporter: exporter,
+	}
+}
+
+// Execute выполняет выгрузку паспорта
+func (uc *ExportRosreestrUseCase) Execute(ctx context.Context, input ExportRosreestrInput) (*ExportRosreestrOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	doc, err := uc.exporter.Export(ctx, passport)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export technical plan: %w", err)
+	}
+
+	return &ExportRosreestrOutput{
+		Document: doc.Data,
+		FileName: doc.FileName,
+		Report:   doc.Report,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// ExportTablesInput входные данные для выгрузки таблиц паспорта в XLSX
+type ExportTablesInput struct {
+	PassportID string
+}
+
+// ExportTablesOutput результат выгрузки таблиц паспорта
+type ExportTablesOutput struct {
+	// Document книга XLSX с экспликацией и составом объекта
+	Document []byte
+
+	// FileName предлагаемое имя файла
+	FileName string
+}
+
+// ExportTablesUseCase use case для выгрузки экспликации и состава объекта в XLSX
+type ExportTablesUseCase struct {
+	repo  repository.PassportRepository
+	codec service.SpreadsheetCodec
+}
+
+// NewExportTablesUseCase создает новый use case
+func NewExportTablesUseCase(repo repository.PassportRepository, codec service.SpreadsheetCodec) *ExportTablesUseCase {
+	return &ExportTablesUseCase{
+		repo:  repo,
+		codec: codec,
+	}
+}
+
+// Execute выполняет выгрузку таблиц
+func (uc *ExportTablesUseCase) Execute(ctx context.Context, input ExportTablesInput) (*ExportTablesOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	data, err := uc.codec.ExportTables(ctx, passport)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export tables: %w", err)
+	}
+
+	return &ExportTablesOutput{
+		Document: data,
+		FileName: passport.ID + ".xlsx",
+	}, nil
+}
@@ -0,0 +1,353 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// DefaultAreaTolerance допустимое расхождение площади по контуру и по экспликации (кв.м):
+// площади в экспликации указываются с точностью 0,1 кв.м
+const DefaultAreaTolerance = 0.1
+
+// AreaCheckStatus результат сверки площади помещения
+type AreaCheckStatus string
+
+const (
+	AreaCheckOK        AreaCheckStatus = "ok"         // Площади совпадают
+	AreaCheckMismatch  AreaCheckStatus = "mismatch"   // Расхождение больше допустимого
+	AreaCheckNoRoom    AreaCheckStatus = "no_room"    // Контура нет в экспликации
+	AreaCheckNoContour AreaCheckStatus = "no_contour" // Помещения нет на плане этажа
+)
+
+// FloorPlanAreaCheck сверка площади помещения по контуру плана с экспликацией
+type FloorPlanAreaCheck struct {
+	Litera     string          `json:"litera"`
+	Floor      string          `json:"floor"`
+	RoomNumber string          `json:"room_number"`
+	PlanArea   float64         `json:"plan_area,omitempty"`
+	RoomArea   float64         `json:"room_area,omitempty"`
+	Difference float64         `json:"difference,omitempty"`
+	Status     AreaCheckStatus `json:"status"`
+}
+
+// CheckFloorPlanAreas сверяет площади контуров векторных планов с площадями помещений экспликации.
+// Помещения этажей без векторного плана не проверяются.
+func CheckFloorPlanAreas(passport *entity.TechnicalPassport, tolerance float64) []FloorPlanAreaCheck {
+	var checks []FloorPlanAreaCheck
+
+	for i := range passport.FloorPlans {
+		plan := &passport.FloorPlans[i]
+		if len(plan.Rooms) == 0 {
+			continue
+		}
+
+		for _, contour := range plan.Rooms {
+			check := FloorPlanAreaCheck{
+				Litera:     plan.Litera,
+				Floor:      plan.Floor,
+				RoomNumber: contour.RoomNumber,
+				PlanArea:   roundArea(contour.Area()),
+			}
+
+			room := passport.FindRoom(plan.Litera, plan.Floor, contour.RoomNumber)
+			if room == nil {
+				check.Status = AreaCheckNoRoom
+				checks = append(checks, check)
+				continue
+			}
+
+			check.RoomArea = room.Area
+			check.Difference = roundArea(check.PlanArea - room.Area)
+			check.Status = AreaCheckOK
+			// Сравнение с запасом на погрешность представления чисел
+			if math.Abs(contour.Area()-room.Area) > tolerance+1e-9 {
+				check.Status = AreaCheckMismatch
+			}
+			checks = append(checks, check)
+		}
+
+		for _, room := range passport.Explication {
+			if plan.Matches(room.Litera, room.Floor) && plan.Contour(room.RoomNumber) == nil {
+				checks = append(checks, FloorPlanAreaCheck{
+					Litera:     room.Litera,
+					Floor:      room.Floor,
+					RoomNumber: room.RoomNumber,
+					RoomArea:   room.Area,
+					Status:     AreaCheckNoContour,
+				})
+			}
+		}
+	}
+
+	return checks
+}
+
+// roundArea округляет площадь до сотых кв.м
+func roundArea(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// areaCheckWarning описание расхождения для предупреждений проверки паспорта
+func areaCheckWarning(c FloorPlanAreaCheck) string {
+	place := fmt.Sprintf("помещение %s (литера %s, этаж %s)", c.RoomNumber, c.Litera, c.Floor)
+	switch c.Status {
+	case AreaCheckMismatch:
+		return fmt.Sprintf("%s: площадь по плану %.2f кв.м, по экспликации %.2f кв.м", place, c.PlanArea, c.RoomArea)
+	case AreaCheckNoRoom:
+		return place + ": есть на плане, но нет в экспликации"
+	case AreaCheckNoContour:
+		return place + ": есть в экспликации, но нет на плане этажа"
+	default:
+		return ""
+	}
+}
+
+// SaveFloorPlanInput входные данные для сохранения поэтажного плана
+type SaveFloorPlanInput struct {
+	PassportID string
+	Plan       entity.FloorPlan
+}
+
+// SaveFloorPlanOutput результат сохранения поэтажного плана
+type SaveFloorPlanOutput struct {
+	Passport *entity.TechnicalPassport
+
+	// Checks сверка площадей сохраненного плана с экспликацией
+	Checks []FloorPlanAreaCheck
+}
+
+// SaveFloorPlanUseCase use case для добавления или замены поэтажного плана
+type SaveFloorPlanUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewSaveFloorPlanUseCase создает новый use case
+func NewSaveFloorPlanUseCase(repo repository.PassportRepository) *SaveFloorPlanUseCase {
+	return &SaveFloorPlanUseCase{
+		repo: repo,
+	}
+}
+
+// Execute сохраняет план; план той же литеры и этажа заменяется
+func (uc *SaveFloorPlanUseCase) Execute(ctx context.Context, input SaveFloorPlanInput) (*SaveFloorPlanOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	if err := passport.SetFloorPlan(input.Plan); err != nil {
+		return nil, err
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	var checks []FloorPlanAreaCheck
+	for _, c := range CheckFloorPlanAreas(passport, DefaultAreaTolerance) {
+		if input.Plan.Matches(c.Litera, c.Floor) {
+			checks = append(checks, c)
+		}
+	}
+
+	return &SaveFloorPlanOutput{
+		Passport: passport,
+		Checks:   checks,
+	}, nil
+}
+
+// RemoveFloorPlanInput входные данные для удаления поэтажного плана
+type RemoveFloorPlanInput struct {
+	PassportID string
+	Litera     string
+	Floor      string
+}
+
+// RemoveFloorPlanOutput результат удаления поэтажного плана
+type RemoveFloorPlanOutput struct {
+	Passport *entity.TechnicalPassport
+}
+
+// RemoveFloorPlanUseCase use case для удаления поэтажного плана
+type RemoveFloorPlanUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewRemoveFloorPlanUseCase создает новый use case
+func NewRemoveFloorPlanUseCase(repo repository.PassportRepository) *RemoveFloorPlanUseCase {
+	return &RemoveFloorPlanUseCase{
+		repo: repo,
+	}
+}
+
+// Execute удаляет план литеры и этажа
+func (uc *RemoveFloorPlanUseCase) Execute(ctx context.Context, input RemoveFloorPlanInput) (*RemoveFloorPlanOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if input.Litera == "" {
+		return nil, entity.ValidationError{Field: "litera", Message: "литера обязательна"}
+	}
+
+	if input.Floor == "" {
+		return nil, entity.ValidationError{Field: "floor", Message: "этаж обязателен"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	if err := passport.RemoveFloorPlan(input.Litera, input.Floor); err != nil {
+		return nil, err
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &RemoveFloorPlanOutput{
+		Passport: passport,
+	}, nil
+}
+
+// RenderFloorPlanInput входные данные для отрисовки поэтажного плана
+type RenderFloorPlanInput struct {
+	PassportID string
+	Litera     string
+	Floor      string
+	Format     service.PlanImageFormat
+}
+
+// RenderFloorPlanOutput изображение поэтажного плана
+type RenderFloorPlanOutput struct {
+	Data   []byte
+	Format service.PlanImageFormat
+}
+
+// RenderFloorPlanUseCase use case для отрисовки поэтажного плана в SVG или PNG
+type RenderFloorPlanUseCase struct {
+	repo     repository.PassportRepository
+	renderer service.FloorPlanRenderer
+}
+
+// NewRenderFloorPlanUseCase создает новый use case
+func NewRenderFloorPlanUseCase(repo repository.PassportRepository, renderer service.FloorPlanRenderer) *RenderFloorPlanUseCase {
+	return &RenderFloorPlanUseCase{
+		repo:     repo,
+		renderer: renderer,
+	}
+}
+
+// Execute отрисовывает план литеры и этажа
+func (uc *RenderFloorPlanUseCase) Execute(ctx context.Context, input RenderFloorPlanInput) (*RenderFloorPlanOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if input.Format == "" {
+		input.Format = service.PlanFormatSVG
+	}
+	if !input.Format.IsValid() {
+		return nil, entity.ValidationError{Field: "format", Message: "поддерживаются форматы svg и png"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	plan := passport.FindFloorPlan(input.Litera, input.Floor)
+	if plan == nil {
+		return nil, entity.ValidationError{
+			Field:   "floor_plans",
+			Message: "план этажа " + input.Floor + " литеры " + input.Litera + " не найден",
+		}
+	}
+
+	data, err := uc.renderer.Render(ctx, plan, input.Format)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render floor plan: %w", err)
+	}
+
+	return &RenderFloorPlanOutput{
+		Data:   data,
+		Format: input.Format,
+	}, nil
+}
+
+// CheckFloorPlanAreasInput входные данные для сверки площадей
+type CheckFloorPlanAreasInput struct {
+	PassportID string
+
+	// Tolerance допустимое расхождение (кв.м), 0 - DefaultAreaTolerance
+	Tolerance float64
+}
+
+// CheckFloorPlanAreasOutput результат сверки площадей
+type CheckFloorPlanAreasOutput struct {
+	Checks []FloorPlanAreaCheck
+
+	// Problems число помещений с расхождениями
+	Problems int
+}
+
+// CheckFloorPlanAreasUseCase use case для сверки площадей планов с экспликацией
+type CheckFloorPlanAreasUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewCheckFloorPlanAreasUseCase создает новый use case
+func NewCheckFloorPlanAreasUseCase(repo repository.PassportRepository) *CheckFloorPlanAreasUseCase {
+	return &CheckFloorPlanAreasUseCase{
+		repo: repo,
+	}
+}
+
+// Execute сверяет площади всех векторных планов паспорта
+func (uc *CheckFloorPlanAreasUseCase) Execute(ctx context.Context, input CheckFloorPlanAreasInput) (*CheckFloorPlanAreasOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if input.Tolerance < 0 {
+		return nil, entity.ValidationError{Field: "tolerance", Message: "допуск не может быть отрицательным"}
+	}
+	if input.Tolerance == 0 {
+		input.Tolerance = DefaultAreaTolerance
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	output := &CheckFloorPlanAreasOutput{Checks: CheckFloorPlanAreas(passport, input.Tolerance)}
+	for _, c := range output.Checks {
+		if c.Status != AreaCheckOK {
+			output.Problems++
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,181 @@
+package passport_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/floorplan"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rectangle контур прямоугольного помещения
+func rectangle(number string, x, y, w, h float64) entity.RoomContour {
+	return entity.RoomContour{
+		RoomNumber: number,
+		Points:     []entity.Point{{X: x, Y: y}, {X: x + w, Y: y}, {X: x + w, Y: y + h}, {X: x, Y: y + h}},
+	}
+}
+
+// planPassport паспорт с экспликацией из трех помещений первого этажа литеры А
+func planPassport(t *testing.T, repo *memory.InMemoryPassportRepository) *entity.TechnicalPassport {
+	p := entity.NewTechnicalPassport(entity.ObjectTypeResidentialHouse, entity.Address{Subject: "г. Москва", House: "1"})
+	p.ID = "TP-PLAN"
+	p.OrganizationName = "ГУП БТИ"
+	p.GeneralInfo = entity.GeneralInfo{Purpose: "Жилое", ConstructionYear: 2020, TotalArea: 40}
+	p.Explication = []entity.Room{
+		{Litera: "А", Floor: "1", RoomNumber: "1", Purpose: "Жилая", Area: 20.0},
+		{Litera: "А", Floor: "1", RoomNumber: "2", Purpose: "Кухня", Area: 9.0},
+		{Litera: "А", Floor: "1", RoomNumber: "3", Purpose: "Санузел", Area: 3.5},
+	}
+	require.NoError(t, repo.Create(context.Background(), p))
+	return p
+}
+
+func TestCheckFloorPlanAreas(t *testing.T) {
+	p := entity.NewTechnicalPassport(entity.ObjectTypeResidentialHouse, entity.Address{})
+	p.Explication = []entity.Room{
+		{Litera: "А", Floor: "1", RoomNumber: "1", Area: 20.0},
+		{Litera: "А", Floor: "1", RoomNumber: "2", Area: 9.0},
+		{Litera: "А", Floor: "1", RoomNumber: "3", Area: 3.5},
+		{Litera: "А", Floor: "2", RoomNumber: "4", Area: 15.0},
+	}
+	p.FloorPlans = []entity.FloorPlan{{
+		Litera: "а",
+		Floor:  "1",
+		Rooms: []entity.RoomContour{
+			rectangle("1", 0, 0, 5, 4.01),  // 20,05 - в пределах допуска
+			rectangle("2", 5, 0, 3, 3.2),   // 9,6 - расхождение
+			rectangle("5", 8, 0, 1.5, 1.5), // нет в экспликации
+		},
+	}}
+
+	checks := passport.CheckFloorPlanAreas(p, passport.DefaultAreaTolerance)
+
+	statuses := map[string]passport.AreaCheckStatus{}
+	for _, c := range checks {
+		statuses[c.RoomNumber] = c.Status
+	}
+	assert.Equal(t, map[string]passport.AreaCheckStatus{
+		"1": passport.AreaCheckOK,
+		"2": passport.AreaCheckMismatch,
+		"5": passport.AreaCheckNoRoom,
+		"3": passport.AreaCheckNoContour,
+	}, statuses, "помещения второго этажа без плана не проверяются")
+
+	for _, c := range checks {
+		if c.RoomNumber == "2" {
+			assert.Equal(t, 9.6, c.PlanArea)
+			assert.Equal(t, 0.6, c.Difference)
+		}
+	}
+}
+
+func TestFloorPlanUseCases(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	p := planPassport(t, repo)
+
+	plan := entity.FloorPlan{
+		Litera: "А",
+		Floor:  "1",
+		Walls: []entity.Wall{
+			{Start: entity.Point{X: 0, Y: 0}, End: entity.Point{X: 9, Y: 0}, Thickness: 0.4},
+		},
+		Openings: []entity.Opening{{Type: entity.OpeningWindow, Wall: 0, Offset: 1, Width: 1.5}},
+		Rooms: []entity.RoomContour{
+			rectangle("1", 0, 0, 5, 4),
+			rectangle("2", 5, 0, 3, 3),
+		},
+	}
+
+	saved, err := passport.NewSaveFloorPlanUseCase(repo).Execute(ctx, passport.SaveFloorPlanInput{PassportID: p.ID, Plan: plan})
+	require.NoError(t, err)
+	require.Len(t, saved.Passport.FloorPlans, 1)
+	require.Len(t, saved.Checks, 3)
+	assert.Equal(t, passport.AreaCheckNoContour, saved.Checks[2].Status)
+
+	// Расхождения попадают в предупреждения проверки паспорта
+	validated, err := passport.NewValidatePassportUseCase(repo).Execute(ctx, passport.ValidatePassportInput{PassportID: p.ID})
+	require.NoError(t, err)
+	assert.True(t, validated.Result.Valid)
+	assert.Contains(t, validated.Result.Warnings, "помещение 3 (литера А, этаж 1): есть в экспликации, но нет на плане этажа")
+
+	// Повторное сохранение заменяет план того же этажа
+	plan.Rooms = append(plan.Rooms, rectangle("3", 8, 0, 1, 3.5))
+	saved, err = passport.NewSaveFloorPlanUseCase(repo).Execute(ctx, passport.SaveFloorPlanInput{PassportID: p.ID, Plan: plan})
+	require.NoError(t, err)
+	require.Len(t, saved.Passport.FloorPlans, 1)
+
+	checked, err := passport.NewCheckFloorPlanAreasUseCase(repo).Execute(ctx, passport.CheckFloorPlanAreasInput{PassportID: p.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 0, checked.Problems)
+
+	rendered, err := passport.NewRenderFloorPlanUseCase(repo, floorplan.NewRenderer()).Execute(ctx, passport.RenderFloorPlanInput{
+		PassportID: p.ID,
+		Litera:     "А",
+		Floor:      "1",
+		Format:     service.PlanFormatSVG,
+	})
+	require.NoError(t, err)
+	assert.Contains(t, string(rendered.Data), "<svg")
+
+	_, err = passport.NewRenderFloorPlanUseCase(repo, floorplan.NewRenderer()).Execute(ctx, passport.RenderFloorPlanInput{
+		PassportID: p.ID,
+		Litera:     "Б",
+		Floor:      "1",
+	})
+	var ve entity.ValidationError
+	require.ErrorAs(t, err, &ve)
+	assert.Equal(t, "floor_plans", ve.Field)
+
+	removed, err := passport.NewRemoveFloorPlanUseCase(repo).Execute(ctx, passport.RemoveFloorPlanInput{PassportID: p.ID, Litera: "А", Floor: "1"})
+	require.NoError(t, err)
+	assert.Empty(t, removed.Passport.FloorPlans)
+	assert.Equal(t, "remove_floor_plan", removed.Passport.AuditLog[len(removed.Passport.AuditLog)-1].Action)
+}
+
+func TestSaveFloorPlan_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		plan  entity.FloorPlan
+		field string
+	}{
+		{
+			name:  "empty plan",
+			plan:  entity.FloorPlan{Litera: "А", Floor: "1"},
+			field: "rooms",
+		},
+		{
+			name:  "opening outside wall",
+			plan:  entity.FloorPlan{Litera: "А", Floor: "1", Walls: []entity.Wall{{End: entity.Point{X: 2}, Thickness: 0.2}}, Openings: []entity.Opening{{Type: entity.OpeningDoor, Offset: 1.5, Width: 0.9}}},
+			field: "openings[0].offset",
+		},
+		{
+			name:  "degenerate contour",
+			plan:  entity.FloorPlan{Litera: "А", Floor: "1", Rooms: []entity.RoomContour{{RoomNumber: "1", Points: []entity.Point{{X: 0}, {X: 1}, {X: 2}}}}},
+			field: "rooms[0].points",
+		},
+		{
+			name:  "duplicate room",
+			plan:  entity.FloorPlan{Litera: "А", Floor: "1", Rooms: []entity.RoomContour{rectangle("1", 0, 0, 1, 1), rectangle(" 1", 1, 0, 1, 1)}},
+			field: "rooms[1].room_number",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := memory.NewInMemoryPassportRepository()
+			p := planPassport(t, repo)
+
+			_, err := passport.NewSaveFloorPlanUseCase(repo).Execute(context.Background(), passport.SaveFloorPlanInput{PassportID: p.ID, Plan: tt.plan})
+			var ve entity.ValidationError
+			require.ErrorAs(t, err, &ve)
+			assert.Equal(t, tt.field, ve.Field)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// GetPassportInput входные данные для получения паспорта
+type GetPassportInput struct {
+	PassportID string
+}
+
+// GetPassportOutput результат получения паспорта
+type GetPassportOutput struct {
+	Passport *entity.TechnicalPassport
+}
+
+// GetPassportUseCase use case для получения паспорта по ID
+type GetPassportUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewGetPassportUseCase создает новый use case
+func NewGetPassportUseCase(repo repository.PassportRepository) *GetPassportUseCase {
+	return &GetPassportUseCase{
+		repo: repo,
+	}
+}
+
+// Execute возвращает паспорт по ID
+func (uc *GetPassportUseCase) Execute(ctx context.Context, input GetPassportInput) (*GetPassportOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	return &GetPassportOutput{
+		Passport: passport,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// ImportPassportInput входные данные для импорта готового паспорта
+type ImportPassportInput struct {
+	Passport *entity.TechnicalPassport
+}
+
+// ImportPassportOutput результат импорта паспорта
+type ImportPassportOutput struct {
+	Passport *entity.TechnicalPassport
+}
+
+// ImportPassportUseCase use case для импорта паспорта, подготовленного вне приложения
+type ImportPassportUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewImportPassportUseCase создает новый use case
+func NewImportPassportUseCase(repo repository.PassportRepository) *ImportPassportUseCase {
+	return &ImportPassportUseCase{
+		repo: repo,
+	}
+}
+
+// Execute выполняет импорт паспорта
+func (uc *ImportPassportUseCase) Execute(ctx context.Context, input ImportPassportInput) (*ImportPassportOutput, error) {
+	// Валидация входных данных
+	if input.Passport == nil {
+		return nil, entity.ValidationError{Field: "passport", Message: "паспорт обязателен"}
+	}
+
+	passport := input.Passport
+	if err := passport.IsValid(); err != nil {
+		return nil, fmt.Errorf("passport validation failed: %w", err)
+	}
+
+	// Паспорт без ID получает новый идентификатор
+	if passport.ID == "" {
+		passport.ID = generateID()
+	}
+
+	// Статус из файла не принимается: импортированный паспорт становится
+	// черновиком и проходит утверждение и выдачу заново. Журнал сохраняется,
+	// сброс статуса и импорт дописываются в него, как при импорте формата обмена
+	if passport.Status != "" && passport.Status != entity.PassportStatusDraft {
+		passport.AddAuditEntry("import", fmt.Sprintf("Статус %s сброшен до черновика при импорте", passport.Status))
+	}
+	passport.Status = entity.PassportStatusDraft
+
+	passport.AddAuditEntry("import", "Технический паспорт импортирован")
+	passport.MarkCreated()
+
+	// Сохраняем в репозиторий
+	if err := uc.repo.Create(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to save passport: %w", err)
+	}
+
+	return &ImportPassportOutput{
+		Passport: passport,
+	}, nil
+}
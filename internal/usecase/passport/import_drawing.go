@@ -0,0 +1,479 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// DefaultRoomLayers слои контуров помещений, если слои не заданы
+var DefaultRoomLayers = []string{"ПОМЕЩЕНИЯ", "ROOMS"}
+
+// DraftRoomPurpose назначение черновика помещения, если на чертеже указан только номер
+const DraftRoomPurpose = "не указано"
+
+// drawingTolerance допуск при сравнении координат контуров (м)
+const drawingTolerance = 0.005
+
+// areaLabel надпись площади помещения ("15,2", "15.20 м2"), которая на чертеже
+// обычно стоит рядом с номером и номером не считается
+var areaLabel = regexp.MustCompile(`^\d+[.,]\d+\s*(м2|м²|кв\.?\s*м)?$`)
+
+// DrawingIssueKind вид замечания к чертежу, требующего ручной проверки
+type DrawingIssueKind string
+
+const (
+	DrawingIssueOpenOutline     DrawingIssueKind = "open_outline"     // Незамкнутая или вырожденная полилиния на слое помещений
+	DrawingIssueNoNumber        DrawingIssueKind = "no_number"        // В контуре нет номера помещения
+	DrawingIssueSeveralNumbers  DrawingIssueKind = "several_numbers"  // В контуре несколько номеров
+	DrawingIssueOrphanNumber    DrawingIssueKind = "orphan_number"    // Номер вне контуров помещений
+	DrawingIssueDuplicateNumber DrawingIssueKind = "duplicate_number" // Номер уже присвоен другому контуру
+	DrawingIssueOverlap         DrawingIssueKind = "overlap"          // Контуры помещений пересекаются
+	DrawingIssueNoPurpose       DrawingIssueKind = "no_purpose"       // У помещения не указано назначение
+)
+
+// DrawingIssue замечание к чертежу. Контуры без номера, с несколькими номерами
+// и с повторяющимся номером в план не попадают; пересекающиеся контуры и
+// помещения без назначения попадают и требуют проверки.
+type DrawingIssue struct {
+	Kind       DrawingIssueKind `json:"kind"`
+	RoomNumber string           `json:"room_number,omitempty"`
+	Layer      string           `json:"layer,omitempty"`
+
+	// At положение на чертеже в единицах чертежа для поиска в CAD-программе
+	At entity.Point `json:"at"`
+
+	Message string `json:"message"`
+}
+
+// DrawingImportOptions параметры разбора чертежа поэтажного плана
+type DrawingImportOptions struct {
+	Litera string
+	Floor  string
+
+	// RoomLayers слои замкнутых полилиний помещений; пустой - DefaultRoomLayers
+	RoomLayers []string
+
+	// TextLayers слои номеров помещений; пустой - надписи любого слоя
+	TextLayers []string
+
+	// Unit единица чертежа, если она не указана в заголовке или указана неверно
+	Unit service.DrawingUnit
+}
+
+// DrawingImportResult поэтажный план и черновики помещений по чертежу
+type DrawingImportResult struct {
+	Plan   entity.FloorPlan
+	Rooms  []entity.Room
+	Issues []DrawingIssue
+}
+
+// drawingOutline замкнутый контур помещения на чертеже
+type drawingOutline struct {
+	layer  string
+	raw    []entity.Point // в единицах чертежа
+	points []entity.Point // в метрах
+	area   float64
+	texts  []service.DrawingText
+}
+
+// BuildFloorPlanFromDrawing строит поэтажный план по чертежу: замкнутые полилинии
+// слоев помещений становятся контурами, надписи внутри контуров - номерами
+// (первое слово) и назначениями (остальные слова). Координаты переводятся в метры
+// и сдвигаются к началу координат.
+func BuildFloorPlanFromDrawing(drawing *service.Drawing, options DrawingImportOptions) (*DrawingImportResult, error) {
+	if options.Litera == "" {
+		return nil, entity.ValidationError{Field: "litera", Message: "литера обязательна"}
+	}
+	if options.Floor == "" {
+		return nil, entity.ValidationError{Field: "floor", Message: "этаж обязателен"}
+	}
+
+	unit := options.Unit
+	if unit == "" {
+		unit = drawing.Unit
+	}
+	if !unit.IsValid() {
+		return nil, entity.ValidationError{Field: "unit", Message: "в чертеже не указаны единицы измерения: укажите mm, cm или m"}
+	}
+	roomLayers := options.RoomLayers
+	if len(roomLayers) == 0 {
+		roomLayers = DefaultRoomLayers
+	}
+
+	result := &DrawingImportResult{}
+
+	// Контуры помещений
+	var outlines []*drawingOutline
+	for _, pl := range drawing.Polylines {
+		if !layerIn(pl.Layer, roomLayers) {
+			continue
+		}
+		outline := &drawingOutline{layer: pl.Layer, raw: pl.Points}
+		for _, p := range pl.Points {
+			outline.points = append(outline.points, entity.Point{X: p.X * unit.Meters(), Y: p.Y * unit.Meters()})
+		}
+		outline.area = entity.RoomContour{Points: outline.points}.Area()
+		if !pl.Closed || len(pl.Points) < 3 || roundDraftArea(outline.area) == 0 {
+			result.Issues = append(result.Issues, DrawingIssue{
+				Kind:    DrawingIssueOpenOutline,
+				Layer:   pl.Layer,
+				At:      pl.Points[0],
+				Message: "полилиния на слое помещений не замкнута или имеет нулевую площадь",
+			})
+			continue
+		}
+		outlines = append(outlines, outline)
+	}
+	if len(outlines) == 0 {
+		return nil, entity.ValidationError{
+			Field:   "source",
+			Message: "на слоях " + strings.Join(roomLayers, ", ") + " нет замкнутых контуров помещений",
+		}
+	}
+
+	// Номера помещений: надпись относится к наименьшему содержащему ее контуру
+	for _, text := range drawing.Texts {
+		if len(options.TextLayers) > 0 && !layerIn(text.Layer, options.TextLayers) {
+			continue
+		}
+		if areaLabel.MatchString(text.Text) {
+			continue
+		}
+
+		var owner *drawingOutline
+		for _, o := range outlines {
+			if pointInPolygon(text.At, o.raw) && (owner == nil || o.area < owner.area) {
+				owner = o
+			}
+		}
+		if owner == nil {
+			// Надписи других слоев вне контуров (штампы, размеры) не интересны
+			if len(options.TextLayers) > 0 {
+				result.Issues = append(result.Issues, DrawingIssue{
+					Kind:       DrawingIssueOrphanNumber,
+					RoomNumber: text.Text,
+					Layer:      text.Layer,
+					At:         text.At,
+					Message:    "номер помещения вне контуров",
+				})
+			}
+			continue
+		}
+		owner.texts = append(owner.texts, text)
+	}
+
+	var accepted []*drawingOutline
+	numbers := map[string]bool{}
+	for _, o := range outlines {
+		at := entity.RoomContour{Points: o.raw}.Centroid()
+		switch len(o.texts) {
+		case 0:
+			result.Issues = append(result.Issues, DrawingIssue{
+				Kind:    DrawingIssueNoNumber,
+				Layer:   o.layer,
+				At:      at,
+				Message: fmt.Sprintf("контур площадью %.1f кв.м без номера помещения", o.area),
+			})
+			continue
+		case 1:
+		default:
+			var texts []string
+			for _, t := range o.texts {
+				texts = append(texts, t.Text)
+			}
+			result.Issues = append(result.Issues, DrawingIssue{
+				Kind:    DrawingIssueSeveralNumbers,
+				Layer:   o.layer,
+				At:      at,
+				Message: "в контуре несколько надписей: " + strings.Join(texts, "; "),
+			})
+			continue
+		}
+
+		number, purpose := splitRoomLabel(o.texts[0].Text)
+		key := strings.ToLower(number)
+		if numbers[key] {
+			result.Issues = append(result.Issues, DrawingIssue{
+				Kind:       DrawingIssueDuplicateNumber,
+				RoomNumber: number,
+				Layer:      o.layer,
+				At:         at,
+				Message:    "номер " + number + " уже присвоен другому контуру",
+			})
+			continue
+		}
+		numbers[key] = true
+		accepted = append(accepted, o)
+
+		room := entity.Room{
+			Litera:     options.Litera,
+			Floor:      options.Floor,
+			RoomNumber: number,
+			Purpose:    purpose,
+			Area:       roundDraftArea(o.area),
+			Note:       "по чертежу DXF",
+		}
+		if purpose == "" {
+			room.Purpose = DraftRoomPurpose
+			result.Issues = append(result.Issues, DrawingIssue{
+				Kind:       DrawingIssueNoPurpose,
+				RoomNumber: number,
+				Layer:      o.layer,
+				At:         at,
+				Message:    "назначение помещения " + number + " не указано",
+			})
+		}
+		result.Rooms = append(result.Rooms, room)
+	}
+
+	// Пересечения контуров (например, помещение, начерченное внутри другого)
+	for i, a := range accepted {
+		for _, b := range accepted[i+1:] {
+			if outlinesOverlap(a.points, b.points) {
+				result.Issues = append(result.Issues, DrawingIssue{
+					Kind:       DrawingIssueOverlap,
+					RoomNumber: outlineNumber(a) + ", " + outlineNumber(b),
+					Layer:      b.layer,
+					At:         entity.RoomContour{Points: b.raw}.Centroid(),
+					Message:    "контуры помещений " + outlineNumber(a) + " и " + outlineNumber(b) + " пересекаются",
+				})
+			}
+		}
+	}
+
+	// План начинается от левого нижнего угла контуров; координаты до миллиметра
+	min := entity.Point{X: math.Inf(1), Y: math.Inf(1)}
+	for _, o := range accepted {
+		for _, p := range o.points {
+			min.X, min.Y = math.Min(min.X, p.X), math.Min(min.Y, p.Y)
+		}
+	}
+	result.Plan = entity.FloorPlan{Litera: options.Litera, Floor: options.Floor}
+	for i, o := range accepted {
+		contour := entity.RoomContour{RoomNumber: result.Rooms[i].RoomNumber}
+		for _, p := range o.points {
+			contour.Points = append(contour.Points, entity.Point{
+				X: math.Round((p.X-min.X)*1000) / 1000,
+				Y: math.Round((p.Y-min.Y)*1000) / 1000,
+			})
+		}
+		result.Plan.Rooms = append(result.Plan.Rooms, contour)
+	}
+
+	return result, nil
+}
+
+// splitRoomLabel делит надпись помещения на номер и назначение: "3 Кухня" -> "3", "Кухня"
+func splitRoomLabel(text string) (number, purpose string) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return "", ""
+	}
+	return fields[0], strings.Join(fields[1:], " ")
+}
+
+// outlineNumber номер помещения принятого контура
+func outlineNumber(o *drawingOutline) string {
+	number, _ := splitRoomLabel(o.texts[0].Text)
+	return number
+}
+
+// roundDraftArea округляет площадь черновика помещения до 0,1 кв.м, как в экспликации
+func roundDraftArea(v float64) float64 {
+	return math.Round(v*10) / 10
+}
+
+// layerIn проверяет слой по списку без учета регистра
+func layerIn(layer string, layers []string) bool {
+	for _, l := range layers {
+		if strings.EqualFold(strings.TrimSpace(l), layer) {
+			return true
+		}
+	}
+	return false
+}
+
+// pointInPolygon проверяет попадание точки в многоугольник (метод луча)
+func pointInPolygon(p entity.Point, polygon []entity.Point) bool {
+	inside := false
+	for i, a := range polygon {
+		b := polygon[(i+1)%len(polygon)]
+		if (a.Y > p.Y) != (b.Y > p.Y) && p.X < a.X+(p.Y-a.Y)*(b.X-a.X)/(b.Y-a.Y) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// outlinesOverlap проверяет пересечение контуров: стороны пересекаются или
+// вершина одного контура лежит внутри другого не ближе допуска к его сторонам.
+// Контуры со смежными сторонами (общая стена без толщины) не пересекаются.
+func outlinesOverlap(a, b []entity.Point) bool {
+	for i := range a {
+		for j := range b {
+			if segmentsCross(a[i], a[(i+1)%len(a)], b[j], b[(j+1)%len(b)]) {
+				return true
+			}
+		}
+	}
+	return strictlyInside(a, b) || strictlyInside(b, a)
+}
+
+// strictlyInside проверяет, что вершина или центр контура inner лежит внутри outer
+// дальше допуска от его сторон
+func strictlyInside(inner, outer []entity.Point) bool {
+	candidates := append([]entity.Point{entity.RoomContour{Points: inner}.Centroid()}, inner...)
+	for _, p := range candidates {
+		if pointInPolygon(p, outer) && distanceToPolygon(p, outer) > drawingTolerance {
+			return true
+		}
+	}
+	return false
+}
+
+// segmentsCross проверяет собственное пересечение отрезков (не касание концами
+// и не наложение на одной прямой)
+func segmentsCross(a, b, c, d entity.Point) bool {
+	d1 := cross(c, d, a)
+	d2 := cross(c, d, b)
+	d3 := cross(a, b, c)
+	d4 := cross(a, b, d)
+	eps := drawingTolerance * drawingTolerance
+	return ((d1 > eps && d2 < -eps) || (d1 < -eps && d2 > eps)) &&
+		((d3 > eps && d4 < -eps) || (d3 < -eps && d4 > eps))
+}
+
+// cross векторное произведение (b - a) x (p - a)
+func cross(a, b, p entity.Point) float64 {
+	return (b.X-a.X)*(p.Y-a.Y) - (b.Y-a.Y)*(p.X-a.X)
+}
+
+// distanceToPolygon расстояние от точки до ближайшей стороны многоугольника
+func distanceToPolygon(p entity.Point, polygon []entity.Point) float64 {
+	best := math.Inf(1)
+	for i, a := range polygon {
+		b := polygon[(i+1)%len(polygon)]
+		dx, dy := b.X-a.X, b.Y-a.Y
+		t := 0.0
+		if l := dx*dx + dy*dy; l > 0 {
+			t = math.Max(0, math.Min(1, ((p.X-a.X)*dx+(p.Y-a.Y)*dy)/l))
+		}
+		best = math.Min(best, math.Hypot(p.X-a.X-t*dx, p.Y-a.Y-t*dy))
+	}
+	return best
+}
+
+// ImportFloorPlanDrawingInput входные данные для загрузки поэтажного плана из чертежа
+type ImportFloorPlanDrawingInput struct {
+	PassportID string
+	Source     io.Reader
+	Options    DrawingImportOptions
+
+	// DryRun только разобрать чертеж, не изменяя паспорт
+	DryRun bool
+}
+
+// ImportFloorPlanDrawingOutput результат загрузки чертежа
+type ImportFloorPlanDrawingOutput struct {
+	Passport *entity.TechnicalPassport
+	Plan     entity.FloorPlan
+
+	// Added черновики помещений, добавленные в экспликацию
+	Added []entity.Room
+
+	// Existing номера помещений, которые уже есть в экспликации и не изменены
+	Existing []string
+
+	Issues []DrawingIssue
+}
+
+// ImportFloorPlanDrawingUseCase use case для загрузки поэтажного плана из чертежа
+// DXF: план литеры и этажа заменяется, помещения, которых нет в экспликации,
+// добавляются черновиками
+type ImportFloorPlanDrawingUseCase struct {
+	repo   repository.PassportRepository
+	parser service.DrawingParser
+}
+
+// NewImportFloorPlanDrawingUseCase создает новый use case
+func NewImportFloorPlanDrawingUseCase(repo repository.PassportRepository, parser service.DrawingParser) *ImportFloorPlanDrawingUseCase {
+	return &ImportFloorPlanDrawingUseCase{
+		repo:   repo,
+		parser: parser,
+	}
+}
+
+// Execute разбирает чертеж и прикрепляет план к паспорту
+func (uc *ImportFloorPlanDrawingUseCase) Execute(ctx context.Context, input ImportFloorPlanDrawingInput) (*ImportFloorPlanDrawingOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if input.Options.Unit != "" && !input.Options.Unit.IsValid() {
+		return nil, entity.ValidationError{Field: "unit", Message: "поддерживаются единицы mm, cm и m"}
+	}
+
+	drawing, err := uc.parser.ParseDrawing(ctx, input.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := BuildFloorPlanFromDrawing(drawing, input.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	output := &ImportFloorPlanDrawingOutput{
+		Passport: passport,
+		Plan:     result.Plan,
+		Issues:   result.Issues,
+	}
+	for _, room := range result.Rooms {
+		if passport.FindRoom(room.Litera, room.Floor, room.RoomNumber) != nil {
+			output.Existing = append(output.Existing, room.RoomNumber)
+		} else {
+			output.Added = append(output.Added, room)
+		}
+	}
+	if input.DryRun {
+		return output, nil
+	}
+
+	if len(result.Plan.Rooms) == 0 {
+		return nil, entity.ValidationError{
+			Field:   "source",
+			Message: fmt.Sprintf("ни один контур не связан с номером помещения (замечаний: %d)", len(result.Issues)),
+		}
+	}
+
+	if err := passport.SetFloorPlan(result.Plan); err != nil {
+		return nil, err
+	}
+	for _, room := range output.Added {
+		if err := passport.AddRoom(room); err != nil {
+			return nil, err
+		}
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return output, nil
+}
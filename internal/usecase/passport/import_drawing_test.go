@@ -0,0 +1,217 @@
+package passport_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/dxf"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// drawingRect замкнутый прямоугольник на чертеже
+func drawingRect(layer string, x1, y1, x2, y2 float64) service.DrawingPolyline {
+	return service.DrawingPolyline{
+		Layer:  layer,
+		Points: []entity.Point{{X: x1, Y: y1}, {X: x2, Y: y1}, {X: x2, Y: y2}, {X: x1, Y: y2}},
+		Closed: true,
+	}
+}
+
+// sampleDrawing чертеж этажа в миллиметрах с типичными ошибками обмерщика
+func sampleDrawing() *service.Drawing {
+	return &service.Drawing{
+		Unit: service.DrawingUnitMillimeter,
+		Polylines: []service.DrawingPolyline{
+			drawingRect("ПОМЕЩЕНИЯ", 1000, 2000, 5000, 7000),  // 1 Жилая, 20 кв.м
+			drawingRect("ПОМЕЩЕНИЯ", 5000, 2000, 8000, 5000),  // 4 без назначения, 9 кв.м
+			drawingRect("ПОМЕЩЕНИЯ", 5000, 5000, 8000, 7000),  // без номера
+			drawingRect("ПОМЕЩЕНИЯ", 1500, 2500, 2500, 3500),  // 5 Шкаф внутри помещения 1
+			drawingRect("ПОМЕЩЕНИЯ", 9000, 2000, 10000, 3000), // повтор номера 4
+			drawingRect("СТЕНЫ", 0, 0, 20000, 20000),
+			{Layer: "ПОМЕЩЕНИЯ", Points: []entity.Point{{X: 0, Y: 0}, {X: 1000, Y: 0}, {X: 1000, Y: 1000}}},
+		},
+		Texts: []service.DrawingText{
+			{Layer: "НОМЕРА", Text: "1 Жилая", At: entity.Point{X: 3000, Y: 5000}},
+			{Layer: "НОМЕРА", Text: "4", At: entity.Point{X: 6500, Y: 3500}},
+			{Layer: "ПЛОЩАДИ", Text: "9,0", At: entity.Point{X: 6500, Y: 3000}},
+			{Layer: "НОМЕРА", Text: "5 Шкаф", At: entity.Point{X: 2000, Y: 3000}},
+			{Layer: "НОМЕРА", Text: "4 Кладовая", At: entity.Point{X: 9500, Y: 2500}},
+			{Layer: "ШТАМП", Text: "Поэтажный план", At: entity.Point{X: 15000, Y: 500}},
+		},
+	}
+}
+
+func TestBuildFloorPlanFromDrawing(t *testing.T) {
+	result, err := passport.BuildFloorPlanFromDrawing(sampleDrawing(), passport.DrawingImportOptions{Litera: "А", Floor: "1"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []entity.Room{
+		{Litera: "А", Floor: "1", RoomNumber: "1", Purpose: "Жилая", Area: 20.0, Note: "по чертежу DXF"},
+		{Litera: "А", Floor: "1", RoomNumber: "4", Purpose: passport.DraftRoomPurpose, Area: 9.0, Note: "по чертежу DXF"},
+		{Litera: "А", Floor: "1", RoomNumber: "5", Purpose: "Шкаф", Area: 1.0, Note: "по чертежу DXF"},
+	}, result.Rooms)
+
+	// Координаты в метрах от левого нижнего угла принятых контуров
+	require.Len(t, result.Plan.Rooms, 3)
+	assert.Equal(t, "4", result.Plan.Rooms[1].RoomNumber)
+	assert.Equal(t, []entity.Point{{X: 4, Y: 0}, {X: 7, Y: 0}, {X: 7, Y: 3}, {X: 4, Y: 3}}, result.Plan.Rooms[1].Points)
+	require.NoError(t, result.Plan.IsValid())
+
+	kinds := map[passport.DrawingIssueKind]string{}
+	for _, issue := range result.Issues {
+		kinds[issue.Kind] = issue.RoomNumber
+	}
+	assert.Equal(t, map[passport.DrawingIssueKind]string{
+		passport.DrawingIssueOpenOutline:     "",
+		passport.DrawingIssueNoNumber:        "",
+		passport.DrawingIssueNoPurpose:       "4",
+		passport.DrawingIssueDuplicateNumber: "4",
+		passport.DrawingIssueOverlap:         "1, 5",
+	}, kinds)
+}
+
+func TestBuildFloorPlanFromDrawing_TextLayers(t *testing.T) {
+	drawing := sampleDrawing()
+	drawing.Texts = append(drawing.Texts, service.DrawingText{Layer: "НОМЕРА", Text: "7", At: entity.Point{X: 15000, Y: 15000}})
+
+	result, err := passport.BuildFloorPlanFromDrawing(drawing, passport.DrawingImportOptions{
+		Litera:     "А",
+		Floor:      "1",
+		TextLayers: []string{"номера"},
+		Unit:       service.DrawingUnitCentimeter,
+	})
+	require.NoError(t, err)
+
+	// Единица из параметров заменяет единицу чертежа
+	assert.Equal(t, 2000.0, result.Rooms[0].Area)
+
+	var orphans []string
+	for _, issue := range result.Issues {
+		if issue.Kind == passport.DrawingIssueOrphanNumber {
+			orphans = append(orphans, issue.RoomNumber)
+		}
+	}
+	assert.Equal(t, []string{"7"}, orphans)
+}
+
+func TestBuildFloorPlanFromDrawing_Errors(t *testing.T) {
+	withoutUnit := sampleDrawing()
+	withoutUnit.Unit = ""
+
+	tests := []struct {
+		name    string
+		drawing *service.Drawing
+		options passport.DrawingImportOptions
+		field   string
+	}{
+		{name: "no litera", drawing: sampleDrawing(), options: passport.DrawingImportOptions{Floor: "1"}, field: "litera"},
+		{name: "no floor", drawing: sampleDrawing(), options: passport.DrawingImportOptions{Litera: "А"}, field: "floor"},
+		{name: "no unit", drawing: withoutUnit, options: passport.DrawingImportOptions{Litera: "А", Floor: "1"}, field: "unit"},
+		{
+			name:    "no outlines on layers",
+			drawing: sampleDrawing(),
+			options: passport.DrawingImportOptions{Litera: "А", Floor: "1", RoomLayers: []string{"КОНТУРЫ"}},
+			field:   "source",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := passport.BuildFloorPlanFromDrawing(tt.drawing, tt.options)
+			var vErr entity.ValidationError
+			require.True(t, errors.As(err, &vErr), "ожидается ValidationError, получено %v", err)
+			assert.Equal(t, tt.field, vErr.Field)
+		})
+	}
+}
+
+// roomsDXF чертеж R2000 в миллиметрах с контурами и номерами помещений
+func roomsDXF(labels map[string][4]float64) string {
+	var b strings.Builder
+	b.WriteString("0\nSECTION\n2\nHEADER\n9\n$INSUNITS\n70\n4\n0\nENDSEC\n0\nSECTION\n2\nENTITIES\n")
+	for _, label := range []string{"2 Кухня", "6 Кладовая"} {
+		r, ok := labels[label]
+		if !ok {
+			continue
+		}
+		fmt.Fprintf(&b, "0\nLWPOLYLINE\n8\nПОМЕЩЕНИЯ\n90\n4\n70\n1\n10\n%[1]g\n20\n%[2]g\n10\n%[3]g\n20\n%[2]g\n10\n%[3]g\n20\n%[4]g\n10\n%[1]g\n20\n%[4]g\n", r[0], r[1], r[2], r[3])
+		fmt.Fprintf(&b, "0\nTEXT\n8\nНОМЕРА\n10\n%g\n20\n%g\n1\n%s\n", (r[0]+r[2])/2, (r[1]+r[3])/2, label)
+	}
+	b.WriteString("0\nENDSEC\n0\nEOF\n")
+	return b.String()
+}
+
+func TestImportFloorPlanDrawingUseCase(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	planPassport(t, repo)
+	uc := passport.NewImportFloorPlanDrawingUseCase(repo, dxf.NewParser())
+
+	data := roomsDXF(map[string][4]float64{
+		"2 Кухня":    {0, 0, 3000, 3000},
+		"6 Кладовая": {3000, 0, 4000, 2000},
+	})
+	input := passport.ImportFloorPlanDrawingInput{
+		PassportID: "TP-PLAN",
+		Source:     strings.NewReader(data),
+		Options:    passport.DrawingImportOptions{Litera: "А", Floor: "1"},
+		DryRun:     true,
+	}
+
+	// Пробный разбор не изменяет паспорт
+	output, err := uc.Execute(ctx, input)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2"}, output.Existing)
+	require.Len(t, output.Added, 1)
+	assert.Equal(t, "6", output.Added[0].RoomNumber)
+	stored, err := repo.GetByID(ctx, "TP-PLAN")
+	require.NoError(t, err)
+	assert.Empty(t, stored.FloorPlans)
+
+	input.Source = strings.NewReader(data)
+	input.DryRun = false
+	output, err = uc.Execute(ctx, input)
+	require.NoError(t, err)
+	assert.Empty(t, output.Issues)
+
+	stored, err = repo.GetByID(ctx, "TP-PLAN")
+	require.NoError(t, err)
+	require.Len(t, stored.FloorPlans, 1)
+	assert.Len(t, stored.FloorPlans[0].Rooms, 2)
+	require.Len(t, stored.Explication, 4)
+	assert.Equal(t, entity.Room{Litera: "А", Floor: "1", RoomNumber: "6", Purpose: "Кладовая", Area: 2.0, Note: "по чертежу DXF"}, stored.Explication[3])
+
+	// Существующее помещение не изменяется
+	assert.Equal(t, 9.0, stored.FindRoom("А", "1", "2").Area)
+}
+
+func TestImportFloorPlanDrawingUseCase_Invalid(t *testing.T) {
+	repo := memory.NewInMemoryPassportRepository()
+	planPassport(t, repo)
+	uc := passport.NewImportFloorPlanDrawingUseCase(repo, dxf.NewParser())
+
+	_, err := uc.Execute(context.Background(), passport.ImportFloorPlanDrawingInput{
+		PassportID: "TP-PLAN",
+		Source:     strings.NewReader(roomsDXF(nil)),
+		Options:    passport.DrawingImportOptions{Litera: "А", Floor: "1", Unit: "inch"},
+	})
+	var vErr entity.ValidationError
+	require.True(t, errors.As(err, &vErr))
+	assert.Equal(t, "unit", vErr.Field)
+
+	_, err = uc.Execute(context.Background(), passport.ImportFloorPlanDrawingInput{
+		PassportID: "TP-PLAN",
+		Source:     strings.NewReader(roomsDXF(nil)),
+		Options:    passport.DrawingImportOptions{Litera: "А", Floor: "1"},
+	})
+	require.True(t, errors.As(err, &vErr))
+	assert.Equal(t, "source", vErr.Field)
+}
@@ -0,0 +1,261 @@
+package passport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// ConflictStrategy определяет поведение импорта, если паспорт с таким ID уже существует
+type ConflictStrategy string
+
+const (
+	ConflictSkip        ConflictStrategy = "skip"      // Пропустить паспорт
+	ConflictOverwrite   ConflictStrategy = "overwrite" // Заменить существующий паспорт
+	ConflictImportAsNew ConflictStrategy = "new"       // Импортировать под новым ID
+)
+
+// IsValid проверяет что стратегия известна системе
+func (s ConflictStrategy) IsValid() bool {
+	switch s {
+	case ConflictSkip, ConflictOverwrite, ConflictImportAsNew:
+		return true
+	}
+	return false
+}
+
+// ImportAction итог импорта отдельного паспорта
+type ImportAction string
+
+const (
+	ImportActionCreated     ImportAction = "created"     // Создан с исходным ID
+	ImportActionSkipped     ImportAction = "skipped"     // Пропущен из-за конфликта
+	ImportActionOverwritten ImportAction = "overwritten" // Заменил существующий
+	ImportActionRenamed     ImportAction = "renamed"     // Создан под новым ID
+)
+
+// ImportInterchangeInput входные данные для загрузки документа формата обмена
+type ImportInterchangeInput struct {
+	// Data документ формата обмена любой поддерживаемой версии
+	Data []byte
+
+	// Conflict стратегия при совпадении ID (по умолчанию ConflictSkip)
+	Conflict ConflictStrategy
+
+	// KeepStatus сохранить статус паспортов из документа;
+	// иначе импортированные паспорта становятся черновиками
+	KeepStatus bool
+}
+
+// ImportResult итог импорта паспорта
+type ImportResult struct {
+	// SourceID ID паспорта в документе
+	SourceID string
+
+	// PassportID ID паспорта в хранилище
+	PassportID string
+
+	Action ImportAction
+}
+
+// ImportInterchangeOutput результат загрузки документа
+type ImportInterchangeOutput struct {
+	// SourceVersion версия схемы исходного документа
+	SourceVersion int
+
+	Results []ImportResult
+}
+
+// ImportInterchangeUseCase use case для загрузки паспортов из версионированного JSON формата обмена
+type ImportInterchangeUseCase struct {
+	repo  repository.PassportRepository
+	codec service.InterchangeCodec
+}
+
+// NewImportInterchangeUseCase создает новый use case
+func NewImportInterchangeUseCase(repo repository.PassportRepository, codec service.InterchangeCodec) *ImportInterchangeUseCase {
+	return &ImportInterchangeUseCase{
+		repo:  repo,
+		codec: codec,
+	}
+}
+
+// Execute выполняет загрузку документа
+// Документ импортируется целиком: если хотя бы один паспорт не проходит
+// проверку, ни один паспорт не сохраняется. Если запись паспорта завершилась
+// ошибкой, уже записанные паспорта документа откатываются
+func (uc *ImportInterchangeUseCase) Execute(ctx context.Context, input ImportInterchangeInput) (*ImportInterchangeOutput, error) {
+	// Валидация входных данных
+	if len(input.Data) == 0 {
+		return nil, entity.ValidationError{Field: "data", Message: "документ пуст"}
+	}
+
+	conflict := input.Conflict
+	if conflict == "" {
+		conflict = ConflictSkip
+	}
+	if !conflict.IsValid() {
+		return nil, entity.ValidationError{Field: "conflict", Message: "допустимые стратегии: skip, overwrite, new"}
+	}
+
+	doc, err := uc.codec.Decode(ctx, input.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode interchange document: %w", err)
+	}
+
+	return uc.importDocument(ctx, doc, conflict, input.KeepStatus)
+}
+
+// importDocument проверяет и сохраняет паспорта прочитанного документа
+func (uc *ImportInterchangeUseCase) importDocument(ctx context.Context, doc *service.InterchangeDocument, conflict ConflictStrategy, keepStatus bool) (*ImportInterchangeOutput, error) {
+	if len(doc.Passports) == 0 {
+		return nil, entity.ValidationError{Field: "passports", Message: "документ не содержит паспортов"}
+	}
+
+	// Полная проверка всех паспортов до записи в хранилище
+	if problems := validateDocument(doc); len(problems) > 0 {
+		return nil, fmt.Errorf("interchange document validation failed: %w", problems)
+	}
+
+	output := &ImportInterchangeOutput{SourceVersion: doc.SourceVersion}
+
+	var written []importedPassport
+	for _, passport := range doc.Passports {
+		result, previous, err := uc.importOne(ctx, passport, conflict, keepStatus, doc.SourceVersion)
+		if err != nil {
+			if rollbackErr := uc.rollback(ctx, written); rollbackErr != nil {
+				return nil, fmt.Errorf("%w; rollback failed: %v", err, rollbackErr)
+			}
+			return nil, err
+		}
+		if result.Action != ImportActionSkipped {
+			written = append(written, importedPassport{id: result.PassportID, previous: previous})
+		}
+		output.Results = append(output.Results, result)
+	}
+
+	return output, nil
+}
+
+// importedPassport паспорт, записанный при импорте документа
+type importedPassport struct {
+	id string
+
+	// previous заменённый паспорт (nil, если паспорт создан)
+	previous *entity.TechnicalPassport
+}
+
+// rollback отменяет запись паспортов документа в обратном порядке:
+// созданные паспорта удаляются, заменённые восстанавливаются
+func (uc *ImportInterchangeUseCase) rollback(ctx context.Context, written []importedPassport) error {
+	var errs []error
+	for i := len(written) - 1; i >= 0; i-- {
+		w := written[i]
+		var err error
+		if w.previous == nil {
+			err = uc.repo.Delete(ctx, w.id)
+		} else {
+			err = uc.repo.Update(ctx, w.previous)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("passport %s: %w", w.id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// importOne сохраняет паспорт с учетом стратегии разрешения конфликтов
+// Для замененного паспорта возвращает его прежнюю версию
+func (uc *ImportInterchangeUseCase) importOne(ctx context.Context, passport *entity.TechnicalPassport, conflict ConflictStrategy, keepStatus bool, version int) (ImportResult, *entity.TechnicalPassport, error) {
+	result := ImportResult{SourceID: passport.ID, Action: ImportActionCreated}
+
+	if !keepStatus && passport.Status != entity.PassportStatusDraft {
+		passport.AddAuditEntry("import", fmt.Sprintf("Статус %s сброшен до черновика при импорте", passport.Status))
+		passport.Status = entity.PassportStatusDraft
+	}
+
+	var existing *entity.TechnicalPassport
+	if passport.ID == "" {
+		passport.ID = generateID()
+	} else {
+		found, err := uc.repo.GetByID(ctx, passport.ID)
+		switch {
+		case err == nil:
+			existing = found
+		case !errors.Is(err, repository.ErrNotFound):
+			return result, nil, fmt.Errorf("failed to get passport: %w", err)
+		}
+	}
+
+	var previous *entity.TechnicalPassport
+	if existing != nil {
+		switch conflict {
+		case ConflictSkip:
+			result.PassportID = passport.ID
+			result.Action = ImportActionSkipped
+			return result, nil, nil
+		case ConflictImportAsNew:
+			passport.ID = generateID()
+			result.Action = ImportActionRenamed
+		case ConflictOverwrite:
+			previous = existing
+			result.Action = ImportActionOverwritten
+		}
+	}
+
+	passport.AddAuditEntry("import", fmt.Sprintf("Технический паспорт импортирован из формата обмена версии %d", version))
+
+	if result.Action == ImportActionOverwritten {
+		if err := uc.repo.Update(ctx, passport); err != nil {
+			return result, nil, fmt.Errorf("failed to update passport: %w", err)
+		}
+	} else {
+		passport.MarkCreated()
+		if err := uc.repo.Create(ctx, passport); err != nil {
+			return result, nil, fmt.Errorf("failed to save passport: %w", err)
+		}
+	}
+
+	result.PassportID = passport.ID
+	return result, previous, nil
+}
+
+// validateDocument выполняет полную проверку паспортов документа; поля ошибок
+// указываются относительно документа (passports[i].field)
+func validateDocument(doc *service.InterchangeDocument) entity.ValidationErrors {
+	var problems entity.ValidationErrors
+	for i, passport := range doc.Passports {
+		for _, e := range validateImported(passport) {
+			e.Field = fmt.Sprintf("passports[%d].%s", i, e.Field)
+			problems = append(problems, e)
+		}
+	}
+	return problems
+}
+
+// validateImported выполняет полную проверку импортируемого паспорта
+func validateImported(passport *entity.TechnicalPassport) []entity.ValidationError {
+	var problems []entity.ValidationError
+
+	if !passport.ObjectType.IsValid() {
+		problems = append(problems, entity.ValidationError{Field: "object_type", Message: "неизвестный тип объекта"})
+	}
+	if !passport.Status.IsValid() {
+		problems = append(problems, entity.ValidationError{Field: "status", Message: "неизвестный статус паспорта"})
+	}
+
+	result := validatePassport(passport, false)
+	problems = append(problems, result.Errors...)
+
+	for i, owner := range passport.Owners {
+		if owner.PersonType != entity.PersonTypeIndividual && owner.PersonType != entity.PersonTypeLegal {
+			problems = append(problems, entity.ValidationError{Field: fmt.Sprintf("owners[%d].person_type", i), Message: "неизвестный тип лица"})
+		}
+	}
+
+	return problems
+}
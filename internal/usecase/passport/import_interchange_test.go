@@ -0,0 +1,157 @@
+package passport_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/interchange"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newInterchangePassport(id, org string) *entity.TechnicalPassport {
+	p := entity.NewTechnicalPassport(
+		entity.ObjectTypeResidentialHouse,
+		entity.Address{Subject: "г. Москва", House: "1"},
+	)
+	p.ID = id
+	p.OrganizationName = org
+	p.Status = entity.PassportStatusApproved
+	p.GeneralInfo = entity.GeneralInfo{Purpose: "Жилое", ConstructionYear: 2020, TotalArea: 100.0}
+	return p
+}
+
+func TestImportInterchangeUseCase_Conflicts(t *testing.T) {
+	tests := []struct {
+		name       string
+		conflict   passport.ConflictStrategy
+		keepStatus bool
+		wantAction passport.ImportAction
+		wantOrg    string // организация паспорта TP-1 после импорта
+		wantCount  int
+		wantStatus entity.PassportStatus
+	}{
+		{"skip leaves existing passport", passport.ConflictSkip, false, passport.ImportActionSkipped, "Старая", 1, entity.PassportStatusDraft},
+		{"overwrite replaces existing passport", passport.ConflictOverwrite, false, passport.ImportActionOverwritten, "Новая", 1, entity.PassportStatusDraft},
+		{"new imports under new ID", passport.ConflictImportAsNew, true, passport.ImportActionRenamed, "Старая", 2, entity.PassportStatusApproved},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := memory.NewInMemoryPassportRepository()
+			codec := interchange.NewCodec()
+
+			existing := newInterchangePassport("TP-1", "Старая")
+			existing.Status = entity.PassportStatusDraft
+			require.NoError(t, repo.Create(ctx, existing))
+
+			data, err := codec.Encode(ctx, []*entity.TechnicalPassport{newInterchangePassport("TP-1", "Новая")})
+			require.NoError(t, err)
+
+			uc := passport.NewImportInterchangeUseCase(repo, codec)
+			output, err := uc.Execute(ctx, passport.ImportInterchangeInput{Data: data, Conflict: tt.conflict, KeepStatus: tt.keepStatus})
+			require.NoError(t, err)
+
+			require.Len(t, output.Results, 1)
+			result := output.Results[0]
+			assert.Equal(t, tt.wantAction, result.Action)
+			assert.Equal(t, "TP-1", result.SourceID)
+
+			stored, err := repo.GetByID(ctx, "TP-1")
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOrg, stored.OrganizationName)
+
+			all, err := repo.List(ctx)
+			require.NoError(t, err)
+			assert.Len(t, all, tt.wantCount)
+
+			imported, err := repo.GetByID(ctx, result.PassportID)
+			require.NoError(t, err)
+			if tt.wantAction != passport.ImportActionSkipped {
+				assert.Equal(t, tt.wantStatus, imported.Status)
+			}
+		})
+	}
+}
+
+func TestImportInterchangeUseCase_RejectsInvalidDocument(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	codec := interchange.NewCodec()
+
+	valid := newInterchangePassport("TP-1", "ГУП БТИ")
+	invalid := newInterchangePassport("TP-2", "ГУП БТИ")
+	invalid.Address.House = ""
+	invalid.ObjectType = "castle"
+
+	data, err := codec.Encode(ctx, []*entity.TechnicalPassport{valid, invalid})
+	require.NoError(t, err)
+
+	uc := passport.NewImportInterchangeUseCase(repo, codec)
+	_, err = uc.Execute(ctx, passport.ImportInterchangeInput{Data: data})
+	require.Error(t, err)
+
+	var problems entity.ValidationErrors
+	require.ErrorAs(t, err, &problems)
+	assert.Len(t, problems, 2)
+	assert.Equal(t, "passports[1].object_type", problems[0].Field)
+	assert.Equal(t, "passports[1].house", problems[1].Field)
+
+	// Документ импортируется целиком или не импортируется вовсе
+	all, err := repo.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, all)
+}
+
+// failingCreateRepository хранилище, в котором не удается создать паспорт с заданным ID
+type failingCreateRepository struct {
+	repository.PassportRepository
+	failID string
+}
+
+func (r failingCreateRepository) Create(ctx context.Context, passport *entity.TechnicalPassport) error {
+	if passport.ID == r.failID {
+		return errors.New("disk failure")
+	}
+	return r.PassportRepository.Create(ctx, passport)
+}
+
+func TestImportInterchangeUseCase_RollsBackOnWriteError(t *testing.T) {
+	ctx := context.Background()
+	inner := memory.NewInMemoryPassportRepository()
+	codec := interchange.NewCodec()
+
+	existing := newInterchangePassport("TP-1", "Старая")
+	require.NoError(t, inner.Create(ctx, existing))
+
+	data, err := codec.Encode(ctx, []*entity.TechnicalPassport{
+		newInterchangePassport("TP-1", "Новая"),
+		newInterchangePassport("TP-2", "ГУП БТИ"),
+		newInterchangePassport("TP-3", "ГУП БТИ"),
+	})
+	require.NoError(t, err)
+
+	uc := passport.NewImportInterchangeUseCase(failingCreateRepository{inner, "TP-3"}, codec)
+	output, err := uc.Execute(ctx, passport.ImportInterchangeInput{Data: data, Conflict: passport.ConflictOverwrite})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "disk failure")
+	assert.Nil(t, output)
+
+	// Замененный паспорт восстановлен, созданный удален
+	stored, err := inner.GetByID(ctx, "TP-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Старая", stored.OrganizationName)
+
+	_, err = inner.GetByID(ctx, "TP-2")
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+
+	all, err := inner.List(ctx)
+	require.NoError(t, err)
+	assert.Len(t, all, 1)
+}
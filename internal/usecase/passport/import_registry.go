@@ -0,0 +1,406 @@
+package passport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// DuplicateStrategy определяет поведение импорта, если паспорт с таким адресом уже есть
+type DuplicateStrategy string
+
+const (
+	DuplicateSkip   DuplicateStrategy = "skip"   // Пропустить запись реестра
+	DuplicateUpdate DuplicateStrategy = "update" // Обновить найденный паспорт
+	DuplicateCreate DuplicateStrategy = "create" // Создать еще один паспорт
+)
+
+// IsValid проверяет что стратегия известна системе
+func (s DuplicateStrategy) IsValid() bool {
+	switch s {
+	case DuplicateSkip, DuplicateUpdate, DuplicateCreate:
+		return true
+	}
+	return false
+}
+
+// RegistryAction итог обработки записи реестра
+type RegistryAction string
+
+const (
+	RegistryActionCreated RegistryAction = "created" // Создан паспорт
+	RegistryActionUpdated RegistryAction = "updated" // Обновлен паспорт с тем же адресом
+	RegistryActionSkipped RegistryAction = "skipped" // Пропущен как дубликат
+	RegistryActionFailed  RegistryAction = "failed"  // Не прошел проверку
+)
+
+// RegistryRowResult итог обработки записи реестра (одной или нескольких строк файла)
+type RegistryRowResult struct {
+	// Line первая строка записи в файле
+	Line int
+
+	// LastLine последняя строка записи в файле
+	LastLine int
+
+	// Key идентификатор записи в старой системе
+	Key string
+
+	Action RegistryAction
+
+	// PassportID созданный, обновленный или найденный паспорт
+	PassportID string
+
+	// Errors ошибки записи; ошибки разбора ячеек имеют вид line[N].field
+	Errors []entity.ValidationError
+}
+
+// ImportRegistryInput входные данные для загрузки реестра паспортов
+type ImportRegistryInput struct {
+	// Source файл реестра; читается потоково
+	Source io.Reader
+
+	// SourceName идентификатор файла для сверки с сохраненной позицией импорта
+	SourceName string
+
+	Mapping service.RegistryMapping
+
+	// Duplicates поведение при совпадении адреса (по умолчанию DuplicateSkip)
+	Duplicates DuplicateStrategy
+
+	// DryRun проверить реестр и найти дубликаты без записи в хранилище
+	DryRun bool
+
+	// Checkpoint хранилище позиции для возобновления; nil - импорт с начала файла.
+	// В пробном режиме позиция не читается и не сохраняется.
+	Checkpoint service.RegistryCheckpoint
+
+	// OnRow получает итог каждой записи по мере обработки;
+	// если не задан, итоги собираются в ImportRegistryOutput.Rows
+	OnRow func(RegistryRowResult) error
+}
+
+// ImportRegistryOutput результат загрузки реестра
+type ImportRegistryOutput struct {
+	DryRun bool
+
+	// ResumedAfter строка, после которой продолжен прерванный импорт; 0 - с начала
+	ResumedAfter int
+
+	// Created, Updated, Skipped, Failed количество записей по итогам,
+	// включая обработанные до прерывания
+	Created int
+	Updated int
+	Skipped int
+	Failed  int
+
+	Rows []RegistryRowResult
+}
+
+// ImportRegistryUseCase use case для потоковой загрузки паспортов из реестра старой системы (CSV)
+type ImportRegistryUseCase struct {
+	repo   repository.PassportRepository
+	parser service.RegistryParser
+}
+
+// NewImportRegistryUseCase создает новый use case
+func NewImportRegistryUseCase(repo repository.PassportRepository, parser service.RegistryParser) *ImportRegistryUseCase {
+	return &ImportRegistryUseCase{
+		repo:   repo,
+		parser: parser,
+	}
+}
+
+// registryImport состояние одного запуска импорта
+type registryImport struct {
+	uc       *ImportRegistryUseCase
+	input    ImportRegistryInput
+	output   *ImportRegistryOutput
+	progress service.RegistryProgress
+
+	// seen адреса записей этого запуска в пробном режиме, когда
+	// созданные паспорта не попадают в хранилище
+	seen map[string]string
+}
+
+// Execute выполняет загрузку реестра.
+// Каждая запись сохраняется отдельно, после нее сохраняется позиция импорта:
+// при прерывании повторный запуск с тем же хранилищем позиции продолжит
+// со следующей записи.
+func (uc *ImportRegistryUseCase) Execute(ctx context.Context, input ImportRegistryInput) (*ImportRegistryOutput, error) {
+	// Валидация входных данных
+	if input.Source == nil {
+		return nil, entity.ValidationError{Field: "source", Message: "файл реестра обязателен"}
+	}
+	if input.Duplicates == "" {
+		input.Duplicates = DuplicateSkip
+	}
+	if !input.Duplicates.IsValid() {
+		return nil, entity.ValidationError{Field: "duplicates", Message: "допустимые стратегии: skip, update, create"}
+	}
+	if err := ValidateRegistryMapping(input.Mapping); err != nil {
+		return nil, fmt.Errorf("invalid registry mapping: %w", err)
+	}
+
+	run := &registryImport{
+		uc:       uc,
+		input:    input,
+		output:   &ImportRegistryOutput{DryRun: input.DryRun},
+		progress: service.RegistryProgress{Source: input.SourceName},
+		seen:     map[string]string{},
+	}
+
+	if input.Checkpoint != nil && !input.DryRun {
+		saved, err := input.Checkpoint.Load(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load import checkpoint: %w", err)
+		}
+		if saved != nil {
+			if saved.Source != input.SourceName {
+				return nil, entity.ValidationError{Field: "checkpoint", Message: fmt.Sprintf("позиция импорта относится к другому файлу: %s", saved.Source)}
+			}
+			run.progress = *saved
+			run.output.ResumedAfter = saved.Line
+		}
+	}
+	run.syncCounters()
+
+	if run.progress.Completed {
+		return run.output, nil
+	}
+
+	rows, err := uc.parser.Open(input.Source, input.Mapping)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open registry: %w", err)
+	}
+
+	if err := run.stream(ctx, rows); err != nil {
+		return run.output, err
+	}
+
+	run.progress.Completed = true
+	if err := run.save(ctx); err != nil {
+		return run.output, err
+	}
+
+	return run.output, nil
+}
+
+// stream читает строки и обрабатывает записи по мере их завершения
+func (r *registryImport) stream(ctx context.Context, rows service.RegistryReader) error {
+	groupField := registryGroupField(r.input.Mapping)
+
+	var group []*service.RegistryRow
+	for {
+		row, err := rows.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read registry: %w", err)
+		}
+
+		// Строки, обработанные до прерывания
+		if row.Line <= r.progress.Line {
+			continue
+		}
+
+		if len(group) > 0 && !sameRecord(groupField, group[0], row) {
+			if err := r.record(ctx, group); err != nil {
+				return err
+			}
+			group = nil
+		}
+		group = append(group, row)
+	}
+
+	if len(group) > 0 {
+		return r.record(ctx, group)
+	}
+	return nil
+}
+
+// sameRecord проверяет, относится ли строка к той же записи реестра
+func sameRecord(groupField string, first, row *service.RegistryRow) bool {
+	if groupField == "" {
+		return false
+	}
+	key := strings.TrimSpace(row.Values[groupField])
+	return key != "" && key == strings.TrimSpace(first.Values[groupField])
+}
+
+// record обрабатывает запись реестра и сохраняет позицию импорта
+func (r *registryImport) record(ctx context.Context, group []*service.RegistryRow) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	result, err := r.apply(ctx, group)
+	if err != nil {
+		return err
+	}
+
+	switch result.Action {
+	case RegistryActionCreated:
+		r.progress.Created++
+	case RegistryActionUpdated:
+		r.progress.Updated++
+	case RegistryActionSkipped:
+		r.progress.Skipped++
+	case RegistryActionFailed:
+		r.progress.Failed++
+	}
+	r.progress.Line = result.LastLine
+	r.syncCounters()
+
+	if err := r.save(ctx); err != nil {
+		return err
+	}
+
+	if r.input.OnRow != nil {
+		return r.input.OnRow(result)
+	}
+	r.output.Rows = append(r.output.Rows, result)
+	return nil
+}
+
+// apply собирает паспорт записи, ищет дубликаты и сохраняет результат
+func (r *registryImport) apply(ctx context.Context, group []*service.RegistryRow) (RegistryRowResult, error) {
+	passport, key, problems := buildRegistryPassport(r.input.Mapping, group)
+	result := RegistryRowResult{
+		Line:     group[0].Line,
+		LastLine: group[len(group)-1].Line,
+		Key:      key,
+	}
+
+	if passport.Status == "" {
+		passport.Status = entity.PassportStatusDraft
+	}
+	problems = append(problems, validateImported(passport)...)
+	if len(problems) > 0 {
+		result.Action = RegistryActionFailed
+		result.Errors = problems
+		return result, nil
+	}
+
+	existing, err := r.duplicate(ctx, passport)
+	if err != nil {
+		return result, err
+	}
+
+	origin := fmt.Sprintf("реестра %s, строка %d", r.input.SourceName, result.Line)
+	if key != "" {
+		origin += ", ключ " + key
+	}
+
+	switch {
+	case existing != nil && r.input.Duplicates == DuplicateSkip:
+		result.Action = RegistryActionSkipped
+		result.PassportID = existing.ID
+		return result, nil
+
+	case existing != nil && r.input.Duplicates == DuplicateUpdate:
+		result.Action = RegistryActionUpdated
+		result.PassportID = existing.ID
+		if r.input.DryRun || existing.TechnicalPassport == nil {
+			return result, nil
+		}
+		mergeRegistryPassport(existing.TechnicalPassport, passport)
+		existing.AddAuditEntry("import_registry", "Обновлен из "+origin)
+		if err := r.uc.repo.Update(ctx, existing.TechnicalPassport); err != nil {
+			return result, fmt.Errorf("failed to update passport %s: %w", existing.ID, err)
+		}
+		return result, nil
+	}
+
+	result.Action = RegistryActionCreated
+	passport.ID = generateID()
+	result.PassportID = passport.ID
+	if r.input.DryRun {
+		r.seen[registryAddressKey(passport.Address)] = passport.ID
+		return result, nil
+	}
+
+	passport.AddAuditEntry("import_registry", "Импортирован из "+origin)
+	if err := r.uc.repo.Create(ctx, passport); err != nil {
+		return result, fmt.Errorf("failed to save passport: %w", err)
+	}
+	return result, nil
+}
+
+// registryDuplicate найденный дубликат записи; в пробном режиме паспорт
+// может быть создан только в памяти этого запуска
+type registryDuplicate struct {
+	ID string
+	*entity.TechnicalPassport
+}
+
+// duplicate ищет паспорт с тем же адресом в хранилище,
+// а в пробном режиме - и среди записей этого запуска
+func (r *registryImport) duplicate(ctx context.Context, passport *entity.TechnicalPassport) (*registryDuplicate, error) {
+	found, err := r.uc.repo.FindByAddress(ctx, passport.Address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find passports by address: %w", err)
+	}
+	if len(found) > 0 {
+		return &registryDuplicate{ID: found[0].ID, TechnicalPassport: found[0]}, nil
+	}
+
+	if r.input.DryRun {
+		if id, ok := r.seen[registryAddressKey(passport.Address)]; ok {
+			return &registryDuplicate{ID: id}, nil
+		}
+	}
+	return nil, nil
+}
+
+// registryAddressKey ключ адреса для поиска дубликатов в пробном режиме
+func registryAddressKey(a entity.Address) string {
+	return strings.ToLower(a.FullAddress())
+}
+
+// mergeRegistryPassport переносит сведения записи реестра в найденный паспорт.
+// Правообладатели и здания заменяются, только если они есть в записи.
+func mergeRegistryPassport(target, source *entity.TechnicalPassport) {
+	target.ObjectType = source.ObjectType
+	target.GeneralInfo = source.GeneralInfo
+	if source.OrganizationName != "" {
+		target.OrganizationName = source.OrganizationName
+	}
+	if source.InventoryNumber != "" {
+		target.InventoryNumber = source.InventoryNumber
+	}
+	if source.CadastralNumber != "" {
+		target.CadastralNumber = source.CadastralNumber
+	}
+	if len(source.Owners) > 0 {
+		target.Owners = source.Owners
+	}
+	if len(source.Buildings) > 0 {
+		target.Buildings = source.Buildings
+	}
+}
+
+// syncCounters переносит счетчики позиции импорта в результат
+func (r *registryImport) syncCounters() {
+	r.output.Created = r.progress.Created
+	r.output.Updated = r.progress.Updated
+	r.output.Skipped = r.progress.Skipped
+	r.output.Failed = r.progress.Failed
+}
+
+// save сохраняет позицию импорта, если задано хранилище
+func (r *registryImport) save(ctx context.Context) error {
+	if r.input.Checkpoint == nil || r.input.DryRun {
+		return nil
+	}
+	if err := r.input.Checkpoint.Save(ctx, r.progress); err != nil {
+		return fmt.Errorf("failed to save import checkpoint: %w", err)
+	}
+	return nil
+}
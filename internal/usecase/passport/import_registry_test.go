@@ -0,0 +1,297 @@
+package passport_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/registry"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// legacyRegistry выгрузка реестра старой системы: у объекта 2 два правообладателя,
+// у объекта 3 не указан год постройки, объект 4 повторяет адрес объекта 1
+const legacyRegistry = `Код;Тип;Город;Улица;Дом;Назначение;Год;Площадь;Собственник;Лит;Здание;Ввод
+1;Жилой дом;Тула;ул. Ленина;1;Жилое;1965;54,3;Иванов И.И.;А;Жилой дом;1965
+2;Жилой дом;Тула;ул. Ленина;2;Жилое;1970;80;Петров П.П.;А;Жилой дом;1970
+2;;;;;;;;Петрова А.А.;Г;Гараж;1985
+3;Жилой дом;Тула;ул. Мира;5;Жилое;;60;Сидоров С.С.;;;
+4;Жилой дом;Тула;ул. Ленина;1;Жилое;1965;55,1;Иванов И.И.;;;
+`
+
+// legacyMapping описание столбцов реестра legacyRegistry
+func legacyMapping() service.RegistryMapping {
+	return service.RegistryMapping{
+		Columns: map[string]string{
+			"key":                            "Код",
+			"object_type":                    "Тип",
+			"address.city":                   "Город",
+			"address.street":                 "Улица",
+			"address.house":                  "Дом",
+			"general_info.purpose":           "Назначение",
+			"general_info.construction_year": "Год",
+			"general_info.total_area":        "Площадь",
+			"owners.full_name":               "Собственник",
+			"buildings.litera":               "Лит",
+			"buildings.name":                 "Здание",
+			"buildings.commission_year":      "Ввод",
+		},
+		Defaults: map[string]string{
+			"address.subject":       "Тульская область",
+			"owners.right_type":     "Собственность",
+			"owners.right_document": "Регистрационное удостоверение",
+			"owners.share":          "1",
+			"owners.entry_date":     "01.01.1995",
+		},
+		Dictionaries: map[string]map[string]string{
+			"object_type": {"жилой дом": string(entity.ObjectTypeResidentialHouse)},
+		},
+	}
+}
+
+// memoryCheckpoint позиция импорта в памяти
+type memoryCheckpoint struct {
+	saved *service.RegistryProgress
+}
+
+func (c *memoryCheckpoint) Load(ctx context.Context) (*service.RegistryProgress, error) {
+	return c.saved, nil
+}
+
+func (c *memoryCheckpoint) Save(ctx context.Context, progress service.RegistryProgress) error {
+	c.saved = &progress
+	return nil
+}
+
+func TestImportRegistry_Duplicates(t *testing.T) {
+	tests := []struct {
+		name       string
+		duplicates passport.DuplicateStrategy
+		actions    []passport.RegistryAction
+		stored     int
+	}{
+		{
+			name:       "skip",
+			duplicates: passport.DuplicateSkip,
+			actions:    []passport.RegistryAction{"created", "created", "failed", "skipped"},
+			stored:     2,
+		},
+		{
+			name:       "update",
+			duplicates: passport.DuplicateUpdate,
+			actions:    []passport.RegistryAction{"created", "created", "failed", "updated"},
+			stored:     2,
+		},
+		{
+			name:       "create",
+			duplicates: passport.DuplicateCreate,
+			actions:    []passport.RegistryAction{"created", "created", "failed", "created"},
+			stored:     3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := memory.NewInMemoryPassportRepository()
+			uc := passport.NewImportRegistryUseCase(repo, registry.NewParser())
+
+			output, err := uc.Execute(ctx, passport.ImportRegistryInput{
+				Source:     strings.NewReader(legacyRegistry),
+				SourceName: "registry.csv",
+				Mapping:    legacyMapping(),
+				Duplicates: tt.duplicates,
+			})
+			require.NoError(t, err)
+
+			require.Len(t, output.Rows, len(tt.actions))
+			for i, action := range tt.actions {
+				assert.Equal(t, action, output.Rows[i].Action, "запись %d", i)
+			}
+
+			stored, err := repo.List(ctx)
+			require.NoError(t, err)
+			assert.Len(t, stored, tt.stored)
+
+			found, err := repo.FindByAddress(ctx, entity.Address{Subject: "Тульская область", City: "Тула", Street: "ул. Ленина", House: "1"})
+			require.NoError(t, err)
+			var areas []float64
+			for _, p := range found {
+				areas = append(areas, p.GeneralInfo.TotalArea)
+			}
+			// Порядок паспортов с одинаковым адресом в хранилище не определен
+			switch tt.duplicates {
+			case passport.DuplicateUpdate:
+				assert.Equal(t, []float64{55.1}, areas)
+			case passport.DuplicateCreate:
+				assert.ElementsMatch(t, []float64{54.3, 55.1}, areas)
+			default:
+				assert.Equal(t, []float64{54.3}, areas)
+			}
+		})
+	}
+}
+
+func TestImportRegistry_GroupsRowsAndReportsErrors(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	uc := passport.NewImportRegistryUseCase(repo, registry.NewParser())
+
+	output, err := uc.Execute(ctx, passport.ImportRegistryInput{
+		Source:     strings.NewReader(legacyRegistry),
+		SourceName: "registry.csv",
+		Mapping:    legacyMapping(),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, output.Created)
+	assert.Equal(t, 1, output.Skipped)
+	assert.Equal(t, 1, output.Failed)
+
+	second := output.Rows[1]
+	assert.Equal(t, "2", second.Key)
+	assert.Equal(t, 3, second.Line)
+	assert.Equal(t, 4, second.LastLine)
+
+	created, err := repo.GetByID(ctx, second.PassportID)
+	require.NoError(t, err)
+	require.Len(t, created.Owners, 2)
+	assert.Equal(t, "Петрова А.А.", created.Owners[1].FullName)
+	assert.Equal(t, entity.PersonTypeIndividual, created.Owners[1].PersonType)
+	require.Len(t, created.Buildings, 2)
+	assert.Equal(t, "Гараж", created.Buildings[1].Name)
+	assert.Equal(t, entity.ObjectTypeResidentialHouse, created.ObjectType)
+	require.NotEmpty(t, created.AuditLog)
+	assert.Contains(t, created.AuditLog[len(created.AuditLog)-1].Description, "registry.csv, строка 3, ключ 2")
+
+	failed := output.Rows[2]
+	assert.Equal(t, passport.RegistryActionFailed, failed.Action)
+	require.Len(t, failed.Errors, 1)
+	assert.Equal(t, "construction_year", failed.Errors[0].Field)
+}
+
+func TestImportRegistry_CellErrors(t *testing.T) {
+	source := "Код;Тип;Город;Улица;Дом;Назначение;Год;Площадь;Собственник;Лит;Здание;Ввод\n" +
+		"1;Жилой дом;Тула;ул. Ленина;1;Жилое;1965 г.;54,3;;;;\n"
+
+	uc := passport.NewImportRegistryUseCase(memory.NewInMemoryPassportRepository(), registry.NewParser())
+	output, err := uc.Execute(context.Background(), passport.ImportRegistryInput{
+		Source:  strings.NewReader(source),
+		Mapping: legacyMapping(),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, output.Rows, 1)
+	require.NotEmpty(t, output.Rows[0].Errors)
+	assert.Equal(t, "line[2].general_info.construction_year", output.Rows[0].Errors[0].Field)
+}
+
+func TestImportRegistry_DryRun(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	checkpoint := &memoryCheckpoint{}
+	uc := passport.NewImportRegistryUseCase(repo, registry.NewParser())
+
+	output, err := uc.Execute(ctx, passport.ImportRegistryInput{
+		Source:     strings.NewReader(legacyRegistry),
+		SourceName: "registry.csv",
+		Mapping:    legacyMapping(),
+		DryRun:     true,
+		Checkpoint: checkpoint,
+	})
+	require.NoError(t, err)
+
+	assert.True(t, output.DryRun)
+	assert.Equal(t, 2, output.Created)
+	assert.Equal(t, 1, output.Skipped, "дубликат внутри файла находится и без записи")
+	assert.Equal(t, 1, output.Failed)
+
+	stored, err := repo.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, stored)
+	assert.Nil(t, checkpoint.saved)
+}
+
+func TestImportRegistry_Resume(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	checkpoint := &memoryCheckpoint{}
+	uc := passport.NewImportRegistryUseCase(repo, registry.NewParser())
+
+	// Прерывание после второй записи
+	interrupted := errors.New("interrupted")
+	processed := 0
+	input := passport.ImportRegistryInput{
+		Source:     strings.NewReader(legacyRegistry),
+		SourceName: "registry.csv",
+		Mapping:    legacyMapping(),
+		Checkpoint: checkpoint,
+		OnRow: func(passport.RegistryRowResult) error {
+			processed++
+			if processed == 2 {
+				return interrupted
+			}
+			return nil
+		},
+	}
+	_, err := uc.Execute(ctx, input)
+	require.ErrorIs(t, err, interrupted)
+	require.NotNil(t, checkpoint.saved)
+	assert.Equal(t, 4, checkpoint.saved.Line)
+	assert.False(t, checkpoint.saved.Completed)
+
+	input.Source = strings.NewReader(legacyRegistry)
+	input.OnRow = nil
+	output, err := uc.Execute(ctx, input)
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, output.ResumedAfter)
+	require.Len(t, output.Rows, 2)
+	assert.Equal(t, 5, output.Rows[0].Line)
+	assert.Equal(t, 2, output.Created)
+	assert.Equal(t, 1, output.Skipped)
+	assert.Equal(t, 1, output.Failed)
+	assert.True(t, checkpoint.saved.Completed)
+
+	stored, err := repo.List(ctx)
+	require.NoError(t, err)
+	assert.Len(t, stored, 2)
+
+	// Позиция другого файла не применяется
+	input.Source = strings.NewReader(legacyRegistry)
+	input.SourceName = "other.csv"
+	_, err = uc.Execute(ctx, input)
+	var ve entity.ValidationError
+	require.ErrorAs(t, err, &ve)
+	assert.Equal(t, "checkpoint", ve.Field)
+}
+
+func TestImportRegistry_InvalidMapping(t *testing.T) {
+	mapping := legacyMapping()
+	mapping.Columns["owners.inn"] = "ИНН"
+	mapping.Columns["address.house"] = "Номер дома"
+
+	uc := passport.NewImportRegistryUseCase(memory.NewInMemoryPassportRepository(), registry.NewParser())
+
+	_, err := uc.Execute(context.Background(), passport.ImportRegistryInput{
+		Source:  strings.NewReader(legacyRegistry),
+		Mapping: mapping,
+	})
+	var problems entity.ValidationErrors
+	require.ErrorAs(t, err, &problems)
+	assert.Equal(t, "columns.owners.inn", problems[0].Field)
+
+	delete(mapping.Columns, "owners.inn")
+	_, err = uc.Execute(context.Background(), passport.ImportRegistryInput{
+		Source:  strings.NewReader(legacyRegistry),
+		Mapping: mapping,
+	})
+	require.ErrorAs(t, err, &problems)
+	assert.Equal(t, "columns.address.house", problems[0].Field)
+}
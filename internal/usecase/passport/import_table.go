@@ -0,0 +1,229 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// SheetPreview лист книги с предложенным сопоставлением столбцов
+type SheetPreview struct {
+	Name string
+
+	Rows [][]string
+
+	// HeaderRow номер строки заголовка с 1; 0, если заголовок не найден
+	HeaderRow int
+
+	// Mapping предложенное сопоставление полей столбцам
+	Mapping ColumnMapping
+}
+
+// PreviewSpreadsheetInput входные данные для предварительного просмотра книги
+type PreviewSpreadsheetInput struct {
+	// Data содержимое файла XLSX
+	Data []byte
+
+	// Table таблица паспорта, для которой подбирается сопоставление
+	Table TableKind
+}
+
+// PreviewSpreadsheetOutput результат предварительного просмотра книги
+type PreviewSpreadsheetOutput struct {
+	Sheets []SheetPreview
+}
+
+// PreviewSpreadsheetUseCase use case для чтения книги и подбора сопоставления
+// столбцов перед импортом (шаги мастера импорта)
+type PreviewSpreadsheetUseCase struct {
+	codec service.SpreadsheetCodec
+}
+
+// NewPreviewSpreadsheetUseCase создает новый use case
+func NewPreviewSpreadsheetUseCase(codec service.SpreadsheetCodec) *PreviewSpreadsheetUseCase {
+	return &PreviewSpreadsheetUseCase{
+		codec: codec,
+	}
+}
+
+// Execute выполняет чтение книги
+func (uc *PreviewSpreadsheetUseCase) Execute(ctx context.Context, input PreviewSpreadsheetInput) (*PreviewSpreadsheetOutput, error) {
+	// Валидация входных данных
+	if len(input.Data) == 0 {
+		return nil, entity.ValidationError{Field: "data", Message: "файл пуст"}
+	}
+	if !input.Table.IsValid() {
+		return nil, entity.ValidationError{Field: "table", Message: "допустимые таблицы: explication, buildings"}
+	}
+
+	sheets, err := uc.codec.ReadSheets(ctx, input.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spreadsheet: %w", err)
+	}
+
+	output := &PreviewSpreadsheetOutput{Sheets: make([]SheetPreview, 0, len(sheets))}
+	for _, sheet := range sheets {
+		preview := SheetPreview{Name: sheet.Name, Rows: sheet.Rows, Mapping: ColumnMapping{}}
+		if header := DetectHeaderRow(input.Table, sheet.Rows); header >= 0 {
+			preview.HeaderRow = header + 1
+			preview.Mapping = SuggestMapping(input.Table, sheet.Rows[header])
+		}
+		output.Sheets = append(output.Sheets, preview)
+	}
+
+	return output, nil
+}
+
+// ImportTableInput входные данные для загрузки таблицы паспорта из XLSX
+type ImportTableInput struct {
+	PassportID string
+
+	// Data содержимое файла XLSX
+	Data []byte
+
+	// Table загружаемая таблица паспорта
+	Table TableKind
+
+	// Sheet имя листа; по умолчанию первый лист, на котором найден заголовок таблицы
+	Sheet string
+
+	// HeaderRow номер строки заголовка с 1; 0 - определить автоматически
+	HeaderRow int
+
+	// Mapping сопоставление полей столбцам; nil - подобрать по заголовку
+	Mapping ColumnMapping
+
+	// Append добавить строки к таблице паспорта; иначе таблица заменяется
+	Append bool
+
+	// SkipInvalid загрузить корректные строки, пропустив строки с ошибками;
+	// иначе при любой ошибке паспорт не изменяется
+	SkipInvalid bool
+}
+
+// ImportTableOutput результат загрузки таблицы
+type ImportTableOutput struct {
+	Passport *entity.TechnicalPassport
+
+	// Imported количество загруженных строк
+	Imported int
+
+	// Skipped ошибки пропущенных строк (при SkipInvalid)
+	Skipped entity.ValidationErrors
+}
+
+// ImportTableUseCase use case для загрузки экспликации или состава объекта из XLSX
+type ImportTableUseCase struct {
+	repo    repository.PassportRepository
+	preview *PreviewSpreadsheetUseCase
+}
+
+// NewImportTableUseCase создает новый use case
+func NewImportTableUseCase(repo repository.PassportRepository, codec service.SpreadsheetCodec) *ImportTableUseCase {
+	return &ImportTableUseCase{
+		repo:    repo,
+		preview: NewPreviewSpreadsheetUseCase(codec),
+	}
+}
+
+// Execute выполняет загрузку таблицы
+func (uc *ImportTableUseCase) Execute(ctx context.Context, input ImportTableInput) (*ImportTableOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	preview, err := uc.preview.Execute(ctx, PreviewSpreadsheetInput{Data: input.Data, Table: input.Table})
+	if err != nil {
+		return nil, err
+	}
+
+	sheet, err := selectSheet(preview.Sheets, input.Sheet)
+	if err != nil {
+		return nil, err
+	}
+
+	headerRow := input.HeaderRow
+	if headerRow == 0 {
+		headerRow = sheet.HeaderRow
+	}
+	if headerRow <= 0 || headerRow > len(sheet.Rows) {
+		return nil, entity.ValidationError{Field: "header_row", Message: "строка заголовка таблицы не найдена на листе " + sheet.Name}
+	}
+
+	mapping := input.Mapping
+	if mapping == nil {
+		mapping = SuggestMapping(input.Table, sheet.Rows[headerRow-1])
+	}
+
+	parsed, err := ParseTable(input.Table, sheet.Rows, headerRow-1, mapping)
+	if err != nil {
+		return nil, err
+	}
+	if len(parsed.Errors) > 0 && !input.SkipInvalid {
+		return nil, fmt.Errorf("spreadsheet validation failed: %w", parsed.Errors)
+	}
+
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	imported := len(parsed.Rooms)
+	title := "экспликация"
+	if input.Table == TableBuildings {
+		imported = len(parsed.Buildings)
+		title = "состав объекта"
+		if !input.Append {
+			passport.Buildings = nil
+		}
+		passport.Buildings = append(passport.Buildings, parsed.Buildings...)
+	} else {
+		if !input.Append {
+			passport.Explication = nil
+		}
+		passport.Explication = append(passport.Explication, parsed.Rooms...)
+	}
+
+	mode := "заменена"
+	if input.Append {
+		mode = "дополнена"
+	}
+	passport.AddAuditEntry("import_table", fmt.Sprintf("Таблица «%s» %s из XLSX (лист %s): строк %d", title, mode, sheet.Name, imported))
+
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &ImportTableOutput{
+		Passport: passport,
+		Imported: imported,
+		Skipped:  parsed.Errors,
+	}, nil
+}
+
+// selectSheet выбирает лист по имени или первый лист с найденным заголовком
+func selectSheet(sheets []SheetPreview, name string) (*SheetPreview, error) {
+	if len(sheets) == 0 {
+		return nil, entity.ValidationError{Field: "sheet", Message: "книга не содержит листов"}
+	}
+
+	if name != "" {
+		for i := range sheets {
+			if sheets[i].Name == name {
+				return &sheets[i], nil
+			}
+		}
+		return nil, entity.ValidationError{Field: "sheet", Message: "лист не найден: " + name}
+	}
+
+	for i := range sheets {
+		if sheets[i].HeaderRow > 0 {
+			return &sheets[i], nil
+		}
+	}
+	return &sheets[0], nil
+}
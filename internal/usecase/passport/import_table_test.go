@@ -0,0 +1,139 @@
+package passport_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/spreadsheet"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newMeasurementSheet формирует книгу обмеров в произвольной раскладке столбцов
+func newMeasurementSheet(t *testing.T, rows [][]interface{}) []byte {
+	t.Helper()
+
+	f := excelize.NewFile()
+	defer f.Close()
+
+	for i, row := range rows {
+		cell, err := excelize.CoordinatesToCellName(1, i+1)
+		require.NoError(t, err)
+		require.NoError(t, f.SetSheetRow("Sheet1", cell, &row))
+	}
+
+	buf, err := f.WriteToBuffer()
+	require.NoError(t, err)
+	return buf.Bytes()
+}
+
+func TestParseTable_RowErrors(t *testing.T) {
+	rows := [][]string{
+		{"Обмеры"},
+		{"Этаж", "Лит.", "Номер", "Наименование", "Площадь", "Высота"},
+		{"1", "А", "1", "Кухня", "12,5", "2,7"},
+		{"1", "А", "2", "Комната", "0", ""},
+		{},
+		{"1", "А", "3", "", "10", ""},
+		{"1", "А", "4", "Санузел", "4 кв.м", ""},
+		{"Итого", "", "", "", "22,5"},
+	}
+
+	header := passport.DetectHeaderRow(passport.TableExplication, rows)
+	require.Equal(t, 1, header)
+
+	mapping := passport.SuggestMapping(passport.TableExplication, rows[header])
+	assert.Equal(t, passport.ColumnMapping{"floor": 0, "litera": 1, "room_number": 2, "purpose": 3, "area": 4, "height": 5}, mapping)
+
+	parsed, err := passport.ParseTable(passport.TableExplication, rows, header, mapping)
+	require.NoError(t, err)
+
+	require.Len(t, parsed.Rooms, 1)
+	assert.Equal(t, 12.5, parsed.Rooms[0].Area)
+	assert.Equal(t, 2.7, parsed.Rooms[0].Height)
+
+	tests := []struct {
+		field   string
+		message string
+	}{
+		{"rows[4].area", "площадь должна быть больше 0"},
+		{"rows[6].purpose", "назначение помещения обязательно"},
+		{"rows[7].area", `значение "4 кв.м" не является числом`},
+	}
+	require.Len(t, parsed.Errors, len(tests))
+	for i, tt := range tests {
+		assert.Equal(t, tt.field, parsed.Errors[i].Field)
+		assert.Equal(t, tt.message, parsed.Errors[i].Message)
+	}
+}
+
+func TestParseTable_MissingRequiredColumn(t *testing.T) {
+	_, err := passport.ParseTable(passport.TableBuildings, nil, 0, passport.ColumnMapping{"litera": 0, "name": 1})
+
+	var ve entity.ValidationError
+	require.ErrorAs(t, err, &ve)
+	assert.Equal(t, "mapping.commission_year", ve.Field)
+}
+
+func TestImportTableUseCase_Execute(t *testing.T) {
+	data := func(t *testing.T) []byte {
+		return newMeasurementSheet(t, [][]interface{}{
+			{"Лит.", "Этаж", "№", "Назначение", "Общая", "Жилая"},
+			{"А", "1", "1", "Комната", 18.2, 18.2},
+			{"А", "1", "2", "Кухня", "-", nil},
+		})
+	}
+
+	tests := []struct {
+		name        string
+		append      bool
+		skipInvalid bool
+		wantErr     bool
+		wantRooms   int
+	}{
+		{"invalid row rejects the whole sheet", false, false, true, 1},
+		{"skip invalid replaces explication", false, true, false, 1},
+		{"skip invalid appends to explication", true, true, false, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := memory.NewInMemoryPassportRepository()
+
+			p := entity.NewTechnicalPassport(entity.ObjectTypeResidentialHouse, entity.Address{Subject: "г. Москва", House: "1"})
+			p.ID = "TP-1"
+			p.Explication = []entity.Room{{Litera: "А", Floor: "1", RoomNumber: "9", Purpose: "Кладовая", Area: 3}}
+			require.NoError(t, repo.Create(ctx, p))
+
+			uc := passport.NewImportTableUseCase(repo, spreadsheet.NewCodec())
+			out, err := uc.Execute(ctx, passport.ImportTableInput{
+				PassportID:  "TP-1",
+				Data:        data(t),
+				Table:       passport.TableExplication,
+				Append:      tt.append,
+				SkipInvalid: tt.skipInvalid,
+			})
+
+			if tt.wantErr {
+				var errs entity.ValidationErrors
+				require.True(t, errors.As(err, &errs))
+				assert.Equal(t, "rows[3].area", errs[0].Field)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, 1, out.Imported)
+				require.Len(t, out.Skipped, 1)
+			}
+
+			stored, err := repo.GetByID(ctx, "TP-1")
+			require.NoError(t, err)
+			assert.Len(t, stored.Explication, tt.wantRooms)
+		})
+	}
+}
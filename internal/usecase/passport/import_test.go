@@ -0,0 +1,53 @@
+package passport_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportPassportUseCase_ResetsStatusAndKeepsAuditLog(t *testing.T) {
+	tests := []struct {
+		name   string
+		status entity.PassportStatus
+		audit  int
+	}{
+		{name: "empty status", status: "", audit: 2},
+		{name: "draft", status: entity.PassportStatusDraft, audit: 2},
+		{name: "approved", status: entity.PassportStatusApproved, audit: 3},
+		{name: "unknown status", status: "signed", audit: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := memory.NewInMemoryPassportRepository()
+			uc := passport.NewImportPassportUseCase(repo)
+
+			p := entity.NewTechnicalPassport(entity.ObjectTypeResidentialHouse, entity.Address{Subject: "г. Москва", House: "1"})
+			p.GeneralInfo = entity.GeneralInfo{Purpose: "Жилое", ConstructionYear: 2020, TotalArea: 100.0}
+			p.Status = tt.status
+			p.AuditLog = []entity.AuditEntry{{Timestamp: time.Now(), Action: "issue", Description: "Паспорт выдан"}}
+
+			output, err := uc.Execute(context.Background(), passport.ImportPassportInput{Passport: p})
+			require.NoError(t, err)
+
+			saved, err := repo.GetByID(context.Background(), output.Passport.ID)
+			require.NoError(t, err)
+			assert.Equal(t, entity.PassportStatusDraft, saved.Status)
+			require.Len(t, saved.AuditLog, tt.audit)
+
+			// Прежний журнал сохраняется, записи импорта дописываются в конец
+			assert.Equal(t, "issue", saved.AuditLog[0].Action)
+			for _, e := range saved.AuditLog[1:] {
+				assert.Equal(t, "import", e.Action)
+			}
+			assert.Equal(t, "Технический паспорт импортирован", saved.AuditLog[tt.audit-1].Description)
+		})
+	}
+}
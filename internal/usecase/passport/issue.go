@@ -0,0 +1,62 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// IssuePassportInput входные данные для выдачи паспорта
+type IssuePassportInput struct {
+	PassportID string
+
+	// Recipient заказчик, которому выдан паспорт
+	Recipient string
+}
+
+// IssuePassportOutput результат выдачи паспорта
+type IssuePassportOutput struct {
+	Passport *entity.TechnicalPassport
+}
+
+// IssuePassportUseCase use case для записи выдачи утвержденного паспорта
+type IssuePassportUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewIssuePassportUseCase создает новый use case
+func NewIssuePassportUseCase(repo repository.PassportRepository) *IssuePassportUseCase {
+	return &IssuePassportUseCase{
+		repo: repo,
+	}
+}
+
+// Execute выполняет выдачу паспорта
+func (uc *IssuePassportUseCase) Execute(ctx context.Context, input IssuePassportInput) (*IssuePassportOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	// Записываем выдачу
+	if err := passport.Issue(input.Recipient); err != nil {
+		return nil, fmt.Errorf("failed to issue passport: %w", err)
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &IssuePassportOutput{
+		Passport: passport,
+	}, nil
+}
@@ -0,0 +1,98 @@
+package passport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// ListPassportsInput входные данные для получения списка паспортов
+type ListPassportsInput struct {
+	// Where условие отбора; nil - все паспорта
+	Where repository.PassportSpecification
+
+	// Sort поле сортировки (по умолчанию ID) и направление
+	Sort       repository.SortField
+	Descending bool
+
+	// Cursor продолжение списка: NextCursor предыдущей страницы
+	// с теми же Where, Sort и Descending
+	Cursor string
+
+	// Offset количество пропускаемых паспортов; с курсором не используется
+	Offset int
+
+	// Limit максимальное количество паспортов (0 - без ограничения)
+	Limit int
+}
+
+// ListPassportsOutput результат получения списка паспортов
+type ListPassportsOutput struct {
+	Passports []*entity.TechnicalPassport
+
+	// Total общее количество подходящих паспортов без учета Cursor/Offset/Limit
+	Total int
+
+	// NextCursor курсор следующей страницы; пусто - страница последняя
+	NextCursor string
+}
+
+// ListPassportsUseCase use case для получения списка паспортов
+type ListPassportsUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewListPassportsUseCase создает новый use case
+func NewListPassportsUseCase(repo repository.PassportRepository) *ListPassportsUseCase {
+	return &ListPassportsUseCase{
+		repo: repo,
+	}
+}
+
+// Execute возвращает страницу паспортов, подходящих под условие,
+// упорядоченных по полю сортировки
+func (uc *ListPassportsUseCase) Execute(ctx context.Context, input ListPassportsInput) (*ListPassportsOutput, error) {
+	// Валидация входных данных
+	if input.Offset < 0 {
+		return nil, entity.ValidationError{Field: "offset", Message: "смещение не может быть отрицательным"}
+	}
+
+	if input.Cursor != "" && input.Offset > 0 {
+		return nil, entity.ValidationError{Field: "cursor", Message: "курсор и смещение нельзя использовать вместе"}
+	}
+
+	criteria := repository.PassportCriteria{
+		Where:      input.Where,
+		Sort:       input.Sort,
+		Descending: input.Descending,
+		Cursor:     input.Cursor,
+		Limit:      input.Limit,
+	}
+	if err := criteria.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Страница по смещению - конец страницы из Offset+Limit паспортов
+	if input.Offset > 0 && input.Limit > 0 {
+		criteria.Limit = input.Offset + input.Limit
+	}
+
+	page, err := uc.repo.Find(ctx, criteria)
+	if errors.Is(err, repository.ErrInvalidCursor) {
+		return nil, entity.ValidationError{Field: "cursor", Message: "недействительный курсор"}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find passports: %w", err)
+	}
+
+	start := min(input.Offset, len(page.Passports))
+
+	return &ListPassportsOutput{
+		Passports:  page.Passports[start:],
+		Total:      page.Total,
+		NextCursor: page.NextCursor,
+	}, nil
+}
@@ -0,0 +1,167 @@
+package passport_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// listFixture создает паспорта TP-01..TP-10: четные - квартиры ГУП БТИ,
+// нечетные - жилые дома ООО «Кадастр»; паспорт N создан N дней назад
+// и построен в 1950+N году, у TP-03 и TP-04 есть правообладатель с ИНН
+func listFixture(t *testing.T) *memory.InMemoryPassportRepository {
+	repo := memory.NewInMemoryPassportRepository()
+	now := time.Now()
+	for i := 1; i <= 10; i++ {
+		objectType, org := entity.ObjectTypeResidentialHouse, "ООО «Кадастр»"
+		if i%2 == 0 {
+			objectType, org = entity.ObjectTypeApartment, "ГУП БТИ"
+		}
+		p := entity.NewTechnicalPassport(objectType, entity.Address{Subject: "г. Москва", Street: "ул. Садовая", House: fmt.Sprint(i)})
+		p.ID = fmt.Sprintf("TP-%02d", i)
+		p.OrganizationName = org
+		p.CreatedDate = now.AddDate(0, 0, -i)
+		p.GeneralInfo.ConstructionYear = 1950 + i
+		if i == 3 || i == 4 {
+			p.Owners = []entity.Owner{{CompanyName: "ООО «Ромашка»", TIN: "7701234567"}}
+		}
+		require.NoError(t, repo.Create(context.Background(), p))
+	}
+	return repo
+}
+
+func passportIDs(passports []*entity.TechnicalPassport) []string {
+	ids := make([]string, 0, len(passports))
+	for _, p := range passports {
+		ids = append(ids, p.ID)
+	}
+	return ids
+}
+
+func TestListPassportsUseCase_Specifications(t *testing.T) {
+	repo := listFixture(t)
+	useCase := passport.NewListPassportsUseCase(repo)
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		where   repository.PassportSpecification
+		wantIDs []string
+	}{
+		{name: "all passports", wantIDs: []string{"TP-01", "TP-02", "TP-03", "TP-04", "TP-05", "TP-06", "TP-07", "TP-08", "TP-09", "TP-10"}},
+		{name: "object type", where: repository.ByObjectType(entity.ObjectTypeApartment), wantIDs: []string{"TP-02", "TP-04", "TP-06", "TP-08", "TP-10"}},
+		{name: "owner TIN", where: repository.HasOwnerTIN("7701234567"), wantIDs: []string{"TP-03", "TP-04"}},
+		{
+			name:    "created between",
+			where:   repository.CreatedBetween(now.AddDate(0, 0, -5).Add(-time.Hour), now.AddDate(0, 0, -2).Add(-time.Hour)),
+			wantIDs: []string{"TP-03", "TP-04", "TP-05"},
+		},
+		{
+			name: "and with not",
+			where: repository.And(
+				repository.ByOrganization("ООО «Кадастр»"),
+				repository.Not(repository.HasOwnerTIN("7701234567")),
+			),
+			wantIDs: []string{"TP-01", "TP-05", "TP-07", "TP-09"},
+		},
+		{
+			name:    "or",
+			where:   repository.Or(repository.HasOwnerTIN("7701234567"), repository.ByStatus(entity.PassportStatusApproved)),
+			wantIDs: []string{"TP-03", "TP-04"},
+		},
+		{name: "empty or", where: repository.Or(), wantIDs: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := useCase.Execute(context.Background(), passport.ListPassportsInput{Where: tt.where})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantIDs, passportIDs(output.Passports))
+			assert.Equal(t, len(tt.wantIDs), output.Total)
+			assert.Empty(t, output.NextCursor)
+		})
+	}
+}
+
+func TestListPassportsUseCase_CursorPagination(t *testing.T) {
+	ctx := context.Background()
+	repo := listFixture(t)
+	useCase := passport.NewListPassportsUseCase(repo)
+
+	input := passport.ListPassportsInput{Sort: repository.SortYear, Descending: true, Limit: 4}
+	first, err := useCase.Execute(ctx, input)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"TP-10", "TP-09", "TP-08", "TP-07"}, passportIDs(first.Passports))
+	assert.Equal(t, 10, first.Total)
+	require.NotEmpty(t, first.NextCursor)
+
+	// Паспорт курсора удален, а в начало списка добавлен новый:
+	// следующая страница продолжается без пропусков и повторов
+	require.NoError(t, repo.Delete(ctx, "TP-07"))
+	added := entity.NewTechnicalPassport(entity.ObjectTypeApartment, entity.Address{Subject: "г. Москва", House: "99"})
+	added.ID = "TP-99"
+	added.GeneralInfo.ConstructionYear = 2020
+	require.NoError(t, repo.Create(ctx, added))
+
+	input.Cursor = first.NextCursor
+	second, err := useCase.Execute(ctx, input)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"TP-06", "TP-05", "TP-04", "TP-03"}, passportIDs(second.Passports))
+
+	input.Cursor = second.NextCursor
+	last, err := useCase.Execute(ctx, input)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"TP-02", "TP-01"}, passportIDs(last.Passports))
+	assert.Empty(t, last.NextCursor)
+
+	// Курсор другой сортировки недействителен
+	input.Descending = false
+	_, err = useCase.Execute(ctx, input)
+	var validationErr entity.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "cursor", validationErr.Field)
+}
+
+func TestListPassportsUseCase_Validation(t *testing.T) {
+	useCase := passport.NewListPassportsUseCase(listFixture(t))
+
+	tests := []struct {
+		name  string
+		input passport.ListPassportsInput
+		field string
+	}{
+		{name: "negative offset", input: passport.ListPassportsInput{Offset: -1}, field: "offset"},
+		{name: "negative limit", input: passport.ListPassportsInput{Limit: -1}, field: "limit"},
+		{name: "unknown sort field", input: passport.ListPassportsInput{Sort: "owner"}, field: "sort"},
+		{name: "relevance outside search", input: passport.ListPassportsInput{Sort: repository.SortRelevance}, field: "sort"},
+		{name: "malformed cursor", input: passport.ListPassportsInput{Cursor: "not a cursor"}, field: "cursor"},
+		{name: "cursor with offset", input: passport.ListPassportsInput{Cursor: "x", Offset: 10}, field: "cursor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := useCase.Execute(context.Background(), tt.input)
+			var validationErr entity.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+
+	// Страница по смещению продолжается курсором
+	output, err := useCase.Execute(context.Background(), passport.ListPassportsInput{Offset: 8, Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"TP-09"}, passportIDs(output.Passports))
+	require.NotEmpty(t, output.NextCursor)
+
+	output, err = useCase.Execute(context.Background(), passport.ListPassportsInput{Cursor: output.NextCursor})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"TP-10"}, passportIDs(output.Passports))
+}
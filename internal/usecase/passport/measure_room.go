@@ -0,0 +1,154 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// CalculateRoomAreaInput входные данные для расчета площади без сохранения
+type CalculateRoomAreaInput struct {
+	Measurements entity.RoomMeasurements
+}
+
+// CalculateRoomAreaOutput результат расчета площади
+type CalculateRoomAreaOutput struct {
+	Calculation *service.AreaCalculation
+}
+
+// CalculateRoomAreaUseCase use case для предварительного расчета площади по обмеру
+// (паспорт не изменяется)
+type CalculateRoomAreaUseCase struct {
+	calculator service.AreaCalculator
+}
+
+// NewCalculateRoomAreaUseCase создает новый use case
+func NewCalculateRoomAreaUseCase(calculator service.AreaCalculator) *CalculateRoomAreaUseCase {
+	return &CalculateRoomAreaUseCase{
+		calculator: calculator,
+	}
+}
+
+// Execute выполняет расчет площади
+func (uc *CalculateRoomAreaUseCase) Execute(ctx context.Context, input CalculateRoomAreaInput) (*CalculateRoomAreaOutput, error) {
+	calculation, err := uc.calculator.Calculate(&input.Measurements)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CalculateRoomAreaOutput{
+		Calculation: calculation,
+	}, nil
+}
+
+// MeasureRoomInput входные данные для записи обмера помещения
+type MeasureRoomInput struct {
+	PassportID string
+	RoomIndex  int // индекс в массиве
+
+	Measurements entity.RoomMeasurements
+}
+
+// MeasureRoomOutput результат записи обмера
+type MeasureRoomOutput struct {
+	Passport    *entity.TechnicalPassport
+	Calculation *service.AreaCalculation
+
+	// PreviousArea площадь помещения до обмера
+	PreviousArea float64
+}
+
+// MeasureRoomUseCase use case для расчета площади помещения по обмеру:
+// площадь записывается в экспликацию, обмер сохраняется вместе с помещением
+type MeasureRoomUseCase struct {
+	repo       repository.PassportRepository
+	calculator service.AreaCalculator
+}
+
+// NewMeasureRoomUseCase создает новый use case
+func NewMeasureRoomUseCase(repo repository.PassportRepository, calculator service.AreaCalculator) *MeasureRoomUseCase {
+	return &MeasureRoomUseCase{
+		repo:       repo,
+		calculator: calculator,
+	}
+}
+
+// Execute выполняет расчет и запись площади помещения
+func (uc *MeasureRoomUseCase) Execute(ctx context.Context, input MeasureRoomInput) (*MeasureRoomOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if input.RoomIndex < 0 {
+		return nil, entity.ValidationError{Field: "room_index", Message: "индекс помещения должен быть >= 0"}
+	}
+
+	calculation, err := uc.calculator.Calculate(&input.Measurements)
+	if err != nil {
+		return nil, err
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	// Проверяем что индекс в пределах массива
+	if input.RoomIndex >= len(passport.Explication) {
+		return nil, entity.ValidationError{
+			Field:   "room_index",
+			Message: "помещение с таким индексом не найдено",
+		}
+	}
+
+	room := &passport.Explication[input.RoomIndex]
+	previous := room.Area
+	ApplyRoomMeasurements(room, input.Measurements, calculation.Area)
+
+	passport.UpdatedDate = time.Now()
+	passport.AddAuditEntry("measure_room", fmt.Sprintf(
+		"Площадь помещения %s (литера %s, этаж %s) рассчитана по обмеру: %.1f кв.м (было %.1f кв.м)",
+		room.RoomNumber, room.Litera, room.Floor, calculation.Area, previous))
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &MeasureRoomOutput{
+		Passport:     passport,
+		Calculation:  calculation,
+		PreviousArea: previous,
+	}, nil
+}
+
+// ApplyRoomMeasurements записывает в помещение площадь по обмеру и сам обмер.
+// Жилая или вспомогательная площадь, совпадавшая с площадью помещения,
+// обновляется вместе с ней.
+func ApplyRoomMeasurements(room *entity.Room, measurements entity.RoomMeasurements, area float64) {
+	if room.LivingArea > 0 && sameArea(room.LivingArea, room.Area) {
+		room.LivingArea = area
+	}
+	if room.AuxiliaryArea > 0 && sameArea(room.AuxiliaryArea, room.Area) {
+		room.AuxiliaryArea = area
+	}
+	room.Area = area
+
+	measurements.Area = area
+	if measurements.MeasuredDate.IsZero() {
+		measurements.MeasuredDate = time.Now()
+	}
+	room.Measurements = &measurements
+}
+
+// sameArea сравнивает площади с точностью экспликации
+func sameArea(a, b float64) bool {
+	return math.Abs(a-b) < 0.05
+}
@@ -0,0 +1,95 @@
+package passport_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/geometry"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeasureRoomUseCase(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	p := planPassport(t, repo)
+	p.Explication[0].LivingArea = 20.0
+	require.NoError(t, repo.Update(ctx, p))
+
+	measurements := entity.RoomMeasurements{
+		Shapes: []entity.MeasuredShape{
+			{Kind: entity.ShapeRectangle, Role: entity.ShapeRoleMain, Length: 5.2, Width: 3.9},
+			{Kind: entity.ShapeRectangle, Role: entity.ShapeRoleDeduction, Length: 0.4, Width: 0.4, Note: "колонна"},
+		},
+		MeasuredBy: "Петров П.П.",
+	}
+
+	uc := passport.NewMeasureRoomUseCase(repo, geometry.NewCalculator())
+	output, err := uc.Execute(ctx, passport.MeasureRoomInput{PassportID: p.ID, RoomIndex: 0, Measurements: measurements})
+	require.NoError(t, err)
+	assert.Equal(t, 20.0, output.PreviousArea)
+	assert.Equal(t, 20.1, output.Calculation.Area) // 20,28 - 0,16
+
+	saved, err := repo.GetByID(ctx, p.ID)
+	require.NoError(t, err)
+	room := saved.Explication[0]
+	assert.Equal(t, 20.1, room.Area)
+	assert.Equal(t, 20.1, room.LivingArea, "жилая площадь совпадала с площадью помещения")
+	require.NotNil(t, room.Measurements)
+	assert.Equal(t, 20.1, room.Measurements.Area)
+	assert.False(t, room.Measurements.MeasuredDate.IsZero())
+	assert.Equal(t, "measure_room", saved.AuditLog[len(saved.AuditLog)-1].Action)
+
+	// Ручная правка площади после обмера попадает в предупреждения
+	saved.Explication[0].Area = 21.0
+	require.NoError(t, repo.Update(ctx, saved))
+	validated, err := passport.NewValidatePassportUseCase(repo).Execute(ctx, passport.ValidatePassportInput{PassportID: p.ID})
+	require.NoError(t, err)
+	assert.Contains(t, validated.Result.Warnings, "помещение 1 (литера А, этаж 1): площадь 21.0 кв.м не совпадает с обмером 20.1 кв.м")
+
+	// Предварительный расчет не меняет паспорт
+	preview, err := passport.NewCalculateRoomAreaUseCase(geometry.NewCalculator()).Execute(ctx, passport.CalculateRoomAreaInput{Measurements: measurements})
+	require.NoError(t, err)
+	assert.Equal(t, 20.1, preview.Calculation.Area)
+}
+
+func TestMeasureRoomUseCase_Invalid(t *testing.T) {
+	rectangle := entity.RoomMeasurements{Shapes: []entity.MeasuredShape{{Kind: entity.ShapeRectangle, Role: entity.ShapeRoleMain, Length: 3, Width: 3}}}
+
+	tests := []struct {
+		name  string
+		input passport.MeasureRoomInput
+		field string
+	}{
+		{
+			name:  "missing passport id",
+			input: passport.MeasureRoomInput{Measurements: rectangle},
+			field: "passport_id",
+		},
+		{
+			name:  "room index out of range",
+			input: passport.MeasureRoomInput{PassportID: "TP-PLAN", RoomIndex: 3, Measurements: rectangle},
+			field: "room_index",
+		},
+		{
+			name:  "empty measurements",
+			input: passport.MeasureRoomInput{PassportID: "TP-PLAN"},
+			field: "shapes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := memory.NewInMemoryPassportRepository()
+			planPassport(t, repo)
+
+			_, err := passport.NewMeasureRoomUseCase(repo, geometry.NewCalculator()).Execute(context.Background(), tt.input)
+			var ve entity.ValidationError
+			require.ErrorAs(t, err, &ve)
+			assert.Equal(t, tt.field, ve.Field)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// MergePassportsInput входные данные для объединения паспортов
+type MergePassportsInput struct {
+	// PassportID паспорт, который сохраняется
+	PassportID string
+
+	// SourceID дубликат, сведения которого переносятся; после объединения удаляется
+	SourceID string
+}
+
+// MergePassportsOutput результат объединения паспортов
+type MergePassportsOutput struct {
+	Passport *entity.TechnicalPassport
+	SourceID string
+	Result   entity.MergeResult
+}
+
+// MergePassportsUseCase use case для объединения паспорта с дубликатом
+type MergePassportsUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewMergePassportsUseCase создает новый use case
+func NewMergePassportsUseCase(repo repository.PassportRepository) *MergePassportsUseCase {
+	return &MergePassportsUseCase{
+		repo: repo,
+	}
+}
+
+// Execute переносит сведения дубликата в паспорт и удаляет дубликат
+func (uc *MergePassportsUseCase) Execute(ctx context.Context, input MergePassportsInput) (*MergePassportsOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if input.SourceID == "" {
+		return nil, entity.ValidationError{Field: "source_id", Message: "ID дубликата обязателен"}
+	}
+
+	// Получаем паспорта
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	source, err := uc.repo.GetByID(ctx, input.SourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get duplicate: %w", err)
+	}
+
+	result, err := passport.Merge(source)
+	if err != nil {
+		return nil, err
+	}
+
+	// Объединенный паспорт должен остаться корректным
+	if err := passport.IsValid(); err != nil {
+		return nil, fmt.Errorf("merged passport validation failed: %w", err)
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	// Удаляем дубликат; событие удаления передает хранилище
+	if err := uc.repo.Delete(ctx, source.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete duplicate: %w", err)
+	}
+
+	return &MergePassportsOutput{
+		Passport: passport,
+		SourceID: source.ID,
+		Result:   result,
+	}, nil
+}
@@ -0,0 +1,149 @@
+package passport_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/address"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func duplicateInput(addr entity.Address) passport.CreatePassportInput {
+	return passport.CreatePassportInput{
+		ObjectType:       entity.ObjectTypeResidentialHouse,
+		OrganizationName: "ГУП БТИ",
+		Address:          addr,
+		GeneralInfo: entity.GeneralInfo{
+			Purpose:          "Жилое",
+			ConstructionYear: 2020,
+			TotalArea:        100.5,
+		},
+	}
+}
+
+func duplicateOwner(name string) entity.Owner {
+	return entity.Owner{
+		EntryDate:     time.Now(),
+		PersonType:    entity.PersonTypeIndividual,
+		FullName:      name,
+		RightType:     "собственность",
+		RightDocument: "Свидетельство 77-АА 123456",
+		Share:         "1/2",
+	}
+}
+
+func TestCreatePassportUseCase_ReportsDuplicates(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	create := passport.NewCreatePassportUseCase(repo, address.NewNormalizer())
+
+	first, err := create.Execute(ctx, duplicateInput(entity.Address{Subject: "г. Москва", Street: "ул. Ленина", House: "д. 5"}))
+	require.NoError(t, err)
+	assert.Empty(t, first.Duplicates)
+
+	other, err := create.Execute(ctx, duplicateInput(entity.Address{Subject: "г. Москва", Street: "ул. Лесная", House: "5"}))
+	require.NoError(t, err)
+	assert.Empty(t, other.Duplicates)
+
+	second, err := create.Execute(ctx, duplicateInput(entity.Address{Subject: "Москва", Street: "Ленина ул", House: "5"}))
+	require.NoError(t, err)
+	require.Len(t, second.Duplicates, 1)
+	assert.Equal(t, first.Passport.ID, second.Duplicates[0].Passport.ID)
+	assert.Equal(t, 1.0, second.Duplicates[0].Score)
+
+	find := passport.NewFindDuplicatesUseCase(repo, address.NewNormalizer())
+	found, err := find.Execute(ctx, passport.FindDuplicatesInput{PassportID: first.Passport.ID})
+	require.NoError(t, err)
+	require.Len(t, found.Duplicates, 1)
+	assert.Equal(t, second.Passport.ID, found.Duplicates[0].Passport.ID)
+
+	found, err = find.Execute(ctx, passport.FindDuplicatesInput{PassportID: first.Passport.ID, Threshold: 0.1})
+	require.NoError(t, err)
+	assert.Len(t, found.Duplicates, 2)
+
+	_, err = find.Execute(ctx, passport.FindDuplicatesInput{PassportID: first.Passport.ID, Threshold: 1.5})
+	var validationErr entity.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "threshold", validationErr.Field)
+}
+
+func TestMergePassportsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	create := passport.NewCreatePassportUseCase(repo, address.NewNormalizer())
+	merge := passport.NewMergePassportsUseCase(repo)
+
+	target, err := create.Execute(ctx, duplicateInput(entity.Address{Subject: "г. Москва", Street: "ул. Ленина", House: "5"}))
+	require.NoError(t, err)
+	target.Passport.Buildings = []entity.Building{{Litera: "А", Name: "Жилой дом", CommissionYear: 2020}}
+	target.Passport.Owners = []entity.Owner{duplicateOwner("Иванов Иван Иванович")}
+	require.NoError(t, repo.Update(ctx, target.Passport))
+
+	source, err := create.Execute(ctx, duplicateInput(entity.Address{Subject: "Москва", Street: "Ленина", House: "5"}))
+	require.NoError(t, err)
+	source.Passport.InventoryNumber = "45-123"
+	source.Passport.Buildings = []entity.Building{{Litera: "а", Name: "Жилой дом", CommissionYear: 2020}, {Litera: "Г", Name: "Гараж", CommissionYear: 2020}}
+	source.Passport.Owners = []entity.Owner{
+		duplicateOwner("Иванов  Иван Иванович"),
+		duplicateOwner("Петров Петр Петрович"),
+	}
+	require.NoError(t, repo.Update(ctx, source.Passport))
+
+	output, err := merge.Execute(ctx, passport.MergePassportsInput{PassportID: target.Passport.ID, SourceID: source.Passport.ID})
+	require.NoError(t, err)
+	assert.Equal(t, source.Passport.ID, output.SourceID)
+	assert.Equal(t, []string{"inventory_number"}, output.Result.Fields)
+	assert.Equal(t, 1, output.Result.Buildings)
+	assert.Equal(t, 1, output.Result.Owners)
+
+	merged, err := repo.GetByID(ctx, target.Passport.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "45-123", merged.InventoryNumber)
+	assert.Len(t, merged.Buildings, 2)
+	assert.Len(t, merged.Owners, 2)
+
+	_, err = repo.GetByID(ctx, source.Passport.ID)
+	assert.Error(t, err)
+}
+
+func TestMergePassportsUseCase_Errors(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	create := passport.NewCreatePassportUseCase(repo, address.NewNormalizer())
+	merge := passport.NewMergePassportsUseCase(repo)
+
+	target, err := create.Execute(ctx, duplicateInput(entity.Address{Subject: "г. Москва", Street: "ул. Ленина", House: "5"}))
+	require.NoError(t, err)
+	approved, err := create.Execute(ctx, duplicateInput(entity.Address{Subject: "г. Москва", Street: "ул. Ленина", House: "5"}))
+	require.NoError(t, err)
+	approved.Passport.Status = entity.PassportStatusApproved
+	require.NoError(t, repo.Update(ctx, approved.Passport))
+
+	tests := []struct {
+		name  string
+		input passport.MergePassportsInput
+		field string
+	}{
+		{name: "missing source", input: passport.MergePassportsInput{PassportID: target.Passport.ID}, field: "source_id"},
+		{name: "merge with itself", input: passport.MergePassportsInput{PassportID: target.Passport.ID, SourceID: target.Passport.ID}, field: "source_id"},
+		{name: "approved source", input: passport.MergePassportsInput{PassportID: target.Passport.ID, SourceID: approved.Passport.ID}, field: "source_id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := merge.Execute(ctx, tt.input)
+			var validationErr entity.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+
+	// Утвержденный паспорт не удален
+	_, err = repo.GetByID(ctx, approved.Passport.ID)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,300 @@
+package passport
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// RegistryKeyField поле с идентификатором записи в старой системе
+const RegistryKeyField = "key"
+
+// defaultRegistryDateLayout формат дат реестра по умолчанию
+const defaultRegistryDateLayout = "02.01.2006"
+
+// registryTarget паспорт, который собирается из строк реестра
+type registryTarget struct {
+	passport *entity.TechnicalPassport
+	owner    *entity.Owner
+	building *entity.Building
+	key      string
+	layout   string
+}
+
+// registrySetter записывает значение ячейки в поле паспорта
+type registrySetter func(t *registryTarget, field, value string) error
+
+// registryFields поля паспорта, которые можно загрузить из реестра
+var registryFields = map[string]registrySetter{
+	RegistryKeyField:    text(func(t *registryTarget) *string { return &t.key }),
+	"object_type":       func(t *registryTarget, _, v string) error { t.passport.ObjectType = entity.ObjectType(v); return nil },
+	"organization_name": text(func(t *registryTarget) *string { return &t.passport.OrganizationName }),
+	"inventory_number":  text(func(t *registryTarget) *string { return &t.passport.InventoryNumber }),
+	"cadastral_number":  text(func(t *registryTarget) *string { return &t.passport.CadastralNumber }),
+	"as_of_date":        date(func(t *registryTarget) *time.Time { return &t.passport.AsOfDate }),
+
+	"address.subject":       text(func(t *registryTarget) *string { return &t.passport.Address.Subject }),
+	"address.district":      text(func(t *registryTarget) *string { return &t.passport.Address.District }),
+	"address.city":          text(func(t *registryTarget) *string { return &t.passport.Address.City }),
+	"address.city_district": text(func(t *registryTarget) *string { return &t.passport.Address.CityDistrict }),
+	"address.street":        text(func(t *registryTarget) *string { return &t.passport.Address.Street }),
+	"address.house":         text(func(t *registryTarget) *string { return &t.passport.Address.House }),
+	"address.building":      text(func(t *registryTarget) *string { return &t.passport.Address.Building }),
+	"address.apartment":     text(func(t *registryTarget) *string { return &t.passport.Address.Apartment }),
+	"address.room":          text(func(t *registryTarget) *string { return &t.passport.Address.Room }),
+	"address.postal_code":   text(func(t *registryTarget) *string { return &t.passport.Address.PostalCode }),
+
+	"general_info.purpose":             text(func(t *registryTarget) *string { return &t.passport.GeneralInfo.Purpose }),
+	"general_info.actual_usage":        text(func(t *registryTarget) *string { return &t.passport.GeneralInfo.ActualUsage }),
+	"general_info.construction_year":   integer(func(t *registryTarget) *int { return &t.passport.GeneralInfo.ConstructionYear }),
+	"general_info.total_area":          number(func(t *registryTarget) *float64 { return &t.passport.GeneralInfo.TotalArea }),
+	"general_info.living_area":         number(func(t *registryTarget) *float64 { return &t.passport.GeneralInfo.LivingArea }),
+	"general_info.floors_above_ground": integer(func(t *registryTarget) *int { return &t.passport.GeneralInfo.FloorsAboveGround }),
+	"general_info.floors_underground":  integer(func(t *registryTarget) *int { return &t.passport.GeneralInfo.FloorsUnderground }),
+	"general_info.note":                text(func(t *registryTarget) *string { return &t.passport.GeneralInfo.Note }),
+
+	"owners.person_type":    func(t *registryTarget, _, v string) error { t.owner.PersonType = entity.PersonType(v); return nil },
+	"owners.full_name":      text(func(t *registryTarget) *string { return &t.owner.FullName }),
+	"owners.passport_data":  text(func(t *registryTarget) *string { return &t.owner.PassportData }),
+	"owners.company_name":   text(func(t *registryTarget) *string { return &t.owner.CompanyName }),
+	"owners.tin":            text(func(t *registryTarget) *string { return &t.owner.TIN }),
+	"owners.right_type":     text(func(t *registryTarget) *string { return &t.owner.RightType }),
+	"owners.right_document": text(func(t *registryTarget) *string { return &t.owner.RightDocument }),
+	"owners.share":          text(func(t *registryTarget) *string { return &t.owner.Share }),
+	"owners.entry_date":     date(func(t *registryTarget) *time.Time { return &t.owner.EntryDate }),
+
+	"buildings.litera":          text(func(t *registryTarget) *string { return &t.building.Litera }),
+	"buildings.name":            text(func(t *registryTarget) *string { return &t.building.Name }),
+	"buildings.commission_year": integer(func(t *registryTarget) *int { return &t.building.CommissionYear }),
+	"buildings.wall_material":   text(func(t *registryTarget) *string { return &t.building.WallMaterial }),
+	"buildings.total_area":      number(func(t *registryTarget) *float64 { return &t.building.TotalArea }),
+	"buildings.build_area":      number(func(t *registryTarget) *float64 { return &t.building.BuildArea }),
+	"buildings.height":          number(func(t *registryTarget) *float64 { return &t.building.Height }),
+	"buildings.volume":          number(func(t *registryTarget) *float64 { return &t.building.Volume }),
+	"buildings.inventory_value": number(func(t *registryTarget) *float64 { return &t.building.InventoryValue }),
+}
+
+// RegistryFields возвращает поля паспорта, доступные для сопоставления, по алфавиту
+func RegistryFields() []string {
+	fields := make([]string, 0, len(registryFields))
+	for field := range registryFields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// ValidateRegistryMapping проверяет, что описание реестра ссылается только на известные поля
+func ValidateRegistryMapping(m service.RegistryMapping) error {
+	var problems entity.ValidationErrors
+
+	if len(m.Columns) == 0 {
+		problems = append(problems, entity.ValidationError{Field: "columns", Message: "не сопоставлено ни одного столбца"})
+	}
+
+	sections := []struct {
+		name   string
+		fields []string
+	}{
+		{"columns", keys(m.Columns)},
+		{"defaults", keys(m.Defaults)},
+		{"dictionaries", keys(m.Dictionaries)},
+	}
+	for _, s := range sections {
+		for _, field := range s.fields {
+			if _, ok := registryFields[field]; !ok {
+				problems = append(problems, entity.ValidationError{Field: s.name + "." + field, Message: "неизвестное поле паспорта"})
+			}
+		}
+	}
+
+	if m.GroupBy != "" {
+		if _, ok := m.Columns[m.GroupBy]; !ok {
+			problems = append(problems, entity.ValidationError{Field: "group_by", Message: "поле группировки должно быть сопоставлено столбцу"})
+		}
+	}
+
+	if len(problems) > 0 {
+		return problems
+	}
+	return nil
+}
+
+// keys возвращает ключи отображения по алфавиту
+func keys[V any](m map[string]V) []string {
+	result := make([]string, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// registryGroupField поле, по которому строки собираются в паспорт
+func registryGroupField(m service.RegistryMapping) string {
+	if m.GroupBy != "" {
+		return m.GroupBy
+	}
+	if _, ok := m.Columns[RegistryKeyField]; ok {
+		return RegistryKeyField
+	}
+	return ""
+}
+
+// buildRegistryPassport собирает паспорт из строк одной записи реестра.
+// Поля паспорта берутся из первой строки, правообладатели и здания - из каждой строки,
+// в которой заполнен хотя бы один их столбец.
+func buildRegistryPassport(m service.RegistryMapping, rows []*service.RegistryRow) (*entity.TechnicalPassport, string, entity.ValidationErrors) {
+	t := &registryTarget{
+		passport: entity.NewTechnicalPassport("", entity.Address{}),
+		layout:   m.DateLayout,
+	}
+	if t.layout == "" {
+		t.layout = defaultRegistryDateLayout
+	}
+
+	var problems entity.ValidationErrors
+	set := func(row *service.RegistryRow, field string) {
+		value, ok := registryValue(m, row, field)
+		if !ok {
+			return
+		}
+		if err := registryFields[field](t, field, value); err != nil {
+			problems = append(problems, lineError(row.Line, err))
+		}
+	}
+
+	fields := RegistryFields()
+	for i, row := range rows {
+		for _, field := range fields {
+			section, _, nested := strings.Cut(field, ".")
+			if nested && (section == "owners" || section == "buildings") {
+				continue
+			}
+			if i == 0 {
+				set(row, field)
+			}
+		}
+
+		if hasSection(row, "owners.") {
+			t.owner = &entity.Owner{}
+			for _, field := range fields {
+				if strings.HasPrefix(field, "owners.") {
+					set(row, field)
+				}
+			}
+			if t.owner.PersonType == "" {
+				t.owner.PersonType = entity.PersonTypeIndividual
+				if t.owner.FullName == "" && t.owner.CompanyName != "" {
+					t.owner.PersonType = entity.PersonTypeLegal
+				}
+			}
+			t.passport.Owners = append(t.passport.Owners, *t.owner)
+		}
+
+		if hasSection(row, "buildings.") {
+			t.building = &entity.Building{}
+			for _, field := range fields {
+				if strings.HasPrefix(field, "buildings.") {
+					set(row, field)
+				}
+			}
+			t.passport.Buildings = append(t.passport.Buildings, *t.building)
+		}
+	}
+
+	return t.passport, t.key, problems
+}
+
+// registryValue возвращает значение поля с учетом значений по умолчанию и перекодировки
+func registryValue(m service.RegistryMapping, row *service.RegistryRow, field string) (string, bool) {
+	value := strings.TrimSpace(row.Values[field])
+	if value == "" {
+		value = strings.TrimSpace(m.Defaults[field])
+	}
+	if value == "" {
+		return "", false
+	}
+
+	for from, to := range m.Dictionaries[field] {
+		if strings.EqualFold(strings.TrimSpace(from), value) {
+			return to, true
+		}
+	}
+	return value, true
+}
+
+// hasSection проверяет, заполнена ли в строке хотя бы одна ячейка раздела
+func hasSection(row *service.RegistryRow, prefix string) bool {
+	for field, value := range row.Values {
+		if strings.HasPrefix(field, prefix) && strings.TrimSpace(value) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// lineError добавляет номер строки файла к ошибке валидации
+func lineError(line int, err error) entity.ValidationError {
+	v, ok := err.(entity.ValidationError)
+	if !ok {
+		v = entity.ValidationError{Message: err.Error()}
+	}
+	field := fmt.Sprintf("line[%d]", line)
+	if v.Field != "" {
+		field += "." + v.Field
+	}
+	return entity.ValidationError{Field: field, Message: v.Message}
+}
+
+// text записывает строковое значение
+func text(target func(*registryTarget) *string) registrySetter {
+	return func(t *registryTarget, _, value string) error {
+		*target(t) = value
+		return nil
+	}
+}
+
+// integer разбирает целое число
+func integer(target func(*registryTarget) *int) registrySetter {
+	return func(t *registryTarget, field, value string) error {
+		v, err := parseNumber(field, value)
+		if err != nil {
+			return err
+		}
+		if v != float64(int(v)) {
+			return entity.ValidationError{Field: field, Message: fmt.Sprintf("значение %q не является целым числом", value)}
+		}
+		*target(t) = int(v)
+		return nil
+	}
+}
+
+// number разбирает число с запятой или точкой
+func number(target func(*registryTarget) *float64) registrySetter {
+	return func(t *registryTarget, field, value string) error {
+		v, err := parseNumber(field, value)
+		if err != nil {
+			return err
+		}
+		*target(t) = v
+		return nil
+	}
+}
+
+// date разбирает дату в формате реестра или ISO 8601
+func date(target func(*registryTarget) *time.Time) registrySetter {
+	return func(t *registryTarget, field, value string) error {
+		for _, layout := range []string{t.layout, "2006-01-02"} {
+			if d, err := time.Parse(layout, value); err == nil {
+				*target(t) = d
+				return nil
+			}
+		}
+		return entity.ValidationError{Field: field, Message: fmt.Sprintf("значение %q не является датой в формате %s", value, t.layout)}
+	}
+}
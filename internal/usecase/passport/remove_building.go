@@ -48,21 +48,10 @@ func (uc *RemoveBuildingUseCase) Execute(ctx context.Context, input RemoveBuildi
 		return nil, fmt.Errorf("failed to get passport: %w", err)
 	}
 
-	// Проверяем что индекс в пределах массива
-	if input.BuildingIndex >= len(passport.Buildings) {
-		return nil, entity.ValidationError{
-			Field:   "building_index",
-			Message: "здание с таким индексом не найдено",
-		}
-	}
-
 	// Удаляем здание
-	passport.Buildings = append(
-		passport.Buildings[:input.BuildingIndex],
-		passport.Buildings[input.BuildingIndex+1:]...,
-	)
-
-	passport.AddAuditEntry("remove_building", fmt.Sprintf("Удалено здание с индексом %d", input.BuildingIndex))
+	if err := passport.RemoveBuilding(input.BuildingIndex); err != nil {
+		return nil, err
+	}
 
 	// Сохраняем изменения
 	if err := uc.repo.Update(ctx, passport); err != nil {
@@ -0,0 +1,64 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// RemoveOwnerInput входные данные для удаления правообладателя
+type RemoveOwnerInput struct {
+	PassportID string
+	OwnerIndex int // индекс в массиве
+}
+
+// RemoveOwnerOutput результат удаления правообладателя
+type RemoveOwnerOutput struct {
+	Passport *entity.TechnicalPassport
+}
+
+// RemoveOwnerUseCase use case для удаления правообладателя из паспорта
+type RemoveOwnerUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewRemoveOwnerUseCase создает новый use case
+func NewRemoveOwnerUseCase(repo repository.PassportRepository) *RemoveOwnerUseCase {
+	return &RemoveOwnerUseCase{
+		repo: repo,
+	}
+}
+
+// Execute выполняет удаление правообладателя
+func (uc *RemoveOwnerUseCase) Execute(ctx context.Context, input RemoveOwnerInput) (*RemoveOwnerOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if input.OwnerIndex < 0 {
+		return nil, entity.ValidationError{Field: "owner_index", Message: "индекс правообладателя должен быть >= 0"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	// Удаляем правообладатель
+	if err := passport.RemoveOwner(input.OwnerIndex); err != nil {
+		return nil, err
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &RemoveOwnerOutput{
+		Passport: passport,
+	}, nil
+}
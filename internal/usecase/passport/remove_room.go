@@ -0,0 +1,75 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// RemoveRoomInput входные данные для удаления помещения
+type RemoveRoomInput struct {
+	PassportID string
+	RoomIndex  int // индекс в массиве
+}
+
+// RemoveRoomOutput результат удаления помещения
+type RemoveRoomOutput struct {
+	Passport *entity.TechnicalPassport
+}
+
+// RemoveRoomUseCase use case для удаления помещения из паспорта
+type RemoveRoomUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewRemoveRoomUseCase создает новый use case
+func NewRemoveRoomUseCase(repo repository.PassportRepository) *RemoveRoomUseCase {
+	return &RemoveRoomUseCase{
+		repo: repo,
+	}
+}
+
+// Execute выполняет удаление помещения
+func (uc *RemoveRoomUseCase) Execute(ctx context.Context, input RemoveRoomInput) (*RemoveRoomOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if input.RoomIndex < 0 {
+		return nil, entity.ValidationError{Field: "room_index", Message: "индекс помещения должен быть >= 0"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	// Проверяем что индекс в пределах массива
+	if input.RoomIndex >= len(passport.Explication) {
+		return nil, entity.ValidationError{
+			Field:   "room_index",
+			Message: "помещение с таким индексом не найдено",
+		}
+	}
+
+	// Удаляем помещение
+	passport.Explication = append(
+		passport.Explication[:input.RoomIndex],
+		passport.Explication[input.RoomIndex+1:]...,
+	)
+
+	passport.AddAuditEntry("remove_room", fmt.Sprintf("Удалено помещение с индексом %d", input.RoomIndex))
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &RemoveRoomOutput{
+		Passport: passport,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// Ограничения размера страницы поиска
+const (
+	DefaultSearchLimit = 50
+	MaxSearchLimit     = 500
+)
+
+// SearchPassportsInput входные данные для поиска паспортов
+type SearchPassportsInput struct {
+	// Query запрос поиска; Limit 0 - DefaultSearchLimit
+	Query repository.PassportQuery
+}
+
+// SearchPassportsOutput результат поиска паспортов
+type SearchPassportsOutput struct {
+	Passports []*entity.TechnicalPassport
+
+	// Total число найденных паспортов без учета Offset/Limit
+	Total int
+
+	Facets repository.SearchFacets
+
+	// Offset и Limit примененная страница
+	Offset int
+	Limit  int
+}
+
+// SearchPassportsUseCase use case для полнотекстового и фасетного поиска паспортов
+type SearchPassportsUseCase struct {
+	searcher repository.PassportSearcher
+}
+
+// NewSearchPassportsUseCase создает новый use case
+func NewSearchPassportsUseCase(searcher repository.PassportSearcher) *SearchPassportsUseCase {
+	return &SearchPassportsUseCase{
+		searcher: searcher,
+	}
+}
+
+// Execute выполняет поиск паспортов
+func (uc *SearchPassportsUseCase) Execute(ctx context.Context, input SearchPassportsInput) (*SearchPassportsOutput, error) {
+	query := input.Query
+
+	// Валидация входных данных
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	if query.Limit > MaxSearchLimit {
+		return nil, entity.ValidationError{Field: "limit", Message: fmt.Sprintf("лимит не может превышать %d", MaxSearchLimit)}
+	}
+
+	if query.Limit == 0 {
+		query.Limit = DefaultSearchLimit
+	}
+
+	result, err := uc.searcher.Search(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search passports: %w", err)
+	}
+
+	return &SearchPassportsOutput{
+		Passports: result.Passports,
+		Total:     result.Total,
+		Facets:    result.Facets,
+		Offset:    query.Offset,
+		Limit:     query.Limit,
+	}, nil
+}
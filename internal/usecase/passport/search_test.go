@@ -0,0 +1,59 @@
+package passport_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/search"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchPassportsUseCase_Execute(t *testing.T) {
+	repo := search.NewIndexedRepository(memory.NewInMemoryPassportRepository())
+	for i := 1; i <= 60; i++ {
+		p := entity.NewTechnicalPassport(entity.ObjectTypeApartment, entity.Address{Subject: "г. Москва", Street: "ул. Садовая", House: fmt.Sprint(i)})
+		p.ID = fmt.Sprintf("TP-%02d", i)
+		require.NoError(t, repo.Create(context.Background(), p))
+	}
+	useCase := passport.NewSearchPassportsUseCase(repo)
+
+	tests := []struct {
+		name      string
+		query     repository.PassportQuery
+		wantCount int
+		wantLimit int
+		field     string
+	}{
+		{name: "default page size", query: repository.PassportQuery{Text: "садовая"}, wantCount: 50, wantLimit: 50},
+		{name: "last page", query: repository.PassportQuery{Offset: 50, Limit: 20}, wantCount: 10, wantLimit: 20},
+		{name: "limit above maximum", query: repository.PassportQuery{Limit: 1000}, field: "limit"},
+		{name: "negative offset", query: repository.PassportQuery{Offset: -1}, field: "offset"},
+		{name: "inverted area range", query: repository.PassportQuery{AreaFrom: 100, AreaTo: 50}, field: "area"},
+		{name: "unknown sort field", query: repository.PassportQuery{Sort: "owner"}, field: "sort"},
+		{name: "sort by creation date", query: repository.PassportQuery{Sort: repository.SortCreated, Limit: 5}, wantCount: 5, wantLimit: 5},
+		{name: "inverted year range", query: repository.PassportQuery{YearFrom: 2020, YearTo: 2000}, field: "year"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := useCase.Execute(context.Background(), passport.SearchPassportsInput{Query: tt.query})
+
+			if tt.field != "" {
+				var validationErr entity.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, tt.field, validationErr.Field)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, output.Passports, tt.wantCount)
+			assert.Equal(t, tt.wantLimit, output.Limit)
+			assert.Equal(t, 60, output.Total)
+		})
+	}
+}
@@ -0,0 +1,342 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// BuildAreaChange площадь застройки здания, пересчитанная по контуру ситуационного плана
+type BuildAreaChange struct {
+	Litera   string  `json:"litera"`
+	Previous float64 `json:"previous"`
+	Area     float64 `json:"area"`
+}
+
+// SituationPlanSummary площади ситуационного плана после сохранения
+type SituationPlanSummary struct {
+	// PlotArea площадь земельного участка (кв.м), округленная до целых
+	PlotArea float64 `json:"plot_area"`
+
+	// BuildAreas пересчитанные площади застройки зданий
+	BuildAreas []BuildAreaChange `json:"build_areas,omitempty"`
+
+	// Unmatched литеры контуров, которых нет в составе объекта
+	Unmatched []string `json:"unmatched,omitempty"`
+}
+
+// applySituationPlan записывает план в паспорт и возвращает сводку площадей
+func applySituationPlan(passport *entity.TechnicalPassport, plan entity.SituationPlan) (*SituationPlanSummary, error) {
+	previous := make([]float64, len(passport.Buildings))
+	for i, b := range passport.Buildings {
+		previous[i] = b.BuildArea
+	}
+
+	if err := passport.SetSituationPlan(plan); err != nil {
+		return nil, err
+	}
+
+	summary := &SituationPlanSummary{PlotArea: plan.PlotArea()}
+	for i, b := range passport.Buildings {
+		if plan.Footprint(b.Litera) != nil {
+			summary.BuildAreas = append(summary.BuildAreas, BuildAreaChange{Litera: b.Litera, Previous: previous[i], Area: b.BuildArea})
+		}
+	}
+	for _, f := range plan.Footprints {
+		if passport.FindBuilding(f.Litera) == nil {
+			summary.Unmatched = append(summary.Unmatched, f.Litera)
+		}
+	}
+
+	return summary, nil
+}
+
+// SaveSituationPlanInput входные данные для сохранения ситуационного плана
+type SaveSituationPlanInput struct {
+	PassportID string
+	Plan       entity.SituationPlan
+}
+
+// SaveSituationPlanOutput результат сохранения ситуационного плана
+type SaveSituationPlanOutput struct {
+	Passport *entity.TechnicalPassport
+	Summary  *SituationPlanSummary
+}
+
+// SaveSituationPlanUseCase use case для замены ситуационного плана с пересчетом
+// площади застройки зданий по контурам
+type SaveSituationPlanUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewSaveSituationPlanUseCase создает новый use case
+func NewSaveSituationPlanUseCase(repo repository.PassportRepository) *SaveSituationPlanUseCase {
+	return &SaveSituationPlanUseCase{
+		repo: repo,
+	}
+}
+
+// Execute сохраняет ситуационный план
+func (uc *SaveSituationPlanUseCase) Execute(ctx context.Context, input SaveSituationPlanInput) (*SaveSituationPlanOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	summary, err := applySituationPlan(passport, input.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &SaveSituationPlanOutput{
+		Passport: passport,
+		Summary:  summary,
+	}, nil
+}
+
+// ImportPlotCoordinatesInput входные данные для загрузки координат из файла
+type ImportPlotCoordinatesInput struct {
+	PassportID string
+
+	Source io.Reader
+	Format service.CoordinateFormat
+
+	// Litera литера здания, контур которого загружается; пустая - граница участка
+	Litera string
+
+	// List номер списка точек в файле (с 0): полилинии DXF по порядку чертежа
+	List int
+
+	// Система координат и зона МСК; обязательны, если ситуационного плана еще нет
+	CoordinateSystem entity.CoordinateSystem
+	Zone             string
+}
+
+// ImportPlotCoordinatesOutput результат загрузки координат
+type ImportPlotCoordinatesOutput struct {
+	Passport *entity.TechnicalPassport
+	Summary  *SituationPlanSummary
+
+	// Lists списки точек, найденные в файле
+	Lists []service.PointList
+}
+
+// ImportPlotCoordinatesUseCase use case для загрузки границы участка или контура
+// здания из каталога координат (CSV) или чертежа (DXF)
+type ImportPlotCoordinatesUseCase struct {
+	repo   repository.PassportRepository
+	parser service.CoordinateParser
+}
+
+// NewImportPlotCoordinatesUseCase создает новый use case
+func NewImportPlotCoordinatesUseCase(repo repository.PassportRepository, parser service.CoordinateParser) *ImportPlotCoordinatesUseCase {
+	return &ImportPlotCoordinatesUseCase{
+		repo:   repo,
+		parser: parser,
+	}
+}
+
+// Execute загружает координаты и обновляет ситуационный план
+func (uc *ImportPlotCoordinatesUseCase) Execute(ctx context.Context, input ImportPlotCoordinatesInput) (*ImportPlotCoordinatesOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if !input.Format.IsValid() {
+		return nil, entity.ValidationError{Field: "format", Message: "поддерживаются форматы csv и dxf"}
+	}
+
+	if input.CoordinateSystem != "" && !input.CoordinateSystem.IsValid() {
+		return nil, entity.ValidationError{Field: "coordinate_system", Message: "поддерживаются системы координат msk и wgs84"}
+	}
+
+	if input.List < 0 {
+		return nil, entity.ValidationError{Field: "list", Message: "номер списка точек должен быть >= 0"}
+	}
+
+	lists, err := uc.parser.ParsePoints(ctx, input.Source, input.Format)
+	if err != nil {
+		return nil, err
+	}
+	if input.List >= len(lists) {
+		return nil, entity.ValidationError{
+			Field:   "list",
+			Message: fmt.Sprintf("в файле %d списков точек", len(lists)),
+		}
+	}
+	points := lists[input.List].Points
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	var plan entity.SituationPlan
+	if passport.SituationPlan != nil {
+		plan = *passport.SituationPlan
+		plan.Footprints = append([]entity.BuildingFootprint{}, plan.Footprints...)
+		if input.CoordinateSystem != "" && input.CoordinateSystem != plan.CoordinateSystem {
+			return nil, entity.ValidationError{
+				Field:   "coordinate_system",
+				Message: "ситуационный план задан в системе " + string(plan.CoordinateSystem),
+			}
+		}
+	} else {
+		if input.CoordinateSystem == "" {
+			return nil, entity.ValidationError{Field: "coordinate_system", Message: "укажите систему координат нового ситуационного плана"}
+		}
+		plan.CoordinateSystem = input.CoordinateSystem
+		plan.Zone = input.Zone
+	}
+
+	if input.Litera == "" {
+		plan.Boundary = points
+	} else if f := plan.Footprint(input.Litera); f != nil {
+		f.Points = points
+	} else {
+		plan.Footprints = append(plan.Footprints, entity.BuildingFootprint{Litera: input.Litera, Points: points})
+	}
+
+	summary, err := applySituationPlan(passport, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &ImportPlotCoordinatesOutput{
+		Passport: passport,
+		Summary:  summary,
+		Lists:    lists,
+	}, nil
+}
+
+// RemoveSituationPlanInput входные данные для удаления ситуационного плана
+type RemoveSituationPlanInput struct {
+	PassportID string
+}
+
+// RemoveSituationPlanOutput результат удаления ситуационного плана
+type RemoveSituationPlanOutput struct {
+	Passport *entity.TechnicalPassport
+}
+
+// RemoveSituationPlanUseCase use case для удаления векторного ситуационного плана
+type RemoveSituationPlanUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewRemoveSituationPlanUseCase создает новый use case
+func NewRemoveSituationPlanUseCase(repo repository.PassportRepository) *RemoveSituationPlanUseCase {
+	return &RemoveSituationPlanUseCase{
+		repo: repo,
+	}
+}
+
+// Execute удаляет ситуационный план; площади застройки зданий сохраняются
+func (uc *RemoveSituationPlanUseCase) Execute(ctx context.Context, input RemoveSituationPlanInput) (*RemoveSituationPlanOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	if err := passport.RemoveSituationPlan(); err != nil {
+		return nil, err
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &RemoveSituationPlanOutput{
+		Passport: passport,
+	}, nil
+}
+
+// RenderSituationPlanInput входные данные для отрисовки ситуационного плана
+type RenderSituationPlanInput struct {
+	PassportID string
+	Format     service.PlanImageFormat
+}
+
+// RenderSituationPlanOutput изображение ситуационного плана
+type RenderSituationPlanOutput struct {
+	Data   []byte
+	Format service.PlanImageFormat
+}
+
+// RenderSituationPlanUseCase use case для отрисовки ситуационного плана в SVG или PNG
+type RenderSituationPlanUseCase struct {
+	repo     repository.PassportRepository
+	renderer service.SituationPlanRenderer
+}
+
+// NewRenderSituationPlanUseCase создает новый use case
+func NewRenderSituationPlanUseCase(repo repository.PassportRepository, renderer service.SituationPlanRenderer) *RenderSituationPlanUseCase {
+	return &RenderSituationPlanUseCase{
+		repo:     repo,
+		renderer: renderer,
+	}
+}
+
+// Execute отрисовывает ситуационный план паспорта
+func (uc *RenderSituationPlanUseCase) Execute(ctx context.Context, input RenderSituationPlanInput) (*RenderSituationPlanOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if input.Format == "" {
+		input.Format = service.PlanFormatSVG
+	}
+	if !input.Format.IsValid() {
+		return nil, entity.ValidationError{Field: "format", Message: "поддерживаются форматы svg и png"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	if passport.SituationPlan == nil {
+		return nil, entity.ValidationError{Field: "situation_plan", Message: "ситуационный план не задан"}
+	}
+
+	data, err := uc.renderer.RenderSituationPlan(ctx, passport.SituationPlan, input.Format)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render situation plan: %w", err)
+	}
+
+	return &RenderSituationPlanOutput{
+		Data:   data,
+		Format: input.Format,
+	}, nil
+}
@@ -0,0 +1,219 @@
+package passport_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/coordinates"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/floorplan"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mskRectangle прямоугольник в МСК: north и east - координаты юго-западного угла
+func mskRectangle(north, east, height, width float64) []entity.GeoPoint {
+	return []entity.GeoPoint{
+		{X: north, Y: east},
+		{X: north + height, Y: east},
+		{X: north + height, Y: east + width},
+		{X: north, Y: east + width},
+	}
+}
+
+// situationPassport паспорт с домом А и сараем Б
+func situationPassport(t *testing.T, repo *memory.InMemoryPassportRepository) *entity.TechnicalPassport {
+	p := planPassport(t, repo)
+	p.Buildings = []entity.Building{
+		{Litera: "А", Name: "Жилой дом", CommissionYear: 2020, BuildArea: 118.0},
+		{Litera: "Б", Name: "Сарай", CommissionYear: 2020, BuildArea: 12.0},
+	}
+	require.NoError(t, repo.Update(context.Background(), p))
+	return p
+}
+
+func TestSituationPlan_Areas(t *testing.T) {
+	msk := entity.SituationPlan{
+		CoordinateSystem: entity.CoordinateMSK,
+		Boundary:         mskRectangle(470100.12, 2200200.45, 30, 20),
+		Footprints:       []entity.BuildingFootprint{{Litera: "А", Points: mskRectangle(470105, 2200205, 10.04, 12)}},
+	}
+	require.NoError(t, msk.IsValid())
+	assert.Equal(t, 600.0, msk.PlotArea())
+	assert.Equal(t, 120.5, msk.FootprintArea(msk.Footprints[0]))
+
+	// Участок 0,001° x 0,0015° на широте Москвы: 111,34 x 94,19 м
+	wgs := entity.SituationPlan{
+		CoordinateSystem: entity.CoordinateWGS84,
+		Boundary: []entity.GeoPoint{
+			{X: 55.7500, Y: 37.6100},
+			{X: 55.7510, Y: 37.6100},
+			{X: 55.7510, Y: 37.6115},
+			{X: 55.7500, Y: 37.6115},
+		},
+	}
+	require.NoError(t, wgs.IsValid())
+	assert.InDelta(t, 10487.0, wgs.PlotArea(), 1)
+}
+
+func TestSituationPlan_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		plan  entity.SituationPlan
+		field string
+	}{
+		{
+			name:  "unknown coordinate system",
+			plan:  entity.SituationPlan{CoordinateSystem: "sk42", Boundary: mskRectangle(0, 0, 1, 1)},
+			field: "coordinate_system",
+		},
+		{
+			name:  "empty plan",
+			plan:  entity.SituationPlan{CoordinateSystem: entity.CoordinateMSK},
+			field: "boundary",
+		},
+		{
+			name:  "collinear boundary",
+			plan:  entity.SituationPlan{CoordinateSystem: entity.CoordinateMSK, Boundary: []entity.GeoPoint{{X: 0}, {X: 1}, {X: 2}}},
+			field: "boundary",
+		},
+		{
+			name:  "latitude out of range",
+			plan:  entity.SituationPlan{CoordinateSystem: entity.CoordinateWGS84, Boundary: []entity.GeoPoint{{X: 91}, {X: 55, Y: 37}, {X: 56, Y: 38}}},
+			field: "boundary[0]",
+		},
+		{
+			name: "duplicate footprint",
+			plan: entity.SituationPlan{
+				CoordinateSystem: entity.CoordinateMSK,
+				Footprints: []entity.BuildingFootprint{
+					{Litera: "А", Points: mskRectangle(0, 0, 1, 1)},
+					{Litera: "а", Points: mskRectangle(5, 5, 1, 1)},
+				},
+			},
+			field: "footprints[1].litera",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.plan.IsValid()
+			var ve entity.ValidationError
+			require.ErrorAs(t, err, &ve)
+			assert.Equal(t, tt.field, ve.Field)
+		})
+	}
+}
+
+func TestSituationPlanUseCases(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	p := situationPassport(t, repo)
+
+	plan := entity.SituationPlan{
+		CoordinateSystem: entity.CoordinateMSK,
+		Zone:             "МСК-50, зона 2",
+		Boundary:         mskRectangle(470100, 2200200, 30, 20),
+		Footprints: []entity.BuildingFootprint{
+			{Litera: "А", Points: mskRectangle(470105, 2200205, 10, 12)},
+			{Litera: "В", Points: mskRectangle(470120, 2200205, 2, 2)},
+		},
+		Annotations: []entity.PlanAnnotation{{Text: "ул. Ленина", At: entity.GeoPoint{X: 470095, Y: 2200210}}},
+	}
+
+	saved, err := passport.NewSaveSituationPlanUseCase(repo).Execute(ctx, passport.SaveSituationPlanInput{PassportID: p.ID, Plan: plan})
+	require.NoError(t, err)
+	assert.Equal(t, 600.0, saved.Summary.PlotArea)
+	assert.Equal(t, []passport.BuildAreaChange{{Litera: "А", Previous: 118.0, Area: 120.0}}, saved.Summary.BuildAreas)
+	assert.Equal(t, []string{"В"}, saved.Summary.Unmatched)
+	assert.Equal(t, 120.0, saved.Passport.Buildings[0].BuildArea)
+	assert.Equal(t, "add_situation_plan", saved.Passport.AuditLog[len(saved.Passport.AuditLog)-1].Action)
+
+	validated, err := passport.NewValidatePassportUseCase(repo).Execute(ctx, passport.ValidatePassportInput{PassportID: p.ID})
+	require.NoError(t, err)
+	assert.Contains(t, validated.Result.Warnings, "ситуационный план: литеры В нет в составе объекта")
+	assert.Contains(t, validated.Result.Warnings, "ситуационный план: нет контура здания литеры Б")
+
+	rendered, err := passport.NewRenderSituationPlanUseCase(repo, floorplan.NewRenderer()).Execute(ctx, passport.RenderSituationPlanInput{PassportID: p.ID})
+	require.NoError(t, err)
+	assert.Equal(t, service.PlanFormatSVG, rendered.Format)
+	assert.Contains(t, string(rendered.Data), "Ситуационный план, площадь участка 600 кв.м")
+
+	removed, err := passport.NewRemoveSituationPlanUseCase(repo).Execute(ctx, passport.RemoveSituationPlanInput{PassportID: p.ID})
+	require.NoError(t, err)
+	assert.Nil(t, removed.Passport.SituationPlan)
+	assert.Equal(t, 120.0, removed.Passport.Buildings[0].BuildArea)
+
+	_, err = passport.NewRenderSituationPlanUseCase(repo, floorplan.NewRenderer()).Execute(ctx, passport.RenderSituationPlanInput{PassportID: p.ID})
+	var ve entity.ValidationError
+	require.ErrorAs(t, err, &ve)
+	assert.Equal(t, "situation_plan", ve.Field)
+}
+
+func TestImportPlotCoordinates(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	p := situationPassport(t, repo)
+	uc := passport.NewImportPlotCoordinatesUseCase(repo, coordinates.NewParser())
+
+	// Нового плана без системы координат нет
+	_, err := uc.Execute(ctx, passport.ImportPlotCoordinatesInput{
+		PassportID: p.ID,
+		Source:     strings.NewReader("1;2\n3;4\n5;7\n"),
+		Format:     service.CoordinateFormatCSV,
+	})
+	var ve entity.ValidationError
+	require.ErrorAs(t, err, &ve)
+	assert.Equal(t, "coordinate_system", ve.Field)
+
+	// Сначала контур сарая из DXF: полилиния 4 x 3 м (X чертежа - восток)
+	dxf := strings.Join([]string{
+		"0", "SECTION", "2", "ENTITIES",
+		"0", "LWPOLYLINE", "8", "Здания", "90", "4",
+		"10", "2200210.0", "20", "470120.0",
+		"10", "2200214.0", "20", "470120.0",
+		"10", "2200214.0", "20", "470123.0",
+		"10", "2200210.0", "20", "470123.0",
+		"0", "ENDSEC", "0", "EOF",
+	}, "\n")
+	output, err := uc.Execute(ctx, passport.ImportPlotCoordinatesInput{
+		PassportID:       p.ID,
+		Source:           strings.NewReader(dxf),
+		Format:           service.CoordinateFormatDXF,
+		Litera:           "Б",
+		CoordinateSystem: entity.CoordinateMSK,
+	})
+	require.NoError(t, err)
+	require.Len(t, output.Lists, 1)
+	assert.Equal(t, 12.0, output.Passport.Buildings[1].BuildArea)
+	assert.Equal(t, 0.0, output.Summary.PlotArea)
+
+	// Затем граница участка из каталога координат
+	csv := "Точка;X;Y\nн1;470100,00;2200200,00\nн2;470130,00;2200200,00\nн3;470130,00;2200220,00\nн4;470100,00;2200220,00\nн1;470100,00;2200200,00\n"
+	output, err = uc.Execute(ctx, passport.ImportPlotCoordinatesInput{
+		PassportID: p.ID,
+		Source:     strings.NewReader(csv),
+		Format:     service.CoordinateFormatCSV,
+	})
+	require.NoError(t, err)
+	plan := output.Passport.SituationPlan
+	require.NotNil(t, plan)
+	assert.Len(t, plan.Boundary, 4)
+	assert.Equal(t, "н1", plan.Boundary[0].Name)
+	assert.Len(t, plan.Footprints, 1)
+	assert.Equal(t, 600.0, output.Summary.PlotArea)
+
+	// Система координат плана не меняется при догрузке
+	_, err = uc.Execute(ctx, passport.ImportPlotCoordinatesInput{
+		PassportID:       p.ID,
+		Source:           strings.NewReader(csv),
+		Format:           service.CoordinateFormatCSV,
+		CoordinateSystem: entity.CoordinateWGS84,
+	})
+	require.ErrorAs(t, err, &ve)
+	assert.Equal(t, "coordinate_system", ve.Field)
+}
@@ -0,0 +1,125 @@
+package passport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// AssessWearInput входные данные для описания конструктивных элементов здания
+type AssessWearInput struct {
+	PassportID string
+	Litera     string
+
+	// Elements описание элементов; nil - пересчитать износ по сохраненному описанию
+	Elements []entity.StructuralElement
+
+	// TableVersion версия справочника удельных весов; пустая - действующая
+	TableVersion string
+
+	// DryRun только рассчитать, не изменяя паспорт
+	DryRun bool
+}
+
+// AssessWearOutput результат оценки износа
+type AssessWearOutput struct {
+	Passport *entity.TechnicalPassport
+	Elements []entity.StructuralElement
+
+	// Assessment взвешенный износ; nil, если удельных весов для здания нет
+	Assessment *entity.WearAssessment
+
+	Warnings []string
+}
+
+// AssessWearUseCase use case для описания конструктивных элементов здания
+// и расчета физического износа по удельным весам элементов
+type AssessWearUseCase struct {
+	repo   repository.PassportRepository
+	valuer service.InventoryValuer
+}
+
+// NewAssessWearUseCase создает новый use case
+func NewAssessWearUseCase(repo repository.PassportRepository, valuer service.InventoryValuer) *AssessWearUseCase {
+	return &AssessWearUseCase{
+		repo:   repo,
+		valuer: valuer,
+	}
+}
+
+// Execute выполняет оценку износа и запись элементов в здание
+func (uc *AssessWearUseCase) Execute(ctx context.Context, input AssessWearInput) (*AssessWearOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if input.Litera == "" {
+		return nil, entity.ValidationError{Field: "litera", Message: "литера обязательна"}
+	}
+
+	if err := entity.ValidateStructuralElements(input.Elements); err != nil {
+		return nil, err
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	building := passport.FindBuilding(input.Litera)
+	if building == nil {
+		return nil, entity.ValidationError{Field: "litera", Message: "литеры " + input.Litera + " нет в составе объекта"}
+	}
+
+	elements := input.Elements
+	if elements == nil {
+		elements = append([]entity.StructuralElement(nil), building.StructuralElements...)
+	}
+	entity.SortStructuralElements(elements)
+
+	output := &AssessWearOutput{
+		Passport: passport,
+		Elements: elements,
+	}
+
+	// Без удельных весов (например, материал стен не относится ни к одной группе)
+	// описание элементов сохраняется без износа здания
+	if len(elements) > 0 {
+		assessment, err := uc.valuer.AssessWear(entity.ValuationInputs{
+			TableVersion: input.TableVersion,
+			WallMaterial: building.WallMaterial,
+			ElementWear:  entity.ElementWear(elements),
+		})
+		var validationErr entity.ValidationError
+		switch {
+		case errors.As(err, &validationErr):
+			output.Warnings = append(output.Warnings, "износ здания не рассчитан: "+validationErr.Message)
+		case err != nil:
+			return nil, fmt.Errorf("failed to assess wear: %w", err)
+		default:
+			output.Assessment = assessment
+			output.Warnings = append(output.Warnings, assessment.Warnings...)
+		}
+	}
+
+	if input.DryRun {
+		return output, nil
+	}
+
+	if err := passport.ApplyStructuralElements(input.Litera, elements, output.Assessment); err != nil {
+		return nil, err
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,125 @@
+package passport_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/valuation"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssessWearUseCase(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	p := valuationPassport(t, repo)
+	uc := passport.NewAssessWearUseCase(repo, valuation.NewEngine())
+
+	elements := []entity.StructuralElement{
+		{Kind: entity.ElementWalls, Description: "кирпичные", Condition: entity.ConditionUnsatisfactory, Wear: 40},
+		{Kind: entity.ElementFoundation, Description: "ленточный бутовый", Wear: 20},
+	}
+
+	// Предварительный расчет не меняет паспорт
+	preview, err := uc.Execute(ctx, passport.AssessWearInput{PassportID: p.ID, Litera: "А", Elements: elements, DryRun: true})
+	require.NoError(t, err)
+	require.NotNil(t, preview.Assessment)
+	assert.Equal(t, 35.0, preview.Assessment.Wear)
+	saved, err := repo.GetByID(ctx, p.ID)
+	require.NoError(t, err)
+	assert.Empty(t, saved.Buildings[0].StructuralElements)
+
+	output, err := uc.Execute(ctx, passport.AssessWearInput{PassportID: p.ID, Litera: "А", Elements: elements})
+	require.NoError(t, err)
+	assert.Len(t, output.Warnings, 1, "оценены не все элементы")
+
+	saved, err = repo.GetByID(ctx, p.ID)
+	require.NoError(t, err)
+	building := saved.Buildings[0]
+	require.Len(t, building.StructuralElements, 2)
+	assert.Equal(t, entity.ElementFoundation, building.StructuralElements[0].Kind, "элементы упорядочены как в паспорте")
+	require.NotNil(t, building.WearAssessment)
+	assert.Equal(t, 35.0, building.WearAssessment.Wear)
+	assert.Equal(t, "assess_wear", saved.AuditLog[len(saved.AuditLog)-1].Action)
+
+	// Износ элементов учитывается в стоимости
+	valued, err := passport.NewValueBuildingUseCase(repo, valuation.NewEngine()).Execute(ctx, passport.ValueBuildingInput{PassportID: p.ID, Litera: "А", Year: 2024})
+	require.NoError(t, err)
+	assert.Equal(t, 35.0, valued.Valuation.Wear)
+	assert.Equal(t, 802701.0, valued.Valuation.InventoryValue)
+
+	// Изменение износа элемента без пересчета попадает в предупреждения
+	saved, err = repo.GetByID(ctx, p.ID)
+	require.NoError(t, err)
+	saved.Buildings[0].StructuralElements[1].Wear = 70
+	require.NoError(t, repo.Update(ctx, saved))
+	validated, err := passport.NewValidatePassportUseCase(repo).Execute(ctx, passport.ValidatePassportInput{PassportID: p.ID})
+	require.NoError(t, err)
+	assert.Contains(t, validated.Result.Warnings, "литера А: стены - состояние «неудовлетворительное» не соответствует износу 70%")
+	assert.Contains(t, validated.Result.Warnings, "литера А: износ элементов изменился после расчета износа здания")
+	assert.Contains(t, validated.Result.Warnings, "литера А: данные здания изменились после расчета стоимости")
+
+	// Пересчет по сохраненному описанию
+	output, err = uc.Execute(ctx, passport.AssessWearInput{PassportID: p.ID, Litera: "А"})
+	require.NoError(t, err)
+	assert.Equal(t, 58.0, output.Assessment.Wear) // (9 x 20 + 30 x 70) / 39 = 58,46
+}
+
+func TestAssessWearUseCase_WithoutWeights(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	p := valuationPassport(t, repo)
+
+	// Материал стен литеры Г не относится ни к одной группе: описание сохраняется без износа
+	output, err := passport.NewAssessWearUseCase(repo, valuation.NewEngine()).Execute(ctx, passport.AssessWearInput{
+		PassportID: p.ID,
+		Litera:     "Г",
+		Elements:   []entity.StructuralElement{{Kind: entity.ElementWalls, Description: "саманные", Wear: 50}},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, output.Assessment)
+	require.Len(t, output.Warnings, 1)
+	assert.Contains(t, output.Warnings[0], "износ здания не рассчитан")
+
+	saved, err := repo.GetByID(ctx, p.ID)
+	require.NoError(t, err)
+	assert.Len(t, saved.Buildings[1].StructuralElements, 1)
+	assert.Nil(t, saved.Buildings[1].WearAssessment)
+}
+
+func TestAssessWearUseCase_Invalid(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	p := valuationPassport(t, repo)
+	uc := passport.NewAssessWearUseCase(repo, valuation.NewEngine())
+
+	tests := []struct {
+		name     string
+		litera   string
+		elements []entity.StructuralElement
+		field    string
+	}{
+		{name: "unknown litera", litera: "Б", field: "litera"},
+		{name: "unknown element", litera: "А", elements: []entity.StructuralElement{{Kind: "chimney", Wear: 10}}, field: "kind"},
+		{name: "unknown condition", litera: "А", elements: []entity.StructuralElement{{Kind: entity.ElementRoof, Condition: "bad", Wear: 10}}, field: "condition"},
+		{name: "wear out of range", litera: "А", elements: []entity.StructuralElement{{Kind: entity.ElementRoof, Wear: 110}}, field: "wear"},
+		{
+			name:     "element described twice",
+			litera:   "А",
+			elements: []entity.StructuralElement{{Kind: entity.ElementRoof, Wear: 10}, {Kind: entity.ElementRoof, Wear: 20}},
+			field:    "structural_elements",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.Execute(ctx, passport.AssessWearInput{PassportID: p.ID, Litera: tt.litera, Elements: tt.elements})
+			var validationErr entity.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// SetStructureInput входные данные для добавления или замены сооружения
+type SetStructureInput struct {
+	PassportID string
+	Structure  entity.Structure
+}
+
+// SetStructureOutput результат добавления или замены сооружения
+type SetStructureOutput struct {
+	Passport *entity.TechnicalPassport
+
+	// Structure сооружение с протяженностью и площадью, вычисленными по трассе
+	Structure entity.Structure
+
+	// Replaced заменено сооружение с той же литерой
+	Replaced bool
+}
+
+// SetStructureUseCase use case для добавления или замены сооружения в составе объекта
+type SetStructureUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewSetStructureUseCase создает новый use case
+func NewSetStructureUseCase(repo repository.PassportRepository) *SetStructureUseCase {
+	return &SetStructureUseCase{
+		repo: repo,
+	}
+}
+
+// Execute добавляет или заменяет сооружение; сохраненный расчет стоимости
+// заменяемого сооружения переносится, если новый не передан
+func (uc *SetStructureUseCase) Execute(ctx context.Context, input SetStructureInput) (*SetStructureOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	if previous := passport.FindStructure(input.Structure.Litera); previous != nil && input.Structure.Valuation == nil {
+		input.Structure.Valuation = previous.Valuation
+	}
+
+	replaced, err := passport.SetStructure(input.Structure)
+	if err != nil {
+		return nil, err
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &SetStructureOutput{
+		Passport:  passport,
+		Structure: *passport.FindStructure(input.Structure.Litera),
+		Replaced:  replaced,
+	}, nil
+}
+
+// RemoveStructureInput входные данные для удаления сооружения
+type RemoveStructureInput struct {
+	PassportID string
+	Litera     string
+}
+
+// RemoveStructureOutput результат удаления сооружения
+type RemoveStructureOutput struct {
+	Passport *entity.TechnicalPassport
+}
+
+// RemoveStructureUseCase use case для удаления сооружения из состава объекта
+type RemoveStructureUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewRemoveStructureUseCase создает новый use case
+func NewRemoveStructureUseCase(repo repository.PassportRepository) *RemoveStructureUseCase {
+	return &RemoveStructureUseCase{
+		repo: repo,
+	}
+}
+
+// Execute удаляет сооружение
+func (uc *RemoveStructureUseCase) Execute(ctx context.Context, input RemoveStructureInput) (*RemoveStructureOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if input.Litera == "" {
+		return nil, entity.ValidationError{Field: "litera", Message: "литера обязательна"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	if err := passport.RemoveStructure(input.Litera); err != nil {
+		return nil, err
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &RemoveStructureOutput{
+		Passport: passport,
+	}, nil
+}
@@ -0,0 +1,112 @@
+package passport_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/valuation"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetStructure(t *testing.T) {
+	route := []entity.GeoPoint{{X: 0, Y: 0}, {X: 30, Y: 40}, {X: 30, Y: 60}}
+
+	tests := []struct {
+		name      string
+		structure entity.Structure
+		length    float64
+		area      float64
+		field     string
+	}{
+		{
+			name:      "fence length from route",
+			structure: entity.Structure{Litera: "I", Kind: entity.StructureKindFence, Name: "Забор", CommissionYear: 2010, Length: 5, Route: route},
+			length:    70, // 50 + 20 м, заданная протяженность заменяется трассой
+		},
+		{
+			name:      "road area from width",
+			structure: entity.Structure{Litera: "II", Kind: entity.StructureKindRoad, Name: "Проезд", CommissionYear: 2015, Width: 3.5, Route: route},
+			length:    70,
+			area:      245,
+		},
+		{
+			name:      "septic without route",
+			structure: entity.Structure{Litera: "III", Kind: entity.StructureKindSeptic, Name: "Выгребная яма", CommissionYear: 2000, Capacity: 4},
+		},
+		{
+			name:      "pipeline without diameter",
+			structure: entity.Structure{Litera: "IV", Kind: entity.StructureKindPipeline, Name: "Водопровод", CommissionYear: 2000, Route: route},
+			field:     "diameter",
+		},
+		{
+			name:      "route of well",
+			structure: entity.Structure{Litera: "V", Kind: entity.StructureKindWell, Name: "Колодец", CommissionYear: 2000, Depth: 8, Route: route},
+			field:     "route",
+		},
+		{
+			name:      "litera of building",
+			structure: entity.Structure{Litera: "а", Kind: entity.StructureKindSeptic, Name: "Выгребная яма", CommissionYear: 2000, Capacity: 4},
+			field:     "litera",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := memory.NewInMemoryPassportRepository()
+			p := entity.NewTechnicalPassport(entity.ObjectTypeResidentialHouse, entity.Address{Subject: "г. Москва", House: "1"})
+			p.ID = "TP-STRUCT"
+			p.Buildings = []entity.Building{{Litera: "А", Name: "Жилой дом", CommissionYear: 2000, TotalArea: 80}}
+			require.NoError(t, repo.Create(ctx, p))
+
+			output, err := passport.NewSetStructureUseCase(repo).Execute(ctx, passport.SetStructureInput{PassportID: p.ID, Structure: tt.structure})
+			if tt.field != "" {
+				var validationErr entity.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, tt.field, validationErr.Field)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.length, output.Structure.Length)
+			assert.Equal(t, tt.area, output.Structure.Area)
+			assert.Len(t, output.Passport.Structures, 1)
+		})
+	}
+}
+
+func TestStructureValuation(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	p := entity.NewTechnicalPassport(entity.ObjectTypeResidentialHouse, entity.Address{Subject: "г. Москва", House: "1"})
+	p.ID = "TP-ROAD"
+	require.NoError(t, repo.Create(ctx, p))
+
+	setStructure := passport.NewSetStructureUseCase(repo)
+	road := entity.Structure{
+		Litera: "I", Kind: entity.StructureKindRoad, Name: "Проезд", CommissionYear: 2020, Material: "асфальтобетон",
+		Width: 3, Wear: 20, Route: []entity.GeoPoint{{X: 0, Y: 0}, {X: 0, Y: 50}},
+	}
+	_, err := setStructure.Execute(ctx, passport.SetStructureInput{PassportID: p.ID, Structure: road})
+	require.NoError(t, err)
+
+	value := passport.NewValueBuildingUseCase(repo, valuation.NewEngine())
+	output, err := value.Execute(ctx, passport.ValueBuildingInput{PassportID: p.ID, Litera: "I", Year: 2024})
+	require.NoError(t, err)
+	assert.Equal(t, "road_asphalt", output.Valuation.WallGroup)
+	assert.Equal(t, 150.0, output.Valuation.Quantity)
+	assert.Equal(t, 20.0, output.Valuation.Wear, "износ сооружения по осмотру")
+	assert.Equal(t, 36575.0, output.Passport.CalculateTotalInventoryValue())
+
+	// Замена сооружения сохраняет расчет, изменение трассы отмечается при проверке
+	road.Route = append(road.Route, entity.GeoPoint{X: 20, Y: 50})
+	_, err = setStructure.Execute(ctx, passport.SetStructureInput{PassportID: p.ID, Structure: road})
+	require.NoError(t, err)
+
+	validated, err := passport.NewValidatePassportUseCase(repo).Execute(ctx, passport.ValidatePassportInput{PassportID: p.ID})
+	require.NoError(t, err)
+	assert.Contains(t, validated.Result.Warnings, "сооружение литеры I: данные сооружения изменились после расчета стоимости")
+}
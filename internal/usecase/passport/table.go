@@ -0,0 +1,324 @@
+package passport
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+)
+
+// TableKind таблица паспорта, которая загружается из электронной таблицы
+type TableKind string
+
+const (
+	// TableExplication экспликация помещений (entity.Room)
+	TableExplication TableKind = "explication"
+
+	// TableBuildings состав объекта (entity.Building)
+	TableBuildings TableKind = "buildings"
+)
+
+// IsValid проверяет, что таблица поддерживается
+func (k TableKind) IsValid() bool {
+	return k == TableExplication || k == TableBuildings
+}
+
+// TableField поле строки таблицы паспорта
+type TableField struct {
+	// Key имя поля в JSON представлении сущности
+	Key string
+
+	// Title заголовок столбца
+	Title string
+
+	// Required поле обязательно для сопоставления со столбцом
+	Required bool
+
+	// aliases фрагменты заголовков столбцов, по которым поле узнается автоматически
+	aliases []string
+}
+
+// explicationFields поля экспликации в порядке столбцов формы № 244
+var explicationFields = []TableField{
+	{Key: "litera", Title: "Литера", Required: true, aliases: []string{"лит"}},
+	{Key: "floor", Title: "Этаж", Required: true, aliases: []string{"этаж"}},
+	{Key: "room_number", Title: "№ помещения", Required: true, aliases: []string{"номер", "№"}},
+	{Key: "purpose", Title: "Назначение", Required: true, aliases: []string{"назначение", "наименование"}},
+	{Key: "area", Title: "Общая площадь, кв.м", Required: true, aliases: []string{"общая", "площадь"}},
+	{Key: "living_area", Title: "Жилая площадь, кв.м", aliases: []string{"жилая", "основная"}},
+	{Key: "auxiliary_area", Title: "Вспомогательная площадь, кв.м", aliases: []string{"вспомогат", "подсобн"}},
+	{Key: "height", Title: "Высота, м", aliases: []string{"высота"}},
+	{Key: "unauthorized_area", Title: "Самовольно переустроенная площадь, кв.м", aliases: []string{"самовол"}},
+	{Key: "note", Title: "Примечание", aliases: []string{"примечан"}},
+}
+
+// buildingFields поля состава объекта
+var buildingFields = []TableField{
+	{Key: "litera", Title: "Литера", Required: true, aliases: []string{"лит"}},
+	{Key: "name", Title: "Наименование", Required: true, aliases: []string{"наименование", "назначение"}},
+	{Key: "commission_year", Title: "Год ввода", Required: true, aliases: []string{"год"}},
+	{Key: "wall_material", Title: "Материал стен", aliases: []string{"материал", "стен"}},
+	{Key: "total_area", Title: "Общая площадь, кв.м", aliases: []string{"общая", "площадь"}},
+	{Key: "build_area", Title: "Площадь застройки, кв.м", aliases: []string{"застройк"}},
+	{Key: "height", Title: "Высота, м", aliases: []string{"высота"}},
+	{Key: "volume", Title: "Объем, куб.м", aliases: []string{"объем", "объём"}},
+	{Key: "inventory_value", Title: "Инвентаризационная стоимость, руб", aliases: []string{"стоимость"}},
+}
+
+// TableFields возвращает поля строки таблицы
+func TableFields(kind TableKind) []TableField {
+	if kind == TableBuildings {
+		return buildingFields
+	}
+	return explicationFields
+}
+
+// ColumnMapping сопоставление полей таблицы паспорта столбцам листа:
+// ключ - TableField.Key, значение - номер столбца с нуля
+type ColumnMapping map[string]int
+
+// maxHeaderScan количество первых строк листа, среди которых ищется заголовок
+const maxHeaderScan = 15
+
+// SuggestMapping сопоставляет поля столбцам по тексту заголовков.
+// Поле, для которого подходящий столбец не найден, в результат не попадает.
+func SuggestMapping(kind TableKind, header []string) ColumnMapping {
+	mapping := ColumnMapping{}
+	used := map[int]bool{}
+
+	// Сначала более специфичные поля: "Жилая площадь" не должна
+	// достаться полю общей площади только потому, что содержит "площадь"
+	fields := TableFields(kind)
+	for pass := 0; pass < 2; pass++ {
+		for _, field := range fields {
+			if _, ok := mapping[field.Key]; ok {
+				continue
+			}
+			for col, title := range header {
+				if used[col] || !matchesAlias(title, field.aliases, pass == 0) {
+					continue
+				}
+				mapping[field.Key] = col
+				used[col] = true
+				break
+			}
+		}
+	}
+
+	return mapping
+}
+
+// matchesAlias проверяет заголовок столбца; в строгом режиме заголовок
+// должен начинаться с фрагмента, иначе достаточно вхождения
+func matchesAlias(title string, aliases []string, strict bool) bool {
+	title = strings.ToLower(strings.TrimSpace(title))
+	if title == "" {
+		return false
+	}
+	for _, alias := range aliases {
+		if strict && strings.HasPrefix(title, alias) || !strict && strings.Contains(title, alias) {
+			return true
+		}
+	}
+	return false
+}
+
+// DetectHeaderRow находит строку заголовка: первую из начальных строк листа,
+// в которой узнаются все обязательные поля. Возвращает номер строки с нуля
+// или -1, если заголовок не найден.
+func DetectHeaderRow(kind TableKind, rows [][]string) int {
+	for i := 0; i < len(rows) && i < maxHeaderScan; i++ {
+		mapping := SuggestMapping(kind, rows[i])
+
+		complete := true
+		for _, field := range TableFields(kind) {
+			if _, ok := mapping[field.Key]; field.Required && !ok {
+				complete = false
+				break
+			}
+		}
+		if complete {
+			return i
+		}
+	}
+	return -1
+}
+
+// ParsedTable строки таблицы, разобранные по сопоставлению столбцов
+type ParsedTable struct {
+	// Rooms помещения экспликации (для TableExplication)
+	Rooms []entity.Room
+
+	// Buildings здания (для TableBuildings)
+	Buildings []entity.Building
+
+	// Errors ошибки строк; поле имеет вид rows[N].field, где N - номер строки листа с 1
+	Errors entity.ValidationErrors
+}
+
+// ParseTable разбирает строки листа ниже заголовка. Пустые строки и строки
+// итогов ("Итого", "Всего") пропускаются, каждая строка проверяется
+// методом IsValid сущности.
+func ParseTable(kind TableKind, rows [][]string, headerRow int, mapping ColumnMapping) (*ParsedTable, error) {
+	if !kind.IsValid() {
+		return nil, entity.ValidationError{Field: "table", Message: "неизвестная таблица: " + string(kind)}
+	}
+	for _, field := range TableFields(kind) {
+		if _, ok := mapping[field.Key]; field.Required && !ok {
+			return nil, entity.ValidationError{Field: "mapping." + field.Key, Message: "не выбран столбец для поля «" + field.Title + "»"}
+		}
+	}
+
+	result := &ParsedTable{}
+	for i := headerRow + 1; i < len(rows); i++ {
+		row := rows[i]
+		if isBlankRow(row) || isTotalRow(row) {
+			continue
+		}
+
+		values := make(map[string]string, len(mapping))
+		for key, col := range mapping {
+			if col >= 0 && col < len(row) {
+				values[key] = strings.TrimSpace(row[col])
+			}
+		}
+
+		prefix := fmt.Sprintf("rows[%d].", i+1)
+		var err error
+		if kind == TableBuildings {
+			var b entity.Building
+			if b, err = parseBuilding(values); err == nil {
+				err = b.IsValid()
+			}
+			if err == nil {
+				result.Buildings = append(result.Buildings, b)
+			}
+		} else {
+			var r entity.Room
+			if r, err = parseRoom(values); err == nil {
+				err = r.IsValid()
+			}
+			if err == nil {
+				result.Rooms = append(result.Rooms, r)
+			}
+		}
+
+		if err != nil {
+			result.Errors = append(result.Errors, prefixed(prefix, err))
+		}
+	}
+
+	return result, nil
+}
+
+// prefixed добавляет номер строки к полю ошибки валидации
+func prefixed(prefix string, err error) entity.ValidationError {
+	if v, ok := err.(entity.ValidationError); ok {
+		return entity.ValidationError{Field: prefix + v.Field, Message: v.Message}
+	}
+	return entity.ValidationError{Field: strings.TrimSuffix(prefix, "."), Message: err.Error()}
+}
+
+// parseRoom собирает помещение из значений ячеек
+func parseRoom(values map[string]string) (entity.Room, error) {
+	r := entity.Room{
+		Litera:     values["litera"],
+		Floor:      values["floor"],
+		RoomNumber: values["room_number"],
+		Purpose:    values["purpose"],
+		Note:       values["note"],
+	}
+
+	numbers := []struct {
+		key    string
+		target *float64
+	}{
+		{"area", &r.Area},
+		{"living_area", &r.LivingArea},
+		{"auxiliary_area", &r.AuxiliaryArea},
+		{"height", &r.Height},
+		{"unauthorized_area", &r.UnauthorizedArea},
+	}
+	for _, n := range numbers {
+		v, err := parseNumber(n.key, values[n.key])
+		if err != nil {
+			return r, err
+		}
+		*n.target = v
+	}
+
+	return r, nil
+}
+
+// parseBuilding собирает здание из значений ячеек
+func parseBuilding(values map[string]string) (entity.Building, error) {
+	b := entity.Building{
+		Litera:       values["litera"],
+		Name:         values["name"],
+		WallMaterial: values["wall_material"],
+	}
+
+	year, err := parseNumber("commission_year", values["commission_year"])
+	if err != nil {
+		return b, err
+	}
+	b.CommissionYear = int(year)
+
+	numbers := []struct {
+		key    string
+		target *float64
+	}{
+		{"total_area", &b.TotalArea},
+		{"build_area", &b.BuildArea},
+		{"height", &b.Height},
+		{"volume", &b.Volume},
+		{"inventory_value", &b.InventoryValue},
+	}
+	for _, n := range numbers {
+		v, err := parseNumber(n.key, values[n.key])
+		if err != nil {
+			return b, err
+		}
+		*n.target = v
+	}
+
+	return b, nil
+}
+
+// parseNumber разбирает число в записи с запятой или точкой и пробелами
+// между разрядами; пустая ячейка означает 0
+func parseNumber(field, value string) (float64, error) {
+	clean := strings.NewReplacer(" ", "", " ", "", ",", ".").Replace(value)
+	if clean == "" {
+		return 0, nil
+	}
+
+	v, err := strconv.ParseFloat(clean, 64)
+	if err != nil {
+		return 0, entity.ValidationError{Field: field, Message: fmt.Sprintf("значение %q не является числом", value)}
+	}
+	return v, nil
+}
+
+// isBlankRow проверяет, что в строке нет значений
+func isBlankRow(row []string) bool {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// isTotalRow проверяет, что строка содержит итоги
+func isTotalRow(row []string) bool {
+	for _, cell := range row {
+		cell = strings.ToLower(strings.TrimSpace(cell))
+		if cell == "" {
+			continue
+		}
+		return strings.HasPrefix(cell, "итого") || strings.HasPrefix(cell, "всего")
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// UpdatePassportInput входные данные для изменения общих сведений паспорта
+// Состав объекта, правообладатели и экспликация меняются отдельными use cases
+type UpdatePassportInput struct {
+	PassportID        string
+	ObjectType        entity.ObjectType
+	Address           entity.Address
+	OrganizationName  string
+	InventoryNumber   string
+	CadastralNumber   string
+	AsOfDate          time.Time
+	GeneralInfo       entity.GeneralInfo
+	Utilities         entity.Utilities
+	SituationPlanPath string
+}
+
+// UpdatePassportOutput результат изменения паспорта
+type UpdatePassportOutput struct {
+	Passport *entity.TechnicalPassport
+}
+
+// UpdatePassportUseCase use case для изменения общих сведений паспорта
+type UpdatePassportUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewUpdatePassportUseCase создает новый use case
+func NewUpdatePassportUseCase(repo repository.PassportRepository) *UpdatePassportUseCase {
+	return &UpdatePassportUseCase{
+		repo: repo,
+	}
+}
+
+// Execute выполняет изменение паспорта
+func (uc *UpdatePassportUseCase) Execute(ctx context.Context, input UpdatePassportInput) (*UpdatePassportOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if input.ObjectType == "" {
+		return nil, entity.ValidationError{Field: "object_type", Message: "тип объекта обязателен"}
+	}
+
+	if input.OrganizationName == "" {
+		return nil, entity.ValidationError{Field: "organization_name", Message: "наименование организации обязательно"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	if passport.Status == entity.PassportStatusArchived {
+		return nil, entity.ValidationError{Field: "status", Message: "паспорт находится в архиве"}
+	}
+
+	// Применяем изменения к копии, чтобы не испортить паспорт при ошибке валидации
+	updated := *passport
+	updated.ObjectType = input.ObjectType
+	updated.Address = input.Address
+	updated.OrganizationName = input.OrganizationName
+	updated.InventoryNumber = input.InventoryNumber
+	updated.CadastralNumber = input.CadastralNumber
+	updated.GeneralInfo = input.GeneralInfo
+	updated.Utilities = input.Utilities
+	updated.SituationPlanPath = input.SituationPlanPath
+	if !input.AsOfDate.IsZero() {
+		updated.AsOfDate = input.AsOfDate
+	}
+
+	if err := updated.IsValid(); err != nil {
+		return nil, fmt.Errorf("passport validation failed: %w", err)
+	}
+
+	updated.UpdatedDate = time.Now()
+	updated.AddAuditEntry("update", "Изменены общие сведения паспорта")
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, &updated); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &UpdatePassportOutput{
+		Passport: &updated,
+	}, nil
+}
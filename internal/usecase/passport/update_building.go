@@ -0,0 +1,95 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// UpdateBuildingInput входные данные для изменения здания
+type UpdateBuildingInput struct {
+	PassportID    string
+	BuildingIndex int // индекс в массиве
+	Building      entity.Building
+}
+
+// UpdateBuildingOutput результат изменения здания
+type UpdateBuildingOutput struct {
+	Passport *entity.TechnicalPassport
+}
+
+// UpdateBuildingUseCase use case для изменения здания в паспорте
+type UpdateBuildingUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewUpdateBuildingUseCase создает новый use case
+func NewUpdateBuildingUseCase(repo repository.PassportRepository) *UpdateBuildingUseCase {
+	return &UpdateBuildingUseCase{
+		repo: repo,
+	}
+}
+
+// Execute выполняет изменение здания
+func (uc *UpdateBuildingUseCase) Execute(ctx context.Context, input UpdateBuildingInput) (*UpdateBuildingOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if input.BuildingIndex < 0 {
+		return nil, entity.ValidationError{Field: "building_index", Message: "индекс здания должен быть >= 0"}
+	}
+
+	if err := input.Building.IsValid(); err != nil {
+		return nil, fmt.Errorf("invalid building data: %w", err)
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	// Проверяем что индекс в пределах массива
+	if input.BuildingIndex >= len(passport.Buildings) {
+		return nil, entity.ValidationError{
+			Field:   "building_index",
+			Message: "здание с таким индексом не найдено",
+		}
+	}
+
+	// Ссылки на вложения, конструктивные элементы и расчеты износа и стоимости
+	// сохраняются, если не заданы явно; расхождение данных здания с расчетами
+	// показывает валидация
+	previous := passport.Buildings[input.BuildingIndex]
+	if input.Building.Attachments == nil {
+		input.Building.Attachments = previous.Attachments
+	}
+	if input.Building.StructuralElements == nil {
+		input.Building.StructuralElements = previous.StructuralElements
+	}
+	if input.Building.WearAssessment == nil {
+		input.Building.WearAssessment = previous.WearAssessment
+	}
+	if input.Building.Valuation == nil {
+		input.Building.Valuation = previous.Valuation
+	}
+
+	// Заменяем здание
+	passport.Buildings[input.BuildingIndex] = input.Building
+	passport.UpdatedDate = time.Now()
+	passport.AddAuditEntry("update_building", fmt.Sprintf("Изменено здание с индексом %d", input.BuildingIndex))
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &UpdateBuildingOutput{
+		Passport: passport,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// UpdateOwnerInput входные данные для изменения правообладателя
+type UpdateOwnerInput struct {
+	PassportID string
+	OwnerIndex int // индекс в массиве
+	Owner      entity.Owner
+}
+
+// UpdateOwnerOutput результат изменения правообладателя
+type UpdateOwnerOutput struct {
+	Passport *entity.TechnicalPassport
+}
+
+// UpdateOwnerUseCase use case для изменения правообладателя в паспорте
+type UpdateOwnerUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewUpdateOwnerUseCase создает новый use case
+func NewUpdateOwnerUseCase(repo repository.PassportRepository) *UpdateOwnerUseCase {
+	return &UpdateOwnerUseCase{
+		repo: repo,
+	}
+}
+
+// Execute выполняет изменение правообладателя
+func (uc *UpdateOwnerUseCase) Execute(ctx context.Context, input UpdateOwnerInput) (*UpdateOwnerOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if input.OwnerIndex < 0 {
+		return nil, entity.ValidationError{Field: "owner_index", Message: "индекс правообладателя должен быть >= 0"}
+	}
+
+	if err := input.Owner.IsValid(); err != nil {
+		return nil, fmt.Errorf("invalid owner data: %w", err)
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	// Проверяем что индекс в пределах массива
+	if input.OwnerIndex >= len(passport.Owners) {
+		return nil, entity.ValidationError{
+			Field:   "owner_index",
+			Message: "правообладатель с таким индексом не найден",
+		}
+	}
+
+	// Ссылки на вложения сохраняются, если не заданы явно
+	if input.Owner.Attachments == nil {
+		input.Owner.Attachments = passport.Owners[input.OwnerIndex].Attachments
+	}
+
+	// Заменяем правообладатель
+	if err := passport.UpdateOwner(input.OwnerIndex, input.Owner); err != nil {
+		return nil, err
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &UpdateOwnerOutput{
+		Passport: passport,
+	}, nil
+}
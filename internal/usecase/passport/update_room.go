@@ -0,0 +1,78 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// UpdateRoomInput входные данные для изменения помещения
+type UpdateRoomInput struct {
+	PassportID string
+	RoomIndex  int // индекс в массиве
+	Room       entity.Room
+}
+
+// UpdateRoomOutput результат изменения помещения
+type UpdateRoomOutput struct {
+	Passport *entity.TechnicalPassport
+}
+
+// UpdateRoomUseCase use case для изменения помещения в паспорте
+type UpdateRoomUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewUpdateRoomUseCase создает новый use case
+func NewUpdateRoomUseCase(repo repository.PassportRepository) *UpdateRoomUseCase {
+	return &UpdateRoomUseCase{
+		repo: repo,
+	}
+}
+
+// Execute выполняет изменение помещения
+func (uc *UpdateRoomUseCase) Execute(ctx context.Context, input UpdateRoomInput) (*UpdateRoomOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if input.RoomIndex < 0 {
+		return nil, entity.ValidationError{Field: "room_index", Message: "индекс помещения должен быть >= 0"}
+	}
+
+	if err := input.Room.IsValid(); err != nil {
+		return nil, fmt.Errorf("invalid room data: %w", err)
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	// Проверяем что индекс в пределах массива
+	if input.RoomIndex >= len(passport.Explication) {
+		return nil, entity.ValidationError{
+			Field:   "room_index",
+			Message: "помещение с таким индексом не найдено",
+		}
+	}
+
+	// Заменяем помещение
+	passport.Explication[input.RoomIndex] = input.Room
+	passport.UpdatedDate = time.Now()
+	passport.AddAuditEntry("update_room", fmt.Sprintf("Изменено помещение с индексом %d", input.RoomIndex))
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return &UpdateRoomOutput{
+		Passport: passport,
+	}, nil
+}
@@ -0,0 +1,232 @@
+package passport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// ValidatePassportInput входные данные для проверки паспорта
+type ValidatePassportInput struct {
+	PassportID string
+
+	// Passport если задан, проверяется без обращения к репозиторию (PassportID игнорируется)
+	Passport *entity.TechnicalPassport
+
+	// Complete требует полноты паспорта для экспорта (здания и правообладатели)
+	Complete bool
+}
+
+// ValidatePassportOutput результат проверки паспорта
+type ValidatePassportOutput struct {
+	Passport *entity.TechnicalPassport
+	Result   service.ValidationResult
+}
+
+// ValidatePassportUseCase use case для проверки технического паспорта
+type ValidatePassportUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewValidatePassportUseCase создает новый use case
+func NewValidatePassportUseCase(repo repository.PassportRepository) *ValidatePassportUseCase {
+	return &ValidatePassportUseCase{
+		repo: repo,
+	}
+}
+
+// Execute выполняет проверку паспорта
+// Ошибки валидации возвращаются в Result, а не как error
+func (uc *ValidatePassportUseCase) Execute(ctx context.Context, input ValidatePassportInput) (*ValidatePassportOutput, error) {
+	// Проверка переданного паспорта без сохранения
+	if input.Passport != nil {
+		return &ValidatePassportOutput{
+			Passport: input.Passport,
+			Result:   validatePassport(input.Passport, input.Complete),
+		}, nil
+	}
+
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	return &ValidatePassportOutput{
+		Passport: passport,
+		Result:   validatePassport(passport, input.Complete),
+	}, nil
+}
+
+// validatePassport формирует результат валидации паспорта
+func validatePassport(passport *entity.TechnicalPassport, complete bool) service.ValidationResult {
+	result := service.ValidationResult{Valid: true}
+
+	check := passport.IsValid
+	if complete {
+		check = passport.IsComplete
+	}
+
+	if err := check(); err != nil {
+		result.Valid = false
+
+		var validationErrs entity.ValidationErrors
+		var validationErr entity.ValidationError
+		switch {
+		case errors.As(err, &validationErrs):
+			result.Errors = append(result.Errors, validationErrs...)
+		case errors.As(err, &validationErr):
+			result.Errors = append(result.Errors, validationErr)
+		default:
+			result.Errors = append(result.Errors, entity.ValidationError{Field: "passport", Message: err.Error()})
+		}
+	}
+
+	if !complete {
+		if len(passport.Buildings) == 0 {
+			result.Warnings = append(result.Warnings, "не указано ни одного здания")
+		}
+		if len(passport.Owners) == 0 {
+			result.Warnings = append(result.Warnings, "не указано ни одного правообладателя")
+		}
+		// Сведения, обязательные по профилю объекта, нужны только для экспорта
+		for _, e := range passport.ProfileErrors() {
+			result.Warnings = append(result.Warnings, e.Message)
+		}
+	}
+
+	// Расхождения поэтажных планов с экспликацией не мешают сохранению и экспорту
+	for _, c := range CheckFloorPlanAreas(passport, DefaultAreaTolerance) {
+		if c.Status != AreaCheckOK {
+			result.Warnings = append(result.Warnings, areaCheckWarning(c))
+		}
+	}
+
+	// Площадь, исправленная вручную после обмера
+	for _, room := range passport.Explication {
+		if room.Measurements != nil && !sameArea(room.Area, room.Measurements.Area) {
+			result.Warnings = append(result.Warnings, fmt.Sprintf(
+				"помещение %s (литера %s, этаж %s): площадь %.1f кв.м не совпадает с обмером %.1f кв.м",
+				room.RoomNumber, room.Litera, room.Floor, room.Area, room.Measurements.Area))
+		}
+	}
+
+	// Состояние элементов, не соответствующее износу, и износ здания,
+	// не пересчитанный после изменения элементов
+	for _, b := range passport.Buildings {
+		for _, e := range b.StructuralElements {
+			if e.Condition != "" && e.Condition != entity.ConditionForWear(e.Wear) {
+				result.Warnings = append(result.Warnings, fmt.Sprintf(
+					"литера %s: %s - состояние «%s» не соответствует износу %.0f%%",
+					b.Litera, strings.ToLower(e.Kind.Title()), e.Condition.Title(), e.Wear))
+			}
+		}
+		if b.WearAssessmentOutdated() {
+			result.Warnings = append(result.Warnings, "литера "+b.Litera+": износ элементов изменился после расчета износа здания")
+		}
+	}
+
+	// Стоимость, исправленная вручную или не пересчитанная после изменения здания
+	for _, b := range passport.Buildings {
+		v := b.Valuation
+		if v == nil {
+			continue
+		}
+		if math.Abs(b.InventoryValue-v.InventoryValue) >= 0.5 {
+			result.Warnings = append(result.Warnings, fmt.Sprintf(
+				"литера %s: инвентаризационная стоимость %.2f руб. не совпадает с расчетом %.2f руб.",
+				b.Litera, b.InventoryValue, v.InventoryValue))
+		}
+		if b.Volume != v.Inputs.Volume || b.TotalArea != v.Inputs.TotalArea ||
+			b.WallMaterial != v.Inputs.WallMaterial || b.CommissionYear != v.Inputs.CommissionYear ||
+			!reflect.DeepEqual(entity.ElementWear(b.StructuralElements), v.Inputs.ElementWear) {
+			result.Warnings = append(result.Warnings, "литера "+b.Litera+": данные здания изменились после расчета стоимости")
+		}
+	}
+
+	// Протяженность сооружения, не совпадающая с трассой, и стоимость,
+	// не пересчитанная после изменения сооружения
+	for _, s := range passport.Structures {
+		if len(s.Route) >= 2 {
+			if length := passport.RouteLength(s.Route); !sameArea(length, s.Length) {
+				result.Warnings = append(result.Warnings, fmt.Sprintf(
+					"сооружение литеры %s: протяженность %.2f м не совпадает с трассой %.2f м", s.Litera, s.Length, length))
+			}
+		}
+		v := s.Valuation
+		if v == nil {
+			continue
+		}
+		if math.Abs(s.InventoryValue-v.InventoryValue) >= 0.5 {
+			result.Warnings = append(result.Warnings, fmt.Sprintf(
+				"сооружение литеры %s: инвентаризационная стоимость %.2f руб. не совпадает с расчетом %.2f руб.",
+				s.Litera, s.InventoryValue, v.InventoryValue))
+		}
+		current := entity.ValuationInputsForStructure(passport, &s)
+		if current.StructureKind != v.Inputs.StructureKind || current.Length != v.Inputs.Length ||
+			current.TotalArea != v.Inputs.TotalArea || current.Volume != v.Inputs.Volume ||
+			current.WallMaterial != v.Inputs.WallMaterial || current.CommissionYear != v.Inputs.CommissionYear {
+			result.Warnings = append(result.Warnings, "сооружение литеры "+s.Litera+": данные сооружения изменились после расчета стоимости")
+		}
+	}
+
+	// Квартиры многоквартирного дома сверяются с составом объекта и общими сведениями
+	if passport.ObjectType == entity.ObjectTypeApartmentBuilding {
+		for _, f := range passport.Flats {
+			if len(f.Explication) == 0 {
+				result.Warnings = append(result.Warnings, "квартира "+f.Number+": не заполнена экспликация")
+			}
+			if len(passport.Buildings) > 0 && passport.FindBuilding(f.Litera) == nil {
+				result.Warnings = append(result.Warnings, "квартира "+f.Number+": литеры "+f.Litera+" нет в составе объекта")
+			}
+		}
+
+		totals := passport.ApartmentTotals()
+		info := passport.GeneralInfo
+		if totals.Flats > 0 && info.TotalArea > 0 && !sameArea(info.TotalArea, totals.TotalArea) {
+			result.Warnings = append(result.Warnings, fmt.Sprintf(
+				"общая площадь %.1f кв.м не совпадает с суммой площадей квартир и общего имущества %.1f кв.м",
+				info.TotalArea, totals.TotalArea))
+		}
+		if totals.Flats > 0 && info.LivingArea > 0 && !sameArea(info.LivingArea, totals.LivingArea) {
+			result.Warnings = append(result.Warnings, fmt.Sprintf(
+				"жилая площадь %.1f кв.м не совпадает с жилой площадью квартир %.1f кв.м",
+				info.LivingArea, totals.LivingArea))
+		}
+	}
+
+	// Контуры ситуационного плана сверяются с составом объекта
+	if plan := passport.SituationPlan; plan != nil {
+		for _, f := range plan.Footprints {
+			if passport.FindBuilding(f.Litera) == nil {
+				result.Warnings = append(result.Warnings, "ситуационный план: литеры "+f.Litera+" нет в составе объекта")
+			}
+		}
+		for _, b := range passport.Buildings {
+			f := plan.Footprint(b.Litera)
+			if f == nil {
+				result.Warnings = append(result.Warnings, "ситуационный план: нет контура здания литеры "+b.Litera)
+				continue
+			}
+			if area := plan.FootprintArea(*f); !sameArea(area, b.BuildArea) {
+				result.Warnings = append(result.Warnings, fmt.Sprintf(
+					"литера %s: площадь застройки %.1f кв.м не совпадает с контуром ситуационного плана %.1f кв.м",
+					b.Litera, b.BuildArea, area))
+			}
+		}
+	}
+
+	return result
+}
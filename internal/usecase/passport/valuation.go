@@ -0,0 +1,269 @@
+package passport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// CalculateInventoryValueInput входные данные для расчета стоимости без сохранения
+type CalculateInventoryValueInput struct {
+	Inputs entity.ValuationInputs
+}
+
+// CalculateInventoryValueOutput результат расчета стоимости
+type CalculateInventoryValueOutput struct {
+	Valuation *entity.BuildingValuation
+}
+
+// CalculateInventoryValueUseCase use case для предварительного расчета
+// инвентаризационной стоимости (паспорт не изменяется)
+type CalculateInventoryValueUseCase struct {
+	valuer service.InventoryValuer
+}
+
+// NewCalculateInventoryValueUseCase создает новый use case
+func NewCalculateInventoryValueUseCase(valuer service.InventoryValuer) *CalculateInventoryValueUseCase {
+	return &CalculateInventoryValueUseCase{
+		valuer: valuer,
+	}
+}
+
+// Execute выполняет расчет стоимости
+func (uc *CalculateInventoryValueUseCase) Execute(ctx context.Context, input CalculateInventoryValueInput) (*CalculateInventoryValueOutput, error) {
+	valuation, err := uc.valuer.Value(input.Inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CalculateInventoryValueOutput{
+		Valuation: valuation,
+	}, nil
+}
+
+// ValueBuildingInput входные данные для расчета стоимости здания паспорта
+type ValueBuildingInput struct {
+	PassportID string
+	Litera     string
+
+	// Year год цен; 0 - текущий
+	Year int
+
+	// Region субъект для территориального коэффициента; пустой - из адреса паспорта
+	Region string
+
+	// TableVersion версия справочника; пустая - действующая
+	TableVersion string
+
+	// Purpose код назначения; пустой - по наименованию здания и виду объекта
+	Purpose string
+
+	// WearPercent износ по результатам осмотра; nil - износ сооружения по осмотру
+	// или по сроку службы
+	WearPercent *float64
+
+	// DryRun только рассчитать, не изменяя паспорт
+	DryRun bool
+}
+
+// ValueBuildingOutput результат расчета стоимости здания
+type ValueBuildingOutput struct {
+	Passport  *entity.TechnicalPassport
+	Valuation *entity.BuildingValuation
+
+	// PreviousValue инвентаризационная стоимость здания до расчета
+	PreviousValue float64
+}
+
+// ValueBuildingUseCase use case для расчета инвентаризационной стоимости здания
+// или сооружения: стоимость записывается в состав объекта, расчет с исходными
+// данными сохраняется вместе со зданием или сооружением
+type ValueBuildingUseCase struct {
+	repo   repository.PassportRepository
+	valuer service.InventoryValuer
+}
+
+// NewValueBuildingUseCase создает новый use case
+func NewValueBuildingUseCase(repo repository.PassportRepository, valuer service.InventoryValuer) *ValueBuildingUseCase {
+	return &ValueBuildingUseCase{
+		repo:   repo,
+		valuer: valuer,
+	}
+}
+
+// Execute выполняет расчет и запись стоимости здания
+func (uc *ValueBuildingUseCase) Execute(ctx context.Context, input ValueBuildingInput) (*ValueBuildingOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	if input.Litera == "" {
+		return nil, entity.ValidationError{Field: "litera", Message: "литера обязательна"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	// Литера здания или сооружения
+	var inputs entity.ValuationInputs
+	var previous float64
+	if building := passport.FindBuilding(input.Litera); building != nil {
+		inputs = entity.ValuationInputsFor(passport, building)
+		previous = building.InventoryValue
+	} else if structure := passport.FindStructure(input.Litera); structure != nil {
+		inputs = entity.ValuationInputsForStructure(passport, structure)
+		previous = structure.InventoryValue
+	} else {
+		return nil, entity.ValidationError{Field: "litera", Message: "литеры " + input.Litera + " нет в составе объекта"}
+	}
+
+	inputs.Year = input.Year
+	if inputs.Year == 0 {
+		inputs.Year = time.Now().Year()
+	}
+	if input.Region != "" {
+		inputs.Region = input.Region
+	}
+	inputs.TableVersion = input.TableVersion
+	inputs.Purpose = input.Purpose
+	if input.WearPercent != nil {
+		inputs.WearPercent = input.WearPercent
+	}
+
+	valuation, err := uc.valuer.Value(inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ValueBuildingOutput{
+		Passport:      passport,
+		Valuation:     valuation,
+		PreviousValue: previous,
+	}
+	if input.DryRun {
+		return output, nil
+	}
+
+	if err := passport.ApplyValuation(input.Litera, *valuation); err != nil {
+		return nil, err
+	}
+
+	// Сохраняем изменения
+	if err := uc.repo.Update(ctx, passport); err != nil {
+		return nil, fmt.Errorf("failed to update passport: %w", err)
+	}
+
+	return output, nil
+}
+
+// ValuationCheckStatus итог повторного расчета сохраненной стоимости
+type ValuationCheckStatus string
+
+const (
+	ValuationCheckOK          ValuationCheckStatus = "ok"          // Расчет воспроизводится
+	ValuationCheckChanged     ValuationCheckStatus = "changed"     // Повторный расчет дает другую стоимость
+	ValuationCheckUnavailable ValuationCheckStatus = "unavailable" // Справочник или показатель недоступен
+)
+
+// ValuationCheck результат повторного расчета стоимости здания
+type ValuationCheck struct {
+	Litera       string               `json:"litera"`
+	TableVersion string               `json:"table_version"`
+	Year         int                  `json:"year"`
+	Stored       float64              `json:"stored"`
+	Recalculated float64              `json:"recalculated,omitempty"`
+	Status       ValuationCheckStatus `json:"status"`
+	Message      string               `json:"message,omitempty"`
+}
+
+// VerifyValuationsInput входные данные для проверки расчетов стоимости
+type VerifyValuationsInput struct {
+	PassportID string
+}
+
+// VerifyValuationsOutput результаты проверки по зданиям с сохраненным расчетом
+type VerifyValuationsOutput struct {
+	Checks []ValuationCheck
+}
+
+// VerifyValuationsUseCase use case для повторения сохраненных расчетов стоимости
+// по их исходным данным и версиям справочников
+type VerifyValuationsUseCase struct {
+	repo   repository.PassportRepository
+	valuer service.InventoryValuer
+}
+
+// NewVerifyValuationsUseCase создает новый use case
+func NewVerifyValuationsUseCase(repo repository.PassportRepository, valuer service.InventoryValuer) *VerifyValuationsUseCase {
+	return &VerifyValuationsUseCase{
+		repo:   repo,
+		valuer: valuer,
+	}
+}
+
+// Execute повторяет расчеты; паспорт не изменяется
+func (uc *VerifyValuationsUseCase) Execute(ctx context.Context, input VerifyValuationsInput) (*VerifyValuationsOutput, error) {
+	// Валидация входных данных
+	if input.PassportID == "" {
+		return nil, entity.ValidationError{Field: "passport_id", Message: "ID паспорта обязателен"}
+	}
+
+	// Получаем паспорт
+	passport, err := uc.repo.GetByID(ctx, input.PassportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passport: %w", err)
+	}
+
+	// Сохраненные расчеты зданий, затем сооружений
+	literas := []string{}
+	valuations := []*entity.BuildingValuation{}
+	for _, b := range passport.Buildings {
+		literas, valuations = append(literas, b.Litera), append(valuations, b.Valuation)
+	}
+	for _, s := range passport.Structures {
+		literas, valuations = append(literas, s.Litera), append(valuations, s.Valuation)
+	}
+
+	output := &VerifyValuationsOutput{Checks: []ValuationCheck{}}
+	for i, stored := range valuations {
+		if stored == nil {
+			continue
+		}
+		check := ValuationCheck{
+			Litera:       literas[i],
+			TableVersion: stored.Inputs.TableVersion,
+			Year:         stored.Inputs.Year,
+			Stored:       stored.InventoryValue,
+		}
+
+		recalculated, err := uc.valuer.Value(stored.Inputs)
+		var validationErr entity.ValidationError
+		switch {
+		case errors.As(err, &validationErr):
+			check.Status = ValuationCheckUnavailable
+			check.Message = validationErr.Message
+		case err != nil:
+			return nil, fmt.Errorf("failed to recalculate valuation: %w", err)
+		case math.Abs(recalculated.InventoryValue-stored.InventoryValue) >= 0.5:
+			check.Status = ValuationCheckChanged
+			check.Recalculated = recalculated.InventoryValue
+			check.Message = fmt.Sprintf("справочник %s изменился после расчета", stored.Inputs.TableVersion)
+		default:
+			check.Status = ValuationCheckOK
+			check.Recalculated = recalculated.InventoryValue
+		}
+		output.Checks = append(output.Checks, check)
+	}
+
+	return output, nil
+}
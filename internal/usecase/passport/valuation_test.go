@@ -0,0 +1,126 @@
+package passport_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/valuation"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/passport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func valuationPassport(t *testing.T, repo *memory.InMemoryPassportRepository) *entity.TechnicalPassport {
+	p := planPassport(t, repo)
+	p.Buildings = []entity.Building{
+		{Litera: "А", Name: "Жилой дом", CommissionYear: 1974, WallMaterial: "Кирпич", TotalArea: 120, Volume: 500, InventoryValue: 100000},
+		{Litera: "Г", Name: "Сарай", CommissionYear: 2000, WallMaterial: "саман", TotalArea: 12, Volume: 30},
+	}
+	require.NoError(t, repo.Update(context.Background(), p))
+	return p
+}
+
+func TestValueBuildingUseCase(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	p := valuationPassport(t, repo)
+	uc := passport.NewValueBuildingUseCase(repo, valuation.NewEngine())
+
+	// Предварительный расчет не меняет паспорт
+	preview, err := uc.Execute(ctx, passport.ValueBuildingInput{PassportID: p.ID, Litera: "А", Year: 2024, DryRun: true})
+	require.NoError(t, err)
+	assert.Equal(t, 827400.0, preview.Valuation.InventoryValue)
+	assert.Equal(t, 100000.0, preview.PreviousValue)
+	saved, err := repo.GetByID(ctx, p.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 100000.0, saved.Buildings[0].InventoryValue)
+	assert.Nil(t, saved.Buildings[0].Valuation)
+
+	output, err := uc.Execute(ctx, passport.ValueBuildingInput{PassportID: p.ID, Litera: "А", Year: 2024})
+	require.NoError(t, err)
+	assert.Equal(t, "г. Москва", output.Valuation.Inputs.Region, "субъект берется из адреса паспорта")
+	assert.Equal(t, "residential", output.Valuation.Inputs.Purpose)
+
+	saved, err = repo.GetByID(ctx, p.ID)
+	require.NoError(t, err)
+	building := saved.Buildings[0]
+	assert.Equal(t, 827400.0, building.InventoryValue)
+	require.NotNil(t, building.Valuation)
+	assert.Equal(t, "upvs-1969-2024", building.Valuation.Inputs.TableVersion)
+	assert.Equal(t, "value_building", saved.AuditLog[len(saved.AuditLog)-1].Action)
+
+	// Ручная правка стоимости и данных здания попадает в предупреждения
+	saved.Buildings[0].InventoryValue = 900000
+	saved.Buildings[0].Volume = 520
+	require.NoError(t, repo.Update(ctx, saved))
+	validated, err := passport.NewValidatePassportUseCase(repo).Execute(ctx, passport.ValidatePassportInput{PassportID: p.ID})
+	require.NoError(t, err)
+	assert.Contains(t, validated.Result.Warnings, "литера А: инвентаризационная стоимость 900000.00 руб. не совпадает с расчетом 827400.00 руб.")
+	assert.Contains(t, validated.Result.Warnings, "литера А: данные здания изменились после расчета стоимости")
+}
+
+func TestValueBuildingUseCase_Invalid(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	p := valuationPassport(t, repo)
+	uc := passport.NewValueBuildingUseCase(repo, valuation.NewEngine())
+
+	tests := []struct {
+		name  string
+		input passport.ValueBuildingInput
+		field string
+	}{
+		{name: "missing passport id", input: passport.ValueBuildingInput{Litera: "А"}, field: "passport_id"},
+		{name: "missing litera", input: passport.ValueBuildingInput{PassportID: p.ID}, field: "litera"},
+		{name: "unknown litera", input: passport.ValueBuildingInput{PassportID: p.ID, Litera: "Б"}, field: "litera"},
+		{name: "unknown wall material", input: passport.ValueBuildingInput{PassportID: p.ID, Litera: "Г", Year: 2024}, field: "wall_material"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.Execute(ctx, tt.input)
+			var validationErr entity.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+}
+
+func TestVerifyValuationsUseCase(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewInMemoryPassportRepository()
+	p := valuationPassport(t, repo)
+
+	wear := 20.0
+	_, err := passport.NewValueBuildingUseCase(repo, valuation.NewEngine()).Execute(ctx, passport.ValueBuildingInput{
+		PassportID: p.ID, Litera: "А", Year: 2024, WearPercent: &wear,
+	})
+	require.NoError(t, err)
+
+	output, err := passport.NewVerifyValuationsUseCase(repo, valuation.NewEngine()).Execute(ctx, passport.VerifyValuationsInput{PassportID: p.ID})
+	require.NoError(t, err)
+	require.Len(t, output.Checks, 1, "здания без расчета не проверяются")
+	assert.Equal(t, passport.ValuationCheckOK, output.Checks[0].Status)
+	assert.Equal(t, 987940.0, output.Checks[0].Recalculated) // 1 234 925 x 0,8
+
+	// Сохраненная стоимость не воспроизводится
+	saved, err := repo.GetByID(ctx, p.ID)
+	require.NoError(t, err)
+	saved.Buildings[0].Valuation.InventoryValue = 1000000
+	saved.Buildings[0].InventoryValue = 1000000
+	require.NoError(t, repo.Update(ctx, saved))
+	output, err = passport.NewVerifyValuationsUseCase(repo, valuation.NewEngine()).Execute(ctx, passport.VerifyValuationsInput{PassportID: p.ID})
+	require.NoError(t, err)
+	assert.Equal(t, passport.ValuationCheckChanged, output.Checks[0].Status)
+	assert.Equal(t, 987940.0, output.Checks[0].Recalculated)
+
+	// Справочника, по которому выполнен расчет, нет в программе
+	saved.Buildings[0].Valuation.Inputs.TableVersion = "org-2025"
+	require.NoError(t, repo.Update(ctx, saved))
+	output, err = passport.NewVerifyValuationsUseCase(repo, valuation.NewEngine()).Execute(ctx, passport.VerifyValuationsInput{PassportID: p.ID})
+	require.NoError(t, err)
+	assert.Equal(t, passport.ValuationCheckUnavailable, output.Checks[0].Status)
+	assert.Equal(t, "справочника org-2025 нет", output.Checks[0].Message)
+}
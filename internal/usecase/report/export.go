@@ -0,0 +1,89 @@
+package report
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// ExportRegistryReportInput входные данные для выгрузки отчета по реестру
+type ExportRegistryReportInput struct {
+	RegistryReportInput
+
+	// Format формат документа: xlsx, pdf или docx
+	Format service.DocumentFormat
+}
+
+// ExportRegistryReportOutput результат выгрузки отчета
+type ExportRegistryReportOutput struct {
+	Report *service.RegistryReport
+
+	// Document содержимое документа
+	Document []byte
+
+	// FileName предлагаемое имя файла
+	FileName string
+}
+
+// ExportRegistryReportUseCase use case для выгрузки отчета по реестру в XLSX, PDF или DOCX
+type ExportRegistryReportUseCase struct {
+	report    *RegistryReportUseCase
+	generator service.ReportGenerator
+	codec     service.SpreadsheetCodec
+}
+
+// NewExportRegistryReportUseCase создает новый use case
+func NewExportRegistryReportUseCase(repo repository.PassportRepository, generator service.ReportGenerator, codec service.SpreadsheetCodec) *ExportRegistryReportUseCase {
+	return &ExportRegistryReportUseCase{
+		report:    NewRegistryReportUseCase(repo),
+		generator: generator,
+		codec:     codec,
+	}
+}
+
+// Execute формирует отчет и документ в выбранном формате
+func (uc *ExportRegistryReportUseCase) Execute(ctx context.Context, input ExportRegistryReportInput) (*ExportRegistryReportOutput, error) {
+	// Валидация входных данных
+	switch input.Format {
+	case service.FormatXLSX, service.FormatPDF, service.FormatDOCX:
+	default:
+		return nil, entity.ValidationError{Field: "format", Message: "неподдерживаемый формат отчета: " + string(input.Format)}
+	}
+
+	output, err := uc.report.Execute(ctx, input.RegistryReportInput)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	if input.Format == service.FormatXLSX {
+		data, err = uc.codec.ExportReport(ctx, output.Report)
+	} else {
+		data, err = uc.generator.GenerateReport(ctx, output.Report, input.Format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to render report: %w", err)
+	}
+
+	return &ExportRegistryReportOutput{
+		Report:   output.Report,
+		Document: data,
+		FileName: reportFileName(output.Report) + "." + string(input.Format),
+	}, nil
+}
+
+// reportFileName имя файла отчета без расширения: registry-report-20260901-20260930
+func reportFileName(r *service.RegistryReport) string {
+	const layout = "20060102"
+	name := "registry-report"
+	if !r.From.IsZero() {
+		name += "-" + r.From.Format(layout)
+	}
+	if !r.To.IsZero() {
+		name += "-" + r.To.AddDate(0, 0, -1).Format(layout)
+	}
+	return name
+}
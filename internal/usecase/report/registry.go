@@ -0,0 +1,216 @@
+// Package report содержит use cases отчетов по реестру технических паспортов
+package report
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+)
+
+// objectTypeTitles наименования типов объектов в отчете
+var objectTypeTitles = map[entity.ObjectType]string{
+	entity.ObjectTypeResidentialHouse:  "Индивидуальный жилой дом",
+	entity.ObjectTypeApartmentBuilding: "Многоквартирный жилой дом",
+	entity.ObjectTypeApartment:         "Квартира",
+	entity.ObjectTypeRoom:              "Комната",
+	entity.ObjectTypeNonResidential:    "Нежилое помещение",
+}
+
+// unspecified наименование группы без значения (район или материал стен не указан)
+const unspecified = "Не указано"
+
+// RegistryReportInput входные данные для отчета по реестру
+type RegistryReportInput struct {
+	// From и To период отчета [From, To); нулевая граница - без ограничения
+	From time.Time
+	To   time.Time
+
+	// Where дополнительное условие отбора паспортов; nil - все паспорта
+	Where repository.PassportSpecification
+}
+
+// RegistryReportOutput результат формирования отчета
+type RegistryReportOutput struct {
+	Report *service.RegistryReport
+}
+
+// RegistryReportUseCase use case для сводного отчета по реестру паспортов:
+// число составленных и выданных паспортов, площадь, инвентаризационная
+// стоимость и средний год постройки с разбивкой по типам объектов,
+// районам и материалу стен зданий
+type RegistryReportUseCase struct {
+	repo repository.PassportRepository
+}
+
+// NewRegistryReportUseCase создает новый use case
+func NewRegistryReportUseCase(repo repository.PassportRepository) *RegistryReportUseCase {
+	return &RegistryReportUseCase{
+		repo: repo,
+	}
+}
+
+// Execute формирует отчет за период
+func (uc *RegistryReportUseCase) Execute(ctx context.Context, input RegistryReportInput) (*RegistryReportOutput, error) {
+	if err := access.Authorize(ctx, entity.PermissionViewPassport); err != nil {
+		return nil, err
+	}
+
+	// Валидация входных данных
+	if !input.From.IsZero() && !input.To.IsZero() && !input.From.Before(input.To) {
+		return nil, entity.ValidationError{Field: "to", Message: "конец периода должен быть позже начала"}
+	}
+
+	page, err := uc.repo.Find(ctx, repository.PassportCriteria{Where: input.Where})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find passports: %w", err)
+	}
+
+	return &RegistryReportOutput{
+		Report: buildReport(page.Passports, input.From, input.To),
+	}, nil
+}
+
+// MonthPeriod границы месяца, содержащего t: [первое число, первое число следующего месяца)
+func MonthPeriod(t time.Time) (from, to time.Time) {
+	from = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	return from, from.AddDate(0, 1, 0)
+}
+
+// accumulator накапливает показатели группы
+type accumulator struct {
+	row     service.ReportRow
+	years   int
+	yearSum int
+}
+
+// add учитывает объект с площадью, стоимостью и годом постройки
+func (a *accumulator) add(area, value float64, year int) {
+	a.row.Count++
+	a.row.TotalArea += area
+	a.row.InventoryValue += value
+	if year > 0 {
+		a.years++
+		a.yearSum += year
+	}
+}
+
+// result показатели группы со средним годом
+func (a *accumulator) result() service.ReportRow {
+	row := a.row
+	if a.years > 0 {
+		row.AverageYear = float64(a.yearSum) / float64(a.years)
+	}
+	return row
+}
+
+// groups группы разбивки по ключу без учета регистра
+type groups map[string]*accumulator
+
+// get возвращает группу; наименование - первое встретившееся написание
+func (g groups) get(key, title string) *accumulator {
+	key = strings.ToLower(key)
+	acc, ok := g[key]
+	if !ok {
+		acc = &accumulator{row: service.ReportRow{Key: key, Title: title}}
+		g[key] = acc
+	}
+	return acc
+}
+
+// rows строки разбивки по убыванию числа паспортов, затем выданных, затем по наименованию
+func (g groups) rows() []service.ReportRow {
+	rows := make([]service.ReportRow, 0, len(g))
+	for _, acc := range g {
+		rows = append(rows, acc.result())
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Count != rows[j].Count {
+			return rows[i].Count > rows[j].Count
+		}
+		if rows[i].Issued != rows[j].Issued {
+			return rows[i].Issued > rows[j].Issued
+		}
+		return rows[i].Title < rows[j].Title
+	})
+	return rows
+}
+
+// buildReport собирает показатели паспортов, составленных или выданных за период
+func buildReport(passports []*entity.TechnicalPassport, from, to time.Time) *service.RegistryReport {
+	inPeriod := func(t time.Time) bool {
+		return (from.IsZero() || !t.Before(from)) && (to.IsZero() || t.Before(to))
+	}
+
+	var total accumulator
+	byType, byDistrict, byWall := groups{}, groups{}, groups{}
+
+	for _, p := range passports {
+		composed := inPeriod(p.AsOfDate)
+		issued := false
+		for _, e := range p.AuditLog {
+			if e.Action == "issue" && inPeriod(e.Timestamp) {
+				issued = true
+				break
+			}
+		}
+		if !composed && !issued {
+			continue
+		}
+
+		typeTitle, ok := objectTypeTitles[p.ObjectType]
+		if !ok {
+			typeTitle = string(p.ObjectType)
+		}
+		district := districtOf(p.Address)
+		targets := []*accumulator{&total, byType.get(string(p.ObjectType), typeTitle), byDistrict.get(district, district)}
+
+		for _, acc := range targets {
+			if composed {
+				acc.add(p.CalculateTotalArea(), p.CalculateTotalInventoryValue(), p.GeneralInfo.ConstructionYear)
+			}
+			if issued {
+				acc.row.Issued++
+			}
+		}
+
+		if composed {
+			for _, b := range p.Buildings {
+				material := strings.TrimSpace(b.WallMaterial)
+				if material == "" {
+					material = unspecified
+				}
+				byWall.get(material, material).add(b.TotalArea, b.InventoryValue, b.CommissionYear)
+			}
+		}
+	}
+
+	result := total.result()
+	result.Key, result.Title = "total", "Всего"
+
+	return &service.RegistryReport{
+		From:           from,
+		To:             to,
+		GeneratedAt:    time.Now(),
+		Total:          result,
+		ByObjectType:   byType.rows(),
+		ByDistrict:     byDistrict.rows(),
+		ByWallMaterial: byWall.rows(),
+	}
+}
+
+// districtOf район объекта: административный район, иначе район города
+func districtOf(a entity.Address) string {
+	for _, d := range []string{a.District, a.CityDistrict} {
+		if d = strings.TrimSpace(d); d != "" {
+			return d
+		}
+	}
+	return unspecified
+}
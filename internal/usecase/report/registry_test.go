@@ -0,0 +1,147 @@
+package report_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/document"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/spreadsheet"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/report"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var september = time.Date(2026, time.September, 15, 12, 0, 0, 0, time.UTC)
+
+// reportFixture паспорта сентября: два жилых дома Тверского района и квартира
+// без района; паспорт августа выдан в сентябре, паспорт октября не входит в отчет
+func reportFixture(t *testing.T) *memory.InMemoryPassportRepository {
+	repo := memory.NewInMemoryPassportRepository()
+	add := func(id string, objectType entity.ObjectType, district string, asOf time.Time, year int, buildings ...entity.Building) *entity.TechnicalPassport {
+		p := entity.NewTechnicalPassport(objectType, entity.Address{Subject: "г. Москва", District: district, House: id})
+		p.ID = id
+		p.AsOfDate = asOf
+		p.GeneralInfo.ConstructionYear = year
+		p.Buildings = buildings
+		require.NoError(t, repo.Create(context.Background(), p))
+		return p
+	}
+
+	add("TP-1", entity.ObjectTypeResidentialHouse, "Тверской", september, 1960,
+		entity.Building{Litera: "А", WallMaterial: "Кирпич", TotalArea: 100, InventoryValue: 500000, CommissionYear: 1960},
+		entity.Building{Litera: "Г", WallMaterial: "Дерево", TotalArea: 20, InventoryValue: 30000, CommissionYear: 1980})
+	add("TP-2", entity.ObjectTypeResidentialHouse, "тверской", september.AddDate(0, 0, 10), 1970,
+		entity.Building{Litera: "А", WallMaterial: "кирпич", TotalArea: 80, InventoryValue: 400000, CommissionYear: 1970})
+	add("TP-3", entity.ObjectTypeApartment, "", september.AddDate(0, 0, -14), 0)
+
+	issued := add("TP-4", entity.ObjectTypeApartment, "Пресненский", september.AddDate(0, -1, 0), 2000)
+	issued.AuditLog = append(issued.AuditLog, entity.AuditEntry{Timestamp: september, Action: "issue", Description: "Паспорт выдан"})
+
+	add("TP-5", entity.ObjectTypeResidentialHouse, "Тверской", september.AddDate(0, 1, 0), 1990,
+		entity.Building{Litera: "А", WallMaterial: "Панель", TotalArea: 50, CommissionYear: 1990})
+
+	return repo
+}
+
+func TestRegistryReportUseCase_Execute(t *testing.T) {
+	ctx := access.WithActor(context.Background(), &entity.User{ID: "U-1", Login: "tech", Role: entity.RoleTechnician})
+	useCase := report.NewRegistryReportUseCase(reportFixture(t))
+	from, to := report.MonthPeriod(september)
+
+	output, err := useCase.Execute(ctx, report.RegistryReportInput{From: from, To: to})
+	require.NoError(t, err)
+	r := output.Report
+
+	assert.Equal(t, "за период с 01.09.2026 по 30.09.2026", r.PeriodTitle())
+	assert.Equal(t, 3, r.Total.Count)
+	assert.Equal(t, 1, r.Total.Issued)
+	assert.InDelta(t, 200, r.Total.TotalArea, 0.001)
+	assert.InDelta(t, 930000, r.Total.InventoryValue, 0.001)
+	assert.InDelta(t, 1965, r.Total.AverageYear, 0.001)
+
+	require.Len(t, r.ByObjectType, 2)
+	assert.Equal(t, service.ReportRow{Key: "residential_house", Title: "Индивидуальный жилой дом", Count: 2, TotalArea: 200, InventoryValue: 930000, AverageYear: 1965}, r.ByObjectType[0])
+	assert.Equal(t, service.ReportRow{Key: "apartment", Title: "Квартира", Count: 1, Issued: 1}, r.ByObjectType[1])
+
+	require.Len(t, r.ByDistrict, 3)
+	assert.Equal(t, "Тверской", r.ByDistrict[0].Title)
+	assert.Equal(t, 2, r.ByDistrict[0].Count)
+	assert.Equal(t, []string{"Не указано", "Пресненский"}, []string{r.ByDistrict[1].Title, r.ByDistrict[2].Title})
+
+	require.Len(t, r.ByWallMaterial, 2)
+	assert.Equal(t, "Кирпич", r.ByWallMaterial[0].Title)
+	assert.Equal(t, 2, r.ByWallMaterial[0].Count)
+	assert.InDelta(t, 180, r.ByWallMaterial[0].TotalArea, 0.001)
+	assert.InDelta(t, 1965, r.ByWallMaterial[0].AverageYear, 0.001)
+
+	// Дополнительное условие отбора
+	output, err = useCase.Execute(ctx, report.RegistryReportInput{From: from, To: to, Where: repository.ByObjectType(entity.ObjectTypeApartment)})
+	require.NoError(t, err)
+	assert.Equal(t, 1, output.Report.Total.Count)
+	assert.Empty(t, output.Report.ByWallMaterial)
+
+	// Без периода - все паспорта
+	output, err = useCase.Execute(ctx, report.RegistryReportInput{})
+	require.NoError(t, err)
+	assert.Equal(t, 5, output.Report.Total.Count)
+
+	_, err = useCase.Execute(ctx, report.RegistryReportInput{From: to, To: from})
+	var validationErr entity.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "to", validationErr.Field)
+
+	_, err = useCase.Execute(context.Background(), report.RegistryReportInput{})
+	var authErr entity.AuthenticationError
+	assert.ErrorAs(t, err, &authErr)
+}
+
+func TestExportRegistryReportUseCase_Execute(t *testing.T) {
+	ctx := access.WithActor(context.Background(), &entity.User{ID: "U-1", Login: "reviewer", Role: entity.RoleReviewer})
+	useCase := report.NewExportRegistryReportUseCase(reportFixture(t), document.NewGenerator(), spreadsheet.NewCodec())
+	from, to := report.MonthPeriod(september)
+
+	tests := []struct {
+		format   service.DocumentFormat
+		fileName string
+		prefix   []byte
+	}{
+		{format: service.FormatXLSX, fileName: "registry-report-20260901-20260930.xlsx", prefix: []byte("PK")},
+		{format: service.FormatPDF, fileName: "registry-report-20260901-20260930.pdf", prefix: []byte("%PDF")},
+		{format: service.FormatDOCX, fileName: "registry-report-20260901-20260930.docx", prefix: []byte("PK")},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			output, err := useCase.Execute(ctx, report.ExportRegistryReportInput{
+				RegistryReportInput: report.RegistryReportInput{From: from, To: to},
+				Format:              tt.format,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.fileName, output.FileName)
+			assert.True(t, bytes.HasPrefix(output.Document, tt.prefix))
+			assert.Equal(t, 3, output.Report.Total.Count)
+		})
+	}
+
+	// Книга читается обратно: итоговая строка листа типов объектов
+	output, err := useCase.Execute(ctx, report.ExportRegistryReportInput{Format: service.FormatXLSX})
+	require.NoError(t, err)
+	sheets, err := spreadsheet.NewCodec().ReadSheets(ctx, output.Document)
+	require.NoError(t, err)
+	require.Len(t, sheets, 3)
+	assert.Equal(t, spreadsheet.SheetReportObjectTypes, sheets[0].Name)
+	last := sheets[0].Rows[len(sheets[0].Rows)-1]
+	assert.Equal(t, []string{"Всего", "5", "1"}, last[:3])
+
+	_, err = useCase.Execute(ctx, report.ExportRegistryReportInput{Format: "csv"})
+	var validationErr entity.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "format", validationErr.Field)
+}
@@ -0,0 +1,63 @@
+package user
+
+import (
+	"context"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+)
+
+// dummyPasswordHash хеш bcrypt (стоимость по умолчанию), с которым сравнивается
+// пароль неизвестного логина. Время ответа не должно выдавать, существует ли логин.
+const dummyPasswordHash = "$2a$10$qzVpK.AD.GUhSyqSfxbe2uRsscEZNxoxBGJBPeGYSRcFtJg5OIn5K"
+
+// LoginInput входные данные для входа в систему
+type LoginInput struct {
+	Login    string
+	Password string
+}
+
+// LoginOutput результат входа в систему
+type LoginOutput struct {
+	User *entity.User
+}
+
+// LoginUseCase use case для аутентификации пользователя
+type LoginUseCase struct {
+	repo   repository.UserRepository
+	hasher service.PasswordHasher
+}
+
+// NewLoginUseCase создает новый use case
+func NewLoginUseCase(repo repository.UserRepository, hasher service.PasswordHasher) *LoginUseCase {
+	return &LoginUseCase{
+		repo:   repo,
+		hasher: hasher,
+	}
+}
+
+// Execute выполняет проверку логина и пароля
+func (uc *LoginUseCase) Execute(ctx context.Context, input LoginInput) (*LoginOutput, error) {
+	// Валидация входных данных
+	if input.Login == "" {
+		return nil, entity.ValidationError{Field: "login", Message: "логин обязателен"}
+	}
+
+	// Не раскрываем, что именно неверно: логин или пароль
+	invalid := entity.AuthenticationError{Message: "неверный логин или пароль"}
+
+	user, err := uc.repo.GetByLogin(ctx, input.Login)
+	if err != nil {
+		_ = uc.hasher.Compare(dummyPasswordHash, input.Password)
+		return nil, invalid
+	}
+
+	if err := uc.hasher.Compare(user.PasswordHash, input.Password); err != nil {
+		return nil, invalid
+	}
+
+	return &LoginOutput{
+		User: user,
+	}, nil
+}
@@ -0,0 +1,126 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/service"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+)
+
+// minPasswordLength минимальная длина пароля
+const minPasswordLength = 6
+
+// RegisterUserInput входные данные для регистрации пользователя
+type RegisterUserInput struct {
+	Login    string
+	Password string
+	FullName string
+	Role     entity.Role
+}
+
+// RegisterUserOutput результат регистрации пользователя
+type RegisterUserOutput struct {
+	User *entity.User
+}
+
+// RegisterUserUseCase use case для регистрации пользователя
+//
+// Пока в хранилище нет ни одного пользователя, разрешено создать
+// первого администратора без авторизации. Дальше регистрация доступна
+// только пользователям с правом entity.PermissionManageUsers.
+type RegisterUserUseCase struct {
+	repo   repository.UserRepository
+	hasher service.PasswordHasher
+}
+
+// NewRegisterUserUseCase создает новый use case
+func NewRegisterUserUseCase(repo repository.UserRepository, hasher service.PasswordHasher) *RegisterUserUseCase {
+	return &RegisterUserUseCase{
+		repo:   repo,
+		hasher: hasher,
+	}
+}
+
+// Execute выполняет регистрацию пользователя
+func (uc *RegisterUserUseCase) Execute(ctx context.Context, input RegisterUserInput) (*RegisterUserOutput, error) {
+	// Валидация входных данных
+	if err := input.validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// Без права на управление пользователями можно создать только первого администратора
+	authErr := access.Authorize(ctx, entity.PermissionManageUsers)
+	if authErr != nil && input.Role != entity.RoleAdmin {
+		// Список нужен только для выбора сообщения: пользователь здесь не создается
+		users, err := uc.repo.List(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list users: %w", err)
+		}
+		if len(users) == 0 {
+			return nil, entity.ValidationError{Field: "role", Message: "первый пользователь должен быть администратором"}
+		}
+		return nil, authErr
+	}
+
+	// Хешируем пароль
+	hash, err := uc.hasher.Hash(input.Password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	user := &entity.User{
+		ID:           generateID(),
+		Login:        input.Login,
+		FullName:     input.FullName,
+		Role:         input.Role,
+		PasswordHash: hash,
+		CreatedDate:  time.Now(),
+	}
+
+	// Сохраняем в репозиторий. Первый администратор создается, только если
+	// хранилище пусто: проверка выполняется репозиторием под его блокировкой
+	if authErr != nil {
+		if err := uc.repo.CreateFirst(ctx, user); err != nil {
+			if errors.Is(err, repository.ErrUsersExist) {
+				return nil, authErr
+			}
+			return nil, fmt.Errorf("failed to save user: %w", err)
+		}
+	} else if err := uc.repo.Create(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to save user: %w", err)
+	}
+
+	return &RegisterUserOutput{
+		User: user,
+	}, nil
+}
+
+// validate проверяет корректность входных данных
+func (input *RegisterUserInput) validate() error {
+	if input.Login == "" {
+		return entity.ValidationError{Field: "login", Message: "логин обязателен"}
+	}
+
+	if len([]rune(input.Password)) < minPasswordLength {
+		return entity.ValidationError{
+			Field:   "password",
+			Message: fmt.Sprintf("пароль должен содержать не менее %d символов", minPasswordLength),
+		}
+	}
+
+	if !input.Role.IsValid() {
+		return entity.ValidationError{Field: "role", Message: "неизвестная роль"}
+	}
+
+	return nil
+}
+
+// generateID генерирует простой ID пользователя
+func generateID() string {
+	return fmt.Sprintf("U-%d", time.Now().UnixNano())
+}
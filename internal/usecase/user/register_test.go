@@ -0,0 +1,191 @@
+package user_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/security"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/memory"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterUserUseCase_Execute(t *testing.T) {
+	hasher := security.NewBcryptHasher()
+
+	tests := []struct {
+		name    string
+		setup   func(*memory.InMemoryUserRepository) context.Context
+		input   user.RegisterUserInput
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "first user can be registered as admin without login",
+			setup: func(repo *memory.InMemoryUserRepository) context.Context {
+				return context.Background()
+			},
+			input:   user.RegisterUserInput{Login: "admin", Password: "secret1", Role: entity.RoleAdmin},
+			wantErr: false,
+		},
+		{
+			name: "first user must be admin",
+			setup: func(repo *memory.InMemoryUserRepository) context.Context {
+				return context.Background()
+			},
+			input:   user.RegisterUserInput{Login: "tech", Password: "secret1", Role: entity.RoleTechnician},
+			wantErr: true,
+			errMsg:  "первый пользователь должен быть администратором",
+		},
+		{
+			name: "short password returns error",
+			setup: func(repo *memory.InMemoryUserRepository) context.Context {
+				return context.Background()
+			},
+			input:   user.RegisterUserInput{Login: "admin", Password: "123", Role: entity.RoleAdmin},
+			wantErr: true,
+			errMsg:  "пароль должен содержать не менее",
+		},
+		{
+			name: "admin registers technician",
+			setup: func(repo *memory.InMemoryUserRepository) context.Context {
+				admin := &entity.User{ID: "U-1", Login: "admin", Role: entity.RoleAdmin, PasswordHash: "x"}
+				repo.Create(context.Background(), admin)
+				return access.WithActor(context.Background(), admin)
+			},
+			input:   user.RegisterUserInput{Login: "tech", Password: "secret1", Role: entity.RoleTechnician},
+			wantErr: false,
+		},
+		{
+			name: "technician cannot register users",
+			setup: func(repo *memory.InMemoryUserRepository) context.Context {
+				tech := &entity.User{ID: "U-1", Login: "tech", Role: entity.RoleTechnician, PasswordHash: "x"}
+				repo.Create(context.Background(), tech)
+				return access.WithActor(context.Background(), tech)
+			},
+			input:   user.RegisterUserInput{Login: "other", Password: "secret1", Role: entity.RoleAdmin},
+			wantErr: true,
+			errMsg:  "доступ запрещен",
+		},
+		{
+			name: "admin without login is denied once users exist",
+			setup: func(repo *memory.InMemoryUserRepository) context.Context {
+				repo.Create(context.Background(), &entity.User{ID: "U-1", Login: "admin", Role: entity.RoleAdmin, PasswordHash: "x"})
+				return context.Background()
+			},
+			input:   user.RegisterUserInput{Login: "other", Password: "secret1", Role: entity.RoleAdmin},
+			wantErr: true,
+			errMsg:  "пользователь не авторизован",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			repo := memory.NewInMemoryUserRepository()
+			ctx := tt.setup(repo)
+			useCase := user.NewRegisterUserUseCase(repo, hasher)
+
+			// Act
+			output, err := useCase.Execute(ctx, tt.input)
+
+			// Assert
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+				assert.Nil(t, output)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, output)
+				assert.Equal(t, tt.input.Role, output.User.Role)
+				assert.NotEqual(t, tt.input.Password, output.User.PasswordHash)
+
+				saved, err := repo.GetByLogin(context.Background(), tt.input.Login)
+				require.NoError(t, err)
+				assert.Equal(t, output.User.ID, saved.ID)
+			}
+		})
+	}
+}
+
+func TestRegisterUserUseCase_ConcurrentFirstAdmin(t *testing.T) {
+	repo := memory.NewInMemoryUserRepository()
+	useCase := user.NewRegisterUserUseCase(repo, security.NewBcryptHasher())
+
+	// Несколько одновременных регистраций без входа: администратором становится только один
+	const attempts = 5
+	var wg sync.WaitGroup
+	errs := make([]error, attempts)
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = useCase.Execute(context.Background(), user.RegisterUserInput{
+				Login:    fmt.Sprintf("admin%d", i),
+				Password: "secret1",
+				Role:     entity.RoleAdmin,
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+		}
+	}
+	assert.Equal(t, 1, succeeded)
+
+	users, err := repo.List(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, users, 1)
+}
+
+// countingHasher считает сравнения паролей
+type countingHasher struct {
+	*security.BcryptHasher
+	compares int
+}
+
+func (h *countingHasher) Compare(hash, password string) error {
+	h.compares++
+	return h.BcryptHasher.Compare(hash, password)
+}
+
+func TestLoginUseCase_Execute(t *testing.T) {
+	repo := memory.NewInMemoryUserRepository()
+	hasher := security.NewBcryptHasher()
+	ctx := context.Background()
+
+	_, err := user.NewRegisterUserUseCase(repo, hasher).Execute(ctx, user.RegisterUserInput{
+		Login:    "admin",
+		Password: "secret1",
+		Role:     entity.RoleAdmin,
+	})
+	require.NoError(t, err)
+
+	loginUC := user.NewLoginUseCase(repo, hasher)
+
+	output, err := loginUC.Execute(ctx, user.LoginInput{Login: "admin", Password: "secret1"})
+	require.NoError(t, err)
+	assert.Equal(t, "admin", output.User.Login)
+
+	var authErr entity.AuthenticationError
+	_, err = loginUC.Execute(ctx, user.LoginInput{Login: "admin", Password: "wrong"})
+	assert.ErrorAs(t, err, &authErr)
+
+	_, err = loginUC.Execute(ctx, user.LoginInput{Login: "nobody", Password: "secret1"})
+	assert.ErrorAs(t, err, &authErr)
+
+	// Для неизвестного логина пароль тоже сравнивается с хешем
+	counting := &countingHasher{BcryptHasher: hasher}
+	_, err = user.NewLoginUseCase(repo, counting).Execute(ctx, user.LoginInput{Login: "nobody", Password: "secret1"})
+	assert.ErrorAs(t, err, &authErr)
+	assert.Equal(t, 1, counting.compares)
+}
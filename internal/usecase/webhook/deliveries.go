@@ -0,0 +1,63 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// defaultDeliveriesLimit число записей журнала по умолчанию
+const defaultDeliveriesLimit = 100
+
+// ListDeliveriesInput входные данные для просмотра журнала доставки
+type ListDeliveriesInput struct {
+	// WebhookID webhook; пусто - все
+	WebhookID string
+
+	// Limit число последних записей (0 - 100)
+	Limit int
+}
+
+// ListDeliveriesOutput записи журнала доставки, новые первыми
+type ListDeliveriesOutput struct {
+	Deliveries []entity.WebhookDelivery
+}
+
+// ListDeliveriesUseCase use case для просмотра журнала доставки уведомлений
+type ListDeliveriesUseCase struct {
+	repo repository.WebhookRepository
+}
+
+// NewListDeliveriesUseCase создает новый use case
+func NewListDeliveriesUseCase(repo repository.WebhookRepository) *ListDeliveriesUseCase {
+	return &ListDeliveriesUseCase{
+		repo: repo,
+	}
+}
+
+// Execute возвращает последние записи журнала доставки
+func (uc *ListDeliveriesUseCase) Execute(ctx context.Context, input ListDeliveriesInput) (*ListDeliveriesOutput, error) {
+	// Валидация входных данных
+	if input.Limit < 0 {
+		return nil, entity.ValidationError{Field: "limit", Message: "число записей не может быть отрицательным"}
+	}
+	if input.Limit == 0 {
+		input.Limit = defaultDeliveriesLimit
+	}
+
+	deliveries, err := uc.repo.Deliveries(ctx, repository.DeliveryFilter{WebhookID: input.WebhookID, Limit: input.Limit})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read delivery log: %w", err)
+	}
+
+	// Журнал хранится в порядке записи
+	for i, j := 0, len(deliveries)-1; i < j; i, j = i+1, j-1 {
+		deliveries[i], deliveries[j] = deliveries[j], deliveries[i]
+	}
+
+	return &ListDeliveriesOutput{
+		Deliveries: deliveries,
+	}, nil
+}
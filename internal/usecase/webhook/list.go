@@ -0,0 +1,82 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// ListWebhooksInput входные данные для получения списка webhook
+type ListWebhooksInput struct {
+	// Organization организация; пусто - все
+	Organization string
+}
+
+// ListWebhooksOutput список webhook
+type ListWebhooksOutput struct {
+	Webhooks []*entity.Webhook
+}
+
+// ListWebhooksUseCase use case для получения списка webhook
+type ListWebhooksUseCase struct {
+	repo repository.WebhookRepository
+}
+
+// NewListWebhooksUseCase создает новый use case
+func NewListWebhooksUseCase(repo repository.WebhookRepository) *ListWebhooksUseCase {
+	return &ListWebhooksUseCase{
+		repo: repo,
+	}
+}
+
+// Execute возвращает webhook организации
+func (uc *ListWebhooksUseCase) Execute(ctx context.Context, input ListWebhooksInput) (*ListWebhooksOutput, error) {
+	webhooks, err := uc.repo.List(ctx, input.Organization)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	return &ListWebhooksOutput{
+		Webhooks: webhooks,
+	}, nil
+}
+
+// RemoveWebhookInput входные данные для удаления webhook
+type RemoveWebhookInput struct {
+	WebhookID string
+}
+
+// RemoveWebhookOutput результат удаления webhook
+type RemoveWebhookOutput struct {
+	WebhookID string
+}
+
+// RemoveWebhookUseCase use case для удаления webhook; журнал его доставок сохраняется
+type RemoveWebhookUseCase struct {
+	repo repository.WebhookRepository
+}
+
+// NewRemoveWebhookUseCase создает новый use case
+func NewRemoveWebhookUseCase(repo repository.WebhookRepository) *RemoveWebhookUseCase {
+	return &RemoveWebhookUseCase{
+		repo: repo,
+	}
+}
+
+// Execute выполняет удаление webhook
+func (uc *RemoveWebhookUseCase) Execute(ctx context.Context, input RemoveWebhookInput) (*RemoveWebhookOutput, error) {
+	// Валидация входных данных
+	if input.WebhookID == "" {
+		return nil, entity.ValidationError{Field: "webhook_id", Message: "ID webhook обязателен"}
+	}
+
+	if err := uc.repo.Delete(ctx, input.WebhookID); err != nil {
+		return nil, fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	return &RemoveWebhookOutput{
+		WebhookID: input.WebhookID,
+	}, nil
+}
@@ -0,0 +1,87 @@
+// Package webhook содержит use cases регистрации webhook организаций
+// и просмотра журнала доставки уведомлений
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/repository"
+)
+
+// RegisterWebhookInput входные данные для регистрации webhook
+type RegisterWebhookInput struct {
+	Organization string
+	URL          string
+
+	// Secret ключ подписи; пусто - генерируется
+	Secret string
+
+	// Actions действия журнала изменений; пусто - жизненный цикл паспорта
+	Actions []string
+}
+
+// RegisterWebhookOutput результат регистрации webhook; ключ подписи
+// передается получателю уведомлений
+type RegisterWebhookOutput struct {
+	Webhook *entity.Webhook
+}
+
+// RegisterWebhookUseCase use case для регистрации webhook организации
+type RegisterWebhookUseCase struct {
+	repo repository.WebhookRepository
+}
+
+// NewRegisterWebhookUseCase создает новый use case
+func NewRegisterWebhookUseCase(repo repository.WebhookRepository) *RegisterWebhookUseCase {
+	return &RegisterWebhookUseCase{
+		repo: repo,
+	}
+}
+
+// Execute выполняет регистрацию webhook
+func (uc *RegisterWebhookUseCase) Execute(ctx context.Context, input RegisterWebhookInput) (*RegisterWebhookOutput, error) {
+	webhook := &entity.Webhook{
+		ID:           fmt.Sprintf("WH-%d", time.Now().UnixNano()),
+		Organization: strings.TrimSpace(input.Organization),
+		URL:          strings.TrimSpace(input.URL),
+		Secret:       input.Secret,
+		Actions:      input.Actions,
+		CreatedDate:  time.Now(),
+	}
+	if webhook.Secret == "" {
+		secret, err := generateSecret()
+		if err != nil {
+			return nil, err
+		}
+		webhook.Secret = secret
+	}
+
+	// Валидация входных данных
+	if err := webhook.IsValid(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// Сохраняем в репозиторий
+	if err := uc.repo.Create(ctx, webhook); err != nil {
+		return nil, fmt.Errorf("failed to save webhook: %w", err)
+	}
+
+	return &RegisterWebhookOutput{
+		Webhook: webhook,
+	}, nil
+}
+
+// generateSecret генерирует ключ подписи из 32 случайных байт
+func generateSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate secret: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,112 @@
+package webhook_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ZakirAlekperov/GoTechPasport/internal/domain/entity"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/infrastructure/storage/file"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/access"
+	"github.com/ZakirAlekperov/GoTechPasport/internal/usecase/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterWebhookUseCase_Execute(t *testing.T) {
+	admin := access.WithActor(context.Background(), &entity.User{ID: "U-1", Login: "admin", Role: entity.RoleAdmin})
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		input   webhook.RegisterWebhookInput
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:  "admin registers webhook with generated secret",
+			ctx:   admin,
+			input: webhook.RegisterWebhookInput{Organization: "ГУП БТИ", URL: "https://example.com/hooks"},
+		},
+		{
+			name:  "actions from audit log",
+			ctx:   admin,
+			input: webhook.RegisterWebhookInput{Organization: "ГУП БТИ", URL: "http://localhost:9000", Actions: []string{"add_building", "remove_building"}},
+		},
+		{
+			name:    "relative url returns error",
+			ctx:     admin,
+			input:   webhook.RegisterWebhookInput{Organization: "ГУП БТИ", URL: "/hooks"},
+			wantErr: true,
+			errMsg:  "абсолютным URL",
+		},
+		{
+			name:    "short secret returns error",
+			ctx:     admin,
+			input:   webhook.RegisterWebhookInput{Organization: "ГУП БТИ", URL: "https://example.com/hooks", Secret: "123"},
+			wantErr: true,
+			errMsg:  "не менее 16 символов",
+		},
+		{
+			name:    "action without event returns error",
+			ctx:     admin,
+			input:   webhook.RegisterWebhookInput{Organization: "ГУП БТИ", URL: "https://example.com/hooks", Actions: []string{"add_room"}},
+			wantErr: true,
+			errMsg:  "add_room",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			repo := file.NewJSONWebhookRepository(t.TempDir())
+			useCase := webhook.NewRegisterWebhookUseCase(repo)
+
+			// Act
+			output, err := useCase.Execute(tt.ctx, tt.input)
+
+			// Assert
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+				assert.Nil(t, output)
+			} else {
+				require.NoError(t, err)
+				assert.Len(t, output.Webhook.Secret, 64)
+
+				saved, err := repo.GetByID(context.Background(), output.Webhook.ID)
+				require.NoError(t, err)
+				assert.Equal(t, tt.input.URL, saved.URL)
+			}
+		})
+	}
+}
+
+func TestListDeliveriesUseCase_Execute(t *testing.T) {
+	admin := access.WithActor(context.Background(), &entity.User{ID: "U-1", Login: "admin", Role: entity.RoleAdmin})
+	repo := file.NewJSONWebhookRepository(t.TempDir())
+
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	for i, status := range []entity.WebhookDeliveryStatus{entity.WebhookFailed, entity.WebhookDelivered, entity.WebhookDelivered} {
+		webhookID := "WH-1"
+		if i == 2 {
+			webhookID = "WH-2"
+		}
+		require.NoError(t, repo.RecordDelivery(admin, entity.WebhookDelivery{
+			WebhookID: webhookID, EventID: "EV-1", Action: "created", Attempt: i + 1, Status: status,
+			Timestamp: start.Add(time.Duration(i) * time.Minute),
+		}))
+	}
+
+	// Новые записи первыми, с отбором по webhook
+	output, err := webhook.NewListDeliveriesUseCase(repo).Execute(admin, webhook.ListDeliveriesInput{WebhookID: "WH-1"})
+	require.NoError(t, err)
+	require.Len(t, output.Deliveries, 2)
+	assert.Equal(t, entity.WebhookDelivered, output.Deliveries[0].Status)
+	assert.Equal(t, entity.WebhookFailed, output.Deliveries[1].Status)
+
+	output, err = webhook.NewListDeliveriesUseCase(repo).Execute(admin, webhook.ListDeliveriesInput{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, output.Deliveries, 1)
+	assert.Equal(t, "WH-2", output.Deliveries[0].WebhookID)
+}